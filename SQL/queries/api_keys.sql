@@ -0,0 +1,25 @@
+-- name: apiKeyCreate :one
+-- apiKeyCreate will insert a hashed API key with its scopes for a client.
+INSERT INTO api_keys (client_id, name, key_hash, scopes)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: apiKeyGetByHash :one
+-- apiKeyGetByHash will retrieve an API key that has not been revoked using its hash.
+SELECT *
+FROM api_keys
+WHERE key_hash = $1 AND revoked_at IS NULL
+LIMIT 1;
+
+-- name: apiKeyList :many
+-- apiKeyList will retrieve all of a client's API keys that have not been revoked, oldest first.
+SELECT *
+FROM api_keys
+WHERE client_id = $1 AND revoked_at IS NULL
+ORDER BY created_at;
+
+-- name: apiKeyRevoke :execrows
+-- apiKeyRevoke will revoke one of a client's API keys.
+UPDATE api_keys
+SET revoked_at = now()
+WHERE client_id = $1 AND key_id = $2 AND revoked_at IS NULL;
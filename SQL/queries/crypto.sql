@@ -4,8 +4,9 @@ INSERT INTO crypto_accounts (client_id, ticker)
 VALUES ($1, $2);
 
 -- name: cryptoPurchase :exec
--- cryptoPurchase will execute a transaction to purchase a Cryptocurrency using a Fiat currency.
-CALL purchase_cryptocurrency($1,$2,$3, @fiat_debit_amount::numeric(18, 2), $4, @crypto_credit_amount::numeric(24, 8));
+-- cryptoPurchase will execute a transaction to purchase a Cryptocurrency using a Fiat currency and record the trade.
+CALL purchase_cryptocurrency($1,$2,$3, @fiat_debit_amount::numeric(18, 2), $4, @crypto_credit_amount::numeric(24, 8),
+    @fiat_fee_amount::numeric(18, 2), @rate::numeric(32, 16), @offer_id::varchar(32));
 
 -- name: cryptoGetAccount :one
 -- cryptoGetAccount will retrieve a specific user's account for a given cryptocurrency ticker.
@@ -20,8 +21,14 @@ FROM crypto_journal
 WHERE client_id = $1 AND tx_id = $2;
 
 -- name: cryptoSell :exec
--- cryptoSell will execute a transaction to sell a Cryptocurrency and purchase a Fiat currency.
-CALL sell_cryptocurrency($1,$2,$3, @fiat_credit_amount::numeric(18, 2), $4, @crypto_debit_amount::numeric(24, 8));
+-- cryptoSell will execute a transaction to sell a Cryptocurrency, purchase a Fiat currency, and record the trade.
+CALL sell_cryptocurrency($1,$2,$3, @fiat_credit_amount::numeric(18, 2), $4, @crypto_debit_amount::numeric(24, 8),
+    @crypto_fee_amount::numeric(24, 8), @rate::numeric(32, 16), @offer_id::varchar(32));
+
+-- name: cryptoSwap :exec
+-- cryptoSwap will execute a transaction to sell a source Cryptocurrency and purchase a destination Cryptocurrency.
+CALL swap_cryptocurrency($1,$2,$3, @source_debit_amount::numeric(24, 8), $4, @destination_credit_amount::numeric(24, 8),
+    @source_fee_amount::numeric(24, 8));
 
 -- name: cryptoGetAllAccounts :many
 -- cryptoGetAllAccounts will retrieve all accounts associated with a specific user.
@@ -44,3 +51,18 @@ WHERE client_id = $1
 ORDER BY transacted_at DESC
 OFFSET $3
 LIMIT $4;
+
+-- name: cryptoSetAccountStatus :one
+-- cryptoSetAccountStatus will set the status of a specific user's account for a given cryptocurrency ticker.
+UPDATE crypto_accounts
+SET status=$3
+WHERE client_id=$1 AND ticker=$2
+RETURNING *;
+
+-- name: cryptoUpdateAccountHold :one
+-- cryptoUpdateAccountHold will add an amount to the funds on hold in a specific user's account for a given
+-- cryptocurrency ticker. The funds on hold cannot be negative or exceed the balance.
+UPDATE crypto_accounts
+SET held=held + @Amount::numeric(24, 8)
+WHERE client_id=$1 AND ticker=$2 AND held + @Amount::numeric(24, 8) BETWEEN 0 AND balance
+RETURNING *;
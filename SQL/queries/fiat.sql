@@ -5,7 +5,7 @@ VALUES ($1, $2);
 
 -- name: fiatRowLockAccount :one
 -- fiatRowLockAccount will acquire a row level lock without locks on the foreign keys.
-SELECT balance
+SELECT balance, held, status
 FROM fiat_accounts
 WHERE client_id=$1 AND currency=$2
 LIMIT 1
@@ -55,6 +55,41 @@ SELECT
         FROM deposit)
 RETURNING tx_id, transacted_at;
 
+-- name: fiatExternalWithdrawalJournalEntry :one
+-- fiatExternalWithdrawalJournalEntry will create both journal entries for fiat accounts outbound withdrawals.
+WITH withdrawal AS (
+    INSERT INTO fiat_journal (
+        client_id,
+        currency,
+        amount,
+        transacted_at,
+        tx_id)
+    SELECT
+        $1,
+        $2,
+        round_half_even(-1 * @amount::numeric(18, 2), 2),
+        now(),
+        gen_random_uuid()
+    RETURNING tx_id, transacted_at
+)
+INSERT INTO fiat_journal (
+    client_id,
+    currency,
+    amount,
+    transacted_at,
+    tx_id)
+SELECT
+    (   SELECT client_id
+        FROM users
+        WHERE username = 'fiat-currencies'),
+    $2,
+    round_half_even(@amount::numeric(18, 2), 2),
+    (   SELECT transacted_at
+        FROM withdrawal),
+    (   SELECT tx_id
+        FROM withdrawal)
+RETURNING tx_id, transacted_at;
+
 -- name: fiatInternalTransferJournalEntry :one
 -- fiatInternalTransferJournalEntry will create both journal entries for fiat account internal transfers.
 WITH deposit AS (
@@ -88,6 +123,23 @@ SELECT
         FROM deposit)
 RETURNING tx_id, transacted_at;
 
+-- name: fiatFeeJournalEntry :exec
+-- fiatFeeJournalEntry will create the journal entry for fees collected in the fee revenue operations account.
+INSERT INTO fiat_journal (
+    client_id,
+    currency,
+    amount,
+    transacted_at,
+    tx_id)
+SELECT
+    (   SELECT client_id
+        FROM users
+        WHERE username = 'fee-revenue'),
+    $1,
+    round_half_even(@amount::numeric(18, 2), 2),
+    $2,
+    $3;
+
 -- name: fiatGetJournalTransaction :many
 -- fiatGetJournalTransaction will retrieve the journal entries associated with a transaction.
 SELECT *
@@ -127,3 +179,56 @@ FROM fiat_accounts
 WHERE client_id=$1 AND currency >= $2
 ORDER BY currency
 LIMIT $3;
+
+-- name: fiatGetDepositJournalEntry :one
+-- fiatGetDepositJournalEntry will retrieve a user's journal entry for an inbound deposit from the Fiat currency
+-- operations account. Deposits consist of only the two Fiat Journal entries, which excludes the Fiat proceeds of
+-- Cryptocurrency sales.
+SELECT deposit.*
+FROM fiat_journal AS deposit
+WHERE deposit.client_id = $1
+      AND deposit.tx_id = $2
+      AND deposit.amount > 0
+      AND EXISTS (
+          SELECT 1
+          FROM fiat_journal AS operations
+          WHERE operations.tx_id = deposit.tx_id
+                AND operations.amount = - deposit.amount
+                AND operations.client_id = (
+                    SELECT client_id
+                    FROM users
+                    WHERE username = 'fiat-currencies'))
+      AND (
+          SELECT COUNT(*)
+          FROM fiat_journal AS entries
+          WHERE entries.tx_id = deposit.tx_id) = 2
+      AND NOT EXISTS (
+          SELECT 1
+          FROM crypto_journal AS crypto
+          WHERE crypto.tx_id = deposit.tx_id)
+      AND NOT EXISTS (
+          SELECT 1
+          FROM trades
+          WHERE trades.tx_id = deposit.tx_id)
+LIMIT 1;
+
+-- name: fiatReversalCreate :execrows
+-- fiatReversalCreate will record the reversal of a Fiat deposit. Deposits that have already been reversed are ignored.
+INSERT INTO fiat_reversals (tx_id, client_id, currency, amount, reversal_tx_id, reversed_by, reversed_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (tx_id) DO NOTHING;
+
+-- name: fiatSetAccountStatus :one
+-- fiatSetAccountStatus will set the status of a specific user's account for a given currency.
+UPDATE fiat_accounts
+SET status=$3
+WHERE client_id=$1 AND currency=$2
+RETURNING *;
+
+-- name: fiatUpdateAccountHold :one
+-- fiatUpdateAccountHold will add an amount to the funds on hold in a specific user's account for a given currency. The
+-- funds on hold cannot be negative or exceed the balance.
+UPDATE fiat_accounts
+SET held=held + @Amount::numeric(18, 2)
+WHERE client_id=$1 AND currency=$2 AND held + @Amount::numeric(18, 2) BETWEEN 0 AND balance
+RETURNING *;
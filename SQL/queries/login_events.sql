@@ -0,0 +1,13 @@
+-- name: loginEventCreate :exec
+-- loginEventCreate will record a login attempt against a user account.
+INSERT INTO login_events (client_id, outcome, ip_address, user_agent)
+VALUES ($1, $2, $3, $4);
+
+-- name: loginEventGetAllPaginated :many
+-- loginEventGetAllPaginated will retrieve a page of login attempts against a user account, newest first.
+SELECT *
+FROM login_events
+WHERE client_id = $1
+ORDER BY created_at DESC
+OFFSET $2
+LIMIT $3;
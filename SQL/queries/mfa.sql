@@ -0,0 +1,48 @@
+-- name: mfaEnroll :execrows
+-- mfaEnroll will create or replace a pending multifactor authentication enrollment. Enabled enrollments are not replaced.
+INSERT INTO mfa_enrollments (client_id, secret, recovery_codes)
+VALUES ($1, $2, $3)
+ON CONFLICT (client_id) DO UPDATE
+SET secret = EXCLUDED.secret,
+    recovery_codes = EXCLUDED.recovery_codes,
+    last_step = 0,
+    created_at = now()
+WHERE mfa_enrollments.is_enabled = false;
+
+-- name: mfaGet :one
+-- mfaGet will retrieve the multifactor authentication enrollment for a client.
+SELECT *
+FROM mfa_enrollments
+WHERE client_id = $1
+LIMIT 1;
+
+-- name: mfaEnable :execrows
+-- mfaEnable will enable a pending multifactor authentication enrollment and record the time step of the code used.
+UPDATE mfa_enrollments
+SET is_enabled = true,
+    last_step = $2
+WHERE client_id = $1 AND is_enabled = false AND last_step < $2;
+
+-- name: mfaUseStep :execrows
+-- mfaUseStep will record the time step of a one-time password code. Codes from the same or earlier time steps are
+-- rejected to stop replays.
+UPDATE mfa_enrollments
+SET last_step = $2
+WHERE client_id = $1 AND is_enabled = true AND last_step < $2;
+
+-- name: mfaUseRecoveryCode :execrows
+-- mfaUseRecoveryCode will remove a single-use hashed recovery code from an enabled enrollment.
+UPDATE mfa_enrollments
+SET recovery_codes = array_remove(recovery_codes, @recovery_code::varchar(64))
+WHERE client_id = @client_id AND is_enabled = true AND @recovery_code::varchar(64) = ANY(recovery_codes);
+
+-- name: mfaSetRecoveryCodes :execrows
+-- mfaSetRecoveryCodes will replace the hashed recovery codes for an enabled enrollment.
+UPDATE mfa_enrollments
+SET recovery_codes = $2
+WHERE client_id = $1 AND is_enabled = true;
+
+-- name: mfaDelete :execrows
+-- mfaDelete will remove the multifactor authentication enrollment for a client.
+DELETE FROM mfa_enrollments
+WHERE client_id = $1;
@@ -0,0 +1,66 @@
+-- name: orderCreate :one
+-- orderCreate will insert a new open limit order.
+INSERT INTO orders (client_id, source, destination, amount, limit_rate)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: orderCancel :one
+-- orderCancel will cancel a specific user's order if it is still open.
+UPDATE orders
+SET status = 'cancelled', updated_at = now()
+WHERE client_id = $1 AND order_id = $2 AND status = 'open'
+RETURNING *;
+
+-- name: orderGetAllPaginated :many
+-- orderGetAllPaginated will retrieve a page of orders associated with a specific user, newest first.
+SELECT *
+FROM orders
+WHERE client_id = $1
+ORDER BY created_at DESC
+OFFSET $2
+LIMIT $3;
+
+-- name: orderGetOpen :many
+-- orderGetOpen will retrieve the least recently checked open orders of unfrozen users and mark them as checked, so that
+-- successive calls rotate through all the open orders.
+UPDATE orders
+SET checked_at = now()
+WHERE order_id IN (
+    SELECT order_id
+    FROM orders
+    WHERE status = 'open' AND client_id NOT IN (SELECT client_id FROM users WHERE is_frozen)
+    ORDER BY checked_at NULLS FIRST, created_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED)
+RETURNING *;
+
+-- name: orderUpdateStatus :execrows
+-- orderUpdateStatus will move an order from its current status to the next status.
+UPDATE orders
+SET status = @next_status, updated_at = now()
+WHERE order_id = @order_id AND status = @current_status;
+
+-- name: orderClaim :execrows
+-- orderClaim will move an open order to executing and record the execution details. The transaction ID is recorded if
+-- it is assigned before the order is settled.
+UPDATE orders
+SET status = 'executing', fill_rate = $2, fill_amount = $3, fee = $4, tx_id = $5, updated_at = now()
+WHERE order_id = $1 AND status = 'open';
+
+-- name: orderFill :execrows
+-- orderFill will mark an executing order as filled with the transaction that settled it.
+UPDATE orders
+SET status = 'filled', tx_id = $2, updated_at = now()
+WHERE order_id = $1 AND status = 'executing';
+
+-- name: orderFillStale :execrows
+-- orderFillStale will mark orders that have been executing since before a cutoff as filled if their trade was recorded.
+UPDATE orders
+SET status = 'filled', updated_at = now()
+WHERE status = 'executing' AND updated_at < $1 AND tx_id IN (SELECT tx_id FROM trades);
+
+-- name: orderReopenStale :execrows
+-- orderReopenStale will reopen orders that have been executing since before a cutoff without their trade being recorded.
+UPDATE orders
+SET status = 'open', fill_rate = 0, fill_amount = 0, fee = 0, tx_id = NULL, updated_at = now()
+WHERE status = 'executing' AND updated_at < $1;
@@ -0,0 +1,62 @@
+-- name: outboxPublish :exec
+-- outboxPublish will publish an account event to the outbox and queue its delivery to the client's webhooks.
+SELECT outbox_publish(@client_id, @event_type, @payload);
+
+-- name: webhookDeliveryClaimDue :many
+-- webhookDeliveryClaimDue will claim a batch of the most overdue pending deliveries by moving their next attempt time
+-- forward, and retrieve them along with their events and webhooks. Deliveries claimed by another instance are skipped.
+UPDATE webhook_deliveries AS wd
+SET next_attempt_at = @leased_until, updated_at = now()
+FROM outbox AS o, webhooks AS w
+WHERE wd.delivery_id IN (
+        SELECT delivery_id
+        FROM webhook_deliveries
+        WHERE status = 'pending' AND next_attempt_at <= now()
+        ORDER BY next_attempt_at
+        LIMIT @batch_size
+        FOR UPDATE SKIP LOCKED)
+    AND o.event_id = wd.event_id
+    AND w.webhook_id = wd.webhook_id
+RETURNING wd.delivery_id, wd.event_id, wd.client_id, wd.attempts, o.event_type, o.payload, o.created_at, w.url, w.secret;
+
+-- name: webhookDeliveryUpdate :execrows
+-- webhookDeliveryUpdate will record the outcome of a delivery attempt.
+UPDATE webhook_deliveries
+SET status = @status,
+    attempts = attempts + 1,
+    next_attempt_at = @next_attempt_at,
+    last_error = @last_error,
+    updated_at = now()
+WHERE delivery_id = @delivery_id;
+
+-- name: webhookDeliveryGetDeadPaginated :many
+-- webhookDeliveryGetDeadPaginated will retrieve a page of a client's dead-lettered deliveries, most recent first.
+SELECT wd.delivery_id, wd.event_id, wd.webhook_id, w.url, o.event_type, o.payload, wd.attempts, wd.last_error,
+       o.created_at, wd.updated_at
+FROM webhook_deliveries AS wd
+    INNER JOIN outbox AS o ON o.event_id = wd.event_id
+    INNER JOIN webhooks AS w ON w.webhook_id = wd.webhook_id
+WHERE wd.client_id = $1 AND wd.status = 'dead'
+ORDER BY wd.updated_at DESC
+OFFSET $2
+LIMIT $3;
+
+-- name: outboxClaimUnrelayed :many
+-- outboxClaimUnrelayed will claim a batch of the oldest events that have not been relayed to the Redis event streams by
+-- marking them as relayed. Events claimed by another instance are skipped.
+UPDATE outbox
+SET relayed_at = now()
+WHERE event_id IN (
+        SELECT event_id
+        FROM outbox
+        WHERE relayed_at IS NULL
+        ORDER BY created_at
+        LIMIT @batch_size
+        FOR UPDATE SKIP LOCKED)
+RETURNING event_id, client_id, event_type, payload, created_at, relayed_at;
+
+-- name: outboxReleaseRelay :execrows
+-- outboxReleaseRelay will return an event that could not be relayed to the Redis event streams to be claimed again.
+UPDATE outbox
+SET relayed_at = NULL
+WHERE event_id = @event_id;
@@ -0,0 +1,18 @@
+-- name: rateCreate :exec
+-- rateCreate will record a currency price quote fetched from a quote provider.
+INSERT INTO rates (source, destination, rate, provider, quoted_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: rateHistory :many
+-- rateHistory will retrieve the open, high, low, and close rates for a currency pair in fixed intervals over a time range.
+SELECT
+    date_bin(@bucket::INTERVAL, quoted_at, @start_time::TIMESTAMPTZ)::TIMESTAMPTZ AS bucket_start,
+    (array_agg(rate ORDER BY quoted_at, fetched_at))[1]::NUMERIC AS open,
+    MAX(rate)::NUMERIC AS high,
+    MIN(rate)::NUMERIC AS low,
+    (array_agg(rate ORDER BY quoted_at DESC, fetched_at DESC))[1]::NUMERIC AS close,
+    COUNT(*) AS quotes
+FROM rates
+WHERE source = @source AND destination = @destination AND quoted_at >= @start_time AND quoted_at < @end_time
+GROUP BY bucket_start
+ORDER BY bucket_start;
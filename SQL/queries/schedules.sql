@@ -0,0 +1,54 @@
+-- name: scheduleCreate :one
+-- scheduleCreate will insert a new active recurring purchase schedule.
+INSERT INTO schedules (client_id, fiat_currency, ticker, amount, frequency, next_run_at, anchor_day)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: scheduleUpdate :one
+-- scheduleUpdate will update the amount, frequency, and active state of a specific user's schedule.
+UPDATE schedules
+SET amount = @amount, frequency = @frequency, is_active = @is_active, updated_at = now()
+WHERE client_id = @client_id AND schedule_id = @schedule_id
+RETURNING *;
+
+-- name: scheduleDelete :execrows
+-- scheduleDelete will delete a specific user's schedule along with its run history.
+DELETE FROM schedules
+WHERE client_id = $1 AND schedule_id = $2;
+
+-- name: scheduleGetAllPaginated :many
+-- scheduleGetAllPaginated will retrieve a page of schedules associated with a specific user, newest first.
+SELECT *
+FROM schedules
+WHERE client_id = $1
+ORDER BY created_at DESC
+OFFSET $2
+LIMIT $3;
+
+-- name: scheduleGetDue :many
+-- scheduleGetDue will retrieve the active schedules of unfrozen users that are due to run, most overdue first.
+SELECT *
+FROM schedules
+WHERE is_active AND next_run_at <= now() AND client_id NOT IN (SELECT client_id FROM users WHERE is_frozen)
+ORDER BY next_run_at
+LIMIT $1;
+
+-- name: scheduleAdvance :execrows
+-- scheduleAdvance will move an active schedule's next run time forward if it has not changed since it was retrieved.
+UPDATE schedules
+SET next_run_at = @next_run_at, updated_at = now()
+WHERE schedule_id = @schedule_id AND next_run_at = @current_run_at AND is_active;
+
+-- name: scheduleRunCreate :exec
+-- scheduleRunCreate will record the outcome of a schedule's execution.
+INSERT INTO schedule_runs (schedule_id, client_id, status, rate, fiat_amount, crypto_amount, fee, tx_id, message)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: scheduleRunGetAllPaginated :many
+-- scheduleRunGetAllPaginated will retrieve a page of a specific user's schedule run history, newest first.
+SELECT *
+FROM schedule_runs
+WHERE client_id = $1 AND schedule_id = $2
+ORDER BY executed_at DESC
+OFFSET $3
+LIMIT $4;
@@ -0,0 +1,10 @@
+-- name: tradeCreate :exec
+-- tradeCreate will record the exchange rate and price quote offer that priced a trade.
+INSERT INTO trades (tx_id, client_id, offer_id, source, destination, rate, traded_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: tradeGetTransaction :many
+-- tradeGetTransaction will retrieve the trade associated with a transaction.
+SELECT *
+FROM trades
+WHERE client_id = $1 AND tx_id = $2;
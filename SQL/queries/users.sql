@@ -6,7 +6,7 @@ RETURNING client_id;
 
 -- name: userGetInfo :one
 -- userGetInfo will retrieve a single users account information.
-SELECT username, client_id, password, first_name, last_name, email, is_deleted
+SELECT username, client_id, password, first_name, last_name, email, is_deleted, is_frozen, role
 FROM users
 WHERE client_id=$1
 LIMIT 1;
@@ -37,3 +37,29 @@ SELECT is_deleted
 FROM users
 WHERE client_id=$1
 LIMIT 1;
+
+-- name: userUpdatePassword :execrows
+-- userUpdatePassword will replace the hashed password of an active users account.
+UPDATE users
+SET password=$2
+WHERE client_id=$1 AND is_deleted=false;
+
+-- name: userGetRole :one
+-- userGetRole will return the role of a user account.
+SELECT role
+FROM users
+WHERE client_id=$1
+LIMIT 1;
+
+-- name: userIsFrozen :one
+-- userIsFrozen will return the frozen status of a user account.
+SELECT is_frozen
+FROM users
+WHERE client_id=$1
+LIMIT 1;
+
+-- name: userSetFrozen :execrows
+-- userSetFrozen will freeze or unfreeze an active users account.
+UPDATE users
+SET is_frozen=$2
+WHERE client_id=$1 AND is_deleted=false;
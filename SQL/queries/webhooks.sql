@@ -0,0 +1,19 @@
+-- name: webhookCreate :one
+-- webhookCreate will register a webhook URL with its encrypted signing secret for a client that has fewer than the
+-- maximum number of webhooks.
+INSERT INTO webhooks (client_id, url, secret)
+SELECT @client_id::UUID, @url::VARCHAR, @secret::VARCHAR
+WHERE (SELECT COUNT(*) FROM webhooks WHERE client_id = @client_id::UUID) < @max_webhooks::BIGINT
+RETURNING *;
+
+-- name: webhookGetAll :many
+-- webhookGetAll will retrieve all of a client's webhooks, oldest first.
+SELECT *
+FROM webhooks
+WHERE client_id = $1
+ORDER BY created_at;
+
+-- name: webhookDelete :execrows
+-- webhookDelete will delete one of a client's webhooks along with its deliveries.
+DELETE FROM webhooks
+WHERE client_id = $1 AND webhook_id = $2;
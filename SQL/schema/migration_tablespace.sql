@@ -406,3 +406,3392 @@ AS '
     END;
 ';
 --rollback DROP PROCEDURE sell_cryptocurrency;
+
+--changeset surahman:12
+--preconditions onFail:HALT onError:HALT
+--comment: Swap one Cryptocurrency for another.
+CREATE OR REPLACE PROCEDURE swap_cryptocurrency(
+    _transaction_id             UUID,
+    _client_id                  UUID,
+    _source_ticker              VARCHAR(6),
+    _source_debit_amount        NUMERIC(24,8),
+    _destination_ticker         VARCHAR(6),
+    _destination_credit_amount  NUMERIC(24,8)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      source_balance        NUMERIC(24,8);  -- current balance of the source Crypto account.
+      destination_balance   NUMERIC(24,8);  -- current balance of the destination Crypto account.
+      current_timestamp     TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_crypto_id        UUID;           -- FTeX Crypto operations account id.
+    BEGIN
+      -- Source and destination Cryptocurrencies must differ.
+      IF _source_ticker = _destination_ticker THEN
+         RAISE EXCEPTION ''swap_cryptocurrency: source and destination Cryptocurrencies must differ'';
+      END IF;
+
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Get FTeX operations account ID.
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      -- Row lock both Crypto accounts in ticker order, without locking the foreign keys, to avoid deadlocks.
+      PERFORM ca.balance
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker IN (_source_ticker, _destination_ticker)
+      ORDER BY ca.ticker
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance INTO STRICT source_balance
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _source_ticker
+      LIMIT 1;
+
+      SELECT ca.balance INTO STRICT destination_balance
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _destination_ticker
+      LIMIT 1;
+
+      -- Check for sufficient source Cryptocurrency balance to complete swap.
+      IF _source_debit_amount > source_balance THEN
+         RAISE EXCEPTION ''swap_cryptocurrency: insufficient Cryptocurrency funds, delta %'', source_balance - _source_debit_amount;
+      END IF;
+
+      -- Debit the source Crypto account and create the Crypto Journal entries for outflow from client to FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(source_balance - _source_debit_amount, 8),
+          last_tx = - _source_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _source_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to update source Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _source_ticker, - _source_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to create Crypto Journal debit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _source_ticker, _source_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to create FTeX operations source Crypto Journal entry'';
+      END IF;
+
+      -- Credit the destination Crypto account and create the Crypto Journal entries for inflow to client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(destination_balance + _destination_credit_amount, 8),
+          last_tx = _destination_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _destination_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to update destination Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _destination_ticker, _destination_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to create Crypto Journal credit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _destination_ticker, - _destination_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to create FTeX operations destination Crypto Journal entry'';
+      END IF;
+
+      COMMIT;
+    END;
+';
+--rollback DROP PROCEDURE swap_cryptocurrency;
+
+--changeset surahman:13
+--preconditions onFail:HALT onError:HALT
+--comment: Create fee revenue operations user and accounts.
+INSERT INTO users (
+    first_name,
+    last_name,
+    email,
+    username,
+    password,
+    is_deleted)
+SELECT
+   'Internal',
+   'FTeX, Inc.',
+   'fees@ftex.com',
+   'fee-revenue',
+   password,
+   true
+FROM
+    substr(md5(random()::text), 0, 32) AS password;
+
+INSERT INTO fiat_accounts (
+    currency,
+    client_id)
+SELECT
+   'FIAT',
+   client_id
+FROM
+    users AS client_id
+WHERE
+    username = 'fee-revenue';
+
+INSERT INTO crypto_accounts (
+    ticker,
+    client_id)
+SELECT
+   'CRYPTO',
+   client_id
+FROM
+    users AS client_id
+WHERE
+    username = 'fee-revenue';
+--rollback DELETE FROM users WHERE username='fee-revenue';
+
+--changeset surahman:14
+--preconditions onFail:HALT onError:HALT
+--comment: Purchase a Cryptocurrency using a base Fiat currency and collect a fee in the Fiat currency.
+CREATE OR REPLACE PROCEDURE purchase_cryptocurrency(
+    _transaction_id         UUID,
+    _client_id              UUID,
+    _fiat_currency          Currency,
+    _fiat_debit_amount      NUMERIC(20, 2),
+    _crypto_ticker          VARCHAR(6),
+    _crypto_credit_amount   NUMERIC(24,8),
+    _fiat_fee_amount        NUMERIC(20, 2)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+      crypto_balance      NUMERIC(24,8);  -- current balance of the Crypto account.
+      current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+      ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+      ftex_fees_id        UUID;           -- FTeX fee revenue operations account id.
+    BEGIN
+
+      -- The fee is collected from the Fiat debit amount.
+      IF _fiat_fee_amount < 0 OR _fiat_fee_amount > _fiat_debit_amount THEN
+         RAISE EXCEPTION ''purchase_cryptocurrency: invalid fee amount %'', _fiat_fee_amount;
+      END IF;
+
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Get FTeX operations account IDs.
+      SELECT client_id INTO STRICT ftex_fiat_id
+      FROM users
+      WHERE username = ''fiat-currencies'';
+
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      SELECT client_id INTO STRICT ftex_fees_id
+      FROM users
+      WHERE username = ''fee-revenue'';
+
+      -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+      SELECT fa.balance INTO STRICT fiat_balance
+      FROM fiat_accounts AS fa
+      WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance INTO STRICT crypto_balance
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      -- Check for sufficient Fiat balance to complete purchase.
+      IF _fiat_debit_amount > fiat_balance THEN
+         RAISE EXCEPTION ''purchase_cryptocurrency: insufficient Fiat currency funds, delta %'', fiat_balance - _fiat_debit_amount;
+      END IF;
+
+      -- Debit the Fiat account and create the Fiat Journal entries for outflow from client to FTeX.
+      UPDATE fiat_accounts
+      SET balance = round_half_even(fiat_balance - _fiat_debit_amount, 2),
+          last_tx = - _fiat_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND currency = _fiat_currency;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Fiat balance'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (_client_id, _fiat_currency, - _fiat_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Fiat Journal debit entry'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (ftex_fiat_id, _fiat_currency, _fiat_debit_amount - _fiat_fee_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+      END IF;
+
+      -- Create the fee revenue Fiat Journal entry.
+      IF _fiat_fee_amount > 0 THEN
+        INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+        VALUES (ftex_fees_id, _fiat_currency, _fiat_fee_amount, current_timestamp, _transaction_id);
+
+        IF NOT FOUND THEN
+          RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX fee revenue Fiat Journal entry'';
+        END IF;
+      END IF;
+
+      -- Credit the Crypto account and create the Crypto Journal entries for inflow to client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(crypto_balance + _crypto_credit_amount, 8),
+          last_tx = _crypto_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _crypto_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _crypto_ticker, _crypto_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Crypto Journal credit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _crypto_ticker, - _crypto_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+      END IF;
+
+      COMMIT;
+    END;
+';
+--rollback DROP PROCEDURE purchase_cryptocurrency(UUID, UUID, Currency, NUMERIC, VARCHAR, NUMERIC, NUMERIC);
+
+--changeset surahman:15
+--preconditions onFail:HALT onError:HALT
+--comment: Sell a Cryptocurrency, purchase a Fiat currency, and collect a fee in the Cryptocurrency.
+CREATE OR REPLACE PROCEDURE sell_cryptocurrency(
+    _transaction_id         UUID,
+    _client_id              UUID,
+    _fiat_currency          Currency,
+    _fiat_credit_amount     NUMERIC(20, 2),
+    _crypto_ticker          VARCHAR(6),
+    _crypto_debit_amount    NUMERIC(24,8),
+    _crypto_fee_amount      NUMERIC(24,8)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+      crypto_balance      NUMERIC(24,8);  -- current balance of the Crypto account.
+      current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+      ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+      ftex_fees_id        UUID;           -- FTeX fee revenue operations account id.
+    BEGIN
+      -- The fee is collected from the Cryptocurrency debit amount.
+      IF _crypto_fee_amount < 0 OR _crypto_fee_amount > _crypto_debit_amount THEN
+         RAISE EXCEPTION ''sell_cryptocurrency: invalid fee amount %'', _crypto_fee_amount;
+      END IF;
+
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Get FTeX operations account IDs.
+      SELECT client_id INTO STRICT ftex_fiat_id
+      FROM users
+      WHERE username = ''fiat-currencies'';
+
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      SELECT client_id INTO STRICT ftex_fees_id
+      FROM users
+      WHERE username = ''fee-revenue'';
+
+      -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+      SELECT fa.balance INTO STRICT fiat_balance
+      FROM fiat_accounts AS fa
+      WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance INTO STRICT crypto_balance
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      -- Check for sufficient Cryptocurrency balance to complete sale.
+      IF _crypto_debit_amount > crypto_balance THEN
+         RAISE EXCEPTION ''sell_cryptocurrency: insufficient Cryptocurrency funds, delta %'', crypto_balance - _crypto_debit_amount;
+      END IF;
+
+      -- Debit the Crypto account and create the Crypto Journal entries for outflow from client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(crypto_balance - _crypto_debit_amount, 8),
+          last_tx = - _crypto_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _crypto_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to update Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _crypto_ticker, - _crypto_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create Crypto Journal debit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _crypto_ticker, _crypto_debit_amount - _crypto_fee_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+      END IF;
+
+      -- Create the fee revenue Crypto Journal entry.
+      IF _crypto_fee_amount > 0 THEN
+        INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+        VALUES (ftex_fees_id, _crypto_ticker, _crypto_fee_amount, current_timestamp, _transaction_id);
+
+        IF NOT FOUND THEN
+          RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX fee revenue Crypto Journal entry'';
+        END IF;
+      END IF;
+
+      -- Credit the Fiat account and create the Fiat Journal entries for inflow to the client from FTeX.
+      UPDATE fiat_accounts
+      SET balance = round_half_even(fiat_balance + _fiat_credit_amount, 2),
+          last_tx = _fiat_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND currency = _fiat_currency;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to update Fiat balance'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (_client_id, _fiat_currency, _fiat_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create Fiat Journal credit entry'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (ftex_fiat_id, _fiat_currency, - _fiat_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+      END IF;
+
+      COMMIT;
+    END;
+';
+--rollback DROP PROCEDURE sell_cryptocurrency(UUID, UUID, Currency, NUMERIC, VARCHAR, NUMERIC, NUMERIC);
+
+--changeset surahman:16
+--preconditions onFail:HALT onError:HALT
+--comment: Swap one Cryptocurrency for another and collect a fee in the source Cryptocurrency.
+CREATE OR REPLACE PROCEDURE swap_cryptocurrency(
+    _transaction_id             UUID,
+    _client_id                  UUID,
+    _source_ticker              VARCHAR(6),
+    _source_debit_amount        NUMERIC(24,8),
+    _destination_ticker         VARCHAR(6),
+    _destination_credit_amount  NUMERIC(24,8),
+    _source_fee_amount          NUMERIC(24,8)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      source_balance        NUMERIC(24,8);  -- current balance of the source Crypto account.
+      destination_balance   NUMERIC(24,8);  -- current balance of the destination Crypto account.
+      current_timestamp     TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_crypto_id        UUID;           -- FTeX Crypto operations account id.
+      ftex_fees_id          UUID;           -- FTeX fee revenue operations account id.
+    BEGIN
+      -- Source and destination Cryptocurrencies must differ.
+      IF _source_ticker = _destination_ticker THEN
+         RAISE EXCEPTION ''swap_cryptocurrency: source and destination Cryptocurrencies must differ'';
+      END IF;
+
+      -- The fee is collected from the source Cryptocurrency debit amount.
+      IF _source_fee_amount < 0 OR _source_fee_amount > _source_debit_amount THEN
+         RAISE EXCEPTION ''swap_cryptocurrency: invalid fee amount %'', _source_fee_amount;
+      END IF;
+
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Get FTeX operations account IDs.
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      SELECT client_id INTO STRICT ftex_fees_id
+      FROM users
+      WHERE username = ''fee-revenue'';
+
+      -- Row lock both Crypto accounts in ticker order, without locking the foreign keys, to avoid deadlocks.
+      PERFORM ca.balance
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker IN (_source_ticker, _destination_ticker)
+      ORDER BY ca.ticker
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance INTO STRICT source_balance
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _source_ticker
+      LIMIT 1;
+
+      SELECT ca.balance INTO STRICT destination_balance
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _destination_ticker
+      LIMIT 1;
+
+      -- Check for sufficient source Cryptocurrency balance to complete swap.
+      IF _source_debit_amount > source_balance THEN
+         RAISE EXCEPTION ''swap_cryptocurrency: insufficient Cryptocurrency funds, delta %'', source_balance - _source_debit_amount;
+      END IF;
+
+      -- Debit the source Crypto account and create the Crypto Journal entries for outflow from client to FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(source_balance - _source_debit_amount, 8),
+          last_tx = - _source_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _source_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to update source Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _source_ticker, - _source_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to create Crypto Journal debit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _source_ticker, _source_debit_amount - _source_fee_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to create FTeX operations source Crypto Journal entry'';
+      END IF;
+
+      -- Create the fee revenue Crypto Journal entry.
+      IF _source_fee_amount > 0 THEN
+        INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+        VALUES (ftex_fees_id, _source_ticker, _source_fee_amount, current_timestamp, _transaction_id);
+
+        IF NOT FOUND THEN
+          RAISE EXCEPTION ''swap_cryptocurrency: failed to create FTeX fee revenue Crypto Journal entry'';
+        END IF;
+      END IF;
+
+      -- Credit the destination Crypto account and create the Crypto Journal entries for inflow to client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(destination_balance + _destination_credit_amount, 8),
+          last_tx = _destination_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _destination_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to update destination Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _destination_ticker, _destination_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to create Crypto Journal credit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _destination_ticker, - _destination_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to create FTeX operations destination Crypto Journal entry'';
+      END IF;
+
+      COMMIT;
+    END;
+';
+--rollback DROP PROCEDURE swap_cryptocurrency(UUID, UUID, VARCHAR, NUMERIC, VARCHAR, NUMERIC, NUMERIC);
+
+--changeset surahman:17
+--preconditions onFail:HALT onError:HALT
+--comment: Enum type for limit order statuses.
+CREATE TYPE order_status AS ENUM ('open', 'executing', 'filled', 'cancelled', 'failed');
+--rollback DROP TYPE order_status;
+
+--changeset surahman:18
+--preconditions onFail:HALT onError:HALT
+--comment: Limit orders that will be executed when the quoted exchange rate reaches the limit rate.
+CREATE TABLE IF NOT EXISTS orders (
+    order_id        UUID            PRIMARY KEY DEFAULT gen_random_uuid(),
+    client_id       UUID            REFERENCES users(client_id) ON DELETE CASCADE NOT NULL,
+    source          VARCHAR(6)      NOT NULL,
+    destination     VARCHAR(6)      NOT NULL,
+    amount          NUMERIC(24,8)   NOT NULL CHECK (amount > 0),
+    limit_rate      NUMERIC(32,16)  NOT NULL CHECK (limit_rate > 0),
+    status          ORDER_STATUS    DEFAULT 'open' NOT NULL,
+    fill_rate       NUMERIC(32,16)  DEFAULT 0 NOT NULL,
+    fill_amount     NUMERIC(24,8)   DEFAULT 0 NOT NULL,
+    fee             NUMERIC(24,8)   DEFAULT 0 NOT NULL,
+    tx_id           UUID,
+    created_at      TIMESTAMPTZ     DEFAULT now() NOT NULL,
+    updated_at      TIMESTAMPTZ     DEFAULT now() NOT NULL
+) TABLESPACE orders_data;
+
+CREATE INDEX IF NOT EXISTS orders_client_id_idx ON orders USING btree (client_id, created_at) TABLESPACE orders_data;
+CREATE INDEX IF NOT EXISTS orders_open_idx ON orders USING btree (created_at) TABLESPACE orders_data WHERE status = 'open';
+--rollback DROP TABLE orders CASCADE;
+
+--changeset surahman:19
+--preconditions onFail:HALT onError:HALT
+--comment: Enum type for recurring purchase schedule frequencies.
+CREATE TYPE schedule_frequency AS ENUM ('daily', 'weekly', 'monthly');
+--rollback DROP TYPE schedule_frequency;
+
+--changeset surahman:20
+--preconditions onFail:HALT onError:HALT
+--comment: Enum type for recurring purchase schedule run outcomes.
+CREATE TYPE schedule_run_status AS ENUM ('succeeded', 'failed');
+--rollback DROP TYPE schedule_run_status;
+
+--changeset surahman:21
+--preconditions onFail:HALT onError:HALT
+--comment: Recurring Cryptocurrency purchase schedules that will be executed when they come due.
+CREATE TABLE IF NOT EXISTS schedules (
+    schedule_id     UUID                PRIMARY KEY DEFAULT gen_random_uuid(),
+    client_id       UUID                REFERENCES users(client_id) ON DELETE CASCADE NOT NULL,
+    fiat_currency   CURRENCY            NOT NULL,
+    ticker          VARCHAR(6)          NOT NULL,
+    amount          NUMERIC(18,2)       NOT NULL CHECK (amount > 0),
+    frequency       SCHEDULE_FREQUENCY  NOT NULL,
+    next_run_at     TIMESTAMPTZ         NOT NULL,
+    is_active       BOOLEAN             DEFAULT true NOT NULL,
+    created_at      TIMESTAMPTZ         DEFAULT now() NOT NULL,
+    updated_at      TIMESTAMPTZ         DEFAULT now() NOT NULL
+) TABLESPACE schedules_data;
+
+CREATE INDEX IF NOT EXISTS schedules_client_id_idx ON schedules USING btree (client_id, created_at) TABLESPACE schedules_data;
+CREATE INDEX IF NOT EXISTS schedules_due_idx ON schedules USING btree (next_run_at) TABLESPACE schedules_data WHERE is_active;
+--rollback DROP TABLE schedules CASCADE;
+
+--changeset surahman:22
+--preconditions onFail:HALT onError:HALT
+--comment: The outcome of each execution of a recurring Cryptocurrency purchase schedule.
+CREATE TABLE IF NOT EXISTS schedule_runs (
+    run_id          UUID                PRIMARY KEY DEFAULT gen_random_uuid(),
+    schedule_id     UUID                REFERENCES schedules(schedule_id) ON DELETE CASCADE NOT NULL,
+    client_id       UUID                REFERENCES users(client_id) ON DELETE CASCADE NOT NULL,
+    status          SCHEDULE_RUN_STATUS NOT NULL,
+    rate            NUMERIC(32,16)      DEFAULT 0 NOT NULL,
+    fiat_amount     NUMERIC(18,2)       DEFAULT 0 NOT NULL,
+    crypto_amount   NUMERIC(24,8)       DEFAULT 0 NOT NULL,
+    fee             NUMERIC(18,2)       DEFAULT 0 NOT NULL,
+    tx_id           UUID,
+    message         TEXT                DEFAULT '' NOT NULL,
+    executed_at     TIMESTAMPTZ         DEFAULT now() NOT NULL
+) TABLESPACE schedules_data;
+
+CREATE INDEX IF NOT EXISTS schedule_runs_client_id_idx ON schedule_runs USING btree (client_id, schedule_id, executed_at) TABLESPACE schedules_data;
+--rollback DROP TABLE schedule_runs CASCADE;
+
+--changeset surahman:23
+--preconditions onFail:HALT onError:HALT
+--comment: Every currency price quote fetched from the quote providers, for rate history and trade audits.
+CREATE TABLE IF NOT EXISTS rates (
+    rate_id         UUID                PRIMARY KEY DEFAULT gen_random_uuid(),
+    source          VARCHAR(6)          NOT NULL,
+    destination     VARCHAR(6)          NOT NULL,
+    rate            NUMERIC(32,16)      NOT NULL CHECK (rate > 0),
+    provider        VARCHAR(32)         NOT NULL,
+    quoted_at       TIMESTAMPTZ         NOT NULL,
+    fetched_at      TIMESTAMPTZ         DEFAULT now() NOT NULL
+) TABLESPACE rates_data;
+
+CREATE INDEX IF NOT EXISTS rates_pair_idx ON rates USING btree (source, destination, quoted_at) TABLESPACE rates_data;
+--rollback DROP TABLE rates CASCADE;
+
+--changeset surahman:24
+--preconditions onFail:HALT onError:HALT
+--comment: The exchange rate and price quote offer that priced each executed currency trade.
+CREATE TABLE IF NOT EXISTS trades (
+    tx_id           UUID                PRIMARY KEY,
+    client_id       UUID                REFERENCES users(client_id) ON DELETE CASCADE NOT NULL,
+    offer_id        VARCHAR(32),
+    source          VARCHAR(6)          NOT NULL,
+    destination     VARCHAR(6)          NOT NULL,
+    rate            NUMERIC(32,16)      NOT NULL CHECK (rate > 0),
+    traded_at       TIMESTAMPTZ         DEFAULT now() NOT NULL
+) TABLESPACE trades_data;
+
+CREATE INDEX IF NOT EXISTS trades_client_id_idx ON trades USING btree (client_id, traded_at) TABLESPACE trades_data;
+--rollback DROP TABLE trades CASCADE;
+
+--changeset surahman:25
+--preconditions onFail:HALT onError:HALT
+--comment: Purchase a Cryptocurrency using a base Fiat currency, collect a fee in the Fiat currency, and record the trade.
+CREATE OR REPLACE PROCEDURE purchase_cryptocurrency(
+    _transaction_id         UUID,
+    _client_id              UUID,
+    _fiat_currency          Currency,
+    _fiat_debit_amount      NUMERIC(20, 2),
+    _crypto_ticker          VARCHAR(6),
+    _crypto_credit_amount   NUMERIC(24,8),
+    _fiat_fee_amount        NUMERIC(20, 2),
+    _rate                   NUMERIC(32,16),
+    _offer_id               VARCHAR(32)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+      crypto_balance      NUMERIC(24,8);  -- current balance of the Crypto account.
+      current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+      ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+      ftex_fees_id        UUID;           -- FTeX fee revenue operations account id.
+    BEGIN
+
+      -- The fee is collected from the Fiat debit amount.
+      IF _fiat_fee_amount < 0 OR _fiat_fee_amount > _fiat_debit_amount THEN
+         RAISE EXCEPTION ''purchase_cryptocurrency: invalid fee amount %'', _fiat_fee_amount;
+      END IF;
+
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Get FTeX operations account IDs.
+      SELECT client_id INTO STRICT ftex_fiat_id
+      FROM users
+      WHERE username = ''fiat-currencies'';
+
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      SELECT client_id INTO STRICT ftex_fees_id
+      FROM users
+      WHERE username = ''fee-revenue'';
+
+      -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+      SELECT fa.balance INTO STRICT fiat_balance
+      FROM fiat_accounts AS fa
+      WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance INTO STRICT crypto_balance
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      -- Check for sufficient Fiat balance to complete purchase.
+      IF _fiat_debit_amount > fiat_balance THEN
+         RAISE EXCEPTION ''purchase_cryptocurrency: insufficient Fiat currency funds, delta %'', fiat_balance - _fiat_debit_amount;
+      END IF;
+
+      -- Debit the Fiat account and create the Fiat Journal entries for outflow from client to FTeX.
+      UPDATE fiat_accounts
+      SET balance = round_half_even(fiat_balance - _fiat_debit_amount, 2),
+          last_tx = - _fiat_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND currency = _fiat_currency;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Fiat balance'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (_client_id, _fiat_currency, - _fiat_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Fiat Journal debit entry'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (ftex_fiat_id, _fiat_currency, _fiat_debit_amount - _fiat_fee_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+      END IF;
+
+      -- Create the fee revenue Fiat Journal entry.
+      IF _fiat_fee_amount > 0 THEN
+        INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+        VALUES (ftex_fees_id, _fiat_currency, _fiat_fee_amount, current_timestamp, _transaction_id);
+
+        IF NOT FOUND THEN
+          RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX fee revenue Fiat Journal entry'';
+        END IF;
+      END IF;
+
+      -- Credit the Crypto account and create the Crypto Journal entries for inflow to client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(crypto_balance + _crypto_credit_amount, 8),
+          last_tx = _crypto_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _crypto_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _crypto_ticker, _crypto_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Crypto Journal credit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _crypto_ticker, - _crypto_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+      END IF;
+
+      -- Record the exchange rate and price quote offer that priced the trade.
+      INSERT INTO trades (tx_id, client_id, offer_id, source, destination, rate, traded_at)
+      VALUES (_transaction_id, _client_id, NULLIF(_offer_id, ''''), _fiat_currency::VARCHAR, _crypto_ticker, _rate, current_timestamp);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create trade entry'';
+      END IF;
+
+      COMMIT;
+    END;
+';
+--rollback DROP PROCEDURE purchase_cryptocurrency(UUID, UUID, Currency, NUMERIC, VARCHAR, NUMERIC, NUMERIC, NUMERIC, VARCHAR);
+
+--changeset surahman:26
+--preconditions onFail:HALT onError:HALT
+--comment: Sell a Cryptocurrency, purchase a Fiat currency, collect a fee in the Cryptocurrency, and record the trade.
+CREATE OR REPLACE PROCEDURE sell_cryptocurrency(
+    _transaction_id         UUID,
+    _client_id              UUID,
+    _fiat_currency          Currency,
+    _fiat_credit_amount     NUMERIC(20, 2),
+    _crypto_ticker          VARCHAR(6),
+    _crypto_debit_amount    NUMERIC(24,8),
+    _crypto_fee_amount      NUMERIC(24,8),
+    _rate                   NUMERIC(32,16),
+    _offer_id               VARCHAR(32)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+      crypto_balance      NUMERIC(24,8);  -- current balance of the Crypto account.
+      current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+      ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+      ftex_fees_id        UUID;           -- FTeX fee revenue operations account id.
+    BEGIN
+      -- The fee is collected from the Cryptocurrency debit amount.
+      IF _crypto_fee_amount < 0 OR _crypto_fee_amount > _crypto_debit_amount THEN
+         RAISE EXCEPTION ''sell_cryptocurrency: invalid fee amount %'', _crypto_fee_amount;
+      END IF;
+
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Get FTeX operations account IDs.
+      SELECT client_id INTO STRICT ftex_fiat_id
+      FROM users
+      WHERE username = ''fiat-currencies'';
+
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      SELECT client_id INTO STRICT ftex_fees_id
+      FROM users
+      WHERE username = ''fee-revenue'';
+
+      -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+      SELECT fa.balance INTO STRICT fiat_balance
+      FROM fiat_accounts AS fa
+      WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance INTO STRICT crypto_balance
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      -- Check for sufficient Cryptocurrency balance to complete sale.
+      IF _crypto_debit_amount > crypto_balance THEN
+         RAISE EXCEPTION ''sell_cryptocurrency: insufficient Cryptocurrency funds, delta %'', crypto_balance - _crypto_debit_amount;
+      END IF;
+
+      -- Debit the Crypto account and create the Crypto Journal entries for outflow from client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(crypto_balance - _crypto_debit_amount, 8),
+          last_tx = - _crypto_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _crypto_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to update Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _crypto_ticker, - _crypto_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create Crypto Journal debit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _crypto_ticker, _crypto_debit_amount - _crypto_fee_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+      END IF;
+
+      -- Create the fee revenue Crypto Journal entry.
+      IF _crypto_fee_amount > 0 THEN
+        INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+        VALUES (ftex_fees_id, _crypto_ticker, _crypto_fee_amount, current_timestamp, _transaction_id);
+
+        IF NOT FOUND THEN
+          RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX fee revenue Crypto Journal entry'';
+        END IF;
+      END IF;
+
+      -- Credit the Fiat account and create the Fiat Journal entries for inflow to the client from FTeX.
+      UPDATE fiat_accounts
+      SET balance = round_half_even(fiat_balance + _fiat_credit_amount, 2),
+          last_tx = _fiat_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND currency = _fiat_currency;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to update Fiat balance'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (_client_id, _fiat_currency, _fiat_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create Fiat Journal credit entry'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (ftex_fiat_id, _fiat_currency, - _fiat_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+      END IF;
+
+      -- Record the exchange rate and price quote offer that priced the trade.
+      INSERT INTO trades (tx_id, client_id, offer_id, source, destination, rate, traded_at)
+      VALUES (_transaction_id, _client_id, NULLIF(_offer_id, ''''), _crypto_ticker, _fiat_currency::VARCHAR, _rate, current_timestamp);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create trade entry'';
+      END IF;
+
+      COMMIT;
+    END;
+';
+--rollback DROP PROCEDURE sell_cryptocurrency(UUID, UUID, Currency, NUMERIC, VARCHAR, NUMERIC, NUMERIC, NUMERIC, VARCHAR);
+
+--changeset surahman:27
+--preconditions onFail:HALT onError:HALT
+--comment: Time-based One-Time Password multifactor authentication enrollments and hashed recovery codes for users.
+CREATE TABLE IF NOT EXISTS mfa_enrollments (
+    client_id       UUID                PRIMARY KEY REFERENCES users(client_id) ON DELETE CASCADE,
+    secret          VARCHAR(128)        NOT NULL,
+    recovery_codes  VARCHAR(64)[]       DEFAULT '{}' NOT NULL,
+    last_step       BIGINT              DEFAULT 0 NOT NULL,
+    is_enabled      BOOLEAN             DEFAULT false NOT NULL,
+    created_at      TIMESTAMPTZ         DEFAULT now() NOT NULL
+) TABLESPACE mfa_enrollments_data;
+--rollback DROP TABLE mfa_enrollments CASCADE;
+
+--changeset surahman:28
+--preconditions onFail:HALT onError:HALT
+--comment: Hashed API keys with scopes that programmatic clients can authenticate with in place of a JWT.
+CREATE TABLE IF NOT EXISTS api_keys (
+    key_id          UUID                PRIMARY KEY DEFAULT gen_random_uuid(),
+    client_id       UUID                REFERENCES users(client_id) ON DELETE CASCADE NOT NULL,
+    name            VARCHAR(64)         NOT NULL,
+    key_hash        VARCHAR(64)         UNIQUE NOT NULL,
+    scopes          VARCHAR(32)[]       NOT NULL,
+    created_at      TIMESTAMPTZ         DEFAULT now() NOT NULL,
+    revoked_at      TIMESTAMPTZ
+) TABLESPACE api_keys_data;
+
+CREATE INDEX IF NOT EXISTS api_keys_client_id_idx ON api_keys USING btree (client_id, created_at);
+--rollback DROP TABLE api_keys CASCADE;
+
+--changeset surahman:29
+--preconditions onFail:HALT onError:HALT
+--comment: Enum type for user roles.
+CREATE TYPE user_role AS ENUM ('user', 'admin');
+--rollback DROP TYPE user_role;
+
+--changeset surahman:30
+--preconditions onFail:HALT onError:HALT
+--comment: User roles for the administrative endpoints and frozen accounts that cannot move funds.
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS role       USER_ROLE   DEFAULT 'user' NOT NULL,
+    ADD COLUMN IF NOT EXISTS is_frozen  BOOLEAN     DEFAULT false NOT NULL;
+--rollback ALTER TABLE users DROP COLUMN role, DROP COLUMN is_frozen;
+
+--changeset surahman:31
+--preconditions onFail:HALT onError:HALT
+--comment: Fiat deposits that have been reversed by an administrator with compensating journal entries.
+CREATE TABLE IF NOT EXISTS fiat_reversals (
+    tx_id           UUID            PRIMARY KEY,
+    client_id       UUID            REFERENCES users(client_id) ON DELETE CASCADE NOT NULL,
+    currency        CURRENCY        NOT NULL,
+    amount          NUMERIC(18,2)   NOT NULL,
+    reversal_tx_id  UUID            UNIQUE NOT NULL,
+    reversed_by     UUID            REFERENCES users(client_id) NOT NULL,
+    reversed_at     TIMESTAMPTZ     NOT NULL
+) TABLESPACE fiat_reversals_data;
+--rollback DROP TABLE fiat_reversals CASCADE;
+
+--changeset surahman:32
+--preconditions onFail:HALT onError:HALT
+--comment: Enum type for the status of Fiat and Crypto accounts.
+CREATE TYPE account_status AS ENUM ('active', 'frozen', 'closed');
+--rollback DROP TYPE account_status;
+
+--changeset surahman:33
+--preconditions onFail:HALT onError:HALT
+--comment: Fiat account status and compliance holds that reserve part of the balance.
+ALTER TABLE fiat_accounts
+    ADD COLUMN IF NOT EXISTS status     ACCOUNT_STATUS  DEFAULT 'active' NOT NULL,
+    ADD COLUMN IF NOT EXISTS held       NUMERIC(18,2)   DEFAULT 0 NOT NULL CHECK (held >= 0),
+    ADD COLUMN IF NOT EXISTS available  NUMERIC(18,2)   GENERATED ALWAYS AS (balance - held) STORED;
+--rollback ALTER TABLE fiat_accounts DROP COLUMN available, DROP COLUMN held, DROP COLUMN status;
+
+--changeset surahman:34
+--preconditions onFail:HALT onError:HALT
+--comment: Crypto account status and compliance holds that reserve part of the balance.
+ALTER TABLE crypto_accounts
+    ADD COLUMN IF NOT EXISTS status     ACCOUNT_STATUS  DEFAULT 'active' NOT NULL,
+    ADD COLUMN IF NOT EXISTS held       NUMERIC(24,8)   DEFAULT 0 NOT NULL CHECK (held >= 0),
+    ADD COLUMN IF NOT EXISTS available  NUMERIC(24,8)   GENERATED ALWAYS AS (balance - held) STORED;
+--rollback ALTER TABLE crypto_accounts DROP COLUMN available, DROP COLUMN held, DROP COLUMN status;
+
+--changeset surahman:35
+--preconditions onFail:HALT onError:HALT
+--comment: Purchase a Cryptocurrency from active accounts without debiting Fiat funds that are on hold.
+CREATE OR REPLACE PROCEDURE purchase_cryptocurrency(
+    _transaction_id         UUID,
+    _client_id              UUID,
+    _fiat_currency          Currency,
+    _fiat_debit_amount      NUMERIC(20, 2),
+    _crypto_ticker          VARCHAR(6),
+    _crypto_credit_amount   NUMERIC(24,8),
+    _fiat_fee_amount        NUMERIC(20, 2),
+    _rate                   NUMERIC(32,16),
+    _offer_id               VARCHAR(32)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+      crypto_balance      NUMERIC(24,8);  -- current balance of the Crypto account.
+      fiat_held           NUMERIC(20,2);  -- amount of the Fiat account balance that is on hold.
+      fiat_status         ACCOUNT_STATUS; -- status of the Fiat account.
+      crypto_status       ACCOUNT_STATUS; -- status of the Crypto account.
+      current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+      ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+      ftex_fees_id        UUID;           -- FTeX fee revenue operations account id.
+    BEGIN
+
+      -- The fee is collected from the Fiat debit amount.
+      IF _fiat_fee_amount < 0 OR _fiat_fee_amount > _fiat_debit_amount THEN
+         RAISE EXCEPTION ''purchase_cryptocurrency: invalid fee amount %'', _fiat_fee_amount;
+      END IF;
+
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Get FTeX operations account IDs.
+      SELECT client_id INTO STRICT ftex_fiat_id
+      FROM users
+      WHERE username = ''fiat-currencies'';
+
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      SELECT client_id INTO STRICT ftex_fees_id
+      FROM users
+      WHERE username = ''fee-revenue'';
+
+      -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+      SELECT fa.balance, fa.held, fa.status INTO STRICT fiat_balance, fiat_held, fiat_status
+      FROM fiat_accounts AS fa
+      WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance, ca.status INTO STRICT crypto_balance, crypto_status
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      -- Check that both accounts are active.
+      IF fiat_status <> ''active'' OR crypto_status <> ''active'' THEN
+         RAISE EXCEPTION ''purchase_cryptocurrency: accounts must be active, Fiat %, Crypto %'', fiat_status, crypto_status;
+      END IF;
+
+      -- Check for sufficient available Fiat balance, excluding holds, to complete purchase.
+      IF _fiat_debit_amount > fiat_balance - fiat_held THEN
+         RAISE EXCEPTION ''purchase_cryptocurrency: insufficient Fiat currency funds, delta %'', fiat_balance - fiat_held - _fiat_debit_amount;
+      END IF;
+
+      -- Debit the Fiat account and create the Fiat Journal entries for outflow from client to FTeX.
+      UPDATE fiat_accounts
+      SET balance = round_half_even(fiat_balance - _fiat_debit_amount, 2),
+          last_tx = - _fiat_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND currency = _fiat_currency;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Fiat balance'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (_client_id, _fiat_currency, - _fiat_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Fiat Journal debit entry'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (ftex_fiat_id, _fiat_currency, _fiat_debit_amount - _fiat_fee_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+      END IF;
+
+      -- Create the fee revenue Fiat Journal entry.
+      IF _fiat_fee_amount > 0 THEN
+        INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+        VALUES (ftex_fees_id, _fiat_currency, _fiat_fee_amount, current_timestamp, _transaction_id);
+
+        IF NOT FOUND THEN
+          RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX fee revenue Fiat Journal entry'';
+        END IF;
+      END IF;
+
+      -- Credit the Crypto account and create the Crypto Journal entries for inflow to client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(crypto_balance + _crypto_credit_amount, 8),
+          last_tx = _crypto_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _crypto_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _crypto_ticker, _crypto_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Crypto Journal credit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _crypto_ticker, - _crypto_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+      END IF;
+
+      -- Record the exchange rate and price quote offer that priced the trade.
+      INSERT INTO trades (tx_id, client_id, offer_id, source, destination, rate, traded_at)
+      VALUES (_transaction_id, _client_id, NULLIF(_offer_id, ''''), _fiat_currency::VARCHAR, _crypto_ticker, _rate, current_timestamp);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create trade entry'';
+      END IF;
+
+      COMMIT;
+    END;
+';
+--rollback CREATE OR REPLACE PROCEDURE purchase_cryptocurrency(
+--rollback     _transaction_id         UUID,
+--rollback     _client_id              UUID,
+--rollback     _fiat_currency          Currency,
+--rollback     _fiat_debit_amount      NUMERIC(20, 2),
+--rollback     _crypto_ticker          VARCHAR(6),
+--rollback     _crypto_credit_amount   NUMERIC(24,8),
+--rollback     _fiat_fee_amount        NUMERIC(20, 2),
+--rollback     _rate                   NUMERIC(32,16),
+--rollback     _offer_id               VARCHAR(32)
+--rollback )
+--rollback LANGUAGE plpgsql
+--rollback AS '
+--rollback     DECLARE
+--rollback       fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+--rollback       crypto_balance      NUMERIC(24,8);  -- current balance of the Crypto account.
+--rollback       current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+--rollback       ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+--rollback       ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+--rollback       ftex_fees_id        UUID;           -- FTeX fee revenue operations account id.
+--rollback     BEGIN
+--rollback
+--rollback       -- The fee is collected from the Fiat debit amount.
+--rollback       IF _fiat_fee_amount < 0 OR _fiat_fee_amount > _fiat_debit_amount THEN
+--rollback          RAISE EXCEPTION ''purchase_cryptocurrency: invalid fee amount %'', _fiat_fee_amount;
+--rollback       END IF;
+--rollback
+--rollback       -- Generate the timestamp with timezone for this transaction.
+--rollback       SELECT NOW() INTO STRICT current_timestamp;
+--rollback
+--rollback       -- Get FTeX operations account IDs.
+--rollback       SELECT client_id INTO STRICT ftex_fiat_id
+--rollback       FROM users
+--rollback       WHERE username = ''fiat-currencies'';
+--rollback
+--rollback       SELECT client_id INTO STRICT ftex_crypto_id
+--rollback       FROM users
+--rollback       WHERE username = ''crypto-currencies'';
+--rollback
+--rollback       SELECT client_id INTO STRICT ftex_fees_id
+--rollback       FROM users
+--rollback       WHERE username = ''fee-revenue'';
+--rollback
+--rollback       -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+--rollback       SELECT fa.balance INTO STRICT fiat_balance
+--rollback       FROM fiat_accounts AS fa
+--rollback       WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+--rollback       LIMIT 1
+--rollback       FOR NO KEY UPDATE;
+--rollback
+--rollback       SELECT ca.balance INTO STRICT crypto_balance
+--rollback       FROM crypto_accounts AS ca
+--rollback       WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+--rollback       LIMIT 1
+--rollback       FOR NO KEY UPDATE;
+--rollback
+--rollback       -- Check for sufficient Fiat balance to complete purchase.
+--rollback       IF _fiat_debit_amount > fiat_balance THEN
+--rollback          RAISE EXCEPTION ''purchase_cryptocurrency: insufficient Fiat currency funds, delta %'', fiat_balance - _fiat_debit_amount;
+--rollback       END IF;
+--rollback
+--rollback       -- Debit the Fiat account and create the Fiat Journal entries for outflow from client to FTeX.
+--rollback       UPDATE fiat_accounts
+--rollback       SET balance = round_half_even(fiat_balance - _fiat_debit_amount, 2),
+--rollback           last_tx = - _fiat_debit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND currency = _fiat_currency;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Fiat balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _fiat_currency, - _fiat_debit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Fiat Journal debit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_fiat_id, _fiat_currency, _fiat_debit_amount - _fiat_fee_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       -- Create the fee revenue Fiat Journal entry.
+--rollback       IF _fiat_fee_amount > 0 THEN
+--rollback         INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+--rollback         VALUES (ftex_fees_id, _fiat_currency, _fiat_fee_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback         IF NOT FOUND THEN
+--rollback           RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX fee revenue Fiat Journal entry'';
+--rollback         END IF;
+--rollback       END IF;
+--rollback
+--rollback       -- Credit the Crypto account and create the Crypto Journal entries for inflow to client from FTeX.
+--rollback       UPDATE crypto_accounts
+--rollback       SET balance = round_half_even(crypto_balance + _crypto_credit_amount, 8),
+--rollback           last_tx = _crypto_credit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND ticker = _crypto_ticker;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Crypto balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _crypto_ticker, _crypto_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Crypto Journal credit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_crypto_id, _crypto_ticker, - _crypto_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       -- Record the exchange rate and price quote offer that priced the trade.
+--rollback       INSERT INTO trades (tx_id, client_id, offer_id, source, destination, rate, traded_at)
+--rollback       VALUES (_transaction_id, _client_id, NULLIF(_offer_id, ''''), _fiat_currency::VARCHAR, _crypto_ticker, _rate, current_timestamp);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to create trade entry'';
+--rollback       END IF;
+--rollback
+--rollback       COMMIT;
+--rollback     END;
+--rollback ';
+
+--changeset surahman:36
+--preconditions onFail:HALT onError:HALT
+--comment: Sell a Cryptocurrency from active accounts without debiting Crypto funds that are on hold.
+CREATE OR REPLACE PROCEDURE sell_cryptocurrency(
+    _transaction_id         UUID,
+    _client_id              UUID,
+    _fiat_currency          Currency,
+    _fiat_credit_amount     NUMERIC(20, 2),
+    _crypto_ticker          VARCHAR(6),
+    _crypto_debit_amount    NUMERIC(24,8),
+    _crypto_fee_amount      NUMERIC(24,8),
+    _rate                   NUMERIC(32,16),
+    _offer_id               VARCHAR(32)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+      crypto_balance      NUMERIC(24,8);  -- current balance of the Crypto account.
+      crypto_held         NUMERIC(24,8);  -- amount of the Crypto account balance that is on hold.
+      fiat_status         ACCOUNT_STATUS; -- status of the Fiat account.
+      crypto_status       ACCOUNT_STATUS; -- status of the Crypto account.
+      current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+      ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+      ftex_fees_id        UUID;           -- FTeX fee revenue operations account id.
+    BEGIN
+      -- The fee is collected from the Cryptocurrency debit amount.
+      IF _crypto_fee_amount < 0 OR _crypto_fee_amount > _crypto_debit_amount THEN
+         RAISE EXCEPTION ''sell_cryptocurrency: invalid fee amount %'', _crypto_fee_amount;
+      END IF;
+
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Get FTeX operations account IDs.
+      SELECT client_id INTO STRICT ftex_fiat_id
+      FROM users
+      WHERE username = ''fiat-currencies'';
+
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      SELECT client_id INTO STRICT ftex_fees_id
+      FROM users
+      WHERE username = ''fee-revenue'';
+
+      -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+      SELECT fa.balance, fa.status INTO STRICT fiat_balance, fiat_status
+      FROM fiat_accounts AS fa
+      WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance, ca.held, ca.status INTO STRICT crypto_balance, crypto_held, crypto_status
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      -- Check that both accounts are active.
+      IF fiat_status <> ''active'' OR crypto_status <> ''active'' THEN
+         RAISE EXCEPTION ''sell_cryptocurrency: accounts must be active, Fiat %, Crypto %'', fiat_status, crypto_status;
+      END IF;
+
+      -- Check for sufficient available Cryptocurrency balance, excluding holds, to complete sale.
+      IF _crypto_debit_amount > crypto_balance - crypto_held THEN
+         RAISE EXCEPTION ''sell_cryptocurrency: insufficient Cryptocurrency funds, delta %'', crypto_balance - crypto_held - _crypto_debit_amount;
+      END IF;
+
+      -- Debit the Crypto account and create the Crypto Journal entries for outflow from client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(crypto_balance - _crypto_debit_amount, 8),
+          last_tx = - _crypto_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _crypto_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to update Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _crypto_ticker, - _crypto_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create Crypto Journal debit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _crypto_ticker, _crypto_debit_amount - _crypto_fee_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+      END IF;
+
+      -- Create the fee revenue Crypto Journal entry.
+      IF _crypto_fee_amount > 0 THEN
+        INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+        VALUES (ftex_fees_id, _crypto_ticker, _crypto_fee_amount, current_timestamp, _transaction_id);
+
+        IF NOT FOUND THEN
+          RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX fee revenue Crypto Journal entry'';
+        END IF;
+      END IF;
+
+      -- Credit the Fiat account and create the Fiat Journal entries for inflow to the client from FTeX.
+      UPDATE fiat_accounts
+      SET balance = round_half_even(fiat_balance + _fiat_credit_amount, 2),
+          last_tx = _fiat_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND currency = _fiat_currency;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to update Fiat balance'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (_client_id, _fiat_currency, _fiat_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create Fiat Journal credit entry'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (ftex_fiat_id, _fiat_currency, - _fiat_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+      END IF;
+
+      -- Record the exchange rate and price quote offer that priced the trade.
+      INSERT INTO trades (tx_id, client_id, offer_id, source, destination, rate, traded_at)
+      VALUES (_transaction_id, _client_id, NULLIF(_offer_id, ''''), _crypto_ticker, _fiat_currency::VARCHAR, _rate, current_timestamp);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create trade entry'';
+      END IF;
+
+      COMMIT;
+    END;
+';
+--rollback CREATE OR REPLACE PROCEDURE sell_cryptocurrency(
+--rollback     _transaction_id         UUID,
+--rollback     _client_id              UUID,
+--rollback     _fiat_currency          Currency,
+--rollback     _fiat_credit_amount     NUMERIC(20, 2),
+--rollback     _crypto_ticker          VARCHAR(6),
+--rollback     _crypto_debit_amount    NUMERIC(24,8),
+--rollback     _crypto_fee_amount      NUMERIC(24,8),
+--rollback     _rate                   NUMERIC(32,16),
+--rollback     _offer_id               VARCHAR(32)
+--rollback )
+--rollback LANGUAGE plpgsql
+--rollback AS '
+--rollback     DECLARE
+--rollback       fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+--rollback       crypto_balance      NUMERIC(24,8);  -- current balance of the Crypto account.
+--rollback       current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+--rollback       ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+--rollback       ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+--rollback       ftex_fees_id        UUID;           -- FTeX fee revenue operations account id.
+--rollback     BEGIN
+--rollback       -- The fee is collected from the Cryptocurrency debit amount.
+--rollback       IF _crypto_fee_amount < 0 OR _crypto_fee_amount > _crypto_debit_amount THEN
+--rollback          RAISE EXCEPTION ''sell_cryptocurrency: invalid fee amount %'', _crypto_fee_amount;
+--rollback       END IF;
+--rollback
+--rollback       -- Generate the timestamp with timezone for this transaction.
+--rollback       SELECT NOW() INTO STRICT current_timestamp;
+--rollback
+--rollback       -- Get FTeX operations account IDs.
+--rollback       SELECT client_id INTO STRICT ftex_fiat_id
+--rollback       FROM users
+--rollback       WHERE username = ''fiat-currencies'';
+--rollback
+--rollback       SELECT client_id INTO STRICT ftex_crypto_id
+--rollback       FROM users
+--rollback       WHERE username = ''crypto-currencies'';
+--rollback
+--rollback       SELECT client_id INTO STRICT ftex_fees_id
+--rollback       FROM users
+--rollback       WHERE username = ''fee-revenue'';
+--rollback
+--rollback       -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+--rollback       SELECT fa.balance INTO STRICT fiat_balance
+--rollback       FROM fiat_accounts AS fa
+--rollback       WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+--rollback       LIMIT 1
+--rollback       FOR NO KEY UPDATE;
+--rollback
+--rollback       SELECT ca.balance INTO STRICT crypto_balance
+--rollback       FROM crypto_accounts AS ca
+--rollback       WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+--rollback       LIMIT 1
+--rollback       FOR NO KEY UPDATE;
+--rollback
+--rollback       -- Check for sufficient Cryptocurrency balance to complete sale.
+--rollback       IF _crypto_debit_amount > crypto_balance THEN
+--rollback          RAISE EXCEPTION ''sell_cryptocurrency: insufficient Cryptocurrency funds, delta %'', crypto_balance - _crypto_debit_amount;
+--rollback       END IF;
+--rollback
+--rollback       -- Debit the Crypto account and create the Crypto Journal entries for outflow from client from FTeX.
+--rollback       UPDATE crypto_accounts
+--rollback       SET balance = round_half_even(crypto_balance - _crypto_debit_amount, 8),
+--rollback           last_tx = - _crypto_debit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND ticker = _crypto_ticker;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to update Crypto balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _crypto_ticker, - _crypto_debit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to create Crypto Journal debit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_crypto_id, _crypto_ticker, _crypto_debit_amount - _crypto_fee_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       -- Create the fee revenue Crypto Journal entry.
+--rollback       IF _crypto_fee_amount > 0 THEN
+--rollback         INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback         VALUES (ftex_fees_id, _crypto_ticker, _crypto_fee_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback         IF NOT FOUND THEN
+--rollback           RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX fee revenue Crypto Journal entry'';
+--rollback         END IF;
+--rollback       END IF;
+--rollback
+--rollback       -- Credit the Fiat account and create the Fiat Journal entries for inflow to the client from FTeX.
+--rollback       UPDATE fiat_accounts
+--rollback       SET balance = round_half_even(fiat_balance + _fiat_credit_amount, 2),
+--rollback           last_tx = _fiat_credit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND currency = _fiat_currency;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to update Fiat balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _fiat_currency, _fiat_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to create Fiat Journal credit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_fiat_id, _fiat_currency, - _fiat_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       -- Record the exchange rate and price quote offer that priced the trade.
+--rollback       INSERT INTO trades (tx_id, client_id, offer_id, source, destination, rate, traded_at)
+--rollback       VALUES (_transaction_id, _client_id, NULLIF(_offer_id, ''''), _crypto_ticker, _fiat_currency::VARCHAR, _rate, current_timestamp);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to create trade entry'';
+--rollback       END IF;
+--rollback
+--rollback       COMMIT;
+--rollback     END;
+--rollback ';
+
+--changeset surahman:37
+--preconditions onFail:HALT onError:HALT
+--comment: Swap Cryptocurrencies between active accounts without debiting source funds that are on hold.
+CREATE OR REPLACE PROCEDURE swap_cryptocurrency(
+    _transaction_id             UUID,
+    _client_id                  UUID,
+    _source_ticker              VARCHAR(6),
+    _source_debit_amount        NUMERIC(24,8),
+    _destination_ticker         VARCHAR(6),
+    _destination_credit_amount  NUMERIC(24,8),
+    _source_fee_amount          NUMERIC(24,8)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      source_balance        NUMERIC(24,8);  -- current balance of the source Crypto account.
+      destination_balance   NUMERIC(24,8);  -- current balance of the destination Crypto account.
+      source_held           NUMERIC(24,8);  -- amount of the source Crypto account balance that is on hold.
+      source_status         ACCOUNT_STATUS; -- status of the source Crypto account.
+      destination_status    ACCOUNT_STATUS; -- status of the destination Crypto account.
+      current_timestamp     TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_crypto_id        UUID;           -- FTeX Crypto operations account id.
+      ftex_fees_id          UUID;           -- FTeX fee revenue operations account id.
+    BEGIN
+      -- Source and destination Cryptocurrencies must differ.
+      IF _source_ticker = _destination_ticker THEN
+         RAISE EXCEPTION ''swap_cryptocurrency: source and destination Cryptocurrencies must differ'';
+      END IF;
+
+      -- The fee is collected from the source Cryptocurrency debit amount.
+      IF _source_fee_amount < 0 OR _source_fee_amount > _source_debit_amount THEN
+         RAISE EXCEPTION ''swap_cryptocurrency: invalid fee amount %'', _source_fee_amount;
+      END IF;
+
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Get FTeX operations account IDs.
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      SELECT client_id INTO STRICT ftex_fees_id
+      FROM users
+      WHERE username = ''fee-revenue'';
+
+      -- Row lock both Crypto accounts in ticker order, without locking the foreign keys, to avoid deadlocks.
+      PERFORM ca.balance
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker IN (_source_ticker, _destination_ticker)
+      ORDER BY ca.ticker
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance, ca.held, ca.status INTO STRICT source_balance, source_held, source_status
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _source_ticker
+      LIMIT 1;
+
+      SELECT ca.balance, ca.status INTO STRICT destination_balance, destination_status
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _destination_ticker
+      LIMIT 1;
+
+      -- Check that both accounts are active.
+      IF source_status <> ''active'' OR destination_status <> ''active'' THEN
+         RAISE EXCEPTION ''swap_cryptocurrency: accounts must be active, source %, destination %'', source_status, destination_status;
+      END IF;
+
+      -- Check for sufficient available source Cryptocurrency balance, excluding holds, to complete swap.
+      IF _source_debit_amount > source_balance - source_held THEN
+         RAISE EXCEPTION ''swap_cryptocurrency: insufficient Cryptocurrency funds, delta %'', source_balance - source_held - _source_debit_amount;
+      END IF;
+
+      -- Debit the source Crypto account and create the Crypto Journal entries for outflow from client to FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(source_balance - _source_debit_amount, 8),
+          last_tx = - _source_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _source_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to update source Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _source_ticker, - _source_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to create Crypto Journal debit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _source_ticker, _source_debit_amount - _source_fee_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to create FTeX operations source Crypto Journal entry'';
+      END IF;
+
+      -- Create the fee revenue Crypto Journal entry.
+      IF _source_fee_amount > 0 THEN
+        INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+        VALUES (ftex_fees_id, _source_ticker, _source_fee_amount, current_timestamp, _transaction_id);
+
+        IF NOT FOUND THEN
+          RAISE EXCEPTION ''swap_cryptocurrency: failed to create FTeX fee revenue Crypto Journal entry'';
+        END IF;
+      END IF;
+
+      -- Credit the destination Crypto account and create the Crypto Journal entries for inflow to client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(destination_balance + _destination_credit_amount, 8),
+          last_tx = _destination_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _destination_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to update destination Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _destination_ticker, _destination_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to create Crypto Journal credit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _destination_ticker, - _destination_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to create FTeX operations destination Crypto Journal entry'';
+      END IF;
+
+      COMMIT;
+    END;
+';
+--rollback CREATE OR REPLACE PROCEDURE swap_cryptocurrency(
+--rollback     _transaction_id             UUID,
+--rollback     _client_id                  UUID,
+--rollback     _source_ticker              VARCHAR(6),
+--rollback     _source_debit_amount        NUMERIC(24,8),
+--rollback     _destination_ticker         VARCHAR(6),
+--rollback     _destination_credit_amount  NUMERIC(24,8),
+--rollback     _source_fee_amount          NUMERIC(24,8)
+--rollback )
+--rollback LANGUAGE plpgsql
+--rollback AS '
+--rollback     DECLARE
+--rollback       source_balance        NUMERIC(24,8);  -- current balance of the source Crypto account.
+--rollback       destination_balance   NUMERIC(24,8);  -- current balance of the destination Crypto account.
+--rollback       current_timestamp     TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+--rollback       ftex_crypto_id        UUID;           -- FTeX Crypto operations account id.
+--rollback       ftex_fees_id          UUID;           -- FTeX fee revenue operations account id.
+--rollback     BEGIN
+--rollback       -- Source and destination Cryptocurrencies must differ.
+--rollback       IF _source_ticker = _destination_ticker THEN
+--rollback          RAISE EXCEPTION ''swap_cryptocurrency: source and destination Cryptocurrencies must differ'';
+--rollback       END IF;
+--rollback
+--rollback       -- The fee is collected from the source Cryptocurrency debit amount.
+--rollback       IF _source_fee_amount < 0 OR _source_fee_amount > _source_debit_amount THEN
+--rollback          RAISE EXCEPTION ''swap_cryptocurrency: invalid fee amount %'', _source_fee_amount;
+--rollback       END IF;
+--rollback
+--rollback       -- Generate the timestamp with timezone for this transaction.
+--rollback       SELECT NOW() INTO STRICT current_timestamp;
+--rollback
+--rollback       -- Get FTeX operations account IDs.
+--rollback       SELECT client_id INTO STRICT ftex_crypto_id
+--rollback       FROM users
+--rollback       WHERE username = ''crypto-currencies'';
+--rollback
+--rollback       SELECT client_id INTO STRICT ftex_fees_id
+--rollback       FROM users
+--rollback       WHERE username = ''fee-revenue'';
+--rollback
+--rollback       -- Row lock both Crypto accounts in ticker order, without locking the foreign keys, to avoid deadlocks.
+--rollback       PERFORM ca.balance
+--rollback       FROM crypto_accounts AS ca
+--rollback       WHERE ca.client_id = _client_id AND ca.ticker IN (_source_ticker, _destination_ticker)
+--rollback       ORDER BY ca.ticker
+--rollback       FOR NO KEY UPDATE;
+--rollback
+--rollback       SELECT ca.balance INTO STRICT source_balance
+--rollback       FROM crypto_accounts AS ca
+--rollback       WHERE ca.client_id = _client_id AND ca.ticker = _source_ticker
+--rollback       LIMIT 1;
+--rollback
+--rollback       SELECT ca.balance INTO STRICT destination_balance
+--rollback       FROM crypto_accounts AS ca
+--rollback       WHERE ca.client_id = _client_id AND ca.ticker = _destination_ticker
+--rollback       LIMIT 1;
+--rollback
+--rollback       -- Check for sufficient source Cryptocurrency balance to complete swap.
+--rollback       IF _source_debit_amount > source_balance THEN
+--rollback          RAISE EXCEPTION ''swap_cryptocurrency: insufficient Cryptocurrency funds, delta %'', source_balance - _source_debit_amount;
+--rollback       END IF;
+--rollback
+--rollback       -- Debit the source Crypto account and create the Crypto Journal entries for outflow from client to FTeX.
+--rollback       UPDATE crypto_accounts
+--rollback       SET balance = round_half_even(source_balance - _source_debit_amount, 8),
+--rollback           last_tx = - _source_debit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND ticker = _source_ticker;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''swap_cryptocurrency: failed to update source Crypto balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _source_ticker, - _source_debit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''swap_cryptocurrency: failed to create Crypto Journal debit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_crypto_id, _source_ticker, _source_debit_amount - _source_fee_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''swap_cryptocurrency: failed to create FTeX operations source Crypto Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       -- Create the fee revenue Crypto Journal entry.
+--rollback       IF _source_fee_amount > 0 THEN
+--rollback         INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback         VALUES (ftex_fees_id, _source_ticker, _source_fee_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback         IF NOT FOUND THEN
+--rollback           RAISE EXCEPTION ''swap_cryptocurrency: failed to create FTeX fee revenue Crypto Journal entry'';
+--rollback         END IF;
+--rollback       END IF;
+--rollback
+--rollback       -- Credit the destination Crypto account and create the Crypto Journal entries for inflow to client from FTeX.
+--rollback       UPDATE crypto_accounts
+--rollback       SET balance = round_half_even(destination_balance + _destination_credit_amount, 8),
+--rollback           last_tx = _destination_credit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND ticker = _destination_ticker;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''swap_cryptocurrency: failed to update destination Crypto balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _destination_ticker, _destination_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''swap_cryptocurrency: failed to create Crypto Journal credit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_crypto_id, _destination_ticker, - _destination_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''swap_cryptocurrency: failed to create FTeX operations destination Crypto Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       COMMIT;
+--rollback     END;
+--rollback ';
+
+--changeset surahman:38
+--preconditions onFail:HALT onError:HALT
+--comment: Enum type for the outcome of a login attempt.
+CREATE TYPE login_outcome AS ENUM ('success', 'failure', 'locked');
+--rollback DROP TYPE login_outcome;
+
+--changeset surahman:39
+--preconditions onFail:HALT onError:HALT
+--comment: Login attempts against user accounts with the originating IP address and user agent.
+CREATE TABLE IF NOT EXISTS login_events (
+    event_id        UUID                PRIMARY KEY DEFAULT gen_random_uuid(),
+    client_id       UUID                REFERENCES users(client_id) ON DELETE CASCADE NOT NULL,
+    outcome         LOGIN_OUTCOME       NOT NULL,
+    ip_address      VARCHAR(64)         NOT NULL,
+    user_agent      VARCHAR(512)        NOT NULL,
+    created_at      TIMESTAMPTZ         DEFAULT now() NOT NULL
+) TABLESPACE login_events_data;
+
+CREATE INDEX IF NOT EXISTS login_events_client_id_idx ON login_events USING btree (client_id, created_at) TABLESPACE login_events_data;
+--rollback DROP TABLE login_events CASCADE;
+
+--changeset surahman:40
+--preconditions onFail:HALT onError:HALT
+--comment: Enum type for the account events published to the transactional outbox.
+CREATE TYPE outbox_event_type AS ENUM ('fiat_deposit', 'fiat_exchange', 'fiat_transfer_sent', 'fiat_transfer_received', 'crypto_purchase', 'crypto_sale');
+--rollback DROP TYPE outbox_event_type;
+
+--changeset surahman:41
+--preconditions onFail:HALT onError:HALT
+--comment: Enum type for the status of a webhook delivery.
+CREATE TYPE webhook_delivery_status AS ENUM ('pending', 'delivered', 'dead');
+--rollback DROP TYPE webhook_delivery_status;
+
+--changeset surahman:42
+--preconditions onFail:HALT onError:HALT
+--comment: Webhook URLs registered by users to receive their account events, along with their encrypted signing secrets.
+CREATE TABLE IF NOT EXISTS webhooks (
+    webhook_id      UUID                PRIMARY KEY DEFAULT gen_random_uuid(),
+    client_id       UUID                REFERENCES users(client_id) ON DELETE CASCADE NOT NULL,
+    url             VARCHAR(2048)       NOT NULL,
+    secret          VARCHAR(256)        NOT NULL,
+    created_at      TIMESTAMPTZ         DEFAULT now() NOT NULL,
+    UNIQUE (client_id, url)
+) TABLESPACE webhooks_data;
+--rollback DROP TABLE webhooks CASCADE;
+
+--changeset surahman:43
+--preconditions onFail:HALT onError:HALT
+--comment: Transactional outbox of account events that are written in the same transactions as the events they describe.
+CREATE TABLE IF NOT EXISTS outbox (
+    event_id        UUID                PRIMARY KEY DEFAULT gen_random_uuid(),
+    client_id       UUID                REFERENCES users(client_id) ON DELETE CASCADE NOT NULL,
+    event_type      OUTBOX_EVENT_TYPE   NOT NULL,
+    payload         JSONB               NOT NULL,
+    created_at      TIMESTAMPTZ         DEFAULT now() NOT NULL
+) TABLESPACE outbox_data;
+
+CREATE INDEX IF NOT EXISTS outbox_client_id_idx ON outbox USING btree (client_id, created_at) TABLESPACE outbox_data;
+--rollback DROP TABLE outbox CASCADE;
+
+--changeset surahman:44
+--preconditions onFail:HALT onError:HALT
+--comment: Deliveries of outbox events to webhooks and their retry state. Deliveries that exhaust their retries are dead.
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    delivery_id     UUID                    PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id        UUID                    REFERENCES outbox(event_id) ON DELETE CASCADE NOT NULL,
+    webhook_id      UUID                    REFERENCES webhooks(webhook_id) ON DELETE CASCADE NOT NULL,
+    client_id       UUID                    REFERENCES users(client_id) ON DELETE CASCADE NOT NULL,
+    status          WEBHOOK_DELIVERY_STATUS DEFAULT 'pending' NOT NULL,
+    attempts        INTEGER                 DEFAULT 0 NOT NULL,
+    next_attempt_at TIMESTAMPTZ             DEFAULT now() NOT NULL,
+    last_error      VARCHAR(512)            DEFAULT '' NOT NULL,
+    created_at      TIMESTAMPTZ             DEFAULT now() NOT NULL,
+    updated_at      TIMESTAMPTZ             DEFAULT now() NOT NULL
+) TABLESPACE webhook_deliveries_data;
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_due_idx ON webhook_deliveries USING btree (next_attempt_at) TABLESPACE webhook_deliveries_data WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS webhook_deliveries_client_id_idx ON webhook_deliveries USING btree (client_id, status, updated_at) TABLESPACE webhook_deliveries_data;
+--rollback DROP TABLE webhook_deliveries CASCADE;
+
+--changeset surahman:45
+--preconditions onFail:HALT onError:HALT
+--comment: Publish an account event to the outbox and queue its delivery to each of the client's webhooks.
+CREATE OR REPLACE FUNCTION outbox_publish(_client_id UUID, _event_type OUTBOX_EVENT_TYPE, _payload JSONB)
+RETURNS UUID
+LANGUAGE plpgsql
+AS '
+    DECLARE
+        published_id    UUID;   -- event id of the published event.
+    BEGIN
+        INSERT INTO outbox (client_id, event_type, payload)
+        VALUES (_client_id, _event_type, _payload)
+        RETURNING event_id INTO STRICT published_id;
+
+        -- Queue a delivery of the event to each of the client''s webhooks.
+        INSERT INTO webhook_deliveries (event_id, webhook_id, client_id)
+        SELECT published_id, w.webhook_id, w.client_id
+        FROM webhooks AS w
+        WHERE w.client_id = _client_id;
+
+        RETURN published_id;
+    END;
+';
+--rollback DROP FUNCTION outbox_publish;
+
+--changeset surahman:46
+--preconditions onFail:HALT onError:HALT
+--comment: Purchase a Cryptocurrency and publish the purchase to the outbox in the same transaction.
+CREATE OR REPLACE PROCEDURE purchase_cryptocurrency(
+    _transaction_id         UUID,
+    _client_id              UUID,
+    _fiat_currency          Currency,
+    _fiat_debit_amount      NUMERIC(20, 2),
+    _crypto_ticker          VARCHAR(6),
+    _crypto_credit_amount   NUMERIC(24,8),
+    _fiat_fee_amount        NUMERIC(20, 2),
+    _rate                   NUMERIC(32,16),
+    _offer_id               VARCHAR(32)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+      crypto_balance      NUMERIC(24,8);  -- current balance of the Crypto account.
+      fiat_held           NUMERIC(20,2);  -- amount of the Fiat account balance that is on hold.
+      fiat_status         ACCOUNT_STATUS; -- status of the Fiat account.
+      crypto_status       ACCOUNT_STATUS; -- status of the Crypto account.
+      current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+      ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+      ftex_fees_id        UUID;           -- FTeX fee revenue operations account id.
+    BEGIN
+
+      -- The fee is collected from the Fiat debit amount.
+      IF _fiat_fee_amount < 0 OR _fiat_fee_amount > _fiat_debit_amount THEN
+         RAISE EXCEPTION ''purchase_cryptocurrency: invalid fee amount %'', _fiat_fee_amount;
+      END IF;
+
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Get FTeX operations account IDs.
+      SELECT client_id INTO STRICT ftex_fiat_id
+      FROM users
+      WHERE username = ''fiat-currencies'';
+
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      SELECT client_id INTO STRICT ftex_fees_id
+      FROM users
+      WHERE username = ''fee-revenue'';
+
+      -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+      SELECT fa.balance, fa.held, fa.status INTO STRICT fiat_balance, fiat_held, fiat_status
+      FROM fiat_accounts AS fa
+      WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance, ca.status INTO STRICT crypto_balance, crypto_status
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      -- Check that both accounts are active.
+      IF fiat_status <> ''active'' OR crypto_status <> ''active'' THEN
+         RAISE EXCEPTION ''purchase_cryptocurrency: accounts must be active, Fiat %, Crypto %'', fiat_status, crypto_status;
+      END IF;
+
+      -- Check for sufficient available Fiat balance, excluding holds, to complete purchase.
+      IF _fiat_debit_amount > fiat_balance - fiat_held THEN
+         RAISE EXCEPTION ''purchase_cryptocurrency: insufficient Fiat currency funds, delta %'', fiat_balance - fiat_held - _fiat_debit_amount;
+      END IF;
+
+      -- Debit the Fiat account and create the Fiat Journal entries for outflow from client to FTeX.
+      UPDATE fiat_accounts
+      SET balance = round_half_even(fiat_balance - _fiat_debit_amount, 2),
+          last_tx = - _fiat_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND currency = _fiat_currency;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Fiat balance'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (_client_id, _fiat_currency, - _fiat_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Fiat Journal debit entry'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (ftex_fiat_id, _fiat_currency, _fiat_debit_amount - _fiat_fee_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+      END IF;
+
+      -- Create the fee revenue Fiat Journal entry.
+      IF _fiat_fee_amount > 0 THEN
+        INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+        VALUES (ftex_fees_id, _fiat_currency, _fiat_fee_amount, current_timestamp, _transaction_id);
+
+        IF NOT FOUND THEN
+          RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX fee revenue Fiat Journal entry'';
+        END IF;
+      END IF;
+
+      -- Credit the Crypto account and create the Crypto Journal entries for inflow to client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(crypto_balance + _crypto_credit_amount, 8),
+          last_tx = _crypto_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _crypto_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _crypto_ticker, _crypto_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Crypto Journal credit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _crypto_ticker, - _crypto_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+      END IF;
+
+      -- Record the exchange rate and price quote offer that priced the trade.
+      INSERT INTO trades (tx_id, client_id, offer_id, source, destination, rate, traded_at)
+      VALUES (_transaction_id, _client_id, NULLIF(_offer_id, ''''), _fiat_currency::VARCHAR, _crypto_ticker, _rate, current_timestamp);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''purchase_cryptocurrency: failed to create trade entry'';
+      END IF;
+
+      -- Publish the purchase to the transactional outbox for delivery to the client''s webhooks.
+      PERFORM outbox_publish(_client_id, ''crypto_purchase'', jsonb_build_object(
+        ''txId'', _transaction_id,
+        ''clientId'', _client_id,
+        ''fiatCurrency'', _fiat_currency,
+        ''fiatAmount'', - _fiat_debit_amount,
+        ''ticker'', _crypto_ticker,
+        ''cryptoAmount'', _crypto_credit_amount,
+        ''fee'', _fiat_fee_amount,
+        ''rate'', _rate,
+        ''transactedAt'', current_timestamp));
+
+      COMMIT;
+    END;
+';
+--rollback CREATE OR REPLACE PROCEDURE purchase_cryptocurrency(
+--rollback     _transaction_id         UUID,
+--rollback     _client_id              UUID,
+--rollback     _fiat_currency          Currency,
+--rollback     _fiat_debit_amount      NUMERIC(20, 2),
+--rollback     _crypto_ticker          VARCHAR(6),
+--rollback     _crypto_credit_amount   NUMERIC(24,8),
+--rollback     _fiat_fee_amount        NUMERIC(20, 2),
+--rollback     _rate                   NUMERIC(32,16),
+--rollback     _offer_id               VARCHAR(32)
+--rollback )
+--rollback LANGUAGE plpgsql
+--rollback AS '
+--rollback     DECLARE
+--rollback       fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+--rollback       crypto_balance      NUMERIC(24,8);  -- current balance of the Crypto account.
+--rollback       fiat_held           NUMERIC(20,2);  -- amount of the Fiat account balance that is on hold.
+--rollback       fiat_status         ACCOUNT_STATUS; -- status of the Fiat account.
+--rollback       crypto_status       ACCOUNT_STATUS; -- status of the Crypto account.
+--rollback       current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+--rollback       ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+--rollback       ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+--rollback       ftex_fees_id        UUID;           -- FTeX fee revenue operations account id.
+--rollback     BEGIN
+--rollback
+--rollback       -- The fee is collected from the Fiat debit amount.
+--rollback       IF _fiat_fee_amount < 0 OR _fiat_fee_amount > _fiat_debit_amount THEN
+--rollback          RAISE EXCEPTION ''purchase_cryptocurrency: invalid fee amount %'', _fiat_fee_amount;
+--rollback       END IF;
+--rollback
+--rollback       -- Generate the timestamp with timezone for this transaction.
+--rollback       SELECT NOW() INTO STRICT current_timestamp;
+--rollback
+--rollback       -- Get FTeX operations account IDs.
+--rollback       SELECT client_id INTO STRICT ftex_fiat_id
+--rollback       FROM users
+--rollback       WHERE username = ''fiat-currencies'';
+--rollback
+--rollback       SELECT client_id INTO STRICT ftex_crypto_id
+--rollback       FROM users
+--rollback       WHERE username = ''crypto-currencies'';
+--rollback
+--rollback       SELECT client_id INTO STRICT ftex_fees_id
+--rollback       FROM users
+--rollback       WHERE username = ''fee-revenue'';
+--rollback
+--rollback       -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+--rollback       SELECT fa.balance, fa.held, fa.status INTO STRICT fiat_balance, fiat_held, fiat_status
+--rollback       FROM fiat_accounts AS fa
+--rollback       WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+--rollback       LIMIT 1
+--rollback       FOR NO KEY UPDATE;
+--rollback
+--rollback       SELECT ca.balance, ca.status INTO STRICT crypto_balance, crypto_status
+--rollback       FROM crypto_accounts AS ca
+--rollback       WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+--rollback       LIMIT 1
+--rollback       FOR NO KEY UPDATE;
+--rollback
+--rollback       -- Check that both accounts are active.
+--rollback       IF fiat_status <> ''active'' OR crypto_status <> ''active'' THEN
+--rollback          RAISE EXCEPTION ''purchase_cryptocurrency: accounts must be active, Fiat %, Crypto %'', fiat_status, crypto_status;
+--rollback       END IF;
+--rollback
+--rollback       -- Check for sufficient available Fiat balance, excluding holds, to complete purchase.
+--rollback       IF _fiat_debit_amount > fiat_balance - fiat_held THEN
+--rollback          RAISE EXCEPTION ''purchase_cryptocurrency: insufficient Fiat currency funds, delta %'', fiat_balance - fiat_held - _fiat_debit_amount;
+--rollback       END IF;
+--rollback
+--rollback       -- Debit the Fiat account and create the Fiat Journal entries for outflow from client to FTeX.
+--rollback       UPDATE fiat_accounts
+--rollback       SET balance = round_half_even(fiat_balance - _fiat_debit_amount, 2),
+--rollback           last_tx = - _fiat_debit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND currency = _fiat_currency;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Fiat balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _fiat_currency, - _fiat_debit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Fiat Journal debit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_fiat_id, _fiat_currency, _fiat_debit_amount - _fiat_fee_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       -- Create the fee revenue Fiat Journal entry.
+--rollback       IF _fiat_fee_amount > 0 THEN
+--rollback         INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+--rollback         VALUES (ftex_fees_id, _fiat_currency, _fiat_fee_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback         IF NOT FOUND THEN
+--rollback           RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX fee revenue Fiat Journal entry'';
+--rollback         END IF;
+--rollback       END IF;
+--rollback
+--rollback       -- Credit the Crypto account and create the Crypto Journal entries for inflow to client from FTeX.
+--rollback       UPDATE crypto_accounts
+--rollback       SET balance = round_half_even(crypto_balance + _crypto_credit_amount, 8),
+--rollback           last_tx = _crypto_credit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND ticker = _crypto_ticker;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Crypto balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _crypto_ticker, _crypto_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Crypto Journal credit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_crypto_id, _crypto_ticker, - _crypto_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       -- Record the exchange rate and price quote offer that priced the trade.
+--rollback       INSERT INTO trades (tx_id, client_id, offer_id, source, destination, rate, traded_at)
+--rollback       VALUES (_transaction_id, _client_id, NULLIF(_offer_id, ''''), _fiat_currency::VARCHAR, _crypto_ticker, _rate, current_timestamp);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to create trade entry'';
+--rollback       END IF;
+--rollback
+--rollback       COMMIT;
+--rollback     END;
+--rollback ';
+
+--changeset surahman:47
+--preconditions onFail:HALT onError:HALT
+--comment: Sell a Cryptocurrency and publish the sale to the outbox in the same transaction.
+CREATE OR REPLACE PROCEDURE sell_cryptocurrency(
+    _transaction_id         UUID,
+    _client_id              UUID,
+    _fiat_currency          Currency,
+    _fiat_credit_amount     NUMERIC(20, 2),
+    _crypto_ticker          VARCHAR(6),
+    _crypto_debit_amount    NUMERIC(24,8),
+    _crypto_fee_amount      NUMERIC(24,8),
+    _rate                   NUMERIC(32,16),
+    _offer_id               VARCHAR(32)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+      crypto_balance      NUMERIC(24,8);  -- current balance of the Crypto account.
+      crypto_held         NUMERIC(24,8);  -- amount of the Crypto account balance that is on hold.
+      fiat_status         ACCOUNT_STATUS; -- status of the Fiat account.
+      crypto_status       ACCOUNT_STATUS; -- status of the Crypto account.
+      current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+      ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+      ftex_fees_id        UUID;           -- FTeX fee revenue operations account id.
+    BEGIN
+      -- The fee is collected from the Cryptocurrency debit amount.
+      IF _crypto_fee_amount < 0 OR _crypto_fee_amount > _crypto_debit_amount THEN
+         RAISE EXCEPTION ''sell_cryptocurrency: invalid fee amount %'', _crypto_fee_amount;
+      END IF;
+
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Get FTeX operations account IDs.
+      SELECT client_id INTO STRICT ftex_fiat_id
+      FROM users
+      WHERE username = ''fiat-currencies'';
+
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      SELECT client_id INTO STRICT ftex_fees_id
+      FROM users
+      WHERE username = ''fee-revenue'';
+
+      -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+      SELECT fa.balance, fa.status INTO STRICT fiat_balance, fiat_status
+      FROM fiat_accounts AS fa
+      WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance, ca.held, ca.status INTO STRICT crypto_balance, crypto_held, crypto_status
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+      LIMIT 1
+      FOR NO KEY UPDATE;
+
+      -- Check that both accounts are active.
+      IF fiat_status <> ''active'' OR crypto_status <> ''active'' THEN
+         RAISE EXCEPTION ''sell_cryptocurrency: accounts must be active, Fiat %, Crypto %'', fiat_status, crypto_status;
+      END IF;
+
+      -- Check for sufficient available Cryptocurrency balance, excluding holds, to complete sale.
+      IF _crypto_debit_amount > crypto_balance - crypto_held THEN
+         RAISE EXCEPTION ''sell_cryptocurrency: insufficient Cryptocurrency funds, delta %'', crypto_balance - crypto_held - _crypto_debit_amount;
+      END IF;
+
+      -- Debit the Crypto account and create the Crypto Journal entries for outflow from client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(crypto_balance - _crypto_debit_amount, 8),
+          last_tx = - _crypto_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _crypto_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to update Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _crypto_ticker, - _crypto_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create Crypto Journal debit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _crypto_ticker, _crypto_debit_amount - _crypto_fee_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+      END IF;
+
+      -- Create the fee revenue Crypto Journal entry.
+      IF _crypto_fee_amount > 0 THEN
+        INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+        VALUES (ftex_fees_id, _crypto_ticker, _crypto_fee_amount, current_timestamp, _transaction_id);
+
+        IF NOT FOUND THEN
+          RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX fee revenue Crypto Journal entry'';
+        END IF;
+      END IF;
+
+      -- Credit the Fiat account and create the Fiat Journal entries for inflow to the client from FTeX.
+      UPDATE fiat_accounts
+      SET balance = round_half_even(fiat_balance + _fiat_credit_amount, 2),
+          last_tx = _fiat_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND currency = _fiat_currency;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to update Fiat balance'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (_client_id, _fiat_currency, _fiat_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create Fiat Journal credit entry'';
+      END IF;
+
+      INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+      VALUES (ftex_fiat_id, _fiat_currency, - _fiat_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+      END IF;
+
+      -- Record the exchange rate and price quote offer that priced the trade.
+      INSERT INTO trades (tx_id, client_id, offer_id, source, destination, rate, traded_at)
+      VALUES (_transaction_id, _client_id, NULLIF(_offer_id, ''''), _crypto_ticker, _fiat_currency::VARCHAR, _rate, current_timestamp);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''sell_cryptocurrency: failed to create trade entry'';
+      END IF;
+
+      -- Publish the sale to the transactional outbox for delivery to the client''s webhooks.
+      PERFORM outbox_publish(_client_id, ''crypto_sale'', jsonb_build_object(
+        ''txId'', _transaction_id,
+        ''clientId'', _client_id,
+        ''fiatCurrency'', _fiat_currency,
+        ''fiatAmount'', _fiat_credit_amount,
+        ''ticker'', _crypto_ticker,
+        ''cryptoAmount'', - _crypto_debit_amount,
+        ''fee'', _crypto_fee_amount,
+        ''rate'', _rate,
+        ''transactedAt'', current_timestamp));
+
+      COMMIT;
+    END;
+';
+--rollback CREATE OR REPLACE PROCEDURE sell_cryptocurrency(
+--rollback     _transaction_id         UUID,
+--rollback     _client_id              UUID,
+--rollback     _fiat_currency          Currency,
+--rollback     _fiat_credit_amount     NUMERIC(20, 2),
+--rollback     _crypto_ticker          VARCHAR(6),
+--rollback     _crypto_debit_amount    NUMERIC(24,8),
+--rollback     _crypto_fee_amount      NUMERIC(24,8),
+--rollback     _rate                   NUMERIC(32,16),
+--rollback     _offer_id               VARCHAR(32)
+--rollback )
+--rollback LANGUAGE plpgsql
+--rollback AS '
+--rollback     DECLARE
+--rollback       fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+--rollback       crypto_balance      NUMERIC(24,8);  -- current balance of the Crypto account.
+--rollback       crypto_held         NUMERIC(24,8);  -- amount of the Crypto account balance that is on hold.
+--rollback       fiat_status         ACCOUNT_STATUS; -- status of the Fiat account.
+--rollback       crypto_status       ACCOUNT_STATUS; -- status of the Crypto account.
+--rollback       current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+--rollback       ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+--rollback       ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+--rollback       ftex_fees_id        UUID;           -- FTeX fee revenue operations account id.
+--rollback     BEGIN
+--rollback       -- The fee is collected from the Cryptocurrency debit amount.
+--rollback       IF _crypto_fee_amount < 0 OR _crypto_fee_amount > _crypto_debit_amount THEN
+--rollback          RAISE EXCEPTION ''sell_cryptocurrency: invalid fee amount %'', _crypto_fee_amount;
+--rollback       END IF;
+--rollback
+--rollback       -- Generate the timestamp with timezone for this transaction.
+--rollback       SELECT NOW() INTO STRICT current_timestamp;
+--rollback
+--rollback       -- Get FTeX operations account IDs.
+--rollback       SELECT client_id INTO STRICT ftex_fiat_id
+--rollback       FROM users
+--rollback       WHERE username = ''fiat-currencies'';
+--rollback
+--rollback       SELECT client_id INTO STRICT ftex_crypto_id
+--rollback       FROM users
+--rollback       WHERE username = ''crypto-currencies'';
+--rollback
+--rollback       SELECT client_id INTO STRICT ftex_fees_id
+--rollback       FROM users
+--rollback       WHERE username = ''fee-revenue'';
+--rollback
+--rollback       -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+--rollback       SELECT fa.balance, fa.status INTO STRICT fiat_balance, fiat_status
+--rollback       FROM fiat_accounts AS fa
+--rollback       WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+--rollback       LIMIT 1
+--rollback       FOR NO KEY UPDATE;
+--rollback
+--rollback       SELECT ca.balance, ca.held, ca.status INTO STRICT crypto_balance, crypto_held, crypto_status
+--rollback       FROM crypto_accounts AS ca
+--rollback       WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+--rollback       LIMIT 1
+--rollback       FOR NO KEY UPDATE;
+--rollback
+--rollback       -- Check that both accounts are active.
+--rollback       IF fiat_status <> ''active'' OR crypto_status <> ''active'' THEN
+--rollback          RAISE EXCEPTION ''sell_cryptocurrency: accounts must be active, Fiat %, Crypto %'', fiat_status, crypto_status;
+--rollback       END IF;
+--rollback
+--rollback       -- Check for sufficient available Cryptocurrency balance, excluding holds, to complete sale.
+--rollback       IF _crypto_debit_amount > crypto_balance - crypto_held THEN
+--rollback          RAISE EXCEPTION ''sell_cryptocurrency: insufficient Cryptocurrency funds, delta %'', crypto_balance - crypto_held - _crypto_debit_amount;
+--rollback       END IF;
+--rollback
+--rollback       -- Debit the Crypto account and create the Crypto Journal entries for outflow from client from FTeX.
+--rollback       UPDATE crypto_accounts
+--rollback       SET balance = round_half_even(crypto_balance - _crypto_debit_amount, 8),
+--rollback           last_tx = - _crypto_debit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND ticker = _crypto_ticker;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to update Crypto balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _crypto_ticker, - _crypto_debit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to create Crypto Journal debit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_crypto_id, _crypto_ticker, _crypto_debit_amount - _crypto_fee_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       -- Create the fee revenue Crypto Journal entry.
+--rollback       IF _crypto_fee_amount > 0 THEN
+--rollback         INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback         VALUES (ftex_fees_id, _crypto_ticker, _crypto_fee_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback         IF NOT FOUND THEN
+--rollback           RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX fee revenue Crypto Journal entry'';
+--rollback         END IF;
+--rollback       END IF;
+--rollback
+--rollback       -- Credit the Fiat account and create the Fiat Journal entries for inflow to the client from FTeX.
+--rollback       UPDATE fiat_accounts
+--rollback       SET balance = round_half_even(fiat_balance + _fiat_credit_amount, 2),
+--rollback           last_tx = _fiat_credit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND currency = _fiat_currency;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to update Fiat balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _fiat_currency, _fiat_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to create Fiat Journal credit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_fiat_id, _fiat_currency, - _fiat_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       -- Record the exchange rate and price quote offer that priced the trade.
+--rollback       INSERT INTO trades (tx_id, client_id, offer_id, source, destination, rate, traded_at)
+--rollback       VALUES (_transaction_id, _client_id, NULLIF(_offer_id, ''''), _crypto_ticker, _fiat_currency::VARCHAR, _rate, current_timestamp);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to create trade entry'';
+--rollback       END IF;
+--rollback
+--rollback       COMMIT;
+--rollback     END;
+--rollback ';
+
+--changeset surahman:48
+--preconditions onFail:HALT onError:HALT
+--comment: Track the outbox events that have been relayed to the Redis event streams. Existing events are not relayed.
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS relayed_at TIMESTAMPTZ;
+
+UPDATE outbox SET relayed_at = created_at;
+
+CREATE INDEX IF NOT EXISTS outbox_unrelayed_idx ON outbox USING btree (created_at) WHERE relayed_at IS NULL TABLESPACE outbox_data;
+--rollback DROP INDEX IF EXISTS outbox_unrelayed_idx;
+--rollback ALTER TABLE outbox DROP COLUMN IF EXISTS relayed_at;
+
+--changeset surahman:49
+--preconditions onFail:HALT onError:HALT
+--comment: Track when each open limit order was last checked by the order matcher so that it rotates through all of them.
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS checked_at TIMESTAMPTZ;
+
+DROP INDEX IF EXISTS orders_open_idx;
+CREATE INDEX IF NOT EXISTS orders_open_idx ON orders USING btree (checked_at NULLS FIRST, created_at) TABLESPACE orders_data WHERE status = 'open';
+CREATE INDEX IF NOT EXISTS orders_executing_idx ON orders USING btree (updated_at) TABLESPACE orders_data WHERE status = 'executing';
+--rollback DROP INDEX IF EXISTS orders_executing_idx;
+--rollback DROP INDEX IF EXISTS orders_open_idx;
+--rollback CREATE INDEX IF NOT EXISTS orders_open_idx ON orders USING btree (created_at) TABLESPACE orders_data WHERE status = 'open';
+--rollback ALTER TABLE orders DROP COLUMN IF EXISTS checked_at;
+
+--changeset surahman:50
+--preconditions onFail:HALT onError:HALT
+--comment: Record the day of the month on which monthly schedules run so that shorter months do not move later runs.
+ALTER TABLE schedules ADD COLUMN IF NOT EXISTS anchor_day SMALLINT CHECK (anchor_day BETWEEN 1 AND 31);
+
+UPDATE schedules SET anchor_day = EXTRACT(DAY FROM next_run_at AT TIME ZONE 'UTC');
+
+ALTER TABLE schedules ALTER COLUMN anchor_day SET NOT NULL;
+--rollback ALTER TABLE schedules DROP COLUMN IF EXISTS anchor_day;
+
+--changeset surahman:51
+--preconditions onFail:HALT onError:HALT
+--comment: Drop the Cryptocurrency procedure overloads that were superseded by procedures with new signatures.
+DROP PROCEDURE IF EXISTS purchase_cryptocurrency(UUID, UUID, Currency, NUMERIC, VARCHAR, NUMERIC);
+DROP PROCEDURE IF EXISTS purchase_cryptocurrency(UUID, UUID, Currency, NUMERIC, VARCHAR, NUMERIC, NUMERIC);
+DROP PROCEDURE IF EXISTS sell_cryptocurrency(UUID, UUID, Currency, NUMERIC, VARCHAR, NUMERIC);
+DROP PROCEDURE IF EXISTS sell_cryptocurrency(UUID, UUID, Currency, NUMERIC, VARCHAR, NUMERIC, NUMERIC);
+DROP PROCEDURE IF EXISTS swap_cryptocurrency(UUID, UUID, VARCHAR, NUMERIC, VARCHAR, NUMERIC);
+--rollback CREATE OR REPLACE PROCEDURE purchase_cryptocurrency(
+--rollback     _transaction_id         UUID,
+--rollback     _client_id              UUID,
+--rollback     _fiat_currency          Currency,
+--rollback     _fiat_debit_amount      NUMERIC(20, 2),
+--rollback     _crypto_ticker          VARCHAR(6),
+--rollback     _crypto_credit_amount   NUMERIC(24,8)
+--rollback )
+--rollback LANGUAGE plpgsql
+--rollback AS '
+--rollback     DECLARE
+--rollback       fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+--rollback       crypto_balance      NUMERIC(24,8);  -- current balance of the Crypto account.
+--rollback       current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+--rollback       ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+--rollback       ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+--rollback     BEGIN
+--rollback
+--rollback       -- Generate the timestamp with timezone for this transaction.
+--rollback       SELECT NOW() INTO STRICT current_timestamp;
+--rollback
+--rollback       -- Get FTeX operations account IDs.
+--rollback       SELECT client_id INTO STRICT ftex_fiat_id
+--rollback       FROM users
+--rollback       WHERE username = ''fiat-currencies'';
+--rollback
+--rollback       SELECT client_id INTO STRICT ftex_crypto_id
+--rollback       FROM users
+--rollback       WHERE username = ''crypto-currencies'';
+--rollback
+--rollback       -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+--rollback       SELECT fa.balance INTO STRICT fiat_balance
+--rollback       FROM fiat_accounts AS fa
+--rollback       WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+--rollback       LIMIT 1
+--rollback       FOR NO KEY UPDATE;
+--rollback
+--rollback       SELECT ca.balance INTO STRICT crypto_balance
+--rollback       FROM crypto_accounts AS ca
+--rollback       WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+--rollback       LIMIT 1
+--rollback       FOR NO KEY UPDATE;
+--rollback
+--rollback       -- Check for sufficient Fiat balance to complete purchase.
+--rollback       IF _fiat_debit_amount > fiat_balance THEN
+--rollback          RAISE EXCEPTION ''purchase_cryptocurrency: insufficient Fiat currency funds, delta %'', fiat_balance - _fiat_debit_amount;
+--rollback       END IF;
+--rollback
+--rollback       -- Debit the Fiat account and create the Fiat Journal entries for outflow from client to FTeX.
+--rollback       UPDATE fiat_accounts
+--rollback       SET balance = round_half_even(fiat_balance - _fiat_debit_amount, 2),
+--rollback           last_tx = - _fiat_debit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND currency = _fiat_currency;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Fiat balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _fiat_currency, - _fiat_debit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Fiat Journal debit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_fiat_id, _fiat_currency, _fiat_debit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       -- Credit the Crypto account and create the Crypto Journal entries for inflow to client from FTeX.
+--rollback       UPDATE crypto_accounts
+--rollback       SET balance = round_half_even(crypto_balance + _crypto_credit_amount, 8),
+--rollback           last_tx = _crypto_credit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND ticker = _crypto_ticker;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Crypto balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _crypto_ticker, _crypto_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Crypto Journal credit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_crypto_id, _crypto_ticker, - _crypto_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       COMMIT;
+--rollback     END;
+--rollback ';
+--rollback CREATE OR REPLACE PROCEDURE purchase_cryptocurrency(
+--rollback     _transaction_id         UUID,
+--rollback     _client_id              UUID,
+--rollback     _fiat_currency          Currency,
+--rollback     _fiat_debit_amount      NUMERIC(20, 2),
+--rollback     _crypto_ticker          VARCHAR(6),
+--rollback     _crypto_credit_amount   NUMERIC(24,8),
+--rollback     _fiat_fee_amount        NUMERIC(20, 2)
+--rollback )
+--rollback LANGUAGE plpgsql
+--rollback AS '
+--rollback     DECLARE
+--rollback       fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+--rollback       crypto_balance      NUMERIC(24,8);  -- current balance of the Crypto account.
+--rollback       current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+--rollback       ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+--rollback       ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+--rollback       ftex_fees_id        UUID;           -- FTeX fee revenue operations account id.
+--rollback     BEGIN
+--rollback
+--rollback       -- The fee is collected from the Fiat debit amount.
+--rollback       IF _fiat_fee_amount < 0 OR _fiat_fee_amount > _fiat_debit_amount THEN
+--rollback          RAISE EXCEPTION ''purchase_cryptocurrency: invalid fee amount %'', _fiat_fee_amount;
+--rollback       END IF;
+--rollback
+--rollback       -- Generate the timestamp with timezone for this transaction.
+--rollback       SELECT NOW() INTO STRICT current_timestamp;
+--rollback
+--rollback       -- Get FTeX operations account IDs.
+--rollback       SELECT client_id INTO STRICT ftex_fiat_id
+--rollback       FROM users
+--rollback       WHERE username = ''fiat-currencies'';
+--rollback
+--rollback       SELECT client_id INTO STRICT ftex_crypto_id
+--rollback       FROM users
+--rollback       WHERE username = ''crypto-currencies'';
+--rollback
+--rollback       SELECT client_id INTO STRICT ftex_fees_id
+--rollback       FROM users
+--rollback       WHERE username = ''fee-revenue'';
+--rollback
+--rollback       -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+--rollback       SELECT fa.balance INTO STRICT fiat_balance
+--rollback       FROM fiat_accounts AS fa
+--rollback       WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+--rollback       LIMIT 1
+--rollback       FOR NO KEY UPDATE;
+--rollback
+--rollback       SELECT ca.balance INTO STRICT crypto_balance
+--rollback       FROM crypto_accounts AS ca
+--rollback       WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+--rollback       LIMIT 1
+--rollback       FOR NO KEY UPDATE;
+--rollback
+--rollback       -- Check for sufficient Fiat balance to complete purchase.
+--rollback       IF _fiat_debit_amount > fiat_balance THEN
+--rollback          RAISE EXCEPTION ''purchase_cryptocurrency: insufficient Fiat currency funds, delta %'', fiat_balance - _fiat_debit_amount;
+--rollback       END IF;
+--rollback
+--rollback       -- Debit the Fiat account and create the Fiat Journal entries for outflow from client to FTeX.
+--rollback       UPDATE fiat_accounts
+--rollback       SET balance = round_half_even(fiat_balance - _fiat_debit_amount, 2),
+--rollback           last_tx = - _fiat_debit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND currency = _fiat_currency;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Fiat balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _fiat_currency, - _fiat_debit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Fiat Journal debit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_fiat_id, _fiat_currency, _fiat_debit_amount - _fiat_fee_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       -- Create the fee revenue Fiat Journal entry.
+--rollback       IF _fiat_fee_amount > 0 THEN
+--rollback         INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+--rollback         VALUES (ftex_fees_id, _fiat_currency, _fiat_fee_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback         IF NOT FOUND THEN
+--rollback           RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX fee revenue Fiat Journal entry'';
+--rollback         END IF;
+--rollback       END IF;
+--rollback
+--rollback       -- Credit the Crypto account and create the Crypto Journal entries for inflow to client from FTeX.
+--rollback       UPDATE crypto_accounts
+--rollback       SET balance = round_half_even(crypto_balance + _crypto_credit_amount, 8),
+--rollback           last_tx = _crypto_credit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND ticker = _crypto_ticker;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to update Crypto balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _crypto_ticker, _crypto_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to create Crypto Journal credit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_crypto_id, _crypto_ticker, - _crypto_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''purchase_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       COMMIT;
+--rollback     END;
+--rollback ';
+--rollback CREATE OR REPLACE PROCEDURE sell_cryptocurrency(
+--rollback     _transaction_id         UUID,
+--rollback     _client_id              UUID,
+--rollback     _fiat_currency          Currency,
+--rollback     _fiat_credit_amount     NUMERIC(20, 2),
+--rollback     _crypto_ticker          VARCHAR(6),
+--rollback     _crypto_debit_amount    NUMERIC(24,8)
+--rollback )
+--rollback LANGUAGE plpgsql
+--rollback AS '
+--rollback     DECLARE
+--rollback       fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+--rollback       crypto_balance      NUMERIC(24,8);  -- current balance of the Crypto account.
+--rollback       current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+--rollback       ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+--rollback       ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+--rollback     BEGIN
+--rollback       -- Generate the timestamp with timezone for this transaction.
+--rollback       SELECT NOW() INTO STRICT current_timestamp;
+--rollback
+--rollback       -- Get FTeX operations account IDs.
+--rollback       SELECT client_id INTO STRICT ftex_fiat_id
+--rollback       FROM users
+--rollback       WHERE username = ''fiat-currencies'';
+--rollback
+--rollback       SELECT client_id INTO STRICT ftex_crypto_id
+--rollback       FROM users
+--rollback       WHERE username = ''crypto-currencies'';
+--rollback
+--rollback       -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+--rollback       SELECT fa.balance INTO STRICT fiat_balance
+--rollback       FROM fiat_accounts AS fa
+--rollback       WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+--rollback       LIMIT 1
+--rollback       FOR NO KEY UPDATE;
+--rollback
+--rollback       SELECT ca.balance INTO STRICT crypto_balance
+--rollback       FROM crypto_accounts AS ca
+--rollback       WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+--rollback       LIMIT 1
+--rollback       FOR NO KEY UPDATE;
+--rollback
+--rollback       -- Check for sufficient Cryptocurrency balance to complete sale.
+--rollback       IF _crypto_debit_amount > crypto_balance THEN
+--rollback          RAISE EXCEPTION ''sell_cryptocurrency: insufficient Cryptocurrency funds, delta %'', crypto_balance - _crypto_debit_amount;
+--rollback       END IF;
+--rollback
+--rollback       -- Debit the Crypto account and create the Crypto Journal entries for outflow from client from FTeX.
+--rollback       UPDATE crypto_accounts
+--rollback       SET balance = round_half_even(crypto_balance - _crypto_debit_amount, 8),
+--rollback           last_tx = - _crypto_debit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND ticker = _crypto_ticker;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to update Crypto balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _crypto_ticker, - _crypto_debit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to create Crypto Journal debit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_crypto_id, _crypto_ticker, _crypto_debit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       -- Credit the Fiat account and create the Fiat Journal entries for inflow to the client from FTeX.
+--rollback       UPDATE fiat_accounts
+--rollback       SET balance = round_half_even(fiat_balance + _fiat_credit_amount, 2),
+--rollback           last_tx = _fiat_credit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND currency = _fiat_currency;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to update Fiat balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _fiat_currency, _fiat_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to create Fiat Journal credit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_fiat_id, _fiat_currency, - _fiat_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       COMMIT;
+--rollback     END;
+--rollback ';
+--rollback CREATE OR REPLACE PROCEDURE sell_cryptocurrency(
+--rollback     _transaction_id         UUID,
+--rollback     _client_id              UUID,
+--rollback     _fiat_currency          Currency,
+--rollback     _fiat_credit_amount     NUMERIC(20, 2),
+--rollback     _crypto_ticker          VARCHAR(6),
+--rollback     _crypto_debit_amount    NUMERIC(24,8),
+--rollback     _crypto_fee_amount      NUMERIC(24,8)
+--rollback )
+--rollback LANGUAGE plpgsql
+--rollback AS '
+--rollback     DECLARE
+--rollback       fiat_balance        NUMERIC(20,2);  -- current balance of the Fiat account.
+--rollback       crypto_balance      NUMERIC(24,8);  -- current balance of the Crypto account.
+--rollback       current_timestamp   TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+--rollback       ftex_fiat_id        UUID;           -- FTeX Fiat operations account id.
+--rollback       ftex_crypto_id      UUID;           -- FTeX Crypto operations account id.
+--rollback       ftex_fees_id        UUID;           -- FTeX fee revenue operations account id.
+--rollback     BEGIN
+--rollback       -- The fee is collected from the Cryptocurrency debit amount.
+--rollback       IF _crypto_fee_amount < 0 OR _crypto_fee_amount > _crypto_debit_amount THEN
+--rollback          RAISE EXCEPTION ''sell_cryptocurrency: invalid fee amount %'', _crypto_fee_amount;
+--rollback       END IF;
+--rollback
+--rollback       -- Generate the timestamp with timezone for this transaction.
+--rollback       SELECT NOW() INTO STRICT current_timestamp;
+--rollback
+--rollback       -- Get FTeX operations account IDs.
+--rollback       SELECT client_id INTO STRICT ftex_fiat_id
+--rollback       FROM users
+--rollback       WHERE username = ''fiat-currencies'';
+--rollback
+--rollback       SELECT client_id INTO STRICT ftex_crypto_id
+--rollback       FROM users
+--rollback       WHERE username = ''crypto-currencies'';
+--rollback
+--rollback       SELECT client_id INTO STRICT ftex_fees_id
+--rollback       FROM users
+--rollback       WHERE username = ''fee-revenue'';
+--rollback
+--rollback       -- Get balances and row lock the Fiat and then Crypto accounts without locking the foreign keys.
+--rollback       SELECT fa.balance INTO STRICT fiat_balance
+--rollback       FROM fiat_accounts AS fa
+--rollback       WHERE fa.client_id = _client_id AND fa.currency = _fiat_currency
+--rollback       LIMIT 1
+--rollback       FOR NO KEY UPDATE;
+--rollback
+--rollback       SELECT ca.balance INTO STRICT crypto_balance
+--rollback       FROM crypto_accounts AS ca
+--rollback       WHERE ca.client_id = _client_id AND ca.ticker = _crypto_ticker
+--rollback       LIMIT 1
+--rollback       FOR NO KEY UPDATE;
+--rollback
+--rollback       -- Check for sufficient Cryptocurrency balance to complete sale.
+--rollback       IF _crypto_debit_amount > crypto_balance THEN
+--rollback          RAISE EXCEPTION ''sell_cryptocurrency: insufficient Cryptocurrency funds, delta %'', crypto_balance - _crypto_debit_amount;
+--rollback       END IF;
+--rollback
+--rollback       -- Debit the Crypto account and create the Crypto Journal entries for outflow from client from FTeX.
+--rollback       UPDATE crypto_accounts
+--rollback       SET balance = round_half_even(crypto_balance - _crypto_debit_amount, 8),
+--rollback           last_tx = - _crypto_debit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND ticker = _crypto_ticker;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to update Crypto balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _crypto_ticker, - _crypto_debit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to create Crypto Journal debit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_crypto_id, _crypto_ticker, _crypto_debit_amount - _crypto_fee_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Crypto Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       -- Create the fee revenue Crypto Journal entry.
+--rollback       IF _crypto_fee_amount > 0 THEN
+--rollback         INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback         VALUES (ftex_fees_id, _crypto_ticker, _crypto_fee_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback         IF NOT FOUND THEN
+--rollback           RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX fee revenue Crypto Journal entry'';
+--rollback         END IF;
+--rollback       END IF;
+--rollback
+--rollback       -- Credit the Fiat account and create the Fiat Journal entries for inflow to the client from FTeX.
+--rollback       UPDATE fiat_accounts
+--rollback       SET balance = round_half_even(fiat_balance + _fiat_credit_amount, 2),
+--rollback           last_tx = _fiat_credit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND currency = _fiat_currency;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to update Fiat balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _fiat_currency, _fiat_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to create Fiat Journal credit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO fiat_journal (client_id, currency, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_fiat_id, _fiat_currency, - _fiat_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''sell_cryptocurrency: failed to create FTeX operations Fiat Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       COMMIT;
+--rollback     END;
+--rollback ';
+--rollback CREATE OR REPLACE PROCEDURE swap_cryptocurrency(
+--rollback     _transaction_id             UUID,
+--rollback     _client_id                  UUID,
+--rollback     _source_ticker              VARCHAR(6),
+--rollback     _source_debit_amount        NUMERIC(24,8),
+--rollback     _destination_ticker         VARCHAR(6),
+--rollback     _destination_credit_amount  NUMERIC(24,8)
+--rollback )
+--rollback LANGUAGE plpgsql
+--rollback AS '
+--rollback     DECLARE
+--rollback       source_balance        NUMERIC(24,8);  -- current balance of the source Crypto account.
+--rollback       destination_balance   NUMERIC(24,8);  -- current balance of the destination Crypto account.
+--rollback       current_timestamp     TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+--rollback       ftex_crypto_id        UUID;           -- FTeX Crypto operations account id.
+--rollback     BEGIN
+--rollback       -- Source and destination Cryptocurrencies must differ.
+--rollback       IF _source_ticker = _destination_ticker THEN
+--rollback          RAISE EXCEPTION ''swap_cryptocurrency: source and destination Cryptocurrencies must differ'';
+--rollback       END IF;
+--rollback
+--rollback       -- Generate the timestamp with timezone for this transaction.
+--rollback       SELECT NOW() INTO STRICT current_timestamp;
+--rollback
+--rollback       -- Get FTeX operations account ID.
+--rollback       SELECT client_id INTO STRICT ftex_crypto_id
+--rollback       FROM users
+--rollback       WHERE username = ''crypto-currencies'';
+--rollback
+--rollback       -- Row lock both Crypto accounts in ticker order, without locking the foreign keys, to avoid deadlocks.
+--rollback       PERFORM ca.balance
+--rollback       FROM crypto_accounts AS ca
+--rollback       WHERE ca.client_id = _client_id AND ca.ticker IN (_source_ticker, _destination_ticker)
+--rollback       ORDER BY ca.ticker
+--rollback       FOR NO KEY UPDATE;
+--rollback
+--rollback       SELECT ca.balance INTO STRICT source_balance
+--rollback       FROM crypto_accounts AS ca
+--rollback       WHERE ca.client_id = _client_id AND ca.ticker = _source_ticker
+--rollback       LIMIT 1;
+--rollback
+--rollback       SELECT ca.balance INTO STRICT destination_balance
+--rollback       FROM crypto_accounts AS ca
+--rollback       WHERE ca.client_id = _client_id AND ca.ticker = _destination_ticker
+--rollback       LIMIT 1;
+--rollback
+--rollback       -- Check for sufficient source Cryptocurrency balance to complete swap.
+--rollback       IF _source_debit_amount > source_balance THEN
+--rollback          RAISE EXCEPTION ''swap_cryptocurrency: insufficient Cryptocurrency funds, delta %'', source_balance - _source_debit_amount;
+--rollback       END IF;
+--rollback
+--rollback       -- Debit the source Crypto account and create the Crypto Journal entries for outflow from client to FTeX.
+--rollback       UPDATE crypto_accounts
+--rollback       SET balance = round_half_even(source_balance - _source_debit_amount, 8),
+--rollback           last_tx = - _source_debit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND ticker = _source_ticker;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''swap_cryptocurrency: failed to update source Crypto balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _source_ticker, - _source_debit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''swap_cryptocurrency: failed to create Crypto Journal debit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_crypto_id, _source_ticker, _source_debit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''swap_cryptocurrency: failed to create FTeX operations source Crypto Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       -- Credit the destination Crypto account and create the Crypto Journal entries for inflow to client from FTeX.
+--rollback       UPDATE crypto_accounts
+--rollback       SET balance = round_half_even(destination_balance + _destination_credit_amount, 8),
+--rollback           last_tx = _destination_credit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND ticker = _destination_ticker;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''swap_cryptocurrency: failed to update destination Crypto balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _destination_ticker, _destination_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''swap_cryptocurrency: failed to create Crypto Journal credit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_crypto_id, _destination_ticker, - _destination_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''swap_cryptocurrency: failed to create FTeX operations destination Crypto Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       COMMIT;
+--rollback     END;
+--rollback ';
+
+--changeset surahman:52
+--preconditions onFail:HALT onError:HALT
+--comment: Account event types for Fiat withdrawals, Fiat deposit reversals, and Cryptocurrency swaps. Enum values cannot be dropped.
+ALTER TYPE outbox_event_type ADD VALUE IF NOT EXISTS 'fiat_withdrawal';
+ALTER TYPE outbox_event_type ADD VALUE IF NOT EXISTS 'fiat_deposit_reversal';
+ALTER TYPE outbox_event_type ADD VALUE IF NOT EXISTS 'crypto_swap';
+--rollback not required
+
+--changeset surahman:53
+--preconditions onFail:HALT onError:HALT
+--comment: Swap Cryptocurrencies and publish the swap to the outbox in the same transaction.
+CREATE OR REPLACE PROCEDURE swap_cryptocurrency(
+    _transaction_id             UUID,
+    _client_id                  UUID,
+    _source_ticker              VARCHAR(6),
+    _source_debit_amount        NUMERIC(24,8),
+    _destination_ticker         VARCHAR(6),
+    _destination_credit_amount  NUMERIC(24,8),
+    _source_fee_amount          NUMERIC(24,8)
+)
+LANGUAGE plpgsql
+AS '
+    DECLARE
+      source_balance        NUMERIC(24,8);  -- current balance of the source Crypto account.
+      destination_balance   NUMERIC(24,8);  -- current balance of the destination Crypto account.
+      source_held           NUMERIC(24,8);  -- amount of the source Crypto account balance that is on hold.
+      source_status         ACCOUNT_STATUS; -- status of the source Crypto account.
+      destination_status    ACCOUNT_STATUS; -- status of the destination Crypto account.
+      current_timestamp     TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+      ftex_crypto_id        UUID;           -- FTeX Crypto operations account id.
+      ftex_fees_id          UUID;           -- FTeX fee revenue operations account id.
+    BEGIN
+      -- Source and destination Cryptocurrencies must differ.
+      IF _source_ticker = _destination_ticker THEN
+         RAISE EXCEPTION ''swap_cryptocurrency: source and destination Cryptocurrencies must differ'';
+      END IF;
+
+      -- The fee is collected from the source Cryptocurrency debit amount.
+      IF _source_fee_amount < 0 OR _source_fee_amount > _source_debit_amount THEN
+         RAISE EXCEPTION ''swap_cryptocurrency: invalid fee amount %'', _source_fee_amount;
+      END IF;
+
+      -- Generate the timestamp with timezone for this transaction.
+      SELECT NOW() INTO STRICT current_timestamp;
+
+      -- Get FTeX operations account IDs.
+      SELECT client_id INTO STRICT ftex_crypto_id
+      FROM users
+      WHERE username = ''crypto-currencies'';
+
+      SELECT client_id INTO STRICT ftex_fees_id
+      FROM users
+      WHERE username = ''fee-revenue'';
+
+      -- Row lock both Crypto accounts in ticker order, without locking the foreign keys, to avoid deadlocks.
+      PERFORM ca.balance
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker IN (_source_ticker, _destination_ticker)
+      ORDER BY ca.ticker
+      FOR NO KEY UPDATE;
+
+      SELECT ca.balance, ca.held, ca.status INTO STRICT source_balance, source_held, source_status
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _source_ticker
+      LIMIT 1;
+
+      SELECT ca.balance, ca.status INTO STRICT destination_balance, destination_status
+      FROM crypto_accounts AS ca
+      WHERE ca.client_id = _client_id AND ca.ticker = _destination_ticker
+      LIMIT 1;
+
+      -- Check that both accounts are active.
+      IF source_status <> ''active'' OR destination_status <> ''active'' THEN
+         RAISE EXCEPTION ''swap_cryptocurrency: accounts must be active, source %, destination %'', source_status, destination_status;
+      END IF;
+
+      -- Check for sufficient available source Cryptocurrency balance, excluding holds, to complete swap.
+      IF _source_debit_amount > source_balance - source_held THEN
+         RAISE EXCEPTION ''swap_cryptocurrency: insufficient Cryptocurrency funds, delta %'', source_balance - source_held - _source_debit_amount;
+      END IF;
+
+      -- Debit the source Crypto account and create the Crypto Journal entries for outflow from client to FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(source_balance - _source_debit_amount, 8),
+          last_tx = - _source_debit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _source_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to update source Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _source_ticker, - _source_debit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to create Crypto Journal debit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _source_ticker, _source_debit_amount - _source_fee_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to create FTeX operations source Crypto Journal entry'';
+      END IF;
+
+      -- Create the fee revenue Crypto Journal entry.
+      IF _source_fee_amount > 0 THEN
+        INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+        VALUES (ftex_fees_id, _source_ticker, _source_fee_amount, current_timestamp, _transaction_id);
+
+        IF NOT FOUND THEN
+          RAISE EXCEPTION ''swap_cryptocurrency: failed to create FTeX fee revenue Crypto Journal entry'';
+        END IF;
+      END IF;
+
+      -- Credit the destination Crypto account and create the Crypto Journal entries for inflow to client from FTeX.
+      UPDATE crypto_accounts
+      SET balance = round_half_even(destination_balance + _destination_credit_amount, 8),
+          last_tx = _destination_credit_amount,
+          last_tx_ts = current_timestamp
+      WHERE client_id = _client_id AND ticker = _destination_ticker;
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to update destination Crypto balance'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (_client_id, _destination_ticker, _destination_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to create Crypto Journal credit entry'';
+      END IF;
+
+      INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+      VALUES (ftex_crypto_id, _destination_ticker, - _destination_credit_amount, current_timestamp, _transaction_id);
+
+      IF NOT FOUND THEN
+        RAISE EXCEPTION ''swap_cryptocurrency: failed to create FTeX operations destination Crypto Journal entry'';
+      END IF;
+
+      -- Publish the swap to the transactional outbox for delivery to the client''s webhooks.
+      PERFORM outbox_publish(_client_id, ''crypto_swap'', jsonb_build_object(
+        ''txId'', _transaction_id,
+        ''clientId'', _client_id,
+        ''sourceTicker'', _source_ticker,
+        ''sourceAmount'', - _source_debit_amount,
+        ''destinationTicker'', _destination_ticker,
+        ''destinationAmount'', _destination_credit_amount,
+        ''fee'', _source_fee_amount,
+        ''transactedAt'', current_timestamp));
+
+      COMMIT;
+    END;
+';
+--rollback CREATE OR REPLACE PROCEDURE swap_cryptocurrency(
+--rollback     _transaction_id             UUID,
+--rollback     _client_id                  UUID,
+--rollback     _source_ticker              VARCHAR(6),
+--rollback     _source_debit_amount        NUMERIC(24,8),
+--rollback     _destination_ticker         VARCHAR(6),
+--rollback     _destination_credit_amount  NUMERIC(24,8),
+--rollback     _source_fee_amount          NUMERIC(24,8)
+--rollback )
+--rollback LANGUAGE plpgsql
+--rollback AS '
+--rollback     DECLARE
+--rollback       source_balance        NUMERIC(24,8);  -- current balance of the source Crypto account.
+--rollback       destination_balance   NUMERIC(24,8);  -- current balance of the destination Crypto account.
+--rollback       source_held           NUMERIC(24,8);  -- amount of the source Crypto account balance that is on hold.
+--rollback       source_status         ACCOUNT_STATUS; -- status of the source Crypto account.
+--rollback       destination_status    ACCOUNT_STATUS; -- status of the destination Crypto account.
+--rollback       current_timestamp     TIMESTAMPTZ;    -- current timestamp with timezone to be used as transaction timestamp.
+--rollback       ftex_crypto_id        UUID;           -- FTeX Crypto operations account id.
+--rollback       ftex_fees_id          UUID;           -- FTeX fee revenue operations account id.
+--rollback     BEGIN
+--rollback       -- Source and destination Cryptocurrencies must differ.
+--rollback       IF _source_ticker = _destination_ticker THEN
+--rollback          RAISE EXCEPTION ''swap_cryptocurrency: source and destination Cryptocurrencies must differ'';
+--rollback       END IF;
+--rollback
+--rollback       -- The fee is collected from the source Cryptocurrency debit amount.
+--rollback       IF _source_fee_amount < 0 OR _source_fee_amount > _source_debit_amount THEN
+--rollback          RAISE EXCEPTION ''swap_cryptocurrency: invalid fee amount %'', _source_fee_amount;
+--rollback       END IF;
+--rollback
+--rollback       -- Generate the timestamp with timezone for this transaction.
+--rollback       SELECT NOW() INTO STRICT current_timestamp;
+--rollback
+--rollback       -- Get FTeX operations account IDs.
+--rollback       SELECT client_id INTO STRICT ftex_crypto_id
+--rollback       FROM users
+--rollback       WHERE username = ''crypto-currencies'';
+--rollback
+--rollback       SELECT client_id INTO STRICT ftex_fees_id
+--rollback       FROM users
+--rollback       WHERE username = ''fee-revenue'';
+--rollback
+--rollback       -- Row lock both Crypto accounts in ticker order, without locking the foreign keys, to avoid deadlocks.
+--rollback       PERFORM ca.balance
+--rollback       FROM crypto_accounts AS ca
+--rollback       WHERE ca.client_id = _client_id AND ca.ticker IN (_source_ticker, _destination_ticker)
+--rollback       ORDER BY ca.ticker
+--rollback       FOR NO KEY UPDATE;
+--rollback
+--rollback       SELECT ca.balance, ca.held, ca.status INTO STRICT source_balance, source_held, source_status
+--rollback       FROM crypto_accounts AS ca
+--rollback       WHERE ca.client_id = _client_id AND ca.ticker = _source_ticker
+--rollback       LIMIT 1;
+--rollback
+--rollback       SELECT ca.balance, ca.status INTO STRICT destination_balance, destination_status
+--rollback       FROM crypto_accounts AS ca
+--rollback       WHERE ca.client_id = _client_id AND ca.ticker = _destination_ticker
+--rollback       LIMIT 1;
+--rollback
+--rollback       -- Check that both accounts are active.
+--rollback       IF source_status <> ''active'' OR destination_status <> ''active'' THEN
+--rollback          RAISE EXCEPTION ''swap_cryptocurrency: accounts must be active, source %, destination %'', source_status, destination_status;
+--rollback       END IF;
+--rollback
+--rollback       -- Check for sufficient available source Cryptocurrency balance, excluding holds, to complete swap.
+--rollback       IF _source_debit_amount > source_balance - source_held THEN
+--rollback          RAISE EXCEPTION ''swap_cryptocurrency: insufficient Cryptocurrency funds, delta %'', source_balance - source_held - _source_debit_amount;
+--rollback       END IF;
+--rollback
+--rollback       -- Debit the source Crypto account and create the Crypto Journal entries for outflow from client to FTeX.
+--rollback       UPDATE crypto_accounts
+--rollback       SET balance = round_half_even(source_balance - _source_debit_amount, 8),
+--rollback           last_tx = - _source_debit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND ticker = _source_ticker;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''swap_cryptocurrency: failed to update source Crypto balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _source_ticker, - _source_debit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''swap_cryptocurrency: failed to create Crypto Journal debit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_crypto_id, _source_ticker, _source_debit_amount - _source_fee_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''swap_cryptocurrency: failed to create FTeX operations source Crypto Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       -- Create the fee revenue Crypto Journal entry.
+--rollback       IF _source_fee_amount > 0 THEN
+--rollback         INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback         VALUES (ftex_fees_id, _source_ticker, _source_fee_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback         IF NOT FOUND THEN
+--rollback           RAISE EXCEPTION ''swap_cryptocurrency: failed to create FTeX fee revenue Crypto Journal entry'';
+--rollback         END IF;
+--rollback       END IF;
+--rollback
+--rollback       -- Credit the destination Crypto account and create the Crypto Journal entries for inflow to client from FTeX.
+--rollback       UPDATE crypto_accounts
+--rollback       SET balance = round_half_even(destination_balance + _destination_credit_amount, 8),
+--rollback           last_tx = _destination_credit_amount,
+--rollback           last_tx_ts = current_timestamp
+--rollback       WHERE client_id = _client_id AND ticker = _destination_ticker;
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''swap_cryptocurrency: failed to update destination Crypto balance'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (_client_id, _destination_ticker, _destination_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''swap_cryptocurrency: failed to create Crypto Journal credit entry'';
+--rollback       END IF;
+--rollback
+--rollback       INSERT INTO crypto_journal (client_id, ticker, amount, transacted_at, tx_id)
+--rollback       VALUES (ftex_crypto_id, _destination_ticker, - _destination_credit_amount, current_timestamp, _transaction_id);
+--rollback
+--rollback       IF NOT FOUND THEN
+--rollback         RAISE EXCEPTION ''swap_cryptocurrency: failed to create FTeX operations destination Crypto Journal entry'';
+--rollback       END IF;
+--rollback
+--rollback       COMMIT;
+--rollback     END;
+--rollback ';
@@ -3,3 +3,14 @@ CREATE TABLESPACE fiat_accounts_data LOCATION '/table_data/ftex_fiat_accounts';
 CREATE TABLESPACE fiat_journal_data LOCATION '/table_data/ftex_fiat_journal';
 CREATE TABLESPACE crypto_accounts_data LOCATION '/table_data/ftex_crypto_accounts';
 CREATE TABLESPACE crypto_journal_data LOCATION '/table_data/ftex_crypto_journal';
+CREATE TABLESPACE orders_data LOCATION '/table_data/ftex_orders';
+CREATE TABLESPACE schedules_data LOCATION '/table_data/ftex_schedules';
+CREATE TABLESPACE rates_data LOCATION '/table_data/ftex_rates';
+CREATE TABLESPACE trades_data LOCATION '/table_data/ftex_trades';
+CREATE TABLESPACE mfa_enrollments_data LOCATION '/table_data/ftex_mfa_enrollments';
+CREATE TABLESPACE api_keys_data LOCATION '/table_data/ftex_api_keys';
+CREATE TABLESPACE fiat_reversals_data LOCATION '/table_data/ftex_fiat_reversals';
+CREATE TABLESPACE login_events_data LOCATION '/table_data/ftex_login_events';
+CREATE TABLESPACE webhooks_data LOCATION '/table_data/ftex_webhooks';
+CREATE TABLESPACE outbox_data LOCATION '/table_data/ftex_outbox';
+CREATE TABLESPACE webhook_deliveries_data LOCATION '/table_data/ftex_webhook_deliveries';
@@ -6,12 +6,17 @@ import (
 
 	"github.com/spf13/afero"
 	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/events"
 	"github.com/surahman/FTeX/pkg/graphql"
 	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/notifier"
+	"github.com/surahman/FTeX/pkg/orders"
 	"github.com/surahman/FTeX/pkg/postgres"
 	"github.com/surahman/FTeX/pkg/quotes"
 	"github.com/surahman/FTeX/pkg/redis"
 	"github.com/surahman/FTeX/pkg/rest"
+	"github.com/surahman/FTeX/pkg/schedules"
+	"github.com/surahman/FTeX/pkg/webhooks"
 	_ "go.uber.org/automaxprocs"
 	"go.uber.org/zap"
 )
@@ -39,9 +44,14 @@ func main() {
 		cache           redis.Redis
 		cleanup         callbacks
 		database        postgres.Postgres
+		dispatcher      *webhooks.Dispatcher
 		err             error
+		eventRelay      *events.Relay
 		logging         *logger.Logger
+		notifications   notifier.Notifier
 		conversionRates quotes.Quotes
+		orderMatcher    *orders.Matcher
+		scheduler       *schedules.Scheduler
 		serverGraphQL   *graphql.Server
 		serverREST      *rest.Server
 		waitGroup       sync.WaitGroup
@@ -83,7 +93,7 @@ func main() {
 	cleanup.add(cache.Close)
 
 	// Quotes setup.
-	if conversionRates, err = quotes.NewQuote(&fs, logging); err != nil {
+	if conversionRates, err = quotes.NewQuote(&fs, cache, database, logging); err != nil {
 		cleanup.callback(logging)
 		logging.Panic("failed to configure Quotes module", zap.Error(err))
 	}
@@ -94,6 +104,12 @@ func main() {
 		logging.Panic("failed to configure authorization module", zap.Error(err))
 	}
 
+	// Notifier setup.
+	if notifications, err = notifier.NewNotifier(&fs, logging); err != nil {
+		cleanup.callback(logging)
+		logging.Panic("failed to configure notifier module", zap.Error(err))
+	}
+
 	// Setup is completed. Configure the cleanup callbacks to be executed on shutdown/exit.
 	defer cleanup.callback(logging)
 
@@ -101,7 +117,7 @@ func main() {
 	waitGroup.Add(1)
 
 	if serverREST, err = rest.
-		NewServer(&fs, authorization, database, cache, conversionRates, logging, &waitGroup); err != nil {
+		NewServer(&fs, authorization, database, cache, conversionRates, notifications, logging, &waitGroup); err != nil {
 		logging.Panic("failed to create the REST server", zap.Error(err))
 	}
 
@@ -111,11 +127,47 @@ func main() {
 	waitGroup.Add(1)
 
 	if serverGraphQL, err = graphql.
-		NewServer(&fs, authorization, database, cache, conversionRates, logging, &waitGroup); err != nil {
+		NewServer(&fs, authorization, database, cache, conversionRates, notifications, logging, &waitGroup); err != nil {
 		logging.Panic("failed to create the GraphQL server", zap.Error(err))
 	}
 
 	go serverGraphQL.Run()
 
+	// Setup limit order matcher and start it.
+	waitGroup.Add(1)
+
+	if orderMatcher, err = orders.NewMatcher(database, conversionRates, logging, &waitGroup); err != nil {
+		logging.Panic("failed to create the limit order matcher", zap.Error(err))
+	}
+
+	go orderMatcher.Run()
+
+	// Setup recurring purchase scheduler and start it.
+	waitGroup.Add(1)
+
+	if scheduler, err = schedules.NewScheduler(database, conversionRates, logging, &waitGroup); err != nil {
+		logging.Panic("failed to create the recurring purchase scheduler", zap.Error(err))
+	}
+
+	go scheduler.Run()
+
+	// Setup webhook dispatcher and start it.
+	waitGroup.Add(1)
+
+	if dispatcher, err = webhooks.NewDispatcher(database, authorization, logging, &waitGroup); err != nil {
+		logging.Panic("failed to create the webhook dispatcher", zap.Error(err))
+	}
+
+	go dispatcher.Run()
+
+	// Setup account event relay and start it.
+	waitGroup.Add(1)
+
+	if eventRelay, err = events.NewRelay(database, cache, logging, &waitGroup); err != nil {
+		logging.Panic("failed to create the account event relay", zap.Error(err))
+	}
+
+	go eventRelay.Run()
+
 	waitGroup.Wait()
 }
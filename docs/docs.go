@@ -30,14 +30,85 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/crypto/exchange/": {
+        "/admin/accounts/{clientID}/crypto/balance/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves all the Cryptocurrency balances for a client. Pagination follows the client's own balance endpoint. Requires the administrator role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin crypto cryptocurrency balance"
+                ],
+                "summary": "Retrieve all the Cryptocurrency balances for a client.",
+                "operationId": "adminBalanceCryptoPaginated",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the Client ID of the account holder",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to with a page of account balances for the client's accounts",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/accounts/{clientID}/crypto/hold": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Purchase or sell a Cryptocurrency to/from a Fiat currency accounts. The Offer ID must be valid and have expired.",
+                "description": "Places a hold on funds in a user's Cryptocurrency account. Funds on hold remain in the balance but cannot be sold or swapped, and the total on hold cannot exceed the balance. Requires the administrator role.",
                 "consumes": [
                     "application/json"
                 ],
@@ -45,24 +116,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "crypto fiat currency cryptocurrency exchange convert offer transfer execute"
+                    "admin crypto hold"
                 ],
-                "summary": "Transfer funds between Fiat and Crypto accounts using a valid Offer ID.",
-                "operationId": "exchangeCrypto",
+                "summary": "Place a hold on funds in a Cryptocurrency account.",
+                "operationId": "adminHoldCrypto",
                 "parameters": [
                     {
-                        "description": "the two currency codes and amount to be converted",
-                        "name": "offerID",
+                        "type": "string",
+                        "description": "the Client ID of the account holder",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the Cryptocurrency ticker and the amount to place on hold",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.HTTPTransferRequest"
+                            "$ref": "#/definitions/models.HTTPAccountHoldRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "a message to confirm the conversion of funds",
+                        "description": "a message to confirm the hold update with the account balance in the payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -79,7 +157,7 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
-                    "408": {
+                    "409": {
                         "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
@@ -94,14 +172,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/crypto/info/balance": {
-            "get": {
+        "/admin/accounts/{clientID}/crypto/release": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieves all the Cryptocurrency balances for a specific client. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
+                "description": "Releases funds on hold in a user's Cryptocurrency account so they are available again. The amount released cannot exceed the funds on hold. Requires the administrator role.",
                 "consumes": [
                     "application/json"
                 ],
@@ -109,27 +187,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "crypto cryptocurrency currency balance"
+                    "admin crypto hold"
                 ],
-                "summary": "Retrieve all the Cryptocurrency balances for a specific client.",
-                "operationId": "balanceCurrencyCryptoPaginated",
+                "summary": "Release a hold on funds in a Cryptocurrency account.",
+                "operationId": "adminReleaseCrypto",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "The page cursor into the query results records.",
-                        "name": "pageCursor",
-                        "in": "query"
+                        "description": "the Client ID of the account holder",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "The number of records to retrieve on this page.",
-                        "name": "pageSize",
-                        "in": "query"
+                        "description": "the Cryptocurrency ticker and the amount to release",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPAccountHoldRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "a message to with a page of account balances for the client's accounts",
+                        "description": "a message to confirm the hold update with the account balance in the payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -146,7 +228,7 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
-                    "404": {
+                    "409": {
                         "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
@@ -161,14 +243,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/crypto/info/balance/{ticker}": {
-            "get": {
+        "/admin/accounts/{clientID}/crypto/status": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieves the balance for a specific Cryptocurrency. The currency ticker must be supplied as a query parameter.",
+                "description": "Sets the status of a user's Cryptocurrency account to active, frozen, or closed. Accounts that are not active cannot send or receive funds. Requires the administrator role.",
                 "consumes": [
                     "application/json"
                 ],
@@ -176,22 +258,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "crypto cryptocurrency currency balance"
+                    "admin crypto status"
                 ],
-                "summary": "Retrieve balance for a specific Cryptocurrency.",
-                "operationId": "balanceCurrencyCrypto",
+                "summary": "Set the status of a Cryptocurrency account.",
+                "operationId": "adminSetStatusCrypto",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the Cryptocurrency ticker to retrieve the balance for",
-                        "name": "ticker",
+                        "description": "the Client ID of the account holder",
+                        "name": "clientID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "the Cryptocurrency ticker and the new account status",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPAccountStatusRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "the details for a specific currency account",
+                        "description": "a message to confirm the status update with the account balance in the payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -223,29 +314,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/crypto/info/transaction/all/{ticker}/": {
+        "/admin/accounts/{clientID}/crypto/transactions/{ticker}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieves all the transaction details for currency a specific client during the specified month. The initial request will contain (optionally) the page size and, month, year, and timezone (option, defaults to UTC). Subsequent requests will require a cursors to the next page that will be returned in the previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves the Journal entries for a client's Cryptocurrency account during the specified month. Pagination follows the client's own transactions endpoint. Requires the administrator role.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "crypto cryptocurrency currency transaction"
+                    "admin crypto cryptocurrency transaction"
                 ],
-                "summary": "Retrieve all the transactions for a currency account for a specific client during a specified month.",
-                "operationId": "txDetailsCryptoPaginated",
+                "summary": "Retrieve all the transactions for a client's Cryptocurrency account during a specified month.",
+                "operationId": "adminTxDetailsCryptoPaginated",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the currency ticker to retrieve the transaction details for.",
+                        "description": "the Client ID of the account holder",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the Cryptocurrency ticker to retrieve the transaction details for.",
                         "name": "ticker",
                         "in": "path",
                         "required": true
@@ -283,7 +378,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "a message to confirm the conversion of funds",
+                        "description": "a page of the account's transactions",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -321,37 +416,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/crypto/info/transaction/{transactionID}": {
+        "/admin/accounts/{clientID}/fiat/balance/": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieves the transaction details for a specific transactionID. The transaction ID must be supplied as a query parameter.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves all the currency balances for a client. Pagination follows the client's own balance endpoint. Requires the administrator role.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "crypto cryptocurrency transactionID transaction details"
+                    "admin fiat currency balance"
                 ],
-                "summary": "Retrieve transaction details for a specific transactionID.",
-                "operationId": "txDetailsCrypto",
+                "summary": "Retrieve all the currency balances for a client.",
+                "operationId": "adminBalanceFiatPaginated",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the transaction ID to retrieve the details for",
-                        "name": "transactionID",
+                        "description": "the Client ID of the account holder",
+                        "name": "clientID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "the transaction details for a specific transaction ID",
+                        "description": "a message to with a page of account balances for the client's accounts",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -383,14 +487,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/crypto/offer": {
+        "/admin/accounts/{clientID}/fiat/hold": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Purchase or sell a Fiat currency using a Cryptocurrency. The amount must be a positive number with at most two or eight decimal places for Fiat and Cryptocurrencies respectively. Both currency accounts must be opened beforehand.",
+                "description": "Places a hold on funds in a user's Fiat currency account. Funds on hold remain in the balance but cannot be withdrawn, transferred, or exchanged, and the total on hold cannot exceed the balance. Requires the administrator role.",
                 "consumes": [
                     "application/json"
                 ],
@@ -398,24 +502,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "fiat crypto cryptocurrency currency sell sale offer"
+                    "admin fiat hold"
                 ],
-                "summary": "Purchase or sell a Cryptocurrency and using a Fiat currency.",
-                "operationId": "sellOfferCrypto",
+                "summary": "Place a hold on funds in a Fiat currency account.",
+                "operationId": "adminHoldFiat",
                 "parameters": [
                     {
-                        "description": "the Cryptocurrency ticker, Fiat currency code, and amount to be converted in the source currency",
+                        "type": "string",
+                        "description": "the Client ID of the account holder",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the Fiat currency code and the amount to place on hold",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.HTTPCryptoOfferRequest"
+                            "$ref": "#/definitions/models.HTTPAccountHoldRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "a message to confirm the purchase rate for a Fiat or Cryptocurrency",
+                        "description": "a message to confirm the hold update with the account balance in the payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -432,6 +543,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "error message with any available details in payload",
                         "schema": {
@@ -441,14 +558,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/crypto/open": {
+        "/admin/accounts/{clientID}/fiat/release": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Creates a Cryptocurrency account for a specified ticker, to be provided as the currency in the request, for a user by creating a row in the Crypto Accounts table.",
+                "description": "Releases funds on hold in a user's Fiat currency account so they are available again. The amount released cannot exceed the funds on hold. Requires the administrator role.",
                 "consumes": [
                     "application/json"
                 ],
@@ -456,24 +573,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "crypto cryptocurrency currency open"
+                    "admin fiat hold"
                 ],
-                "summary": "Open a Cryptocurrency account.",
-                "operationId": "openCrypto",
+                "summary": "Release a hold on funds in a Fiat currency account.",
+                "operationId": "adminReleaseFiat",
                 "parameters": [
                     {
-                        "description": "cryptocurrency ticker for new account",
+                        "type": "string",
+                        "description": "the Client ID of the account holder",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the Fiat currency code and the amount to release",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.HTTPOpenCurrencyAccountRequest"
+                            "$ref": "#/definitions/models.HTTPAccountHoldRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "a message to confirm the creation of an account",
+                    "200": {
+                        "description": "a message to confirm the hold update with the account balance in the payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -490,6 +614,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "error message with any available details in payload",
                         "schema": {
@@ -499,39 +629,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/fiat/deposit": {
+        "/admin/accounts/{clientID}/fiat/reverse/{transactionID}": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Deposit funds into a Fiat account in a specific currency for a user. The amount must be a positive number with at most two decimal places.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Reverses an erroneous Fiat deposit by posting compensating Journal entries that withdraw the deposited amount. The deposit itself is never edited or removed, and can only be reversed once. The account must have a sufficient balance to cover the reversal. Requires the administrator role.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "fiat currency deposit"
+                    "admin fiat currency deposit reverse"
                 ],
-                "summary": "Deposit funds into a Fiat account.",
-                "operationId": "depositFiat",
+                "summary": "Reverse a Fiat deposit.",
+                "operationId": "adminReverseDeposit",
                 "parameters": [
                     {
-                        "description": "currency code and amount to be deposited",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.HTTPDepositCurrencyRequest"
-                        }
+                        "type": "string",
+                        "description": "the Client ID of the account holder",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the transaction ID of the deposit to reverse",
+                        "name": "transactionID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "a message to confirm the deposit of funds",
+                        "description": "a message to confirm the reversal with the reversal transaction receipt in the payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -548,6 +680,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "error message with any available details in payload",
                         "schema": {
@@ -557,14 +701,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/fiat/exchange/offer": {
+        "/admin/accounts/{clientID}/fiat/status": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Exchange quote for Fiat funds between two Fiat currencies. The amount must be a positive number with at most two decimal places and both currency accounts must be opened.",
+                "description": "Sets the status of a user's Fiat currency account to active, frozen, or closed. Accounts that are not active cannot send or receive funds. Requires the administrator role.",
                 "consumes": [
                     "application/json"
                 ],
@@ -572,24 +716,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "fiat currency exchange convert offer transfer"
+                    "admin fiat status"
                 ],
-                "summary": "Exchange quote for Fiat funds between two Fiat currencies.",
-                "operationId": "exchangeOfferFiat",
+                "summary": "Set the status of a Fiat currency account.",
+                "operationId": "adminSetStatusFiat",
                 "parameters": [
                     {
-                        "description": "the two currency code and amount to be converted",
+                        "type": "string",
+                        "description": "the Client ID of the account holder",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the Fiat currency code and the new account status",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.HTTPExchangeOfferRequest"
+                            "$ref": "#/definitions/models.HTTPAccountStatusRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "a message to confirm the conversion rate for a currency",
+                        "description": "a message to confirm the status update with the account balance in the payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -606,48 +757,86 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
-                    "500": {
+                    "404": {
                         "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
                         }
-                    }
-                }
-            }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
         },
-        "/fiat/exchange/transfer": {
-            "post": {
+        "/admin/accounts/{clientID}/fiat/transactions/{currencyCode}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Transfer Fiat funds between two Fiat currencies. The Offer ID must be valid and have expired.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves the Journal entries for a client's currency account during the specified month. Pagination follows the client's own transactions endpoint. Requires the administrator role.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "fiat currency exchange convert offer transfer execute"
+                    "admin fiat currency transaction"
                 ],
-                "summary": "Transfer Fiat funds between two Fiat currencies using a valid Offer ID.",
-                "operationId": "exchangeTransferFiat",
+                "summary": "Retrieve all the transactions for a client's currency account during a specified month.",
+                "operationId": "adminTxDetailsFiatPaginated",
                 "parameters": [
                     {
-                        "description": "the two currency codes and amount to be converted",
-                        "name": "offerID",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.HTTPTransferRequest"
-                        }
+                        "type": "string",
+                        "description": "the Client ID of the account holder",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the currency code to retrieve the transaction details for.",
+                        "name": "currencyCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The timezone for the month in question.",
+                        "name": "timezone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The month for which transaction records are being requested.",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The year for the month for which transaction records are being requested.",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "a message to confirm the conversion of funds",
+                        "description": "a page of the account's transactions",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -664,7 +853,13 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
-                    "408": {
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "416": {
                         "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
@@ -679,42 +874,34 @@ const docTemplate = `{
                 }
             }
         },
-        "/fiat/info/balance": {
-            "get": {
+        "/admin/accounts/{clientID}/freeze": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieves all the currency balances for a specific client. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Freezes a user's account. Frozen accounts cannot trade, deposit, or withdraw funds, and their open limit orders and recurring purchase schedules are not executed. Balances and transactions can still be viewed. Requires the administrator role.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "fiat currency balance"
+                    "admin user users freeze"
                 ],
-                "summary": "Retrieve all the currency balances for a specific client.",
-                "operationId": "balanceCurrencyFiatPaginated",
+                "summary": "Freeze a user's account.",
+                "operationId": "adminFreezeAccount",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "The page cursor into the query results records.",
-                        "name": "pageCursor",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "The number of records to retrieve on this page.",
-                        "name": "pageSize",
-                        "in": "query"
+                        "description": "the Client ID of the account holder",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "a message to with a page of account balances for the client's accounts",
+                        "description": "a message to confirm the account has been frozen",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -746,37 +933,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/fiat/info/balance/{ticker}": {
+        "/admin/accounts/{clientID}/transaction/{transactionID}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieves the balance for a specific Fiat currency. The currency ticker must be supplied as a query parameter.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves the Fiat and Cryptocurrency Journal entries for a client's transaction. Requires the administrator role.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "fiat currency balance"
+                    "admin transaction"
                 ],
-                "summary": "Retrieve balance for a specific Fiat currency.",
-                "operationId": "balanceCurrencyFiat",
+                "summary": "Retrieve the Journal entries for a client's transaction.",
+                "operationId": "adminTxDetails",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the currency ticker to retrieve the balance for",
-                        "name": "ticker",
+                        "description": "the Client ID of the account holder",
+                        "name": "clientID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the transaction ID to retrieve the Journal entries for",
+                        "name": "transactionID",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "the details for a specific currency account",
+                        "description": "the transaction's Journal entries in the payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -808,67 +999,34 @@ const docTemplate = `{
                 }
             }
         },
-        "/fiat/info/transaction/all/{currencyCode}/": {
-            "get": {
+        "/admin/accounts/{clientID}/unfreeze": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieves all the transaction details for currency a specific client during the specified month. The initial request will contain (optionally) the page size and, month, year, and timezone (option, defaults to UTC). Subsequent requests will require a cursors to the next page that will be returned in the previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Unfreezes a user's account so that it can trade, deposit, and withdraw funds again. Requires the administrator role.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "fiat currency transaction"
+                    "admin user users freeze"
                 ],
-                "summary": "Retrieve all the transactions for a currency account for a specific client during a specified month.",
-                "operationId": "txDetailsCurrencyFiatPaginated",
+                "summary": "Unfreeze a user's account.",
+                "operationId": "adminUnfreezeAccount",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the currency code to retrieve the transaction details for.",
-                        "name": "currencyCode",
+                        "description": "the Client ID of the account holder",
+                        "name": "clientID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "The page cursor into the query results records.",
-                        "name": "pageCursor",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "The timezone for the month in question.",
-                        "name": "timezone",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "The month for which transaction records are being requested.",
-                        "name": "month",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "The year for the month for which transaction records are being requested.",
-                        "name": "year",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "The number of records to retrieve on this page.",
-                        "name": "pageSize",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "a message to confirm the conversion of funds",
+                        "description": "a message to confirm the account has been unfrozen",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -891,12 +1049,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
-                    "416": {
-                        "description": "error message with any available details in payload",
-                        "schema": {
-                            "$ref": "#/definitions/models.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "error message with any available details in payload",
                         "schema": {
@@ -906,47 +1058,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/fiat/info/transaction/{transactionID}": {
+        "/admin/users/{username}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieves the transaction details for a specific transactionID. The transaction ID must be supplied as a query parameter.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves the Client ID, name, email address, role, and frozen and deleted statuses of a user's account. Requires the administrator role.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "fiat transactionID transaction details"
+                    "admin user users"
                 ],
-                "summary": "Retrieve transaction details for a specific transactionID.",
-                "operationId": "txDetailsCurrencyFiat",
+                "summary": "Retrieve a user's account information.",
+                "operationId": "adminUser",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the transaction ID to retrieve the details for",
-                        "name": "transactionID",
+                        "description": "the username of the user to retrieve",
+                        "name": "username",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "the transaction details for a specific transaction ID",
+                        "description": "the user's account information in the payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
                     },
-                    "400": {
-                        "description": "error message with any available details in payload",
-                        "schema": {
-                            "$ref": "#/definitions/models.HTTPError"
-                        }
-                    },
                     "403": {
                         "description": "error message with any available details in payload",
                         "schema": {
@@ -968,14 +1111,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/fiat/open": {
+        "/crypto/exchange/": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
                     }
                 ],
-                "description": "Creates a Fiat account for a specific currency for a user by creating a row in the Fiat Accounts table.",
+                "description": "Purchase or sell a Cryptocurrency to/from a Fiat currency accounts. The Offer ID must be valid and have expired.",
                 "consumes": [
                     "application/json"
                 ],
@@ -983,24 +1129,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "fiat currency open"
+                    "crypto fiat currency cryptocurrency exchange convert offer transfer execute"
                 ],
-                "summary": "Open a Fiat account.",
-                "operationId": "openFiat",
+                "summary": "Transfer funds between Fiat and Crypto accounts using a valid Offer ID.",
+                "operationId": "exchangeCrypto",
                 "parameters": [
                     {
-                        "description": "currency code for new account",
-                        "name": "request",
+                        "description": "the two currency codes and amount to be converted",
+                        "name": "offerID",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.HTTPOpenCurrencyAccountRequest"
+                            "$ref": "#/definitions/models.HTTPTransferRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "unique key used to safely retry the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "one-time password when step-up multifactor authentication is required",
+                        "name": "X-MFA-Code",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "a message to confirm the creation of an account",
+                    "200": {
+                        "description": "a message to confirm the conversion of funds",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -1017,35 +1175,26 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
-                    "500": {
+                    "408": {
                         "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
                         }
-                    }
-                }
-            }
-        },
-        "/health": {
-            "get": {
-                "description": "This endpoint is exposed to allow load balancers etc. to check the health of the service.\nThis is achieved by the service pinging the data tier comprised of Postgres and Redis.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "health healthcheck liveness"
-                ],
-                "summary": "Healthcheck for service liveness.",
-                "operationId": "healthcheck",
-                "responses": {
-                    "200": {
-                        "description": "message: healthy",
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
                         "schema": {
-                            "$ref": "#/definitions/models.HTTPSuccess"
+                            "$ref": "#/definitions/models.HTTPError"
                         }
                     },
-                    "503": {
-                        "description": "error message with any available details",
+                    "422": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
                         }
@@ -1053,14 +1202,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/delete": {
-            "delete": {
+        "/crypto/info/balance": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
                     }
                 ],
-                "description": "Deletes a user stored in the database by marking it as deleted. The user must supply their login credentials as well as complete the following confirmation message:\n\"I understand the consequences, delete my user account USERNAME HERE\"",
+                "description": "Retrieves all the Cryptocurrency balances for a specific client. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1068,10 +1220,2254 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user users delete security"
+                    "crypto cryptocurrency currency balance"
                 ],
-                "summary": "Deletes a user. The user must supply their credentials as well as a confirmation message.",
-                "operationId": "deleteUser",
+                "summary": "Retrieve all the Cryptocurrency balances for a specific client.",
+                "operationId": "balanceCurrencyCryptoPaginated",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to with a page of account balances for the client's accounts",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/info/balance/{ticker}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Retrieves the balance for a specific Cryptocurrency. The currency ticker must be supplied as a query parameter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency currency balance"
+                ],
+                "summary": "Retrieve balance for a specific Cryptocurrency.",
+                "operationId": "balanceCurrencyCrypto",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the Cryptocurrency ticker to retrieve the balance for",
+                        "name": "ticker",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the details for a specific currency account",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/info/transaction/all/{ticker}/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Retrieves all the transaction details for currency a specific client during the specified month. The initial request will contain (optionally) the page size and, month, year, and timezone (option, defaults to UTC). Subsequent requests will require a cursors to the next page that will be returned in the previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency currency transaction"
+                ],
+                "summary": "Retrieve all the transactions for a currency account for a specific client during a specified month.",
+                "operationId": "txDetailsCryptoPaginated",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the currency ticker to retrieve the transaction details for.",
+                        "name": "ticker",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The timezone for the month in question.",
+                        "name": "timezone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The month for which transaction records are being requested.",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The year for the month for which transaction records are being requested.",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the conversion of funds",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "416": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/info/transaction/{transactionID}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Retrieves the transaction details for a specific transactionID. The transaction ID must be supplied as a query parameter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency transactionID transaction details"
+                ],
+                "summary": "Retrieve transaction details for a specific transactionID.",
+                "operationId": "txDetailsCrypto",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the transaction ID to retrieve the details for",
+                        "name": "transactionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the transaction details for a specific transaction ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/offer": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Purchase or sell a Fiat currency using a Cryptocurrency. The amount must be a positive number with at most two or eight decimal places for Fiat and Cryptocurrencies respectively. Both currency accounts must be opened beforehand.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat crypto cryptocurrency currency sell sale offer"
+                ],
+                "summary": "Purchase or sell a Cryptocurrency and using a Fiat currency.",
+                "operationId": "sellOfferCrypto",
+                "parameters": [
+                    {
+                        "description": "the Cryptocurrency ticker, Fiat currency code, and amount to be converted in the source currency",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPCryptoOfferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the purchase rate for a Fiat or Cryptocurrency",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/open": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Creates a Cryptocurrency account for a specified ticker, to be provided as the currency in the request, for a user by creating a row in the Crypto Accounts table.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency currency open"
+                ],
+                "summary": "Open a Cryptocurrency account.",
+                "operationId": "openCrypto",
+                "parameters": [
+                    {
+                        "description": "cryptocurrency ticker for new account",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPOpenCurrencyAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "a message to confirm the creation of an account",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/swap/exchange": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Swap a source Cryptocurrency for a destination Cryptocurrency. The Offer ID must be valid and have not expired.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency swap exchange convert offer transfer execute"
+                ],
+                "summary": "Transfer funds between two Crypto accounts using a valid swap Offer ID.",
+                "operationId": "exchangeSwapCrypto",
+                "parameters": [
+                    {
+                        "description": "the swap offer ID",
+                        "name": "offerID",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPTransferRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "unique key used to safely retry the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "one-time password when step-up multifactor authentication is required",
+                        "name": "X-MFA-Code",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the swap of funds",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "408": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/crypto/swap/offer": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Swap a source Cryptocurrency for a destination Cryptocurrency. The amount must be a positive number with at most eight decimal places. Both currency accounts must be opened beforehand.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crypto cryptocurrency currency swap offer"
+                ],
+                "summary": "Swap a Cryptocurrency for another Cryptocurrency.",
+                "operationId": "swapOfferCrypto",
+                "parameters": [
+                    {
+                        "description": "the source and destination Cryptocurrency tickers, and amount to be converted in the source currency",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPExchangeOfferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the swap rate for a Cryptocurrency",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/events": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Streams the deposits, currency exchanges, transfers, and Cryptocurrency purchases and sales on a client's accounts as Server-Sent Events as they are committed. Each event's id is its position in the client's event log, its event is the event type, and its data is the JSON event. A stream that is reconnected with the Last-Event-ID header will resume after that event, provided it is still retained in the event log. Only the most recent events are retained, for up to a day. Heartbeat comments are sent on idle streams, and streams opened with a JWT are closed when it expires. Events are delivered at least once and should be deduplicated with the eventId in the data.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "events stream"
+                ],
+                "summary": "Stream account events.",
+                "operationId": "events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the id of the last event received, to resume the stream after",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a stream of account events",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/deposit": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Deposit funds into a Fiat account in a specific currency for a user. The amount must be a positive number with at most two decimal places.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency deposit"
+                ],
+                "summary": "Deposit funds into a Fiat account.",
+                "operationId": "depositFiat",
+                "parameters": [
+                    {
+                        "description": "currency code and amount to be deposited",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPDepositCurrencyRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "unique key used to safely retry the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the deposit of funds",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/exchange/offer": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Exchange quote for Fiat funds between two Fiat currencies. The amount must be a positive number with at most two decimal places and both currency accounts must be opened.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency exchange convert offer transfer"
+                ],
+                "summary": "Exchange quote for Fiat funds between two Fiat currencies.",
+                "operationId": "exchangeOfferFiat",
+                "parameters": [
+                    {
+                        "description": "the two currency code and amount to be converted",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPExchangeOfferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the conversion rate for a currency",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/exchange/transfer": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Transfer Fiat funds between two Fiat currencies. The Offer ID must be valid and have expired.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency exchange convert offer transfer execute"
+                ],
+                "summary": "Transfer Fiat funds between two Fiat currencies using a valid Offer ID.",
+                "operationId": "exchangeTransferFiat",
+                "parameters": [
+                    {
+                        "description": "the two currency codes and amount to be converted",
+                        "name": "offerID",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPTransferRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "unique key used to safely retry the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "one-time password when step-up multifactor authentication is required",
+                        "name": "X-MFA-Code",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the conversion of funds",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "408": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/info/balance": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Retrieves all the currency balances for a specific client. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency balance"
+                ],
+                "summary": "Retrieve all the currency balances for a specific client.",
+                "operationId": "balanceCurrencyFiatPaginated",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to with a page of account balances for the client's accounts",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/info/balance/{ticker}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Retrieves the balance for a specific Fiat currency. The currency ticker must be supplied as a query parameter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency balance"
+                ],
+                "summary": "Retrieve balance for a specific Fiat currency.",
+                "operationId": "balanceCurrencyFiat",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the currency ticker to retrieve the balance for",
+                        "name": "ticker",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the details for a specific currency account",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/info/transaction/all/{currencyCode}/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Retrieves all the transaction details for currency a specific client during the specified month. The initial request will contain (optionally) the page size and, month, year, and timezone (option, defaults to UTC). Subsequent requests will require a cursors to the next page that will be returned in the previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency transaction"
+                ],
+                "summary": "Retrieve all the transactions for a currency account for a specific client during a specified month.",
+                "operationId": "txDetailsCurrencyFiatPaginated",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the currency code to retrieve the transaction details for.",
+                        "name": "currencyCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The timezone for the month in question.",
+                        "name": "timezone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The month for which transaction records are being requested.",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The year for the month for which transaction records are being requested.",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the conversion of funds",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "416": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/info/transaction/{transactionID}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Retrieves the transaction details for a specific transactionID. The transaction ID must be supplied as a query parameter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat transactionID transaction details"
+                ],
+                "summary": "Retrieve transaction details for a specific transactionID.",
+                "operationId": "txDetailsCurrencyFiat",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the transaction ID to retrieve the details for",
+                        "name": "transactionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the transaction details for a specific transaction ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/open": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Creates a Fiat account for a specific currency for a user by creating a row in the Fiat Accounts table.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency open"
+                ],
+                "summary": "Open a Fiat account.",
+                "operationId": "openFiat",
+                "parameters": [
+                    {
+                        "description": "currency code for new account",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPOpenCurrencyAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "a message to confirm the creation of an account",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/transfer/p2p": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Transfer Fiat funds to another client's account in the same currency. The recipient is identified by their username and must have an open account in the currency. The amount must be a positive number with at most two decimal places.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency transfer p2p peer"
+                ],
+                "summary": "Transfer Fiat funds to another client.",
+                "operationId": "transferP2PFiat",
+                "parameters": [
+                    {
+                        "description": "recipient username, currency code, and amount to be transferred",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPFiatP2PTransferRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "unique key used to safely retry the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "one-time password when step-up multifactor authentication is required",
+                        "name": "X-MFA-Code",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the transfer of funds",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fiat/withdraw": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Withdraw funds from a Fiat account in a specific currency for a user. The amount must be a positive number with at most two decimal places and cannot exceed the account balance.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fiat currency withdraw"
+                ],
+                "summary": "Withdraw funds from a Fiat account.",
+                "operationId": "withdrawFiat",
+                "parameters": [
+                    {
+                        "description": "currency code and amount to be withdrawn",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPWithdrawCurrencyRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "unique key used to safely retry the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "one-time password when step-up multifactor authentication is required",
+                        "name": "X-MFA-Code",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the withdrawal of funds",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "This endpoint is exposed to allow load balancers etc. to check the health of the service.\nThis is achieved by the service pinging the data tier comprised of Postgres and Redis.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health healthcheck liveness"
+                ],
+                "summary": "Healthcheck for service liveness.",
+                "operationId": "healthcheck",
+                "responses": {
+                    "200": {
+                        "description": "message: healthy",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "503": {
+                        "description": "error message with any available details",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/cancel/{orderID}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Cancel an open limit order. Orders that are being executed, filled, cancelled, or have failed cannot be cancelled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders limit cancel"
+                ],
+                "summary": "Cancel an open limit order.",
+                "operationId": "cancelOrder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the order ID of the open limit order to cancel",
+                        "name": "orderID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the cancellation of the order",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/info": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Retrieves all the limit orders for a specific client, newest first. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders limit info"
+                ],
+                "summary": "Retrieve all the limit orders for a specific client.",
+                "operationId": "ordersPaginated",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to with a page of limit orders for the client",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/place": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Place a limit order to convert funds from a source to a destination currency once the conversion rate is at or above the limit rate. Fiat to Fiat, Fiat to Cryptocurrency, and Cryptocurrency to Fiat orders are supported. The source amount must be a positive number with the source currency's precision. Account balances are verified when the order is settled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders limit fiat crypto cryptocurrency currency place"
+                ],
+                "summary": "Place a limit order to convert between two currencies.",
+                "operationId": "placeOrder",
+                "parameters": [
+                    {
+                        "description": "the two currency codes, the source amount, and the limit rate",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPOrderRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "unique key used to safely retry the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "a message to confirm the placement of the order",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/rates/history": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Retrieves the open, high, low, and close rates, and the number of price quotes, for a currency pair over an RFC3339 time range. The time range is split into intervals of the requested duration, such as 15m or 1h, starting from the beginning of the range. Intervals without any recorded price quotes are omitted. Rates are recorded for the direction in which they were quoted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rates history quotes"
+                ],
+                "summary": "Retrieve the rate history for a currency pair.",
+                "operationId": "rateHistory",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the source currency code or ticker",
+                        "name": "source",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the destination currency code or ticker",
+                        "name": "destination",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the RFC3339 start of the time range, inclusive",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the RFC3339 end of the time range, exclusive",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the duration of each interval, such as 15m or 1h",
+                        "name": "interval",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message with the rate history for the currency pair",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/schedules/create": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Create a schedule to purchase a Fiat amount of a Cryptocurrency daily, weekly, or monthly. The first purchase will be made at the optional RFC3339 start time, or immediately if it is omitted or in the past. Account balances are verified each time the schedule runs and the outcome of every run is recorded in the schedule's run history.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedules recurring crypto cryptocurrency purchase create"
+                ],
+                "summary": "Create a recurring Cryptocurrency purchase schedule.",
+                "operationId": "createSchedule",
+                "parameters": [
+                    {
+                        "description": "the Fiat currency, Cryptocurrency ticker, Fiat amount, frequency, and optional start time",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPScheduleRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "unique key used to safely retry the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "a message to confirm the creation of the schedule",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/schedules/delete/{scheduleID}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Delete a recurring Cryptocurrency purchase schedule along with its run history. Purchases that have already been made are not affected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedules recurring delete"
+                ],
+                "summary": "Delete a recurring Cryptocurrency purchase schedule.",
+                "operationId": "deleteSchedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the schedule ID of the recurring purchase to delete",
+                        "name": "scheduleID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the deletion of the schedule",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/schedules/info": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Retrieves all the recurring Cryptocurrency purchase schedules for a specific client, newest first. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedules recurring info"
+                ],
+                "summary": "Retrieve all the recurring purchase schedules for a specific client.",
+                "operationId": "schedulesPaginated",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to with a page of recurring purchase schedules for the client",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/schedules/runs/{scheduleID}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Retrieves the results and failures of every run of a recurring Cryptocurrency purchase schedule, newest first. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedules recurring runs history info"
+                ],
+                "summary": "Retrieve the run history for a recurring purchase schedule.",
+                "operationId": "scheduleRunsPaginated",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the schedule ID of the recurring purchase",
+                        "name": "scheduleID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to with a page of runs for the recurring purchase schedule",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/schedules/update/{scheduleID}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ClientAPIKey": []
+                    }
+                ],
+                "description": "Update the Fiat amount and frequency of a recurring Cryptocurrency purchase schedule, and pause or resume it. Changes take effect from the next scheduled run.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedules recurring crypto cryptocurrency purchase update"
+                ],
+                "summary": "Update a recurring Cryptocurrency purchase schedule.",
+                "operationId": "updateSchedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the schedule ID of the recurring purchase to update",
+                        "name": "scheduleID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the Fiat amount, frequency, and active state",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPScheduleUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the update of the schedule",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/api-keys/create": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Generates an API key that programmatic clients can supply in the X-API-Key header in place of a JWT. The key can only access endpoints covered by its scopes: read-balances, trade, deposit, and withdraw. Only a hash of the key is stored and the key is only ever returned once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users api-keys create security"
+                ],
+                "summary": "Create an API key.",
+                "operationId": "createAPIKey",
+                "parameters": [
+                    {
+                        "description": "the name of the API key and the scopes it grants",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPAPIKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "a message to confirm the creation of the API key with the key in the payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/api-keys/info/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the name, scopes, and creation time of all the API keys for a specific client that have not been revoked, oldest first. The keys themselves are never returned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users api-keys info security"
+                ],
+                "summary": "Retrieve all the API keys for a specific client.",
+                "operationId": "apiKeys",
+                "responses": {
+                    "200": {
+                        "description": "the API keys in the payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/api-keys/revoke/{keyID}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Revokes an API key. Requests made with the key are rejected immediately.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users api-keys revoke security"
+                ],
+                "summary": "Revoke an API key.",
+                "operationId": "revokeAPIKey",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the key ID of the API key to revoke",
+                        "name": "keyID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the revocation of the API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deletes a user stored in the database by marking it as deleted. The user must supply their login credentials as well as complete the following confirmation message:\n\"I understand the consequences, delete my user account USERNAME HERE\"",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users delete security"
+                ],
+                "summary": "Deletes a user. The user must supply their credentials as well as a confirmation message.",
+                "operationId": "deleteUser",
                 "parameters": [
                     {
                         "description": "The request payload for deleting an account",
@@ -1079,13 +3475,631 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.HTTPDeleteUserRequest"
+                            "$ref": "#/definitions/models.HTTPDeleteUserRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "one-time password when step-up multifactor authentication is required",
+                        "name": "X-MFA-Code",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "message with a confirmation of a deleted user account",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/login": {
+            "post": {
+                "description": "Logs in a user by validating credentials and returning a JWT. Users with multifactor authentication enabled will receive a challenge that must be completed at the multifactor authentication login endpoint. Repeated failed login attempts will temporarily lock the account with an increasing lockout duration.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users login security"
+                ],
+                "summary": "Login a user.",
+                "operationId": "loginUser",
+                "parameters": [
+                    {
+                        "description": "Username and password to login with",
+                        "name": "credentials",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UserLoginCredentials"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a valid JWT token for the new account",
+                        "schema": {
+                            "$ref": "#/definitions/models.JWTAuthResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "a multifactor authentication challenge to be completed",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPMFAChallengeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/login/mfa": {
+            "post": {
+                "description": "Completes the login challenge issued to a user with multifactor authentication enabled. A one-time password or an unused recovery code must be supplied. A challenge can only be attempted once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users login mfa security"
+                ],
+                "summary": "Complete a multifactor authentication login.",
+                "operationId": "loginMFA",
+                "parameters": [
+                    {
+                        "description": "the challenge ID and a one-time password or recovery code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPMFALoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a valid JWT token for the account",
+                        "schema": {
+                            "$ref": "#/definitions/models.JWTAuthResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "408": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/logout": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Revokes the JSON Web Token supplied in the request header. The token will be rejected for the remainder of its validity interval.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users logout security"
+                ],
+                "summary": "Logout of the current session.",
+                "operationId": "logout",
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the session has been logged out",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/logout/all": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Revokes all JSON Web Tokens issued to the user up to and including the time of the request, including the token supplied in the request header.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users logout security"
+                ],
+                "summary": "Logout of all sessions.",
+                "operationId": "logoutEverywhere",
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm all sessions have been logged out",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/mfa/disable": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Removes a user's multifactor authentication enrollment. Enabled enrollments must be confirmed with a one-time password or an unused recovery code.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users mfa disable security"
+                ],
+                "summary": "Disable multifactor authentication.",
+                "operationId": "disableMFA",
+                "parameters": [
+                    {
+                        "description": "a one-time password or recovery code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPMFACodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm multifactor authentication is disabled",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/mfa/enroll": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Generates a Time-based One-Time Password secret, a provisioning URI for authenticator applications, and single-use recovery codes. The enrollment must be verified before it is enabled. Pending enrollments are replaced.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users mfa enroll security"
+                ],
+                "summary": "Enroll in multifactor authentication.",
+                "operationId": "enrollMFA",
+                "responses": {
+                    "201": {
+                        "description": "a message to confirm enrollment with the secret and recovery codes in the payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/mfa/recovery-codes": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replaces a user's recovery codes once the request has been confirmed with a one-time password. Any unused recovery codes are invalidated.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users mfa recovery security"
+                ],
+                "summary": "Regenerate multifactor authentication recovery codes.",
+                "operationId": "recoveryCodesMFA",
+                "parameters": [
+                    {
+                        "description": "a one-time password",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPMFACodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the replacement with the recovery codes in the payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/mfa/verify": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Enables a pending multifactor authentication enrollment once it has been confirmed with a one-time password from an authenticator application.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users mfa verify security"
+                ],
+                "summary": "Verify a multifactor authentication enrollment.",
+                "operationId": "verifyMFA",
+                "parameters": [
+                    {
+                        "description": "a one-time password",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPMFACodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm multifactor authentication is enabled",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/password/change": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replaces the password of the user once their current password has been verified. All the user's sessions, including the one used to make the request, are logged out and the user must log in again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users password security"
+                ],
+                "summary": "Change a user's password.",
+                "operationId": "changePassword",
+                "parameters": [
+                    {
+                        "description": "the current and new passwords",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPChangePasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the password has been changed",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/password/reset": {
+            "post": {
+                "description": "Replaces the password of the account a password reset token was issued for. A token can only be used once. All the user's sessions are logged out.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users password reset security"
+                ],
+                "summary": "Reset a user's password.",
+                "operationId": "resetPassword",
+                "parameters": [
+                    {
+                        "description": "the password reset token and new password",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPResetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to confirm the password has been reset",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/password/reset/request": {
+            "post": {
+                "description": "Issues a single-use, time-limited password reset token and delivers it to the owner of the account. The same response is returned whether the account exists so as not to disclose registered usernames.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users password reset security"
+                ],
+                "summary": "Request a password reset.",
+                "operationId": "requestPasswordReset",
+                "parameters": [
+                    {
+                        "description": "the username of the account",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPPasswordResetRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "message with a confirmation of a deleted user account",
+                    "202": {
+                        "description": "a message to confirm the request with the token expiration in the payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPSuccess"
                         }
@@ -1096,6 +4110,38 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/refresh": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Refreshes a user's JWT by validating it and then issuing a fresh JWT with an extended validity time. JWT must be expiring in under 60 seconds.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users login refresh security"
+                ],
+                "summary": "Refresh a user's JWT by extending its expiration time.",
+                "operationId": "loginRefresh",
+                "responses": {
+                    "200": {
+                        "description": "A new valid JWT",
+                        "schema": {
+                            "$ref": "#/definitions/models.JWTAuthResponse"
+                        }
+                    },
                     "403": {
                         "description": "error message with any available details in payload",
                         "schema": {
@@ -1107,13 +4153,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
                         }
+                    },
+                    "510": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
                     }
                 }
             }
         },
-        "/user/login": {
+        "/user/register": {
             "post": {
-                "description": "Logs in a user by validating credentials and returning a JWT.",
+                "description": "Creates a user account by inserting credentials into the database. A hashed password is stored.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1121,23 +4173,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user users login security"
+                    "user users register security"
                 ],
-                "summary": "Login a user.",
-                "operationId": "loginUser",
+                "summary": "Register a user.",
+                "operationId": "registerUser",
                 "parameters": [
                     {
-                        "description": "Username and password to login with",
-                        "name": "credentials",
+                        "description": "Username, password, first and last name, email address of user",
+                        "name": "user",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.UserLoginCredentials"
+                            "$ref": "#/definitions/models.UserAccount"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
+                    "201": {
                         "description": "a valid JWT token for the new account",
                         "schema": {
                             "$ref": "#/definitions/models.JWTAuthResponse"
@@ -1149,7 +4201,7 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
-                    "409": {
+                    "404": {
                         "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
@@ -1164,27 +4216,240 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/refresh": {
+        "/user/security/events": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the successful, failed, and locked out login attempts against a user's account with the originating IP address and user agent, newest first. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users login security"
+                ],
+                "summary": "Retrieve the login attempts against a user's account.",
+                "operationId": "loginEvents",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to with a page of login events for the client",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/webhooks/create": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Refreshes a user's JWT by validating it and then issuing a fresh JWT with an extended validity time. JWT must be expiring in under 60 seconds.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "user users login refresh security"
-                ],
-                "summary": "Refresh a user's JWT by extending its expiration time.",
-                "operationId": "loginRefresh",
+                "description": "Registers an HTTPS URL that deposits, currency exchanges, transfers, and Cryptocurrency purchases and sales will be delivered to as signed JSON events. Each request is signed with the returned secret: the X-FTeX-Signature header contains sha256= followed by the hex encoded HMAC-SHA256 of the X-FTeX-Timestamp header, a period, and the request body. Events that are not acknowledged with a 2xx response are retried with an exponential backoff before being dead-lettered. Only the encrypted secret is stored and it is only ever returned once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users webhooks create"
+                ],
+                "summary": "Register a webhook.",
+                "operationId": "createWebhook",
+                "parameters": [
+                    {
+                        "description": "the HTTPS URL to deliver events to",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "a message to confirm the registration of the webhook with the signing secret in the payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/webhooks/dead-letters/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the events that were abandoned after exhausting their delivery attempts with the webhook URL, number of attempts, and the error from the final attempt, most recent first. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. The user may choose to change the page size in any sequence of calls.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users webhooks dead-letters info"
+                ],
+                "summary": "Retrieve the events that could not be delivered to a user's webhooks.",
+                "operationId": "webhookDeadLetters",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The page cursor into the query results records.",
+                        "name": "pageCursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of records to retrieve on this page.",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "a message to with a page of dead-lettered events for the client",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/webhooks/delete/{webhookID}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deletes a webhook. Events that have not been delivered to it, and its dead-lettered events, are discarded.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user users webhooks delete"
+                ],
+                "summary": "Delete a webhook.",
+                "operationId": "deleteWebhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the webhook ID of the webhook to delete",
+                        "name": "webhookID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "A new valid JWT",
+                        "description": "a message to confirm the deletion of the webhook",
                         "schema": {
-                            "$ref": "#/definitions/models.JWTAuthResponse"
+                            "$ref": "#/definitions/models.HTTPSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "error message with any available details in payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.HTTPError"
                         }
                     },
                     "403": {
@@ -1193,13 +4458,13 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
-                    "500": {
+                    "404": {
                         "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
                         }
                     },
-                    "510": {
+                    "500": {
                         "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
@@ -1208,45 +4473,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/register": {
-            "post": {
-                "description": "Creates a user account by inserting credentials into the database. A hashed password is stored.",
-                "consumes": [
-                    "application/json"
+        "/user/webhooks/info/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Retrieves the URL and registration time of all the webhooks for a specific client, oldest first. The signing secrets are never returned.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user users register security"
-                ],
-                "summary": "Register a user.",
-                "operationId": "registerUser",
-                "parameters": [
-                    {
-                        "description": "Username, password, first and last name, email address of user",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.UserAccount"
-                        }
-                    }
+                    "user users webhooks info"
                 ],
+                "summary": "Retrieve all the webhooks for a specific client.",
+                "operationId": "webhooks",
                 "responses": {
-                    "201": {
-                        "description": "a valid JWT token for the new account",
-                        "schema": {
-                            "$ref": "#/definitions/models.JWTAuthResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "error message with any available details in payload",
+                    "200": {
+                        "description": "the webhooks in the payload",
                         "schema": {
-                            "$ref": "#/definitions/models.HTTPError"
+                            "$ref": "#/definitions/models.HTTPSuccess"
                         }
                     },
-                    "404": {
+                    "403": {
                         "description": "error message with any available details in payload",
                         "schema": {
                             "$ref": "#/definitions/models.HTTPError"
@@ -1263,6 +4513,75 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "models.HTTPAPIKeyRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "scopes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.HTTPAccountHoldRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "currency"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.HTTPAccountStatusRequest": {
+            "type": "object",
+            "required": [
+                "currency",
+                "status"
+            ],
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.HTTPChangePasswordRequest": {
+            "type": "object",
+            "required": [
+                "currentPassword",
+                "newPassword"
+            ],
+            "properties": {
+                "currentPassword": {
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "newPassword": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 8
+                }
+            }
+        },
         "models.HTTPCryptoOfferRequest": {
             "type": "object",
             "required": [
@@ -1344,6 +4663,64 @@ const docTemplate = `{
                 }
             }
         },
+        "models.HTTPFiatP2PTransferRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "currency",
+                "username"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.HTTPMFAChallengeResponse": {
+            "type": "object",
+            "properties": {
+                "challengeId": {
+                    "type": "string"
+                },
+                "expires": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.HTTPMFACodeRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 32
+                }
+            }
+        },
+        "models.HTTPMFALoginRequest": {
+            "type": "object",
+            "required": [
+                "challengeId",
+                "code"
+            ],
+            "properties": {
+                "challengeId": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string",
+                    "maxLength": 32
+                }
+            }
+        },
         "models.HTTPOpenCurrencyAccountRequest": {
             "type": "object",
             "required": [
@@ -1355,6 +4732,104 @@ const docTemplate = `{
                 }
             }
         },
+        "models.HTTPOrderRequest": {
+            "type": "object",
+            "required": [
+                "destinationCurrency",
+                "limitRate",
+                "sourceAmount",
+                "sourceCurrency"
+            ],
+            "properties": {
+                "destinationCurrency": {
+                    "type": "string"
+                },
+                "limitRate": {
+                    "type": "number"
+                },
+                "sourceAmount": {
+                    "type": "number"
+                },
+                "sourceCurrency": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.HTTPPasswordResetRequest": {
+            "type": "object",
+            "required": [
+                "username"
+            ],
+            "properties": {
+                "username": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 8
+                }
+            }
+        },
+        "models.HTTPResetPasswordRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "token"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 8
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.HTTPScheduleRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "fiatCurrency",
+                "frequency",
+                "ticker"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "fiatCurrency": {
+                    "type": "string"
+                },
+                "frequency": {
+                    "type": "string"
+                },
+                "startAt": {
+                    "type": "string"
+                },
+                "ticker": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.HTTPScheduleUpdateRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "frequency",
+                "isActive"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "frequency": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                }
+            }
+        },
         "models.HTTPSuccess": {
             "type": "object",
             "properties": {
@@ -1375,6 +4850,33 @@ const docTemplate = `{
                 }
             }
         },
+        "models.HTTPWebhookRequest": {
+            "type": "object",
+            "required": [
+                "url"
+            ],
+            "properties": {
+                "url": {
+                    "type": "string",
+                    "maxLength": 2048
+                }
+            }
+        },
+        "models.HTTPWithdrawCurrencyRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "currency"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                }
+            }
+        },
         "models.JWTAuthResponse": {
             "type": "object",
             "required": [
@@ -1456,6 +4958,11 @@ const docTemplate = `{
             "type": "apiKey",
             "name": "Authorization",
             "in": "header"
+        },
+        "ClientAPIKey": {
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
         }
     }
 }`
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	"github.com/surahman/FTeX/pkg/constants"
+)
+
+// Scope is a permission that an API key grants to the programmatic client that holds it.
+type Scope string
+
+const (
+	ScopeNone         Scope = ""              // ScopeNone endpoints cannot be accessed with an API key.
+	ScopeReadBalances Scope = "read-balances" // ScopeReadBalances endpoints read balances, transactions, orders, schedules, and rates.
+	ScopeTrade        Scope = "trade"         // ScopeTrade endpoints open Cryptocurrency accounts, price and execute exchanges, and manage orders and schedules.
+	ScopeDeposit      Scope = "deposit"       // ScopeDeposit endpoints open Fiat accounts and deposit funds.
+	ScopeWithdraw     Scope = "withdraw"      // ScopeWithdraw endpoints withdraw funds or transfer them to other users.
+)
+
+// GrantedBy returns whether a set of API key scopes grants access to endpoints requiring this scope. Endpoints that do
+// not accept API keys are never granted.
+func (s Scope) GrantedBy(scopes []string) bool {
+	return s != ScopeNone && slices.Contains(scopes, string(s))
+}
+
+// MovesFunds returns whether endpoints requiring this scope trade, deposit, or withdraw funds. These endpoints cannot be
+// accessed by frozen accounts.
+func (s Scope) MovesFunds() bool {
+	return s == ScopeTrade || s == ScopeDeposit || s == ScopeWithdraw
+}
+
+const (
+	// apiKeyPrefix identifies FTeX API keys so that they can be recognised by secret scanners.
+	apiKeyPrefix = "ftex_"
+
+	// apiKeyBytes is the size of the random portion of an API key before it is encoded.
+	apiKeyBytes = 32
+)
+
+// GenerateAPIKey will create a fresh API key. The key is returned in plaintext, to be shown to the user once, and in
+// hashed form for storage.
+func (a *authImpl) GenerateAPIKey() (plaintext string, hashed string, err error) {
+	rawKey := make([]byte, apiKeyBytes)
+	if _, err = rand.Read(rawKey); err != nil {
+		return "", "", fmt.Errorf(constants.ErrorFormatMessage(), "failed to generate api key", err)
+	}
+
+	plaintext = apiKeyPrefix + base64.RawURLEncoding.EncodeToString(rawKey)
+
+	return plaintext, a.HashAPIKey(plaintext), nil
+}
+
+// HashAPIKey will generate the SHA-256 hex digest of an API key. API keys have enough entropy that a slow password
+// hashing function is not required, and a deterministic digest allows the key to be looked up by its hash.
+func (a *authImpl) HashAPIKey(key string) string {
+	digest := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(digest[:])
+}
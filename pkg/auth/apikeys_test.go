@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeys_GrantedBy(t *testing.T) {
+	t.Parallel()
+
+	scopes := []string{string(ScopeReadBalances), string(ScopeTrade)}
+
+	require.True(t, ScopeReadBalances.GrantedBy(scopes), "read balances scope not granted.")
+	require.True(t, ScopeTrade.GrantedBy(scopes), "trade scope not granted.")
+	require.False(t, ScopeDeposit.GrantedBy(scopes), "deposit scope granted.")
+	require.False(t, ScopeWithdraw.GrantedBy(nil), "withdraw scope granted without scopes.")
+	require.False(t, ScopeNone.GrantedBy([]string{""}), "endpoint without api key access granted.")
+}
+
+func TestAPIKeys_MovesFunds(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, ScopeTrade.MovesFunds(), "trade scope does not move funds.")
+	require.True(t, ScopeDeposit.MovesFunds(), "deposit scope does not move funds.")
+	require.True(t, ScopeWithdraw.MovesFunds(), "withdraw scope does not move funds.")
+	require.False(t, ScopeReadBalances.MovesFunds(), "read balances scope moves funds.")
+	require.False(t, ScopeNone.MovesFunds(), "endpoint without a scope moves funds.")
+}
+
+func TestAPIKeys_GenerateAPIKey(t *testing.T) {
+	t.Parallel()
+
+	plaintext, hashed, err := testAuth.GenerateAPIKey()
+	require.NoError(t, err, "failed to generate api key.")
+	require.True(t, strings.HasPrefix(plaintext, apiKeyPrefix), "api key prefix not set.")
+	require.Len(t, plaintext, len(apiKeyPrefix)+43, "api key length mismatch.")
+	require.Equal(t, testAuth.HashAPIKey(plaintext), hashed, "hashed api key mismatch.")
+
+	other, _, err := testAuth.GenerateAPIKey()
+	require.NoError(t, err, "failed to generate second api key.")
+	require.NotEqual(t, plaintext, other, "api keys are not unique.")
+}
+
+func TestAPIKeys_HashAPIKey(t *testing.T) {
+	t.Parallel()
+
+	expected := testAuth.HashAPIKey("ftex_api-key")
+
+	require.Len(t, expected, 64, "digest length mismatch.")
+	require.Equal(t, expected, testAuth.HashAPIKey("ftex_api-key"), "digest is not deterministic.")
+	require.NotEqual(t, expected, testAuth.HashAPIKey("ftex_API-key"), "different keys matched.")
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -24,6 +25,12 @@ import (
 // Mock Auth interface stub generation.
 //go:generate mockgen -destination=../mocks/mock_auth.go -package=mocks github.com/surahman/FTeX/pkg/auth Auth
 
+// init records the issue time of JWTs to the microsecond so that a logout everywhere does not revoke sessions that are
+// issued later in the same second.
+func init() {
+	jwt.TimePrecision = time.Microsecond
+}
+
 // Auth is the interface through which the authorization operations can be accessed. Created to support mock testing.
 type Auth interface {
 	// HashPassword will take a plaintext string and generate a hashed representation of it.
@@ -47,6 +54,13 @@ type Auth interface {
 	// refreshed in.
 	RefreshThreshold() int64
 
+	// SessionFromJWT will take the JSON Web Token and validate it. It will extract and return the session ID and issued
+	// at time (Unix timestamp in microseconds) or an error if validation fails.
+	SessionFromJWT(token string) (string, int64, error)
+
+	// ExpirationDuration returns the validity interval in seconds of a freshly issued JSON Web Token.
+	ExpirationDuration() int64
+
 	// EncryptToString will generate an encrypted base64 encoded character from the plaintext.
 	EncryptToString(plaintext []byte) (string, error)
 
@@ -55,6 +69,45 @@ type Auth interface {
 
 	// TokenInfoFromGinCtx extracts the clientID and expiration deadline stored from a JWT in the Gin context.
 	TokenInfoFromGinCtx(ctx *gin.Context) (uuid.UUID, int64, error)
+
+	// GenerateTOTP will create a Time-based One-Time Password secret for an account and return it with the provisioning
+	// URI to be used by authenticator applications.
+	GenerateTOTP(accountName string) (string, string, error)
+
+	// ValidateTOTP will check a Time-based One-Time Password code against a secret and return the time step it was
+	// generated for.
+	ValidateTOTP(secret, code string) (int64, error)
+
+	// GenerateRecoveryCodes will create a set of single-use recovery codes and return them in plaintext and hashed form.
+	GenerateRecoveryCodes() ([]string, []string, error)
+
+	// HashRecoveryCode will generate the hashed representation of a recovery code to be stored and looked up.
+	HashRecoveryCode(code string) string
+
+	// MFAChallengeExpiration returns the time in seconds that a login multifactor authentication challenge is valid for.
+	MFAChallengeExpiration() int64
+
+	// StepUpRequired returns whether a category of sensitive operations requires step-up multifactor authentication.
+	StepUpRequired(operation StepUp) bool
+
+	// JWKS returns the public keys that JSON Web Tokens can be verified with as a JSON Web Key Set.
+	JWKS() *models.JWKSet
+
+	// GenerateAPIKey will create an API key for programmatic clients and return it in plaintext and hashed form.
+	GenerateAPIKey() (string, string, error)
+
+	// HashAPIKey will generate the hashed representation of an API key to be stored and looked up.
+	HashAPIKey(key string) string
+
+	// GenerateWebhookSecret will create a webhook signing secret and return it in plaintext and encrypted form.
+	GenerateWebhookSecret() (string, string, error)
+
+	// LoginLockout returns the duration an account should be locked for after a number of consecutive failed login
+	// attempts. A zero duration indicates that the account should not be locked.
+	LoginLockout(failures int64) time.Duration
+
+	// LoginFailureWindow returns the duration that failed login attempts are tracked for before the count is reset.
+	LoginFailureWindow() time.Duration
 }
 
 // Check to ensure the Auth interface has been implemented.
@@ -63,6 +116,8 @@ var _ Auth = &authImpl{}
 // authImpl implements the Auth interface and contains the logic for authorization functionality.
 type authImpl struct {
 	cryptoSecret []byte
+	cryptoKeys   []*cryptoKey
+	signingKeys  []*signingKey
 	conf         *config
 	logger       *logger.Logger
 }
@@ -86,6 +141,13 @@ func newAuthImpl(fs *afero.Fs, logger *logger.Logger) (a *authImpl, err error) {
 	}
 
 	a.cryptoSecret = []byte(a.conf.General.CryptoSecret)
+	a.cryptoKeys = newCryptoKeys(a.conf.General.CryptoKeys)
+
+	if a.signingKeys, err = parseSigningKeys(a.conf.JWTConfig.SigningKeys); err != nil {
+		a.logger.Error("failed to load JWT signing keys", zap.Error(err))
+
+		return nil, err
+	}
 
 	return
 }
@@ -118,21 +180,42 @@ type jwtClaim struct {
 	jwt.RegisteredClaims
 }
 
-// GenerateJWT creates a payload consisting of the JWT with the Client ID and expiration time.
+// GenerateJWT creates a payload consisting of the JWT with the Client ID, session ID, and expiration time. The JWT is
+// signed by the active asymmetric signing key, with its key ID in the header, or the symmetric key if there is none.
 func (a *authImpl) GenerateJWT(clientID uuid.UUID) (*models.JWTAuthResponse, error) {
+	sessionID, err := uuid.NewV4()
+	if err != nil {
+		msg := "failed to generate jwt session id"
+		a.logger.Warn(msg, zap.Error(err))
+
+		return nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	issuedAt := time.Now().UTC()
 	claims := &jwtClaim{
 		ClientID: clientID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			Issuer: a.conf.JWTConfig.Issuer,
+			ID:       sessionID.String(),
+			Issuer:   a.conf.JWTConfig.Issuer,
+			IssuedAt: jwt.NewNumericDate(issuedAt),
 			ExpiresAt: jwt.NewNumericDate(
-				time.
-					Now().
-					Add(time.Duration(a.conf.JWTConfig.ExpirationDuration) * time.Second).UTC()),
+				issuedAt.Add(time.Duration(a.conf.JWTConfig.ExpirationDuration) * time.Second)),
 		},
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
-	tokenString, err := token.SignedString([]byte(a.conf.JWTConfig.Key))
+	method, key, kid, err := a.jwtSigner(issuedAt)
+	if err != nil {
+		a.logger.Error("failed to select jwt signing key", zap.Error(err))
+
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+
+	tokenString, err := token.SignedString(key)
 	if err != nil {
 		msg := "failed to generate signed jwt"
 		a.logger.Warn(msg, zap.Error(err))
@@ -149,18 +232,14 @@ func (a *authImpl) GenerateJWT(clientID uuid.UUID) (*models.JWTAuthResponse, err
 	return authResponse, nil
 }
 
-// ValidateJWT will validate a signed JWT and extracts the Client ID and unix expiration timestamp from it.
-//
-//nolint:revive
-func (a *authImpl) ValidateJWT(signedToken string) (uuid.UUID, int64, error) {
-	token, err := jwt.ParseWithClaims(signedToken, &jwtClaim{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(a.conf.JWTConfig.Key), nil
-	})
+// parseJWT will validate a signed JWT and return its claims.
+func (a *authImpl) parseJWT(signedToken string) (*jwtClaim, error) {
+	token, err := jwt.ParseWithClaims(signedToken, &jwtClaim{}, a.jwtVerificationKey)
 	if err != nil {
 		msg := "failed to parse token"
 		a.logger.Warn(msg, zap.Error(err))
 
-		return uuid.UUID{}, -1, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+		return nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
 	}
 
 	// Cast token claim to JWT.
@@ -169,25 +248,51 @@ func (a *authImpl) ValidateJWT(signedToken string) (uuid.UUID, int64, error) {
 		msg := "failed to extract jwt data"
 		a.logger.Warn(msg, zap.Error(err))
 
-		return uuid.UUID{}, -1, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+		return nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
 	}
 
 	// Check for errors and compare the expiration time in Unix format.
 	expiration, err := claims.GetExpirationTime()
 	if err != nil || expiration.Unix() < time.Now().Unix() {
-		return uuid.UUID{}, -1, errors.New("token has expired")
+		return nil, errors.New("token has expired")
 	}
 
 	// Check the issuer is correct.
 	issuer, err := claims.GetIssuer()
 	if err != nil || issuer != a.conf.JWTConfig.Issuer {
-		return uuid.UUID{}, -1, errors.New("unauthorized issuer")
+		return nil, errors.New("unauthorized issuer")
+	}
+
+	return claims, nil
+}
+
+// ValidateJWT will validate a signed JWT and extracts the Client ID and unix expiration timestamp from it.
+func (a *authImpl) ValidateJWT(signedToken string) (uuid.UUID, int64, error) {
+	claims, err := a.parseJWT(signedToken)
+	if err != nil {
+		return uuid.UUID{}, -1, err
 	}
 
 	// Return the username and the unix expiration timestamp.
 	return claims.ClientID, claims.ExpiresAt.Unix(), nil
 }
 
+// SessionFromJWT will validate a signed JWT and extracts the session ID and unix issued at timestamp in microseconds
+// from it.
+func (a *authImpl) SessionFromJWT(signedToken string) (string, int64, error) {
+	claims, err := a.parseJWT(signedToken)
+	if err != nil {
+		return "", -1, err
+	}
+
+	// Tokens must carry a session ID and issue time to be revocable.
+	if claims.ID == "" || claims.IssuedAt == nil {
+		return "", -1, errors.New("token does not contain session information")
+	}
+
+	return claims.ID, claims.IssuedAt.UnixMicro(), nil
+}
+
 // RefreshJWT will extend a valid JWTs lease by generating a fresh valid JWT.
 func (a *authImpl) RefreshJWT(token string) (authResponse *models.JWTAuthResponse, err error) {
 	var clientID uuid.UUID
@@ -208,15 +313,20 @@ func (a *authImpl) RefreshThreshold() int64 {
 	return a.conf.JWTConfig.RefreshThreshold
 }
 
+// ExpirationDuration is the seconds that a freshly issued JWT is valid for.
+func (a *authImpl) ExpirationDuration() int64 {
+	return a.conf.JWTConfig.ExpirationDuration
+}
+
 // encryptAES256 employs Authenticated Encryption with Associated Data using Galois/Counter mode and returns the cipher
 // as a Base64 encoded string to be used in URIs.
-func (a *authImpl) encryptAES256(data []byte) (cipherStr string, cipherBytes []byte, err error) {
+func encryptAES256(secret, data, additionalData []byte) (cipherStr string, cipherBytes []byte, err error) {
 	var (
 		cipherBlock cipher.Block
 		gcm         cipher.AEAD
 	)
 
-	if cipherBlock, err = aes.NewCipher(a.cryptoSecret); err != nil {
+	if cipherBlock, err = aes.NewCipher(secret); err != nil {
 		return
 	}
 
@@ -230,7 +340,7 @@ func (a *authImpl) encryptAES256(data []byte) (cipherStr string, cipherBytes []b
 	}
 
 	// Encrypt to a cipher text.
-	cipherBytes = gcm.Seal(nonce, nonce, data, nil)
+	cipherBytes = gcm.Seal(nonce, nonce, data, additionalData)
 
 	// Convert to Base64 URL encoded string for use in URLs.
 	cipherStr = base64.URLEncoding.EncodeToString(cipherBytes)
@@ -240,14 +350,14 @@ func (a *authImpl) encryptAES256(data []byte) (cipherStr string, cipherBytes []b
 
 // decryptAES256 employs Authenticated Encryption with Associated Data using Galois/Counter mode and returns the
 // decrypted plaintext bytes.
-func (a *authImpl) decryptAES256(data []byte) (cipherBytes []byte, err error) {
+func decryptAES256(secret, data, additionalData []byte) (cipherBytes []byte, err error) {
 	var (
 		cipherBlock cipher.Block
 		gcm         cipher.AEAD
 		nonceSize   int
 	)
 
-	if cipherBlock, err = aes.NewCipher(a.cryptoSecret); err != nil {
+	if cipherBlock, err = aes.NewCipher(secret); err != nil {
 		return
 	}
 
@@ -255,7 +365,7 @@ func (a *authImpl) decryptAES256(data []byte) (cipherBytes []byte, err error) {
 		return
 	}
 
-	if nonceSize = gcm.NonceSize(); nonceSize < 0 {
+	if nonceSize = gcm.NonceSize(); nonceSize < 0 || len(data) < nonceSize {
 		return nil, errors.New("bad nonce size")
 	}
 
@@ -263,27 +373,58 @@ func (a *authImpl) decryptAES256(data []byte) (cipherBytes []byte, err error) {
 	nonce, cipherText := data[:nonceSize], data[nonceSize:]
 
 	// Decrypt cipher text.
-	cipherBytes, err = gcm.Open(nil, nonce, cipherText, nil)
+	cipherBytes, err = gcm.Open(nil, nonce, cipherText, additionalData)
 
 	return
 }
 
-// EncryptToString will generate an encrypted base64 encoded character from the plaintext.
+// EncryptToString will generate an encrypted base64 encoded character from the plaintext. The ciphertext is generated
+// by the active key in the keyring and prefixed with its ID, or by the crypto secret if there is none.
 func (a *authImpl) EncryptToString(plaintext []byte) (ciphertext string, err error) {
-	ciphertext, _, err = a.encryptAES256(plaintext)
+	key := a.activeCryptoKey(time.Now())
+	if key == nil {
+		if len(a.cryptoSecret) == 0 {
+			return "", errors.New("no active encryption key")
+		}
 
-	return
+		ciphertext, _, err = encryptAES256(a.cryptoSecret, plaintext, nil)
+
+		return
+	}
+
+	// The key ID is authenticated as associated data so that it cannot be substituted.
+	if ciphertext, _, err = encryptAES256(key.secret, plaintext, []byte(key.id)); err != nil {
+		return
+	}
+
+	return key.id + cipherKeyIDSeparator + ciphertext, nil
 }
 
-// DecryptFromString will decrypt an encrypted base64 encoded character from the ciphertext.
+// DecryptFromString will decrypt an encrypted base64 encoded character from the ciphertext. Ciphertexts prefixed with
+// a key ID are decrypted by that key if it has not been retired, and all others by the crypto secret.
 func (a *authImpl) DecryptFromString(ciphertext string) (plaintext []byte, err error) {
-	var bytes []byte
+	var (
+		bytes  []byte
+		secret = a.cryptoSecret
+		keyID  []byte
+	)
+
+	if id, encoded, found := strings.Cut(ciphertext, cipherKeyIDSeparator); found {
+		key := a.decryptionCryptoKey(id, time.Now())
+		if key == nil {
+			return nil, errors.New("unknown or retired encryption key")
+		}
+
+		secret, keyID, ciphertext = key.secret, []byte(key.id), encoded
+	} else if len(secret) == 0 {
+		return nil, errors.New("no encryption key for unversioned ciphertext")
+	}
 
 	if bytes, err = base64.URLEncoding.DecodeString(ciphertext); err != nil {
 		return
 	}
 
-	return a.decryptAES256(bytes)
+	return decryptAES256(secret, bytes, keyID)
 }
 
 // testConfigurationImpl creates an authImpl configuration for testing.
@@ -297,6 +438,16 @@ func testConfigurationImpl(zapLogger *logger.Logger, expDuration, refThreshold i
 	auth.conf.JWTConfig.ExpirationDuration = expDuration
 	auth.conf.JWTConfig.RefreshThreshold = refThreshold
 	auth.conf.General.BcryptCost = 4
+	auth.conf.MFA.Issuer = "issuer for test suite"
+	auth.conf.MFA.Skew = 1
+	auth.conf.MFA.ChallengeExpiration = 300
+	auth.conf.MFA.RecoveryCodes = 10
+	auth.conf.MFA.StepUpTransfers = true
+	auth.conf.MFA.StepUpDeletes = true
+	auth.conf.Lockout.MaxAttempts = 5
+	auth.conf.Lockout.BaseDuration = time.Minute
+	auth.conf.Lockout.MaxDuration = time.Hour
+	auth.conf.Lockout.FailureWindow = 24 * time.Hour
 	auth.cryptoSecret = []byte("*****crypto key for testing*****")
 
 	return auth
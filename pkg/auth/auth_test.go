@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 	"github.com/surahman/FTeX/pkg/constants"
@@ -86,6 +87,30 @@ func TestNewAuthImpl(t *testing.T) {
 			input:     authConfigTestData["valid"],
 			expectErr: require.Error,
 			expectNil: require.Nil,
+		}, {
+			name:      "Signing keys",
+			fileName:  constants.AuthFileName(),
+			input:     authConfigTestData["valid_signing_keys"],
+			expectErr: require.NoError,
+			expectNil: require.NotNil,
+		}, {
+			name:      "Signing keys without symmetric key",
+			fileName:  constants.AuthFileName(),
+			input:     authConfigTestData["signing_keys_no_symmetric_key"],
+			expectErr: require.NoError,
+			expectNil: require.NotNil,
+		}, {
+			name:      "Crypto keys without crypto secret",
+			fileName:  constants.AuthFileName(),
+			input:     authConfigTestData["crypto_keys_no_secret"],
+			expectErr: require.NoError,
+			expectNil: require.NotNil,
+		}, {
+			name:      "Signing key algorithm mismatch",
+			fileName:  constants.AuthFileName(),
+			input:     authConfigTestData["signing_key_algorithm_mismatch"],
+			expectErr: require.Error,
+			expectNil: require.Nil,
 		},
 	}
 
@@ -351,6 +376,93 @@ func TestAuthImpl_RefreshThreshold(t *testing.T) {
 		"token refresh threshold did not match expected threshold")
 }
 
+func TestAuthImpl_ExpirationDuration(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, expirationDuration, testAuth.ExpirationDuration(),
+		"token expiration duration did not match expected duration")
+}
+
+func TestAuthImpl_SessionFromJWT(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate clientID.")
+
+	t.Run("Invalid tokens", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := testAuth.SessionFromJWT("")
+		require.Error(t, err, "parsing an empty token should fail")
+
+		_, _, err = testAuth.SessionFromJWT("bad#token#string")
+		require.Error(t, err, "parsing and invalid token should fail")
+	})
+
+	t.Run("Missing session information", func(t *testing.T) {
+		t.Parallel()
+
+		claims := &jwtClaim{
+			ClientID: clientID,
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    testAuth.conf.JWTConfig.Issuer,
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+		}
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).
+			SignedString([]byte(testAuth.conf.JWTConfig.Key))
+		require.NoError(t, err, "failed to sign test token")
+
+		_, _, err = testAuth.SessionFromJWT(token)
+		require.Error(t, err, "token without a session ID should fail")
+		require.Contains(t, err.Error(), "session", "error message did not contain expected err")
+	})
+
+	t.Run("Unique sessions", func(t *testing.T) {
+		t.Parallel()
+
+		before := time.Now().UnixMicro()
+
+		first, err := testAuth.GenerateJWT(clientID)
+		require.NoError(t, err, "failed to create first JWT")
+
+		second, err := testAuth.GenerateJWT(clientID)
+		require.NoError(t, err, "failed to create second JWT")
+
+		firstID, firstIssuedAt, err := testAuth.SessionFromJWT(first.Token)
+		require.NoError(t, err, "failed to extract session from first JWT")
+		require.NotEmpty(t, firstID, "first session ID is empty")
+		require.GreaterOrEqual(t, firstIssuedAt, before, "first issued at is before generation")
+		require.LessOrEqual(t, firstIssuedAt, time.Now().UnixMicro(), "first issued at is after current time")
+
+		secondID, _, err := testAuth.SessionFromJWT(second.Token)
+		require.NoError(t, err, "failed to extract session from second JWT")
+		require.NotEqual(t, firstID, secondID, "session IDs must be unique")
+	})
+
+	t.Run("Sub-second issue time", func(t *testing.T) {
+		t.Parallel()
+
+		issuedAt := time.Now().Truncate(time.Second).Add(250 * time.Millisecond)
+		claims := &jwtClaim{
+			ClientID: clientID,
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        "session-id",
+				Issuer:    testAuth.conf.JWTConfig.Issuer,
+				IssuedAt:  jwt.NewNumericDate(issuedAt),
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+		}
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).
+			SignedString([]byte(testAuth.conf.JWTConfig.Key))
+		require.NoError(t, err, "failed to sign test token")
+
+		_, actual, err := testAuth.SessionFromJWT(token)
+		require.NoError(t, err, "failed to extract session from JWT")
+		require.Equal(t, issuedAt.UnixMicro(), actual, "issued at time lost sub-second precision")
+	})
+}
+
 func TestAuthImpl_encryptAES256_and_decryptAES256(t *testing.T) {
 	t.Parallel()
 
@@ -373,13 +485,13 @@ func TestAuthImpl_encryptAES256_and_decryptAES256(t *testing.T) {
 			t.Parallel()
 
 			// Encrypt phase.
-			cipherStr, cipherBytes, err := testAuth.encryptAES256([]byte(test.plainText))
+			cipherStr, cipherBytes, err := encryptAES256(testAuth.cryptoSecret, []byte(test.plainText), nil)
 			require.NoError(t, err, "error encrypting to AES256")
 			require.NotNil(t, cipherBytes, "no cipher block returned as bytes")
 			test.expectStr(t, len(cipherStr) > 0, "cipher string expectation failed")
 
 			// Decrypt phase.
-			plainTextBytes, err := testAuth.decryptAES256(cipherBytes)
+			plainTextBytes, err := decryptAES256(testAuth.cryptoSecret, cipherBytes, nil)
 			require.NoError(t, err, "error decrypting from AES256")
 			require.NotNil(t, plainTextBytes, "no plaintext block returned as bytes")
 			require.Equal(t, test.plainText, string(plainTextBytes), "decrypted cipher does not match input plaintext")
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/afero"
 	"github.com/surahman/FTeX/pkg/configloader"
@@ -12,24 +13,73 @@ import (
 type config struct {
 	JWTConfig jwtConfig     `json:"jwt,omitempty"     mapstructure:"jwt"     validate:"required" yaml:"jwt,omitempty"`
 	General   generalConfig `json:"general,omitempty" mapstructure:"general" validate:"required" yaml:"general,omitempty"`
+	MFA       mfaConfig     `json:"mfa,omitempty"     mapstructure:"mfa"     validate:"required" yaml:"mfa,omitempty"`
+	Lockout   lockoutConfig `json:"lockout,omitempty" mapstructure:"lockout" validate:"required" yaml:"lockout,omitempty"`
 }
 
 // jwtConfig contains the configurations for JWT creation and verification.
 //
 //nolint:lll
 type jwtConfig struct {
-	Key                string `json:"key,omitempty"                mapstructure:"key"                validate:"required,min=8,max=256"                     yaml:"key,omitempty"`
-	Issuer             string `json:"issuer,omitempty"             mapstructure:"issuer"             validate:"required"                                   yaml:"issuer,omitempty"`
-	ExpirationDuration int64  `json:"expirationDuration,omitempty" mapstructure:"expirationDuration" validate:"required,min=60,gtefield=RefreshThreshold"  yaml:"expirationDuration,omitempty"`
-	RefreshThreshold   int64  `json:"refreshThreshold,omitempty"   mapstructure:"refreshThreshold"   validate:"required,min=1,ltefield=ExpirationDuration" yaml:"refreshThreshold,omitempty"`
+	Key                string         `json:"key,omitempty"                mapstructure:"key"                validate:"required_without=SigningKeys,omitempty,min=8,max=256" yaml:"key,omitempty"`
+	Issuer             string         `json:"issuer,omitempty"             mapstructure:"issuer"             validate:"required"                                             yaml:"issuer,omitempty"`
+	ExpirationDuration int64          `json:"expirationDuration,omitempty" mapstructure:"expirationDuration" validate:"required,min=60,gtefield=RefreshThreshold"            yaml:"expirationDuration,omitempty"`
+	RefreshThreshold   int64          `json:"refreshThreshold,omitempty"   mapstructure:"refreshThreshold"   validate:"required,min=1,ltefield=ExpirationDuration"           yaml:"refreshThreshold,omitempty"`
+	SigningKeys        []jwtKeyConfig `json:"signingKeys,omitempty"        mapstructure:"signingKeys"        validate:"omitempty,unique=KID,dive"                            yaml:"signingKeys,omitempty"`
+}
+
+// jwtKeyConfig contains an asymmetric JWT signing key and its rotation schedule. The key signs JWTs from its activation
+// time until a newer key is activated, and verifies JWTs until it is retired.
+//
+//nolint:lll
+type jwtKeyConfig struct {
+	KID        string    `json:"kid,omitempty"        mapstructure:"kid"        validate:"required,max=64"              yaml:"kid,omitempty"`
+	Algorithm  string    `json:"algorithm,omitempty"  mapstructure:"algorithm"  validate:"required,oneof=RS256 EdDSA"   yaml:"algorithm,omitempty"`
+	PrivateKey string    `json:"privateKey,omitempty" mapstructure:"privateKey" validate:"required"                     yaml:"privateKey,omitempty"`
+	ActiveFrom time.Time `json:"activeFrom,omitempty" mapstructure:"activeFrom" validate:"required"                     yaml:"activeFrom,omitempty"`
+	RetireAt   time.Time `json:"retireAt,omitempty"   mapstructure:"retireAt"   validate:"omitempty,gtfield=ActiveFrom" yaml:"retireAt,omitempty"`
 }
 
 // generalConfig contains the configurations for general encryption.
 //
 //nolint:lll
 type generalConfig struct {
-	BcryptCost   int    `json:"bcryptCost,omitempty"   mapstructure:"bcryptCost"   validate:"required,min=4,max=31" yaml:"bcryptCost,omitempty"`
-	CryptoSecret string `json:"cryptoSecret,omitempty" mapstructure:"cryptoSecret" validate:"required,len=32"       yaml:"cryptoSecret,omitempty"`
+	BcryptCost   int               `json:"bcryptCost,omitempty"   mapstructure:"bcryptCost"   validate:"required,min=4,max=31"                        yaml:"bcryptCost,omitempty"`
+	CryptoSecret string            `json:"cryptoSecret,omitempty" mapstructure:"cryptoSecret" validate:"required_without=CryptoKeys,omitempty,len=32" yaml:"cryptoSecret,omitempty"`
+	CryptoKeys   []cryptoKeyConfig `json:"cryptoKeys,omitempty"   mapstructure:"cryptoKeys"   validate:"omitempty,unique=ID,dive"                     yaml:"cryptoKeys,omitempty"`
+}
+
+// cryptoKeyConfig contains an AES-256 encryption key from the keyring and its rotation schedule. The key encrypts from
+// its activation time until a newer key is activated, and decrypts until it is retired.
+//
+//nolint:lll
+type cryptoKeyConfig struct {
+	ID         string    `json:"id,omitempty"         mapstructure:"id"         validate:"required,alphanum,max=16"     yaml:"id,omitempty"`
+	Secret     string    `json:"secret,omitempty"     mapstructure:"secret"     validate:"required,len=32"              yaml:"secret,omitempty"`
+	ActiveFrom time.Time `json:"activeFrom,omitempty" mapstructure:"activeFrom" validate:"required"                     yaml:"activeFrom,omitempty"`
+	RetireAt   time.Time `json:"retireAt,omitempty"   mapstructure:"retireAt"   validate:"omitempty,gtfield=ActiveFrom" yaml:"retireAt,omitempty"`
+}
+
+// mfaConfig contains the configurations for Time-based One-Time Password multifactor authentication.
+//
+//nolint:lll
+type mfaConfig struct {
+	Issuer              string `json:"issuer,omitempty"              mapstructure:"issuer"              validate:"required"                yaml:"issuer,omitempty"`
+	Skew                int64  `json:"skew,omitempty"                mapstructure:"skew"                validate:"min=0,max=3"             yaml:"skew,omitempty"`
+	ChallengeExpiration int64  `json:"challengeExpiration,omitempty" mapstructure:"challengeExpiration" validate:"required,min=30,max=900" yaml:"challengeExpiration,omitempty"`
+	RecoveryCodes       int    `json:"recoveryCodes,omitempty"       mapstructure:"recoveryCodes"       validate:"required,min=4,max=16"   yaml:"recoveryCodes,omitempty"`
+	StepUpTransfers     bool   `json:"stepUpTransfers,omitempty"     mapstructure:"stepUpTransfers"                                        yaml:"stepUpTransfers,omitempty"`
+	StepUpDeletes       bool   `json:"stepUpDeletes,omitempty"       mapstructure:"stepUpDeletes"                                          yaml:"stepUpDeletes,omitempty"`
+}
+
+// lockoutConfig contains the configurations for temporarily locking accounts after repeated failed login attempts.
+//
+//nolint:lll
+type lockoutConfig struct {
+	MaxAttempts   int64         `json:"maxAttempts,omitempty"   mapstructure:"maxAttempts"   validate:"required,min=1"                 yaml:"maxAttempts,omitempty"`
+	BaseDuration  time.Duration `json:"baseDuration,omitempty"  mapstructure:"baseDuration"  validate:"required,min=1s"                yaml:"baseDuration,omitempty"`
+	MaxDuration   time.Duration `json:"maxDuration,omitempty"   mapstructure:"maxDuration"   validate:"required,gtefield=BaseDuration" yaml:"maxDuration,omitempty"`
+	FailureWindow time.Duration `json:"failureWindow,omitempty" mapstructure:"failureWindow" validate:"required,gtefield=BaseDuration" yaml:"failureWindow,omitempty"`
 }
 
 // newConfig creates a blank configuration struct for the authorization.
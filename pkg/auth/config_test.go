@@ -17,6 +17,8 @@ import (
 func TestAuthConfigs_Load(t *testing.T) {
 	keyspaceJwt := constants.AuthPrefix() + "_JWT."
 	keyspaceGen := constants.AuthPrefix() + "_GENERAL."
+	keyspaceMFA := constants.AuthPrefix() + "_MFA."
+	keyspaceLockout := constants.AuthPrefix() + "_LOCKOUT."
 
 	testCases := []struct {
 		name         string
@@ -28,7 +30,7 @@ func TestAuthConfigs_Load(t *testing.T) {
 			name:         "empty - etc dir",
 			input:        authConfigTestData["empty"],
 			expectErr:    require.Error,
-			expectErrCnt: 6,
+			expectErrCnt: 13,
 		}, {
 			name:         "valid - etc dir",
 			input:        authConfigTestData["valid"],
@@ -84,6 +86,76 @@ func TestAuthConfigs_Load(t *testing.T) {
 			input:        authConfigTestData["crypto_key_too_long"],
 			expectErr:    require.Error,
 			expectErrCnt: 1,
+		}, {
+			name:         "mfa no issuer - etc dir",
+			input:        authConfigTestData["mfa_no_issuer"],
+			expectErr:    require.Error,
+			expectErrCnt: 1,
+		}, {
+			name:         "mfa skew above 3 - etc dir",
+			input:        authConfigTestData["mfa_skew_above_3"],
+			expectErr:    require.Error,
+			expectErrCnt: 1,
+		}, {
+			name:         "mfa challenge expiration below 30s - etc dir",
+			input:        authConfigTestData["mfa_challenge_expiration_below_30s"],
+			expectErr:    require.Error,
+			expectErrCnt: 1,
+		}, {
+			name:         "mfa recovery codes above 16 - etc dir",
+			input:        authConfigTestData["mfa_recovery_codes_above_16"],
+			expectErr:    require.Error,
+			expectErrCnt: 1,
+		}, {
+			name:         "valid signing keys - etc dir",
+			input:        authConfigTestData["valid_signing_keys"],
+			expectErr:    require.NoError,
+			expectErrCnt: 0,
+		}, {
+			name:         "no jwt keys - etc dir",
+			input:        authConfigTestData["no_jwt_keys"],
+			expectErr:    require.Error,
+			expectErrCnt: 1,
+		}, {
+			name:         "invalid signing key - etc dir",
+			input:        authConfigTestData["signing_key_invalid"],
+			expectErr:    require.Error,
+			expectErrCnt: 3,
+		}, {
+			name:         "duplicate signing key id - etc dir",
+			input:        authConfigTestData["signing_key_duplicate_kid"],
+			expectErr:    require.Error,
+			expectErrCnt: 1,
+		}, {
+			name:         "valid crypto keys - etc dir",
+			input:        authConfigTestData["valid_crypto_keys"],
+			expectErr:    require.NoError,
+			expectErrCnt: 0,
+		}, {
+			name:         "no crypto keys - etc dir",
+			input:        authConfigTestData["no_crypto_keys"],
+			expectErr:    require.Error,
+			expectErrCnt: 1,
+		}, {
+			name:         "invalid crypto key - etc dir",
+			input:        authConfigTestData["crypto_key_invalid"],
+			expectErr:    require.Error,
+			expectErrCnt: 3,
+		}, {
+			name:         "duplicate crypto key id - etc dir",
+			input:        authConfigTestData["crypto_key_duplicate_id"],
+			expectErr:    require.Error,
+			expectErrCnt: 1,
+		}, {
+			name:         "lockout max duration below base - etc dir",
+			input:        authConfigTestData["lockout_max_duration_below_base"],
+			expectErr:    require.Error,
+			expectErrCnt: 1,
+		}, {
+			name:         "no lockout - etc dir",
+			input:        authConfigTestData["no_lockout"],
+			expectErr:    require.Error,
+			expectErrCnt: 4,
 		},
 	}
 
@@ -120,6 +192,9 @@ func TestAuthConfigs_Load(t *testing.T) {
 			testBcryptCost := 16
 			testIssuer := "test issuer"
 			testCryptoSecret := "**crypto secret set in env var**"
+			testMFAIssuer := "test mfa issuer"
+			testChallengeExpiration := int64(120)
+			testMaxAttempts := int64(3)
 
 			t.Setenv(keyspaceJwt+"KEY", testKey)
 			t.Setenv(keyspaceJwt+"ISSUER", testIssuer)
@@ -127,6 +202,9 @@ func TestAuthConfigs_Load(t *testing.T) {
 			t.Setenv(keyspaceJwt+"REFRESHTHRESHOLD", strconv.FormatInt(testRefThreshold, 10))
 			t.Setenv(keyspaceGen+"BCRYPTCOST", strconv.Itoa(testBcryptCost))
 			t.Setenv(keyspaceGen+"CRYPTOSECRET", testCryptoSecret)
+			t.Setenv(keyspaceMFA+"ISSUER", testMFAIssuer)
+			t.Setenv(keyspaceMFA+"CHALLENGEEXPIRATION", strconv.FormatInt(testChallengeExpiration, 10))
+			t.Setenv(keyspaceLockout+"MAXATTEMPTS", strconv.FormatInt(testMaxAttempts, 10))
 
 			err = actual.Load(fs)
 			require.NoErrorf(t, err, "Failed to load constants file: %v", err)
@@ -141,6 +219,12 @@ func TestAuthConfigs_Load(t *testing.T) {
 				"Failed to load bcrypt cost environment variable into configs")
 			require.Equal(t, testCryptoSecret, actual.General.CryptoSecret,
 				"Failed to load crypto secret environment variable into configs")
+			require.Equal(t, testMFAIssuer, actual.MFA.Issuer,
+				"Failed to load MFA issuer environment variable into configs")
+			require.Equal(t, testChallengeExpiration, actual.MFA.ChallengeExpiration,
+				"Failed to load MFA challenge expiration environment variable into configs")
+			require.Equal(t, testMaxAttempts, actual.Lockout.MaxAttempts,
+				"Failed to load lockout max attempts environment variable into configs")
 		})
 	}
 }
@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"sort"
+	"time"
+)
+
+// cipherKeyIDSeparator separates the ID of the key that generated a ciphertext from the Base64 encoded ciphertext. The
+// separator is not part of the Base64 URL encoding alphabet.
+const cipherKeyIDSeparator = "."
+
+// cryptoKey is an AES-256 encryption key from the keyring and its rotation schedule.
+type cryptoKey struct {
+	id         string
+	secret     []byte
+	activeFrom time.Time
+	retireAt   time.Time
+}
+
+// retired returns whether the key can no longer be used to decrypt ciphertexts.
+func (k *cryptoKey) retired(now time.Time) bool {
+	return !k.retireAt.IsZero() && !now.Before(k.retireAt)
+}
+
+// newCryptoKeys will load the keyring from its configurations. The keys are returned in order of activation time.
+func newCryptoKeys(configs []cryptoKeyConfig) []*cryptoKey {
+	keys := make([]*cryptoKey, 0, len(configs))
+
+	for idx := range configs {
+		cfg := &configs[idx]
+		keys = append(keys, &cryptoKey{
+			id:         cfg.ID,
+			secret:     []byte(cfg.Secret),
+			activeFrom: cfg.ActiveFrom,
+			retireAt:   cfg.RetireAt,
+		})
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].activeFrom.Before(keys[j].activeFrom) })
+
+	return keys
+}
+
+// activeCryptoKey returns the most recently activated key in the keyring that has not been retired. A nil key is
+// returned if there are no eligible keys.
+func (a *authImpl) activeCryptoKey(now time.Time) *cryptoKey {
+	for idx := len(a.cryptoKeys) - 1; idx >= 0; idx-- {
+		key := a.cryptoKeys[idx]
+		if key.activeFrom.After(now) || key.retired(now) {
+			continue
+		}
+
+		return key
+	}
+
+	return nil
+}
+
+// decryptionCryptoKey returns the key in the keyring with the ID that has not been retired. Keys that have been
+// replaced by a newer key can still decrypt ciphertexts until they are retired. A nil key is returned if there is no
+// eligible key.
+func (a *authImpl) decryptionCryptoKey(id string, now time.Time) *cryptoKey {
+	for _, key := range a.cryptoKeys {
+		if key.id == id && !key.retired(now) {
+			return key
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCryptoKeys(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	keys := newCryptoKeys([]cryptoKeyConfig{
+		{ID: "key3", Secret: "**crypto key three for testing**", ActiveFrom: now.Add(time.Hour), RetireAt: time.Time{}},
+		{ID: "key1", Secret: "***crypto key one for testing***", ActiveFrom: now.Add(-time.Hour), RetireAt: now},
+		{ID: "key2", Secret: "***crypto key two for testing***", ActiveFrom: now, RetireAt: time.Time{}},
+	})
+	require.Len(t, keys, 3, "crypto key count mismatched.")
+
+	for idx, id := range []string{"key1", "key2", "key3"} {
+		require.Equal(t, id, keys[idx].id, "crypto keys out of order.")
+	}
+}
+
+func TestAuthImpl_Keyring(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	retired := cryptoKeyConfig{
+		ID: "retired", Secret: "***retired crypto key testing***",
+		ActiveFrom: now.Add(-2 * time.Hour), RetireAt: now.Add(-time.Hour),
+	}
+	replaced := cryptoKeyConfig{
+		ID: "replaced", Secret: "**replaced crypto key testing***",
+		ActiveFrom: now.Add(-time.Hour), RetireAt: now.Add(time.Hour),
+	}
+	active := cryptoKeyConfig{
+		ID: "active", Secret: "***active crypto key testing****",
+		ActiveFrom: now.Add(-30 * time.Minute), RetireAt: time.Time{},
+	}
+	scheduled := cryptoKeyConfig{
+		ID: "scheduled", Secret: "**scheduled crypto key testing**",
+		ActiveFrom: now.Add(time.Hour), RetireAt: time.Time{},
+	}
+
+	// keyringAuth creates an authImpl with the keyring and optionally the crypto secret.
+	keyringAuth := func(withSecret bool, configs ...cryptoKeyConfig) *authImpl {
+		impl := testConfigurationImpl(zapLogger, 600, 60)
+		impl.cryptoKeys = newCryptoKeys(configs)
+
+		if !withSecret {
+			impl.cryptoSecret = nil
+		}
+
+		return impl
+	}
+
+	// encrypt generates a ciphertext from a plaintext with the keyring and optionally the crypto secret.
+	encrypt := func(t *testing.T, withSecret bool, configs ...cryptoKeyConfig) string {
+		t.Helper()
+
+		ciphertext, err := keyringAuth(withSecret, configs...).EncryptToString([]byte("plaintext"))
+		require.NoError(t, err, "failed to encrypt plaintext.")
+
+		return ciphertext
+	}
+
+	t.Run("active crypto key", func(t *testing.T) {
+		t.Parallel()
+
+		impl := keyringAuth(true, retired, replaced, active, scheduled)
+
+		testCases := []struct {
+			name     string
+			now      time.Time
+			expectID string
+		}{
+			{
+				name:     "before any activation",
+				now:      now.Add(-3 * time.Hour),
+				expectID: "",
+			}, {
+				name:     "single active key",
+				now:      now.Add(-90 * time.Minute),
+				expectID: "retired",
+			}, {
+				name:     "newest active key",
+				now:      now,
+				expectID: "active",
+			}, {
+				name:     "scheduled key activated",
+				now:      now.Add(2 * time.Hour),
+				expectID: "scheduled",
+			},
+		}
+
+		for _, testCase := range testCases {
+			test := testCase
+
+			key := impl.activeCryptoKey(test.now)
+			if test.expectID == "" {
+				require.Nil(t, key, "%s: ineligible crypto key returned.", test.name)
+
+				continue
+			}
+
+			require.NotNil(t, key, "%s: crypto key not returned.", test.name)
+			require.Equal(t, test.expectID, key.id, "%s: crypto key mismatched.", test.name)
+		}
+	})
+
+	t.Run("encrypt", func(t *testing.T) {
+		t.Parallel()
+
+		ciphertext := encrypt(t, true, replaced, active)
+		require.True(t, strings.HasPrefix(ciphertext, "active"+cipherKeyIDSeparator), "key ID prefix not set.")
+
+		ciphertext = encrypt(t, true)
+		require.NotContains(t, ciphertext, cipherKeyIDSeparator, "key ID prefix set without a keyring.")
+
+		_, err := keyringAuth(false).EncryptToString([]byte("plaintext"))
+		require.Error(t, err, "encrypted without a key.")
+	})
+
+	t.Run("decrypt", func(t *testing.T) {
+		t.Parallel()
+
+		relabelled := "active" + strings.TrimPrefix(encrypt(t, false, replaced), "replaced")
+
+		testCases := []struct {
+			name       string
+			ciphertext string
+			decrypter  *authImpl
+			expectErr  require.ErrorAssertionFunc
+		}{
+			{
+				name:       "active key",
+				ciphertext: encrypt(t, false, active),
+				decrypter:  keyringAuth(false, replaced, active),
+				expectErr:  require.NoError,
+			}, {
+				name:       "replaced key in grace period",
+				ciphertext: encrypt(t, false, replaced),
+				decrypter:  keyringAuth(false, replaced, active),
+				expectErr:  require.NoError,
+			}, {
+				name:       "retired key",
+				ciphertext: encrypt(t, false, replaced),
+				decrypter:  keyringAuth(false, retired, active),
+				expectErr:  require.Error,
+			}, {
+				name:       "unknown key",
+				ciphertext: encrypt(t, false, cryptoKeyConfig{ID: "unknown", Secret: active.Secret, ActiveFrom: now}),
+				decrypter:  keyringAuth(false, replaced, active),
+				expectErr:  require.Error,
+			}, {
+				name:       "substituted key ID",
+				ciphertext: relabelled,
+				decrypter:  keyringAuth(false, cryptoKeyConfig{ID: "active", Secret: replaced.Secret}),
+				expectErr:  require.Error,
+			}, {
+				name:       "malformed ciphertext",
+				ciphertext: "active" + cipherKeyIDSeparator + "AAAA",
+				decrypter:  keyringAuth(false, active),
+				expectErr:  require.Error,
+			}, {
+				name:       "unversioned with crypto secret",
+				ciphertext: encrypt(t, true),
+				decrypter:  keyringAuth(true, active),
+				expectErr:  require.NoError,
+			}, {
+				name:       "unversioned without crypto secret",
+				ciphertext: encrypt(t, true),
+				decrypter:  keyringAuth(false, active),
+				expectErr:  require.Error,
+			},
+		}
+
+		for _, testCase := range testCases {
+			test := testCase
+
+			plaintext, err := test.decrypter.DecryptFromString(test.ciphertext)
+			test.expectErr(t, err, "%s: error expectation failed.", test.name)
+
+			if err == nil {
+				require.Equal(t, "plaintext", string(plaintext), "%s: plaintext mismatched.", test.name)
+			}
+		}
+	})
+}
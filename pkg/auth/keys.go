@@ -0,0 +1,201 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/surahman/FTeX/pkg/models"
+)
+
+// minRSAKeyBits is the smallest RSA modulus that is accepted for signing JWTs.
+const minRSAKeyBits = 2048
+
+// signingKey is an asymmetric JWT signing key and its rotation schedule.
+type signingKey struct {
+	kid        string
+	method     jwt.SigningMethod
+	privateKey crypto.Signer
+	activeFrom time.Time
+	retireAt   time.Time
+}
+
+// retired returns whether the key can no longer be used to verify JWTs.
+func (k *signingKey) retired(now time.Time) bool {
+	return !k.retireAt.IsZero() && !now.Before(k.retireAt)
+}
+
+// jwk will generate the JSON Web Key representation of the public portion of the signing key.
+func (k *signingKey) jwk() models.JWK {
+	key := models.JWK{Use: "sig", Alg: k.method.Alg(), Kid: k.kid}
+
+	switch publicKey := k.privateKey.Public().(type) {
+	case *rsa.PublicKey:
+		key.Kty = "RSA"
+		key.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+		key.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+	case ed25519.PublicKey:
+		key.Kty = "OKP"
+		key.Crv = "Ed25519"
+		key.X = base64.RawURLEncoding.EncodeToString(publicKey)
+	}
+
+	return key
+}
+
+// parseSigningKeys will load the asymmetric JWT signing keys from their configurations. The keys are returned in order
+// of their activation times.
+func parseSigningKeys(configs []jwtKeyConfig) ([]*signingKey, error) {
+	keys := make([]*signingKey, 0, len(configs))
+
+	for idx := range configs {
+		var (
+			err error
+			cfg = &configs[idx]
+			key = &signingKey{kid: cfg.KID, activeFrom: cfg.ActiveFrom, retireAt: cfg.RetireAt}
+		)
+
+		if key.privateKey, key.method, err = parsePrivateKey(cfg.Algorithm, cfg.PrivateKey); err != nil {
+			return nil, fmt.Errorf("jwt signing key %s: %w", cfg.KID, err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].activeFrom.Before(keys[j].activeFrom) })
+
+	return keys, nil
+}
+
+// parsePrivateKey will decode a PEM encoded PKCS #8 private key, or PKCS #1 RSA private key, and check that it can be
+// used with the signing algorithm.
+func parsePrivateKey(algorithm, encoded string) (crypto.Signer, jwt.SigningMethod, error) {
+	var (
+		err    error
+		parsed any
+	)
+
+	block, _ := pem.Decode([]byte(encoded))
+	if block == nil {
+		return nil, nil, errors.New("private key is not PEM encoded")
+	}
+
+	if parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes); err != nil {
+		if parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+			return nil, nil, errors.New("private key is not a PKCS #8 or PKCS #1 key")
+		}
+	}
+
+	switch privateKey := parsed.(type) {
+	case *rsa.PrivateKey:
+		if algorithm != jwt.SigningMethodRS256.Alg() {
+			return nil, nil, fmt.Errorf("RSA private key cannot be used with %s", algorithm)
+		}
+
+		if privateKey.N.BitLen() < minRSAKeyBits {
+			return nil, nil, fmt.Errorf("RSA private key must be at least %d bits", minRSAKeyBits)
+		}
+
+		return privateKey, jwt.SigningMethodRS256, nil
+	case ed25519.PrivateKey:
+		if algorithm != jwt.SigningMethodEdDSA.Alg() {
+			return nil, nil, fmt.Errorf("Ed25519 private key cannot be used with %s", algorithm)
+		}
+
+		return privateKey, jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, nil, errors.New("private key type is not supported")
+	}
+}
+
+// activeSigningKey returns the most recently activated signing key that will not be retired before a JWT it signs now
+// expires. A nil key is returned if there are no eligible keys.
+func (a *authImpl) activeSigningKey(now time.Time) *signingKey {
+	expiresAt := now.Add(time.Duration(a.conf.JWTConfig.ExpirationDuration) * time.Second)
+
+	for idx := len(a.signingKeys) - 1; idx >= 0; idx-- {
+		key := a.signingKeys[idx]
+		if key.activeFrom.After(now) || key.retired(expiresAt) {
+			continue
+		}
+
+		return key
+	}
+
+	return nil
+}
+
+// jwtSigner returns the signing method, key, and key ID to sign a JWT with. Asymmetric signing keys take precedence
+// over the symmetric key, which does not set a key ID.
+func (a *authImpl) jwtSigner(now time.Time) (jwt.SigningMethod, any, string, error) {
+	if key := a.activeSigningKey(now); key != nil {
+		return key.method, key.privateKey, key.kid, nil
+	}
+
+	if a.conf.JWTConfig.Key != "" {
+		return jwt.SigningMethodHS256, []byte(a.conf.JWTConfig.Key), "", nil
+	}
+
+	return nil, nil, "", errors.New("no active jwt signing key")
+}
+
+// jwtVerificationKey returns the key to verify a JWT's signature with. JWTs with a key ID must be signed by an
+// unretired asymmetric key with the same algorithm, and JWTs without one must be signed with the symmetric key.
+//
+//nolint:revive
+func (a *authImpl) jwtVerificationKey(token *jwt.Token) (interface{}, error) {
+	rawKID, ok := token.Header["kid"]
+	if !ok {
+		if a.conf.JWTConfig.Key == "" || token.Method.Alg() != jwt.SigningMethodHS256.Alg() {
+			return nil, errors.New("unexpected jwt signing method")
+		}
+
+		return []byte(a.conf.JWTConfig.Key), nil
+	}
+
+	kid, ok := rawKID.(string)
+	if !ok {
+		return nil, errors.New("malformed jwt key id")
+	}
+
+	now := time.Now()
+	for _, key := range a.signingKeys {
+		if key.kid != kid || key.retired(now) {
+			continue
+		}
+
+		if token.Method.Alg() != key.method.Alg() {
+			return nil, errors.New("unexpected jwt signing method")
+		}
+
+		return key.privateKey.Public(), nil
+	}
+
+	return nil, errors.New("unknown jwt key id")
+}
+
+// JWKS returns the public keys that JWTs can be verified with as a JSON Web Key Set. Keys that are scheduled to be
+// activated are published ahead of time so that they are known before JWTs signed by them are issued.
+func (a *authImpl) JWKS() *models.JWKSet {
+	now := time.Now()
+	set := &models.JWKSet{Keys: make([]models.JWK, 0, len(a.signingKeys))}
+
+	for _, key := range a.signingKeys {
+		if key.retired(now) {
+			continue
+		}
+
+		set.Keys = append(set.Keys, key.jwk())
+	}
+
+	return set
+}
@@ -0,0 +1,369 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// testPEMKeys will generate PEM encoded RSA and Ed25519 private keys for the test suite.
+func testPEMKeys(t *testing.T) (string, string) {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, minRSAKeyBits)
+	require.NoError(t, err, "failed to generate RSA key.")
+
+	rsaDER, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+	require.NoError(t, err, "failed to marshal RSA key.")
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err, "failed to generate Ed25519 key.")
+
+	edDER, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err, "failed to marshal Ed25519 key.")
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: rsaDER})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: edDER}))
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	t.Parallel()
+
+	rsaPEM, edPEM := testPEMKeys(t)
+
+	smallRSAKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err, "failed to generate small RSA key.")
+
+	pkcs1PEM := string(pem.EncodeToMemory(
+		&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(smallRSAKey)}))
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err, "failed to generate ECDSA key.")
+
+	ecDER, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	require.NoError(t, err, "failed to marshal ECDSA key.")
+
+	ecPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: ecDER}))
+
+	testCases := []struct {
+		name      string
+		algorithm string
+		encoded   string
+		expectAlg string
+		expectErr require.ErrorAssertionFunc
+	}{
+		{
+			name:      "not PEM encoded",
+			algorithm: "EdDSA",
+			encoded:   "some-private-key",
+			expectErr: require.Error,
+		}, {
+			name:      "not a private key",
+			algorithm: "EdDSA",
+			encoded:   string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("bad key")})),
+			expectErr: require.Error,
+		}, {
+			name:      "unsupported key type",
+			algorithm: "EdDSA",
+			encoded:   ecPEM,
+			expectErr: require.Error,
+		}, {
+			name:      "RSA key with EdDSA",
+			algorithm: "EdDSA",
+			encoded:   rsaPEM,
+			expectErr: require.Error,
+		}, {
+			name:      "Ed25519 key with RS256",
+			algorithm: "RS256",
+			encoded:   edPEM,
+			expectErr: require.Error,
+		}, {
+			name:      "PKCS #1 RSA key below minimum size",
+			algorithm: "RS256",
+			encoded:   pkcs1PEM,
+			expectErr: require.Error,
+		}, {
+			name:      "RSA key",
+			algorithm: "RS256",
+			encoded:   rsaPEM,
+			expectAlg: "RS256",
+			expectErr: require.NoError,
+		}, {
+			name:      "Ed25519 key",
+			algorithm: "EdDSA",
+			encoded:   edPEM,
+			expectAlg: "EdDSA",
+			expectErr: require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			privateKey, method, err := parsePrivateKey(test.algorithm, test.encoded)
+			test.expectErr(t, err, "error expectation failed.")
+
+			if err != nil {
+				return
+			}
+
+			require.NotNil(t, privateKey, "private key not returned.")
+			require.Equal(t, test.expectAlg, method.Alg(), "signing method mismatched.")
+		})
+	}
+}
+
+func TestParseSigningKeys(t *testing.T) {
+	t.Parallel()
+
+	rsaPEM, edPEM := testPEMKeys(t)
+	now := time.Now().UTC()
+
+	t.Run("invalid key", func(t *testing.T) {
+		t.Parallel()
+
+		keys, err := parseSigningKeys([]jwtKeyConfig{
+			{KID: "key-1", Algorithm: "RS256", PrivateKey: edPEM, ActiveFrom: now, RetireAt: time.Time{}},
+		})
+		require.Error(t, err, "mismatched key and algorithm parsed.")
+		require.Nil(t, keys, "keys returned on failure.")
+	})
+
+	t.Run("ordered by activation", func(t *testing.T) {
+		t.Parallel()
+
+		keys, err := parseSigningKeys([]jwtKeyConfig{
+			{KID: "key-3", Algorithm: "EdDSA", PrivateKey: edPEM, ActiveFrom: now.Add(time.Hour), RetireAt: time.Time{}},
+			{KID: "key-1", Algorithm: "RS256", PrivateKey: rsaPEM, ActiveFrom: now.Add(-time.Hour), RetireAt: now},
+			{KID: "key-2", Algorithm: "EdDSA", PrivateKey: edPEM, ActiveFrom: now, RetireAt: time.Time{}},
+		})
+		require.NoError(t, err, "failed to parse signing keys.")
+		require.Len(t, keys, 3, "signing key count mismatched.")
+
+		for idx, kid := range []string{"key-1", "key-2", "key-3"} {
+			require.Equal(t, kid, keys[idx].kid, "signing keys out of order.")
+		}
+	})
+}
+
+func TestAuthImpl_SigningKeys(t *testing.T) {
+	t.Parallel()
+
+	rsaPEM, edPEM := testPEMKeys(t)
+	now := time.Now().UTC()
+
+	signingKeys, err := parseSigningKeys([]jwtKeyConfig{
+		{
+			KID: "retired", Algorithm: "EdDSA", PrivateKey: edPEM,
+			ActiveFrom: now.Add(-2 * time.Hour), RetireAt: now.Add(-time.Hour),
+		}, {
+			KID: "retiring", Algorithm: "RS256", PrivateKey: rsaPEM,
+			ActiveFrom: now.Add(-time.Hour), RetireAt: now.Add(5 * time.Minute),
+		}, {
+			KID: "active", Algorithm: "EdDSA", PrivateKey: edPEM,
+			ActiveFrom: now.Add(-30 * time.Minute), RetireAt: now.Add(35 * time.Minute),
+		}, {
+			KID: "scheduled", Algorithm: "RS256", PrivateKey: rsaPEM,
+			ActiveFrom: now.Add(30 * time.Minute), RetireAt: time.Time{},
+		},
+	})
+	require.NoError(t, err, "failed to parse signing keys.")
+
+	testAuthImpl := testConfigurationImpl(zapLogger, 600, 60)
+	testAuthImpl.signingKeys = signingKeys
+
+	t.Run("active signing key", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			name      string
+			now       time.Time
+			expectKID string
+		}{
+			{
+				name:      "before any activation",
+				now:       now.Add(-3 * time.Hour),
+				expectKID: "",
+			}, {
+				name:      "single active key",
+				now:       now.Add(-90 * time.Minute),
+				expectKID: "retired",
+			}, {
+				name:      "older key retires before expiration",
+				now:       now.Add(-58 * time.Minute),
+				expectKID: "retiring",
+			}, {
+				name:      "newest active key",
+				now:       now.Add(-15 * time.Minute),
+				expectKID: "active",
+			}, {
+				name:      "all active keys retire before expiration",
+				now:       now.Add(28 * time.Minute),
+				expectKID: "",
+			}, {
+				name:      "scheduled key activated",
+				now:       now.Add(55 * time.Minute),
+				expectKID: "scheduled",
+			},
+		}
+
+		for _, testCase := range testCases {
+			test := testCase
+
+			key := testAuthImpl.activeSigningKey(test.now)
+			if test.expectKID == "" {
+				require.Nil(t, key, "%s: ineligible signing key returned.", test.name)
+
+				continue
+			}
+
+			require.NotNil(t, key, "%s: signing key not returned.", test.name)
+			require.Equal(t, test.expectKID, key.kid, "%s: signing key mismatched.", test.name)
+		}
+	})
+
+	t.Run("sign and verify", func(t *testing.T) {
+		t.Parallel()
+
+		clientID, err := uuid.NewV4()
+		require.NoError(t, err, "failed to generate client id.")
+
+		authResponse, err := testAuthImpl.GenerateJWT(clientID)
+		require.NoError(t, err, "failed to generate JWT.")
+
+		token, _, err := jwt.NewParser().ParseUnverified(authResponse.Token, &jwtClaim{})
+		require.NoError(t, err, "failed to parse JWT header.")
+		require.Equal(t, "active", token.Header["kid"], "key id not set in header.")
+		require.Equal(t, "EdDSA", token.Method.Alg(), "signing method mismatched.")
+
+		actualClientID, _, err := testAuthImpl.ValidateJWT(authResponse.Token)
+		require.NoError(t, err, "failed to validate JWT.")
+		require.Equal(t, clientID, actualClientID, "client id mismatched.")
+	})
+
+	t.Run("verification keys", func(t *testing.T) {
+		t.Parallel()
+
+		claims := &jwtClaim{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    testAuthImpl.conf.JWTConfig.Issuer,
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+		}
+
+		signKID := func(key *signingKey, kid string) string {
+			token := jwt.NewWithClaims(key.method, claims)
+			token.Header["kid"] = kid
+			signed, err := token.SignedString(key.privateKey)
+			require.NoError(t, err, "failed to sign JWT.")
+
+			return signed
+		}
+
+		symmetric, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).
+			SignedString([]byte(testAuthImpl.conf.JWTConfig.Key))
+		require.NoError(t, err, "failed to sign symmetric JWT.")
+
+		symmetricKID := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		symmetricKID.Header["kid"] = "active"
+		symmetricKIDSigned, err := symmetricKID.SignedString([]byte(testAuthImpl.conf.JWTConfig.Key))
+		require.NoError(t, err, "failed to sign symmetric JWT with key id.")
+
+		testCases := []struct {
+			name      string
+			token     string
+			expectErr require.ErrorAssertionFunc
+		}{
+			{
+				name:      "symmetric key",
+				token:     symmetric,
+				expectErr: require.NoError,
+			}, {
+				name:      "symmetric key with asymmetric key id",
+				token:     symmetricKIDSigned,
+				expectErr: require.Error,
+			}, {
+				name:      "retired key",
+				token:     signKID(signingKeys[0], "retired"),
+				expectErr: require.Error,
+			}, {
+				name:      "unknown key id",
+				token:     signKID(signingKeys[2], "unknown"),
+				expectErr: require.Error,
+			}, {
+				name:      "key id of another key",
+				token:     signKID(signingKeys[2], "scheduled"),
+				expectErr: require.Error,
+			}, {
+				name:      "retiring key",
+				token:     signKID(signingKeys[1], "retiring"),
+				expectErr: require.NoError,
+			}, {
+				name:      "scheduled key",
+				token:     signKID(signingKeys[3], "scheduled"),
+				expectErr: require.NoError,
+			},
+		}
+
+		for _, testCase := range testCases {
+			test := testCase
+
+			_, err := testAuthImpl.parseJWT(test.token)
+			test.expectErr(t, err, "%s: error expectation failed.", test.name)
+		}
+	})
+
+	t.Run("no symmetric key", func(t *testing.T) {
+		t.Parallel()
+
+		asymmetricOnly := testConfigurationImpl(zapLogger, 600, 60)
+		asymmetricOnly.conf.JWTConfig.Key = ""
+
+		symmetric, err := testConfigurationImpl(zapLogger, 600, 60).GenerateJWT(uuid.UUID{})
+		require.NoError(t, err, "failed to generate symmetric JWT.")
+
+		_, err = asymmetricOnly.parseJWT(symmetric.Token)
+		require.Error(t, err, "symmetric JWT verified without a symmetric key.")
+
+		_, err = asymmetricOnly.GenerateJWT(uuid.UUID{})
+		require.Error(t, err, "JWT generated without a signing key.")
+	})
+
+	t.Run("JWKS", func(t *testing.T) {
+		t.Parallel()
+
+		jwks := testAuthImpl.JWKS()
+		require.Len(t, jwks.Keys, 3, "retired key published.")
+
+		for _, key := range jwks.Keys {
+			require.NotEqual(t, "retired", key.Kid, "retired key published.")
+			require.Equal(t, "sig", key.Use, "key use mismatched.")
+
+			switch key.Alg {
+			case "RS256":
+				require.Equal(t, "RSA", key.Kty, "RSA key type mismatched.")
+				require.Equal(t, "AQAB", key.E, "RSA exponent mismatched.")
+				require.NotEmpty(t, key.N, "RSA modulus not set.")
+			case "EdDSA":
+				require.Equal(t, "OKP", key.Kty, "Ed25519 key type mismatched.")
+				require.Equal(t, "Ed25519", key.Crv, "Ed25519 curve mismatched.")
+				require.NotEmpty(t, key.X, "Ed25519 public key not set.")
+			default:
+				require.Failf(t, "unexpected algorithm", "algorithm %s", key.Alg)
+			}
+		}
+	})
+}
@@ -0,0 +1,25 @@
+package auth
+
+import "time"
+
+// LoginLockout returns the duration an account should be locked for after a number of consecutive failed login
+// attempts. Accounts are not locked until the configured maximum number of attempts is reached, after which the lockout
+// duration doubles with each further failure up to the configured ceiling.
+func (a *authImpl) LoginLockout(failures int64) time.Duration {
+	if failures < a.conf.Lockout.MaxAttempts {
+		return 0
+	}
+
+	duration := a.conf.Lockout.BaseDuration
+
+	for excess := failures - a.conf.Lockout.MaxAttempts; excess > 0 && duration < a.conf.Lockout.MaxDuration; excess-- {
+		duration *= 2
+	}
+
+	return min(duration, a.conf.Lockout.MaxDuration)
+}
+
+// LoginFailureWindow is the duration that failed login attempts are tracked for before the count is reset.
+func (a *authImpl) LoginFailureWindow() time.Duration {
+	return a.conf.Lockout.FailureWindow
+}
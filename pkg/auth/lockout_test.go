@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockout_LoginLockout(t *testing.T) {
+	t.Parallel()
+
+	auth := testConfigurationImpl(zapLogger, expirationDuration, refreshThreshold)
+	auth.conf.Lockout.MaxAttempts = 3
+	auth.conf.Lockout.BaseDuration = time.Minute
+	auth.conf.Lockout.MaxDuration = 10 * time.Minute
+
+	testCases := []struct {
+		name     string
+		failures int64
+		expected time.Duration
+	}{
+		{
+			name:     "no failures",
+			failures: 0,
+			expected: 0,
+		}, {
+			name:     "below max attempts",
+			failures: 2,
+			expected: 0,
+		}, {
+			name:     "at max attempts",
+			failures: 3,
+			expected: time.Minute,
+		}, {
+			name:     "one over max attempts",
+			failures: 4,
+			expected: 2 * time.Minute,
+		}, {
+			name:     "two over max attempts",
+			failures: 5,
+			expected: 4 * time.Minute,
+		}, {
+			name:     "capped at max duration",
+			failures: 7,
+			expected: 10 * time.Minute,
+		}, {
+			name:     "far beyond max attempts",
+			failures: 1_000_000,
+			expected: 10 * time.Minute,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.expected, auth.LoginLockout(test.failures), "lockout duration mismatch.")
+		})
+	}
+}
+
+func TestLockout_LoginFailureWindow(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, testAuth.conf.Lockout.FailureWindow, testAuth.LoginFailureWindow(), "failure window mismatch.")
+}
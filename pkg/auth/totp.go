@@ -0,0 +1,170 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/surahman/FTeX/pkg/constants"
+)
+
+// StepUp is a category of sensitive operations that can be configured to require step-up multifactor authentication.
+type StepUp int
+
+const (
+	StepUpNone      StepUp = iota // StepUpNone operations never require step-up multifactor authentication.
+	StepUpTransfers               // StepUpTransfers operations move funds out of or between accounts.
+	StepUpDeletes                 // StepUpDeletes operations delete a user account.
+)
+
+const (
+	// totpSecretBytes is the size of a Time-based One-Time Password secret. RFC 4226 recommends 160 bits.
+	totpSecretBytes = 20
+
+	// recoveryCodeBytes is the size of a recovery code before it is encoded.
+	recoveryCodeBytes = 10
+
+	// recoveryCodeGroup is the number of characters between the separators in a formatted recovery code.
+	recoveryCodeGroup = 4
+)
+
+// totpEncoding is the unpadded Base32 encoding used by authenticator applications for secrets.
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTP will create a fresh Time-based One-Time Password secret for an account. The Base32 encoded secret and the
+// provisioning URI to be used by authenticator applications are returned.
+func (a *authImpl) GenerateTOTP(accountName string) (secret string, uri string, err error) {
+	rawSecret := make([]byte, totpSecretBytes)
+	if _, err = rand.Read(rawSecret); err != nil {
+		return "", "", fmt.Errorf(constants.ErrorFormatMessage(), "failed to generate totp secret", err)
+	}
+
+	secret = totpEncoding.EncodeToString(rawSecret)
+
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", a.conf.MFA.Issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprintf("%d", constants.TOTPDigits()))
+	params.Set("period", fmt.Sprintf("%d", int64(constants.TOTPPeriod().Seconds())))
+
+	uri = (&url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + a.conf.MFA.Issuer + ":" + accountName,
+		RawQuery: params.Encode(),
+	}).String()
+
+	return secret, uri, nil
+}
+
+// ValidateTOTP will check a Time-based One-Time Password code against a Base32 encoded secret. The time step the code
+// was generated for is returned so that callers can block replays of the code.
+func (a *authImpl) ValidateTOTP(secret, code string) (int64, error) {
+	return a.validateTOTPAt(secret, code, time.Now())
+}
+
+// validateTOTPAt will check a Time-based One-Time Password code against a secret at a specific point in time. Codes
+// from the configured number of time steps on either side of the current step are accepted to allow for clock skew.
+func (a *authImpl) validateTOTPAt(secret, code string, at time.Time) (int64, error) {
+	if len(code) != constants.TOTPDigits() {
+		return -1, errors.New("invalid one-time password code")
+	}
+
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return -1, fmt.Errorf(constants.ErrorFormatMessage(), "failed to decode totp secret", err)
+	}
+
+	current := at.Unix() / int64(constants.TOTPPeriod().Seconds())
+
+	for step := current - a.conf.MFA.Skew; step <= current+a.conf.MFA.Skew; step++ {
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, step)), []byte(code)) == 1 {
+			return step, nil
+		}
+	}
+
+	return -1, errors.New("invalid one-time password code")
+}
+
+// totpCode will generate the HMAC-based One-Time Password code, as outlined in RFC 4226, for a time step.
+func totpCode(key []byte, step int64) string {
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation.
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulus := uint32(1)
+	for idx := 0; idx < constants.TOTPDigits(); idx++ {
+		modulus *= 10
+	}
+
+	return fmt.Sprintf("%0*d", constants.TOTPDigits(), value%modulus)
+}
+
+// GenerateRecoveryCodes will create the configured number of single-use recovery codes. The codes are returned in
+// plaintext, to be shown to the user once, and in hashed form for storage.
+func (a *authImpl) GenerateRecoveryCodes() (plaintext []string, hashed []string, err error) {
+	plaintext = make([]string, a.conf.MFA.RecoveryCodes)
+	hashed = make([]string, a.conf.MFA.RecoveryCodes)
+
+	for idx := range plaintext {
+		rawCode := make([]byte, recoveryCodeBytes)
+		if _, err = rand.Read(rawCode); err != nil {
+			return nil, nil, fmt.Errorf(constants.ErrorFormatMessage(), "failed to generate recovery code", err)
+		}
+
+		encoded := totpEncoding.EncodeToString(rawCode)
+		groups := make([]string, 0, len(encoded)/recoveryCodeGroup)
+
+		for start := 0; start < len(encoded); start += recoveryCodeGroup {
+			groups = append(groups, encoded[start:min(start+recoveryCodeGroup, len(encoded))])
+		}
+
+		plaintext[idx] = strings.Join(groups, "-")
+		hashed[idx] = a.HashRecoveryCode(plaintext[idx])
+	}
+
+	return plaintext, hashed, nil
+}
+
+// HashRecoveryCode will generate the SHA-256 hex digest of a recovery code. Separators and letter casing are ignored.
+func (a *authImpl) HashRecoveryCode(code string) string {
+	normalized := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	digest := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(digest[:])
+}
+
+// MFAChallengeExpiration is the time in seconds that a login multifactor authentication challenge is valid for.
+func (a *authImpl) MFAChallengeExpiration() int64 {
+	return a.conf.MFA.ChallengeExpiration
+}
+
+// StepUpRequired returns whether a category of sensitive operations requires step-up multifactor authentication.
+func (a *authImpl) StepUpRequired(operation StepUp) bool {
+	switch operation {
+	case StepUpTransfers:
+		return a.conf.MFA.StepUpTransfers
+	case StepUpDeletes:
+		return a.conf.MFA.StepUpDeletes
+	default:
+		return false
+	}
+}
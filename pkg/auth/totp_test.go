@@ -0,0 +1,224 @@
+package auth
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+)
+
+func TestTOTP_totpCode(t *testing.T) {
+	t.Parallel()
+
+	// RFC 6238 Appendix B SHA1 test vectors truncated to six digits.
+	key := []byte("12345678901234567890")
+
+	testCases := []struct {
+		name     string
+		unixTime int64
+		expected string
+	}{
+		{
+			name:     "59",
+			unixTime: 59,
+			expected: "287082",
+		}, {
+			name:     "1111111109",
+			unixTime: 1111111109,
+			expected: "081804",
+		}, {
+			name:     "1111111111",
+			unixTime: 1111111111,
+			expected: "050471",
+		}, {
+			name:     "1234567890",
+			unixTime: 1234567890,
+			expected: "005924",
+		}, {
+			name:     "2000000000",
+			unixTime: 2000000000,
+			expected: "279037",
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			step := test.unixTime / int64(constants.TOTPPeriod().Seconds())
+			require.Equal(t, test.expected, totpCode(key, step), "code mismatch.")
+		})
+	}
+}
+
+func TestTOTP_GenerateTOTP(t *testing.T) {
+	t.Parallel()
+
+	secret, uri, err := testAuth.GenerateTOTP("username")
+	require.NoError(t, err, "failed to generate secret.")
+
+	rawSecret, err := totpEncoding.DecodeString(secret)
+	require.NoError(t, err, "failed to decode secret.")
+	require.Len(t, rawSecret, totpSecretBytes, "secret length mismatch.")
+
+	parsed, err := url.Parse(uri)
+	require.NoError(t, err, "failed to parse provisioning uri.")
+	require.Equal(t, "otpauth", parsed.Scheme, "scheme mismatch.")
+	require.Equal(t, "totp", parsed.Host, "type mismatch.")
+	require.Equal(t, "/"+testAuth.conf.MFA.Issuer+":username", parsed.Path, "label mismatch.")
+	require.Equal(t, secret, parsed.Query().Get("secret"), "secret mismatch.")
+	require.Equal(t, testAuth.conf.MFA.Issuer, parsed.Query().Get("issuer"), "issuer mismatch.")
+
+	otherSecret, _, err := testAuth.GenerateTOTP("username")
+	require.NoError(t, err, "failed to generate second secret.")
+	require.NotEqual(t, secret, otherSecret, "secrets are not unique.")
+}
+
+func TestTOTP_validateTOTPAt(t *testing.T) {
+	t.Parallel()
+
+	secret := totpEncoding.EncodeToString([]byte("12345678901234567890"))
+	period := int64(constants.TOTPPeriod().Seconds())
+	at := time.Unix(1111111111, 0)
+	step := at.Unix() / period
+
+	testCases := []struct {
+		name         string
+		secret       string
+		code         string
+		expectedStep int64
+		expectErr    require.ErrorAssertionFunc
+	}{
+		{
+			name:         "current step",
+			secret:       secret,
+			code:         "050471",
+			expectedStep: step,
+			expectErr:    require.NoError,
+		}, {
+			name:         "lower case secret",
+			secret:       strings.ToLower(secret),
+			code:         "050471",
+			expectedStep: step,
+			expectErr:    require.NoError,
+		}, {
+			name:         "previous step within skew",
+			secret:       secret,
+			code:         totpCode([]byte("12345678901234567890"), step-1),
+			expectedStep: step - 1,
+			expectErr:    require.NoError,
+		}, {
+			name:         "next step within skew",
+			secret:       secret,
+			code:         totpCode([]byte("12345678901234567890"), step+1),
+			expectedStep: step + 1,
+			expectErr:    require.NoError,
+		}, {
+			name:         "outside skew",
+			secret:       secret,
+			code:         totpCode([]byte("12345678901234567890"), step+2),
+			expectedStep: -1,
+			expectErr:    require.Error,
+		}, {
+			name:         "wrong code",
+			secret:       secret,
+			code:         "000000",
+			expectedStep: -1,
+			expectErr:    require.Error,
+		}, {
+			name:         "short code",
+			secret:       secret,
+			code:         "05047",
+			expectedStep: -1,
+			expectErr:    require.Error,
+		}, {
+			name:         "invalid secret",
+			secret:       "not-base32!",
+			code:         "050471",
+			expectedStep: -1,
+			expectErr:    require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualStep, err := testAuth.validateTOTPAt(test.secret, test.code, at)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectedStep, actualStep, "time step mismatch.")
+		})
+	}
+}
+
+func TestTOTP_ValidateTOTP(t *testing.T) {
+	t.Parallel()
+
+	secret, _, err := testAuth.GenerateTOTP("username")
+	require.NoError(t, err, "failed to generate secret.")
+
+	key, err := totpEncoding.DecodeString(secret)
+	require.NoError(t, err, "failed to decode secret.")
+
+	step := time.Now().Unix() / int64(constants.TOTPPeriod().Seconds())
+
+	actualStep, err := testAuth.ValidateTOTP(secret, totpCode(key, step))
+	require.NoError(t, err, "failed to validate current code.")
+	require.Equal(t, step, actualStep, "time step mismatch.")
+}
+
+func TestTOTP_GenerateRecoveryCodes(t *testing.T) {
+	t.Parallel()
+
+	plaintext, hashed, err := testAuth.GenerateRecoveryCodes()
+	require.NoError(t, err, "failed to generate recovery codes.")
+	require.Len(t, plaintext, testAuth.conf.MFA.RecoveryCodes, "plaintext code count mismatch.")
+	require.Len(t, hashed, testAuth.conf.MFA.RecoveryCodes, "hashed code count mismatch.")
+
+	unique := make(map[string]struct{})
+
+	for idx, code := range plaintext {
+		require.Len(t, code, 19, "recovery code format mismatch.")
+		require.Equal(t, testAuth.HashRecoveryCode(code), hashed[idx], "hashed code mismatch.")
+
+		unique[code] = struct{}{}
+	}
+
+	require.Len(t, unique, len(plaintext), "recovery codes are not unique.")
+}
+
+func TestTOTP_HashRecoveryCode(t *testing.T) {
+	t.Parallel()
+
+	expected := testAuth.HashRecoveryCode("ABCD-EFGH-IJKL-MNOP")
+
+	require.Len(t, expected, 64, "digest length mismatch.")
+	require.Equal(t, expected, testAuth.HashRecoveryCode("abcd efgh ijkl mnop"), "normalization failed.")
+	require.Equal(t, expected, testAuth.HashRecoveryCode("ABCDEFGHIJKLMNOP"), "separator removal failed.")
+	require.NotEqual(t, expected, testAuth.HashRecoveryCode("ABCD-EFGH-IJKL-MNOQ"), "different codes matched.")
+}
+
+func TestTOTP_MFAChallengeExpiration(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, testAuth.conf.MFA.ChallengeExpiration, testAuth.MFAChallengeExpiration(),
+		"challenge expiration mismatch.")
+}
+
+func TestTOTP_StepUpRequired(t *testing.T) {
+	t.Parallel()
+
+	auth := testConfigurationImpl(zapLogger, expirationDuration, refreshThreshold)
+	auth.conf.MFA.StepUpDeletes = false
+
+	require.False(t, auth.StepUpRequired(StepUpNone), "step-up required for no operation.")
+	require.True(t, auth.StepUpRequired(StepUpTransfers), "step-up not required for transfers.")
+	require.False(t, auth.StepUpRequired(StepUpDeletes), "step-up required for deletes.")
+}
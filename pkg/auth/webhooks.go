@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/surahman/FTeX/pkg/constants"
+)
+
+const (
+	// webhookSecretPrefix identifies FTeX webhook signing secrets so that they can be recognised by secret scanners.
+	webhookSecretPrefix = "whsec_"
+
+	// webhookSecretBytes is the size of the random portion of a webhook signing secret before it is encoded.
+	webhookSecretBytes = 32
+)
+
+// GenerateWebhookSecret will create a fresh webhook signing secret. The secret is returned in plaintext, to be shown to
+// the user once, and in encrypted form for storage. Unlike API keys, the secret must be recoverable to sign the
+// webhook requests.
+func (a *authImpl) GenerateWebhookSecret() (plaintext string, encrypted string, err error) {
+	rawSecret := make([]byte, webhookSecretBytes)
+	if _, err = rand.Read(rawSecret); err != nil {
+		return "", "", fmt.Errorf(constants.ErrorFormatMessage(), "failed to generate webhook secret", err)
+	}
+
+	plaintext = webhookSecretPrefix + base64.RawURLEncoding.EncodeToString(rawSecret)
+
+	if encrypted, err = a.EncryptToString([]byte(plaintext)); err != nil {
+		return "", "", fmt.Errorf(constants.ErrorFormatMessage(), "failed to encrypt webhook secret", err)
+	}
+
+	return plaintext, encrypted, nil
+}
@@ -0,0 +1,25 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhooks_GenerateWebhookSecret(t *testing.T) {
+	t.Parallel()
+
+	plaintext, encrypted, err := testAuth.GenerateWebhookSecret()
+	require.NoError(t, err, "failed to generate webhook secret.")
+	require.True(t, strings.HasPrefix(plaintext, webhookSecretPrefix), "webhook secret prefix not set.")
+	require.Len(t, plaintext, len(webhookSecretPrefix)+43, "webhook secret length mismatch.")
+
+	decrypted, err := testAuth.DecryptFromString(encrypted)
+	require.NoError(t, err, "failed to decrypt webhook secret.")
+	require.Equal(t, plaintext, string(decrypted), "decrypted webhook secret mismatch.")
+
+	other, _, err := testAuth.GenerateWebhookSecret()
+	require.NoError(t, err, "failed to generate second webhook secret.")
+	require.NotEqual(t, plaintext, other, "webhook secrets are not unique.")
+}
@@ -0,0 +1,354 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// adminUserError will extract the message and HTTP status code from a user account database error. The login error
+// message for unknown accounts is replaced because administrators do not supply credentials.
+func adminUserError(logger *logger.Logger, err error) (string, int, error) {
+	if errors.Is(err, postgres.ErrNotFoundUser) {
+		return "user not found", http.StatusNotFound, fmt.Errorf("%w", err)
+	}
+
+	logger.Warn("failed to complete administrative user account request", zap.Error(err))
+
+	return constants.RetryMessageString(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+}
+
+// HTTPAdminCheck will check that a client has been granted the administrator role.
+func HTTPAdminCheck(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID) (string, int, error) {
+	role, err := db.UserGetRole(clientID)
+	if err != nil {
+		logger.Error("unable to retrieve client role", zap.Error(err))
+
+		return constants.RetryMessageString(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+	}
+
+	if role != postgres.UserRoleAdmin {
+		msg := "administrator role required"
+
+		return msg, http.StatusForbidden, errors.New(msg)
+	}
+
+	return "", 0, nil
+}
+
+// HTTPAccountFrozen will check whether a frozen account is attempting to access an endpoint that moves funds. Frozen
+// accounts can still access endpoints that do not trade, deposit, or withdraw funds.
+func HTTPAccountFrozen(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, scope auth.Scope) (
+	string, int, error) {
+	if !scope.MovesFunds() {
+		return "", 0, nil
+	}
+
+	isFrozen, err := db.UserIsFrozen(clientID)
+	if err != nil {
+		logger.Error("unable to retrieve client account frozen status", zap.Error(err))
+
+		return constants.RetryMessageString(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+	}
+
+	if isFrozen {
+		msg := "account is frozen, please contact support"
+
+		return msg, http.StatusForbidden, errors.New(msg)
+	}
+
+	return "", 0, nil
+}
+
+// HTTPAdminParseClientID will extract and validate the Client ID of the account an administrative request acts on.
+func HTTPAdminParseClientID(clientIDStr string) (uuid.UUID, string, int, error) {
+	clientID, err := uuid.FromString(clientIDStr)
+	if err != nil {
+		return uuid.UUID{}, "invalid client ID", http.StatusBadRequest, fmt.Errorf("%w", err)
+	}
+
+	return clientID, "", 0, nil
+}
+
+// HTTPAdminUser will retrieve the account information of a user by their username.
+func HTTPAdminUser(db postgres.Postgres, logger *logger.Logger, username string) (
+	*models.HTTPAdminUserResponse, string, int, error) {
+	var (
+		err        error
+		clientID   uuid.UUID
+		user       modelsPostgres.User
+		httpMsg    string
+		httpStatus int
+	)
+
+	if clientID, err = db.UserGetClientID(username); err != nil {
+		httpMsg, httpStatus, err = adminUserError(logger, err)
+
+		return nil, httpMsg, httpStatus, err
+	}
+
+	if user, err = db.UserGetInfo(clientID); err != nil {
+		httpMsg, httpStatus, err = adminUserError(logger, err)
+
+		return nil, httpMsg, httpStatus, err
+	}
+
+	return &models.HTTPAdminUserResponse{
+		ClientID:  user.ClientID.String(),
+		Username:  user.Username,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Email:     user.Email,
+		Role:      user.Role,
+		IsFrozen:  user.IsFrozen,
+		IsDeleted: user.IsDeleted,
+	}, "", 0, nil
+}
+
+// HTTPAdminSetFrozen will freeze or unfreeze a user's account. Frozen accounts cannot trade, deposit, or withdraw
+// funds, and their open orders and recurring purchase schedules are not executed.
+func HTTPAdminSetFrozen(db postgres.Postgres, logger *logger.Logger, clientIDStr string, isFrozen bool) (
+	string, int, error) {
+	clientID, httpMsg, httpStatus, err := HTTPAdminParseClientID(clientIDStr)
+	if err != nil {
+		return httpMsg, httpStatus, err
+	}
+
+	if err = db.UserSetFrozen(clientID, isFrozen); err != nil {
+		return adminUserError(logger, err)
+	}
+
+	return "", 0, nil
+}
+
+// HTTPAdminReverseDeposit will reverse an erroneous Fiat deposit into a user's account by posting compensating Journal
+// entries. The deposit is never edited or removed.
+func HTTPAdminReverseDeposit(db postgres.Postgres, logger *logger.Logger, adminID uuid.UUID,
+	clientIDStr, txIDStr string) (*postgres.FiatAccountTransferResult, string, int, error) {
+	var (
+		err        error
+		clientID   uuid.UUID
+		txID       uuid.UUID
+		receipt    *postgres.FiatAccountTransferResult
+		httpMsg    string
+		httpStatus int
+	)
+
+	if clientID, httpMsg, httpStatus, err = HTTPAdminParseClientID(clientIDStr); err != nil {
+		return nil, httpMsg, httpStatus, err
+	}
+
+	if txID, err = uuid.FromString(txIDStr); err != nil {
+		return nil, "invalid transaction ID", http.StatusBadRequest, fmt.Errorf("%w", err)
+	}
+
+	if receipt, err = db.FiatReverseDeposit(context.Background(), adminID, clientID, txID); err != nil {
+		var reverseErr *postgres.Error
+		if !errors.As(err, &reverseErr) {
+			logger.Info("failed to unpack Fiat deposit reversal error", zap.Error(err))
+
+			return nil, constants.RetryMessageString(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+		}
+
+		return nil, reverseErr.Message, reverseErr.Code, fmt.Errorf("%w", err)
+	}
+
+	logger.Info("Fiat deposit reversed", zap.String("adminID", adminID.String()),
+		zap.String("clientID", clientID.String()), zap.String("txID", txID.String()))
+
+	return receipt, "", 0, nil
+}
+
+// adminAccountError will extract the message and HTTP status code from an account status or hold database error.
+func adminAccountError(logger *logger.Logger, err error) (string, int, error) {
+	var accountErr *postgres.Error
+	if !errors.As(err, &accountErr) {
+		logger.Info("failed to unpack administrative account request error", zap.Error(err))
+
+		return constants.RetryMessageString(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+	}
+
+	return accountErr.Message, accountErr.Code, fmt.Errorf("%w", err)
+}
+
+// adminHoldAmount will check that a hold amount is positive and has the correct number of decimal places. The amount is
+// negated when funds on hold are being released.
+func adminHoldAmount(amount decimal.Decimal, decimalPlaces int32, release bool) (decimal.Decimal, error) {
+	if !amount.Equal(amount.Truncate(decimalPlaces)) || !amount.IsPositive() {
+		return decimal.Zero, errors.New("invalid amount")
+	}
+
+	if release {
+		return amount.Neg(), nil
+	}
+
+	return amount, nil
+}
+
+// HTTPAdminFiatSetStatus will set the status of a user's Fiat currency account. Accounts that are not active cannot
+// send or receive funds.
+func HTTPAdminFiatSetStatus(db postgres.Postgres, logger *logger.Logger, clientIDStr string,
+	request *models.HTTPAccountStatusRequest) (*postgres.FiatAccount, string, int, any, error) {
+	var (
+		err        error
+		account    postgres.FiatAccount
+		clientID   uuid.UUID
+		currency   postgres.Currency
+		status     postgres.AccountStatus
+		httpMsg    string
+		httpStatus int
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, constants.ValidationString(), http.StatusBadRequest, fmt.Errorf("%w", err), fmt.Errorf("%w", err)
+	}
+
+	if clientID, httpMsg, httpStatus, err = HTTPAdminParseClientID(clientIDStr); err != nil {
+		return nil, httpMsg, httpStatus, clientIDStr, err
+	}
+
+	if err = currency.Scan(request.Currency); err != nil || !currency.Valid() {
+		return nil, constants.InvalidCurrencyString(), http.StatusBadRequest, request.Currency, fmt.Errorf("%w", err)
+	}
+
+	if err = status.Scan(request.Status); err != nil || !status.Valid() {
+		return nil, "invalid account status", http.StatusBadRequest, request.Status, fmt.Errorf("%w", err)
+	}
+
+	if account, err = db.FiatSetStatus(clientID, currency, status); err != nil {
+		httpMsg, httpStatus, err = adminAccountError(logger, err)
+
+		return nil, httpMsg, httpStatus, nil, err
+	}
+
+	return &account, "", 0, nil, nil
+}
+
+// HTTPAdminFiatUpdateHold will place or release a hold on funds in a user's Fiat currency account. Funds on hold cannot
+// be withdrawn, transferred, or traded.
+func HTTPAdminFiatUpdateHold(db postgres.Postgres, logger *logger.Logger, clientIDStr string,
+	request *models.HTTPAccountHoldRequest, release bool) (*postgres.FiatAccount, string, int, any, error) {
+	var (
+		err        error
+		account    postgres.FiatAccount
+		amount     decimal.Decimal
+		clientID   uuid.UUID
+		currency   postgres.Currency
+		httpMsg    string
+		httpStatus int
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, constants.ValidationString(), http.StatusBadRequest, fmt.Errorf("%w", err), fmt.Errorf("%w", err)
+	}
+
+	if clientID, httpMsg, httpStatus, err = HTTPAdminParseClientID(clientIDStr); err != nil {
+		return nil, httpMsg, httpStatus, clientIDStr, err
+	}
+
+	if err = currency.Scan(request.Currency); err != nil || !currency.Valid() {
+		return nil, constants.InvalidCurrencyString(), http.StatusBadRequest, request.Currency, fmt.Errorf("%w", err)
+	}
+
+	if amount, err = adminHoldAmount(request.Amount, constants.DecimalPlacesFiat(), release); err != nil {
+		return nil, err.Error(), http.StatusBadRequest, request.Amount, err
+	}
+
+	if account, err = db.FiatUpdateHold(clientID, currency, amount); err != nil {
+		httpMsg, httpStatus, err = adminAccountError(logger, err)
+
+		return nil, httpMsg, httpStatus, nil, err
+	}
+
+	return &account, "", 0, nil, nil
+}
+
+// HTTPAdminCryptoSetStatus will set the status of a user's Cryptocurrency account. Accounts that are not active cannot
+// send or receive funds.
+func HTTPAdminCryptoSetStatus(db postgres.Postgres, logger *logger.Logger, clientIDStr string,
+	request *models.HTTPAccountStatusRequest) (*postgres.CryptoAccount, string, int, any, error) {
+	var (
+		err        error
+		account    postgres.CryptoAccount
+		clientID   uuid.UUID
+		status     postgres.AccountStatus
+		httpMsg    string
+		httpStatus int
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, constants.ValidationString(), http.StatusBadRequest, fmt.Errorf("%w", err), fmt.Errorf("%w", err)
+	}
+
+	if clientID, httpMsg, httpStatus, err = HTTPAdminParseClientID(clientIDStr); err != nil {
+		return nil, httpMsg, httpStatus, clientIDStr, err
+	}
+
+	if len(request.Currency) < 1 || len(request.Currency) > 6 {
+		return nil, constants.InvalidCurrencyString(), http.StatusBadRequest, request.Currency,
+			errors.New(constants.InvalidCurrencyString())
+	}
+
+	if err = status.Scan(request.Status); err != nil || !status.Valid() {
+		return nil, "invalid account status", http.StatusBadRequest, request.Status, fmt.Errorf("%w", err)
+	}
+
+	if account, err = db.CryptoSetStatus(clientID, request.Currency, status); err != nil {
+		httpMsg, httpStatus, err = adminAccountError(logger, err)
+
+		return nil, httpMsg, httpStatus, nil, err
+	}
+
+	return &account, "", 0, nil, nil
+}
+
+// HTTPAdminCryptoUpdateHold will place or release a hold on funds in a user's Cryptocurrency account. Funds on hold
+// cannot be sold or swapped.
+func HTTPAdminCryptoUpdateHold(db postgres.Postgres, logger *logger.Logger, clientIDStr string,
+	request *models.HTTPAccountHoldRequest, release bool) (*postgres.CryptoAccount, string, int, any, error) {
+	var (
+		err        error
+		account    postgres.CryptoAccount
+		amount     decimal.Decimal
+		clientID   uuid.UUID
+		httpMsg    string
+		httpStatus int
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, constants.ValidationString(), http.StatusBadRequest, fmt.Errorf("%w", err), fmt.Errorf("%w", err)
+	}
+
+	if clientID, httpMsg, httpStatus, err = HTTPAdminParseClientID(clientIDStr); err != nil {
+		return nil, httpMsg, httpStatus, clientIDStr, err
+	}
+
+	if len(request.Currency) < 1 || len(request.Currency) > 6 {
+		return nil, constants.InvalidCurrencyString(), http.StatusBadRequest, request.Currency,
+			errors.New(constants.InvalidCurrencyString())
+	}
+
+	if amount, err = adminHoldAmount(request.Amount, constants.DecimalPlacesCrypto(), release); err != nil {
+		return nil, err.Error(), http.StatusBadRequest, request.Amount, err
+	}
+
+	if account, err = db.CryptoUpdateHold(clientID, request.Currency, amount); err != nil {
+		httpMsg, httpStatus, err = adminAccountError(logger, err)
+
+		return nil, httpMsg, httpStatus, nil, err
+	}
+
+	return &account, "", 0, nil, nil
+}
@@ -0,0 +1,833 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestCommon_HTTPAdminCheck(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		expectedMsg    string
+		expectedStatus int
+		role           postgres.UserRole
+		roleErr        error
+		expectErr      require.ErrorAssertionFunc
+	}{
+		{
+			name:           "db failure",
+			expectedMsg:    constants.RetryMessageString(),
+			expectedStatus: http.StatusInternalServerError,
+			roleErr:        postgres.ErrNotFoundUser,
+			expectErr:      require.Error,
+		}, {
+			name:           "user",
+			expectedMsg:    "administrator role required",
+			expectedStatus: http.StatusForbidden,
+			role:           postgres.UserRoleUser,
+			expectErr:      require.Error,
+		}, {
+			name:           "admin",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			role:           postgres.UserRoleAdmin,
+			expectErr:      require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			mockPostgres.EXPECT().UserGetRole(gomock.Any()).
+				Return(test.role, test.roleErr).
+				Times(1)
+
+			httpMsg, httpCode, err := HTTPAdminCheck(mockPostgres, zapLogger, uuid.UUID{})
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPAccountFrozen(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		expectedMsg    string
+		expectedStatus int
+		scope          auth.Scope
+		isFrozen       bool
+		isFrozenErr    error
+		isFrozenTimes  int
+		expectErr      require.ErrorAssertionFunc
+	}{
+		{
+			name:           "read endpoint",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			scope:          auth.ScopeReadBalances,
+			isFrozen:       true,
+			isFrozenTimes:  0,
+			expectErr:      require.NoError,
+		}, {
+			name:           "db failure",
+			expectedMsg:    constants.RetryMessageString(),
+			expectedStatus: http.StatusInternalServerError,
+			scope:          auth.ScopeTrade,
+			isFrozenErr:    postgres.ErrNotFound,
+			isFrozenTimes:  1,
+			expectErr:      require.Error,
+		}, {
+			name:           "frozen",
+			expectedMsg:    "account is frozen",
+			expectedStatus: http.StatusForbidden,
+			scope:          auth.ScopeWithdraw,
+			isFrozen:       true,
+			isFrozenTimes:  1,
+			expectErr:      require.Error,
+		}, {
+			name:           "not frozen",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			scope:          auth.ScopeDeposit,
+			isFrozen:       false,
+			isFrozenTimes:  1,
+			expectErr:      require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+				Return(test.isFrozen, test.isFrozenErr).
+				Times(test.isFrozenTimes)
+
+			httpMsg, httpCode, err := HTTPAccountFrozen(mockPostgres, zapLogger, uuid.UUID{}, test.scope)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPAdminUser(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.Must(uuid.NewV4())
+	user := modelsPostgres.User{
+		UserAccount: &modelsPostgres.UserAccount{
+			UserLoginCredentials: modelsPostgres.UserLoginCredentials{Username: "username1", Password: "hashed"},
+			FirstName:            "first",
+			LastName:             "last",
+			Email:                "user@email.com",
+		},
+		ClientID: clientID,
+		IsFrozen: true,
+		Role:     string(postgres.UserRoleUser),
+	}
+
+	testCases := []struct {
+		name             string
+		expectedMsg      string
+		expectedStatus   int
+		getClientIDErr   error
+		getClientIDTimes int
+		getInfoErr       error
+		getInfoTimes     int
+		expectErr        require.ErrorAssertionFunc
+		expectNil        require.ValueAssertionFunc
+	}{
+		{
+			name:             "unknown user",
+			expectedMsg:      "user not found",
+			expectedStatus:   http.StatusNotFound,
+			getClientIDErr:   postgres.ErrNotFoundUser,
+			getClientIDTimes: 1,
+			expectErr:        require.Error,
+			expectNil:        require.Nil,
+		}, {
+			name:             "db failure",
+			expectedMsg:      constants.RetryMessageString(),
+			expectedStatus:   http.StatusInternalServerError,
+			getClientIDTimes: 1,
+			getInfoErr:       errors.New("db failure"),
+			getInfoTimes:     1,
+			expectErr:        require.Error,
+			expectNil:        require.Nil,
+		}, {
+			name:             "valid",
+			expectedMsg:      "",
+			expectedStatus:   0,
+			getClientIDTimes: 1,
+			getInfoTimes:     1,
+			expectErr:        require.NoError,
+			expectNil:        require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockPostgres.EXPECT().UserGetClientID("username1").
+					Return(clientID, test.getClientIDErr).
+					Times(test.getClientIDTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(clientID).
+					Return(user, test.getInfoErr).
+					Times(test.getInfoTimes),
+			)
+
+			response, httpMsg, httpCode, err := HTTPAdminUser(mockPostgres, zapLogger, "username1")
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectNil(t, response, "response nil expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+
+			if response == nil {
+				return
+			}
+
+			require.Equal(t, clientID.String(), response.ClientID, "client ID mismatched.")
+			require.Equal(t, "username1", response.Username, "username mismatched.")
+			require.True(t, response.IsFrozen, "frozen status mismatched.")
+			require.Equal(t, "user", response.Role, "role mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPAdminSetFrozen(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name           string
+		expectedMsg    string
+		expectedStatus int
+		clientID       string
+		setFrozenErr   error
+		setFrozenTimes int
+		expectErr      require.ErrorAssertionFunc
+	}{
+		{
+			name:           "invalid client id",
+			expectedMsg:    "invalid client ID",
+			expectedStatus: http.StatusBadRequest,
+			clientID:       "invalid-client-id",
+			expectErr:      require.Error,
+		}, {
+			name:           "unknown user",
+			expectedMsg:    "user not found",
+			expectedStatus: http.StatusNotFound,
+			clientID:       clientID.String(),
+			setFrozenErr:   postgres.ErrNotFoundUser,
+			setFrozenTimes: 1,
+			expectErr:      require.Error,
+		}, {
+			name:           "valid",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			clientID:       clientID.String(),
+			setFrozenTimes: 1,
+			expectErr:      require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			mockPostgres.EXPECT().UserSetFrozen(clientID, true).
+				Return(test.setFrozenErr).
+				Times(test.setFrozenTimes)
+
+			httpMsg, httpCode, err := HTTPAdminSetFrozen(mockPostgres, zapLogger, test.clientID, true)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPAdminReverseDeposit(t *testing.T) {
+	t.Parallel()
+
+	adminID := uuid.Must(uuid.NewV4())
+	clientID := uuid.Must(uuid.NewV4())
+	txID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name           string
+		expectedMsg    string
+		expectedStatus int
+		clientID       string
+		txID           string
+		reverseErr     error
+		reverseTimes   int
+		expectErr      require.ErrorAssertionFunc
+		expectNil      require.ValueAssertionFunc
+	}{
+		{
+			name:           "invalid client id",
+			expectedMsg:    "invalid client ID",
+			expectedStatus: http.StatusBadRequest,
+			clientID:       "invalid-client-id",
+			txID:           txID.String(),
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "invalid transaction id",
+			expectedMsg:    "invalid transaction ID",
+			expectedStatus: http.StatusBadRequest,
+			clientID:       clientID.String(),
+			txID:           "invalid-tx-id",
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "deposit not found",
+			expectedMsg:    "deposit not found",
+			expectedStatus: http.StatusNotFound,
+			clientID:       clientID.String(),
+			txID:           txID.String(),
+			reverseErr:     postgres.ErrNotFoundDeposit,
+			reverseTimes:   1,
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "already reversed",
+			expectedMsg:    "could not reverse Fiat deposit",
+			expectedStatus: http.StatusConflict,
+			clientID:       clientID.String(),
+			txID:           txID.String(),
+			reverseErr:     postgres.ErrReverseFiat,
+			reverseTimes:   1,
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "unknown error",
+			expectedMsg:    constants.RetryMessageString(),
+			expectedStatus: http.StatusInternalServerError,
+			clientID:       clientID.String(),
+			txID:           txID.String(),
+			reverseErr:     errors.New("unknown error"),
+			reverseTimes:   1,
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "valid",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			clientID:       clientID.String(),
+			txID:           txID.String(),
+			reverseTimes:   1,
+			expectErr:      require.NoError,
+			expectNil:      require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			receipt := &postgres.FiatAccountTransferResult{}
+			if test.reverseErr != nil {
+				receipt = nil
+			}
+
+			mockPostgres.EXPECT().FiatReverseDeposit(gomock.Any(), adminID, clientID, txID).
+				Return(receipt, test.reverseErr).
+				Times(test.reverseTimes)
+
+			response, httpMsg, httpCode, err :=
+				HTTPAdminReverseDeposit(mockPostgres, zapLogger, adminID, test.clientID, test.txID)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectNil(t, response, "response nil expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPAdminFiatSetStatus(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name           string
+		expectedMsg    string
+		expectedStatus int
+		clientID       string
+		request        *models.HTTPAccountStatusRequest
+		statusErr      error
+		statusTimes    int
+		expectErr      require.ErrorAssertionFunc
+		expectNil      require.ValueAssertionFunc
+	}{
+		{
+			name:           "empty request",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountStatusRequest{},
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "invalid client id",
+			expectedMsg:    "invalid client ID",
+			expectedStatus: http.StatusBadRequest,
+			clientID:       "invalid-client-id",
+			request:        &models.HTTPAccountStatusRequest{Currency: "USD", Status: "frozen"},
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "invalid currency",
+			expectedMsg:    constants.InvalidCurrencyString(),
+			expectedStatus: http.StatusBadRequest,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountStatusRequest{Currency: "INVALID", Status: "frozen"},
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "invalid status",
+			expectedMsg:    "invalid account status",
+			expectedStatus: http.StatusBadRequest,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountStatusRequest{Currency: "USD", Status: "suspended"},
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "account not found",
+			expectedMsg:    "not found",
+			expectedStatus: http.StatusNotFound,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountStatusRequest{Currency: "USD", Status: "frozen"},
+			statusErr:      postgres.ErrNotFound,
+			statusTimes:    1,
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "unknown error",
+			expectedMsg:    constants.RetryMessageString(),
+			expectedStatus: http.StatusInternalServerError,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountStatusRequest{Currency: "USD", Status: "frozen"},
+			statusErr:      errors.New("unknown error"),
+			statusTimes:    1,
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "valid",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountStatusRequest{Currency: "USD", Status: "frozen"},
+			statusTimes:    1,
+			expectErr:      require.NoError,
+			expectNil:      require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			mockPostgres.EXPECT().FiatSetStatus(clientID, postgres.CurrencyUSD, postgres.AccountStatusFrozen).
+				Return(postgres.FiatAccount{}, test.statusErr).
+				Times(test.statusTimes)
+
+			response, httpMsg, httpCode, _, err := HTTPAdminFiatSetStatus(mockPostgres, zapLogger, test.clientID, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectNil(t, response, "response nil expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPAdminFiatUpdateHold(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name           string
+		expectedMsg    string
+		expectedStatus int
+		clientID       string
+		request        *models.HTTPAccountHoldRequest
+		release        bool
+		expectedAmount decimal.Decimal
+		holdErr        error
+		holdTimes      int
+		expectErr      require.ErrorAssertionFunc
+		expectNil      require.ValueAssertionFunc
+	}{
+		{
+			name:           "empty request",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountHoldRequest{},
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "invalid client id",
+			expectedMsg:    "invalid client ID",
+			expectedStatus: http.StatusBadRequest,
+			clientID:       "invalid-client-id",
+			request:        &models.HTTPAccountHoldRequest{Currency: "USD", Amount: decimal.NewFromFloat(10)},
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "invalid currency",
+			expectedMsg:    constants.InvalidCurrencyString(),
+			expectedStatus: http.StatusBadRequest,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountHoldRequest{Currency: "INVALID", Amount: decimal.NewFromFloat(10)},
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "too many decimal places",
+			expectedMsg:    "invalid amount",
+			expectedStatus: http.StatusBadRequest,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountHoldRequest{Currency: "USD", Amount: decimal.NewFromFloat(10.001)},
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "negative amount",
+			expectedMsg:    "invalid amount",
+			expectedStatus: http.StatusBadRequest,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountHoldRequest{Currency: "USD", Amount: decimal.NewFromFloat(-10)},
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "hold exceeds balance",
+			expectedMsg:    "could not update funds on hold",
+			expectedStatus: http.StatusConflict,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountHoldRequest{Currency: "USD", Amount: decimal.NewFromFloat(10)},
+			expectedAmount: decimal.NewFromFloat(10),
+			holdErr:        postgres.ErrUpdateHold,
+			holdTimes:      1,
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "unknown error",
+			expectedMsg:    constants.RetryMessageString(),
+			expectedStatus: http.StatusInternalServerError,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountHoldRequest{Currency: "USD", Amount: decimal.NewFromFloat(10)},
+			expectedAmount: decimal.NewFromFloat(10),
+			holdErr:        errors.New("unknown error"),
+			holdTimes:      1,
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "valid hold",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountHoldRequest{Currency: "USD", Amount: decimal.NewFromFloat(10)},
+			expectedAmount: decimal.NewFromFloat(10),
+			holdTimes:      1,
+			expectErr:      require.NoError,
+			expectNil:      require.NotNil,
+		}, {
+			name:           "valid release",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountHoldRequest{Currency: "USD", Amount: decimal.NewFromFloat(10)},
+			release:        true,
+			expectedAmount: decimal.NewFromFloat(-10),
+			holdTimes:      1,
+			expectErr:      require.NoError,
+			expectNil:      require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			mockPostgres.EXPECT().FiatUpdateHold(clientID, postgres.CurrencyUSD, test.expectedAmount).
+				Return(postgres.FiatAccount{}, test.holdErr).
+				Times(test.holdTimes)
+
+			response, httpMsg, httpCode, _, err :=
+				HTTPAdminFiatUpdateHold(mockPostgres, zapLogger, test.clientID, test.request, test.release)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectNil(t, response, "response nil expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPAdminCryptoSetStatus(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name           string
+		expectedMsg    string
+		expectedStatus int
+		clientID       string
+		request        *models.HTTPAccountStatusRequest
+		statusErr      error
+		statusTimes    int
+		expectErr      require.ErrorAssertionFunc
+		expectNil      require.ValueAssertionFunc
+	}{
+		{
+			name:           "empty request",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountStatusRequest{},
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "invalid client id",
+			expectedMsg:    "invalid client ID",
+			expectedStatus: http.StatusBadRequest,
+			clientID:       "invalid-client-id",
+			request:        &models.HTTPAccountStatusRequest{Currency: "BTC", Status: "closed"},
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "invalid ticker",
+			expectedMsg:    constants.InvalidCurrencyString(),
+			expectedStatus: http.StatusBadRequest,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountStatusRequest{Currency: "INVALID", Status: "closed"},
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "invalid status",
+			expectedMsg:    "invalid account status",
+			expectedStatus: http.StatusBadRequest,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountStatusRequest{Currency: "BTC", Status: "suspended"},
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "account not found",
+			expectedMsg:    "not found",
+			expectedStatus: http.StatusNotFound,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountStatusRequest{Currency: "BTC", Status: "closed"},
+			statusErr:      postgres.ErrNotFound,
+			statusTimes:    1,
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "valid",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountStatusRequest{Currency: "BTC", Status: "closed"},
+			statusTimes:    1,
+			expectErr:      require.NoError,
+			expectNil:      require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			mockPostgres.EXPECT().CryptoSetStatus(clientID, "BTC", postgres.AccountStatusClosed).
+				Return(postgres.CryptoAccount{}, test.statusErr).
+				Times(test.statusTimes)
+
+			response, httpMsg, httpCode, _, err :=
+				HTTPAdminCryptoSetStatus(mockPostgres, zapLogger, test.clientID, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectNil(t, response, "response nil expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPAdminCryptoUpdateHold(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name           string
+		expectedMsg    string
+		expectedStatus int
+		clientID       string
+		request        *models.HTTPAccountHoldRequest
+		release        bool
+		expectedAmount decimal.Decimal
+		holdErr        error
+		holdTimes      int
+		expectErr      require.ErrorAssertionFunc
+		expectNil      require.ValueAssertionFunc
+	}{
+		{
+			name:           "empty request",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountHoldRequest{},
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "invalid client id",
+			expectedMsg:    "invalid client ID",
+			expectedStatus: http.StatusBadRequest,
+			clientID:       "invalid-client-id",
+			request:        &models.HTTPAccountHoldRequest{Currency: "BTC", Amount: decimal.NewFromFloat(0.5)},
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "invalid ticker",
+			expectedMsg:    constants.InvalidCurrencyString(),
+			expectedStatus: http.StatusBadRequest,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountHoldRequest{Currency: "INVALID", Amount: decimal.NewFromFloat(0.5)},
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "too many decimal places",
+			expectedMsg:    "invalid amount",
+			expectedStatus: http.StatusBadRequest,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountHoldRequest{Currency: "BTC", Amount: decimal.NewFromFloat(0.123456789)},
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "release exceeds hold",
+			expectedMsg:    "could not update funds on hold",
+			expectedStatus: http.StatusConflict,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountHoldRequest{Currency: "BTC", Amount: decimal.NewFromFloat(0.5)},
+			release:        true,
+			expectedAmount: decimal.NewFromFloat(-0.5),
+			holdErr:        postgres.ErrUpdateHold,
+			holdTimes:      1,
+			expectErr:      require.Error,
+			expectNil:      require.Nil,
+		}, {
+			name:           "valid hold",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			clientID:       clientID.String(),
+			request:        &models.HTTPAccountHoldRequest{Currency: "BTC", Amount: decimal.NewFromFloat(0.5)},
+			expectedAmount: decimal.NewFromFloat(0.5),
+			holdTimes:      1,
+			expectErr:      require.NoError,
+			expectNil:      require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			mockPostgres.EXPECT().CryptoUpdateHold(clientID, "BTC", test.expectedAmount).
+				Return(postgres.CryptoAccount{}, test.holdErr).
+				Times(test.holdTimes)
+
+			response, httpMsg, httpCode, _, err :=
+				HTTPAdminCryptoUpdateHold(mockPostgres, zapLogger, test.clientID, test.request, test.release)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectNil(t, response, "response nil expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// apiKeyDatabaseError will extract the message and HTTP status code from an API key database error.
+func apiKeyDatabaseError(logger *logger.Logger, err error) (string, int, error) {
+	var pgErr *postgres.Error
+	if !errors.As(err, &pgErr) {
+		logger.Warn("failed to extract api key error", zap.Error(err))
+
+		return constants.RetryMessageString(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+	}
+
+	return pgErr.Message, pgErr.Code, fmt.Errorf("%w", err)
+}
+
+// HTTPAPIKeyAuth will authenticate a request made with an API key and check that the key grants the scope required by
+// the endpoint. The client ID of the key's owner is returned.
+func HTTPAPIKeyAuth(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, key string, scope auth.Scope) (
+	uuid.UUID, string, int, error) {
+	var (
+		err    error
+		apiKey postgres.ApiKey
+	)
+
+	// Endpoints without a scope do not accept API keys.
+	if scope == "" {
+		msg := "endpoint cannot be accessed with an api key"
+
+		return uuid.UUID{}, msg, http.StatusForbidden, errors.New(msg)
+	}
+
+	if apiKey, err = db.APIKeyGet(auth.HashAPIKey(key)); err != nil {
+		if errors.Is(err, postgres.ErrNotFoundAPIKey) {
+			return uuid.UUID{}, "request contains an invalid or revoked api key", http.StatusForbidden,
+				fmt.Errorf("%w", err)
+		}
+
+		logger.Error("failed to retrieve api key", zap.Error(err))
+
+		return uuid.UUID{}, constants.RetryMessageString(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+	}
+
+	if !scope.GrantedBy(apiKey.Scopes) {
+		msg := fmt.Sprintf("api key does not grant the %s scope", scope)
+
+		return uuid.UUID{}, msg, http.StatusForbidden, errors.New(msg)
+	}
+
+	return apiKey.ClientID, "", 0, nil
+}
+
+// HTTPAPIKeyCreate will generate an API key with the requested scopes for a client. Only the hash of the key is stored
+// and the plaintext key is only ever returned once.
+func HTTPAPIKeyCreate(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	request *models.HTTPAPIKeyRequest) (*models.HTTPAPIKeyResponse, string, int, any, error) {
+	var (
+		err        error
+		hashedKey  string
+		httpMsg    string
+		httpStatus int
+		response   models.HTTPAPIKeyResponse
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, constants.ValidationString(), http.StatusBadRequest, fmt.Errorf("%w", err), fmt.Errorf("%w", err)
+	}
+
+	if response.Key, hashedKey, err = auth.GenerateAPIKey(); err != nil {
+		logger.Error("failed to generate api key", zap.Error(err))
+
+		return nil, constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	if response.APIKey, err = db.APIKeyCreate(clientID, request.Name, hashedKey, request.Scopes); err != nil {
+		httpMsg, httpStatus, err = apiKeyDatabaseError(logger, err)
+
+		return nil, httpMsg, httpStatus, nil, err
+	}
+
+	return &response, "", 0, nil, nil
+}
+
+// HTTPAPIKeyList will retrieve all of a client's API keys that have not been revoked.
+func HTTPAPIKeyList(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID) (
+	*models.HTTPAPIKeysResponse, string, int, error) {
+	var (
+		err        error
+		httpMsg    string
+		httpStatus int
+		response   models.HTTPAPIKeysResponse
+	)
+
+	if response.APIKeys, err = db.APIKeyList(clientID); err != nil {
+		httpMsg, httpStatus, err = apiKeyDatabaseError(logger, err)
+
+		return nil, httpMsg, httpStatus, err
+	}
+
+	return &response, "", 0, nil
+}
+
+// HTTPAPIKeyRevoke will revoke one of a client's API keys. Requests made with a revoked key are rejected immediately.
+func HTTPAPIKeyRevoke(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, keyIDStr string) (
+	string, int, error) {
+	var (
+		err   error
+		keyID uuid.UUID
+	)
+
+	if keyID, err = uuid.FromString(keyIDStr); err != nil {
+		return "invalid api key ID", http.StatusBadRequest, fmt.Errorf("%w", err)
+	}
+
+	if err = db.APIKeyRevoke(clientID, keyID); err != nil {
+		return apiKeyDatabaseError(logger, err)
+	}
+
+	return "", 0, nil
+}
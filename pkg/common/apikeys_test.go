@@ -0,0 +1,338 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestCommon_HTTPAPIKeyAuth(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name           string
+		expectedMsg    string
+		expectedStatus int
+		scope          auth.Scope
+		apiKeyGetKey   postgres.ApiKey
+		apiKeyGetErr   error
+		apiKeyGetTimes int
+		expectErr      require.ErrorAssertionFunc
+		expectClientID uuid.UUID
+	}{
+		{
+			name:           "endpoint without scope",
+			expectedMsg:    "cannot be accessed with an api key",
+			expectedStatus: http.StatusForbidden,
+			scope:          auth.ScopeNone,
+			expectErr:      require.Error,
+		}, {
+			name:           "unknown or revoked key",
+			expectedMsg:    "invalid or revoked api key",
+			expectedStatus: http.StatusForbidden,
+			scope:          auth.ScopeTrade,
+			apiKeyGetErr:   postgres.ErrNotFoundAPIKey,
+			apiKeyGetTimes: 1,
+			expectErr:      require.Error,
+		}, {
+			name:           "db failure",
+			expectedMsg:    constants.RetryMessageString(),
+			expectedStatus: http.StatusInternalServerError,
+			scope:          auth.ScopeTrade,
+			apiKeyGetErr:   postgres.ErrTransactAPIKey,
+			apiKeyGetTimes: 1,
+			expectErr:      require.Error,
+		}, {
+			name:           "scope not granted",
+			expectedMsg:    "does not grant the withdraw scope",
+			expectedStatus: http.StatusForbidden,
+			scope:          auth.ScopeWithdraw,
+			apiKeyGetKey:   postgres.ApiKey{ClientID: clientID, Scopes: []string{"read-balances", "trade"}},
+			apiKeyGetTimes: 1,
+			expectErr:      require.Error,
+		}, {
+			name:           "valid",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			scope:          auth.ScopeTrade,
+			apiKeyGetKey:   postgres.ApiKey{ClientID: clientID, Scopes: []string{"read-balances", "trade"}},
+			apiKeyGetTimes: 1,
+			expectErr:      require.NoError,
+			expectClientID: clientID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().HashAPIKey("ftex_api-key").
+					Return("hashed-api-key").
+					Times(test.apiKeyGetTimes),
+
+				mockPostgres.EXPECT().APIKeyGet("hashed-api-key").
+					Return(test.apiKeyGetKey, test.apiKeyGetErr).
+					Times(test.apiKeyGetTimes),
+			)
+
+			clientID, httpMsg, httpCode, err :=
+				HTTPAPIKeyAuth(mockAuth, mockPostgres, zapLogger, "ftex_api-key", test.scope)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectClientID, clientID, "client ID mismatched.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPAPIKeyCreate(t *testing.T) {
+	t.Parallel()
+
+	validRequest := &models.HTTPAPIKeyRequest{Name: "trading bot", Scopes: []string{"read-balances", "trade"}}
+
+	testCases := []struct {
+		name              string
+		expectedMsg       string
+		expectedStatus    int
+		request           *models.HTTPAPIKeyRequest
+		generateKeyErr    error
+		generateKeyTimes  int
+		apiKeyCreateErr   error
+		apiKeyCreateTimes int
+		expectErr         require.ErrorAssertionFunc
+		expectPayload     require.ValueAssertionFunc
+		expectResponse    require.ValueAssertionFunc
+	}{
+		{
+			name:           "empty request",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			request:        &models.HTTPAPIKeyRequest{},
+			expectErr:      require.Error,
+			expectPayload:  require.NotNil,
+			expectResponse: require.Nil,
+		}, {
+			name:           "unknown scope",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			request:        &models.HTTPAPIKeyRequest{Name: "trading bot", Scopes: []string{"admin"}},
+			expectErr:      require.Error,
+			expectPayload:  require.NotNil,
+			expectResponse: require.Nil,
+		}, {
+			name:           "duplicate scope",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			request:        &models.HTTPAPIKeyRequest{Name: "trading bot", Scopes: []string{"trade", "trade"}},
+			expectErr:      require.Error,
+			expectPayload:  require.NotNil,
+			expectResponse: require.Nil,
+		}, {
+			name:             "generation failure",
+			expectedMsg:      constants.RetryMessageString(),
+			expectedStatus:   http.StatusInternalServerError,
+			request:          validRequest,
+			generateKeyErr:   errors.New("generation failure"),
+			generateKeyTimes: 1,
+			expectErr:        require.Error,
+			expectPayload:    require.Nil,
+			expectResponse:   require.Nil,
+		}, {
+			name:              "db failure",
+			expectedMsg:       "could not complete api key request",
+			expectedStatus:    http.StatusInternalServerError,
+			request:           validRequest,
+			generateKeyTimes:  1,
+			apiKeyCreateErr:   postgres.ErrTransactAPIKey,
+			apiKeyCreateTimes: 1,
+			expectErr:         require.Error,
+			expectPayload:     require.Nil,
+			expectResponse:    require.Nil,
+		}, {
+			name:              "valid",
+			expectedMsg:       "",
+			expectedStatus:    0,
+			request:           validRequest,
+			generateKeyTimes:  1,
+			apiKeyCreateTimes: 1,
+			expectErr:         require.NoError,
+			expectPayload:     require.Nil,
+			expectResponse:    require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().GenerateAPIKey().
+					Return("ftex_api-key", "hashed-api-key", test.generateKeyErr).
+					Times(test.generateKeyTimes),
+
+				mockPostgres.EXPECT().APIKeyCreate(gomock.Any(), test.request.Name, "hashed-api-key",
+					test.request.Scopes).
+					Return(postgres.ApiKey{Name: test.request.Name, Scopes: test.request.Scopes}, test.apiKeyCreateErr).
+					Times(test.apiKeyCreateTimes),
+			)
+
+			response, httpMsg, httpCode, payload, err :=
+				HTTPAPIKeyCreate(mockAuth, mockPostgres, zapLogger, uuid.UUID{}, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectPayload(t, payload, "payload expectation failed.")
+			test.expectResponse(t, response, "response expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+
+			if err == nil {
+				require.Equal(t, "ftex_api-key", response.Key, "plaintext api key mismatched.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPAPIKeyList(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		expectedMsg    string
+		expectedStatus int
+		apiKeyListErr  error
+		expectErr      require.ErrorAssertionFunc
+		expectResponse require.ValueAssertionFunc
+	}{
+		{
+			name:           "db failure",
+			expectedMsg:    "could not complete api key request",
+			expectedStatus: http.StatusInternalServerError,
+			apiKeyListErr:  postgres.ErrTransactAPIKey,
+			expectErr:      require.Error,
+			expectResponse: require.Nil,
+		}, {
+			name:           "unknown db failure",
+			expectedMsg:    constants.RetryMessageString(),
+			expectedStatus: http.StatusInternalServerError,
+			apiKeyListErr:  errors.New("unknown db failure"),
+			expectErr:      require.Error,
+			expectResponse: require.Nil,
+		}, {
+			name:           "valid",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			apiKeyListErr:  nil,
+			expectErr:      require.NoError,
+			expectResponse: require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			mockPostgres.EXPECT().APIKeyList(gomock.Any()).
+				Return([]postgres.ApiKey{{Name: "trading bot"}}, test.apiKeyListErr).
+				Times(1)
+
+			response, httpMsg, httpCode, err := HTTPAPIKeyList(mockPostgres, zapLogger, uuid.UUID{})
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectResponse(t, response, "response expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPAPIKeyRevoke(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name              string
+		keyID             string
+		expectedMsg       string
+		expectedStatus    int
+		apiKeyRevokeErr   error
+		apiKeyRevokeTimes int
+		expectErr         require.ErrorAssertionFunc
+	}{
+		{
+			name:           "invalid key ID",
+			keyID:          "invalid-key-id",
+			expectedMsg:    "invalid api key ID",
+			expectedStatus: http.StatusBadRequest,
+			expectErr:      require.Error,
+		}, {
+			name:              "not found",
+			keyID:             uuid.Must(uuid.NewV4()).String(),
+			expectedMsg:       "api key not found",
+			expectedStatus:    http.StatusNotFound,
+			apiKeyRevokeErr:   postgres.ErrNotFoundAPIKey,
+			apiKeyRevokeTimes: 1,
+			expectErr:         require.Error,
+		}, {
+			name:              "valid",
+			keyID:             uuid.Must(uuid.NewV4()).String(),
+			expectedMsg:       "",
+			expectedStatus:    0,
+			apiKeyRevokeTimes: 1,
+			expectErr:         require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			mockPostgres.EXPECT().APIKeyRevoke(gomock.Any(), gomock.Any()).
+				Return(test.apiKeyRevokeErr).
+				Times(test.apiKeyRevokeTimes)
+
+			httpMsg, httpCode, err := HTTPAPIKeyRevoke(mockPostgres, zapLogger, uuid.UUID{}, test.keyID)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
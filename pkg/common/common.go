@@ -251,6 +251,42 @@ func HTTPTxParseQueryParams(auth auth.Auth, logger *logger.Logger, params *HTTPP
 	return 0, nil
 }
 
+// offsetPaginatedRequest will convert the encrypted URL query parameter for the offset and the record limit and
+// covert them to integers. The offsetStr is the encrypted pageCursor passed in.
+func offsetPaginatedRequest(auth auth.Auth, offsetStr, limitStr string) (int32, int32, error) {
+	var (
+		decrypted []byte
+		err       error
+		offset    int64
+		limit     int64
+	)
+
+	// Decrypt and convert the offset.
+	if len(offsetStr) > 0 {
+		if decrypted, err = auth.DecryptFromString(offsetStr); err != nil {
+			return -1, -1, errors.New("failed to decrypt next offset")
+		}
+
+		if offset, err = strconv.ParseInt(string(decrypted), 10, 32); err != nil || offset < 0 {
+			return -1, -1, errors.New("failed to parse next offset")
+		}
+	}
+
+	// Convert record limit to int and set base bound for bad input.
+	if len(limitStr) > 0 {
+		if limit, err = strconv.ParseInt(limitStr, 10, 32); err != nil {
+			return -1, -1, errors.New("failed to parse record limit")
+		}
+	}
+
+	if limit < 1 {
+		limit = 10
+	}
+
+	//nolint:gosec
+	return int32(offset), int32(limit), nil
+}
+
 // HTTPValidateOfferRequest will validate an offer request by checking the amount and Fiat currencies are valid.
 func HTTPValidateOfferRequest(debitAmount decimal.Decimal, precision int32, fiatCurrencies ...string) (
 	[]postgres.Currency, error) {
@@ -274,12 +310,14 @@ func HTTPValidateOfferRequest(debitAmount decimal.Decimal, precision int32, fiat
 	return parsedCurrencies, nil
 }
 
-// HTTPTxDetails will retrieve the Cryptocurrency journal entries for a specified transaction.
+// HTTPTxDetails will retrieve the Fiat and Cryptocurrency journal entries for a specified transaction. Transactions that
+// are trades will also include the exchange rate and price quote offer that priced them.
 func HTTPTxDetails(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, txID string) (
 	[]any, int, string, error) {
 	var (
 		cryptoEntries []postgres.CryptoJournal
 		fiatEntries   []postgres.FiatJournal
+		tradeEntries  []postgres.Trade
 		transactionID uuid.UUID
 		err           error
 	)
@@ -326,5 +364,21 @@ func HTTPTxDetails(db postgres.Postgres, logger *logger.Logger, clientID uuid.UU
 		return nil, http.StatusNotFound, "transaction id not found", errors.New("transaction id not found")
 	}
 
+	if tradeEntries, err = db.TradeTxDetails(clientID, transactionID); err != nil {
+		var tradeErr *postgres.Error
+		if !errors.As(err, &tradeErr) {
+			logger.Info("failed to unpack trade transactionID error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, tradeErr.Code, tradeErr.Message, fmt.Errorf("%w", err)
+	}
+
+	// Append the trade details so that the rate applied to the transaction can be displayed alongside it.
+	for _, item := range tradeEntries {
+		journalEntries = append(journalEntries, item)
+	}
+
 	return journalEntries, 0, "", nil
 }
@@ -512,6 +512,7 @@ func TestCommon_HTTPTxDetails(t *testing.T) {
 
 	cryptoJournal := []postgres.CryptoJournal{{}, {}}
 	fiatJournal := []postgres.FiatJournal{{}, {}}
+	trades := []postgres.Trade{{}}
 
 	testCases := []struct {
 		name          string
@@ -524,6 +525,10 @@ func TestCommon_HTTPTxDetails(t *testing.T) {
 		cryptoTxTimes int
 		fiatTxErr     error
 		fiatTxTimes   int
+		trades        []postgres.Trade
+		tradeTxErr    error
+		tradeTxTimes  int
+		entries       int
 		expectErr     require.ErrorAssertionFunc
 	}{
 		{
@@ -537,6 +542,10 @@ func TestCommon_HTTPTxDetails(t *testing.T) {
 			fiatTxTimes:   0,
 			cryptoTxErr:   nil,
 			cryptoTxTimes: 0,
+			trades:        trades,
+			tradeTxErr:    nil,
+			tradeTxTimes:  0,
+			entries:       0,
 			expectErr:     require.Error,
 		}, {
 			name:          "unknown fiat db error",
@@ -549,6 +558,10 @@ func TestCommon_HTTPTxDetails(t *testing.T) {
 			cryptoJournal: cryptoJournal,
 			cryptoTxErr:   nil,
 			cryptoTxTimes: 0,
+			trades:        trades,
+			tradeTxErr:    nil,
+			tradeTxTimes:  0,
+			entries:       0,
 			expectErr:     require.Error,
 		}, {
 			name:          "unknown crypto db error",
@@ -561,6 +574,10 @@ func TestCommon_HTTPTxDetails(t *testing.T) {
 			cryptoJournal: cryptoJournal,
 			cryptoTxErr:   errors.New("unknown db error"),
 			cryptoTxTimes: 1,
+			trades:        trades,
+			tradeTxErr:    nil,
+			tradeTxTimes:  0,
+			entries:       0,
 			expectErr:     require.Error,
 		}, {
 			name:          "known fiat db error",
@@ -573,6 +590,10 @@ func TestCommon_HTTPTxDetails(t *testing.T) {
 			cryptoJournal: cryptoJournal,
 			cryptoTxErr:   nil,
 			cryptoTxTimes: 0,
+			trades:        trades,
+			tradeTxErr:    nil,
+			tradeTxTimes:  0,
+			entries:       0,
 			expectErr:     require.Error,
 		}, {
 			name:          "known crypto db error",
@@ -585,6 +606,10 @@ func TestCommon_HTTPTxDetails(t *testing.T) {
 			cryptoJournal: cryptoJournal,
 			cryptoTxErr:   postgres.ErrTransactCrypto,
 			cryptoTxTimes: 1,
+			trades:        trades,
+			tradeTxErr:    nil,
+			tradeTxTimes:  0,
+			entries:       0,
 			expectErr:     require.Error,
 		}, {
 			name:          "empty result set",
@@ -597,7 +622,59 @@ func TestCommon_HTTPTxDetails(t *testing.T) {
 			cryptoJournal: []postgres.CryptoJournal{},
 			cryptoTxErr:   nil,
 			cryptoTxTimes: 1,
+			trades:        trades,
+			tradeTxErr:    nil,
+			tradeTxTimes:  0,
+			entries:       0,
 			expectErr:     require.Error,
+		}, {
+			name:          "unknown trade db error",
+			txID:          validTxID.String(),
+			expectErrMsg:  "please retry",
+			httpStatus:    http.StatusInternalServerError,
+			fiatJournal:   fiatJournal,
+			fiatTxErr:     nil,
+			fiatTxTimes:   1,
+			cryptoJournal: cryptoJournal,
+			cryptoTxErr:   nil,
+			cryptoTxTimes: 1,
+			trades:        trades,
+			tradeTxErr:    errors.New("unknown db error"),
+			tradeTxTimes:  1,
+			entries:       0,
+			expectErr:     require.Error,
+		}, {
+			name:          "known trade db error",
+			txID:          validTxID.String(),
+			expectErrMsg:  "not found",
+			httpStatus:    http.StatusNotFound,
+			fiatJournal:   fiatJournal,
+			fiatTxErr:     nil,
+			fiatTxTimes:   1,
+			cryptoJournal: cryptoJournal,
+			cryptoTxErr:   nil,
+			cryptoTxTimes: 1,
+			trades:        trades,
+			tradeTxErr:    postgres.ErrNotFound,
+			tradeTxTimes:  1,
+			entries:       0,
+			expectErr:     require.Error,
+		}, {
+			name:          "valid without trade",
+			txID:          validTxID.String(),
+			expectErrMsg:  "",
+			httpStatus:    0,
+			fiatJournal:   fiatJournal,
+			fiatTxErr:     nil,
+			fiatTxTimes:   1,
+			cryptoJournal: []postgres.CryptoJournal{},
+			cryptoTxErr:   nil,
+			cryptoTxTimes: 1,
+			trades:        []postgres.Trade{},
+			tradeTxErr:    nil,
+			tradeTxTimes:  1,
+			entries:       2,
+			expectErr:     require.NoError,
 		}, {
 			name:          "valid",
 			txID:          validTxID.String(),
@@ -609,6 +686,10 @@ func TestCommon_HTTPTxDetails(t *testing.T) {
 			cryptoJournal: cryptoJournal,
 			cryptoTxErr:   nil,
 			cryptoTxTimes: 1,
+			trades:        trades,
+			tradeTxErr:    nil,
+			tradeTxTimes:  1,
+			entries:       5,
 			expectErr:     require.NoError,
 		},
 	}
@@ -631,10 +712,15 @@ func TestCommon_HTTPTxDetails(t *testing.T) {
 				mockPostgres.EXPECT().CryptoTxDetails(gomock.Any(), gomock.Any()).
 					Return(test.cryptoJournal, test.cryptoTxErr).
 					Times(test.cryptoTxTimes),
+
+				mockPostgres.EXPECT().TradeTxDetails(gomock.Any(), gomock.Any()).
+					Return(test.trades, test.tradeTxErr).
+					Times(test.tradeTxTimes),
 			)
 
-			_, status, errMsg, err := HTTPTxDetails(mockPostgres, zapLogger, uuid.UUID{}, test.txID)
+			entries, status, errMsg, err := HTTPTxDetails(mockPostgres, zapLogger, uuid.UUID{}, test.txID)
 			test.expectErr(t, err, "error expectation failed.")
+			require.Len(t, entries, test.entries, "journal and trade entry count mismatched.")
 
 			require.Equal(t, test.httpStatus, status, "http status code mismatched.")
 			require.Contains(t, errMsg, test.expectErrMsg, "http error message mismatched.")
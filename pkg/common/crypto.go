@@ -90,9 +90,17 @@ func HTTPCryptoOffer(auth auth.Auth, cache redis.Redis, logger *logger.Logger, q
 		return offer, http.StatusBadRequest, constants.InvalidRequestString(), fmt.Errorf("%w", err)
 	}
 
+	// Compute the fee, which is collected in the source currency, and ensure there are funds left to convert.
+	offer.Fee = quotes.Fee(source, destination, sourceAmount, precision)
+	if !sourceAmount.GreaterThan(offer.Fee) {
+		msg := "cryptocurrency purchase/sale amount does not cover the fee"
+
+		return offer, http.StatusBadRequest, msg, errors.New(msg)
+	}
+
 	// Compile exchange rate offer.
 	if offer.Rate, offer.Amount, err = quotes.CryptoConversion(
-		source, destination, sourceAmount, isPurchase, nil); err != nil {
+		source, destination, sourceAmount.Sub(offer.Fee), isPurchase, nil); err != nil {
 		logger.Warn("failed to retrieve quote for Cryptocurrency purchase/sale offer", zap.Error(err))
 
 		return offer, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
@@ -214,9 +222,147 @@ func HTTPExchangeCrypto(auth auth.Auth, cache redis.Redis, db postgres.Postgres,
 		return receipt, http.StatusBadRequest, msg, fmt.Errorf("%w", err)
 	}
 
-	// Execute transfer.
-	if receipt.FiatTxReceipt, receipt.CryptoTxReceipt, err =
-		transferFunc(clientID, fiatCurrency[0], fiatAmount, cryptoTicker, cryptoAmount); err != nil {
+	// Execute transfer and record the trade with the rate from the offer.
+	if receipt.FiatTxReceipt, receipt.CryptoTxReceipt, err = transferFunc(clientID, fiatCurrency[0], fiatAmount,
+		cryptoTicker, cryptoAmount, offer.Fee, &postgres.TradeDetails{OfferID: offerID, Rate: offer.Rate}); err != nil {
+		return receipt, http.StatusInternalServerError, err.Error(), fmt.Errorf("%w", err)
+	}
+
+	return receipt, 0, "", nil
+}
+
+// HTTPCryptoSwapOffer will request the conversion rate between two Cryptocurrencies, prepare the price quote, and store
+// it in the Redis cache.
+func HTTPCryptoSwapOffer(auth auth.Auth, cache redis.Redis, logger *logger.Logger, quotes quotes.Quotes,
+	clientID uuid.UUID, source, destination string, sourceAmount decimal.Decimal) (
+	models.HTTPExchangeOfferResponse, int, string, error) {
+	var (
+		err     error
+		offer   models.HTTPExchangeOfferResponse
+		offerID = xid.New().String()
+	)
+
+	// Validate the Cryptocurrency tickers.
+	for _, ticker := range []string{source, destination} {
+		if len(ticker) < 1 || len(ticker) > 6 {
+			return offer, http.StatusBadRequest, constants.InvalidRequestString(),
+				fmt.Errorf("invalid Cryptocurrency ticker %s", ticker)
+		}
+	}
+
+	if source == destination {
+		return offer, http.StatusBadRequest, constants.InvalidRequestString(),
+			errors.New("source and destination Cryptocurrencies must differ")
+	}
+
+	// Validate the source amount.
+	if _, err = HTTPValidateOfferRequest(sourceAmount, constants.DecimalPlacesCrypto()); err != nil {
+		return offer, http.StatusBadRequest, constants.InvalidRequestString(), fmt.Errorf("%w", err)
+	}
+
+	// Compute the fee, which is collected in the source Cryptocurrency, and ensure there are funds left to convert.
+	offer.Fee = quotes.Fee(source, destination, sourceAmount, constants.DecimalPlacesCrypto())
+	if !sourceAmount.GreaterThan(offer.Fee) {
+		msg := "cryptocurrency swap amount does not cover the fee"
+
+		return offer, http.StatusBadRequest, msg, errors.New(msg)
+	}
+
+	// Compile exchange rate offer. The destination amount is a Cryptocurrency and requires Cryptocurrency precision.
+	if offer.Rate, offer.Amount, err = quotes.CryptoConversion(
+		source, destination, sourceAmount.Sub(offer.Fee), true, nil); err != nil {
+		logger.Warn("failed to retrieve quote for Cryptocurrency swap offer", zap.Error(err))
+
+		return offer, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+	}
+
+	// Check to make sure there is a valid Cryptocurrency amount.
+	if !offer.Amount.GreaterThan(decimal.NewFromFloat(0)) {
+		msg := "cryptocurrency swap amount is too small"
+
+		return offer, http.StatusBadRequest, msg, errors.New(msg)
+	}
+
+	offer.PriceQuote.ClientID = clientID
+	offer.SourceAcc = source
+	offer.DestinationAcc = destination
+	offer.DebitAmount = sourceAmount
+	offer.Expires = time.Now().Add(constants.FiatOfferTTL()).Unix()
+	offer.IsCryptoSwap = true
+
+	// Encrypt offer ID before returning to client.
+	if offer.OfferID, err = auth.EncryptToString([]byte(offerID)); err != nil {
+		msg := "failed to encrypt offer ID for Cryptocurrency swap offer"
+		logger.Warn(msg, zap.Error(err))
+
+		return offer, http.StatusInternalServerError, constants.RetryMessageString(), errors.New(msg)
+	}
+
+	// Store the offer in Redis.
+	if err = cache.Set(offerID, &offer, constants.FiatOfferTTL()); err != nil {
+		msg := "failed to store Cryptocurrency swap offer in cache"
+		logger.Warn(msg, zap.Error(err))
+
+		return offer, http.StatusInternalServerError, constants.RetryMessageString(), errors.New(msg)
+	}
+
+	return offer, 0, "", nil
+}
+
+// HTTPSwapCrypto will complete a Cryptocurrency to Cryptocurrency swap.
+func HTTPSwapCrypto(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
+	clientID uuid.UUID, offerID string) (models.HTTPCryptoSwapResponse, int, string, error) {
+	var (
+		err     error
+		offer   models.HTTPExchangeOfferResponse
+		receipt models.HTTPCryptoSwapResponse
+	)
+
+	// Extract Offer ID from request.
+	{
+		var rawOfferID []byte
+
+		if rawOfferID, err = auth.DecryptFromString(offerID); err != nil {
+			logger.Warn("failed to decrypt Offer ID for Crypto swap request", zap.Error(err))
+
+			return receipt, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		offerID = string(rawOfferID)
+	}
+
+	// Retrieve the offer from Redis. Once retrieved, the entry must be removed from the cache to block re-use of
+	// the offer. If a database update fails below this point the user will need to re-request an offer.
+	{
+		var (
+			status int
+			msg    string
+		)
+
+		if offer, status, msg, err = HTTPGetCachedOffer(cache, logger, offerID); err != nil {
+			return receipt, status, msg, fmt.Errorf("%w", err)
+		}
+	}
+
+	// Verify that offer is a Crypto swap offer.
+	if !offer.IsCryptoSwap {
+		msg := "invalid Cryptocurrency swap offer"
+
+		return receipt, http.StatusBadRequest, msg, errors.New(msg)
+	}
+
+	// Verify that the client IDs match.
+	if clientID != offer.ClientID {
+		msg := "clientID mismatch with the Crypto swap Offer stored in Redis"
+		logger.Warn(msg,
+			zap.Strings("Requester & Offer Client IDs", []string{clientID.String(), offer.ClientID.String()}))
+
+		return receipt, http.StatusInternalServerError, constants.RetryMessageString(), errors.New(msg)
+	}
+
+	// Execute swap.
+	if receipt.SrcTxReceipt, receipt.DstTxReceipt, err = db.CryptoSwap(
+		clientID, offer.SourceAcc, offer.DebitAmount, offer.DestinationAcc, offer.Amount, offer.Fee); err != nil {
 		return receipt, http.StatusInternalServerError, err.Error(), fmt.Errorf("%w", err)
 	}
 
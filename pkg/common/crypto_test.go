@@ -376,6 +376,8 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 		httpStatus       int
 		isPurchase       bool
 		quotesAmount     decimal.Decimal
+		feeAmount        decimal.Decimal
+		feeTimes         int
 		quotesTimes      int
 		quotesErr        error
 		authEncryptTimes int
@@ -393,6 +395,7 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpStatus:       http.StatusBadRequest,
 			isPurchase:       true,
 			quotesAmount:     decimal.NewFromFloat(1.23),
+			feeTimes:         0,
 			quotesTimes:      0,
 			quotesErr:        nil,
 			authEncryptTimes: 0,
@@ -409,6 +412,7 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpStatus:       http.StatusInternalServerError,
 			isPurchase:       true,
 			quotesAmount:     decimal.NewFromFloat(1.23),
+			feeTimes:         1,
 			quotesTimes:      1,
 			quotesErr:        errors.New("quote failure"),
 			authEncryptTimes: 0,
@@ -425,6 +429,7 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpStatus:       http.StatusBadRequest,
 			isPurchase:       true,
 			quotesAmount:     decimal.NewFromFloat(0),
+			feeTimes:         1,
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 0,
@@ -441,6 +446,7 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpStatus:       http.StatusInternalServerError,
 			isPurchase:       true,
 			quotesAmount:     decimal.NewFromFloat(1.23),
+			feeTimes:         1,
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 1,
@@ -457,6 +463,7 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpStatus:       http.StatusInternalServerError,
 			isPurchase:       true,
 			quotesAmount:     decimal.NewFromFloat(1.23),
+			feeTimes:         1,
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 1,
@@ -473,6 +480,7 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpStatus:       0,
 			isPurchase:       true,
 			quotesAmount:     decimal.NewFromFloat(1.23),
+			feeTimes:         1,
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 1,
@@ -489,6 +497,7 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpStatus:       http.StatusBadRequest,
 			isPurchase:       false,
 			quotesAmount:     decimal.NewFromFloat(1.23),
+			feeTimes:         0,
 			quotesTimes:      0,
 			quotesErr:        nil,
 			authEncryptTimes: 0,
@@ -505,6 +514,7 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpStatus:       http.StatusInternalServerError,
 			isPurchase:       false,
 			quotesAmount:     decimal.NewFromFloat(1.23),
+			feeTimes:         1,
 			quotesTimes:      1,
 			quotesErr:        errors.New("quote failure"),
 			authEncryptTimes: 0,
@@ -521,6 +531,7 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpStatus:       http.StatusBadRequest,
 			isPurchase:       false,
 			quotesAmount:     decimal.NewFromFloat(0),
+			feeTimes:         1,
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 0,
@@ -537,6 +548,7 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpStatus:       http.StatusInternalServerError,
 			isPurchase:       false,
 			quotesAmount:     decimal.NewFromFloat(1.23),
+			feeTimes:         1,
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 1,
@@ -553,6 +565,7 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpStatus:       http.StatusInternalServerError,
 			isPurchase:       false,
 			quotesAmount:     decimal.NewFromFloat(1.23),
+			feeTimes:         1,
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 1,
@@ -569,6 +582,43 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			httpStatus:       0,
 			isPurchase:       false,
 			quotesAmount:     decimal.NewFromFloat(1.23),
+			feeTimes:         1,
+			quotesTimes:      1,
+			quotesErr:        nil,
+			authEncryptTimes: 1,
+			authEncryptErr:   nil,
+			redisTimes:       1,
+			redisErr:         nil,
+			expectErr:        require.NoError,
+		}, {
+			name:             "fee exceeds amount - purchase",
+			source:           "USD",
+			destination:      "BTC",
+			expectErrMsg:     "does not cover the fee",
+			httpMessage:      "does not cover the fee",
+			httpStatus:       http.StatusBadRequest,
+			isPurchase:       true,
+			quotesAmount:     decimal.NewFromFloat(1.23),
+			feeAmount:        sourceAmount,
+			feeTimes:         1,
+			quotesTimes:      0,
+			quotesErr:        nil,
+			authEncryptTimes: 0,
+			authEncryptErr:   nil,
+			redisTimes:       0,
+			redisErr:         nil,
+			expectErr:        require.Error,
+		}, {
+			name:             "valid with fee - sell",
+			source:           "BTC",
+			destination:      "USD",
+			expectErrMsg:     "",
+			httpMessage:      "",
+			httpStatus:       0,
+			isPurchase:       false,
+			quotesAmount:     decimal.NewFromFloat(1.23),
+			feeAmount:        decimal.NewFromFloat(57.80780000),
+			feeTimes:         1,
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 1,
@@ -589,9 +639,18 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			mockCache := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl)
 
+			precision := constants.DecimalPlacesFiat()
+			if !test.isPurchase {
+				precision = constants.DecimalPlacesCrypto()
+			}
+
 			gomock.InOrder(
+				mockQuotes.EXPECT().Fee(test.source, test.destination, sourceAmount, precision).
+					Return(test.feeAmount).
+					Times(test.feeTimes),
+
 				mockQuotes.EXPECT().CryptoConversion(
-					test.source, test.destination, sourceAmount, test.isPurchase, nil).
+					test.source, test.destination, sourceAmount.Sub(test.feeAmount), test.isPurchase, nil).
 					Return(quotesRate, test.quotesAmount, test.quotesErr).
 					Times(test.quotesTimes),
 
@@ -620,6 +679,7 @@ func TestCommon_HTTPCryptoOffer(t *testing.T) {
 			require.Equal(t, test.destination, offer.DestinationAcc, "destination account mismatch.")
 			require.Equal(t, sourceAmount, offer.DebitAmount, "debit amount mismatch.")
 			require.Equal(t, quotesRate, offer.Rate, "offer rate mismatch.")
+			require.Equal(t, test.feeAmount, offer.Fee, "offer fee mismatch.")
 			require.Equal(t, test.quotesAmount, offer.Amount, "offer amount mismatch.")
 		})
 	}
@@ -634,6 +694,7 @@ func TestCommon_HTTPExchangeCrypto(t *testing.T) { //nolint: maintidx
 
 	cryptoAmount := decimal.NewFromFloat(1234.56)
 	fiatAmount := decimal.NewFromFloat(78910.11)
+	feeAmount := decimal.NewFromFloat(0.25)
 
 	validFiat := models.HTTPExchangeOfferResponse{
 		PriceQuote: models.PriceQuote{
@@ -659,6 +720,7 @@ func TestCommon_HTTPExchangeCrypto(t *testing.T) { //nolint: maintidx
 			Amount:         fiatAmount,
 		},
 		DebitAmount:      cryptoAmount,
+		Fee:              feeAmount,
 		OfferID:          "OFFER-ID",
 		Expires:          0,
 		IsCryptoPurchase: false,
@@ -674,6 +736,7 @@ func TestCommon_HTTPExchangeCrypto(t *testing.T) { //nolint: maintidx
 			Amount:         fiatAmount,
 		},
 		DebitAmount:      cryptoAmount,
+		Fee:              feeAmount,
 		OfferID:          "OFFER-ID",
 		Expires:          0,
 		IsCryptoPurchase: false,
@@ -689,6 +752,7 @@ func TestCommon_HTTPExchangeCrypto(t *testing.T) { //nolint: maintidx
 			Amount:         cryptoAmount,
 		},
 		DebitAmount:      fiatAmount,
+		Fee:              feeAmount,
 		OfferID:          "OFFER-ID",
 		Expires:          0,
 		IsCryptoPurchase: true,
@@ -894,12 +958,14 @@ func TestCommon_HTTPExchangeCrypto(t *testing.T) { //nolint: maintidx
 					Times(test.redisDelTimes),
 
 				mockPostgres.EXPECT().CryptoPurchase(
-					gomock.Any(), postgres.CurrencyUSD, fiatAmount, "BTC", cryptoAmount).
+					gomock.Any(), postgres.CurrencyUSD, fiatAmount, "BTC", cryptoAmount, feeAmount,
+					gomock.Not(gomock.Nil())).
 					Return(&postgres.FiatJournal{}, &postgres.CryptoJournal{}, test.purchaseErr).
 					Times(test.purchaseTimes),
 
 				mockPostgres.EXPECT().CryptoSell(
-					gomock.Any(), postgres.CurrencyUSD, fiatAmount, "BTC", cryptoAmount).
+					gomock.Any(), postgres.CurrencyUSD, fiatAmount, "BTC", cryptoAmount, feeAmount,
+					gomock.Not(gomock.Nil())).
 					Return(&postgres.FiatJournal{}, &postgres.CryptoJournal{}, test.sellErr).
 					Times(test.sellTimes),
 			)
@@ -914,6 +980,487 @@ func TestCommon_HTTPExchangeCrypto(t *testing.T) { //nolint: maintidx
 	}
 }
 
+func TestCommon_HTTPCryptoSwapOffer(t *testing.T) {
+	var (
+		sourceAmount = decimal.NewFromFloat(1.12345678)
+		quotesRate   = decimal.NewFromFloat(16.5)
+	)
+
+	testCases := []struct {
+		name             string
+		source           string
+		destination      string
+		amount           decimal.Decimal
+		expectErrMsg     string
+		httpMessage      string
+		httpStatus       int
+		quotesAmount     decimal.Decimal
+		feeAmount        decimal.Decimal
+		feeTimes         int
+		quotesTimes      int
+		quotesErr        error
+		authEncryptTimes int
+		authEncryptErr   error
+		redisTimes       int
+		redisErr         error
+		expectErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:             "invalid source ticker",
+			source:           "INVALID",
+			destination:      "ETH",
+			amount:           sourceAmount,
+			expectErrMsg:     "INVALID",
+			httpMessage:      constants.InvalidRequestString(),
+			httpStatus:       http.StatusBadRequest,
+			quotesAmount:     decimal.NewFromFloat(1.23),
+			feeTimes:         0,
+			quotesTimes:      0,
+			quotesErr:        nil,
+			authEncryptTimes: 0,
+			authEncryptErr:   nil,
+			redisTimes:       0,
+			redisErr:         nil,
+			expectErr:        require.Error,
+		}, {
+			name:             "invalid destination ticker",
+			source:           "BTC",
+			destination:      "",
+			amount:           sourceAmount,
+			expectErrMsg:     "ticker",
+			httpMessage:      constants.InvalidRequestString(),
+			httpStatus:       http.StatusBadRequest,
+			quotesAmount:     decimal.NewFromFloat(1.23),
+			feeTimes:         0,
+			quotesTimes:      0,
+			quotesErr:        nil,
+			authEncryptTimes: 0,
+			authEncryptErr:   nil,
+			redisTimes:       0,
+			redisErr:         nil,
+			expectErr:        require.Error,
+		}, {
+			name:             "same source and destination",
+			source:           "BTC",
+			destination:      "BTC",
+			amount:           sourceAmount,
+			expectErrMsg:     "must differ",
+			httpMessage:      constants.InvalidRequestString(),
+			httpStatus:       http.StatusBadRequest,
+			quotesAmount:     decimal.NewFromFloat(1.23),
+			feeTimes:         0,
+			quotesTimes:      0,
+			quotesErr:        nil,
+			authEncryptTimes: 0,
+			authEncryptErr:   nil,
+			redisTimes:       0,
+			redisErr:         nil,
+			expectErr:        require.Error,
+		}, {
+			name:             "invalid amount",
+			source:           "BTC",
+			destination:      "ETH",
+			amount:           decimal.NewFromFloat(1.123456789),
+			expectErrMsg:     "invalid source amount",
+			httpMessage:      constants.InvalidRequestString(),
+			httpStatus:       http.StatusBadRequest,
+			quotesAmount:     decimal.NewFromFloat(1.23),
+			feeTimes:         0,
+			quotesTimes:      0,
+			quotesErr:        nil,
+			authEncryptTimes: 0,
+			authEncryptErr:   nil,
+			redisTimes:       0,
+			redisErr:         nil,
+			expectErr:        require.Error,
+		}, {
+			name:             "crypto conversion",
+			source:           "BTC",
+			destination:      "ETH",
+			amount:           sourceAmount,
+			expectErrMsg:     "quote failure",
+			httpMessage:      constants.RetryMessageString(),
+			httpStatus:       http.StatusInternalServerError,
+			quotesAmount:     decimal.NewFromFloat(1.23),
+			feeTimes:         1,
+			quotesTimes:      1,
+			quotesErr:        errors.New("quote failure"),
+			authEncryptTimes: 0,
+			authEncryptErr:   nil,
+			redisTimes:       0,
+			redisErr:         nil,
+			expectErr:        require.Error,
+		}, {
+			name:             "zero amount",
+			source:           "BTC",
+			destination:      "ETH",
+			amount:           sourceAmount,
+			expectErrMsg:     "too small",
+			httpMessage:      "too small",
+			httpStatus:       http.StatusBadRequest,
+			quotesAmount:     decimal.NewFromFloat(0),
+			feeTimes:         1,
+			quotesTimes:      1,
+			quotesErr:        nil,
+			authEncryptTimes: 0,
+			authEncryptErr:   nil,
+			redisTimes:       0,
+			redisErr:         nil,
+			expectErr:        require.Error,
+		}, {
+			name:             "encryption failure",
+			source:           "BTC",
+			destination:      "ETH",
+			amount:           sourceAmount,
+			expectErrMsg:     "failed to encrypt",
+			httpMessage:      constants.RetryMessageString(),
+			httpStatus:       http.StatusInternalServerError,
+			quotesAmount:     decimal.NewFromFloat(1.23),
+			feeTimes:         1,
+			quotesTimes:      1,
+			quotesErr:        nil,
+			authEncryptTimes: 1,
+			authEncryptErr:   errors.New("encryption failure"),
+			redisTimes:       0,
+			redisErr:         nil,
+			expectErr:        require.Error,
+		}, {
+			name:             "cache failure",
+			source:           "BTC",
+			destination:      "ETH",
+			amount:           sourceAmount,
+			expectErrMsg:     "failed to store",
+			httpMessage:      constants.RetryMessageString(),
+			httpStatus:       http.StatusInternalServerError,
+			quotesAmount:     decimal.NewFromFloat(1.23),
+			feeTimes:         1,
+			quotesTimes:      1,
+			quotesErr:        nil,
+			authEncryptTimes: 1,
+			authEncryptErr:   nil,
+			redisTimes:       1,
+			redisErr:         errors.New("cache failure"),
+			expectErr:        require.Error,
+		}, {
+			name:             "valid",
+			source:           "BTC",
+			destination:      "ETH",
+			amount:           sourceAmount,
+			expectErrMsg:     "",
+			httpMessage:      "",
+			httpStatus:       0,
+			quotesAmount:     decimal.NewFromFloat(18.53703687),
+			feeTimes:         1,
+			quotesTimes:      1,
+			quotesErr:        nil,
+			authEncryptTimes: 1,
+			authEncryptErr:   nil,
+			redisTimes:       1,
+			redisErr:         nil,
+			expectErr:        require.NoError,
+		}, {
+			name:             "fee exceeds amount",
+			source:           "BTC",
+			destination:      "ETH",
+			amount:           sourceAmount,
+			expectErrMsg:     "does not cover the fee",
+			httpMessage:      "does not cover the fee",
+			httpStatus:       http.StatusBadRequest,
+			quotesAmount:     decimal.NewFromFloat(18.53703687),
+			feeAmount:        sourceAmount,
+			feeTimes:         1,
+			quotesTimes:      0,
+			quotesErr:        nil,
+			authEncryptTimes: 0,
+			authEncryptErr:   nil,
+			redisTimes:       0,
+			redisErr:         nil,
+			expectErr:        require.Error,
+		}, {
+			name:             "valid with fee",
+			source:           "BTC",
+			destination:      "ETH",
+			amount:           sourceAmount,
+			expectErrMsg:     "",
+			httpMessage:      "",
+			httpStatus:       0,
+			quotesAmount:     decimal.NewFromFloat(18.53703687),
+			feeAmount:        decimal.NewFromFloat(0.00280864),
+			feeTimes:         1,
+			quotesTimes:      1,
+			quotesErr:        nil,
+			authEncryptTimes: 1,
+			authEncryptErr:   nil,
+			redisTimes:       1,
+			redisErr:         nil,
+			expectErr:        require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+
+			gomock.InOrder(
+				mockQuotes.EXPECT().Fee(test.source, test.destination, test.amount, constants.DecimalPlacesCrypto()).
+					Return(test.feeAmount).
+					Times(test.feeTimes),
+
+				mockQuotes.EXPECT().CryptoConversion(
+					test.source, test.destination, test.amount.Sub(test.feeAmount), true, nil).
+					Return(quotesRate, test.quotesAmount, test.quotesErr).
+					Times(test.quotesTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("OFFER-ID", test.authEncryptErr).
+					Times(test.authEncryptTimes),
+
+				mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.redisErr).
+					Times(test.redisTimes),
+			)
+
+			offer, status, msg, err := HTTPCryptoSwapOffer(mockAuth, mockCache, zapLogger, mockQuotes,
+				uuid.UUID{}, test.source, test.destination, test.amount)
+			test.expectErr(t, err, "error expectation failed.")
+
+			if err != nil {
+				require.Contains(t, err.Error(), test.expectErrMsg, "error message is incorrect.")
+				require.Contains(t, msg, test.httpMessage, "http error message mismatched.")
+				require.Equal(t, test.httpStatus, status, "http status mismatched.")
+
+				return
+			}
+
+			require.Equal(t, test.source, offer.SourceAcc, "source account mismatch.")
+			require.Equal(t, test.destination, offer.DestinationAcc, "destination account mismatch.")
+			require.Equal(t, test.amount, offer.DebitAmount, "debit amount mismatch.")
+			require.Equal(t, quotesRate, offer.Rate, "offer rate mismatch.")
+			require.Equal(t, test.feeAmount, offer.Fee, "offer fee mismatch.")
+			require.Equal(t, test.quotesAmount, offer.Amount, "offer amount mismatch.")
+			require.True(t, offer.IsCryptoSwap, "offer not marked as a swap.")
+			require.False(t, offer.IsCryptoPurchase, "offer marked as a purchase.")
+			require.False(t, offer.IsCryptoSale, "offer marked as a sale.")
+		})
+	}
+}
+
+func TestCommon_HTTPSwapCrypto(t *testing.T) {
+	validClientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate a valid uuid.")
+
+	invalidClientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate a valid uuid.")
+
+	sourceAmount := decimal.NewFromFloat(1.12345678)
+	feeAmount := decimal.NewFromFloat(0.00012345)
+	destinationAmount := decimal.NewFromFloat(18.53703687)
+
+	validSwap := models.HTTPExchangeOfferResponse{
+		PriceQuote: models.PriceQuote{
+			ClientID:       validClientID,
+			SourceAcc:      "BTC",
+			DestinationAcc: "ETH",
+			Rate:           decimal.NewFromFloat(16.5),
+			Amount:         destinationAmount,
+		},
+		DebitAmount:  sourceAmount,
+		Fee:          feeAmount,
+		OfferID:      "OFFER-ID",
+		Expires:      0,
+		IsCryptoSwap: true,
+	}
+
+	validPurchase := models.HTTPExchangeOfferResponse{
+		PriceQuote: models.PriceQuote{
+			ClientID:       validClientID,
+			SourceAcc:      "USD",
+			DestinationAcc: "BTC",
+			Rate:           decimal.Decimal{},
+			Amount:         sourceAmount,
+		},
+		DebitAmount:      destinationAmount,
+		Fee:              feeAmount,
+		OfferID:          "OFFER-ID",
+		Expires:          0,
+		IsCryptoPurchase: true,
+	}
+
+	testCases := []struct {
+		name             string
+		expectErrMsg     string
+		clientID         uuid.UUID
+		httpStatus       int
+		authDecryptTimes int
+		authDecryptErr   error
+		redisGetData     models.HTTPExchangeOfferResponse
+		redisGetTimes    int
+		redisGetErr      error
+		redisDelTimes    int
+		redisDelErr      error
+		swapTimes        int
+		swapErr          error
+		expectErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:             "decrypt failure",
+			clientID:         validClientID,
+			expectErrMsg:     "retry",
+			httpStatus:       http.StatusInternalServerError,
+			authDecryptTimes: 1,
+			authDecryptErr:   errors.New("decrypt failure"),
+			redisGetData:     validSwap,
+			redisGetTimes:    0,
+			redisGetErr:      nil,
+			redisDelTimes:    0,
+			redisDelErr:      nil,
+			swapTimes:        0,
+			swapErr:          nil,
+			expectErr:        require.Error,
+		}, {
+			name:             "cache get failure",
+			clientID:         validClientID,
+			expectErrMsg:     "retry",
+			httpStatus:       http.StatusInternalServerError,
+			authDecryptTimes: 1,
+			authDecryptErr:   nil,
+			redisGetData:     validSwap,
+			redisGetTimes:    1,
+			redisGetErr:      errors.New("cache get failure"),
+			redisDelTimes:    0,
+			redisDelErr:      nil,
+			swapTimes:        0,
+			swapErr:          nil,
+			expectErr:        require.Error,
+		}, {
+			name:             "cache del failure",
+			clientID:         validClientID,
+			expectErrMsg:     "retry",
+			httpStatus:       http.StatusInternalServerError,
+			authDecryptTimes: 1,
+			authDecryptErr:   nil,
+			redisGetData:     validSwap,
+			redisGetTimes:    1,
+			redisGetErr:      nil,
+			redisDelTimes:    1,
+			redisDelErr:      errors.New("cache del failure"),
+			swapTimes:        0,
+			swapErr:          nil,
+			expectErr:        require.Error,
+		}, {
+			name:             "not a swap offer",
+			clientID:         validClientID,
+			expectErrMsg:     "invalid",
+			httpStatus:       http.StatusBadRequest,
+			authDecryptTimes: 1,
+			authDecryptErr:   nil,
+			redisGetData:     validPurchase,
+			redisGetTimes:    1,
+			redisGetErr:      nil,
+			redisDelTimes:    1,
+			redisDelErr:      nil,
+			swapTimes:        0,
+			swapErr:          nil,
+			expectErr:        require.Error,
+		}, {
+			name:             "clientID mismatch",
+			clientID:         invalidClientID,
+			expectErrMsg:     "retry",
+			httpStatus:       http.StatusInternalServerError,
+			authDecryptTimes: 1,
+			authDecryptErr:   nil,
+			redisGetData:     validSwap,
+			redisGetTimes:    1,
+			redisGetErr:      nil,
+			redisDelTimes:    1,
+			redisDelErr:      nil,
+			swapTimes:        0,
+			swapErr:          nil,
+			expectErr:        require.Error,
+		}, {
+			name:             "transaction failure",
+			clientID:         validClientID,
+			expectErrMsg:     "swap failure",
+			httpStatus:       http.StatusInternalServerError,
+			authDecryptTimes: 1,
+			authDecryptErr:   nil,
+			redisGetData:     validSwap,
+			redisGetTimes:    1,
+			redisGetErr:      nil,
+			redisDelTimes:    1,
+			redisDelErr:      nil,
+			swapTimes:        1,
+			swapErr:          errors.New("swap failure"),
+			expectErr:        require.Error,
+		}, {
+			name:             "valid",
+			clientID:         validClientID,
+			expectErrMsg:     "",
+			httpStatus:       0,
+			authDecryptTimes: 1,
+			authDecryptErr:   nil,
+			redisGetData:     validSwap,
+			redisGetTimes:    1,
+			redisGetErr:      nil,
+			redisDelTimes:    1,
+			redisDelErr:      nil,
+			swapTimes:        1,
+			swapErr:          nil,
+			expectErr:        require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("OFFER-ID"), test.authDecryptErr).
+					Times(test.authDecryptTimes),
+
+				mockCache.EXPECT().Get(gomock.Any(), gomock.Any()).
+					Return(test.redisGetErr).
+					SetArg(1, test.redisGetData).
+					Times(test.redisGetTimes),
+
+				mockCache.EXPECT().Del(gomock.Any()).
+					Return(test.redisDelErr).
+					Times(test.redisDelTimes),
+
+				mockPostgres.EXPECT().CryptoSwap(
+					gomock.Any(), "BTC", sourceAmount, "ETH", destinationAmount, feeAmount).
+					Return(&postgres.CryptoJournal{}, &postgres.CryptoJournal{}, test.swapErr).
+					Times(test.swapTimes),
+			)
+
+			receipt, status, errMsg, err :=
+				HTTPSwapCrypto(mockAuth, mockCache, mockPostgres, zapLogger, test.clientID, "offer-id")
+			test.expectErr(t, err, "error expectation failed.")
+
+			require.Equal(t, test.httpStatus, status, "http status code mismatched.")
+			require.Contains(t, errMsg, test.expectErrMsg, "http error message mismatched.")
+
+			if err == nil {
+				require.NotNil(t, receipt.SrcTxReceipt, "source receipt missing.")
+				require.NotNil(t, receipt.DstTxReceipt, "destination receipt missing.")
+			}
+		})
+	}
+}
+
 func TestCommon_CryptoBalancePaginatedRequest(t *testing.T) {
 	encBTC, err := testAuth.EncryptToString([]byte("BTC"))
 	require.NoError(t, err, "failed to encrypt BTC currency.")
@@ -90,6 +90,47 @@ func HTTPFiatDeposit(db postgres.Postgres, logger *logger.Logger, clientID uuid.
 	return transferReceipt, 0, "", nil, nil
 }
 
+// HTTPFiatWithdraw withdraws a valid amount from a Fiat account.
+func HTTPFiatWithdraw(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	request *models.HTTPWithdrawCurrencyRequest) (*postgres.FiatAccountTransferResult, int, string, any, error) {
+	var (
+		pgCurrency      postgres.Currency
+		err             error
+		transferReceipt *postgres.FiatAccountTransferResult
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	// Extract and validate the currency.
+	if err = pgCurrency.Scan(request.Currency); err != nil || !pgCurrency.Valid() {
+		return nil, http.StatusBadRequest, constants.InvalidCurrencyString(), request.Currency, fmt.Errorf("%w", err)
+	}
+
+	// Check for correct decimal places.
+	if !request.Amount.Equal(request.Amount.Truncate(constants.DecimalPlacesFiat())) || !request.Amount.IsPositive() {
+		return nil, http.StatusBadRequest, "invalid amount", request.Amount, fmt.Errorf("%w", err)
+	}
+
+	if transferReceipt, err = db.FiatExternalWithdrawal(context.Background(),
+		&postgres.FiatTransactionDetails{
+			ClientID: clientID,
+			Currency: pgCurrency,
+			Amount:   request.Amount}); err != nil {
+		var withdrawErr *postgres.Error
+		if !errors.As(err, &withdrawErr) {
+			logger.Info("failed to unpack withdraw Fiat account error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+		}
+
+		return nil, withdrawErr.Code, withdrawErr.Message, nil, fmt.Errorf("%w", err)
+	}
+
+	return transferReceipt, 0, "", nil, nil
+}
+
 // HTTPFiatOffer retrieves an exchange rate offer from a quote provider and stores it in the Redis session cache.
 func HTTPFiatOffer(auth auth.Auth, cache redis.Redis, logger *logger.Logger, quotes quotes.Quotes, clientID uuid.UUID,
 	request *models.HTTPExchangeOfferRequest) (*models.HTTPExchangeOfferResponse, int, string, any, error) {
@@ -109,9 +150,18 @@ func HTTPFiatOffer(auth auth.Auth, cache redis.Redis, logger *logger.Logger, quo
 		return nil, http.StatusBadRequest, constants.InvalidRequestString(), err.Error(), fmt.Errorf("%w", err)
 	}
 
+	// Compute the fee, which is collected in the source currency, and ensure there are funds left to convert.
+	offer.Fee = quotes.Fee(request.SourceCurrency, request.DestinationCurrency, request.SourceAmount,
+		constants.DecimalPlacesFiat())
+	if !request.SourceAmount.GreaterThan(offer.Fee) {
+		msg := "fiat currency purchase/sale amount does not cover the fee"
+
+		return nil, http.StatusBadRequest, msg, offer.Fee.String(), errors.New(msg)
+	}
+
 	// Compile exchange rate offer.
 	if offer.Rate, offer.Amount, err = quotes.FiatConversion(
-		request.SourceCurrency, request.DestinationCurrency, request.SourceAmount, nil); err != nil {
+		request.SourceCurrency, request.DestinationCurrency, request.SourceAmount.Sub(offer.Fee), nil); err != nil {
 		logger.Warn("failed to retrieve quote for Fiat currency conversion", zap.Error(err))
 
 		return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
@@ -197,7 +247,7 @@ func HTTPFiatTransfer(auth auth.Auth, cache redis.Redis, db postgres.Postgres, l
 	}
 
 	// Verify the offer is for a Fiat exchange.
-	if offer.IsCryptoPurchase || offer.IsCryptoSale {
+	if offer.IsCryptoPurchase || offer.IsCryptoSale || offer.IsCryptoSwap {
 		return nil, http.StatusBadRequest, "invalid Fiat currency exchange offer", nil, fmt.Errorf("%w", err)
 	}
 
@@ -215,15 +265,17 @@ func HTTPFiatTransfer(auth auth.Auth, cache redis.Redis, db postgres.Postgres, l
 		ClientID: offer.ClientID,
 		Currency: parsedCurrencies[0],
 		Amount:   offer.DebitAmount,
+		Fee:      offer.Fee,
 	}
 	dstTxDetails := &postgres.FiatTransactionDetails{
 		ClientID: offer.ClientID,
 		Currency: parsedCurrencies[1],
 		Amount:   offer.Amount,
 	}
+	trade := &postgres.TradeDetails{OfferID: offerID, Rate: offer.Rate}
 
 	if receipt.SrcTxReceipt, receipt.DstTxReceipt, err = db.
-		FiatInternalTransfer(context.Background(), srcTxDetails, dstTxDetails); err != nil {
+		FiatInternalTransfer(context.Background(), srcTxDetails, dstTxDetails, trade); err != nil {
 		logger.Warn("failed to complete internal Fiat transfer", zap.Error(err))
 
 		return nil, http.StatusBadRequest, "please check you have both currency accounts and enough funds.",
@@ -233,6 +285,73 @@ func HTTPFiatTransfer(auth auth.Auth, cache redis.Redis, db postgres.Postgres, l
 	return &receipt, 0, "", nil, nil
 }
 
+// HTTPFiatTransferP2P will transfer funds from a client's Fiat account to another client's Fiat account in the same
+// currency. Only the source account's receipt is returned to avoid leaking the recipient's account details.
+func HTTPFiatTransferP2P(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	request *models.HTTPFiatP2PTransferRequest) (*postgres.FiatAccountTransferResult, int, string, any, error) {
+	var (
+		err         error
+		pgCurrency  postgres.Currency
+		recipientID uuid.UUID
+		srcReceipt  *postgres.FiatAccountTransferResult
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	// Extract and validate the currency.
+	if err = pgCurrency.Scan(request.Currency); err != nil || !pgCurrency.Valid() {
+		return nil, http.StatusBadRequest, constants.InvalidCurrencyString(), request.Currency, fmt.Errorf("%w", err)
+	}
+
+	// Check for correct decimal places.
+	if !request.Amount.Equal(request.Amount.Truncate(constants.DecimalPlacesFiat())) || !request.Amount.IsPositive() {
+		return nil, http.StatusBadRequest, "invalid amount", request.Amount, fmt.Errorf("%w", err)
+	}
+
+	// Resolve the recipient and ensure it is an active account.
+	{
+		var isDeleted bool
+
+		if recipientID, err = db.UserGetClientID(request.Username); err != nil {
+			return nil, http.StatusNotFound, "recipient not found", request.Username, fmt.Errorf("%w", err)
+		}
+
+		if isDeleted, err = db.UserIsDeleted(recipientID); err != nil || isDeleted {
+			return nil, http.StatusNotFound, "recipient not found", request.Username, fmt.Errorf("%w", err)
+		}
+	}
+
+	if recipientID == clientID {
+		msg := "cannot transfer funds to your own account"
+
+		return nil, http.StatusBadRequest, msg, request.Username, errors.New(msg)
+	}
+
+	// Execute transfer.
+	srcTxDetails := &postgres.FiatTransactionDetails{
+		ClientID: clientID,
+		Currency: pgCurrency,
+		Amount:   request.Amount,
+	}
+	dstTxDetails := &postgres.FiatTransactionDetails{
+		ClientID: recipientID,
+		Currency: pgCurrency,
+		Amount:   request.Amount,
+	}
+
+	if srcReceipt, _, err = db.FiatInternalTransfer(context.Background(), srcTxDetails, dstTxDetails, nil); err != nil {
+		logger.Warn("failed to complete peer-to-peer Fiat transfer", zap.Error(err))
+
+		return nil, http.StatusBadRequest,
+			"please check that you and the recipient have currency accounts and that you have enough funds.",
+			nil, fmt.Errorf("%w", err)
+	}
+
+	return srcReceipt, 0, "", nil, nil
+}
+
 // HTTPFiatBalance retrieves the account balance for a specific Fiat currency.
 func HTTPFiatBalance(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, ticker string) (
 	*postgres.FiatAccount, int, string, any, error) {
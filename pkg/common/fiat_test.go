@@ -201,6 +201,124 @@ func TestCommon_HTTPFiatDeposit(t *testing.T) {
 	}
 }
 
+func TestCommon_HTTPFiatWithdraw(t *testing.T) {
+	validRequest := models.HTTPWithdrawCurrencyRequest{
+		Amount:   decimal.NewFromFloat(49866.13),
+		Currency: "USD",
+	}
+
+	testCases := []struct {
+		name             string
+		request          *models.HTTPWithdrawCurrencyRequest
+		expectErrMsg     string
+		expectErrCode    int
+		withdrawErr      error
+		withdrawTimes    int
+		expectErr        require.ErrorAssertionFunc
+		expectNilReceipt require.ValueAssertionFunc
+		expectNilPayload require.ValueAssertionFunc
+	}{
+		{
+			name:             "empty request",
+			request:          &models.HTTPWithdrawCurrencyRequest{},
+			withdrawErr:      nil,
+			withdrawTimes:    0,
+			expectErrCode:    http.StatusBadRequest,
+			expectErrMsg:     constants.ValidationString(),
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "invalid currency",
+			request:          &models.HTTPWithdrawCurrencyRequest{Currency: "INVALID", Amount: validRequest.Amount},
+			withdrawErr:      nil,
+			withdrawTimes:    0,
+			expectErrCode:    http.StatusBadRequest,
+			expectErrMsg:     constants.InvalidCurrencyString(),
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name: "too many decimal places",
+			request: &models.HTTPWithdrawCurrencyRequest{
+				Currency: validRequest.Currency,
+				Amount:   decimal.NewFromFloat(49866.123),
+			},
+			withdrawErr:      nil,
+			withdrawTimes:    0,
+			expectErrCode:    http.StatusBadRequest,
+			expectErrMsg:     "invalid amount",
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name: "negative",
+			request: &models.HTTPWithdrawCurrencyRequest{
+				Currency: validRequest.Currency,
+				Amount:   decimal.NewFromFloat(-49866.13),
+			},
+			withdrawErr:      nil,
+			withdrawTimes:    0,
+			expectErrCode:    http.StatusBadRequest,
+			expectErrMsg:     "invalid amount",
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "unknown db failure",
+			request:          &validRequest,
+			withdrawErr:      errors.New("unknown error"),
+			withdrawTimes:    1,
+			expectErrCode:    http.StatusInternalServerError,
+			expectErrMsg:     constants.RetryMessageString(),
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "known db failure",
+			request:          &validRequest,
+			withdrawErr:      postgres.ErrWithdrawFiat,
+			withdrawTimes:    1,
+			expectErrCode:    http.StatusBadRequest,
+			expectErrMsg:     "sufficient funds",
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "USD",
+			request:          &validRequest,
+			withdrawErr:      nil,
+			withdrawTimes:    1,
+			expectErrCode:    0,
+			expectErrMsg:     "",
+			expectErr:        require.NoError,
+			expectNilReceipt: require.NotNil,
+			expectNilPayload: require.Nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().FiatExternalWithdrawal(gomock.Any(), gomock.Any()).
+				Return(&postgres.FiatAccountTransferResult{}, test.withdrawErr).
+				Times(test.withdrawTimes)
+
+			result, actualErrCode, actualErrMsg, payload, err := HTTPFiatWithdraw(mockDB, zapLogger, uuid.UUID{}, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectNilReceipt(t, result, "nil result expectation failed.")
+			test.expectNilPayload(t, payload, "nil payload expectation failed.")
+			require.Equal(t, test.expectErrCode, actualErrCode, "error codes mismatched.")
+			require.Contains(t, actualErrMsg, test.expectErrMsg, "expected error message mismatched.")
+		})
+	}
+}
+
 func TestCommon_HTTPFiatOffer(t *testing.T) {
 	var (
 		sourceAmount = decimal.NewFromFloat(23123.12)
@@ -222,6 +340,8 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 		httpStatus       int
 		request          *models.HTTPExchangeOfferRequest
 		quotesAmount     decimal.Decimal
+		feeAmount        decimal.Decimal
+		feeTimes         int
 		quotesTimes      int
 		quotesErr        error
 		authEncryptTimes int
@@ -244,6 +364,7 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 				SourceAmount:        sourceAmount,
 			},
 			quotesAmount:     quotesAmount,
+			feeTimes:         0,
 			quotesTimes:      0,
 			quotesErr:        nil,
 			authEncryptTimes: 0,
@@ -259,6 +380,7 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 			httpStatus:       http.StatusInternalServerError,
 			request:          &validRequest,
 			quotesAmount:     quotesAmount,
+			feeTimes:         1,
 			quotesTimes:      1,
 			quotesErr:        errors.New("quote failure"),
 			authEncryptTimes: 0,
@@ -274,6 +396,7 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 			httpStatus:       http.StatusBadRequest,
 			request:          &validRequest,
 			quotesAmount:     decimal.NewFromFloat(0),
+			feeTimes:         1,
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 0,
@@ -289,6 +412,7 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 			httpStatus:       http.StatusInternalServerError,
 			request:          &validRequest,
 			quotesAmount:     quotesAmount,
+			feeTimes:         1,
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 1,
@@ -304,6 +428,7 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 			httpStatus:       http.StatusInternalServerError,
 			request:          &validRequest,
 			quotesAmount:     quotesAmount,
+			feeTimes:         1,
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 1,
@@ -319,6 +444,41 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 			httpStatus:       0,
 			request:          &validRequest,
 			quotesAmount:     quotesAmount,
+			feeTimes:         1,
+			quotesTimes:      1,
+			quotesErr:        nil,
+			authEncryptTimes: 1,
+			authEncryptErr:   nil,
+			redisTimes:       1,
+			redisErr:         nil,
+			expectErr:        require.NoError,
+			expectNilPayload: require.Nil,
+		}, {
+			name:             "fee exceeds amount",
+			expectErrMsg:     "does not cover the fee",
+			httpMessage:      "does not cover the fee",
+			httpStatus:       http.StatusBadRequest,
+			request:          &validRequest,
+			quotesAmount:     quotesAmount,
+			feeAmount:        sourceAmount,
+			feeTimes:         1,
+			quotesTimes:      0,
+			quotesErr:        nil,
+			authEncryptTimes: 0,
+			authEncryptErr:   nil,
+			redisTimes:       0,
+			redisErr:         nil,
+			expectErr:        require.Error,
+			expectNilPayload: require.NotNil,
+		}, {
+			name:             "valid with fee",
+			expectErrMsg:     "",
+			httpMessage:      "",
+			httpStatus:       0,
+			request:          &validRequest,
+			quotesAmount:     quotesAmount,
+			feeAmount:        decimal.NewFromFloat(57.81),
+			feeTimes:         1,
 			quotesTimes:      1,
 			quotesErr:        nil,
 			authEncryptTimes: 1,
@@ -341,8 +501,12 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 			mockQuotes := quotes.NewMockQuotes(mockCtrl)
 
 			gomock.InOrder(
+				mockQuotes.EXPECT().Fee(test.request.SourceCurrency, test.request.DestinationCurrency, test.request.SourceAmount, constants.DecimalPlacesFiat()).
+					Return(test.feeAmount).
+					Times(test.feeTimes),
+
 				mockQuotes.EXPECT().FiatConversion(
-					test.request.SourceCurrency, test.request.DestinationCurrency, test.request.SourceAmount, nil).
+					test.request.SourceCurrency, test.request.DestinationCurrency, test.request.SourceAmount.Sub(test.feeAmount), nil).
 					Return(quotesRate, test.quotesAmount, test.quotesErr).
 					Times(test.quotesTimes),
 
@@ -372,6 +536,7 @@ func TestCommon_HTTPFiatOffer(t *testing.T) {
 			require.Equal(t, test.request.DestinationCurrency, offer.DestinationAcc, "destination account mismatch.")
 			require.Equal(t, test.request.SourceAmount, offer.DebitAmount, "debit amount mismatch.")
 			require.Equal(t, quotesRate, offer.Rate, "offer rate mismatch.")
+			require.Equal(t, test.feeAmount, offer.Fee, "offer fee mismatch.")
 			require.Equal(t, test.quotesAmount, offer.Amount, "offer amount mismatch.")
 		})
 	}
@@ -676,7 +841,7 @@ func TestCommon_HTTPFiatTransfer(t *testing.T) { //nolint:maintidx
 					Return(test.redisDelErr).
 					Times(test.redisDelTimes),
 
-				mockDB.EXPECT().FiatInternalTransfer(gomock.Any(), gomock.Any(), gomock.Any()).
+				mockDB.EXPECT().FiatInternalTransfer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).
 					Return(nil, nil, test.internalXferErr).
 					Times(test.internalXferTimes),
 			)
@@ -692,6 +857,200 @@ func TestCommon_HTTPFiatTransfer(t *testing.T) { //nolint:maintidx
 	}
 }
 
+func TestCommon_HTTPFiatTransferP2P(t *testing.T) {
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	recipientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate recipient id.")
+
+	validRequest := models.HTTPFiatP2PTransferRequest{
+		Username: "recipient",
+		Currency: "USD",
+		Amount:   decimal.NewFromFloat(1234.56),
+	}
+
+	testCases := []struct {
+		name             string
+		request          *models.HTTPFiatP2PTransferRequest
+		recipientID      uuid.UUID
+		expectErrMsg     string
+		expectErrCode    int
+		getClientIDErr   error
+		getClientIDTimes int
+		isDeleted        bool
+		isDeletedErr     error
+		isDeletedTimes   int
+		transferErr      error
+		transferTimes    int
+		expectErr        require.ErrorAssertionFunc
+		expectNilReceipt require.ValueAssertionFunc
+	}{
+		{
+			name:             "empty request",
+			request:          &models.HTTPFiatP2PTransferRequest{},
+			recipientID:      recipientID,
+			expectErrMsg:     constants.ValidationString(),
+			expectErrCode:    http.StatusBadRequest,
+			getClientIDTimes: 0,
+			isDeletedTimes:   0,
+			transferTimes:    0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+		}, {
+			name: "invalid currency",
+			request: &models.HTTPFiatP2PTransferRequest{
+				Username: validRequest.Username,
+				Currency: "INVALID",
+				Amount:   validRequest.Amount,
+			},
+			recipientID:      recipientID,
+			expectErrMsg:     constants.InvalidCurrencyString(),
+			expectErrCode:    http.StatusBadRequest,
+			getClientIDTimes: 0,
+			isDeletedTimes:   0,
+			transferTimes:    0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+		}, {
+			name: "too many decimal places",
+			request: &models.HTTPFiatP2PTransferRequest{
+				Username: validRequest.Username,
+				Currency: validRequest.Currency,
+				Amount:   decimal.NewFromFloat(1234.567),
+			},
+			recipientID:      recipientID,
+			expectErrMsg:     "invalid amount",
+			expectErrCode:    http.StatusBadRequest,
+			getClientIDTimes: 0,
+			isDeletedTimes:   0,
+			transferTimes:    0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+		}, {
+			name: "negative amount",
+			request: &models.HTTPFiatP2PTransferRequest{
+				Username: validRequest.Username,
+				Currency: validRequest.Currency,
+				Amount:   decimal.NewFromFloat(-1234.56),
+			},
+			recipientID:      recipientID,
+			expectErrMsg:     "invalid amount",
+			expectErrCode:    http.StatusBadRequest,
+			getClientIDTimes: 0,
+			isDeletedTimes:   0,
+			transferTimes:    0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+		}, {
+			name:             "recipient not found",
+			request:          &validRequest,
+			recipientID:      uuid.UUID{},
+			expectErrMsg:     "recipient not found",
+			expectErrCode:    http.StatusNotFound,
+			getClientIDErr:   postgres.ErrNotFoundUser,
+			getClientIDTimes: 1,
+			isDeletedTimes:   0,
+			transferTimes:    0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+		}, {
+			name:             "recipient deletion check failure",
+			request:          &validRequest,
+			recipientID:      recipientID,
+			expectErrMsg:     "recipient not found",
+			expectErrCode:    http.StatusNotFound,
+			getClientIDTimes: 1,
+			isDeletedErr:     postgres.ErrNotFound,
+			isDeletedTimes:   1,
+			transferTimes:    0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+		}, {
+			name:             "recipient deleted",
+			request:          &validRequest,
+			recipientID:      recipientID,
+			expectErrMsg:     "recipient not found",
+			expectErrCode:    http.StatusNotFound,
+			getClientIDTimes: 1,
+			isDeleted:        true,
+			isDeletedTimes:   1,
+			transferTimes:    0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+		}, {
+			name:             "self transfer",
+			request:          &validRequest,
+			recipientID:      clientID,
+			expectErrMsg:     "own account",
+			expectErrCode:    http.StatusBadRequest,
+			getClientIDTimes: 1,
+			isDeletedTimes:   1,
+			transferTimes:    0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+		}, {
+			name:             "transfer failure",
+			request:          &validRequest,
+			recipientID:      recipientID,
+			expectErrMsg:     "enough funds",
+			expectErrCode:    http.StatusBadRequest,
+			getClientIDTimes: 1,
+			isDeletedTimes:   1,
+			transferErr:      postgres.ErrTransactFiat,
+			transferTimes:    1,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+		}, {
+			name:             "valid",
+			request:          &validRequest,
+			recipientID:      recipientID,
+			expectErrMsg:     "",
+			expectErrCode:    0,
+			getClientIDTimes: 1,
+			isDeletedTimes:   1,
+			transferTimes:    1,
+			expectErr:        require.NoError,
+			expectNilReceipt: require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			var srcReceipt *postgres.FiatAccountTransferResult
+			if test.transferErr == nil {
+				srcReceipt = &postgres.FiatAccountTransferResult{}
+			}
+
+			gomock.InOrder(
+				mockDB.EXPECT().UserGetClientID(gomock.Any()).
+					Return(test.recipientID, test.getClientIDErr).
+					Times(test.getClientIDTimes),
+
+				mockDB.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(test.isDeleted, test.isDeletedErr).
+					Times(test.isDeletedTimes),
+
+				mockDB.EXPECT().FiatInternalTransfer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Nil()).
+					Return(srcReceipt, &postgres.FiatAccountTransferResult{}, test.transferErr).
+					Times(test.transferTimes),
+			)
+
+			receipt, httpStatus, httpMsg, _, err := HTTPFiatTransferP2P(mockDB, zapLogger, clientID, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectNilReceipt(t, receipt, "nil receipt expectation failed.")
+			require.Equal(t, test.expectErrCode, httpStatus, "http status mismatch.")
+			require.Contains(t, httpMsg, test.expectErrMsg, "http message mismatch.")
+		})
+	}
+}
+
 func TestCommon_HTTPFiatBalance(t *testing.T) {
 	testCases := []struct {
 		name                string
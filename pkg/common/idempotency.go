@@ -0,0 +1,150 @@
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/redis"
+	"go.uber.org/zap"
+)
+
+// HTTPIdempotentRequest will execute an action at most once for a given client and idempotency key. The first
+// successful response is stored in the Redis cache and will be returned for any replays with the same key and request.
+// Replays with a different request type or payload are rejected. Requests without an idempotency key will always
+// execute the action.
+func HTTPIdempotentRequest[T any](cache redis.Redis, logger *logger.Logger, clientID uuid.UUID,
+	idempotencyKey string, request any, action func() (*T, int, string, any, error)) (
+	*T, int, string, any, error) {
+	var (
+		err         error
+		cacheKey    string
+		requestHash string
+		record      models.IdempotencyRecord
+	)
+
+	if len(idempotencyKey) == 0 {
+		return action()
+	}
+
+	if len(idempotencyKey) > constants.IdempotencyKeyMaxLength() {
+		msg := "invalid idempotency key"
+
+		return nil, http.StatusBadRequest, msg, idempotencyKey, errors.New(msg)
+	}
+
+	if requestHash, err = idempotencyRequestHash[T](request); err != nil {
+		logger.Warn("failed to generate idempotency request hash", zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+	}
+
+	cacheKey = constants.IdempotencyKeyPrefix() + clientID.String() + "-" + idempotencyKey
+
+	// Atomically reserve the idempotency key to block concurrent requests whilst the action executes.
+	reserved, err := cache.SetNX(cacheKey, &models.IdempotencyRecord{RequestHash: requestHash, InProgress: true},
+		constants.IdempotencyTTL())
+	if err != nil {
+		logger.Warn("failed to reserve idempotency key in Redis", zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+	}
+
+	// The key is held by a previous request, which is either still in progress or has completed.
+	if !reserved {
+		if err = cache.Get(cacheKey, &record); err == nil {
+			return idempotencyReplay[T](logger, &record, requestHash)
+		}
+
+		var redisErr *redis.Error
+		if !errors.As(err, &redisErr) || !redisErr.Is(redis.ErrCacheMiss) {
+			logger.Warn("unknown error occurred whilst retrieving idempotency record from Redis", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+		}
+
+		// The previous request released the key after the reservation was attempted.
+		msg := "a request with this idempotency key is already being processed"
+
+		return nil, http.StatusConflict, msg, nil, errors.New(msg)
+	}
+
+	result, httpStatus, httpMessage, payload, err := action()
+	if err != nil {
+		// Release the idempotency key so that the client may retry the failed request.
+		if errDel := cache.Del(cacheKey); errDel != nil {
+			logger.Warn("failed to release idempotency key in Redis", zap.Error(errDel))
+		}
+
+		return nil, httpStatus, httpMessage, payload, fmt.Errorf("%w", err)
+	}
+
+	// Store the response for replays. The action has completed so failures here are logged and not returned.
+	if record.Response, err = json.Marshal(result); err != nil {
+		logger.Warn("failed to marshal response for idempotency record", zap.Error(err))
+
+		if errDel := cache.Del(cacheKey); errDel != nil {
+			logger.Warn("failed to release idempotency key in Redis", zap.Error(errDel))
+		}
+
+		return result, 0, "", nil, nil
+	}
+
+	record.RequestHash = requestHash
+
+	if err = cache.Set(cacheKey, &record, constants.IdempotencyTTL()); err != nil {
+		logger.Warn("failed to store idempotency record in Redis", zap.Error(err))
+	}
+
+	return result, 0, "", nil, nil
+}
+
+// idempotencyReplay will validate a stored idempotency record against a request and unpack the stored response.
+func idempotencyReplay[T any](logger *logger.Logger, record *models.IdempotencyRecord, requestHash string) (
+	*T, int, string, any, error) {
+	var response T
+
+	if record.RequestHash != requestHash {
+		msg := "idempotency key has already been used for a different request"
+
+		return nil, http.StatusUnprocessableEntity, msg, nil, errors.New(msg)
+	}
+
+	if record.InProgress {
+		msg := "a request with this idempotency key is already being processed"
+
+		return nil, http.StatusConflict, msg, nil, errors.New(msg)
+	}
+
+	if err := json.Unmarshal(record.Response, &response); err != nil {
+		logger.Warn("failed to unmarshal stored idempotency response", zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+	}
+
+	return &response, 0, "", nil, nil
+}
+
+// idempotencyRequestHash will generate a hex encoded SHA-256 hash of a request payload. The request and response types
+// are included to ensure an idempotency key cannot be replayed against a different operation.
+func idempotencyRequestHash[T any](request any) (string, error) {
+	var response T
+
+	rawRequest, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("%w", err)
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(fmt.Sprintf("%T:%T:", request, response)))
+	hash.Write(rawRequest)
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,259 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestCommon_IdempotencyRequestHash(t *testing.T) {
+	t.Parallel()
+
+	deposit := &models.HTTPDepositCurrencyRequest{Amount: decimal.NewFromFloat(10), Currency: "USD"}
+	withdraw := &models.HTTPWithdrawCurrencyRequest{Amount: decimal.NewFromFloat(10), Currency: "USD"}
+
+	depositHash, err := idempotencyRequestHash[postgres.FiatAccountTransferResult](deposit)
+	require.NoError(t, err, "failed to hash deposit request.")
+
+	depositHashRepeat, err := idempotencyRequestHash[postgres.FiatAccountTransferResult](deposit)
+	require.NoError(t, err, "failed to hash repeat deposit request.")
+	require.Equal(t, depositHash, depositHashRepeat, "hashes for the same request mismatched.")
+
+	withdrawHash, err := idempotencyRequestHash[postgres.FiatAccountTransferResult](withdraw)
+	require.NoError(t, err, "failed to hash withdraw request.")
+	require.NotEqual(t, depositHash, withdrawHash, "hashes for different request types matched.")
+
+	responseHash, err := idempotencyRequestHash[models.HTTPFiatTransferResponse](deposit)
+	require.NoError(t, err, "failed to hash deposit request with different response.")
+	require.NotEqual(t, depositHash, responseHash, "hashes for different response types matched.")
+
+	deposit.Amount = decimal.NewFromFloat(11)
+	depositHashChanged, err := idempotencyRequestHash[postgres.FiatAccountTransferResult](deposit)
+	require.NoError(t, err, "failed to hash changed deposit request.")
+	require.NotEqual(t, depositHash, depositHashChanged, "hashes for different payloads matched.")
+}
+
+func TestCommon_HTTPIdempotentRequest(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	request := &models.HTTPDepositCurrencyRequest{Amount: decimal.NewFromFloat(1234.56), Currency: "USD"}
+	receipt := &postgres.FiatAccountTransferResult{
+		TxID:     clientID,
+		ClientID: clientID,
+		TxTS:     pgtype.Timestamptz{Valid: true},
+		Balance:  decimal.NewFromFloat(5000),
+		LastTx:   decimal.NewFromFloat(1234.56),
+		Currency: postgres.CurrencyUSD,
+	}
+
+	requestHash, err := idempotencyRequestHash[postgres.FiatAccountTransferResult](request)
+	require.NoError(t, err, "failed to generate request hash.")
+
+	rawReceipt, err := json.Marshal(receipt)
+	require.NoError(t, err, "failed to marshal receipt.")
+
+	testCases := []struct {
+		name             string
+		idempotencyKey   string
+		expectErrMsg     string
+		expectErrCode    int
+		redisSetNXOk     bool
+		redisSetNXErr    error
+		redisSetNXTimes  int
+		redisGetData     models.IdempotencyRecord
+		redisGetErr      error
+		redisGetTimes    int
+		redisSetErr      error
+		redisSetTimes    int
+		redisDelTimes    int
+		actionErr        error
+		actionTimes      int
+		expectErr        require.ErrorAssertionFunc
+		expectNilReceipt require.ValueAssertionFunc
+	}{
+		{
+			name:             "no idempotency key",
+			idempotencyKey:   "",
+			expectErrCode:    0,
+			actionTimes:      1,
+			expectErr:        require.NoError,
+			expectNilReceipt: require.NotNil,
+		}, {
+			name:             "idempotency key too long",
+			idempotencyKey:   strings.Repeat("k", constants.IdempotencyKeyMaxLength()+1),
+			expectErrMsg:     "invalid idempotency key",
+			expectErrCode:    http.StatusBadRequest,
+			actionTimes:      0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+		}, {
+			name:             "reservation failure",
+			idempotencyKey:   "idempotency-key",
+			expectErrMsg:     constants.RetryMessageString(),
+			expectErrCode:    http.StatusInternalServerError,
+			redisSetNXErr:    redis.ErrCacheSet,
+			redisSetNXTimes:  1,
+			actionTimes:      0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+		}, {
+			name:             "cache get unknown error",
+			idempotencyKey:   "idempotency-key",
+			expectErrMsg:     constants.RetryMessageString(),
+			expectErrCode:    http.StatusInternalServerError,
+			redisSetNXTimes:  1,
+			redisGetErr:      redis.ErrCacheUnknown,
+			redisGetTimes:    1,
+			actionTimes:      0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+		}, {
+			name:             "released after reservation attempt",
+			idempotencyKey:   "idempotency-key",
+			expectErrMsg:     "already being processed",
+			expectErrCode:    http.StatusConflict,
+			redisSetNXTimes:  1,
+			redisGetErr:      redis.ErrCacheMiss,
+			redisGetTimes:    1,
+			actionTimes:      0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+		}, {
+			name:             "payload mismatch",
+			idempotencyKey:   "idempotency-key",
+			expectErrMsg:     "different request",
+			expectErrCode:    http.StatusUnprocessableEntity,
+			redisSetNXTimes:  1,
+			redisGetData:     models.IdempotencyRecord{RequestHash: "some other hash", Response: rawReceipt},
+			redisGetTimes:    1,
+			actionTimes:      0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+		}, {
+			name:             "in progress",
+			idempotencyKey:   "idempotency-key",
+			expectErrMsg:     "already being processed",
+			expectErrCode:    http.StatusConflict,
+			redisSetNXTimes:  1,
+			redisGetData:     models.IdempotencyRecord{RequestHash: requestHash, InProgress: true},
+			redisGetTimes:    1,
+			actionTimes:      0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+		}, {
+			name:             "corrupt stored response",
+			idempotencyKey:   "idempotency-key",
+			expectErrMsg:     constants.RetryMessageString(),
+			expectErrCode:    http.StatusInternalServerError,
+			redisSetNXTimes:  1,
+			redisGetData:     models.IdempotencyRecord{RequestHash: requestHash, Response: []byte("{corrupt")},
+			redisGetTimes:    1,
+			actionTimes:      0,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+		}, {
+			name:             "replay",
+			idempotencyKey:   "idempotency-key",
+			expectErrCode:    0,
+			redisSetNXTimes:  1,
+			redisGetData:     models.IdempotencyRecord{RequestHash: requestHash, Response: rawReceipt},
+			redisGetTimes:    1,
+			actionTimes:      0,
+			expectErr:        require.NoError,
+			expectNilReceipt: require.NotNil,
+		}, {
+			name:             "action failure releases key",
+			idempotencyKey:   "idempotency-key",
+			expectErrMsg:     "action failure",
+			expectErrCode:    http.StatusBadRequest,
+			redisSetNXOk:     true,
+			redisSetNXTimes:  1,
+			redisDelTimes:    1,
+			actionErr:        errors.New("action failure"),
+			actionTimes:      1,
+			expectErr:        require.Error,
+			expectNilReceipt: require.Nil,
+		}, {
+			name:             "first request",
+			idempotencyKey:   "idempotency-key",
+			expectErrCode:    0,
+			redisSetNXOk:     true,
+			redisSetNXTimes:  1,
+			redisSetTimes:    1,
+			actionTimes:      1,
+			expectErr:        require.NoError,
+			expectNilReceipt: require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCache := mocks.NewMockRedis(mockCtrl)
+
+			mockCache.EXPECT().SetNX(gomock.Any(), gomock.Any(), constants.IdempotencyTTL()).
+				Return(test.redisSetNXOk, test.redisSetNXErr).
+				Times(test.redisSetNXTimes)
+
+			mockCache.EXPECT().Get(gomock.Any(), gomock.Any()).
+				SetArg(1, test.redisGetData).
+				Return(test.redisGetErr).
+				Times(test.redisGetTimes)
+
+			mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(test.redisSetErr).
+				Times(test.redisSetTimes)
+
+			mockCache.EXPECT().Del(gomock.Any()).
+				Return(nil).
+				Times(test.redisDelTimes)
+
+			actionCalls := 0
+			action := func() (*postgres.FiatAccountTransferResult, int, string, any, error) {
+				actionCalls++
+
+				if test.actionErr != nil {
+					return nil, http.StatusBadRequest, test.actionErr.Error(), nil, test.actionErr
+				}
+
+				return receipt, 0, "", nil, nil
+			}
+
+			result, httpStatus, httpMsg, _, err :=
+				HTTPIdempotentRequest(mockCache, zapLogger, clientID, test.idempotencyKey, request, action)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectNilReceipt(t, result, "nil receipt expectation failed.")
+			require.Equal(t, test.expectErrCode, httpStatus, "http status mismatch.")
+			require.Contains(t, httpMsg, test.expectErrMsg, "http message mismatch.")
+			require.Equal(t, test.actionTimes, actionCalls, "action call count mismatch.")
+
+			if result != nil {
+				require.Equal(t, receipt.TxID, result.TxID, "transaction id mismatch.")
+				require.True(t, receipt.Balance.Equal(result.Balance), "balance mismatch.")
+				require.Equal(t, receipt.Currency, result.Currency, "currency mismatch.")
+			}
+		})
+	}
+}
@@ -0,0 +1,499 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+	"unicode"
+
+	"github.com/gofrs/uuid"
+	"github.com/rs/xid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+	"github.com/surahman/FTeX/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// mfaChallenge is a login challenge stored in the Redis cache. The username the client logged in with is kept so that
+// failed attempts count towards its login lockout.
+type mfaChallenge struct {
+	ClientID uuid.UUID
+	Username string
+}
+
+// mfaEnrollment will retrieve a client's multifactor authentication enrollment and report whether it is enabled.
+// Clients that are not enrolled are reported as not enabled without an error.
+func mfaEnrollment(db postgres.Postgres, clientID uuid.UUID) (postgres.MfaEnrollment, bool, error) {
+	enrollment, err := db.MFAGet(clientID)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotEnrolledMFA) {
+			return enrollment, false, nil
+		}
+
+		return enrollment, false, fmt.Errorf("%w", err)
+	}
+
+	return enrollment, enrollment.IsEnabled, nil
+}
+
+// mfaDatabaseError will extract the message and HTTP status code from a multifactor authentication database error.
+func mfaDatabaseError(logger *logger.Logger, err error) (string, int, error) {
+	var pgErr *postgres.Error
+	if !errors.As(err, &pgErr) {
+		logger.Warn("failed to extract multifactor authentication error", zap.Error(err))
+
+		return constants.RetryMessageString(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+	}
+
+	return pgErr.Message, pgErr.Code, fmt.Errorf("%w", err)
+}
+
+// isTOTPCode will check whether a code has the format of a Time-based One-Time Password code.
+func isTOTPCode(code string) bool {
+	if len(code) != constants.TOTPDigits() {
+		return false
+	}
+
+	for _, char := range code {
+		if !unicode.IsDigit(char) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkTOTP will decrypt an enrollment's secret and validate a Time-based One-Time Password code against it. The time
+// step the code was generated for is returned.
+func checkTOTP(auth auth.Auth, logger *logger.Logger, enrollment *postgres.MfaEnrollment, code string) (
+	int64, string, int, error) {
+	var (
+		err    error
+		secret []byte
+		step   int64
+	)
+
+	if secret, err = auth.DecryptFromString(enrollment.Secret); err != nil {
+		logger.Error("failed to decrypt multifactor authentication secret", zap.Error(err))
+
+		return -1, constants.RetryMessageString(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+	}
+
+	if step, err = auth.ValidateTOTP(string(secret), code); err != nil {
+		return -1, "invalid multifactor authentication code", http.StatusForbidden, fmt.Errorf("%w", err)
+	}
+
+	return step, "", 0, nil
+}
+
+// verifyMFACode will check a one-time password code, or a recovery code if permitted, against an enabled enrollment.
+// Codes are recorded as used to block replays.
+func verifyMFACode(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, enrollment *postgres.MfaEnrollment,
+	code string, allowRecovery bool) (string, int, error) {
+	var (
+		err        error
+		httpMsg    string
+		httpStatus int
+		step       int64
+	)
+
+	// One-time password codes have a fixed number of digits. Anything else is treated as a recovery code.
+	if !isTOTPCode(code) {
+		if !allowRecovery {
+			msg := "invalid multifactor authentication code"
+
+			return msg, http.StatusForbidden, errors.New(msg)
+		}
+
+		if err = db.MFAUseRecoveryCode(enrollment.ClientID, auth.HashRecoveryCode(code)); err != nil {
+			return mfaDatabaseError(logger, err)
+		}
+
+		return "", 0, nil
+	}
+
+	if step, httpMsg, httpStatus, err = checkTOTP(auth, logger, enrollment, code); err != nil {
+		return httpMsg, httpStatus, err
+	}
+
+	if err = db.MFAUseStep(enrollment.ClientID, step); err != nil {
+		return mfaDatabaseError(logger, err)
+	}
+
+	return "", 0, nil
+}
+
+// httpMFAChallenge will issue a login challenge for a client. The challenge is stored in the Redis cache and its
+// encrypted identifier is returned to the client.
+func httpMFAChallenge(auth auth.Auth, cache redis.Redis, logger *logger.Logger, clientID uuid.UUID, username string) (
+	*models.HTTPMFAChallengeResponse, error) {
+	var (
+		err         error
+		challenge   models.HTTPMFAChallengeResponse
+		challengeID = xid.New().String()
+		ttl         = time.Duration(auth.MFAChallengeExpiration()) * time.Second
+	)
+
+	// Encrypt challenge ID before returning to client.
+	if challenge.ChallengeID, err = auth.EncryptToString([]byte(challengeID)); err != nil {
+		logger.Warn("failed to encrypt multifactor authentication challenge ID", zap.Error(err))
+
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	// Store the challenge in Redis.
+	if err = cache.Set(constants.MFAChallengeKeyPrefix()+challengeID,
+		&mfaChallenge{ClientID: clientID, Username: username}, ttl); err != nil {
+		logger.Warn("failed to store multifactor authentication challenge in cache", zap.Error(err))
+
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	challenge.Expires = time.Now().Add(ttl).Unix()
+
+	return &challenge, nil
+}
+
+// HTTPLoginMFA will complete the second step of a login for a user enrolled in multifactor authentication. A challenge
+// can only be attempted once, and a failed attempt will require the user to log in again. Failed attempts count towards
+// the login lockout of the username, which is only reset once the login completes, and are recorded with the
+// originating IP address and user agent.
+func HTTPLoginMFA(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
+	request *models.HTTPMFALoginRequest, ipAddress, userAgent string) (*models.JWTAuthResponse, string, int, any, error) {
+	var (
+		err         error
+		authToken   *models.JWTAuthResponse
+		challengeID string
+		challenge   mfaChallenge
+		enrollment  postgres.MfaEnrollment
+		isEnabled   bool
+		httpMsg     string
+		httpStatus  int
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, constants.ValidationString(), http.StatusBadRequest, fmt.Errorf("%w", err), fmt.Errorf("%w", err)
+	}
+
+	// Extract Challenge ID from request.
+	{
+		var rawChallengeID []byte
+
+		if rawChallengeID, err = auth.DecryptFromString(request.ChallengeID); err != nil {
+			return nil, "invalid multifactor authentication challenge", http.StatusForbidden, nil, fmt.Errorf("%w", err)
+		}
+
+		challengeID = constants.MFAChallengeKeyPrefix() + string(rawChallengeID)
+	}
+
+	// Retrieve the challenge from Redis. Once retrieved, the entry must be removed from the cache to block re-use of the
+	// challenge.
+	if err = cache.Get(challengeID, &challenge); err != nil {
+		var redisErr *redis.Error
+
+		// If we have a valid Redis package error AND the error is that the key is not found.
+		if errors.As(err, &redisErr) && redisErr.Is(redis.ErrCacheMiss) {
+			return nil, "multifactor authentication challenge has expired", http.StatusRequestTimeout, nil,
+				fmt.Errorf("%w", err)
+		}
+
+		logger.Warn("unknown error occurred whilst retrieving login challenge from Redis", zap.Error(err))
+
+		return nil, constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	// Consume the challenge to block its re-use. A cache miss indicates the challenge has expired or was consumed by a
+	// concurrent request.
+	if err = cache.Del(challengeID); err != nil {
+		var redisErr *redis.Error
+
+		if errors.As(err, &redisErr) && redisErr.Is(redis.ErrCacheMiss) {
+			return nil, "multifactor authentication challenge has expired", http.StatusRequestTimeout, nil,
+				fmt.Errorf("%w", err)
+		}
+
+		logger.Warn("unknown error occurred whilst evicting login challenge from Redis", zap.Error(err))
+
+		return nil, constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	// Challenges issued before the username was locked cannot be used to continue guessing.
+	if remaining, isLocked := loginLockedOut(cache, logger, challenge.Username); isLocked {
+		recordLoginEvent(db, logger, challenge.ClientID, postgres.LoginOutcomeLocked, ipAddress, userAgent)
+
+		return nil, loginLockedMessage(remaining), http.StatusTooManyRequests, nil,
+			errors.New("login attempts are temporarily locked")
+	}
+
+	if enrollment, isEnabled, err = mfaEnrollment(db, challenge.ClientID); err != nil {
+		logger.Error("failed to retrieve multifactor authentication enrollment during login", zap.Error(err))
+
+		return nil, constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	if !isEnabled {
+		msg := "invalid multifactor authentication challenge"
+
+		return nil, msg, http.StatusForbidden, nil, errors.New(msg)
+	}
+
+	if httpMsg, httpStatus, err = verifyMFACode(auth, db, logger, &enrollment, request.Code, true); err != nil {
+		// Only invalid codes count as failed attempts.
+		if httpStatus < http.StatusInternalServerError {
+			loginFailed(auth, cache, logger, challenge.Username)
+			recordLoginEvent(db, logger, challenge.ClientID, postgres.LoginOutcomeFailure, ipAddress, userAgent)
+		}
+
+		return nil, httpMsg, httpStatus, nil, err
+	}
+
+	loginSucceeded(cache, logger, challenge.Username)
+	recordLoginEvent(db, logger, challenge.ClientID, postgres.LoginOutcomeSuccess, ipAddress, userAgent)
+
+	if authToken, err = auth.GenerateJWT(challenge.ClientID); err != nil {
+		logger.Error("failure generating JWT during multifactor authentication login", zap.Error(err))
+
+		return nil, err.Error(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	return authToken, "", 0, nil, nil
+}
+
+// HTTPMFAEnroll will generate a fresh Time-based One-Time Password secret and recovery codes for a client. The
+// enrollment must be verified with a code from an authenticator application before it is enabled.
+func HTTPMFAEnroll(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID) (
+	*models.HTTPMFAEnrollResponse, string, int, any, error) {
+	var (
+		err             error
+		accountInfo     modelsPostgres.User
+		encryptedSecret string
+		hashedCodes     []string
+		httpMsg         string
+		httpStatus      int
+		response        models.HTTPMFAEnrollResponse
+	)
+
+	if accountInfo, err = db.UserGetInfo(clientID); err != nil {
+		logger.Warn("failed to read user record during multifactor authentication enrollment",
+			zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return nil, constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	if response.Secret, response.URI, err = auth.GenerateTOTP(accountInfo.Username); err != nil {
+		logger.Error("failed to generate multifactor authentication secret", zap.Error(err))
+
+		return nil, constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	if response.RecoveryCodes, hashedCodes, err = auth.GenerateRecoveryCodes(); err != nil {
+		logger.Error("failed to generate multifactor authentication recovery codes", zap.Error(err))
+
+		return nil, constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	// Encrypt the secret before it is stored.
+	if encryptedSecret, err = auth.EncryptToString([]byte(response.Secret)); err != nil {
+		logger.Error("failed to encrypt multifactor authentication secret", zap.Error(err))
+
+		return nil, constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	if err = db.MFAEnroll(clientID, encryptedSecret, hashedCodes); err != nil {
+		httpMsg, httpStatus, err = mfaDatabaseError(logger, err)
+
+		return nil, httpMsg, httpStatus, nil, err
+	}
+
+	return &response, "", 0, nil, nil
+}
+
+// HTTPMFAVerify will enable a pending multifactor authentication enrollment once it has been confirmed with a one-time
+// password code.
+func HTTPMFAVerify(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	request *models.HTTPMFACodeRequest) (string, int, any, error) {
+	var (
+		err        error
+		enrollment postgres.MfaEnrollment
+		httpMsg    string
+		httpStatus int
+		step       int64
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return constants.ValidationString(), http.StatusBadRequest, fmt.Errorf("%w", err), fmt.Errorf("%w", err)
+	}
+
+	if enrollment, err = db.MFAGet(clientID); err != nil {
+		httpMsg, httpStatus, err = mfaDatabaseError(logger, err)
+
+		return httpMsg, httpStatus, nil, err
+	}
+
+	if enrollment.IsEnabled {
+		return postgres.ErrEnabledMFA.Error(), http.StatusConflict, nil, fmt.Errorf("%w", postgres.ErrEnabledMFA)
+	}
+
+	if step, httpMsg, httpStatus, err = checkTOTP(auth, logger, &enrollment, request.Code); err != nil {
+		return httpMsg, httpStatus, nil, err
+	}
+
+	if err = db.MFAEnable(clientID, step); err != nil {
+		httpMsg, httpStatus, err = mfaDatabaseError(logger, err)
+
+		return httpMsg, httpStatus, nil, err
+	}
+
+	return "", 0, nil, nil
+}
+
+// HTTPMFADisable will remove a client's multifactor authentication enrollment. Enabled enrollments must be confirmed
+// with a one-time password or recovery code.
+func HTTPMFADisable(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	request *models.HTTPMFACodeRequest) (string, int, any, error) {
+	var (
+		err        error
+		enrollment postgres.MfaEnrollment
+		httpMsg    string
+		httpStatus int
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return constants.ValidationString(), http.StatusBadRequest, fmt.Errorf("%w", err), fmt.Errorf("%w", err)
+	}
+
+	if enrollment, err = db.MFAGet(clientID); err != nil {
+		httpMsg, httpStatus, err = mfaDatabaseError(logger, err)
+
+		return httpMsg, httpStatus, nil, err
+	}
+
+	// Pending enrollments have never been verified and can be removed without a code.
+	if enrollment.IsEnabled {
+		if httpMsg, httpStatus, err = verifyMFACode(auth, db, logger, &enrollment, request.Code, true); err != nil {
+			return httpMsg, httpStatus, nil, err
+		}
+	}
+
+	if err = db.MFADelete(clientID); err != nil {
+		httpMsg, httpStatus, err = mfaDatabaseError(logger, err)
+
+		return httpMsg, httpStatus, nil, err
+	}
+
+	return "", 0, nil, nil
+}
+
+// HTTPMFARecoveryCodes will replace a client's recovery codes once the request has been confirmed with a one-time
+// password code. Any unused recovery codes are invalidated.
+func HTTPMFARecoveryCodes(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	request *models.HTTPMFACodeRequest) (*models.HTTPMFARecoveryCodesResponse, string, int, any, error) {
+	var (
+		err         error
+		enrollment  postgres.MfaEnrollment
+		hashedCodes []string
+		httpMsg     string
+		httpStatus  int
+		response    models.HTTPMFARecoveryCodesResponse
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, constants.ValidationString(), http.StatusBadRequest, fmt.Errorf("%w", err), fmt.Errorf("%w", err)
+	}
+
+	if enrollment, err = db.MFAGet(clientID); err != nil {
+		httpMsg, httpStatus, err = mfaDatabaseError(logger, err)
+
+		return nil, httpMsg, httpStatus, nil, err
+	}
+
+	if !enrollment.IsEnabled {
+		msg := "multifactor authentication is not enabled"
+
+		return nil, msg, http.StatusNotFound, nil, errors.New(msg)
+	}
+
+	if httpMsg, httpStatus, err = verifyMFACode(auth, db, logger, &enrollment, request.Code, false); err != nil {
+		return nil, httpMsg, httpStatus, nil, err
+	}
+
+	if response.RecoveryCodes, hashedCodes, err = auth.GenerateRecoveryCodes(); err != nil {
+		logger.Error("failed to generate multifactor authentication recovery codes", zap.Error(err))
+
+		return nil, constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	if err = db.MFASetRecoveryCodes(clientID, hashedCodes); err != nil {
+		httpMsg, httpStatus, err = mfaDatabaseError(logger, err)
+
+		return nil, httpMsg, httpStatus, nil, err
+	}
+
+	return &response, "", 0, nil, nil
+}
+
+// HTTPMFAStepUp will check the one-time password code supplied with a sensitive operation. Operations that have not
+// been configured to require step-up authentication, and clients without multifactor authentication enabled, are
+// permitted without a code. Invalid codes count towards the same lockout as failed logins, but the failure count is
+// only cleared by a completed login.
+func HTTPMFAStepUp(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
+	clientID uuid.UUID, operation auth.StepUp, code string) (string, int, error) {
+	var (
+		err         error
+		accountInfo modelsPostgres.User
+		enrollment  postgres.MfaEnrollment
+		httpMsg     string
+		httpStatus  int
+		isEnabled   bool
+	)
+
+	if !auth.StepUpRequired(operation) {
+		return "", 0, nil
+	}
+
+	if enrollment, isEnabled, err = mfaEnrollment(db, clientID); err != nil {
+		logger.Error("failed to retrieve multifactor authentication enrollment for step-up", zap.Error(err))
+
+		return constants.RetryMessageString(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+	}
+
+	if !isEnabled {
+		return "", 0, nil
+	}
+
+	if code == "" {
+		msg := "multifactor authentication code required"
+
+		return msg, http.StatusUnauthorized, errors.New(msg)
+	}
+
+	// Lockouts are tracked by username and shared with the login endpoints.
+	if accountInfo, err = db.UserGetInfo(clientID); err != nil {
+		logger.Warn("failed to read user record during step-up multifactor authentication",
+			zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return constants.RetryMessageString(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+	}
+
+	if remaining, isLocked := loginLockedOut(cache, logger, accountInfo.Username); isLocked {
+		return loginLockedMessage(remaining), http.StatusTooManyRequests,
+			errors.New("step-up authentication attempts are temporarily locked")
+	}
+
+	if httpMsg, httpStatus, err = verifyMFACode(auth, db, logger, &enrollment, code, false); err != nil {
+		// Only invalid codes count as failed attempts.
+		if httpStatus < http.StatusInternalServerError {
+			loginFailed(auth, cache, logger, accountInfo.Username)
+		}
+
+		return httpMsg, httpStatus, err
+	}
+
+	return "", 0, nil
+}
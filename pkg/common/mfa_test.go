@@ -0,0 +1,993 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestCommon_isTOTPCode(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, isTOTPCode("012345"), "valid code rejected.")
+	require.False(t, isTOTPCode("01234"), "short code accepted.")
+	require.False(t, isTOTPCode("0123456"), "long code accepted.")
+	require.False(t, isTOTPCode("01234A"), "non-numeric code accepted.")
+	require.False(t, isTOTPCode("ABCD-EFGH-IJKL-MNOP"), "recovery code accepted.")
+}
+
+func TestCommon_HTTPLoginMFA(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name              string
+		expectedMsg       string
+		expectedStatus    int
+		request           *models.HTTPMFALoginRequest
+		authDecryptErr    error
+		authDecryptTimes  int
+		redisGetErr       error
+		redisGetTimes     int
+		redisDelErr       error
+		redisDelTimes     int
+		mfaGetEnrollment  postgres.MfaEnrollment
+		mfaGetErr         error
+		mfaGetTimes       int
+		mfaUseCodeErr     error
+		mfaUseCodeTimes   int
+		authGenJWTErr     error
+		authGenJWTTimes   int
+		expectErr         require.ErrorAssertionFunc
+		expectPayload     require.ValueAssertionFunc
+		expectToken       require.ValueAssertionFunc
+		expectedRecovery  bool
+		validateTOTPTimes int
+	}{
+		{
+			name:           "empty request",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			request:        &models.HTTPMFALoginRequest{},
+			expectErr:      require.Error,
+			expectPayload:  require.NotNil,
+			expectToken:    require.Nil,
+		}, {
+			name:             "invalid challenge",
+			expectedMsg:      "invalid multifactor authentication challenge",
+			expectedStatus:   http.StatusForbidden,
+			request:          &models.HTTPMFALoginRequest{ChallengeID: "challenge", Code: "123456"},
+			authDecryptErr:   errors.New("decrypt failure"),
+			authDecryptTimes: 1,
+			expectErr:        require.Error,
+			expectPayload:    require.Nil,
+			expectToken:      require.Nil,
+		}, {
+			name:             "expired challenge",
+			expectedMsg:      "challenge has expired",
+			expectedStatus:   http.StatusRequestTimeout,
+			request:          &models.HTTPMFALoginRequest{ChallengeID: "challenge", Code: "123456"},
+			authDecryptTimes: 1,
+			redisGetErr:      redis.ErrCacheMiss,
+			redisGetTimes:    1,
+			expectErr:        require.Error,
+			expectPayload:    require.Nil,
+			expectToken:      require.Nil,
+		}, {
+			name:             "cache get failure",
+			expectedMsg:      constants.RetryMessageString(),
+			expectedStatus:   http.StatusInternalServerError,
+			request:          &models.HTTPMFALoginRequest{ChallengeID: "challenge", Code: "123456"},
+			authDecryptTimes: 1,
+			redisGetErr:      redis.ErrCacheUnknown,
+			redisGetTimes:    1,
+			expectErr:        require.Error,
+			expectPayload:    require.Nil,
+			expectToken:      require.Nil,
+		}, {
+			name:             "cache del failure",
+			expectedMsg:      constants.RetryMessageString(),
+			expectedStatus:   http.StatusInternalServerError,
+			request:          &models.HTTPMFALoginRequest{ChallengeID: "challenge", Code: "123456"},
+			authDecryptTimes: 1,
+			redisGetTimes:    1,
+			redisDelErr:      redis.ErrCacheUnknown,
+			redisDelTimes:    1,
+			expectErr:        require.Error,
+			expectPayload:    require.Nil,
+			expectToken:      require.Nil,
+		}, {
+			name:             "challenge already consumed",
+			expectedMsg:      "challenge has expired",
+			expectedStatus:   http.StatusRequestTimeout,
+			request:          &models.HTTPMFALoginRequest{ChallengeID: "challenge", Code: "123456"},
+			authDecryptTimes: 1,
+			redisGetTimes:    1,
+			redisDelErr:      redis.ErrCacheMiss,
+			redisDelTimes:    1,
+			expectErr:        require.Error,
+			expectPayload:    require.Nil,
+			expectToken:      require.Nil,
+		}, {
+			name:             "enrollment failure",
+			expectedMsg:      constants.RetryMessageString(),
+			expectedStatus:   http.StatusInternalServerError,
+			request:          &models.HTTPMFALoginRequest{ChallengeID: "challenge", Code: "123456"},
+			authDecryptTimes: 1,
+			redisGetTimes:    1,
+			redisDelTimes:    1,
+			mfaGetErr:        postgres.ErrTransactMFA,
+			mfaGetTimes:      1,
+			expectErr:        require.Error,
+			expectPayload:    require.Nil,
+			expectToken:      require.Nil,
+		}, {
+			name:             "not enabled",
+			expectedMsg:      "invalid multifactor authentication challenge",
+			expectedStatus:   http.StatusForbidden,
+			request:          &models.HTTPMFALoginRequest{ChallengeID: "challenge", Code: "123456"},
+			authDecryptTimes: 1,
+			redisGetTimes:    1,
+			redisDelTimes:    1,
+			mfaGetErr:        postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:      1,
+			expectErr:        require.Error,
+			expectPayload:    require.Nil,
+			expectToken:      require.Nil,
+		}, {
+			name:             "recovery code used",
+			expectedMsg:      "has already been used",
+			expectedStatus:   http.StatusForbidden,
+			request:          &models.HTTPMFALoginRequest{ChallengeID: "challenge", Code: "ABCD-EFGH-IJKL-MNOP"},
+			authDecryptTimes: 1,
+			redisGetTimes:    1,
+			redisDelTimes:    1,
+			mfaGetEnrollment: postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:      1,
+			mfaUseCodeErr:    postgres.ErrUsedMFA,
+			mfaUseCodeTimes:  1,
+			expectedRecovery: true,
+			expectErr:        require.Error,
+			expectPayload:    require.Nil,
+			expectToken:      require.Nil,
+		}, {
+			name:             "recovery code",
+			expectedMsg:      "",
+			expectedStatus:   0,
+			request:          &models.HTTPMFALoginRequest{ChallengeID: "challenge", Code: "ABCD-EFGH-IJKL-MNOP"},
+			authDecryptTimes: 1,
+			redisGetTimes:    1,
+			redisDelTimes:    1,
+			mfaGetEnrollment: postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:      1,
+			mfaUseCodeTimes:  1,
+			authGenJWTTimes:  1,
+			expectedRecovery: true,
+			expectErr:        require.NoError,
+			expectPayload:    require.Nil,
+			expectToken:      require.NotNil,
+		}, {
+			name:              "one-time password",
+			expectedMsg:       "",
+			expectedStatus:    0,
+			request:           &models.HTTPMFALoginRequest{ChallengeID: "challenge", Code: "123456"},
+			authDecryptTimes:  1,
+			redisGetTimes:     1,
+			redisDelTimes:     1,
+			mfaGetEnrollment:  postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:       1,
+			validateTOTPTimes: 1,
+			mfaUseCodeTimes:   1,
+			authGenJWTTimes:   1,
+			expectErr:         require.NoError,
+			expectPayload:     require.Nil,
+			expectToken:       require.NotNil,
+		}, {
+			name:              "auth token failure",
+			expectedMsg:       "auth token failure",
+			expectedStatus:    http.StatusInternalServerError,
+			request:           &models.HTTPMFALoginRequest{ChallengeID: "challenge", Code: "123456"},
+			authDecryptTimes:  1,
+			redisGetTimes:     1,
+			redisDelTimes:     1,
+			mfaGetEnrollment:  postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:       1,
+			validateTOTPTimes: 1,
+			mfaUseCodeTimes:   1,
+			authGenJWTErr:     errors.New("auth token failure"),
+			authGenJWTTimes:   1,
+			expectErr:         require.Error,
+			expectPayload:     require.Nil,
+			expectToken:       require.Nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			recoveryTimes, stepTimes := 0, test.mfaUseCodeTimes
+			if test.expectedRecovery {
+				recoveryTimes, stepTimes = test.mfaUseCodeTimes, 0
+			}
+
+			// Login lockout and event recording are covered by the login security tests.
+			mockCache.EXPECT().Get(constants.LoginLockoutKeyPrefix()+"username1", gomock.Any()).
+				Return(redis.ErrCacheMiss).AnyTimes()
+			mockCache.EXPECT().Incr(constants.LoginFailuresKeyPrefix()+"username1", gomock.Any()).
+				Return(int64(1), nil).AnyTimes()
+			mockCache.EXPECT().Del(constants.LoginFailuresKeyPrefix() + "username1").Return(nil).AnyTimes()
+			mockAuth.EXPECT().LoginFailureWindow().Return(time.Hour).AnyTimes()
+			mockAuth.EXPECT().LoginLockout(gomock.Any()).Return(time.Duration(0)).AnyTimes()
+			mockPostgres.EXPECT().LoginEventCreate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("challenge-id"), test.authDecryptErr).
+					Times(test.authDecryptTimes),
+
+				mockCache.EXPECT().Get(constants.MFAChallengeKeyPrefix()+"challenge-id", gomock.Any()).
+					DoAndReturn(func(_ string, value any) error {
+						if test.redisGetErr == nil {
+							*value.(*mfaChallenge) = mfaChallenge{Username: "username1"} //nolint:forcetypeassert
+						}
+
+						return test.redisGetErr
+					}).
+					Times(test.redisGetTimes),
+
+				mockCache.EXPECT().Del(constants.MFAChallengeKeyPrefix()+"challenge-id").
+					Return(test.redisDelErr).
+					Times(test.redisDelTimes),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(test.mfaGetEnrollment, test.mfaGetErr).
+					Times(test.mfaGetTimes),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("SECRET"), nil).
+					Times(test.validateTOTPTimes),
+
+				mockAuth.EXPECT().ValidateTOTP("SECRET", test.request.Code).
+					Return(int64(10), nil).
+					Times(test.validateTOTPTimes),
+
+				mockPostgres.EXPECT().MFAUseStep(gomock.Any(), int64(10)).
+					Return(test.mfaUseCodeErr).
+					Times(stepTimes),
+
+				mockAuth.EXPECT().GenerateJWT(gomock.Any()).
+					Return(&models.JWTAuthResponse{}, test.authGenJWTErr).
+					Times(test.authGenJWTTimes),
+			)
+
+			mockAuth.EXPECT().HashRecoveryCode(gomock.Any()).Return("hashed code").Times(recoveryTimes)
+			mockPostgres.EXPECT().MFAUseRecoveryCode(gomock.Any(), "hashed code").
+				Return(test.mfaUseCodeErr).
+				Times(recoveryTimes)
+
+			token, httpMsg, httpCode, payload, err :=
+				HTTPLoginMFA(mockAuth, mockCache, mockPostgres, zapLogger, test.request, "127.0.0.1", "test-agent")
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectPayload(t, payload, "payload expectation failed.")
+			test.expectToken(t, token, "token expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPMFAEnroll(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                string
+		expectedMsg         string
+		expectedStatus      int
+		userGetInfoErr      error
+		userGetInfoTimes    int
+		authGenTOTPErr      error
+		authGenTOTPTimes    int
+		authGenRecoveryErr  error
+		authGenRecoveryTime int
+		authEncryptErr      error
+		authEncryptTimes    int
+		mfaEnrollErr        error
+		mfaEnrollTimes      int
+		expectErr           require.ErrorAssertionFunc
+		expectResponse      require.ValueAssertionFunc
+	}{
+		{
+			name:             "user info failure",
+			expectedMsg:      constants.RetryMessageString(),
+			expectedStatus:   http.StatusInternalServerError,
+			userGetInfoErr:   postgres.ErrNotFound,
+			userGetInfoTimes: 1,
+			expectErr:        require.Error,
+			expectResponse:   require.Nil,
+		}, {
+			name:             "secret failure",
+			expectedMsg:      constants.RetryMessageString(),
+			expectedStatus:   http.StatusInternalServerError,
+			userGetInfoTimes: 1,
+			authGenTOTPErr:   errors.New("secret failure"),
+			authGenTOTPTimes: 1,
+			expectErr:        require.Error,
+			expectResponse:   require.Nil,
+		}, {
+			name:                "recovery codes failure",
+			expectedMsg:         constants.RetryMessageString(),
+			expectedStatus:      http.StatusInternalServerError,
+			userGetInfoTimes:    1,
+			authGenTOTPTimes:    1,
+			authGenRecoveryErr:  errors.New("recovery codes failure"),
+			authGenRecoveryTime: 1,
+			expectErr:           require.Error,
+			expectResponse:      require.Nil,
+		}, {
+			name:                "encryption failure",
+			expectedMsg:         constants.RetryMessageString(),
+			expectedStatus:      http.StatusInternalServerError,
+			userGetInfoTimes:    1,
+			authGenTOTPTimes:    1,
+			authGenRecoveryTime: 1,
+			authEncryptErr:      errors.New("encryption failure"),
+			authEncryptTimes:    1,
+			expectErr:           require.Error,
+			expectResponse:      require.Nil,
+		}, {
+			name:                "already enabled",
+			expectedMsg:         "is already enabled",
+			expectedStatus:      http.StatusConflict,
+			userGetInfoTimes:    1,
+			authGenTOTPTimes:    1,
+			authGenRecoveryTime: 1,
+			authEncryptTimes:    1,
+			mfaEnrollErr:        postgres.ErrEnabledMFA,
+			mfaEnrollTimes:      1,
+			expectErr:           require.Error,
+			expectResponse:      require.Nil,
+		}, {
+			name:                "unknown database failure",
+			expectedMsg:         constants.RetryMessageString(),
+			expectedStatus:      http.StatusInternalServerError,
+			userGetInfoTimes:    1,
+			authGenTOTPTimes:    1,
+			authGenRecoveryTime: 1,
+			authEncryptTimes:    1,
+			mfaEnrollErr:        errors.New("unknown database failure"),
+			mfaEnrollTimes:      1,
+			expectErr:           require.Error,
+			expectResponse:      require.Nil,
+		}, {
+			name:                "valid",
+			expectedMsg:         "",
+			expectedStatus:      0,
+			userGetInfoTimes:    1,
+			authGenTOTPTimes:    1,
+			authGenRecoveryTime: 1,
+			authEncryptTimes:    1,
+			mfaEnrollTimes:      1,
+			expectErr:           require.NoError,
+			expectResponse:      require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: testUserData["username1"]}, test.userGetInfoErr).
+					Times(test.userGetInfoTimes),
+
+				mockAuth.EXPECT().GenerateTOTP(gomock.Any()).
+					Return("SECRET", "otpauth://totp/issuer:username", test.authGenTOTPErr).
+					Times(test.authGenTOTPTimes),
+
+				mockAuth.EXPECT().GenerateRecoveryCodes().
+					Return([]string{"code"}, []string{"hashed code"}, test.authGenRecoveryErr).
+					Times(test.authGenRecoveryTime),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted secret", test.authEncryptErr).
+					Times(test.authEncryptTimes),
+
+				mockPostgres.EXPECT().MFAEnroll(gomock.Any(), "encrypted secret", []string{"hashed code"}).
+					Return(test.mfaEnrollErr).
+					Times(test.mfaEnrollTimes),
+			)
+
+			response, httpMsg, httpCode, payload, err :=
+				HTTPMFAEnroll(mockAuth, mockPostgres, zapLogger, uuid.UUID{})
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectResponse(t, response, "response expectation failed.")
+			require.Nil(t, payload, "payload expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPMFAVerify(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name              string
+		expectedMsg       string
+		expectedStatus    int
+		request           *models.HTTPMFACodeRequest
+		mfaGetEnrollment  postgres.MfaEnrollment
+		mfaGetErr         error
+		mfaGetTimes       int
+		authDecryptErr    error
+		authDecryptTimes  int
+		validateTOTPErr   error
+		validateTOTPTimes int
+		mfaEnableErr      error
+		mfaEnableTimes    int
+		expectErr         require.ErrorAssertionFunc
+		expectPayload     require.ValueAssertionFunc
+	}{
+		{
+			name:           "empty request",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			request:        &models.HTTPMFACodeRequest{},
+			expectErr:      require.Error,
+			expectPayload:  require.NotNil,
+		}, {
+			name:           "not enrolled",
+			expectedMsg:    "is not enrolled",
+			expectedStatus: http.StatusNotFound,
+			request:        &models.HTTPMFACodeRequest{Code: "123456"},
+			mfaGetErr:      postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:    1,
+			expectErr:      require.Error,
+			expectPayload:  require.Nil,
+		}, {
+			name:             "already enabled",
+			expectedMsg:      "is already enabled",
+			expectedStatus:   http.StatusConflict,
+			request:          &models.HTTPMFACodeRequest{Code: "123456"},
+			mfaGetEnrollment: postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:      1,
+			expectErr:        require.Error,
+			expectPayload:    require.Nil,
+		}, {
+			name:             "decryption failure",
+			expectedMsg:      constants.RetryMessageString(),
+			expectedStatus:   http.StatusInternalServerError,
+			request:          &models.HTTPMFACodeRequest{Code: "123456"},
+			mfaGetTimes:      1,
+			authDecryptErr:   errors.New("decryption failure"),
+			authDecryptTimes: 1,
+			expectErr:        require.Error,
+			expectPayload:    require.Nil,
+		}, {
+			name:              "invalid code",
+			expectedMsg:       "invalid multifactor authentication code",
+			expectedStatus:    http.StatusForbidden,
+			request:           &models.HTTPMFACodeRequest{Code: "123456"},
+			mfaGetTimes:       1,
+			authDecryptTimes:  1,
+			validateTOTPErr:   errors.New("invalid code"),
+			validateTOTPTimes: 1,
+			expectErr:         require.Error,
+			expectPayload:     require.Nil,
+		}, {
+			name:              "enable failure",
+			expectedMsg:       "is already enabled",
+			expectedStatus:    http.StatusConflict,
+			request:           &models.HTTPMFACodeRequest{Code: "123456"},
+			mfaGetTimes:       1,
+			authDecryptTimes:  1,
+			validateTOTPTimes: 1,
+			mfaEnableErr:      postgres.ErrEnabledMFA,
+			mfaEnableTimes:    1,
+			expectErr:         require.Error,
+			expectPayload:     require.Nil,
+		}, {
+			name:              "valid",
+			expectedMsg:       "",
+			expectedStatus:    0,
+			request:           &models.HTTPMFACodeRequest{Code: "123456"},
+			mfaGetTimes:       1,
+			authDecryptTimes:  1,
+			validateTOTPTimes: 1,
+			mfaEnableTimes:    1,
+			expectErr:         require.NoError,
+			expectPayload:     require.Nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(test.mfaGetEnrollment, test.mfaGetErr).
+					Times(test.mfaGetTimes),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("SECRET"), test.authDecryptErr).
+					Times(test.authDecryptTimes),
+
+				mockAuth.EXPECT().ValidateTOTP("SECRET", test.request.Code).
+					Return(int64(10), test.validateTOTPErr).
+					Times(test.validateTOTPTimes),
+
+				mockPostgres.EXPECT().MFAEnable(gomock.Any(), int64(10)).
+					Return(test.mfaEnableErr).
+					Times(test.mfaEnableTimes),
+			)
+
+			httpMsg, httpCode, payload, err := HTTPMFAVerify(mockAuth, mockPostgres, zapLogger, uuid.UUID{}, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectPayload(t, payload, "payload expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPMFADisable(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name              string
+		expectedMsg       string
+		expectedStatus    int
+		request           *models.HTTPMFACodeRequest
+		mfaGetEnrollment  postgres.MfaEnrollment
+		mfaGetErr         error
+		mfaGetTimes       int
+		recoveryCodeErr   error
+		recoveryCodeTimes int
+		mfaDeleteErr      error
+		mfaDeleteTimes    int
+		expectErr         require.ErrorAssertionFunc
+		expectPayload     require.ValueAssertionFunc
+	}{
+		{
+			name:           "empty request",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			request:        &models.HTTPMFACodeRequest{},
+			expectErr:      require.Error,
+			expectPayload:  require.NotNil,
+		}, {
+			name:           "not enrolled",
+			expectedMsg:    "is not enrolled",
+			expectedStatus: http.StatusNotFound,
+			request:        &models.HTTPMFACodeRequest{Code: "ABCD-EFGH-IJKL-MNOP"},
+			mfaGetErr:      postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:    1,
+			expectErr:      require.Error,
+			expectPayload:  require.Nil,
+		}, {
+			name:           "pending enrollment",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			request:        &models.HTTPMFACodeRequest{Code: "ABCD-EFGH-IJKL-MNOP"},
+			mfaGetTimes:    1,
+			mfaDeleteTimes: 1,
+			expectErr:      require.NoError,
+			expectPayload:  require.Nil,
+		}, {
+			name:              "enabled enrollment invalid code",
+			expectedMsg:       "has already been used",
+			expectedStatus:    http.StatusForbidden,
+			request:           &models.HTTPMFACodeRequest{Code: "ABCD-EFGH-IJKL-MNOP"},
+			mfaGetEnrollment:  postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:       1,
+			recoveryCodeErr:   postgres.ErrUsedMFA,
+			recoveryCodeTimes: 1,
+			expectErr:         require.Error,
+			expectPayload:     require.Nil,
+		}, {
+			name:              "delete failure",
+			expectedMsg:       "is not enrolled",
+			expectedStatus:    http.StatusNotFound,
+			request:           &models.HTTPMFACodeRequest{Code: "ABCD-EFGH-IJKL-MNOP"},
+			mfaGetEnrollment:  postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:       1,
+			recoveryCodeTimes: 1,
+			mfaDeleteErr:      postgres.ErrNotEnrolledMFA,
+			mfaDeleteTimes:    1,
+			expectErr:         require.Error,
+			expectPayload:     require.Nil,
+		}, {
+			name:              "enabled enrollment",
+			expectedMsg:       "",
+			expectedStatus:    0,
+			request:           &models.HTTPMFACodeRequest{Code: "ABCD-EFGH-IJKL-MNOP"},
+			mfaGetEnrollment:  postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:       1,
+			recoveryCodeTimes: 1,
+			mfaDeleteTimes:    1,
+			expectErr:         require.NoError,
+			expectPayload:     require.Nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(test.mfaGetEnrollment, test.mfaGetErr).
+					Times(test.mfaGetTimes),
+
+				mockAuth.EXPECT().HashRecoveryCode(test.request.Code).
+					Return("hashed code").
+					Times(test.recoveryCodeTimes),
+
+				mockPostgres.EXPECT().MFAUseRecoveryCode(gomock.Any(), "hashed code").
+					Return(test.recoveryCodeErr).
+					Times(test.recoveryCodeTimes),
+
+				mockPostgres.EXPECT().MFADelete(gomock.Any()).
+					Return(test.mfaDeleteErr).
+					Times(test.mfaDeleteTimes),
+			)
+
+			httpMsg, httpCode, payload, err :=
+				HTTPMFADisable(mockAuth, mockPostgres, zapLogger, uuid.UUID{}, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectPayload(t, payload, "payload expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPMFARecoveryCodes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                string
+		expectedMsg         string
+		expectedStatus      int
+		request             *models.HTTPMFACodeRequest
+		mfaGetEnrollment    postgres.MfaEnrollment
+		mfaGetErr           error
+		mfaGetTimes         int
+		verifyTimes         int
+		mfaUseStepErr       error
+		authGenRecoveryErr  error
+		authGenRecoveryTime int
+		mfaSetCodesErr      error
+		mfaSetCodesTimes    int
+		expectErr           require.ErrorAssertionFunc
+		expectPayload       require.ValueAssertionFunc
+		expectResponse      require.ValueAssertionFunc
+	}{
+		{
+			name:           "empty request",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			request:        &models.HTTPMFACodeRequest{},
+			expectErr:      require.Error,
+			expectPayload:  require.NotNil,
+			expectResponse: require.Nil,
+		}, {
+			name:           "not enrolled",
+			expectedMsg:    "is not enrolled",
+			expectedStatus: http.StatusNotFound,
+			request:        &models.HTTPMFACodeRequest{Code: "123456"},
+			mfaGetErr:      postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:    1,
+			expectErr:      require.Error,
+			expectPayload:  require.Nil,
+			expectResponse: require.Nil,
+		}, {
+			name:           "pending enrollment",
+			expectedMsg:    "not enabled",
+			expectedStatus: http.StatusNotFound,
+			request:        &models.HTTPMFACodeRequest{Code: "123456"},
+			mfaGetTimes:    1,
+			expectErr:      require.Error,
+			expectPayload:  require.Nil,
+			expectResponse: require.Nil,
+		}, {
+			name:             "recovery code rejected",
+			expectedMsg:      "invalid multifactor authentication code",
+			expectedStatus:   http.StatusForbidden,
+			request:          &models.HTTPMFACodeRequest{Code: "ABCD-EFGH-IJKL-MNOP"},
+			mfaGetEnrollment: postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:      1,
+			expectErr:        require.Error,
+			expectPayload:    require.Nil,
+			expectResponse:   require.Nil,
+		}, {
+			name:             "replayed code",
+			expectedMsg:      "has already been used",
+			expectedStatus:   http.StatusForbidden,
+			request:          &models.HTTPMFACodeRequest{Code: "123456"},
+			mfaGetEnrollment: postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:      1,
+			verifyTimes:      1,
+			mfaUseStepErr:    postgres.ErrUsedMFA,
+			expectErr:        require.Error,
+			expectPayload:    require.Nil,
+			expectResponse:   require.Nil,
+		}, {
+			name:                "recovery codes failure",
+			expectedMsg:         constants.RetryMessageString(),
+			expectedStatus:      http.StatusInternalServerError,
+			request:             &models.HTTPMFACodeRequest{Code: "123456"},
+			mfaGetEnrollment:    postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:         1,
+			verifyTimes:         1,
+			authGenRecoveryErr:  errors.New("recovery codes failure"),
+			authGenRecoveryTime: 1,
+			expectErr:           require.Error,
+			expectPayload:       require.Nil,
+			expectResponse:      require.Nil,
+		}, {
+			name:                "store failure",
+			expectedMsg:         "could not complete multifactor",
+			expectedStatus:      http.StatusInternalServerError,
+			request:             &models.HTTPMFACodeRequest{Code: "123456"},
+			mfaGetEnrollment:    postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:         1,
+			verifyTimes:         1,
+			authGenRecoveryTime: 1,
+			mfaSetCodesErr:      postgres.ErrTransactMFA,
+			mfaSetCodesTimes:    1,
+			expectErr:           require.Error,
+			expectPayload:       require.Nil,
+			expectResponse:      require.Nil,
+		}, {
+			name:                "valid",
+			expectedMsg:         "",
+			expectedStatus:      0,
+			request:             &models.HTTPMFACodeRequest{Code: "123456"},
+			mfaGetEnrollment:    postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:         1,
+			verifyTimes:         1,
+			authGenRecoveryTime: 1,
+			mfaSetCodesTimes:    1,
+			expectErr:           require.NoError,
+			expectPayload:       require.Nil,
+			expectResponse:      require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(test.mfaGetEnrollment, test.mfaGetErr).
+					Times(test.mfaGetTimes),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("SECRET"), nil).
+					Times(test.verifyTimes),
+
+				mockAuth.EXPECT().ValidateTOTP("SECRET", test.request.Code).
+					Return(int64(10), nil).
+					Times(test.verifyTimes),
+
+				mockPostgres.EXPECT().MFAUseStep(gomock.Any(), int64(10)).
+					Return(test.mfaUseStepErr).
+					Times(test.verifyTimes),
+
+				mockAuth.EXPECT().GenerateRecoveryCodes().
+					Return([]string{"code"}, []string{"hashed code"}, test.authGenRecoveryErr).
+					Times(test.authGenRecoveryTime),
+
+				mockPostgres.EXPECT().MFASetRecoveryCodes(gomock.Any(), []string{"hashed code"}).
+					Return(test.mfaSetCodesErr).
+					Times(test.mfaSetCodesTimes),
+			)
+
+			response, httpMsg, httpCode, payload, err :=
+				HTTPMFARecoveryCodes(mockAuth, mockPostgres, zapLogger, uuid.UUID{}, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectPayload(t, payload, "payload expectation failed.")
+			test.expectResponse(t, response, "response expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPMFAStepUp(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		expectedMsg      string
+		expectedStatus   int
+		code             string
+		stepUpRequired   bool
+		mfaGetEnrollment postgres.MfaEnrollment
+		mfaGetErr        error
+		mfaGetTimes      int
+		userInfoTimes    int
+		verifyTimes      int
+		mfaUseStepErr    error
+		expectErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:           "not required",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			code:           "",
+			stepUpRequired: false,
+			expectErr:      require.NoError,
+		}, {
+			name:           "enrollment failure",
+			expectedMsg:    constants.RetryMessageString(),
+			expectedStatus: http.StatusInternalServerError,
+			code:           "",
+			stepUpRequired: true,
+			mfaGetErr:      postgres.ErrTransactMFA,
+			mfaGetTimes:    1,
+			expectErr:      require.Error,
+		}, {
+			name:           "not enrolled",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			code:           "",
+			stepUpRequired: true,
+			mfaGetErr:      postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:    1,
+			expectErr:      require.NoError,
+		}, {
+			name:             "missing code",
+			expectedMsg:      "code required",
+			expectedStatus:   http.StatusUnauthorized,
+			code:             "",
+			stepUpRequired:   true,
+			mfaGetEnrollment: postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:      1,
+			expectErr:        require.Error,
+		}, {
+			name:             "recovery code rejected",
+			expectedMsg:      "invalid multifactor authentication code",
+			expectedStatus:   http.StatusForbidden,
+			code:             "ABCD-EFGH-IJKL-MNOP",
+			stepUpRequired:   true,
+			mfaGetEnrollment: postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:      1,
+			userInfoTimes:    1,
+			expectErr:        require.Error,
+		}, {
+			name:             "replayed code",
+			expectedMsg:      "has already been used",
+			expectedStatus:   http.StatusForbidden,
+			code:             "123456",
+			stepUpRequired:   true,
+			mfaGetEnrollment: postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:      1,
+			userInfoTimes:    1,
+			verifyTimes:      1,
+			mfaUseStepErr:    postgres.ErrUsedMFA,
+			expectErr:        require.Error,
+		}, {
+			name:             "valid code",
+			expectedMsg:      "",
+			expectedStatus:   0,
+			code:             "123456",
+			stepUpRequired:   true,
+			mfaGetEnrollment: postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:      1,
+			userInfoTimes:    1,
+			verifyTimes:      1,
+			expectErr:        require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			mockCache.EXPECT().Get(gomock.Any(), gomock.Any()).Return(redis.ErrCacheMiss).AnyTimes()
+			mockCache.EXPECT().Incr(gomock.Any(), gomock.Any()).Return(int64(1), nil).AnyTimes()
+			mockAuth.EXPECT().LoginFailureWindow().Return(time.Hour).AnyTimes()
+			mockAuth.EXPECT().LoginLockout(gomock.Any()).Return(time.Duration(0)).AnyTimes()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().StepUpRequired(auth.StepUpTransfers).
+					Return(test.stepUpRequired).
+					Times(1),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(test.mfaGetEnrollment, test.mfaGetErr).
+					Times(test.mfaGetTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: testUserData["username1"]}, nil).
+					Times(test.userInfoTimes),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("SECRET"), nil).
+					Times(test.verifyTimes),
+
+				mockAuth.EXPECT().ValidateTOTP("SECRET", test.code).
+					Return(int64(10), nil).
+					Times(test.verifyTimes),
+
+				mockPostgres.EXPECT().MFAUseStep(gomock.Any(), int64(10)).
+					Return(test.mfaUseStepErr).
+					Times(test.verifyTimes),
+			)
+
+			httpMsg, httpCode, err :=
+				HTTPMFAStepUp(mockAuth, mockCache, mockPostgres, zapLogger, uuid.UUID{}, auth.StepUpTransfers, test.code)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
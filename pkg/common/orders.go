@@ -0,0 +1,137 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/orders"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// HTTPOrderPlace will validate and place a limit order. Account balances are not checked when the order is placed and
+// will be verified when the order is settled.
+func HTTPOrderPlace(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	request *models.HTTPOrderRequest) (*postgres.Order, int, string, any, error) {
+	var (
+		err       error
+		order     postgres.Order
+		orderType orders.Type
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	// Determine the settlement path, which also validates the currency pair.
+	if orderType, err = orders.OrderType(request.SourceCurrency, request.DestinationCurrency); err != nil {
+		return nil, http.StatusBadRequest, constants.InvalidRequestString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	// Check for correct decimal places in the source amount.
+	if _, err = HTTPValidateOfferRequest(request.SourceAmount, orderType.Precision()); err != nil ||
+		!request.SourceAmount.IsPositive() {
+		return nil, http.StatusBadRequest, "invalid source amount", request.SourceAmount.String(),
+			fmt.Errorf("invalid source amount %s", request.SourceAmount.String())
+	}
+
+	if !request.LimitRate.IsPositive() {
+		return nil, http.StatusBadRequest, "invalid limit rate", request.LimitRate.String(),
+			fmt.Errorf("invalid limit rate %s", request.LimitRate.String())
+	}
+
+	if order, err = db.OrderCreate(clientID, request.SourceCurrency, request.DestinationCurrency,
+		request.SourceAmount, request.LimitRate); err != nil {
+		logger.Warn("failed to place limit order", zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+	}
+
+	return &order, 0, "", nil, nil
+}
+
+// HTTPOrderCancel will cancel an open limit order.
+func HTTPOrderCancel(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, orderIDStr string) (
+	*postgres.Order, int, string, error) {
+	var (
+		err     error
+		order   postgres.Order
+		orderID uuid.UUID
+	)
+
+	// Extract and validate the orderID.
+	if orderID, err = uuid.FromString(orderIDStr); err != nil {
+		return nil, http.StatusBadRequest, "invalid order ID", fmt.Errorf("%w", err)
+	}
+
+	if order, err = db.OrderCancel(clientID, orderID); err != nil {
+		var cancelErr *postgres.Error
+		if !errors.As(err, &cancelErr) {
+			logger.Info("failed to unpack limit order cancellation error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return nil, cancelErr.Code, "open order not found", fmt.Errorf("%w", err)
+	}
+
+	return &order, 0, "", nil
+}
+
+// HTTPOrdersPaginated retrieves a page of limit orders, newest first, and prepares a link to the next page of data.
+func HTTPOrdersPaginated(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	pageCursor, pageSizeStr string, isREST bool) (models.HTTPOrdersPaginated, int, string, error) {
+	var (
+		err          error
+		offset       int32
+		pageSize     int32
+		nextPage     string
+		orderDetails models.HTTPOrdersPaginated
+	)
+
+	// Extract and assemble the page cursor and page size.
+	if offset, pageSize, err = offsetPaginatedRequest(auth, pageCursor, pageSizeStr); err != nil {
+		return orderDetails, http.StatusBadRequest, "invalid page cursor or page size", fmt.Errorf("%w", err)
+	}
+
+	if orderDetails.Orders, err = db.OrdersPaginated(clientID, pageSize+1, offset); err != nil {
+		var ordersErr *postgres.Error
+		if !errors.As(err, &ordersErr) {
+			logger.Info("failed to unpack limit orders error", zap.Error(err))
+
+			return orderDetails, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return orderDetails, ordersErr.Code, ordersErr.Message, fmt.Errorf("%w", err)
+	}
+
+	// Generate the next page link if the page size is N + 1 of the requested.
+	if len(orderDetails.Orders) > int(pageSize) {
+		// Generate next page link.
+		if nextPage, err = auth.EncryptToString([]byte(strconv.Itoa(int(offset + pageSize)))); err != nil {
+			logger.Error("failed to encrypt limit orders offset for use as cursor", zap.Error(err))
+
+			return orderDetails, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		// Remove last element.
+		orderDetails.Orders = orderDetails.Orders[:pageSize]
+
+		// Generate naked next page link for REST.
+		if isREST {
+			orderDetails.Links.NextPage = fmt.Sprintf(constants.NextPageRESTFormatString(), nextPage, pageSize)
+		} else {
+			orderDetails.Links.PageCursor = nextPage
+		}
+	}
+
+	return orderDetails, 0, "", nil
+}
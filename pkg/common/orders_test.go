@@ -0,0 +1,399 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestCommon_HTTPOrderPlace(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		request       *models.HTTPOrderRequest
+		expectErrMsg  string
+		expectErrCode int
+		createErr     error
+		createTimes   int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "empty request",
+			request:       &models.HTTPOrderRequest{},
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			createTimes:   0,
+			expectErr:     require.Error,
+		}, {
+			name: "crypto to crypto",
+			request: &models.HTTPOrderRequest{
+				SourceCurrency:      "ETH",
+				DestinationCurrency: "BTC",
+				SourceAmount:        decimal.NewFromFloat(1.5),
+				LimitRate:           decimal.NewFromFloat(0.06),
+			},
+			expectErrMsg:  constants.InvalidRequestString(),
+			expectErrCode: http.StatusBadRequest,
+			createTimes:   0,
+			expectErr:     require.Error,
+		}, {
+			name: "fiat amount too precise",
+			request: &models.HTTPOrderRequest{
+				SourceCurrency:      "USD",
+				DestinationCurrency: "CAD",
+				SourceAmount:        decimal.NewFromFloat(100.001),
+				LimitRate:           decimal.NewFromFloat(1.35),
+			},
+			expectErrMsg:  "invalid source amount",
+			expectErrCode: http.StatusBadRequest,
+			createTimes:   0,
+			expectErr:     require.Error,
+		}, {
+			name: "negative amount",
+			request: &models.HTTPOrderRequest{
+				SourceCurrency:      "USD",
+				DestinationCurrency: "CAD",
+				SourceAmount:        decimal.NewFromFloat(-100),
+				LimitRate:           decimal.NewFromFloat(1.35),
+			},
+			expectErrMsg:  "invalid source amount",
+			expectErrCode: http.StatusBadRequest,
+			createTimes:   0,
+			expectErr:     require.Error,
+		}, {
+			name: "negative limit rate",
+			request: &models.HTTPOrderRequest{
+				SourceCurrency:      "USD",
+				DestinationCurrency: "CAD",
+				SourceAmount:        decimal.NewFromFloat(100),
+				LimitRate:           decimal.NewFromFloat(-1.35),
+			},
+			expectErrMsg:  "invalid limit rate",
+			expectErrCode: http.StatusBadRequest,
+			createTimes:   0,
+			expectErr:     require.Error,
+		}, {
+			name: "db failure",
+			request: &models.HTTPOrderRequest{
+				SourceCurrency:      "USD",
+				DestinationCurrency: "CAD",
+				SourceAmount:        decimal.NewFromFloat(100),
+				LimitRate:           decimal.NewFromFloat(1.35),
+			},
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			createErr:     postgres.ErrCreateOrder,
+			createTimes:   1,
+			expectErr:     require.Error,
+		}, {
+			name: "valid - crypto sale",
+			request: &models.HTTPOrderRequest{
+				SourceCurrency:      "BTC",
+				DestinationCurrency: "USD",
+				SourceAmount:        decimal.NewFromFloat(0.12345678),
+				LimitRate:           decimal.NewFromFloat(30000),
+			},
+			createTimes: 1,
+			expectErr:   require.NoError,
+		}, {
+			name: "valid - fiat",
+			request: &models.HTTPOrderRequest{
+				SourceCurrency:      "USD",
+				DestinationCurrency: "CAD",
+				SourceAmount:        decimal.NewFromFloat(100),
+				LimitRate:           decimal.NewFromFloat(1.35),
+			},
+			createTimes: 1,
+			expectErr:   require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().OrderCreate(gomock.Any(), test.request.SourceCurrency, test.request.DestinationCurrency,
+				test.request.SourceAmount, test.request.LimitRate).
+				Return(postgres.Order{}, test.createErr).
+				Times(test.createTimes)
+
+			order, httpStatus, httpMsg, _, err := HTTPOrderPlace(mockDB, zapLogger, uuid.UUID{}, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, httpStatus, "http status mismatch.")
+			require.Contains(t, httpMsg, test.expectErrMsg, "http message mismatch.")
+
+			if err == nil {
+				require.NotNil(t, order, "nil order returned.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPOrderCancel(t *testing.T) {
+	t.Parallel()
+
+	orderID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate order id.")
+
+	testCases := []struct {
+		name          string
+		orderID       string
+		expectErrMsg  string
+		expectErrCode int
+		cancelErr     error
+		cancelTimes   int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "invalid order id",
+			orderID:       "invalid-order-id",
+			expectErrMsg:  "invalid order ID",
+			expectErrCode: http.StatusBadRequest,
+			cancelTimes:   0,
+			expectErr:     require.Error,
+		}, {
+			name:          "unknown db failure",
+			orderID:       orderID.String(),
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			cancelErr:     errors.New("unknown error"),
+			cancelTimes:   1,
+			expectErr:     require.Error,
+		}, {
+			name:          "not found",
+			orderID:       orderID.String(),
+			expectErrMsg:  "open order not found",
+			expectErrCode: http.StatusNotFound,
+			cancelErr:     postgres.ErrNotFound,
+			cancelTimes:   1,
+			expectErr:     require.Error,
+		}, {
+			name:        "valid",
+			orderID:     orderID.String(),
+			cancelTimes: 1,
+			expectErr:   require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().OrderCancel(gomock.Any(), orderID).
+				Return(postgres.Order{OrderID: orderID, Status: postgres.OrderStatusCancelled}, test.cancelErr).
+				Times(test.cancelTimes)
+
+			order, httpStatus, httpMsg, err := HTTPOrderCancel(mockDB, zapLogger, uuid.UUID{}, test.orderID)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, httpStatus, "http status mismatch.")
+			require.Contains(t, httpMsg, test.expectErrMsg, "http message mismatch.")
+
+			if err == nil {
+				require.Equal(t, postgres.OrderStatusCancelled, order.Status, "order status mismatch.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPOrdersPaginated(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pageCursor  = "some-page-cursor"
+		fourRecords = []postgres.Order{{}, {}, {}, {}}
+	)
+
+	testCases := []struct {
+		name               string
+		pageCursor         string
+		pageSize           string
+		expectErrMsg       string
+		isREST             bool
+		httpStatus         int
+		expectedRecordsLen int
+		expectedPageSize   int32
+		expectedOffset     int32
+		decryptString      []byte
+		decryptStringErr   error
+		decryptStringTimes int
+		ordersData         []postgres.Order
+		ordersErr          error
+		ordersTimes        int
+		encryptStringErr   error
+		encryptStingTimes  int
+		expectErr          require.ErrorAssertionFunc
+		expectNextPage     require.BoolAssertionFunc
+		expectPageCursor   require.BoolAssertionFunc
+	}{
+		{
+			name:               "bad page size",
+			pageSize:           "bad-page-size",
+			expectErrMsg:       "page size",
+			httpStatus:         http.StatusBadRequest,
+			decryptStringTimes: 0,
+			ordersTimes:        0,
+			encryptStingTimes:  0,
+			expectErr:          require.Error,
+		}, {
+			name:               "cursor decryption failure",
+			pageCursor:         pageCursor,
+			pageSize:           "3",
+			expectErrMsg:       "invalid page cursor",
+			httpStatus:         http.StatusBadRequest,
+			decryptStringErr:   errors.New("decrypt failure"),
+			decryptStringTimes: 1,
+			ordersTimes:        0,
+			encryptStingTimes:  0,
+			expectErr:          require.Error,
+		}, {
+			name:               "cursor invalid offset",
+			pageCursor:         pageCursor,
+			pageSize:           "3",
+			expectErrMsg:       "invalid page cursor",
+			httpStatus:         http.StatusBadRequest,
+			decryptString:      []byte("not-an-offset"),
+			decryptStringTimes: 1,
+			ordersTimes:        0,
+			encryptStingTimes:  0,
+			expectErr:          require.Error,
+		}, {
+			name:              "db failure - known error",
+			pageSize:          "3",
+			expectErrMsg:      "not found",
+			httpStatus:        http.StatusNotFound,
+			expectedPageSize:  3,
+			ordersErr:         postgres.ErrNotFound,
+			ordersTimes:       1,
+			encryptStingTimes: 0,
+			expectErr:         require.Error,
+		}, {
+			name:              "db failure - unknown error",
+			pageSize:          "3",
+			expectErrMsg:      constants.RetryMessageString(),
+			httpStatus:        http.StatusInternalServerError,
+			expectedPageSize:  3,
+			ordersErr:         errors.New("unknown db failure"),
+			ordersTimes:       1,
+			encryptStingTimes: 0,
+			expectErr:         require.Error,
+		}, {
+			name:              "next page encryption failure",
+			pageSize:          "3",
+			expectErrMsg:      constants.RetryMessageString(),
+			httpStatus:        http.StatusInternalServerError,
+			expectedPageSize:  3,
+			ordersData:        fourRecords,
+			ordersTimes:       1,
+			encryptStringErr:  errors.New("encrypt failure"),
+			encryptStingTimes: 1,
+			expectErr:         require.Error,
+		}, {
+			name:               "valid - default page size",
+			pageSize:           "0",
+			isREST:             true,
+			expectedRecordsLen: 4,
+			expectedPageSize:   10,
+			ordersData:         fourRecords,
+			ordersTimes:        1,
+			encryptStingTimes:  0,
+			expectErr:          require.NoError,
+			expectNextPage:     require.False,
+			expectPageCursor:   require.False,
+		}, {
+			name:               "valid - has next page - graphql",
+			pageCursor:         pageCursor,
+			pageSize:           "3",
+			isREST:             false,
+			expectedRecordsLen: 3,
+			expectedPageSize:   3,
+			expectedOffset:     6,
+			decryptString:      []byte("6"),
+			decryptStringTimes: 1,
+			ordersData:         fourRecords,
+			ordersTimes:        1,
+			encryptStingTimes:  1,
+			expectErr:          require.NoError,
+			expectNextPage:     require.False,
+			expectPageCursor:   require.True,
+		}, {
+			name:               "valid - has next page",
+			pageSize:           "3",
+			isREST:             true,
+			expectedRecordsLen: 3,
+			expectedPageSize:   3,
+			ordersData:         fourRecords,
+			ordersTimes:        1,
+			encryptStingTimes:  1,
+			expectErr:          require.NoError,
+			expectNextPage:     require.True,
+			expectPageCursor:   require.False,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().DecryptFromString(pageCursor).
+					Return(test.decryptString, test.decryptStringErr).
+					Times(test.decryptStringTimes),
+
+				mockPostgres.EXPECT().OrdersPaginated(gomock.Any(), test.expectedPageSize+1, test.expectedOffset).
+					Return(test.ordersData, test.ordersErr).
+					Times(test.ordersTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("next-page-cursor", test.encryptStringErr).
+					Times(test.encryptStingTimes),
+			)
+
+			actualDetails, status, errMsg, err := HTTPOrdersPaginated(mockAuth, mockPostgres, zapLogger,
+				uuid.UUID{}, test.pageCursor, test.pageSize, test.isREST)
+			test.expectErr(t, err, "error expectation failed.")
+
+			require.Equal(t, test.httpStatus, status, "http status code mismatched.")
+			require.Contains(t, errMsg, test.expectErrMsg, "http error message mismatched.")
+
+			if err != nil {
+				return
+			}
+
+			test.expectNextPage(t, len(actualDetails.Links.NextPage) > 0, "next page link expectation failed.")
+			test.expectPageCursor(t, len(actualDetails.Links.PageCursor) > 0, "page cursor expectation failed.")
+			require.Len(t, actualDetails.Orders, test.expectedRecordsLen, "number of returned records mismatched")
+		})
+	}
+}
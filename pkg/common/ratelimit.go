@@ -0,0 +1,52 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/redis"
+	"go.uber.org/zap"
+)
+
+// HTTPRateLimit will take the tokens for a request from the rate limiting token buckets of a group of endpoints for
+// each of the request's identities, such as its client ID and IP address. Requests that exceed the limit are rejected
+// with the number of seconds to wait before retrying. Requests are not throttled if the Redis cache is unavailable.
+func HTTPRateLimit(cache redis.Redis, logger *logger.Logger, limit *models.RateLimit, group string, tokens int64,
+	identities ...string) (int64, string, int, error) {
+	var (
+		err      error
+		allowed  bool
+		wait     time.Duration
+		interval = limit.Period / time.Duration(limit.Requests)
+	)
+
+	// Requests that need more tokens than a bucket can hold would never be permitted.
+	if tokens > limit.Burst {
+		msg := fmt.Sprintf("request exceeds the rate limit of %d operations", limit.Burst)
+
+		return 0, msg, http.StatusBadRequest, errors.New(msg)
+	}
+
+	for _, identity := range identities {
+		key := fmt.Sprintf("%s%s-%s", constants.RateLimitKeyPrefix(), group, identity)
+
+		if allowed, wait, err = cache.TakeTokens(key, limit.Burst, tokens, interval); err != nil {
+			logger.Warn("failed to apply rate limit", zap.String("key", key), zap.Error(err))
+
+			continue
+		}
+
+		if !allowed {
+			return int64(math.Ceil(wait.Seconds())), "rate limit exceeded, " + constants.RetryMessageString(),
+				http.StatusTooManyRequests, errors.New("rate limit exceeded")
+		}
+	}
+
+	return 0, "", 0, nil
+}
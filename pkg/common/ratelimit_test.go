@@ -0,0 +1,121 @@
+package common
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestCommon_HTTPRateLimit(t *testing.T) {
+	t.Parallel()
+
+	limit := &models.RateLimit{Requests: 10, Period: time.Minute, Burst: 5}
+	ipKey := constants.RateLimitKeyPrefix() + "fiat-ip:127.0.0.1"
+	clientKey := constants.RateLimitKeyPrefix() + "fiat-client:client-id"
+
+	testCases := []struct {
+		name               string
+		expectedMsg        string
+		expectedStatus     int
+		expectedRetryAfter int64
+		tokens             int64
+		ipAllowed          bool
+		ipWait             time.Duration
+		ipErr              error
+		ipTimes            int
+		clientAllowed      bool
+		clientWait         time.Duration
+		clientTimes        int
+		expectErr          require.ErrorAssertionFunc
+	}{
+		{
+			name:           "exceeds burst",
+			expectedMsg:    "exceeds the rate limit",
+			expectedStatus: http.StatusBadRequest,
+			tokens:         6,
+			ipTimes:        0,
+			clientTimes:    0,
+			expectErr:      require.Error,
+		}, {
+			name:               "ip limited",
+			expectedMsg:        "rate limit exceeded",
+			expectedStatus:     http.StatusTooManyRequests,
+			expectedRetryAfter: 3,
+			tokens:             1,
+			ipAllowed:          false,
+			ipWait:             2100 * time.Millisecond,
+			ipTimes:            1,
+			clientTimes:        0,
+			expectErr:          require.Error,
+		}, {
+			name:               "client limited",
+			expectedMsg:        "rate limit exceeded",
+			expectedStatus:     http.StatusTooManyRequests,
+			expectedRetryAfter: 1,
+			tokens:             2,
+			ipAllowed:          true,
+			ipTimes:            1,
+			clientAllowed:      false,
+			clientWait:         time.Millisecond,
+			clientTimes:        1,
+			expectErr:          require.Error,
+		}, {
+			name:           "cache failure",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			tokens:         1,
+			ipErr:          redis.ErrCacheSet,
+			ipTimes:        1,
+			clientAllowed:  true,
+			clientTimes:    1,
+			expectErr:      require.NoError,
+		}, {
+			name:           "allowed",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			tokens:         5,
+			ipAllowed:      true,
+			ipTimes:        1,
+			clientAllowed:  true,
+			clientTimes:    1,
+			expectErr:      require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCache := mocks.NewMockRedis(mockCtrl)
+
+			gomock.InOrder(
+				mockCache.EXPECT().TakeTokens(ipKey, limit.Burst, test.tokens, 6*time.Second).
+					Return(test.ipAllowed, test.ipWait, test.ipErr).
+					Times(test.ipTimes),
+
+				mockCache.EXPECT().TakeTokens(clientKey, limit.Burst, test.tokens, 6*time.Second).
+					Return(test.clientAllowed, test.clientWait, nil).
+					Times(test.clientTimes),
+			)
+
+			retryAfter, httpMsg, httpStatus, err := HTTPRateLimit(
+				mockCache, zapLogger, limit, "fiat", test.tokens, "ip:127.0.0.1", "client:client-id")
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectedStatus, httpStatus, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+			require.Equal(t, test.expectedRetryAfter, retryAfter, "retry after mismatched.")
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// HTTPRateHistory will validate a rate history request and retrieve the open, high, low, and close rates for a currency
+// pair in fixed intervals over the requested time range.
+func HTTPRateHistory(db postgres.Postgres, logger *logger.Logger, request *models.HTTPRateHistoryRequest) (
+	*models.HTTPRateHistoryResponse, int, string, any, error) {
+	var (
+		candles  []postgres.RateCandle
+		err      error
+		from     time.Time
+		interval time.Duration
+		to       time.Time
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	if from, err = time.Parse(time.RFC3339, request.From); err != nil {
+		return nil, http.StatusBadRequest, "invalid start time", request.From, fmt.Errorf("%w", err)
+	}
+
+	if to, err = time.Parse(time.RFC3339, request.To); err != nil {
+		return nil, http.StatusBadRequest, "invalid end time", request.To, fmt.Errorf("%w", err)
+	}
+
+	if !to.After(from) {
+		msg := "end time must be after the start time"
+
+		return nil, http.StatusBadRequest, "invalid time range", msg, errors.New(msg)
+	}
+
+	if interval, err = time.ParseDuration(request.Interval); err != nil || interval < constants.RateHistoryMinInterval() {
+		msg := fmt.Sprintf("interval must be a duration of at least %s", constants.RateHistoryMinInterval())
+
+		return nil, http.StatusBadRequest, "invalid interval", msg, errors.New(msg)
+	}
+
+	// Round up to include the partial interval at the end of the time range.
+	intervals := (int64(to.Sub(from)) + int64(interval) - 1) / int64(interval)
+	if intervals > constants.RateHistoryMaxIntervals() {
+		msg := fmt.Sprintf("time range must not exceed %d intervals", constants.RateHistoryMaxIntervals())
+
+		return nil, http.StatusBadRequest, "too many intervals", msg, errors.New(msg)
+	}
+
+	source := strings.ToUpper(request.Source)
+	destination := strings.ToUpper(request.Destination)
+
+	if candles, err = db.RateHistory(source, destination, from, to, interval); err != nil {
+		logger.Warn("failed to retrieve rate history", zap.String("source", source),
+			zap.String("destination", destination), zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+	}
+
+	return &models.HTTPRateHistoryResponse{
+		Source:      source,
+		Destination: destination,
+		Interval:    interval.String(),
+		Candles:     candles,
+	}, 0, "", nil, nil
+}
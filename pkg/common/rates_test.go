@@ -0,0 +1,153 @@
+package common
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestCommon_HTTPRateHistory(t *testing.T) {
+	t.Parallel()
+
+	from := "2023-06-01T00:00:00Z"
+	to := "2023-06-02T00:00:00Z"
+
+	testCases := []struct {
+		name          string
+		request       *models.HTTPRateHistoryRequest
+		expectErrMsg  string
+		expectErrCode int
+		historyErr    error
+		historyTimes  int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "empty request",
+			request:       &models.HTTPRateHistoryRequest{},
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			historyTimes:  0,
+			expectErr:     require.Error,
+		}, {
+			name: "invalid start time",
+			request: &models.HTTPRateHistoryRequest{
+				Source: "USD", Destination: "CAD", From: "2023-06-01", To: to, Interval: "1h"},
+			expectErrMsg:  "invalid start time",
+			expectErrCode: http.StatusBadRequest,
+			historyTimes:  0,
+			expectErr:     require.Error,
+		}, {
+			name: "invalid end time",
+			request: &models.HTTPRateHistoryRequest{
+				Source: "USD", Destination: "CAD", From: from, To: "tomorrow", Interval: "1h"},
+			expectErrMsg:  "invalid end time",
+			expectErrCode: http.StatusBadRequest,
+			historyTimes:  0,
+			expectErr:     require.Error,
+		}, {
+			name: "reversed time range",
+			request: &models.HTTPRateHistoryRequest{
+				Source: "USD", Destination: "CAD", From: to, To: from, Interval: "1h"},
+			expectErrMsg:  "invalid time range",
+			expectErrCode: http.StatusBadRequest,
+			historyTimes:  0,
+			expectErr:     require.Error,
+		}, {
+			name: "malformed interval",
+			request: &models.HTTPRateHistoryRequest{
+				Source: "USD", Destination: "CAD", From: from, To: to, Interval: "hourly"},
+			expectErrMsg:  "invalid interval",
+			expectErrCode: http.StatusBadRequest,
+			historyTimes:  0,
+			expectErr:     require.Error,
+		}, {
+			name: "interval too short",
+			request: &models.HTTPRateHistoryRequest{
+				Source: "USD", Destination: "CAD", From: from, To: to, Interval: "30s"},
+			expectErrMsg:  "invalid interval",
+			expectErrCode: http.StatusBadRequest,
+			historyTimes:  0,
+			expectErr:     require.Error,
+		}, {
+			name: "too many intervals",
+			request: &models.HTTPRateHistoryRequest{
+				Source: "USD", Destination: "CAD", From: from, To: to, Interval: "1m"},
+			expectErrMsg:  "too many intervals",
+			expectErrCode: http.StatusBadRequest,
+			historyTimes:  0,
+			expectErr:     require.Error,
+		}, {
+			name: "db failure",
+			request: &models.HTTPRateHistoryRequest{
+				Source: "USD", Destination: "CAD", From: from, To: to, Interval: "1h"},
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			historyErr:    postgres.ErrNotFound,
+			historyTimes:  1,
+			expectErr:     require.Error,
+		}, {
+			name: "valid",
+			request: &models.HTTPRateHistoryRequest{
+				Source: "usd", Destination: "cad", From: from, To: to, Interval: "1h"},
+			historyTimes: 1,
+			expectErr:    require.NoError,
+		}, {
+			name: "valid - partial interval",
+			request: &models.HTTPRateHistoryRequest{
+				Source: "BTC", Destination: "USD", From: from, To: to, Interval: "7h"},
+			historyTimes: 1,
+			expectErr:    require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			candles := []postgres.RateCandle{{
+				Start:  time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC),
+				Open:   decimal.NewFromFloat(1.31),
+				High:   decimal.NewFromFloat(1.35),
+				Low:    decimal.NewFromFloat(1.30),
+				Close:  decimal.NewFromFloat(1.33),
+				Quotes: 4,
+			}}
+
+			mockDB.EXPECT().RateHistory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(candles, test.historyErr).
+				Times(test.historyTimes)
+
+			history, httpStatus, httpMsg, _, err := HTTPRateHistory(mockDB, zapLogger, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, httpStatus, "http status mismatch.")
+			require.Contains(t, httpMsg, test.expectErrMsg, "http message mismatch.")
+
+			if err != nil {
+				require.Nil(t, history, "history returned on failure.")
+
+				return
+			}
+
+			require.Equal(t, candles, history.Candles, "candles mismatch.")
+			interval, err := time.ParseDuration(test.request.Interval)
+			require.NoError(t, err, "failed to parse interval.")
+			require.Equal(t, interval.String(), history.Interval, "interval mismatch.")
+			require.Regexp(t, "^[A-Z]+$", history.Source, "source currency not normalized.")
+		})
+	}
+}
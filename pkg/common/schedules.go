@@ -0,0 +1,266 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/orders"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// scheduleValidateAmountFrequency will validate the Fiat amount and the frequency of a recurring purchase.
+func scheduleValidateAmountFrequency(amount decimal.Decimal, frequencyStr string) (
+	postgres.ScheduleFrequency, int, string, any, error) {
+	frequency := postgres.ScheduleFrequency(frequencyStr)
+	if !frequency.Valid() {
+		return frequency, http.StatusBadRequest, "invalid frequency", frequencyStr,
+			fmt.Errorf("invalid frequency %s", frequencyStr)
+	}
+
+	if _, err := HTTPValidateOfferRequest(amount, constants.DecimalPlacesFiat()); err != nil ||
+		!amount.IsPositive() {
+		return frequency, http.StatusBadRequest, "invalid amount", amount.String(),
+			fmt.Errorf("invalid amount %s", amount.String())
+	}
+
+	return frequency, 0, "", nil, nil
+}
+
+// HTTPScheduleCreate will validate and create a recurring Cryptocurrency purchase schedule. Account balances are not
+// checked when the schedule is created and will be verified each time the schedule runs.
+func HTTPScheduleCreate(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	request *models.HTTPScheduleRequest) (*postgres.Schedule, int, string, any, error) {
+	var (
+		err        error
+		frequency  postgres.ScheduleFrequency
+		httpMsg    string
+		httpStatus int
+		orderType  orders.Type
+		payload    any
+		schedule   postgres.Schedule
+		startAt    = time.Now()
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	// Recurring purchases must debit a Fiat currency and credit a Cryptocurrency.
+	if orderType, err = orders.OrderType(request.FiatCurrency, request.Ticker); err != nil ||
+		orderType != orders.TypeCryptoPurchase {
+		msg := "recurring purchases must be from a Fiat currency to a Cryptocurrency"
+
+		return nil, http.StatusBadRequest, constants.InvalidRequestString(), msg, errors.New(msg)
+	}
+
+	if frequency, httpStatus, httpMsg, payload, err =
+		scheduleValidateAmountFrequency(request.Amount, request.Frequency); err != nil {
+		return nil, httpStatus, httpMsg, payload, err
+	}
+
+	// Start times in the past will run on the next poll.
+	if len(request.StartAt) > 0 {
+		var parsed time.Time
+		if parsed, err = time.Parse(time.RFC3339, request.StartAt); err != nil {
+			return nil, http.StatusBadRequest, "invalid start time", request.StartAt, fmt.Errorf("%w", err)
+		}
+
+		if parsed.After(startAt) {
+			startAt = parsed
+		}
+	}
+
+	if schedule, err = db.ScheduleCreate(clientID, postgres.Currency(request.FiatCurrency), request.Ticker,
+		request.Amount, frequency, startAt); err != nil {
+		logger.Warn("failed to create recurring purchase schedule", zap.Error(err))
+
+		return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+	}
+
+	return &schedule, 0, "", nil, nil
+}
+
+// HTTPScheduleUpdate will validate and update the amount, frequency, and active state of a recurring purchase schedule.
+func HTTPScheduleUpdate(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, scheduleIDStr string,
+	request *models.HTTPScheduleUpdateRequest) (*postgres.Schedule, int, string, any, error) {
+	var (
+		err        error
+		frequency  postgres.ScheduleFrequency
+		httpMsg    string
+		httpStatus int
+		payload    any
+		schedule   postgres.Schedule
+		scheduleID uuid.UUID
+	)
+
+	if scheduleID, err = uuid.FromString(scheduleIDStr); err != nil {
+		return nil, http.StatusBadRequest, "invalid schedule ID", scheduleIDStr, fmt.Errorf("%w", err)
+	}
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, http.StatusBadRequest, constants.ValidationString(), err.Error(), fmt.Errorf("%w", err)
+	}
+
+	if frequency, httpStatus, httpMsg, payload, err =
+		scheduleValidateAmountFrequency(request.Amount, request.Frequency); err != nil {
+		return nil, httpStatus, httpMsg, payload, err
+	}
+
+	if schedule, err = db.ScheduleUpdate(clientID, scheduleID, request.Amount, frequency,
+		*request.IsActive); err != nil {
+		var updateErr *postgres.Error
+		if !errors.As(err, &updateErr) {
+			logger.Info("failed to unpack recurring purchase schedule update error", zap.Error(err))
+
+			return nil, http.StatusInternalServerError, constants.RetryMessageString(), nil, fmt.Errorf("%w", err)
+		}
+
+		return nil, updateErr.Code, "schedule not found", scheduleIDStr, fmt.Errorf("%w", err)
+	}
+
+	return &schedule, 0, "", nil, nil
+}
+
+// HTTPScheduleDelete will delete a recurring purchase schedule along with its run history.
+func HTTPScheduleDelete(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, scheduleIDStr string) (
+	int, string, error) {
+	var (
+		err        error
+		scheduleID uuid.UUID
+	)
+
+	if scheduleID, err = uuid.FromString(scheduleIDStr); err != nil {
+		return http.StatusBadRequest, "invalid schedule ID", fmt.Errorf("%w", err)
+	}
+
+	if err = db.ScheduleDelete(clientID, scheduleID); err != nil {
+		var deleteErr *postgres.Error
+		if !errors.As(err, &deleteErr) {
+			logger.Info("failed to unpack recurring purchase schedule deletion error", zap.Error(err))
+
+			return http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return deleteErr.Code, "schedule not found", fmt.Errorf("%w", err)
+	}
+
+	return 0, "", nil
+}
+
+// schedulesNextPage will generate the encrypted page cursor for the page of records following the current offset.
+func schedulesNextPage(auth auth.Auth, logger *logger.Logger, offset, pageSize int32, isREST bool) (
+	models.HTTPLinks, error) {
+	var links models.HTTPLinks
+
+	nextPage, err := auth.EncryptToString([]byte(strconv.Itoa(int(offset + pageSize))))
+	if err != nil {
+		logger.Error("failed to encrypt recurring purchase offset for use as cursor", zap.Error(err))
+
+		return links, fmt.Errorf("%w", err)
+	}
+
+	// Generate naked next page link for REST.
+	if isREST {
+		links.NextPage = fmt.Sprintf(constants.NextPageRESTFormatString(), nextPage, pageSize)
+	} else {
+		links.PageCursor = nextPage
+	}
+
+	return links, nil
+}
+
+// HTTPSchedulesPaginated retrieves a page of recurring purchase schedules, newest first, and prepares a link to the
+// next page of data.
+func HTTPSchedulesPaginated(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	pageCursor, pageSizeStr string, isREST bool) (models.HTTPSchedulesPaginated, int, string, error) {
+	var (
+		err       error
+		offset    int32
+		pageSize  int32
+		schedules models.HTTPSchedulesPaginated
+	)
+
+	// Extract and assemble the page cursor and page size.
+	if offset, pageSize, err = offsetPaginatedRequest(auth, pageCursor, pageSizeStr); err != nil {
+		return schedules, http.StatusBadRequest, "invalid page cursor or page size", fmt.Errorf("%w", err)
+	}
+
+	if schedules.Schedules, err = db.SchedulesPaginated(clientID, pageSize+1, offset); err != nil {
+		var schedulesErr *postgres.Error
+		if !errors.As(err, &schedulesErr) {
+			logger.Info("failed to unpack recurring purchase schedules error", zap.Error(err))
+
+			return schedules, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return schedules, schedulesErr.Code, schedulesErr.Message, fmt.Errorf("%w", err)
+	}
+
+	// Generate the next page link if the page size is N + 1 of the requested.
+	if len(schedules.Schedules) > int(pageSize) {
+		if schedules.Links, err = schedulesNextPage(auth, logger, offset, pageSize, isREST); err != nil {
+			return schedules, http.StatusInternalServerError, constants.RetryMessageString(), err
+		}
+
+		// Remove last element.
+		schedules.Schedules = schedules.Schedules[:pageSize]
+	}
+
+	return schedules, 0, "", nil
+}
+
+// HTTPScheduleRunsPaginated retrieves a page of the run history for a recurring purchase schedule, newest first, and
+// prepares a link to the next page of data.
+func HTTPScheduleRunsPaginated(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	scheduleIDStr, pageCursor, pageSizeStr string, isREST bool) (models.HTTPScheduleRunsPaginated, int, string, error) {
+	var (
+		err        error
+		offset     int32
+		pageSize   int32
+		runs       models.HTTPScheduleRunsPaginated
+		scheduleID uuid.UUID
+	)
+
+	if scheduleID, err = uuid.FromString(scheduleIDStr); err != nil {
+		return runs, http.StatusBadRequest, "invalid schedule ID", fmt.Errorf("%w", err)
+	}
+
+	// Extract and assemble the page cursor and page size.
+	if offset, pageSize, err = offsetPaginatedRequest(auth, pageCursor, pageSizeStr); err != nil {
+		return runs, http.StatusBadRequest, "invalid page cursor or page size", fmt.Errorf("%w", err)
+	}
+
+	if runs.Runs, err = db.ScheduleRunsPaginated(clientID, scheduleID, pageSize+1, offset); err != nil {
+		var runsErr *postgres.Error
+		if !errors.As(err, &runsErr) {
+			logger.Info("failed to unpack recurring purchase schedule runs error", zap.Error(err))
+
+			return runs, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return runs, runsErr.Code, runsErr.Message, fmt.Errorf("%w", err)
+	}
+
+	// Generate the next page link if the page size is N + 1 of the requested.
+	if len(runs.Runs) > int(pageSize) {
+		if runs.Links, err = schedulesNextPage(auth, logger, offset, pageSize, isREST); err != nil {
+			return runs, http.StatusInternalServerError, constants.RetryMessageString(), err
+		}
+
+		// Remove last element.
+		runs.Runs = runs.Runs[:pageSize]
+	}
+
+	return runs, 0, "", nil
+}
@@ -0,0 +1,648 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestCommon_HTTPScheduleCreate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		request       *models.HTTPScheduleRequest
+		expectErrMsg  string
+		expectErrCode int
+		createErr     error
+		createTimes   int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "empty request",
+			request:       &models.HTTPScheduleRequest{},
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			createTimes:   0,
+			expectErr:     require.Error,
+		}, {
+			name: "crypto sale",
+			request: &models.HTTPScheduleRequest{
+				FiatCurrency: "BTC",
+				Ticker:       "USD",
+				Amount:       decimal.NewFromFloat(50),
+				Frequency:    "weekly",
+			},
+			expectErrMsg:  constants.InvalidRequestString(),
+			expectErrCode: http.StatusBadRequest,
+			createTimes:   0,
+			expectErr:     require.Error,
+		}, {
+			name: "fiat to fiat",
+			request: &models.HTTPScheduleRequest{
+				FiatCurrency: "USD",
+				Ticker:       "CAD",
+				Amount:       decimal.NewFromFloat(50),
+				Frequency:    "weekly",
+			},
+			expectErrMsg:  constants.InvalidRequestString(),
+			expectErrCode: http.StatusBadRequest,
+			createTimes:   0,
+			expectErr:     require.Error,
+		}, {
+			name: "invalid frequency",
+			request: &models.HTTPScheduleRequest{
+				FiatCurrency: "USD",
+				Ticker:       "BTC",
+				Amount:       decimal.NewFromFloat(50),
+				Frequency:    "hourly",
+			},
+			expectErrMsg:  "invalid frequency",
+			expectErrCode: http.StatusBadRequest,
+			createTimes:   0,
+			expectErr:     require.Error,
+		}, {
+			name: "amount too precise",
+			request: &models.HTTPScheduleRequest{
+				FiatCurrency: "USD",
+				Ticker:       "BTC",
+				Amount:       decimal.NewFromFloat(50.001),
+				Frequency:    "weekly",
+			},
+			expectErrMsg:  "invalid amount",
+			expectErrCode: http.StatusBadRequest,
+			createTimes:   0,
+			expectErr:     require.Error,
+		}, {
+			name: "negative amount",
+			request: &models.HTTPScheduleRequest{
+				FiatCurrency: "USD",
+				Ticker:       "BTC",
+				Amount:       decimal.NewFromFloat(-50),
+				Frequency:    "weekly",
+			},
+			expectErrMsg:  "invalid amount",
+			expectErrCode: http.StatusBadRequest,
+			createTimes:   0,
+			expectErr:     require.Error,
+		}, {
+			name: "invalid start time",
+			request: &models.HTTPScheduleRequest{
+				FiatCurrency: "USD",
+				Ticker:       "BTC",
+				Amount:       decimal.NewFromFloat(50),
+				Frequency:    "weekly",
+				StartAt:      "next monday",
+			},
+			expectErrMsg:  "invalid start time",
+			expectErrCode: http.StatusBadRequest,
+			createTimes:   0,
+			expectErr:     require.Error,
+		}, {
+			name: "db failure",
+			request: &models.HTTPScheduleRequest{
+				FiatCurrency: "USD",
+				Ticker:       "BTC",
+				Amount:       decimal.NewFromFloat(50),
+				Frequency:    "weekly",
+			},
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			createErr:     postgres.ErrCreateSchedule,
+			createTimes:   1,
+			expectErr:     require.Error,
+		}, {
+			name: "valid - start in the past",
+			request: &models.HTTPScheduleRequest{
+				FiatCurrency: "USD",
+				Ticker:       "BTC",
+				Amount:       decimal.NewFromFloat(50),
+				Frequency:    "monthly",
+				StartAt:      time.Now().Add(-time.Hour).Format(time.RFC3339),
+			},
+			createTimes: 1,
+			expectErr:   require.NoError,
+		}, {
+			name: "valid",
+			request: &models.HTTPScheduleRequest{
+				FiatCurrency: "USD",
+				Ticker:       "BTC",
+				Amount:       decimal.NewFromFloat(50),
+				Frequency:    "weekly",
+				StartAt:      time.Now().Add(time.Hour).Format(time.RFC3339),
+			},
+			createTimes: 1,
+			expectErr:   require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().ScheduleCreate(gomock.Any(), postgres.Currency(test.request.FiatCurrency),
+				test.request.Ticker, test.request.Amount, postgres.ScheduleFrequency(test.request.Frequency),
+				gomock.Any()).
+				Return(postgres.Schedule{}, test.createErr).
+				Times(test.createTimes)
+
+			schedule, httpStatus, httpMsg, _, err := HTTPScheduleCreate(mockDB, zapLogger, uuid.UUID{}, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, httpStatus, "http status mismatch.")
+			require.Contains(t, httpMsg, test.expectErrMsg, "http message mismatch.")
+
+			if err == nil {
+				require.NotNil(t, schedule, "nil schedule returned.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPScheduleUpdate(t *testing.T) {
+	t.Parallel()
+
+	scheduleID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate schedule id.")
+
+	isActive := false
+
+	testCases := []struct {
+		name          string
+		scheduleID    string
+		request       *models.HTTPScheduleUpdateRequest
+		expectErrMsg  string
+		expectErrCode int
+		updateErr     error
+		updateTimes   int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "invalid schedule id",
+			scheduleID:    "invalid-schedule-id",
+			request:       &models.HTTPScheduleUpdateRequest{},
+			expectErrMsg:  "invalid schedule ID",
+			expectErrCode: http.StatusBadRequest,
+			updateTimes:   0,
+			expectErr:     require.Error,
+		}, {
+			name:          "empty request",
+			scheduleID:    scheduleID.String(),
+			request:       &models.HTTPScheduleUpdateRequest{},
+			expectErrMsg:  constants.ValidationString(),
+			expectErrCode: http.StatusBadRequest,
+			updateTimes:   0,
+			expectErr:     require.Error,
+		}, {
+			name:       "invalid frequency",
+			scheduleID: scheduleID.String(),
+			request: &models.HTTPScheduleUpdateRequest{
+				Amount:    decimal.NewFromFloat(50),
+				Frequency: "yearly",
+				IsActive:  &isActive,
+			},
+			expectErrMsg:  "invalid frequency",
+			expectErrCode: http.StatusBadRequest,
+			updateTimes:   0,
+			expectErr:     require.Error,
+		}, {
+			name:       "unknown db failure",
+			scheduleID: scheduleID.String(),
+			request: &models.HTTPScheduleUpdateRequest{
+				Amount:    decimal.NewFromFloat(50),
+				Frequency: "daily",
+				IsActive:  &isActive,
+			},
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			updateErr:     errors.New("unknown error"),
+			updateTimes:   1,
+			expectErr:     require.Error,
+		}, {
+			name:       "not found",
+			scheduleID: scheduleID.String(),
+			request: &models.HTTPScheduleUpdateRequest{
+				Amount:    decimal.NewFromFloat(50),
+				Frequency: "daily",
+				IsActive:  &isActive,
+			},
+			expectErrMsg:  "schedule not found",
+			expectErrCode: http.StatusNotFound,
+			updateErr:     postgres.ErrNotFound,
+			updateTimes:   1,
+			expectErr:     require.Error,
+		}, {
+			name:       "valid",
+			scheduleID: scheduleID.String(),
+			request: &models.HTTPScheduleUpdateRequest{
+				Amount:    decimal.NewFromFloat(50),
+				Frequency: "daily",
+				IsActive:  &isActive,
+			},
+			updateTimes: 1,
+			expectErr:   require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().ScheduleUpdate(gomock.Any(), scheduleID, test.request.Amount,
+				postgres.ScheduleFrequency(test.request.Frequency), isActive).
+				Return(postgres.Schedule{ScheduleID: scheduleID}, test.updateErr).
+				Times(test.updateTimes)
+
+			schedule, httpStatus, httpMsg, _, err := HTTPScheduleUpdate(mockDB, zapLogger, uuid.UUID{},
+				test.scheduleID, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, httpStatus, "http status mismatch.")
+			require.Contains(t, httpMsg, test.expectErrMsg, "http message mismatch.")
+
+			if err == nil {
+				require.Equal(t, scheduleID, schedule.ScheduleID, "schedule id mismatch.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPScheduleDelete(t *testing.T) {
+	t.Parallel()
+
+	scheduleID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate schedule id.")
+
+	testCases := []struct {
+		name          string
+		scheduleID    string
+		expectErrMsg  string
+		expectErrCode int
+		deleteErr     error
+		deleteTimes   int
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "invalid schedule id",
+			scheduleID:    "invalid-schedule-id",
+			expectErrMsg:  "invalid schedule ID",
+			expectErrCode: http.StatusBadRequest,
+			deleteTimes:   0,
+			expectErr:     require.Error,
+		}, {
+			name:          "unknown db failure",
+			scheduleID:    scheduleID.String(),
+			expectErrMsg:  constants.RetryMessageString(),
+			expectErrCode: http.StatusInternalServerError,
+			deleteErr:     errors.New("unknown error"),
+			deleteTimes:   1,
+			expectErr:     require.Error,
+		}, {
+			name:          "not found",
+			scheduleID:    scheduleID.String(),
+			expectErrMsg:  "schedule not found",
+			expectErrCode: http.StatusNotFound,
+			deleteErr:     postgres.ErrNotFound,
+			deleteTimes:   1,
+			expectErr:     require.Error,
+		}, {
+			name:        "valid",
+			scheduleID:  scheduleID.String(),
+			deleteTimes: 1,
+			expectErr:   require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().ScheduleDelete(gomock.Any(), scheduleID).
+				Return(test.deleteErr).
+				Times(test.deleteTimes)
+
+			httpStatus, httpMsg, err := HTTPScheduleDelete(mockDB, zapLogger, uuid.UUID{}, test.scheduleID)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectErrCode, httpStatus, "http status mismatch.")
+			require.Contains(t, httpMsg, test.expectErrMsg, "http message mismatch.")
+		})
+	}
+}
+
+func TestCommon_HTTPSchedulesPaginated(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pageCursor  = "some-page-cursor"
+		fourRecords = []postgres.Schedule{{}, {}, {}, {}}
+	)
+
+	testCases := []struct {
+		name               string
+		pageCursor         string
+		pageSize           string
+		expectErrMsg       string
+		isREST             bool
+		httpStatus         int
+		expectedRecordsLen int
+		expectedPageSize   int32
+		expectedOffset     int32
+		decryptString      []byte
+		decryptStringErr   error
+		decryptStringTimes int
+		schedulesData      []postgres.Schedule
+		schedulesErr       error
+		schedulesTimes     int
+		encryptStringErr   error
+		encryptStingTimes  int
+		expectErr          require.ErrorAssertionFunc
+		expectNextPage     require.BoolAssertionFunc
+		expectPageCursor   require.BoolAssertionFunc
+	}{
+		{
+			name:               "bad page size",
+			pageSize:           "bad-page-size",
+			expectErrMsg:       "page size",
+			httpStatus:         http.StatusBadRequest,
+			decryptStringTimes: 0,
+			schedulesTimes:     0,
+			encryptStingTimes:  0,
+			expectErr:          require.Error,
+		}, {
+			name:               "cursor decryption failure",
+			pageCursor:         pageCursor,
+			pageSize:           "3",
+			expectErrMsg:       "invalid page cursor",
+			httpStatus:         http.StatusBadRequest,
+			decryptStringErr:   errors.New("decrypt failure"),
+			decryptStringTimes: 1,
+			schedulesTimes:     0,
+			encryptStingTimes:  0,
+			expectErr:          require.Error,
+		}, {
+			name:              "db failure - known error",
+			pageSize:          "3",
+			expectErrMsg:      "not found",
+			httpStatus:        http.StatusNotFound,
+			expectedPageSize:  3,
+			schedulesErr:      postgres.ErrNotFound,
+			schedulesTimes:    1,
+			encryptStingTimes: 0,
+			expectErr:         require.Error,
+		}, {
+			name:              "db failure - unknown error",
+			pageSize:          "3",
+			expectErrMsg:      constants.RetryMessageString(),
+			httpStatus:        http.StatusInternalServerError,
+			expectedPageSize:  3,
+			schedulesErr:      errors.New("unknown db failure"),
+			schedulesTimes:    1,
+			encryptStingTimes: 0,
+			expectErr:         require.Error,
+		}, {
+			name:              "next page encryption failure",
+			pageSize:          "3",
+			expectErrMsg:      constants.RetryMessageString(),
+			httpStatus:        http.StatusInternalServerError,
+			expectedPageSize:  3,
+			schedulesData:     fourRecords,
+			schedulesTimes:    1,
+			encryptStringErr:  errors.New("encrypt failure"),
+			encryptStingTimes: 1,
+			expectErr:         require.Error,
+		}, {
+			name:               "valid - default page size",
+			pageSize:           "0",
+			isREST:             true,
+			expectedRecordsLen: 4,
+			expectedPageSize:   10,
+			schedulesData:      fourRecords,
+			schedulesTimes:     1,
+			encryptStingTimes:  0,
+			expectErr:          require.NoError,
+			expectNextPage:     require.False,
+			expectPageCursor:   require.False,
+		}, {
+			name:               "valid - has next page - graphql",
+			pageCursor:         pageCursor,
+			pageSize:           "3",
+			isREST:             false,
+			expectedRecordsLen: 3,
+			expectedPageSize:   3,
+			expectedOffset:     6,
+			decryptString:      []byte("6"),
+			decryptStringTimes: 1,
+			schedulesData:      fourRecords,
+			schedulesTimes:     1,
+			encryptStingTimes:  1,
+			expectErr:          require.NoError,
+			expectNextPage:     require.False,
+			expectPageCursor:   require.True,
+		}, {
+			name:               "valid - has next page",
+			pageSize:           "3",
+			isREST:             true,
+			expectedRecordsLen: 3,
+			expectedPageSize:   3,
+			schedulesData:      fourRecords,
+			schedulesTimes:     1,
+			encryptStingTimes:  1,
+			expectErr:          require.NoError,
+			expectNextPage:     require.True,
+			expectPageCursor:   require.False,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().DecryptFromString(pageCursor).
+					Return(test.decryptString, test.decryptStringErr).
+					Times(test.decryptStringTimes),
+
+				mockPostgres.EXPECT().SchedulesPaginated(gomock.Any(), test.expectedPageSize+1, test.expectedOffset).
+					Return(test.schedulesData, test.schedulesErr).
+					Times(test.schedulesTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("next-page-cursor", test.encryptStringErr).
+					Times(test.encryptStingTimes),
+			)
+
+			actual, status, errMsg, err := HTTPSchedulesPaginated(mockAuth, mockPostgres, zapLogger,
+				uuid.UUID{}, test.pageCursor, test.pageSize, test.isREST)
+			test.expectErr(t, err, "error expectation failed.")
+
+			require.Equal(t, test.httpStatus, status, "http status code mismatched.")
+			require.Contains(t, errMsg, test.expectErrMsg, "http error message mismatched.")
+
+			if err != nil {
+				return
+			}
+
+			test.expectNextPage(t, len(actual.Links.NextPage) > 0, "next page link expectation failed.")
+			test.expectPageCursor(t, len(actual.Links.PageCursor) > 0, "page cursor expectation failed.")
+			require.Len(t, actual.Schedules, test.expectedRecordsLen, "number of returned records mismatched")
+		})
+	}
+}
+
+func TestCommon_HTTPScheduleRunsPaginated(t *testing.T) {
+	t.Parallel()
+
+	scheduleID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate schedule id.")
+
+	fourRecords := []postgres.ScheduleRun{{}, {}, {}, {}}
+
+	testCases := []struct {
+		name               string
+		scheduleID         string
+		pageSize           string
+		expectErrMsg       string
+		httpStatus         int
+		expectedRecordsLen int
+		expectedPageSize   int32
+		runsData           []postgres.ScheduleRun
+		runsErr            error
+		runsTimes          int
+		encryptStingTimes  int
+		expectErr          require.ErrorAssertionFunc
+		expectNextPage     require.BoolAssertionFunc
+	}{
+		{
+			name:         "invalid schedule id",
+			scheduleID:   "invalid-schedule-id",
+			pageSize:     "3",
+			expectErrMsg: "invalid schedule ID",
+			httpStatus:   http.StatusBadRequest,
+			expectErr:    require.Error,
+		}, {
+			name:         "bad page size",
+			scheduleID:   scheduleID.String(),
+			pageSize:     "bad-page-size",
+			expectErrMsg: "page size",
+			httpStatus:   http.StatusBadRequest,
+			expectErr:    require.Error,
+		}, {
+			name:             "db failure - known error",
+			scheduleID:       scheduleID.String(),
+			pageSize:         "3",
+			expectErrMsg:     "not found",
+			httpStatus:       http.StatusNotFound,
+			expectedPageSize: 3,
+			runsErr:          postgres.ErrNotFound,
+			runsTimes:        1,
+			expectErr:        require.Error,
+		}, {
+			name:             "db failure - unknown error",
+			scheduleID:       scheduleID.String(),
+			pageSize:         "3",
+			expectErrMsg:     constants.RetryMessageString(),
+			httpStatus:       http.StatusInternalServerError,
+			expectedPageSize: 3,
+			runsErr:          errors.New("unknown db failure"),
+			runsTimes:        1,
+			expectErr:        require.Error,
+		}, {
+			name:               "valid - default page size",
+			scheduleID:         scheduleID.String(),
+			pageSize:           "0",
+			expectedRecordsLen: 4,
+			expectedPageSize:   10,
+			runsData:           fourRecords,
+			runsTimes:          1,
+			expectErr:          require.NoError,
+			expectNextPage:     require.False,
+		}, {
+			name:               "valid - has next page",
+			scheduleID:         scheduleID.String(),
+			pageSize:           "3",
+			expectedRecordsLen: 3,
+			expectedPageSize:   3,
+			runsData:           fourRecords,
+			runsTimes:          1,
+			encryptStingTimes:  1,
+			expectErr:          require.NoError,
+			expectNextPage:     require.True,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockPostgres.EXPECT().ScheduleRunsPaginated(gomock.Any(), scheduleID, test.expectedPageSize+1,
+					int32(0)).
+					Return(test.runsData, test.runsErr).
+					Times(test.runsTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("next-page-cursor", nil).
+					Times(test.encryptStingTimes),
+			)
+
+			actual, status, errMsg, err := HTTPScheduleRunsPaginated(mockAuth, mockPostgres, zapLogger,
+				uuid.UUID{}, test.scheduleID, "", test.pageSize, true)
+			test.expectErr(t, err, "error expectation failed.")
+
+			require.Equal(t, test.httpStatus, status, "http status code mismatched.")
+			require.Contains(t, errMsg, test.expectErrMsg, "http error message mismatched.")
+
+			if err != nil {
+				return
+			}
+
+			test.expectNextPage(t, len(actual.Links.NextPage) > 0, "next page link expectation failed.")
+			require.Len(t, actual.Runs, test.expectedRecordsLen, "number of returned records mismatched")
+		})
+	}
+}
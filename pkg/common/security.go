@@ -0,0 +1,135 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+	"go.uber.org/zap"
+)
+
+// loginLockedOut will check whether logins for a username are temporarily locked and return the time remaining on the
+// lockout. Lockouts are not enforced if the Redis cache cannot be reached.
+func loginLockedOut(cache redis.Redis, logger *logger.Logger, username string) (time.Duration, bool) {
+	var lockedUntil int64
+
+	if err := cache.Get(constants.LoginLockoutKeyPrefix()+username, &lockedUntil); err != nil {
+		var redisErr *redis.Error
+		if !errors.As(err, &redisErr) || !redisErr.Is(redis.ErrCacheMiss) {
+			logger.Warn("failed to retrieve login lockout from Redis", zap.String("username", username), zap.Error(err))
+		}
+
+		return 0, false
+	}
+
+	remaining := time.Until(time.Unix(lockedUntil, 0))
+
+	return remaining, remaining > 0
+}
+
+// loginFailed will count a failed login attempt for a username and lock logins for it once the number of consecutive
+// failures in the failure window reaches the configured threshold.
+func loginFailed(auth auth.Auth, cache redis.Redis, logger *logger.Logger, username string) {
+	failures, err := cache.Incr(constants.LoginFailuresKeyPrefix()+username, auth.LoginFailureWindow())
+	if err != nil {
+		logger.Warn("failed to count failed login attempt in Redis", zap.String("username", username), zap.Error(err))
+
+		return
+	}
+
+	lockout := auth.LoginLockout(failures)
+	if lockout <= 0 {
+		return
+	}
+
+	if err = cache.Set(constants.LoginLockoutKeyPrefix()+username, time.Now().Add(lockout).Unix(), lockout); err != nil {
+		logger.Warn("failed to lock logins in Redis", zap.String("username", username), zap.Error(err))
+	}
+}
+
+// loginSucceeded will reset the count of consecutive failed login attempts for a username.
+func loginSucceeded(cache redis.Redis, logger *logger.Logger, username string) {
+	if err := cache.Del(constants.LoginFailuresKeyPrefix() + username); err != nil {
+		var redisErr *redis.Error
+		if !errors.As(err, &redisErr) || !redisErr.Is(redis.ErrCacheMiss) {
+			logger.Warn("failed to reset failed login attempts in Redis", zap.String("username", username),
+				zap.Error(err))
+		}
+	}
+}
+
+// recordLoginEvent will record a login attempt against a user account. Failures are logged and do not block the login.
+func recordLoginEvent(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, outcome postgres.LoginOutcome,
+	ipAddress, userAgent string) {
+	if err := db.LoginEventCreate(clientID, outcome, ipAddress, userAgent); err != nil {
+		logger.Warn("failed to record login event", zap.String("clientID", clientID.String()),
+			zap.String("outcome", string(outcome)), zap.Error(err))
+	}
+}
+
+// loginLockedMessage will prepare the message returned to a client whose login attempts are temporarily locked.
+func loginLockedMessage(remaining time.Duration) string {
+	return fmt.Sprintf("account is temporarily locked due to repeated failed login attempts, please retry in %d seconds",
+		int64(math.Ceil(remaining.Seconds())))
+}
+
+// HTTPLoginEventsPaginated retrieves a page of login attempts against a user account, newest first, and prepares a
+// link to the next page of data.
+func HTTPLoginEventsPaginated(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	pageCursor, pageSizeStr string, isREST bool) (models.HTTPLoginEventsPaginated, int, string, error) {
+	var (
+		err      error
+		offset   int32
+		pageSize int32
+		nextPage string
+		events   models.HTTPLoginEventsPaginated
+	)
+
+	// Extract and assemble the page cursor and page size.
+	if offset, pageSize, err = offsetPaginatedRequest(auth, pageCursor, pageSizeStr); err != nil {
+		return events, http.StatusBadRequest, "invalid page cursor or page size", fmt.Errorf("%w", err)
+	}
+
+	if events.Events, err = db.LoginEventsPaginated(clientID, pageSize+1, offset); err != nil {
+		var eventsErr *postgres.Error
+		if !errors.As(err, &eventsErr) {
+			logger.Info("failed to unpack login events error", zap.Error(err))
+
+			return events, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		return events, eventsErr.Code, eventsErr.Message, fmt.Errorf("%w", err)
+	}
+
+	// Generate the next page link if the page size is N + 1 of the requested.
+	if len(events.Events) > int(pageSize) {
+		// Generate next page link.
+		if nextPage, err = auth.EncryptToString([]byte(strconv.Itoa(int(offset + pageSize)))); err != nil {
+			logger.Error("failed to encrypt login events offset for use as cursor", zap.Error(err))
+
+			return events, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		// Remove last element.
+		events.Events = events.Events[:pageSize]
+
+		// Generate naked next page link for REST.
+		if isREST {
+			events.Links.NextPage = fmt.Sprintf(constants.NextPageRESTFormatString(), nextPage, pageSize)
+		} else {
+			events.Links.PageCursor = nextPage
+		}
+	}
+
+	return events, 0, "", nil
+}
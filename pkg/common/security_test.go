@@ -0,0 +1,717 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestCommon_HTTPUserLogin_Lockout(t *testing.T) {
+	t.Parallel()
+
+	user := &testUserData["username1"].UserLoginCredentials
+	failuresKey := constants.LoginFailuresKeyPrefix() + user.Username
+	lockoutKey := constants.LoginLockoutKeyPrefix() + user.Username
+
+	testCases := []struct {
+		name             string
+		expectedMsg      string
+		expectedStatus   int
+		lockedUntil      int64
+		lockoutGetErr    error
+		userCredsErr     error
+		checkPassErr     error
+		checkPassTimes   int
+		failures         int64
+		incrErr          error
+		incrTimes        int
+		lockout          time.Duration
+		lockoutTimes     int
+		lockoutSetTimes  int
+		delErr           error
+		delTimes         int
+		outcome          postgres.LoginOutcome
+		loginEventErr    error
+		loginEventTimes  int
+		mfaGetTimes      int
+		mfaEnabled       bool
+		challengeTimes   int
+		authGenJWTTimes  int
+		expectErr        require.ErrorAssertionFunc
+		expectToken      require.ValueAssertionFunc
+		expectChallenge  require.ValueAssertionFunc
+		expectRetryAfter bool
+	}{
+		{
+			name:             "locked - existing user",
+			expectedMsg:      "temporarily locked",
+			expectedStatus:   http.StatusTooManyRequests,
+			lockedUntil:      time.Now().Add(time.Minute).Unix(),
+			outcome:          postgres.LoginOutcomeLocked,
+			loginEventTimes:  1,
+			expectErr:        require.Error,
+			expectToken:      require.Nil,
+			expectChallenge:  require.Nil,
+			expectRetryAfter: true,
+		}, {
+			name:             "locked - unknown user",
+			expectedMsg:      "temporarily locked",
+			expectedStatus:   http.StatusTooManyRequests,
+			lockedUntil:      time.Now().Add(time.Minute).Unix(),
+			userCredsErr:     postgres.ErrLoginUser,
+			expectErr:        require.Error,
+			expectToken:      require.Nil,
+			expectChallenge:  require.Nil,
+			expectRetryAfter: true,
+		}, {
+			name:            "expired lockout - unknown user",
+			expectedMsg:     "invalid credentials",
+			expectedStatus:  http.StatusForbidden,
+			lockedUntil:     time.Now().Add(-time.Minute).Unix(),
+			userCredsErr:    postgres.ErrLoginUser,
+			failures:        1,
+			incrTimes:       1,
+			lockoutTimes:    1,
+			expectErr:       require.Error,
+			expectToken:     require.Nil,
+			expectChallenge: require.Nil,
+		}, {
+			name:            "invalid password - below threshold",
+			expectedMsg:     "invalid username or password",
+			expectedStatus:  http.StatusForbidden,
+			lockoutGetErr:   redis.ErrCacheMiss,
+			checkPassErr:    errors.New("password mismatch"),
+			checkPassTimes:  1,
+			failures:        4,
+			incrTimes:       1,
+			lockoutTimes:    1,
+			outcome:         postgres.LoginOutcomeFailure,
+			loginEventTimes: 1,
+			expectErr:       require.Error,
+			expectToken:     require.Nil,
+			expectChallenge: require.Nil,
+		}, {
+			name:            "invalid password - lockout triggered",
+			expectedMsg:     "invalid username or password",
+			expectedStatus:  http.StatusForbidden,
+			lockoutGetErr:   redis.ErrCacheMiss,
+			checkPassErr:    errors.New("password mismatch"),
+			checkPassTimes:  1,
+			failures:        5,
+			incrTimes:       1,
+			lockout:         time.Minute,
+			lockoutTimes:    1,
+			lockoutSetTimes: 1,
+			outcome:         postgres.LoginOutcomeFailure,
+			loginEventTimes: 1,
+			expectErr:       require.Error,
+			expectToken:     require.Nil,
+			expectChallenge: require.Nil,
+		}, {
+			name:            "invalid password - cache failures",
+			expectedMsg:     "invalid username or password",
+			expectedStatus:  http.StatusForbidden,
+			lockoutGetErr:   redis.ErrCacheUnknown,
+			checkPassErr:    errors.New("password mismatch"),
+			checkPassTimes:  1,
+			incrErr:         redis.ErrCacheUnknown,
+			incrTimes:       1,
+			outcome:         postgres.LoginOutcomeFailure,
+			loginEventErr:   postgres.ErrCreateLoginEvent,
+			loginEventTimes: 1,
+			expectErr:       require.Error,
+			expectToken:     require.Nil,
+			expectChallenge: require.Nil,
+		}, {
+			name:            "valid - failures reset",
+			lockoutGetErr:   redis.ErrCacheMiss,
+			checkPassTimes:  1,
+			delTimes:        1,
+			outcome:         postgres.LoginOutcomeSuccess,
+			loginEventTimes: 1,
+			mfaGetTimes:     1,
+			authGenJWTTimes: 1,
+			expectErr:       require.NoError,
+			expectToken:     require.NotNil,
+			expectChallenge: require.Nil,
+		}, {
+			name:            "valid - no failures to reset",
+			lockoutGetErr:   redis.ErrCacheMiss,
+			checkPassTimes:  1,
+			delErr:          redis.ErrCacheMiss,
+			delTimes:        1,
+			outcome:         postgres.LoginOutcomeSuccess,
+			loginEventErr:   postgres.ErrCreateLoginEvent,
+			loginEventTimes: 1,
+			mfaGetTimes:     1,
+			authGenJWTTimes: 1,
+			expectErr:       require.NoError,
+			expectToken:     require.NotNil,
+			expectChallenge: require.Nil,
+		}, {
+			name:            "valid - failures kept until mfa completes",
+			lockoutGetErr:   redis.ErrCacheMiss,
+			checkPassTimes:  1,
+			mfaGetTimes:     1,
+			mfaEnabled:      true,
+			challengeTimes:  1,
+			expectErr:       require.NoError,
+			expectToken:     require.Nil,
+			expectChallenge: require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockCache.EXPECT().Get(lockoutKey, gomock.Any()).
+					DoAndReturn(func(_ string, value any) error {
+						if test.lockoutGetErr == nil {
+							*value.(*int64) = test.lockedUntil //nolint:forcetypeassert
+						}
+
+						return test.lockoutGetErr
+					}).
+					Times(1),
+
+				mockPostgres.EXPECT().UserCredentials(user.Username).
+					Return(uuid.UUID{}, "hashed password", test.userCredsErr).
+					Times(1),
+
+				mockAuth.EXPECT().CheckPassword(gomock.Any(), gomock.Any()).
+					Return(test.checkPassErr).
+					Times(test.checkPassTimes),
+
+				mockAuth.EXPECT().LoginFailureWindow().
+					Return(24*time.Hour).
+					Times(test.incrTimes),
+
+				mockCache.EXPECT().Incr(failuresKey, 24*time.Hour).
+					Return(test.failures, test.incrErr).
+					Times(test.incrTimes),
+
+				mockAuth.EXPECT().LoginLockout(test.failures).
+					Return(test.lockout).
+					Times(test.lockoutTimes),
+
+				mockCache.EXPECT().Set(lockoutKey, gomock.Any(), test.lockout).
+					Return(nil).
+					Times(test.lockoutSetTimes),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.MfaEnrollment{IsEnabled: test.mfaEnabled}, nil).
+					Times(test.mfaGetTimes),
+
+				mockAuth.EXPECT().MFAChallengeExpiration().
+					Return(int64(300)).
+					Times(test.challengeTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-challenge-id", nil).
+					Times(test.challengeTimes),
+
+				mockCache.EXPECT().Set(gomock.Any(), &mfaChallenge{Username: user.Username}, 300*time.Second).
+					Return(nil).
+					Times(test.challengeTimes),
+
+				mockCache.EXPECT().Del(failuresKey).
+					Return(test.delErr).
+					Times(test.delTimes),
+
+				mockPostgres.EXPECT().LoginEventCreate(gomock.Any(), test.outcome, "127.0.0.1", "test-agent").
+					Return(test.loginEventErr).
+					Times(test.loginEventTimes),
+
+				mockAuth.EXPECT().GenerateJWT(gomock.Any()).
+					Return(&models.JWTAuthResponse{}, nil).
+					Times(test.authGenJWTTimes),
+			)
+
+			token, challenge, httpMsg, httpCode, _, err :=
+				HTTPLoginUser(mockAuth, mockCache, mockPostgres, zapLogger, user, "127.0.0.1", "test-agent")
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectToken(t, token, "token expectation failed.")
+			test.expectChallenge(t, challenge, "challenge expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+
+			if test.expectRetryAfter {
+				require.Contains(t, httpMsg, "retry in", "retry time missing from message.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPLoginMFA_Lockout(t *testing.T) {
+	t.Parallel()
+
+	username := "username1"
+	challengeKey := constants.MFAChallengeKeyPrefix() + "challenge-id"
+	failuresKey := constants.LoginFailuresKeyPrefix() + username
+	lockoutKey := constants.LoginLockoutKeyPrefix() + username
+	request := &models.HTTPMFALoginRequest{ChallengeID: "challenge", Code: "123456"}
+
+	testCases := []struct {
+		name             string
+		expectedMsg      string
+		expectedStatus   int
+		lockedUntil      int64
+		lockoutGetErr    error
+		mfaGetTimes      int
+		decryptErr       error
+		validateErr      error
+		validateTimes    int
+		useStepTimes     int
+		failures         int64
+		incrTimes        int
+		lockout          time.Duration
+		lockoutSetTimes  int
+		delTimes         int
+		outcome          postgres.LoginOutcome
+		loginEventTimes  int
+		authGenJWTTimes  int
+		expectErr        require.ErrorAssertionFunc
+		expectToken      require.ValueAssertionFunc
+		expectRetryAfter bool
+	}{
+		{
+			name:             "locked",
+			expectedMsg:      "temporarily locked",
+			expectedStatus:   http.StatusTooManyRequests,
+			lockedUntil:      time.Now().Add(time.Minute).Unix(),
+			outcome:          postgres.LoginOutcomeLocked,
+			loginEventTimes:  1,
+			expectErr:        require.Error,
+			expectToken:      require.Nil,
+			expectRetryAfter: true,
+		}, {
+			name:            "invalid code - below threshold",
+			expectedMsg:     "invalid multifactor authentication code",
+			expectedStatus:  http.StatusForbidden,
+			lockoutGetErr:   redis.ErrCacheMiss,
+			mfaGetTimes:     1,
+			validateErr:     errors.New("invalid code"),
+			validateTimes:   1,
+			failures:        4,
+			incrTimes:       1,
+			outcome:         postgres.LoginOutcomeFailure,
+			loginEventTimes: 1,
+			expectErr:       require.Error,
+			expectToken:     require.Nil,
+		}, {
+			name:            "invalid code - lockout triggered",
+			expectedMsg:     "invalid multifactor authentication code",
+			expectedStatus:  http.StatusForbidden,
+			lockoutGetErr:   redis.ErrCacheMiss,
+			mfaGetTimes:     1,
+			validateErr:     errors.New("invalid code"),
+			validateTimes:   1,
+			failures:        5,
+			incrTimes:       1,
+			lockout:         time.Minute,
+			lockoutSetTimes: 1,
+			outcome:         postgres.LoginOutcomeFailure,
+			loginEventTimes: 1,
+			expectErr:       require.Error,
+			expectToken:     require.Nil,
+		}, {
+			name:           "server failure not counted",
+			expectedMsg:    constants.RetryMessageString(),
+			expectedStatus: http.StatusInternalServerError,
+			lockoutGetErr:  redis.ErrCacheMiss,
+			mfaGetTimes:    1,
+			decryptErr:     errors.New("decrypt failure"),
+			expectErr:      require.Error,
+			expectToken:    require.Nil,
+		}, {
+			name:            "valid - failures reset",
+			lockoutGetErr:   redis.ErrCacheMiss,
+			mfaGetTimes:     1,
+			validateTimes:   1,
+			useStepTimes:    1,
+			delTimes:        1,
+			outcome:         postgres.LoginOutcomeSuccess,
+			loginEventTimes: 1,
+			authGenJWTTimes: 1,
+			expectErr:       require.NoError,
+			expectToken:     require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().DecryptFromString(request.ChallengeID).
+					Return([]byte("challenge-id"), nil).
+					Times(1),
+
+				mockCache.EXPECT().Get(challengeKey, gomock.Any()).
+					DoAndReturn(func(_ string, value any) error {
+						*value.(*mfaChallenge) = mfaChallenge{Username: username} //nolint:forcetypeassert
+
+						return nil
+					}).
+					Times(1),
+
+				mockCache.EXPECT().Del(challengeKey).
+					Return(nil).
+					Times(1),
+
+				mockCache.EXPECT().Get(lockoutKey, gomock.Any()).
+					DoAndReturn(func(_ string, value any) error {
+						if test.lockoutGetErr == nil {
+							*value.(*int64) = test.lockedUntil //nolint:forcetypeassert
+						}
+
+						return test.lockoutGetErr
+					}).
+					Times(1),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.MfaEnrollment{IsEnabled: true, Secret: "encrypted-secret"}, nil).
+					Times(test.mfaGetTimes),
+
+				mockAuth.EXPECT().DecryptFromString("encrypted-secret").
+					Return([]byte("SECRET"), test.decryptErr).
+					Times(test.mfaGetTimes),
+
+				mockAuth.EXPECT().ValidateTOTP("SECRET", request.Code).
+					Return(int64(10), test.validateErr).
+					Times(test.validateTimes),
+
+				mockPostgres.EXPECT().MFAUseStep(gomock.Any(), int64(10)).
+					Return(nil).
+					Times(test.useStepTimes),
+
+				mockAuth.EXPECT().LoginFailureWindow().
+					Return(24*time.Hour).
+					Times(test.incrTimes),
+
+				mockCache.EXPECT().Incr(failuresKey, 24*time.Hour).
+					Return(test.failures, nil).
+					Times(test.incrTimes),
+
+				mockAuth.EXPECT().LoginLockout(test.failures).
+					Return(test.lockout).
+					Times(test.incrTimes),
+
+				mockCache.EXPECT().Set(lockoutKey, gomock.Any(), test.lockout).
+					Return(nil).
+					Times(test.lockoutSetTimes),
+
+				mockCache.EXPECT().Del(failuresKey).
+					Return(nil).
+					Times(test.delTimes),
+
+				mockPostgres.EXPECT().LoginEventCreate(gomock.Any(), test.outcome, "127.0.0.1", "test-agent").
+					Return(nil).
+					Times(test.loginEventTimes),
+
+				mockAuth.EXPECT().GenerateJWT(gomock.Any()).
+					Return(&models.JWTAuthResponse{}, nil).
+					Times(test.authGenJWTTimes),
+			)
+
+			token, httpMsg, httpCode, _, err :=
+				HTTPLoginMFA(mockAuth, mockCache, mockPostgres, zapLogger, request, "127.0.0.1", "test-agent")
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectToken(t, token, "token expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+
+			if test.expectRetryAfter {
+				require.Contains(t, httpMsg, "retry in", "retry time missing from message.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPMFAStepUp_Lockout(t *testing.T) {
+	t.Parallel()
+
+	const threshold = 3
+
+	username := testUserData["username1"].Username
+	failuresKey := constants.LoginFailuresKeyPrefix() + username
+	lockoutKey := constants.LoginLockoutKeyPrefix() + username
+
+	// Mock configurations.
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockAuth := mocks.NewMockAuth(mockCtrl)
+	mockCache := mocks.NewMockRedis(mockCtrl)
+	mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+	// Track the failure count and lockout expiry as Redis would.
+	var (
+		failures    int64
+		lockedUntil int64
+	)
+
+	mockAuth.EXPECT().StepUpRequired(auth.StepUpTransfers).Return(true).Times(threshold + 1)
+	mockAuth.EXPECT().LoginFailureWindow().Return(24 * time.Hour).Times(threshold)
+	mockAuth.EXPECT().LoginLockout(gomock.Any()).
+		DoAndReturn(func(count int64) time.Duration {
+			if count < threshold {
+				return 0
+			}
+
+			return time.Minute
+		}).
+		Times(threshold)
+
+	mockPostgres.EXPECT().MFAGet(gomock.Any()).
+		Return(postgres.MfaEnrollment{IsEnabled: true, Secret: "encrypted-secret"}, nil).
+		Times(threshold + 1)
+	mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+		Return(modelsPostgres.User{UserAccount: testUserData["username1"]}, nil).
+		Times(threshold + 1)
+
+	// Codes are only checked whilst the account is not locked.
+	mockAuth.EXPECT().DecryptFromString("encrypted-secret").Return([]byte("SECRET"), nil).Times(threshold)
+	mockAuth.EXPECT().ValidateTOTP("SECRET", "123456").Return(int64(0), errors.New("invalid code")).Times(threshold)
+
+	mockCache.EXPECT().Get(lockoutKey, gomock.Any()).
+		DoAndReturn(func(_ string, value any) error {
+			if lockedUntil == 0 {
+				return redis.ErrCacheMiss
+			}
+
+			*value.(*int64) = lockedUntil //nolint:forcetypeassert
+
+			return nil
+		}).
+		Times(threshold + 1)
+	mockCache.EXPECT().Incr(failuresKey, 24*time.Hour).
+		DoAndReturn(func(string, time.Duration) (int64, error) {
+			failures++
+
+			return failures, nil
+		}).
+		Times(threshold)
+	mockCache.EXPECT().Set(lockoutKey, gomock.Any(), time.Minute).
+		DoAndReturn(func(_ string, value any, _ time.Duration) error {
+			lockedUntil = value.(int64) //nolint:forcetypeassert
+
+			return nil
+		}).
+		Times(1)
+
+	for attempt := 1; attempt <= threshold; attempt++ {
+		httpMsg, httpCode, err :=
+			HTTPMFAStepUp(mockAuth, mockCache, mockPostgres, zapLogger, uuid.UUID{}, auth.StepUpTransfers, "123456")
+		require.Errorf(t, err, "attempt %d: invalid code accepted.", attempt)
+		require.Equalf(t, http.StatusForbidden, httpCode, "attempt %d: http codes mismatched.", attempt)
+		require.Containsf(t, httpMsg, "invalid multifactor authentication code", "attempt %d: http message mismatched.",
+			attempt)
+	}
+
+	httpMsg, httpCode, err :=
+		HTTPMFAStepUp(mockAuth, mockCache, mockPostgres, zapLogger, uuid.UUID{}, auth.StepUpTransfers, "123456")
+	require.Error(t, err, "locked account accepted a step-up code.")
+	require.Equal(t, http.StatusTooManyRequests, httpCode, "http codes mismatched.")
+	require.Contains(t, httpMsg, "temporarily locked", "http message mismatched.")
+	require.Contains(t, httpMsg, "retry in", "retry time missing from message.")
+}
+
+func TestCommon_HTTPLoginEventsPaginated(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pageCursor  = "some-page-cursor"
+		fourRecords = []postgres.LoginEvent{{}, {}, {}, {}}
+	)
+
+	testCases := []struct {
+		name               string
+		pageCursor         string
+		pageSize           string
+		expectErrMsg       string
+		isREST             bool
+		httpStatus         int
+		expectedRecordsLen int
+		expectedPageSize   int32
+		expectedOffset     int32
+		decryptString      []byte
+		decryptStringErr   error
+		decryptStringTimes int
+		eventsData         []postgres.LoginEvent
+		eventsErr          error
+		eventsTimes        int
+		encryptStringErr   error
+		encryptStingTimes  int
+		expectErr          require.ErrorAssertionFunc
+		expectNextPage     require.BoolAssertionFunc
+		expectPageCursor   require.BoolAssertionFunc
+	}{
+		{
+			name:               "bad page size",
+			pageSize:           "bad-page-size",
+			expectErrMsg:       "page size",
+			httpStatus:         http.StatusBadRequest,
+			decryptStringTimes: 0,
+			eventsTimes:        0,
+			encryptStingTimes:  0,
+			expectErr:          require.Error,
+		}, {
+			name:               "cursor decryption failure",
+			pageCursor:         pageCursor,
+			pageSize:           "3",
+			expectErrMsg:       "invalid page cursor",
+			httpStatus:         http.StatusBadRequest,
+			decryptStringErr:   errors.New("decrypt failure"),
+			decryptStringTimes: 1,
+			eventsTimes:        0,
+			encryptStingTimes:  0,
+			expectErr:          require.Error,
+		}, {
+			name:              "db failure - known error",
+			pageSize:          "3",
+			expectErrMsg:      "not found",
+			httpStatus:        http.StatusNotFound,
+			expectedPageSize:  3,
+			eventsErr:         postgres.ErrNotFound,
+			eventsTimes:       1,
+			encryptStingTimes: 0,
+			expectErr:         require.Error,
+		}, {
+			name:              "db failure - unknown error",
+			pageSize:          "3",
+			expectErrMsg:      constants.RetryMessageString(),
+			httpStatus:        http.StatusInternalServerError,
+			expectedPageSize:  3,
+			eventsErr:         errors.New("unknown db failure"),
+			eventsTimes:       1,
+			encryptStingTimes: 0,
+			expectErr:         require.Error,
+		}, {
+			name:              "next page encryption failure",
+			pageSize:          "3",
+			expectErrMsg:      constants.RetryMessageString(),
+			httpStatus:        http.StatusInternalServerError,
+			expectedPageSize:  3,
+			eventsData:        fourRecords,
+			eventsTimes:       1,
+			encryptStringErr:  errors.New("encrypt failure"),
+			encryptStingTimes: 1,
+			expectErr:         require.Error,
+		}, {
+			name:               "valid - default page size",
+			pageSize:           "0",
+			isREST:             true,
+			expectedRecordsLen: 4,
+			expectedPageSize:   10,
+			eventsData:         fourRecords,
+			eventsTimes:        1,
+			encryptStingTimes:  0,
+			expectErr:          require.NoError,
+			expectNextPage:     require.False,
+			expectPageCursor:   require.False,
+		}, {
+			name:               "valid - has next page - graphql",
+			pageCursor:         pageCursor,
+			pageSize:           "3",
+			isREST:             false,
+			expectedRecordsLen: 3,
+			expectedPageSize:   3,
+			expectedOffset:     6,
+			decryptString:      []byte("6"),
+			decryptStringTimes: 1,
+			eventsData:         fourRecords,
+			eventsTimes:        1,
+			encryptStingTimes:  1,
+			expectErr:          require.NoError,
+			expectNextPage:     require.False,
+			expectPageCursor:   require.True,
+		}, {
+			name:               "valid - has next page",
+			pageSize:           "3",
+			isREST:             true,
+			expectedRecordsLen: 3,
+			expectedPageSize:   3,
+			eventsData:         fourRecords,
+			eventsTimes:        1,
+			encryptStingTimes:  1,
+			expectErr:          require.NoError,
+			expectNextPage:     require.True,
+			expectPageCursor:   require.False,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().DecryptFromString(pageCursor).
+					Return(test.decryptString, test.decryptStringErr).
+					Times(test.decryptStringTimes),
+
+				mockPostgres.EXPECT().LoginEventsPaginated(gomock.Any(), test.expectedPageSize+1, test.expectedOffset).
+					Return(test.eventsData, test.eventsErr).
+					Times(test.eventsTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("next-page-cursor", test.encryptStringErr).
+					Times(test.encryptStingTimes),
+			)
+
+			actualEvents, status, errMsg, err := HTTPLoginEventsPaginated(mockAuth, mockPostgres, zapLogger,
+				uuid.UUID{}, test.pageCursor, test.pageSize, test.isREST)
+			test.expectErr(t, err, "error expectation failed.")
+
+			require.Equal(t, test.httpStatus, status, "http status code mismatched.")
+			require.Contains(t, errMsg, test.expectErrMsg, "http error message mismatched.")
+
+			if err != nil {
+				return
+			}
+
+			test.expectNextPage(t, len(actualEvents.Links.NextPage) > 0, "next page link expectation failed.")
+			test.expectPageCursor(t, len(actualEvents.Links.PageCursor) > 0, "page cursor expectation failed.")
+			require.Len(t, actualEvents.Events, test.expectedRecordsLen, "number of returned records mismatched")
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/redis"
+	"go.uber.org/zap"
+)
+
+// revocationEntry will retrieve an entry from the revocation list in the Redis cache. Entries that are not found are
+// reported as absent without an error.
+func revocationEntry(cache redis.Redis, key string, value any) (bool, error) {
+	if err := cache.Get(key, value); err != nil {
+		var redisErr *redis.Error
+
+		// If we have a valid Redis package error AND the error is that the key is not found.
+		if errors.As(err, &redisErr) && redisErr.Is(redis.ErrCacheMiss) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("%w", err)
+	}
+
+	return true, nil
+}
+
+// HTTPSessionRevoked will check whether a JWT has been revoked by a logout from its session or a logout from all of a
+// client's sessions.
+func HTTPSessionRevoked(auth auth.Auth, cache redis.Redis, logger *logger.Logger, clientID uuid.UUID, token string) (
+	string, int, error) {
+	var (
+		err           error
+		found         bool
+		sessionID     string
+		issuedAt      int64
+		revokedBefore int64
+		isRevoked     bool
+	)
+
+	if sessionID, issuedAt, err = auth.SessionFromJWT(token); err != nil {
+		return "request contains invalid or expired authorization token", http.StatusForbidden, fmt.Errorf("%w", err)
+	}
+
+	// Check whether this session has been logged out.
+	if found, err = revocationEntry(cache, constants.RevokedSessionKeyPrefix()+sessionID, &isRevoked); err != nil {
+		logger.Warn("unknown error occurred whilst retrieving session revocation from Redis", zap.Error(err))
+
+		return constants.RetryMessageString(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+	}
+
+	if found && isRevoked {
+		return "request contains invalid or expired authorization token", http.StatusForbidden,
+			errors.New("session has been revoked")
+	}
+
+	// Check whether all sessions issued up to a point in time have been logged out.
+	clientKey := constants.RevokedClientKeyPrefix() + clientID.String()
+	if found, err = revocationEntry(cache, clientKey, &revokedBefore); err != nil {
+		logger.Warn("unknown error occurred whilst retrieving client revocation from Redis", zap.Error(err))
+
+		return constants.RetryMessageString(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+	}
+
+	if found && issuedAt <= revokedBefore {
+		return "request contains invalid or expired authorization token", http.StatusForbidden,
+			errors.New("all client sessions have been revoked")
+	}
+
+	return "", 0, nil
+}
+
+// HTTPLogout will revoke the session of a JWT. The session is kept on the revocation list until the JWT expires.
+func HTTPLogout(auth auth.Auth, cache redis.Redis, logger *logger.Logger, token string, expiresAt int64) (
+	string, int, error) {
+	var (
+		err       error
+		sessionID string
+		ttl       = time.Until(time.Unix(expiresAt, 0))
+	)
+
+	if sessionID, _, err = auth.SessionFromJWT(token); err != nil {
+		return "request contains invalid or expired authorization token", http.StatusForbidden, fmt.Errorf("%w", err)
+	}
+
+	// An expired token does not need to be revoked.
+	if ttl <= 0 {
+		return "", 0, nil
+	}
+
+	if err = cache.Set(constants.RevokedSessionKeyPrefix()+sessionID, true, ttl); err != nil {
+		logger.Warn("failed to store session revocation in cache", zap.Error(err))
+
+		return constants.RetryMessageString(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+	}
+
+	return "", 0, nil
+}
+
+// HTTPLogoutEverywhere will revoke all JWTs issued to a client up to and including the current microsecond. The entry
+// is kept on the revocation list until every revoked JWT has expired.
+func HTTPLogoutEverywhere(auth auth.Auth, cache redis.Redis, logger *logger.Logger, clientID uuid.UUID) (
+	string, int, error) {
+	var (
+		revokedBefore = time.Now().UnixMicro()
+		ttl           = time.Duration(auth.ExpirationDuration()+1) * time.Second
+	)
+
+	if err := cache.Set(constants.RevokedClientKeyPrefix()+clientID.String(), revokedBefore, ttl); err != nil {
+		logger.Warn("failed to store client revocation in cache", zap.Error(err))
+
+		return constants.RetryMessageString(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+	}
+
+	return "", 0, nil
+}
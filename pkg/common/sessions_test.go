@@ -0,0 +1,297 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestCommon_HTTPSessionRevoked(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	testCases := []struct {
+		name               string
+		expectedMsg        string
+		expectedStatus     int
+		sessionErr         error
+		sessionGetErr      error
+		sessionGetTimes    int
+		sessionRevoked     bool
+		clientGetErr       error
+		clientGetTimes     int
+		clientRevokeBefore int64
+		expectErr          require.ErrorAssertionFunc
+	}{
+		{
+			name:            "invalid token",
+			expectedMsg:     "invalid or expired",
+			expectedStatus:  http.StatusForbidden,
+			sessionErr:      errors.New("invalid token"),
+			sessionGetErr:   nil,
+			sessionGetTimes: 0,
+			clientGetErr:    nil,
+			clientGetTimes:  0,
+			expectErr:       require.Error,
+		}, {
+			name:            "session get unknown error",
+			expectedMsg:     "retry",
+			expectedStatus:  http.StatusInternalServerError,
+			sessionErr:      nil,
+			sessionGetErr:   redis.ErrCacheUnknown,
+			sessionGetTimes: 1,
+			clientGetErr:    nil,
+			clientGetTimes:  0,
+			expectErr:       require.Error,
+		}, {
+			name:            "session revoked",
+			expectedMsg:     "invalid or expired",
+			expectedStatus:  http.StatusForbidden,
+			sessionErr:      nil,
+			sessionGetErr:   nil,
+			sessionGetTimes: 1,
+			sessionRevoked:  true,
+			clientGetErr:    nil,
+			clientGetTimes:  0,
+			expectErr:       require.Error,
+		}, {
+			name:            "client get unknown error",
+			expectedMsg:     "retry",
+			expectedStatus:  http.StatusInternalServerError,
+			sessionErr:      nil,
+			sessionGetErr:   redis.ErrCacheMiss,
+			sessionGetTimes: 1,
+			clientGetErr:    redis.ErrCacheUnknown,
+			clientGetTimes:  1,
+			expectErr:       require.Error,
+		}, {
+			name:               "client revoked at issue time",
+			expectedMsg:        "invalid or expired",
+			expectedStatus:     http.StatusForbidden,
+			sessionErr:         nil,
+			sessionGetErr:      redis.ErrCacheMiss,
+			sessionGetTimes:    1,
+			clientGetErr:       nil,
+			clientGetTimes:     1,
+			clientRevokeBefore: 100,
+			expectErr:          require.Error,
+		}, {
+			name:               "client revoked before issue time",
+			expectedMsg:        "",
+			expectedStatus:     0,
+			sessionErr:         nil,
+			sessionGetErr:      redis.ErrCacheMiss,
+			sessionGetTimes:    1,
+			clientGetErr:       nil,
+			clientGetTimes:     1,
+			clientRevokeBefore: 99,
+			expectErr:          require.NoError,
+		}, {
+			name:            "not revoked",
+			expectedMsg:     "",
+			expectedStatus:  0,
+			sessionErr:      nil,
+			sessionGetErr:   redis.ErrCacheMiss,
+			sessionGetTimes: 1,
+			clientGetErr:    redis.ErrCacheMiss,
+			clientGetTimes:  1,
+			expectErr:       require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().SessionFromJWT("token").
+					Return("session-id", int64(100), test.sessionErr).
+					Times(1),
+
+				mockCache.EXPECT().Get(constants.RevokedSessionKeyPrefix()+"session-id", gomock.Any()).
+					DoAndReturn(func(_ string, value any) error {
+						if revoked, ok := value.(*bool); ok && test.sessionGetErr == nil {
+							*revoked = test.sessionRevoked
+						}
+
+						return test.sessionGetErr
+					}).
+					Times(test.sessionGetTimes),
+
+				mockCache.EXPECT().Get(constants.RevokedClientKeyPrefix()+clientID.String(), gomock.Any()).
+					DoAndReturn(func(_ string, value any) error {
+						if revokedBefore, ok := value.(*int64); ok && test.clientGetErr == nil {
+							*revokedBefore = test.clientRevokeBefore
+						}
+
+						return test.clientGetErr
+					}).
+					Times(test.clientGetTimes),
+			)
+
+			httpMsg, httpStatus, err := HTTPSessionRevoked(mockAuth, mockCache, zapLogger, clientID, "token")
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectedStatus, httpStatus, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPLogout(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		expectedMsg    string
+		expectedStatus int
+		expiresAt      int64
+		sessionErr     error
+		redisSetErr    error
+		redisSetTimes  int
+		expectErr      require.ErrorAssertionFunc
+	}{
+		{
+			name:           "invalid token",
+			expectedMsg:    "invalid or expired",
+			expectedStatus: http.StatusForbidden,
+			expiresAt:      time.Now().Add(time.Minute).Unix(),
+			sessionErr:     errors.New("invalid token"),
+			redisSetErr:    nil,
+			redisSetTimes:  0,
+			expectErr:      require.Error,
+		}, {
+			name:           "expired token",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			expiresAt:      time.Now().Add(-time.Minute).Unix(),
+			sessionErr:     nil,
+			redisSetErr:    nil,
+			redisSetTimes:  0,
+			expectErr:      require.NoError,
+		}, {
+			name:           "cache failure",
+			expectedMsg:    "retry",
+			expectedStatus: http.StatusInternalServerError,
+			expiresAt:      time.Now().Add(time.Minute).Unix(),
+			sessionErr:     nil,
+			redisSetErr:    redis.ErrCacheSet,
+			redisSetTimes:  1,
+			expectErr:      require.Error,
+		}, {
+			name:           "valid",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			expiresAt:      time.Now().Add(time.Minute).Unix(),
+			sessionErr:     nil,
+			redisSetErr:    nil,
+			redisSetTimes:  1,
+			expectErr:      require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().SessionFromJWT("token").
+					Return("session-id", int64(100), test.sessionErr).
+					Times(1),
+
+				mockCache.EXPECT().Set(constants.RevokedSessionKeyPrefix()+"session-id", true, gomock.Any()).
+					Return(test.redisSetErr).
+					Times(test.redisSetTimes),
+			)
+
+			httpMsg, httpStatus, err := HTTPLogout(mockAuth, mockCache, zapLogger, "token", test.expiresAt)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectedStatus, httpStatus, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPLogoutEverywhere(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	testCases := []struct {
+		name           string
+		expectedMsg    string
+		expectedStatus int
+		redisSetErr    error
+		expectErr      require.ErrorAssertionFunc
+	}{
+		{
+			name:           "cache failure",
+			expectedMsg:    "retry",
+			expectedStatus: http.StatusInternalServerError,
+			redisSetErr:    redis.ErrCacheSet,
+			expectErr:      require.Error,
+		}, {
+			name:           "valid",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			redisSetErr:    nil,
+			expectErr:      require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ExpirationDuration().
+					Return(int64(600)).
+					Times(1),
+
+				mockCache.EXPECT().Set(constants.RevokedClientKeyPrefix()+clientID.String(), gomock.Any(),
+					601*time.Second).
+					Return(test.redisSetErr).
+					Times(1),
+			)
+
+			httpMsg, httpStatus, err := HTTPLogoutEverywhere(mockAuth, mockCache, zapLogger, clientID)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectedStatus, httpStatus, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
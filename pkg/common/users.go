@@ -7,12 +7,15 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/rs/xid"
 	"github.com/surahman/FTeX/pkg/auth"
 	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/logger"
 	"github.com/surahman/FTeX/pkg/models"
 	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/notifier"
 	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
 	"github.com/surahman/FTeX/pkg/validator"
 	"go.uber.org/zap"
 )
@@ -81,35 +84,79 @@ func HTTPRegisterUser(auth auth.Auth, db postgres.Postgres, logger *logger.Logge
 	return authToken, "", 0, nil, nil
 }
 
-// HTTPLoginUser will complete a login request for a user.
-func HTTPLoginUser(auth auth.Auth, db postgres.Postgres, logger *logger.Logger,
-	loginRequest *modelsPostgres.UserLoginCredentials) (*models.JWTAuthResponse, string, int, any, error) {
+// HTTPLoginUser will complete a login request for a user. Users with multifactor authentication enabled are issued a
+// challenge, which must be completed through HTTPLoginMFA, instead of a JWT. Repeated failed login attempts for a
+// username will temporarily lock it, and login attempts against existing accounts are recorded with the originating IP
+// address and user agent. Logins that require multifactor authentication are only recorded as successful, and the
+// failed attempts reset, once the challenge is completed.
+func HTTPLoginUser(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
+	loginRequest *modelsPostgres.UserLoginCredentials, ipAddress, userAgent string) (
+	*models.JWTAuthResponse, *models.HTTPMFAChallengeResponse, string, int, any, error) {
 	var (
 		err            error
 		authToken      *models.JWTAuthResponse
+		challenge      *models.HTTPMFAChallengeResponse
 		clientID       uuid.UUID
 		hashedPassword string
+		isMFAEnabled   bool
 	)
 
 	if err = validator.ValidateStruct(loginRequest); err != nil {
-		return nil, constants.ValidationString(), http.StatusBadRequest, fmt.Errorf("%w", err), fmt.Errorf("%w", err)
+		return nil, nil, constants.ValidationString(), http.StatusBadRequest, fmt.Errorf("%w", err),
+			fmt.Errorf("%w", err)
 	}
 
-	if clientID, hashedPassword, err = db.UserCredentials(loginRequest.Username); err != nil {
-		return nil, "invalid credentials", http.StatusForbidden, nil, fmt.Errorf("%w", err)
+	remaining, isLocked := loginLockedOut(cache, logger, loginRequest.Username)
+
+	clientID, hashedPassword, err = db.UserCredentials(loginRequest.Username)
+
+	// Locked usernames are rejected without checking the password or counting a failure.
+	if isLocked {
+		if err == nil {
+			recordLoginEvent(db, logger, clientID, postgres.LoginOutcomeLocked, ipAddress, userAgent)
+		}
+
+		return nil, nil, loginLockedMessage(remaining), http.StatusTooManyRequests, nil,
+			errors.New("login attempts are temporarily locked")
+	}
+
+	if err != nil {
+		loginFailed(auth, cache, logger, loginRequest.Username)
+
+		return nil, nil, "invalid credentials", http.StatusForbidden, nil, fmt.Errorf("%w", err)
 	}
 
 	if err = auth.CheckPassword(hashedPassword, loginRequest.Password); err != nil {
-		return nil, "invalid username or password", http.StatusForbidden, nil, fmt.Errorf("%w", err)
+		loginFailed(auth, cache, logger, loginRequest.Username)
+		recordLoginEvent(db, logger, clientID, postgres.LoginOutcomeFailure, ipAddress, userAgent)
+
+		return nil, nil, "invalid username or password", http.StatusForbidden, nil, fmt.Errorf("%w", err)
 	}
 
+	if _, isMFAEnabled, err = mfaEnrollment(db, clientID); err != nil {
+		logger.Error("failed to retrieve multifactor authentication enrollment during login", zap.Error(err))
+
+		return nil, nil, constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	if isMFAEnabled {
+		if challenge, err = httpMFAChallenge(auth, cache, logger, clientID, loginRequest.Username); err != nil {
+			return nil, nil, constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+		}
+
+		return nil, challenge, "", 0, nil, nil
+	}
+
+	loginSucceeded(cache, logger, loginRequest.Username)
+	recordLoginEvent(db, logger, clientID, postgres.LoginOutcomeSuccess, ipAddress, userAgent)
+
 	if authToken, err = auth.GenerateJWT(clientID); err != nil {
 		logger.Error("failure generating JWT during login", zap.Error(err))
 
-		return nil, err.Error(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+		return nil, nil, err.Error(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
 	}
 
-	return authToken, "", 0, nil, nil
+	return authToken, nil, "", 0, nil, nil
 }
 
 // HTTPRefreshLogin validates a JWT token and issues a fresh token.
@@ -207,3 +254,195 @@ func HTTPDeleteUser(auth auth.Auth, db postgres.Postgres, logger *logger.Logger,
 
 	return "", 0, nil, nil
 }
+
+// HTTPChangePassword will replace the password of an authenticated user once their current password has been verified.
+// All the user's sessions are logged out once the password has been changed.
+func HTTPChangePassword(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
+	clientID uuid.UUID, request *models.HTTPChangePasswordRequest) (string, int, any, error) {
+	var (
+		err            error
+		hashedPassword string
+		userAccount    modelsPostgres.User
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return constants.ValidationString(), http.StatusBadRequest, fmt.Errorf("%w", err), fmt.Errorf("%w", err)
+	}
+
+	if userAccount, err = db.UserGetInfo(clientID); err != nil {
+		logger.Warn("failed to read user record during a password change request",
+			zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	if userAccount.IsDeleted {
+		msg := "user account is deleted"
+
+		return msg, http.StatusForbidden, nil, errors.New(msg)
+	}
+
+	if err = auth.CheckPassword(userAccount.Password, request.CurrentPassword); err != nil {
+		msg := "invalid user credentials"
+
+		return msg, http.StatusForbidden, nil, errors.New(msg)
+	}
+
+	if hashedPassword, err = auth.HashPassword(request.NewPassword); err != nil {
+		logger.Error("failure hashing password during a password change", zap.Error(err))
+
+		return constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	if err = db.UserUpdatePassword(clientID, hashedPassword); err != nil {
+		logger.Warn("failed to update user password", zap.String("username", userAccount.Username), zap.Error(err))
+
+		return constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	return passwordChangedLogout(auth, cache, logger, clientID)
+}
+
+// HTTPRequestPasswordReset will issue a single-use password reset token for an account and deliver it to the account
+// owner through the notifier. The same response is returned whether the account exists so as not to disclose
+// registered usernames.
+func HTTPRequestPasswordReset(auth auth.Auth, cache redis.Redis, db postgres.Postgres, notify notifier.Notifier,
+	logger *logger.Logger, request *models.HTTPPasswordResetRequest) (
+	*models.HTTPPasswordResetResponse, string, int, any, error) {
+	var (
+		err         error
+		clientID    uuid.UUID
+		token       string
+		tokenID     = xid.New().String()
+		userAccount modelsPostgres.User
+		response    = &models.HTTPPasswordResetResponse{
+			Expires: time.Now().Add(constants.PasswordResetTTL()).Unix(),
+		}
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, constants.ValidationString(), http.StatusBadRequest, fmt.Errorf("%w", err), fmt.Errorf("%w", err)
+	}
+
+	// Unknown usernames are not disclosed to the requester.
+	if clientID, err = db.UserGetClientID(request.Username); err != nil {
+		return response, "", 0, nil, nil
+	}
+
+	if userAccount, err = db.UserGetInfo(clientID); err != nil {
+		logger.Warn("failed to read user record during a password reset request",
+			zap.String("clientID", clientID.String()), zap.Error(err))
+
+		return nil, constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	// Deleted accounts are not disclosed to the requester.
+	if userAccount.IsDeleted {
+		return response, "", 0, nil, nil
+	}
+
+	// Encrypt the token ID before delivering it to the account owner.
+	if token, err = auth.EncryptToString([]byte(tokenID)); err != nil {
+		logger.Warn("failed to encrypt password reset token", zap.Error(err))
+
+		return nil, constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	if err = cache.Set(constants.PasswordResetKeyPrefix()+tokenID, &clientID, constants.PasswordResetTTL()); err != nil {
+		logger.Warn("failed to store password reset token in cache", zap.Error(err))
+
+		return nil, constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	if err = notify.PasswordReset(&notifier.Recipient{
+		Username:  userAccount.Username,
+		FirstName: userAccount.FirstName,
+		Email:     userAccount.Email,
+	}, token, response.Expires); err != nil {
+		logger.Warn("failed to deliver password reset token", zap.String("username", userAccount.Username),
+			zap.Error(err))
+
+		return nil, constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	return response, "", 0, nil, nil
+}
+
+// HTTPResetPassword will replace the password of the account a password reset token was issued for. The token is
+// consumed on use and all the user's sessions are logged out once the password has been reset.
+func HTTPResetPassword(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
+	request *models.HTTPResetPasswordRequest) (string, int, any, error) {
+	var (
+		err            error
+		clientID       uuid.UUID
+		hashedPassword string
+		tokenKey       string
+		redisErr       *redis.Error
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return constants.ValidationString(), http.StatusBadRequest, fmt.Errorf("%w", err), fmt.Errorf("%w", err)
+	}
+
+	// Extract the token ID from the request.
+	{
+		var rawTokenID []byte
+
+		if rawTokenID, err = auth.DecryptFromString(request.Token); err != nil {
+			return "invalid or expired password reset token", http.StatusForbidden, nil, fmt.Errorf("%w", err)
+		}
+
+		tokenKey = constants.PasswordResetKeyPrefix() + string(rawTokenID)
+	}
+
+	if err = cache.Get(tokenKey, &clientID); err != nil {
+		// If we have a valid Redis package error AND the error is that the key is not found.
+		if errors.As(err, &redisErr) && redisErr.Is(redis.ErrCacheMiss) {
+			return "invalid or expired password reset token", http.StatusForbidden, nil, fmt.Errorf("%w", err)
+		}
+
+		logger.Warn("unknown error occurred whilst retrieving password reset token from Redis", zap.Error(err))
+
+		return constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	if hashedPassword, err = auth.HashPassword(request.Password); err != nil {
+		logger.Error("failure hashing password during a password reset", zap.Error(err))
+
+		return constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	// Consume the token to block its re-use. A cache miss indicates the token has expired or was consumed by a
+	// concurrent request.
+	if err = cache.Del(tokenKey); err != nil {
+		if errors.As(err, &redisErr) && redisErr.Is(redis.ErrCacheMiss) {
+			return "invalid or expired password reset token", http.StatusForbidden, nil, fmt.Errorf("%w", err)
+		}
+
+		logger.Warn("unknown error occurred whilst evicting password reset token from Redis", zap.Error(err))
+
+		return constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	if err = db.UserUpdatePassword(clientID, hashedPassword); err != nil {
+		logger.Warn("failed to reset user password", zap.String("clientID", clientID.String()), zap.Error(err))
+
+		msg := "failed to reset password, please request a new password reset token"
+
+		return msg, http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	return passwordChangedLogout(auth, cache, logger, clientID)
+}
+
+// passwordChangedLogout will log out all of a user's sessions after their password has been replaced.
+func passwordChangedLogout(auth auth.Auth, cache redis.Redis, logger *logger.Logger, clientID uuid.UUID) (
+	string, int, any, error) {
+	if _, _, err := HTTPLogoutEverywhere(auth, cache, logger, clientID); err != nil {
+		msg := "password replaced but existing sessions could not be logged out, please log out of all sessions"
+
+		return msg, http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	return "", 0, nil, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/surahman/FTeX/pkg/models"
 	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
 	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
 )
 
 func TestCommon_HTTPUserRegister(t *testing.T) {
@@ -168,11 +169,18 @@ func TestCommon_HTTPUserLogin(t *testing.T) {
 		userCredsTimes     int
 		authCheckPassErr   error
 		authCheckPassTimes int
+		mfaGetEnrollment   postgres.MfaEnrollment
+		mfaGetErr          error
+		mfaGetTimes        int
+		authEncryptTimes   int
+		redisSetErr        error
+		redisSetTimes      int
 		authGenJWTErr      error
 		authGenJWTTimes    int
 		expectErr          require.ErrorAssertionFunc
 		expectPayload      require.ValueAssertionFunc
 		expectToken        require.ValueAssertionFunc
+		expectChallenge    require.ValueAssertionFunc
 	}{
 		{
 			name:               "empty user",
@@ -183,11 +191,17 @@ func TestCommon_HTTPUserLogin(t *testing.T) {
 			userCredsTimes:     0,
 			authCheckPassErr:   nil,
 			authCheckPassTimes: 0,
+			mfaGetErr:          nil,
+			mfaGetTimes:        0,
+			authEncryptTimes:   0,
+			redisSetErr:        nil,
+			redisSetTimes:      0,
 			authGenJWTErr:      nil,
 			authGenJWTTimes:    0,
 			expectErr:          require.Error,
 			expectPayload:      require.NotNil,
 			expectToken:        require.Nil,
+			expectChallenge:    require.Nil,
 		}, {
 			name:               "valid user",
 			expectedMsg:        "",
@@ -197,11 +211,59 @@ func TestCommon_HTTPUserLogin(t *testing.T) {
 			userCredsTimes:     1,
 			authCheckPassErr:   nil,
 			authCheckPassTimes: 1,
+			mfaGetErr:          postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:        1,
+			authEncryptTimes:   0,
+			redisSetErr:        nil,
+			redisSetTimes:      0,
 			authGenJWTErr:      nil,
 			authGenJWTTimes:    1,
 			expectErr:          require.NoError,
 			expectPayload:      require.Nil,
 			expectToken:        require.NotNil,
+			expectChallenge:    require.Nil,
+		}, {
+			name:               "valid user with pending mfa enrollment",
+			expectedMsg:        "",
+			expectedStatus:     0,
+			user:               &testUserData["username1"].UserLoginCredentials,
+			userCredsErr:       nil,
+			userCredsTimes:     1,
+			authCheckPassErr:   nil,
+			authCheckPassTimes: 1,
+			mfaGetEnrollment:   postgres.MfaEnrollment{IsEnabled: false},
+			mfaGetErr:          nil,
+			mfaGetTimes:        1,
+			authEncryptTimes:   0,
+			redisSetErr:        nil,
+			redisSetTimes:      0,
+			authGenJWTErr:      nil,
+			authGenJWTTimes:    1,
+			expectErr:          require.NoError,
+			expectPayload:      require.Nil,
+			expectToken:        require.NotNil,
+			expectChallenge:    require.Nil,
+		}, {
+			name:               "valid user with mfa enabled",
+			expectedMsg:        "",
+			expectedStatus:     0,
+			user:               &testUserData["username1"].UserLoginCredentials,
+			userCredsErr:       nil,
+			userCredsTimes:     1,
+			authCheckPassErr:   nil,
+			authCheckPassTimes: 1,
+			mfaGetEnrollment:   postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetErr:          nil,
+			mfaGetTimes:        1,
+			authEncryptTimes:   1,
+			redisSetErr:        nil,
+			redisSetTimes:      1,
+			authGenJWTErr:      nil,
+			authGenJWTTimes:    0,
+			expectErr:          require.NoError,
+			expectPayload:      require.Nil,
+			expectToken:        require.Nil,
+			expectChallenge:    require.NotNil,
 		}, {
 			name:               "database failure",
 			expectedMsg:        "invalid credentials",
@@ -211,11 +273,17 @@ func TestCommon_HTTPUserLogin(t *testing.T) {
 			userCredsTimes:     1,
 			authCheckPassErr:   nil,
 			authCheckPassTimes: 0,
+			mfaGetErr:          nil,
+			mfaGetTimes:        0,
+			authEncryptTimes:   0,
+			redisSetErr:        nil,
+			redisSetTimes:      0,
 			authGenJWTErr:      nil,
 			authGenJWTTimes:    0,
 			expectErr:          require.Error,
 			expectPayload:      require.Nil,
 			expectToken:        require.Nil,
+			expectChallenge:    require.Nil,
 		}, {
 			name:               "password check failure",
 			expectedMsg:        "invalid username or password",
@@ -225,11 +293,58 @@ func TestCommon_HTTPUserLogin(t *testing.T) {
 			userCredsTimes:     1,
 			authCheckPassErr:   errors.New("password hash failure"),
 			authCheckPassTimes: 1,
+			mfaGetErr:          nil,
+			mfaGetTimes:        0,
+			authEncryptTimes:   0,
+			redisSetErr:        nil,
+			redisSetTimes:      0,
+			authGenJWTErr:      nil,
+			authGenJWTTimes:    0,
+			expectErr:          require.Error,
+			expectPayload:      require.Nil,
+			expectToken:        require.Nil,
+			expectChallenge:    require.Nil,
+		}, {
+			name:               "mfa enrollment failure",
+			expectedMsg:        constants.RetryMessageString(),
+			expectedStatus:     http.StatusInternalServerError,
+			user:               &testUserData["username1"].UserLoginCredentials,
+			userCredsErr:       nil,
+			userCredsTimes:     1,
+			authCheckPassErr:   nil,
+			authCheckPassTimes: 1,
+			mfaGetErr:          postgres.ErrTransactMFA,
+			mfaGetTimes:        1,
+			authEncryptTimes:   0,
+			redisSetErr:        nil,
+			redisSetTimes:      0,
+			authGenJWTErr:      nil,
+			authGenJWTTimes:    0,
+			expectErr:          require.Error,
+			expectPayload:      require.Nil,
+			expectToken:        require.Nil,
+			expectChallenge:    require.Nil,
+		}, {
+			name:               "mfa challenge cache failure",
+			expectedMsg:        constants.RetryMessageString(),
+			expectedStatus:     http.StatusInternalServerError,
+			user:               &testUserData["username1"].UserLoginCredentials,
+			userCredsErr:       nil,
+			userCredsTimes:     1,
+			authCheckPassErr:   nil,
+			authCheckPassTimes: 1,
+			mfaGetEnrollment:   postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetErr:          nil,
+			mfaGetTimes:        1,
+			authEncryptTimes:   1,
+			redisSetErr:        redis.ErrCacheUnknown,
+			redisSetTimes:      1,
 			authGenJWTErr:      nil,
 			authGenJWTTimes:    0,
 			expectErr:          require.Error,
 			expectPayload:      require.Nil,
 			expectToken:        require.Nil,
+			expectChallenge:    require.Nil,
 		}, {
 			name:               "auth token failure",
 			expectedMsg:        "auth token failure",
@@ -239,11 +354,17 @@ func TestCommon_HTTPUserLogin(t *testing.T) {
 			authCheckPassTimes: 1,
 			userCredsErr:       nil,
 			userCredsTimes:     1,
+			mfaGetErr:          postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:        1,
+			authEncryptTimes:   0,
+			redisSetErr:        nil,
+			redisSetTimes:      0,
 			authGenJWTErr:      errors.New("auth token failure"),
 			authGenJWTTimes:    1,
 			expectErr:          require.Error,
 			expectPayload:      require.Nil,
 			expectToken:        require.Nil,
+			expectChallenge:    require.Nil,
 		},
 	}
 
@@ -257,8 +378,18 @@ func TestCommon_HTTPUserLogin(t *testing.T) {
 			mockCtrl := gomock.NewController(t)
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
 
+			// Login lockout and event recording are covered by the login security tests.
+			mockCache.EXPECT().Get(gomock.Any(), gomock.Any()).Return(redis.ErrCacheMiss).AnyTimes()
+			mockCache.EXPECT().Incr(gomock.Any(), gomock.Any()).Return(int64(1), nil).AnyTimes()
+			mockCache.EXPECT().Del(gomock.Any()).Return(nil).AnyTimes()
+			mockAuth.EXPECT().LoginFailureWindow().Return(time.Hour).AnyTimes()
+			mockAuth.EXPECT().LoginLockout(gomock.Any()).Return(time.Duration(0)).AnyTimes()
+			mockPostgres.EXPECT().LoginEventCreate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil).AnyTimes()
+
 			gomock.InOrder(
 				mockPostgres.EXPECT().UserCredentials(gomock.Any()).
 					Return(uuid.UUID{}, "hashed password", test.userCredsErr).
@@ -268,15 +399,33 @@ func TestCommon_HTTPUserLogin(t *testing.T) {
 					Return(test.authCheckPassErr).
 					Times(test.authCheckPassTimes),
 
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(test.mfaGetEnrollment, test.mfaGetErr).
+					Times(test.mfaGetTimes),
+
+				mockAuth.EXPECT().MFAChallengeExpiration().
+					Return(int64(300)).
+					Times(test.authEncryptTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-challenge-id", nil).
+					Times(test.authEncryptTimes),
+
+				mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.redisSetErr).
+					Times(test.redisSetTimes),
+
 				mockAuth.EXPECT().GenerateJWT(gomock.Any()).
 					Return(&models.JWTAuthResponse{}, test.authGenJWTErr).
 					Times(test.authGenJWTTimes),
 			)
 
-			token, httpMsg, httpCode, payload, err := HTTPLoginUser(mockAuth, mockPostgres, zapLogger, test.user)
+			token, challenge, httpMsg, httpCode, payload, err :=
+				HTTPLoginUser(mockAuth, mockCache, mockPostgres, zapLogger, test.user, "127.0.0.1", "test-agent")
 			test.expectErr(t, err, "error expectation failed.")
 			test.expectPayload(t, payload, "payload expectation failed.")
 			test.expectToken(t, token, "token expectation failed.")
+			test.expectChallenge(t, challenge, "challenge expectation failed.")
 			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
 			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
 		})
@@ -642,3 +791,757 @@ func TestCommon_DeleteUser(t *testing.T) {
 		})
 	}
 }
+
+func TestCommon_HTTPChangePassword(t *testing.T) {
+	t.Parallel()
+
+	userAccount := &modelsPostgres.UserAccount{
+		UserLoginCredentials: modelsPostgres.UserLoginCredentials{
+			Username: "username1",
+			Password: "hashed-password",
+		},
+	}
+
+	validRequest := &models.HTTPChangePasswordRequest{
+		CurrentPassword: "current-password",
+		NewPassword:     "new-password",
+	}
+
+	testCases := []struct {
+		name              string
+		expectedMsg       string
+		expectedStatus    int
+		request           *models.HTTPChangePasswordRequest
+		userGetInfoAcc    modelsPostgres.User
+		userGetInfoErr    error
+		userGetInfoTimes  int
+		authCheckPwdErr   error
+		authCheckPwdTimes int
+		authHashErr       error
+		authHashTimes     int
+		updatePwdErr      error
+		updatePwdTimes    int
+		redisSetErr       error
+		redisSetTimes     int
+		expectErr         require.ErrorAssertionFunc
+		expectPayload     require.ValueAssertionFunc
+	}{
+		{
+			name:              "empty request",
+			expectedMsg:       constants.ValidationString(),
+			expectedStatus:    http.StatusBadRequest,
+			request:           &models.HTTPChangePasswordRequest{},
+			userGetInfoAcc:    modelsPostgres.User{},
+			userGetInfoErr:    nil,
+			userGetInfoTimes:  0,
+			authCheckPwdErr:   nil,
+			authCheckPwdTimes: 0,
+			authHashErr:       nil,
+			authHashTimes:     0,
+			updatePwdErr:      nil,
+			updatePwdTimes:    0,
+			redisSetErr:       nil,
+			redisSetTimes:     0,
+			expectErr:         require.Error,
+			expectPayload:     require.NotNil,
+		}, {
+			name:           "unchanged password",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			request: &models.HTTPChangePasswordRequest{
+				CurrentPassword: "current-password",
+				NewPassword:     "current-password",
+			},
+			userGetInfoAcc:    modelsPostgres.User{},
+			userGetInfoErr:    nil,
+			userGetInfoTimes:  0,
+			authCheckPwdErr:   nil,
+			authCheckPwdTimes: 0,
+			authHashErr:       nil,
+			authHashTimes:     0,
+			updatePwdErr:      nil,
+			updatePwdTimes:    0,
+			redisSetErr:       nil,
+			redisSetTimes:     0,
+			expectErr:         require.Error,
+			expectPayload:     require.NotNil,
+		}, {
+			name:              "user info failure",
+			expectedMsg:       "retry",
+			expectedStatus:    http.StatusInternalServerError,
+			request:           validRequest,
+			userGetInfoAcc:    modelsPostgres.User{},
+			userGetInfoErr:    postgres.ErrNotFoundUser,
+			userGetInfoTimes:  1,
+			authCheckPwdErr:   nil,
+			authCheckPwdTimes: 0,
+			authHashErr:       nil,
+			authHashTimes:     0,
+			updatePwdErr:      nil,
+			updatePwdTimes:    0,
+			redisSetErr:       nil,
+			redisSetTimes:     0,
+			expectErr:         require.Error,
+			expectPayload:     require.Nil,
+		}, {
+			name:              "deleted user",
+			expectedMsg:       "deleted",
+			expectedStatus:    http.StatusForbidden,
+			request:           validRequest,
+			userGetInfoAcc:    modelsPostgres.User{UserAccount: userAccount, IsDeleted: true},
+			userGetInfoErr:    nil,
+			userGetInfoTimes:  1,
+			authCheckPwdErr:   nil,
+			authCheckPwdTimes: 0,
+			authHashErr:       nil,
+			authHashTimes:     0,
+			updatePwdErr:      nil,
+			updatePwdTimes:    0,
+			redisSetErr:       nil,
+			redisSetTimes:     0,
+			expectErr:         require.Error,
+			expectPayload:     require.Nil,
+		}, {
+			name:              "invalid current password",
+			expectedMsg:       "invalid user credentials",
+			expectedStatus:    http.StatusForbidden,
+			request:           validRequest,
+			userGetInfoAcc:    modelsPostgres.User{UserAccount: userAccount},
+			userGetInfoErr:    nil,
+			userGetInfoTimes:  1,
+			authCheckPwdErr:   errors.New("invalid password"),
+			authCheckPwdTimes: 1,
+			authHashErr:       nil,
+			authHashTimes:     0,
+			updatePwdErr:      nil,
+			updatePwdTimes:    0,
+			redisSetErr:       nil,
+			redisSetTimes:     0,
+			expectErr:         require.Error,
+			expectPayload:     require.Nil,
+		}, {
+			name:              "hash failure",
+			expectedMsg:       "retry",
+			expectedStatus:    http.StatusInternalServerError,
+			request:           validRequest,
+			userGetInfoAcc:    modelsPostgres.User{UserAccount: userAccount},
+			userGetInfoErr:    nil,
+			userGetInfoTimes:  1,
+			authCheckPwdErr:   nil,
+			authCheckPwdTimes: 1,
+			authHashErr:       errors.New("hash failure"),
+			authHashTimes:     1,
+			updatePwdErr:      nil,
+			updatePwdTimes:    0,
+			redisSetErr:       nil,
+			redisSetTimes:     0,
+			expectErr:         require.Error,
+			expectPayload:     require.Nil,
+		}, {
+			name:              "update failure",
+			expectedMsg:       "retry",
+			expectedStatus:    http.StatusInternalServerError,
+			request:           validRequest,
+			userGetInfoAcc:    modelsPostgres.User{UserAccount: userAccount},
+			userGetInfoErr:    nil,
+			userGetInfoTimes:  1,
+			authCheckPwdErr:   nil,
+			authCheckPwdTimes: 1,
+			authHashErr:       nil,
+			authHashTimes:     1,
+			updatePwdErr:      postgres.ErrNotFoundUser,
+			updatePwdTimes:    1,
+			redisSetErr:       nil,
+			redisSetTimes:     0,
+			expectErr:         require.Error,
+			expectPayload:     require.Nil,
+		}, {
+			name:              "session revocation failure",
+			expectedMsg:       "log out of all sessions",
+			expectedStatus:    http.StatusInternalServerError,
+			request:           validRequest,
+			userGetInfoAcc:    modelsPostgres.User{UserAccount: userAccount},
+			userGetInfoErr:    nil,
+			userGetInfoTimes:  1,
+			authCheckPwdErr:   nil,
+			authCheckPwdTimes: 1,
+			authHashErr:       nil,
+			authHashTimes:     1,
+			updatePwdErr:      nil,
+			updatePwdTimes:    1,
+			redisSetErr:       redis.ErrCacheSet,
+			redisSetTimes:     1,
+			expectErr:         require.Error,
+			expectPayload:     require.Nil,
+		}, {
+			name:              "valid",
+			expectedMsg:       "",
+			expectedStatus:    0,
+			request:           validRequest,
+			userGetInfoAcc:    modelsPostgres.User{UserAccount: userAccount},
+			userGetInfoErr:    nil,
+			userGetInfoTimes:  1,
+			authCheckPwdErr:   nil,
+			authCheckPwdTimes: 1,
+			authHashErr:       nil,
+			authHashTimes:     1,
+			updatePwdErr:      nil,
+			updatePwdTimes:    1,
+			redisSetErr:       nil,
+			redisSetTimes:     1,
+			expectErr:         require.NoError,
+			expectPayload:     require.Nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(test.userGetInfoAcc, test.userGetInfoErr).
+					Times(test.userGetInfoTimes),
+
+				mockAuth.EXPECT().CheckPassword("hashed-password", "current-password").
+					Return(test.authCheckPwdErr).
+					Times(test.authCheckPwdTimes),
+
+				mockAuth.EXPECT().HashPassword("new-password").
+					Return("new-hashed-password", test.authHashErr).
+					Times(test.authHashTimes),
+
+				mockPostgres.EXPECT().UserUpdatePassword(gomock.Any(), "new-hashed-password").
+					Return(test.updatePwdErr).
+					Times(test.updatePwdTimes),
+
+				mockAuth.EXPECT().ExpirationDuration().
+					Return(int64(600)).
+					Times(test.redisSetTimes),
+
+				mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.redisSetErr).
+					Times(test.redisSetTimes),
+			)
+
+			httpMsg, httpCode, payload, err :=
+				HTTPChangePassword(mockAuth, mockCache, mockPostgres, zapLogger, uuid.UUID{}, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectPayload(t, payload, "payload expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPRequestPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	userAccount := &modelsPostgres.UserAccount{
+		UserLoginCredentials: modelsPostgres.UserLoginCredentials{
+			Username: "username1",
+			Password: "hashed-password",
+		},
+		Email: "user1@email-address.com",
+	}
+
+	validRequest := &models.HTTPPasswordResetRequest{Username: "username1"}
+
+	testCases := []struct {
+		name             string
+		expectedMsg      string
+		expectedStatus   int
+		request          *models.HTTPPasswordResetRequest
+		clientIDErr      error
+		userGetInfoAcc   modelsPostgres.User
+		userGetInfoErr   error
+		userGetInfoTimes int
+		encryptErr       error
+		encryptTimes     int
+		redisSetErr      error
+		redisSetTimes    int
+		notifyErr        error
+		notifyTimes      int
+		expectErr        require.ErrorAssertionFunc
+		expectPayload    require.ValueAssertionFunc
+		expectResponse   require.ValueAssertionFunc
+	}{
+		{
+			name:             "empty request",
+			expectedMsg:      constants.ValidationString(),
+			expectedStatus:   http.StatusBadRequest,
+			request:          &models.HTTPPasswordResetRequest{},
+			clientIDErr:      nil,
+			userGetInfoAcc:   modelsPostgres.User{},
+			userGetInfoErr:   nil,
+			userGetInfoTimes: 0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			redisSetErr:      nil,
+			redisSetTimes:    0,
+			notifyErr:        nil,
+			notifyTimes:      0,
+			expectErr:        require.Error,
+			expectPayload:    require.NotNil,
+			expectResponse:   require.Nil,
+		}, {
+			name:             "unknown user",
+			expectedMsg:      "",
+			expectedStatus:   0,
+			request:          validRequest,
+			clientIDErr:      postgres.ErrNotFoundUser,
+			userGetInfoAcc:   modelsPostgres.User{},
+			userGetInfoErr:   nil,
+			userGetInfoTimes: 0,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			redisSetErr:      nil,
+			redisSetTimes:    0,
+			notifyErr:        nil,
+			notifyTimes:      0,
+			expectErr:        require.NoError,
+			expectPayload:    require.Nil,
+			expectResponse:   require.NotNil,
+		}, {
+			name:             "user info failure",
+			expectedMsg:      "retry",
+			expectedStatus:   http.StatusInternalServerError,
+			request:          validRequest,
+			clientIDErr:      nil,
+			userGetInfoAcc:   modelsPostgres.User{},
+			userGetInfoErr:   postgres.ErrNotFoundUser,
+			userGetInfoTimes: 1,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			redisSetErr:      nil,
+			redisSetTimes:    0,
+			notifyErr:        nil,
+			notifyTimes:      0,
+			expectErr:        require.Error,
+			expectPayload:    require.Nil,
+			expectResponse:   require.Nil,
+		}, {
+			name:             "deleted user",
+			expectedMsg:      "",
+			expectedStatus:   0,
+			request:          validRequest,
+			clientIDErr:      nil,
+			userGetInfoAcc:   modelsPostgres.User{UserAccount: userAccount, IsDeleted: true},
+			userGetInfoErr:   nil,
+			userGetInfoTimes: 1,
+			encryptErr:       nil,
+			encryptTimes:     0,
+			redisSetErr:      nil,
+			redisSetTimes:    0,
+			notifyErr:        nil,
+			notifyTimes:      0,
+			expectErr:        require.NoError,
+			expectPayload:    require.Nil,
+			expectResponse:   require.NotNil,
+		}, {
+			name:             "encryption failure",
+			expectedMsg:      "retry",
+			expectedStatus:   http.StatusInternalServerError,
+			request:          validRequest,
+			clientIDErr:      nil,
+			userGetInfoAcc:   modelsPostgres.User{UserAccount: userAccount},
+			userGetInfoErr:   nil,
+			userGetInfoTimes: 1,
+			encryptErr:       errors.New("encryption failure"),
+			encryptTimes:     1,
+			redisSetErr:      nil,
+			redisSetTimes:    0,
+			notifyErr:        nil,
+			notifyTimes:      0,
+			expectErr:        require.Error,
+			expectPayload:    require.Nil,
+			expectResponse:   require.Nil,
+		}, {
+			name:             "cache failure",
+			expectedMsg:      "retry",
+			expectedStatus:   http.StatusInternalServerError,
+			request:          validRequest,
+			clientIDErr:      nil,
+			userGetInfoAcc:   modelsPostgres.User{UserAccount: userAccount},
+			userGetInfoErr:   nil,
+			userGetInfoTimes: 1,
+			encryptErr:       nil,
+			encryptTimes:     1,
+			redisSetErr:      redis.ErrCacheSet,
+			redisSetTimes:    1,
+			notifyErr:        nil,
+			notifyTimes:      0,
+			expectErr:        require.Error,
+			expectPayload:    require.Nil,
+			expectResponse:   require.Nil,
+		}, {
+			name:             "notification failure",
+			expectedMsg:      "retry",
+			expectedStatus:   http.StatusInternalServerError,
+			request:          validRequest,
+			clientIDErr:      nil,
+			userGetInfoAcc:   modelsPostgres.User{UserAccount: userAccount},
+			userGetInfoErr:   nil,
+			userGetInfoTimes: 1,
+			encryptErr:       nil,
+			encryptTimes:     1,
+			redisSetErr:      nil,
+			redisSetTimes:    1,
+			notifyErr:        errors.New("notification failure"),
+			notifyTimes:      1,
+			expectErr:        require.Error,
+			expectPayload:    require.Nil,
+			expectResponse:   require.Nil,
+		}, {
+			name:             "valid",
+			expectedMsg:      "",
+			expectedStatus:   0,
+			request:          validRequest,
+			clientIDErr:      nil,
+			userGetInfoAcc:   modelsPostgres.User{UserAccount: userAccount},
+			userGetInfoErr:   nil,
+			userGetInfoTimes: 1,
+			encryptErr:       nil,
+			encryptTimes:     1,
+			redisSetErr:      nil,
+			redisSetTimes:    1,
+			notifyErr:        nil,
+			notifyTimes:      1,
+			expectErr:        require.NoError,
+			expectPayload:    require.Nil,
+			expectResponse:   require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockNotifier := mocks.NewMockNotifier(mockCtrl)
+
+			clientIDTimes := 0
+			if test.request.Username != "" {
+				clientIDTimes = 1
+			}
+
+			gomock.InOrder(
+				mockPostgres.EXPECT().UserGetClientID(test.request.Username).
+					Return(uuid.UUID{}, test.clientIDErr).
+					Times(clientIDTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(test.userGetInfoAcc, test.userGetInfoErr).
+					Times(test.userGetInfoTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-token", test.encryptErr).
+					Times(test.encryptTimes),
+
+				mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), constants.PasswordResetTTL()).
+					Return(test.redisSetErr).
+					Times(test.redisSetTimes),
+
+				mockNotifier.EXPECT().PasswordReset(gomock.Any(), "encrypted-token", gomock.Any()).
+					Return(test.notifyErr).
+					Times(test.notifyTimes),
+			)
+
+			response, httpMsg, httpCode, payload, err := HTTPRequestPasswordReset(
+				mockAuth, mockCache, mockPostgres, mockNotifier, zapLogger, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectPayload(t, payload, "payload expectation failed.")
+			test.expectResponse(t, response, "response expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPResetPassword(t *testing.T) {
+	t.Parallel()
+
+	validRequest := &models.HTTPResetPasswordRequest{Token: "encrypted-token", Password: "new-password"}
+
+	testCases := []struct {
+		name           string
+		expectedMsg    string
+		expectedStatus int
+		request        *models.HTTPResetPasswordRequest
+		decryptErr     error
+		decryptTimes   int
+		redisGetErr    error
+		redisGetTimes  int
+		authHashErr    error
+		authHashTimes  int
+		redisDelErr    error
+		redisDelTimes  int
+		updatePwdErr   error
+		updatePwdTimes int
+		redisSetErr    error
+		redisSetTimes  int
+		expectErr      require.ErrorAssertionFunc
+		expectPayload  require.ValueAssertionFunc
+	}{
+		{
+			name:           "empty request",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			request:        &models.HTTPResetPasswordRequest{},
+			decryptErr:     nil,
+			decryptTimes:   0,
+			redisGetErr:    nil,
+			redisGetTimes:  0,
+			authHashErr:    nil,
+			authHashTimes:  0,
+			redisDelErr:    nil,
+			redisDelTimes:  0,
+			updatePwdErr:   nil,
+			updatePwdTimes: 0,
+			redisSetErr:    nil,
+			redisSetTimes:  0,
+			expectErr:      require.Error,
+			expectPayload:  require.NotNil,
+		}, {
+			name:           "invalid token",
+			expectedMsg:    "invalid or expired",
+			expectedStatus: http.StatusForbidden,
+			request:        validRequest,
+			decryptErr:     errors.New("decryption failure"),
+			decryptTimes:   1,
+			redisGetErr:    nil,
+			redisGetTimes:  0,
+			authHashErr:    nil,
+			authHashTimes:  0,
+			redisDelErr:    nil,
+			redisDelTimes:  0,
+			updatePwdErr:   nil,
+			updatePwdTimes: 0,
+			redisSetErr:    nil,
+			redisSetTimes:  0,
+			expectErr:      require.Error,
+			expectPayload:  require.Nil,
+		}, {
+			name:           "expired token",
+			expectedMsg:    "invalid or expired",
+			expectedStatus: http.StatusForbidden,
+			request:        validRequest,
+			decryptErr:     nil,
+			decryptTimes:   1,
+			redisGetErr:    redis.ErrCacheMiss,
+			redisGetTimes:  1,
+			authHashErr:    nil,
+			authHashTimes:  0,
+			redisDelErr:    nil,
+			redisDelTimes:  0,
+			updatePwdErr:   nil,
+			updatePwdTimes: 0,
+			redisSetErr:    nil,
+			redisSetTimes:  0,
+			expectErr:      require.Error,
+			expectPayload:  require.Nil,
+		}, {
+			name:           "cache get failure",
+			expectedMsg:    "retry",
+			expectedStatus: http.StatusInternalServerError,
+			request:        validRequest,
+			decryptErr:     nil,
+			decryptTimes:   1,
+			redisGetErr:    redis.ErrCacheUnknown,
+			redisGetTimes:  1,
+			authHashErr:    nil,
+			authHashTimes:  0,
+			redisDelErr:    nil,
+			redisDelTimes:  0,
+			updatePwdErr:   nil,
+			updatePwdTimes: 0,
+			redisSetErr:    nil,
+			redisSetTimes:  0,
+			expectErr:      require.Error,
+			expectPayload:  require.Nil,
+		}, {
+			name:           "hash failure",
+			expectedMsg:    "retry",
+			expectedStatus: http.StatusInternalServerError,
+			request:        validRequest,
+			decryptErr:     nil,
+			decryptTimes:   1,
+			redisGetErr:    nil,
+			redisGetTimes:  1,
+			authHashErr:    errors.New("hash failure"),
+			authHashTimes:  1,
+			redisDelErr:    nil,
+			redisDelTimes:  0,
+			updatePwdErr:   nil,
+			updatePwdTimes: 0,
+			redisSetErr:    nil,
+			redisSetTimes:  0,
+			expectErr:      require.Error,
+			expectPayload:  require.Nil,
+		}, {
+			name:           "token consumed",
+			expectedMsg:    "invalid or expired",
+			expectedStatus: http.StatusForbidden,
+			request:        validRequest,
+			decryptErr:     nil,
+			decryptTimes:   1,
+			redisGetErr:    nil,
+			redisGetTimes:  1,
+			authHashErr:    nil,
+			authHashTimes:  1,
+			redisDelErr:    redis.ErrCacheMiss,
+			redisDelTimes:  1,
+			updatePwdErr:   nil,
+			updatePwdTimes: 0,
+			redisSetErr:    nil,
+			redisSetTimes:  0,
+			expectErr:      require.Error,
+			expectPayload:  require.Nil,
+		}, {
+			name:           "cache del failure",
+			expectedMsg:    "retry",
+			expectedStatus: http.StatusInternalServerError,
+			request:        validRequest,
+			decryptErr:     nil,
+			decryptTimes:   1,
+			redisGetErr:    nil,
+			redisGetTimes:  1,
+			authHashErr:    nil,
+			authHashTimes:  1,
+			redisDelErr:    redis.ErrCacheDel,
+			redisDelTimes:  1,
+			updatePwdErr:   nil,
+			updatePwdTimes: 0,
+			redisSetErr:    nil,
+			redisSetTimes:  0,
+			expectErr:      require.Error,
+			expectPayload:  require.Nil,
+		}, {
+			name:           "update failure",
+			expectedMsg:    "request a new password reset",
+			expectedStatus: http.StatusInternalServerError,
+			request:        validRequest,
+			decryptErr:     nil,
+			decryptTimes:   1,
+			redisGetErr:    nil,
+			redisGetTimes:  1,
+			authHashErr:    nil,
+			authHashTimes:  1,
+			redisDelErr:    nil,
+			redisDelTimes:  1,
+			updatePwdErr:   postgres.ErrNotFoundUser,
+			updatePwdTimes: 1,
+			redisSetErr:    nil,
+			redisSetTimes:  0,
+			expectErr:      require.Error,
+			expectPayload:  require.Nil,
+		}, {
+			name:           "session revocation failure",
+			expectedMsg:    "log out of all sessions",
+			expectedStatus: http.StatusInternalServerError,
+			request:        validRequest,
+			decryptErr:     nil,
+			decryptTimes:   1,
+			redisGetErr:    nil,
+			redisGetTimes:  1,
+			authHashErr:    nil,
+			authHashTimes:  1,
+			redisDelErr:    nil,
+			redisDelTimes:  1,
+			updatePwdErr:   nil,
+			updatePwdTimes: 1,
+			redisSetErr:    redis.ErrCacheSet,
+			redisSetTimes:  1,
+			expectErr:      require.Error,
+			expectPayload:  require.Nil,
+		}, {
+			name:           "valid",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			request:        validRequest,
+			decryptErr:     nil,
+			decryptTimes:   1,
+			redisGetErr:    nil,
+			redisGetTimes:  1,
+			authHashErr:    nil,
+			authHashTimes:  1,
+			redisDelErr:    nil,
+			redisDelTimes:  1,
+			updatePwdErr:   nil,
+			updatePwdTimes: 1,
+			redisSetErr:    nil,
+			redisSetTimes:  1,
+			expectErr:      require.NoError,
+			expectPayload:  require.Nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			tokenKey := constants.PasswordResetKeyPrefix() + "token-id"
+
+			gomock.InOrder(
+				mockAuth.EXPECT().DecryptFromString("encrypted-token").
+					Return([]byte("token-id"), test.decryptErr).
+					Times(test.decryptTimes),
+
+				mockCache.EXPECT().Get(tokenKey, gomock.Any()).
+					Return(test.redisGetErr).
+					Times(test.redisGetTimes),
+
+				mockAuth.EXPECT().HashPassword("new-password").
+					Return("new-hashed-password", test.authHashErr).
+					Times(test.authHashTimes),
+
+				mockCache.EXPECT().Del(tokenKey).
+					Return(test.redisDelErr).
+					Times(test.redisDelTimes),
+
+				mockPostgres.EXPECT().UserUpdatePassword(gomock.Any(), "new-hashed-password").
+					Return(test.updatePwdErr).
+					Times(test.updatePwdTimes),
+
+				mockAuth.EXPECT().ExpirationDuration().
+					Return(int64(600)).
+					Times(test.redisSetTimes),
+
+				mockCache.EXPECT().Set(constants.RevokedClientKeyPrefix()+uuid.UUID{}.String(), gomock.Any(), gomock.Any()).
+					Return(test.redisSetErr).
+					Times(test.redisSetTimes),
+			)
+
+			httpMsg, httpCode, payload, err := HTTPResetPassword(mockAuth, mockCache, mockPostgres, zapLogger, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectPayload(t, payload, "payload expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/validator"
+	"github.com/surahman/FTeX/pkg/webhooks"
+	"go.uber.org/zap"
+)
+
+// webhookDatabaseError will extract the message and HTTP status code from a webhook database error.
+func webhookDatabaseError(logger *logger.Logger, err error) (string, int, error) {
+	var pgErr *postgres.Error
+	if !errors.As(err, &pgErr) {
+		logger.Warn("failed to extract webhook error", zap.Error(err))
+
+		return constants.RetryMessageString(), http.StatusInternalServerError, fmt.Errorf("%w", err)
+	}
+
+	return pgErr.Message, pgErr.Code, fmt.Errorf("%w", err)
+}
+
+// HTTPWebhookCreate will register a webhook URL for a client and generate the secret its requests will be signed with.
+// The secret is stored encrypted and the plaintext secret is only ever returned once.
+func HTTPWebhookCreate(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	request *models.HTTPWebhookRequest) (*models.HTTPWebhookResponse, string, int, any, error) {
+	var (
+		err             error
+		encryptedSecret string
+		httpMsg         string
+		httpStatus      int
+		response        models.HTTPWebhookResponse
+	)
+
+	if err = validator.ValidateStruct(request); err != nil {
+		return nil, constants.ValidationString(), http.StatusBadRequest, fmt.Errorf("%w", err), fmt.Errorf("%w", err)
+	}
+
+	if err = webhooks.ValidateURL(request.URL); err != nil {
+		return nil, err.Error(), http.StatusBadRequest, request.URL, fmt.Errorf("%w", err)
+	}
+
+	if response.Secret, encryptedSecret, err = auth.GenerateWebhookSecret(); err != nil {
+		logger.Error("failed to generate webhook secret", zap.Error(err))
+
+		return nil, constants.RetryMessageString(), http.StatusInternalServerError, nil, fmt.Errorf("%w", err)
+	}
+
+	if response.Webhook, err = db.WebhookCreate(clientID, request.URL, encryptedSecret,
+		constants.WebhookMaxPerClient()); err != nil {
+		httpMsg, httpStatus, err = webhookDatabaseError(logger, err)
+
+		return nil, httpMsg, httpStatus, nil, err
+	}
+
+	return &response, "", 0, nil, nil
+}
+
+// HTTPWebhookList will retrieve all of a client's registered webhooks.
+func HTTPWebhookList(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID) (
+	*models.HTTPWebhooksResponse, string, int, error) {
+	var (
+		err        error
+		httpMsg    string
+		httpStatus int
+		response   models.HTTPWebhooksResponse
+	)
+
+	if response.Webhooks, err = db.WebhookList(clientID); err != nil {
+		httpMsg, httpStatus, err = webhookDatabaseError(logger, err)
+
+		return nil, httpMsg, httpStatus, err
+	}
+
+	return &response, "", 0, nil
+}
+
+// HTTPWebhookDelete will delete one of a client's webhooks. Events that have not been delivered to it are discarded.
+func HTTPWebhookDelete(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, webhookIDStr string) (
+	string, int, error) {
+	var (
+		err       error
+		webhookID uuid.UUID
+	)
+
+	if webhookID, err = uuid.FromString(webhookIDStr); err != nil {
+		return "invalid webhook ID", http.StatusBadRequest, fmt.Errorf("%w", err)
+	}
+
+	if err = db.WebhookDelete(clientID, webhookID); err != nil {
+		return webhookDatabaseError(logger, err)
+	}
+
+	return "", 0, nil
+}
+
+// HTTPWebhookDeadLettersPaginated retrieves a page of the events that could not be delivered to a client's webhooks,
+// most recent first, and prepares a link to the next page of data.
+func HTTPWebhookDeadLettersPaginated(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID,
+	pageCursor, pageSizeStr string, isREST bool) (models.HTTPWebhookDeadLettersPaginated, int, string, error) {
+	var (
+		err         error
+		offset      int32
+		pageSize    int32
+		nextPage    string
+		deadLetters models.HTTPWebhookDeadLettersPaginated
+	)
+
+	// Extract and assemble the page cursor and page size.
+	if offset, pageSize, err = offsetPaginatedRequest(auth, pageCursor, pageSizeStr); err != nil {
+		return deadLetters, http.StatusBadRequest, "invalid page cursor or page size", fmt.Errorf("%w", err)
+	}
+
+	if deadLetters.DeadLetters, err = db.WebhookDeadLettersPaginated(clientID, pageSize+1, offset); err != nil {
+		httpMsg, httpStatus, err := webhookDatabaseError(logger, err)
+
+		return deadLetters, httpStatus, httpMsg, err
+	}
+
+	// Generate the next page link if the page size is N + 1 of the requested.
+	if len(deadLetters.DeadLetters) > int(pageSize) {
+		// Generate next page link.
+		if nextPage, err = auth.EncryptToString([]byte(strconv.Itoa(int(offset + pageSize)))); err != nil {
+			logger.Error("failed to encrypt webhook dead letters offset for use as cursor", zap.Error(err))
+
+			return deadLetters, http.StatusInternalServerError, constants.RetryMessageString(), fmt.Errorf("%w", err)
+		}
+
+		// Remove last element.
+		deadLetters.DeadLetters = deadLetters.DeadLetters[:pageSize]
+
+		// Generate naked next page link for REST.
+		if isREST {
+			deadLetters.Links.NextPage = fmt.Sprintf(constants.NextPageRESTFormatString(), nextPage, pageSize)
+		} else {
+			deadLetters.Links.PageCursor = nextPage
+		}
+	}
+
+	return deadLetters, 0, "", nil
+}
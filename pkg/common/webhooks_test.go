@@ -0,0 +1,431 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestCommon_HTTPWebhookCreate(t *testing.T) {
+	t.Parallel()
+
+	validRequest := &models.HTTPWebhookRequest{URL: "https://example.com/ftex/events"}
+
+	testCases := []struct {
+		name                string
+		expectedMsg         string
+		expectedStatus      int
+		request             *models.HTTPWebhookRequest
+		generateSecretErr   error
+		generateSecretTimes int
+		webhookCreateErr    error
+		webhookCreateTimes  int
+		expectErr           require.ErrorAssertionFunc
+		expectPayload       require.ValueAssertionFunc
+		expectResponse      require.ValueAssertionFunc
+	}{
+		{
+			name:           "empty request",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			request:        &models.HTTPWebhookRequest{},
+			expectErr:      require.Error,
+			expectPayload:  require.NotNil,
+			expectResponse: require.Nil,
+		}, {
+			name:           "invalid url",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			request:        &models.HTTPWebhookRequest{URL: "not a url"},
+			expectErr:      require.Error,
+			expectPayload:  require.NotNil,
+			expectResponse: require.Nil,
+		}, {
+			name:           "insecure url",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			request:        &models.HTTPWebhookRequest{URL: "http://example.com/ftex/events"},
+			expectErr:      require.Error,
+			expectPayload:  require.NotNil,
+			expectResponse: require.Nil,
+		}, {
+			name:           "metadata address",
+			expectedMsg:    "publicly routable",
+			expectedStatus: http.StatusBadRequest,
+			request:        &models.HTTPWebhookRequest{URL: "https://169.254.169.254/latest/meta-data"},
+			expectErr:      require.Error,
+			expectPayload:  require.NotNil,
+			expectResponse: require.Nil,
+		}, {
+			name:           "local host name",
+			expectedMsg:    "publicly routable",
+			expectedStatus: http.StatusBadRequest,
+			request:        &models.HTTPWebhookRequest{URL: "https://localhost:8443/ftex/events"},
+			expectErr:      require.Error,
+			expectPayload:  require.NotNil,
+			expectResponse: require.Nil,
+		}, {
+			name:                "generation failure",
+			expectedMsg:         constants.RetryMessageString(),
+			expectedStatus:      http.StatusInternalServerError,
+			request:             validRequest,
+			generateSecretErr:   errors.New("generation failure"),
+			generateSecretTimes: 1,
+			expectErr:           require.Error,
+			expectPayload:       require.Nil,
+			expectResponse:      require.Nil,
+		}, {
+			name:                "already registered or limit reached",
+			expectedMsg:         "could not register webhook",
+			expectedStatus:      http.StatusConflict,
+			request:             validRequest,
+			generateSecretTimes: 1,
+			webhookCreateErr:    postgres.ErrCreateWebhook,
+			webhookCreateTimes:  1,
+			expectErr:           require.Error,
+			expectPayload:       require.Nil,
+			expectResponse:      require.Nil,
+		}, {
+			name:                "valid",
+			expectedMsg:         "",
+			expectedStatus:      0,
+			request:             validRequest,
+			generateSecretTimes: 1,
+			webhookCreateTimes:  1,
+			expectErr:           require.NoError,
+			expectPayload:       require.Nil,
+			expectResponse:      require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().GenerateWebhookSecret().
+					Return("whsec_secret", "encrypted-secret", test.generateSecretErr).
+					Times(test.generateSecretTimes),
+
+				mockPostgres.EXPECT().WebhookCreate(gomock.Any(), test.request.URL, "encrypted-secret",
+					constants.WebhookMaxPerClient()).
+					Return(postgres.Webhook{Url: test.request.URL}, test.webhookCreateErr).
+					Times(test.webhookCreateTimes),
+			)
+
+			response, httpMsg, httpCode, payload, err :=
+				HTTPWebhookCreate(mockAuth, mockPostgres, zapLogger, uuid.UUID{}, test.request)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectPayload(t, payload, "payload expectation failed.")
+			test.expectResponse(t, response, "response expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+
+			if err == nil {
+				require.Equal(t, "whsec_secret", response.Secret, "plaintext secret mismatched.")
+			}
+		})
+	}
+}
+
+func TestCommon_HTTPWebhookList(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		expectedMsg    string
+		expectedStatus int
+		webhookListErr error
+		expectErr      require.ErrorAssertionFunc
+		expectResponse require.ValueAssertionFunc
+	}{
+		{
+			name:           "db failure",
+			expectedMsg:    "could not complete webhook request",
+			expectedStatus: http.StatusInternalServerError,
+			webhookListErr: postgres.ErrTransactWebhook,
+			expectErr:      require.Error,
+			expectResponse: require.Nil,
+		}, {
+			name:           "unknown db failure",
+			expectedMsg:    constants.RetryMessageString(),
+			expectedStatus: http.StatusInternalServerError,
+			webhookListErr: errors.New("unknown db failure"),
+			expectErr:      require.Error,
+			expectResponse: require.Nil,
+		}, {
+			name:           "valid",
+			expectedMsg:    "",
+			expectedStatus: 0,
+			webhookListErr: nil,
+			expectErr:      require.NoError,
+			expectResponse: require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			mockPostgres.EXPECT().WebhookList(gomock.Any()).
+				Return([]postgres.Webhook{{Url: "https://example.com/ftex/events"}}, test.webhookListErr).
+				Times(1)
+
+			response, httpMsg, httpCode, err := HTTPWebhookList(mockPostgres, zapLogger, uuid.UUID{})
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectResponse(t, response, "response expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPWebhookDelete(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		webhookID          string
+		expectedMsg        string
+		expectedStatus     int
+		webhookDeleteErr   error
+		webhookDeleteTimes int
+		expectErr          require.ErrorAssertionFunc
+	}{
+		{
+			name:           "invalid webhook ID",
+			webhookID:      "invalid-webhook-id",
+			expectedMsg:    "invalid webhook ID",
+			expectedStatus: http.StatusBadRequest,
+			expectErr:      require.Error,
+		}, {
+			name:               "not found",
+			webhookID:          uuid.Must(uuid.NewV4()).String(),
+			expectedMsg:        "webhook not found",
+			expectedStatus:     http.StatusNotFound,
+			webhookDeleteErr:   postgres.ErrNotFoundWebhook,
+			webhookDeleteTimes: 1,
+			expectErr:          require.Error,
+		}, {
+			name:               "valid",
+			webhookID:          uuid.Must(uuid.NewV4()).String(),
+			expectedMsg:        "",
+			expectedStatus:     0,
+			webhookDeleteTimes: 1,
+			expectErr:          require.NoError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			mockPostgres.EXPECT().WebhookDelete(gomock.Any(), gomock.Any()).
+				Return(test.webhookDeleteErr).
+				Times(test.webhookDeleteTimes)
+
+			httpMsg, httpCode, err := HTTPWebhookDelete(mockPostgres, zapLogger, uuid.UUID{}, test.webhookID)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectedStatus, httpCode, "http codes mismatched.")
+			require.Contains(t, httpMsg, test.expectedMsg, "http message mismatched.")
+		})
+	}
+}
+
+func TestCommon_HTTPWebhookDeadLettersPaginated(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pageCursor  = "some-page-cursor"
+		fourRecords = []postgres.WebhookDeadLetter{{}, {}, {}, {}}
+	)
+
+	testCases := []struct {
+		name               string
+		pageCursor         string
+		pageSize           string
+		expectErrMsg       string
+		isREST             bool
+		httpStatus         int
+		expectedRecordsLen int
+		expectedPageSize   int32
+		expectedOffset     int32
+		decryptString      []byte
+		decryptStringErr   error
+		decryptStringTimes int
+		deadLettersData    []postgres.WebhookDeadLetter
+		deadLettersErr     error
+		deadLettersTimes   int
+		encryptStringErr   error
+		encryptStingTimes  int
+		expectErr          require.ErrorAssertionFunc
+		expectNextPage     require.BoolAssertionFunc
+		expectPageCursor   require.BoolAssertionFunc
+	}{
+		{
+			name:               "bad page size",
+			pageSize:           "bad-page-size",
+			expectErrMsg:       "page size",
+			httpStatus:         http.StatusBadRequest,
+			decryptStringTimes: 0,
+			deadLettersTimes:   0,
+			encryptStingTimes:  0,
+			expectErr:          require.Error,
+		}, {
+			name:               "cursor decryption failure",
+			pageCursor:         pageCursor,
+			pageSize:           "3",
+			expectErrMsg:       "invalid page cursor",
+			httpStatus:         http.StatusBadRequest,
+			decryptStringErr:   errors.New("decrypt failure"),
+			decryptStringTimes: 1,
+			deadLettersTimes:   0,
+			encryptStingTimes:  0,
+			expectErr:          require.Error,
+		}, {
+			name:              "db failure - known error",
+			pageSize:          "3",
+			expectErrMsg:      "not found",
+			httpStatus:        http.StatusNotFound,
+			expectedPageSize:  3,
+			deadLettersErr:    postgres.ErrNotFound,
+			deadLettersTimes:  1,
+			encryptStingTimes: 0,
+			expectErr:         require.Error,
+		}, {
+			name:              "db failure - unknown error",
+			pageSize:          "3",
+			expectErrMsg:      constants.RetryMessageString(),
+			httpStatus:        http.StatusInternalServerError,
+			expectedPageSize:  3,
+			deadLettersErr:    errors.New("unknown db failure"),
+			deadLettersTimes:  1,
+			encryptStingTimes: 0,
+			expectErr:         require.Error,
+		}, {
+			name:              "next page encryption failure",
+			pageSize:          "3",
+			expectErrMsg:      constants.RetryMessageString(),
+			httpStatus:        http.StatusInternalServerError,
+			expectedPageSize:  3,
+			deadLettersData:   fourRecords,
+			deadLettersTimes:  1,
+			encryptStringErr:  errors.New("encrypt failure"),
+			encryptStingTimes: 1,
+			expectErr:         require.Error,
+		}, {
+			name:               "valid - default page size",
+			pageSize:           "0",
+			isREST:             true,
+			expectedRecordsLen: 4,
+			expectedPageSize:   10,
+			deadLettersData:    fourRecords,
+			deadLettersTimes:   1,
+			encryptStingTimes:  0,
+			expectErr:          require.NoError,
+			expectNextPage:     require.False,
+			expectPageCursor:   require.False,
+		}, {
+			name:               "valid - has next page - graphql",
+			pageCursor:         pageCursor,
+			pageSize:           "3",
+			isREST:             false,
+			expectedRecordsLen: 3,
+			expectedPageSize:   3,
+			expectedOffset:     6,
+			decryptString:      []byte("6"),
+			decryptStringTimes: 1,
+			deadLettersData:    fourRecords,
+			deadLettersTimes:   1,
+			encryptStingTimes:  1,
+			expectErr:          require.NoError,
+			expectNextPage:     require.False,
+			expectPageCursor:   require.True,
+		}, {
+			name:               "valid - has next page",
+			pageSize:           "3",
+			isREST:             true,
+			expectedRecordsLen: 3,
+			expectedPageSize:   3,
+			deadLettersData:    fourRecords,
+			deadLettersTimes:   1,
+			encryptStingTimes:  1,
+			expectErr:          require.NoError,
+			expectNextPage:     require.True,
+			expectPageCursor:   require.False,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().DecryptFromString(pageCursor).
+					Return(test.decryptString, test.decryptStringErr).
+					Times(test.decryptStringTimes),
+
+				mockPostgres.EXPECT().WebhookDeadLettersPaginated(gomock.Any(), test.expectedPageSize+1, test.expectedOffset).
+					Return(test.deadLettersData, test.deadLettersErr).
+					Times(test.deadLettersTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("next-page-cursor", test.encryptStringErr).
+					Times(test.encryptStingTimes),
+			)
+
+			actualDeadLetters, status, errMsg, err := HTTPWebhookDeadLettersPaginated(mockAuth, mockPostgres, zapLogger,
+				uuid.UUID{}, test.pageCursor, test.pageSize, test.isREST)
+			test.expectErr(t, err, "error expectation failed.")
+
+			require.Equal(t, test.httpStatus, status, "http status code mismatched.")
+			require.Contains(t, errMsg, test.expectErrMsg, "http error message mismatched.")
+
+			if err != nil {
+				return
+			}
+
+			test.expectNextPage(t, len(actualDeadLetters.Links.NextPage) > 0, "next page link expectation failed.")
+			test.expectPageCursor(t, len(actualDeadLetters.Links.PageCursor) > 0, "page cursor expectation failed.")
+			require.Len(t, actualDeadLetters.DeadLetters, test.expectedRecordsLen, "number of returned records mismatched")
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package configloader
 
 import (
+	"time"
+
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/afero"
 	"github.com/spf13/viper"
 	"github.com/surahman/FTeX/pkg/constants"
@@ -23,7 +26,12 @@ func Load[T any](fs afero.Fs, cfg *T, filename, prefix, format string) (err erro
 		return
 	}
 
-	if err = viper.Unmarshal(cfg); err != nil {
+	// Timestamps are decrypted from SOPS files as strings and must be parsed.
+	if err = viper.Unmarshal(cfg, viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToSliceHookFunc(","),
+		mapstructure.StringToTimeHookFunc(time.RFC3339),
+	))); err != nil {
 		return
 	}
 
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/rs/xid"
 	"github.com/spf13/afero"
@@ -127,3 +128,24 @@ func TestConfigLoader(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigLoader_Timestamps(t *testing.T) {
+	// Timestamps decrypted from SOPS files are quoted strings.
+	input := `
+timestamps:
+  quoted: "2024-01-01T00:00:00Z"
+  unquoted: 2024-01-01T00:00:00Z`
+
+	expected := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	// Configure mock filesystem.
+	fs := afero.NewMemMapFs()
+	require.NoError(t, fs.MkdirAll(constants.EtcDir(), 0644), "Failed to create in memory directory")
+	require.NoError(t, afero.WriteFile(fs, constants.EtcDir()+testFilename, []byte(input), 0644),
+		"Failed to write in memory file")
+
+	actual := &timestampConfig{}
+	require.NoError(t, Load(fs, actual, testFilename, testPrefix, "yaml"), "failed to load timestamps")
+	require.True(t, expected.Equal(actual.Timestamps.Quoted), "quoted timestamp mismatch")
+	require.True(t, expected.Equal(actual.Timestamps.Unquoted), "unquoted timestamp mismatch")
+}
@@ -1,5 +1,7 @@
 package configloader
 
+import "time"
+
 // testConfigTestData is a map of configuration loader test data.
 var testConfigTestData = configTestData()
 
@@ -30,3 +32,14 @@ type ParentLevel2 struct {
 	ChildTwo   bool   `json:"childTwo"   mapstructure:"childTwo"   yaml:"childTwo"`
 	ChildThree string `json:"childThree" mapstructure:"childThree" validate:"min=3" yaml:"childThree"`
 }
+
+// timestampConfig is a test configuration container with timestamps.
+type timestampConfig struct {
+	Timestamps `json:"timestamps" mapstructure:"timestamps" validate:"required" yaml:"timestamps"`
+}
+
+// Timestamps is an embedded test struct.
+type Timestamps struct {
+	Quoted   time.Time `json:"quoted"   mapstructure:"quoted"   validate:"required" yaml:"quoted"`
+	Unquoted time.Time `json:"unquoted" mapstructure:"unquoted" validate:"required" yaml:"unquoted"`
+}
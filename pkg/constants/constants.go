@@ -18,6 +18,7 @@ const (
 	authConfigFileName     = "AuthConfig.yaml"
 	restConfigFileName     = "HTTPRESTConfig.yaml"
 	graphqlConfigFileName  = "HTTPGraphQLConfig.yaml"
+	notifierConfigFileName = "NotifierConfig.yaml"
 
 	// Environment variables.
 	githubCIKey    = "GITHUB_ACTIONS_CI"
@@ -28,6 +29,7 @@ const (
 	authPrefix     = "AUTH"
 	restPrefix     = "REST"
 	graphQLPrefix  = "GRAPHQL"
+	notifierPrefix = "NOTIFIER"
 
 	// Miscellaneous.
 	postgresDSN                   = "user=%s password=%s host=%s port=%d dbname=%s connect_timeout=%d sslmode=disable"
@@ -37,10 +39,61 @@ const (
 	cryptoDecimalPlaces           = int32(8)
 	fiatOfferTTL                  = 2 * time.Minute
 	cryptoOfferTTL                = 2 * time.Minute
+	idempotencyTTL                = 24 * time.Hour
+	idempotencyKeyHeader          = "Idempotency-Key"
+	idempotencyKeyPrefix          = "idempotency-"
+	idempotencyKeyMaxLength       = 255
+	quoteFiatKeyPrefix            = "quote-fiat-"
+	quoteCryptoKeyPrefix          = "quote-crypto-"
+	orderMatcherInterval          = 15 * time.Second
+	orderMatcherBatchSize         = int32(100)
+	orderMatcherStaleInterval     = time.Minute
+	schedulerInterval             = time.Minute
+	schedulerBatchSize            = int32(100)
+	rateHistoryMinInterval        = time.Minute
+	rateHistoryMaxIntervals       = int64(1000)
+	totpPeriod                    = 30 * time.Second
+	totpDigits                    = 6
+	mfaCodeHeader                 = "X-MFA-Code"
+	mfaChallengeKeyPrefix         = "mfa-challenge-"
+	revokedSessionKeyPrefix       = "revoked-session-"
+	revokedClientKeyPrefix        = "revoked-client-"
+	passwordResetKeyPrefix        = "password-reset-"
+	passwordResetTTL              = 15 * time.Minute
+	jwksCacheControl              = "public, max-age=300"
+	apiKeyHeader                  = "X-API-Key"
+	rateLimitKeyPrefix            = "rate-limit-"
+	retryAfterHeader              = "Retry-After"
+	loginFailuresKeyPrefix        = "login-failures-"
+	loginLockoutKeyPrefix         = "login-lockout-"
+	webhookInterval               = 10 * time.Second
+	webhookBatchSize              = int32(100)
+	webhookTimeout                = 5 * time.Second
+	webhookLease                  = time.Minute
+	webhookMaxAttempts            = int32(8)
+	webhookBaseBackoff            = 30 * time.Second
+	webhookMaxBackoff             = 6 * time.Hour
+	webhookMaxPerClient           = int64(5)
+	webhookSignatureHeader        = "X-FTeX-Signature"
+	webhookTimestampHeader        = "X-FTeX-Timestamp"
+	webhookEventIDHeader          = "X-FTeX-Event-ID"
+	webhookEventTypeHeader        = "X-FTeX-Event-Type"
+	relayInterval                 = time.Second
+	relayBatchSize                = int32(100)
+	accountEventsChannelPrefix    = "account-events-"
+	rateTickerChannelPrefix       = "rate-ticker-"
+	subscriptionKeepAlive         = 15 * time.Second
+	eventLogKeyPrefix             = "account-log-"
+	eventLogLength                = int64(1000)
+	eventLogTTL                   = 24 * time.Hour
+	eventStreamHeartbeat          = 15 * time.Second
+	lastEventIDHeader             = "Last-Event-ID"
 	monthFormatString             = "%d-%02d-01T00:00:00%s" // YYYY-MM-DDTHH:MM:SS+HH:MM (last section is +/- timezone.)
 	nextPageRESTFormatString      = "?pageCursor=%s&pageSize=%d"
 	specialAccountFiat            = "fiat-currencies"
 	specialAccountCrypto          = "crypto-currencies"
+	specialAccountFees            = "fee-revenue"
+	basisPointsPerUnit            = int64(10000)
 	invalidRequestString          = "invalid request"
 	validationSting               = "validation"
 	invalidCurrencyString         = "invalid currency"
@@ -170,6 +223,259 @@ func CryptoOfferTTL() time.Duration {
 	return cryptoOfferTTL
 }
 
+// IdempotencyTTL is the time duration that the response to a request made with an idempotency key will be retained.
+func IdempotencyTTL() time.Duration {
+	return idempotencyTTL
+}
+
+// IdempotencyKeyHeader is the HTTP header through which a client may supply an idempotency key.
+func IdempotencyKeyHeader() string {
+	return idempotencyKeyHeader
+}
+
+// IdempotencyKeyPrefix is the prefix for idempotency keys stored in the Redis cache.
+func IdempotencyKeyPrefix() string {
+	return idempotencyKeyPrefix
+}
+
+// IdempotencyKeyMaxLength is the maximum permitted length of a client supplied idempotency key.
+func IdempotencyKeyMaxLength() int {
+	return idempotencyKeyMaxLength
+}
+
+// QuoteFiatKeyPrefix is the prefix for cached Fiat currency price quotes stored in the Redis cache.
+func QuoteFiatKeyPrefix() string {
+	return quoteFiatKeyPrefix
+}
+
+// QuoteCryptoKeyPrefix is the prefix for cached Cryptocurrency price quotes stored in the Redis cache.
+func QuoteCryptoKeyPrefix() string {
+	return quoteCryptoKeyPrefix
+}
+
+// OrderMatcherInterval is the time duration between polls of the open limit orders by the order matcher.
+func OrderMatcherInterval() time.Duration {
+	return orderMatcherInterval
+}
+
+// OrderMatcherBatchSize is the maximum number of open limit orders the order matcher will evaluate per poll.
+func OrderMatcherBatchSize() int32 {
+	return orderMatcherBatchSize
+}
+
+// OrderMatcherStaleInterval is the time duration after which a limit order that is still executing is considered to
+// have been abandoned by the order matcher and is recovered.
+func OrderMatcherStaleInterval() time.Duration {
+	return orderMatcherStaleInterval
+}
+
+// SchedulerInterval is the time duration between polls of the due recurring purchase schedules by the scheduler.
+func SchedulerInterval() time.Duration {
+	return schedulerInterval
+}
+
+// SchedulerBatchSize is the maximum number of due recurring purchase schedules the scheduler will run per poll.
+func SchedulerBatchSize() int32 {
+	return schedulerBatchSize
+}
+
+// RateHistoryMinInterval is the shortest interval the rate history can be bucketed into.
+func RateHistoryMinInterval() time.Duration {
+	return rateHistoryMinInterval
+}
+
+// RateHistoryMaxIntervals is the maximum number of intervals that can be requested in a single rate history request.
+func RateHistoryMaxIntervals() int64 {
+	return rateHistoryMaxIntervals
+}
+
+// TOTPPeriod is the time step duration over which a Time-based One-Time Password code is valid.
+func TOTPPeriod() time.Duration {
+	return totpPeriod
+}
+
+// TOTPDigits is the number of digits in a Time-based One-Time Password code.
+func TOTPDigits() int {
+	return totpDigits
+}
+
+// MFACodeHeader is the HTTP header through which a client supplies a one-time password code for step-up multifactor
+// authentication.
+func MFACodeHeader() string {
+	return mfaCodeHeader
+}
+
+// MFAChallengeKeyPrefix is the prefix for login multifactor authentication challenges stored in the Redis cache.
+func MFAChallengeKeyPrefix() string {
+	return mfaChallengeKeyPrefix
+}
+
+// RevokedSessionKeyPrefix is the prefix for revoked JSON Web Token session IDs stored in the Redis cache.
+func RevokedSessionKeyPrefix() string {
+	return revokedSessionKeyPrefix
+}
+
+// RevokedClientKeyPrefix is the prefix for the time before which all of a client's JSON Web Tokens are revoked, stored
+// in the Redis cache.
+func RevokedClientKeyPrefix() string {
+	return revokedClientKeyPrefix
+}
+
+// PasswordResetKeyPrefix is the prefix for password reset tokens stored in the Redis cache.
+func PasswordResetKeyPrefix() string {
+	return passwordResetKeyPrefix
+}
+
+// PasswordResetTTL is the time duration that a password reset token will be valid for.
+func PasswordResetTTL() time.Duration {
+	return passwordResetTTL
+}
+
+// JWKSCacheControl is the Cache-Control header value that allows the JSON Web Key Set to be cached by verifiers.
+func JWKSCacheControl() string {
+	return jwksCacheControl
+}
+
+// APIKeyHeader is the HTTP header through which a programmatic client supplies an API key in place of a JWT.
+func APIKeyHeader() string {
+	return apiKeyHeader
+}
+
+// RateLimitKeyPrefix is the prefix for the rate limiting token buckets stored in the Redis cache.
+func RateLimitKeyPrefix() string {
+	return rateLimitKeyPrefix
+}
+
+// RetryAfterHeader is the HTTP header through which a client is told how many seconds to wait before retrying a
+// request that was rate limited.
+func RetryAfterHeader() string {
+	return retryAfterHeader
+}
+
+// LoginFailuresKeyPrefix is the prefix for the failed login attempt counters stored in the Redis cache.
+func LoginFailuresKeyPrefix() string {
+	return loginFailuresKeyPrefix
+}
+
+// LoginLockoutKeyPrefix is the prefix for the temporary account lockouts stored in the Redis cache.
+func LoginLockoutKeyPrefix() string {
+	return loginLockoutKeyPrefix
+}
+
+// WebhookInterval is the time duration between polls of the due webhook deliveries by the webhook dispatcher.
+func WebhookInterval() time.Duration {
+	return webhookInterval
+}
+
+// WebhookBatchSize is the maximum number of due webhook deliveries the webhook dispatcher will attempt per poll.
+func WebhookBatchSize() int32 {
+	return webhookBatchSize
+}
+
+// WebhookTimeout is the maximum time duration the webhook dispatcher will wait for a webhook endpoint to respond.
+func WebhookTimeout() time.Duration {
+	return webhookTimeout
+}
+
+// WebhookLease is the time duration a claimed webhook delivery is reserved for before it is due again. Deliveries that
+// are interrupted before their outcome is recorded will be retried once the lease expires.
+func WebhookLease() time.Duration {
+	return webhookLease
+}
+
+// WebhookMaxAttempts is the number of failed delivery attempts after which a webhook delivery is dead-lettered.
+func WebhookMaxAttempts() int32 {
+	return webhookMaxAttempts
+}
+
+// WebhookBaseBackoff is the time duration to wait before retrying a webhook delivery after its first failed attempt.
+// The wait doubles with each subsequent failed attempt.
+func WebhookBaseBackoff() time.Duration {
+	return webhookBaseBackoff
+}
+
+// WebhookMaxBackoff is the maximum time duration to wait before retrying a failed webhook delivery.
+func WebhookMaxBackoff() time.Duration {
+	return webhookMaxBackoff
+}
+
+// WebhookMaxPerClient is the maximum number of webhooks a client can register.
+func WebhookMaxPerClient() int64 {
+	return webhookMaxPerClient
+}
+
+// WebhookSignatureHeader is the HTTP header containing the HMAC-SHA256 signature of a webhook request.
+func WebhookSignatureHeader() string {
+	return webhookSignatureHeader
+}
+
+// WebhookTimestampHeader is the HTTP header containing the Unix timestamp included in the signature of a webhook
+// request.
+func WebhookTimestampHeader() string {
+	return webhookTimestampHeader
+}
+
+// WebhookEventIDHeader is the HTTP header containing the event ID of a webhook request. Endpoints can use it to
+// discard duplicate deliveries.
+func WebhookEventIDHeader() string {
+	return webhookEventIDHeader
+}
+
+// WebhookEventTypeHeader is the HTTP header containing the event type of a webhook request.
+func WebhookEventTypeHeader() string {
+	return webhookEventTypeHeader
+}
+
+// RelayInterval is the time duration between polls of the outbox by the event relay.
+func RelayInterval() time.Duration {
+	return relayInterval
+}
+
+// RelayBatchSize is the maximum number of outbox events the event relay will publish per poll.
+func RelayBatchSize() int32 {
+	return relayBatchSize
+}
+
+// AccountEventsChannelPrefix is the prefix for the Redis channels a client's account events are published to.
+func AccountEventsChannelPrefix() string {
+	return accountEventsChannelPrefix
+}
+
+// RateTickerChannelPrefix is the prefix for the Redis channels a currency pair's fresh price quotes are published to.
+func RateTickerChannelPrefix() string {
+	return rateTickerChannelPrefix
+}
+
+// SubscriptionKeepAlive is the time duration between keep-alive messages sent on idle GraphQL subscriptions.
+func SubscriptionKeepAlive() time.Duration {
+	return subscriptionKeepAlive
+}
+
+// EventLogKeyPrefix is the prefix of the Redis keys that hold the logs of a client's most recent account events.
+func EventLogKeyPrefix() string {
+	return eventLogKeyPrefix
+}
+
+// EventLogLength is the approximate number of a client's most recent account events retained in their event log.
+func EventLogLength() int64 {
+	return eventLogLength
+}
+
+// EventLogTTL is the time duration an event log is retained for after the last account event is appended to it.
+func EventLogTTL() time.Duration {
+	return eventLogTTL
+}
+
+// EventStreamHeartbeat is the time duration between heartbeats sent on idle account event streams.
+func EventStreamHeartbeat() time.Duration {
+	return eventStreamHeartbeat
+}
+
+// LastEventIDHeader is the HTTP header key for the ID of the last account event a client received on an event stream.
+func LastEventIDHeader() string {
+	return lastEventIDHeader
+}
+
 // MonthFormatString is the base RFC3339 format string for a configurable month, year, and timezone.
 func MonthFormatString() string {
 	return monthFormatString
@@ -190,6 +496,16 @@ func HTTPGraphQLPrefix() string {
 	return graphQLPrefix
 }
 
+// NotifierFileName returns the notifier configuration file name.
+func NotifierFileName() string {
+	return notifierConfigFileName
+}
+
+// NotifierPrefix returns the environment variable prefix for the notifier.
+func NotifierPrefix() string {
+	return notifierPrefix
+}
+
 // SpecialAccountFiat special purpose account for Fiat currency related operations in the database.
 func SpecialAccountFiat() string {
 	return specialAccountFiat
@@ -200,6 +516,16 @@ func SpecialAccountCrypto() string {
 	return specialAccountCrypto
 }
 
+// SpecialAccountFees special purpose account for fee revenue collected on exchanges in the database.
+func SpecialAccountFees() string {
+	return specialAccountFees
+}
+
+// BasisPointsPerUnit is the number of basis points in a single unit.
+func BasisPointsPerUnit() int64 {
+	return basisPointsPerUnit
+}
+
 // InvalidRequestString is the error string message for an invalid request.
 func InvalidRequestString() string {
 	return invalidRequestString
@@ -99,6 +99,259 @@ func TestCryptoOfferTTL(t *testing.T) {
 	require.Equal(t, cryptoOfferTTL, CryptoOfferTTL(), "Incorrect Crypto offer TTL.")
 }
 
+func TestIdempotencyTTL(t *testing.T) {
+	require.Equal(t, idempotencyTTL, IdempotencyTTL(), "Incorrect idempotency TTL.")
+}
+
+func TestIdempotencyKeyHeader(t *testing.T) {
+	require.Equal(t, idempotencyKeyHeader, IdempotencyKeyHeader(), "Incorrect idempotency key header.")
+}
+
+func TestIdempotencyKeyPrefix(t *testing.T) {
+	require.Equal(t, idempotencyKeyPrefix, IdempotencyKeyPrefix(), "Incorrect idempotency key prefix.")
+}
+
+func TestIdempotencyKeyMaxLength(t *testing.T) {
+	require.Equal(t, idempotencyKeyMaxLength, IdempotencyKeyMaxLength(), "Incorrect idempotency key max length.")
+}
+
+func TestQuoteFiatKeyPrefix(t *testing.T) {
+	require.Equal(t, quoteFiatKeyPrefix, QuoteFiatKeyPrefix(), "Incorrect Fiat quote key prefix.")
+}
+
+func TestQuoteCryptoKeyPrefix(t *testing.T) {
+	require.Equal(t, quoteCryptoKeyPrefix, QuoteCryptoKeyPrefix(), "Incorrect Crypto quote key prefix.")
+}
+
+func TestOrderMatcherInterval(t *testing.T) {
+	require.Equal(t, orderMatcherInterval, OrderMatcherInterval(), "Incorrect order matcher interval.")
+}
+
+func TestOrderMatcherBatchSize(t *testing.T) {
+	require.Equal(t, orderMatcherBatchSize, OrderMatcherBatchSize(), "Incorrect order matcher batch size.")
+}
+
+func TestOrderMatcherStaleInterval(t *testing.T) {
+	require.Equal(t, orderMatcherStaleInterval, OrderMatcherStaleInterval(), "Incorrect order matcher stale interval.")
+}
+
+func TestSchedulerInterval(t *testing.T) {
+	require.Equal(t, schedulerInterval, SchedulerInterval(), "Incorrect scheduler interval.")
+}
+
+func TestSchedulerBatchSize(t *testing.T) {
+	require.Equal(t, schedulerBatchSize, SchedulerBatchSize(), "Incorrect scheduler batch size.")
+}
+
+func TestRateHistoryMinInterval(t *testing.T) {
+	require.Equal(t, rateHistoryMinInterval, RateHistoryMinInterval(), "Incorrect rate history minimum interval.")
+}
+
+func TestRateHistoryMaxIntervals(t *testing.T) {
+	require.Equal(t, rateHistoryMaxIntervals, RateHistoryMaxIntervals(), "Incorrect rate history maximum intervals.")
+}
+
+func TestTOTPPeriod(t *testing.T) {
+	require.Equal(t, totpPeriod, TOTPPeriod(), "Incorrect TOTP period.")
+}
+
+func TestTOTPDigits(t *testing.T) {
+	require.Equal(t, totpDigits, TOTPDigits(), "Incorrect TOTP digits.")
+}
+
+func TestMFACodeHeader(t *testing.T) {
+	require.Equal(t, mfaCodeHeader, MFACodeHeader(), "Incorrect MFA code header.")
+}
+
+func TestMFAChallengeKeyPrefix(t *testing.T) {
+	require.Equal(t, mfaChallengeKeyPrefix, MFAChallengeKeyPrefix(), "Incorrect MFA challenge key prefix.")
+}
+
+func TestRevokedSessionKeyPrefix(t *testing.T) {
+	require.Equal(t, revokedSessionKeyPrefix, RevokedSessionKeyPrefix(), "Incorrect revoked session key prefix.")
+}
+
+func TestRevokedClientKeyPrefix(t *testing.T) {
+	require.Equal(t, revokedClientKeyPrefix, RevokedClientKeyPrefix(), "Incorrect revoked client key prefix.")
+}
+
+func TestPasswordResetKeyPrefix(t *testing.T) {
+	require.Equal(t, passwordResetKeyPrefix, PasswordResetKeyPrefix(), "Incorrect password reset key prefix.")
+}
+
+func TestPasswordResetTTL(t *testing.T) {
+	require.Equal(t, passwordResetTTL, PasswordResetTTL(), "Incorrect password reset TTL.")
+}
+
+func TestJWKSCacheControl(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, jwksCacheControl, JWKSCacheControl(), "Incorrect JWKS cache control.")
+}
+
+func TestAPIKeyHeader(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, apiKeyHeader, APIKeyHeader(), "Incorrect API key header.")
+}
+
+func TestRateLimitKeyPrefix(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, rateLimitKeyPrefix, RateLimitKeyPrefix(), "Incorrect rate limit key prefix.")
+}
+
+func TestRetryAfterHeader(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, retryAfterHeader, RetryAfterHeader(), "Incorrect Retry-After header.")
+}
+
+func TestLoginFailuresKeyPrefix(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, loginFailuresKeyPrefix, LoginFailuresKeyPrefix(), "Incorrect login failures key prefix.")
+}
+
+func TestLoginLockoutKeyPrefix(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, loginLockoutKeyPrefix, LoginLockoutKeyPrefix(), "Incorrect login lockout key prefix.")
+}
+
+func TestWebhookInterval(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, webhookInterval, WebhookInterval(), "Incorrect webhook interval.")
+}
+
+func TestWebhookBatchSize(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, webhookBatchSize, WebhookBatchSize(), "Incorrect webhook batch size.")
+}
+
+func TestWebhookTimeout(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, webhookTimeout, WebhookTimeout(), "Incorrect webhook timeout.")
+}
+
+func TestWebhookLease(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, webhookLease, WebhookLease(), "Incorrect webhook lease.")
+}
+
+func TestWebhookMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, webhookMaxAttempts, WebhookMaxAttempts(), "Incorrect webhook max attempts.")
+}
+
+func TestWebhookBaseBackoff(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, webhookBaseBackoff, WebhookBaseBackoff(), "Incorrect webhook base backoff.")
+}
+
+func TestWebhookMaxBackoff(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, webhookMaxBackoff, WebhookMaxBackoff(), "Incorrect webhook max backoff.")
+}
+
+func TestWebhookMaxPerClient(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, webhookMaxPerClient, WebhookMaxPerClient(), "Incorrect webhook max per client.")
+}
+
+func TestWebhookSignatureHeader(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, webhookSignatureHeader, WebhookSignatureHeader(), "Incorrect webhook signature header.")
+}
+
+func TestWebhookTimestampHeader(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, webhookTimestampHeader, WebhookTimestampHeader(), "Incorrect webhook timestamp header.")
+}
+
+func TestWebhookEventIDHeader(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, webhookEventIDHeader, WebhookEventIDHeader(), "Incorrect webhook event ID header.")
+}
+
+func TestWebhookEventTypeHeader(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, webhookEventTypeHeader, WebhookEventTypeHeader(), "Incorrect webhook event type header.")
+}
+
+func TestRelayInterval(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, relayInterval, RelayInterval(), "Incorrect relay interval.")
+}
+
+func TestRelayBatchSize(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, relayBatchSize, RelayBatchSize(), "Incorrect relay batch size.")
+}
+
+func TestAccountEventsChannelPrefix(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, accountEventsChannelPrefix, AccountEventsChannelPrefix(),
+		"Incorrect account events channel prefix.")
+}
+
+func TestRateTickerChannelPrefix(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, rateTickerChannelPrefix, RateTickerChannelPrefix(), "Incorrect rate ticker channel prefix.")
+}
+
+func TestSubscriptionKeepAlive(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, subscriptionKeepAlive, SubscriptionKeepAlive(), "Incorrect subscription keep-alive.")
+}
+
+func TestEventLogKeyPrefix(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, eventLogKeyPrefix, EventLogKeyPrefix(), "Incorrect event log key prefix.")
+}
+
+func TestEventLogLength(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, eventLogLength, EventLogLength(), "Incorrect event log length.")
+}
+
+func TestEventLogTTL(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, eventLogTTL, EventLogTTL(), "Incorrect event log TTL.")
+}
+
+func TestEventStreamHeartbeat(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, eventStreamHeartbeat, EventStreamHeartbeat(), "Incorrect event stream heartbeat.")
+}
+
+func TestLastEventIDHeader(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, lastEventIDHeader, LastEventIDHeader(), "Incorrect Last-Event-ID header.")
+}
+
 func TestMonthFormatString(t *testing.T) {
 	t.Parallel()
 
@@ -162,6 +415,14 @@ func TestHTTPGraphQLPrefix(t *testing.T) {
 	require.Equal(t, graphQLPrefix, HTTPGraphQLPrefix(), "Incorrect HTTP GraphQL environment prefix.")
 }
 
+func TestNotifierFileName(t *testing.T) {
+	require.Equal(t, notifierConfigFileName, NotifierFileName(), "Incorrect notifier filename.")
+}
+
+func TestNotifierPrefix(t *testing.T) {
+	require.Equal(t, notifierPrefix, NotifierPrefix(), "Incorrect notifier environment prefix.")
+}
+
 func TestSpecialAccountFiat(t *testing.T) {
 	require.Equal(t, specialAccountFiat, SpecialAccountFiat(), "Incorrect Fiat currency account name.")
 }
@@ -170,6 +431,14 @@ func TestSpecialAccountCrypto(t *testing.T) {
 	require.Equal(t, specialAccountCrypto, SpecialAccountCrypto(), "Incorrect Cryptocurrency account name.")
 }
 
+func TestSpecialAccountFees(t *testing.T) {
+	require.Equal(t, specialAccountFees, SpecialAccountFees(), "Incorrect fee revenue account name.")
+}
+
+func TestBasisPointsPerUnit(t *testing.T) {
+	require.Equal(t, basisPointsPerUnit, BasisPointsPerUnit(), "Incorrect basis points per unit.")
+}
+
 func TestInvalidRequest(t *testing.T) {
 	require.Equal(t, invalidRequestString, InvalidRequestString(), "Incorrect invalid request string.")
 }
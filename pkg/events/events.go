@@ -0,0 +1,118 @@
+package events
+
+import (
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// Event is an account event that has been committed to the transactional outbox and relayed to a client's live event
+// streams.
+type Event struct {
+	EventID   uuid.UUID                `json:"eventId"`
+	EventType postgres.OutboxEventType `json:"eventType"`
+	ClientID  uuid.UUID                `json:"clientId"`
+	CreatedAt time.Time                `json:"createdAt"`
+	Payload   json.RawMessage          `json:"payload"`
+}
+
+// RateTick is a fresh price quote for a currency pair that has been retrieved from a price quote provider.
+type RateTick struct {
+	Source      string          `json:"source"`
+	Destination string          `json:"destination"`
+	Rate        decimal.Decimal `json:"rate"`
+	QuotedAt    time.Time       `json:"quotedAt"`
+}
+
+// payloadCurrencies contains the currency fields found across the account event payloads. Fiat exchanges contain the
+// receipts for both of the accounts involved and Cryptocurrency swaps contain both of the tickers.
+type payloadCurrencies struct {
+	Currency          string `json:"currency"`
+	FiatCurrency      string `json:"fiatCurrency"`
+	Ticker            string `json:"ticker"`
+	SourceTicker      string `json:"sourceTicker"`
+	DestinationTicker string `json:"destinationTicker"`
+	Source            *struct {
+		Currency string `json:"currency"`
+	} `json:"source"`
+	Destination *struct {
+		Currency string `json:"currency"`
+	} `json:"destination"`
+}
+
+// Currencies will extract the Fiat currencies and Cryptocurrencies whose balances were changed by an account event.
+// Malformed payloads are reported as not changing any balances.
+func (e *Event) Currencies() []string {
+	var (
+		fields     payloadCurrencies
+		currencies []string
+	)
+
+	if err := json.Unmarshal(e.Payload, &fields); err != nil {
+		return nil
+	}
+
+	candidates := []string{
+		fields.Currency, fields.FiatCurrency, fields.Ticker, fields.SourceTicker, fields.DestinationTicker,
+	}
+
+	if fields.Source != nil {
+		candidates = append(candidates, fields.Source.Currency)
+	}
+
+	if fields.Destination != nil {
+		candidates = append(candidates, fields.Destination.Currency)
+	}
+
+	for _, currency := range candidates {
+		if currency != "" && !slices.Contains(currencies, currency) {
+			currencies = append(currencies, currency)
+		}
+	}
+
+	return currencies
+}
+
+// AffectsCurrency will check whether an account event changed the balance of a Fiat currency or Cryptocurrency account.
+func (e *Event) AffectsCurrency(currency string) bool {
+	return slices.Contains(e.Currencies(), strings.ToUpper(currency))
+}
+
+// AccountEventsChannel will generate the name of the Redis channel a client's account events are published to.
+func AccountEventsChannel(clientID uuid.UUID) string {
+	return constants.AccountEventsChannelPrefix() + clientID.String()
+}
+
+// AccountEventsLog will generate the Redis key of the log that retains a client's most recent account events.
+func AccountEventsLog(clientID uuid.UUID) string {
+	return constants.EventLogKeyPrefix() + clientID.String()
+}
+
+// ValidLogID will check whether an ID is formatted as an event log ID, which consists of the millisecond timestamp and
+// sequence number at which the account event was appended.
+func ValidLogID(id string) bool {
+	timestamp, sequence, found := strings.Cut(id, "-")
+	if !found {
+		return false
+	}
+
+	if _, err := strconv.ParseUint(timestamp, 10, 64); err != nil {
+		return false
+	}
+
+	_, err := strconv.ParseUint(sequence, 10, 64)
+
+	return err == nil
+}
+
+// RateTickerChannel will generate the name of the Redis channel a currency pair's fresh price quotes are published to.
+func RateTickerChannel(source, destination string) string {
+	return constants.RateTickerChannelPrefix() + strings.ToUpper(source) + "-" + strings.ToUpper(destination)
+}
@@ -0,0 +1,116 @@
+package events
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+)
+
+func TestEvents_Currencies(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		payload  string
+		expected []string
+	}{
+		{
+			name:     "malformed",
+			payload:  `{"currency":`,
+			expected: nil,
+		}, {
+			name:     "no currencies",
+			payload:  `{"txId":"b1c2d3"}`,
+			expected: nil,
+		}, {
+			name:     "fiat deposit",
+			payload:  `{"currency":"USD","balance":"100.00"}`,
+			expected: []string{"USD"},
+		}, {
+			name:     "fiat exchange",
+			payload:  `{"source":{"currency":"USD"},"destination":{"currency":"CAD"}}`,
+			expected: []string{"USD", "CAD"},
+		}, {
+			name:     "crypto purchase",
+			payload:  `{"fiatCurrency":"USD","ticker":"BTC"}`,
+			expected: []string{"USD", "BTC"},
+		}, {
+			name:     "crypto swap",
+			payload:  `{"sourceTicker":"BTC","destinationTicker":"ETH"}`,
+			expected: []string{"BTC", "ETH"},
+		}, {
+			name:     "fiat deposit reversal",
+			payload:  `{"currency":"USD","reversedTxId":"b1c2d3"}`,
+			expected: []string{"USD"},
+		}, {
+			name:     "duplicates",
+			payload:  `{"source":{"currency":"USD"},"destination":{"currency":"USD"}}`,
+			expected: []string{"USD"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			event := Event{Payload: json.RawMessage(test.payload)}
+			require.Equal(t, test.expected, event.Currencies(), "currencies mismatch.")
+		})
+	}
+}
+
+func TestEvents_AffectsCurrency(t *testing.T) {
+	t.Parallel()
+
+	event := Event{Payload: json.RawMessage(`{"fiatCurrency":"USD","ticker":"BTC"}`)}
+
+	require.True(t, event.AffectsCurrency("USD"), "Fiat currency should be affected.")
+	require.True(t, event.AffectsCurrency("btc"), "currency matching should be case-insensitive.")
+	require.False(t, event.AffectsCurrency("CAD"), "unrelated currency should not be affected.")
+}
+
+func TestEvents_Channels(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.Must(uuid.NewV4())
+
+	require.Equal(t, constants.AccountEventsChannelPrefix()+clientID.String(), AccountEventsChannel(clientID),
+		"account events channel mismatch.")
+	require.Equal(t, constants.RateTickerChannelPrefix()+"BTC-USD", RateTickerChannel("btc", "Usd"),
+		"rate ticker channel mismatch.")
+	require.Equal(t, constants.EventLogKeyPrefix()+clientID.String(), AccountEventsLog(clientID),
+		"account events log mismatch.")
+}
+
+func TestEvents_ValidLogID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		id       string
+		expected bool
+	}{
+		{name: "empty", id: "", expected: false},
+		{name: "no sequence", id: "1686400000000", expected: false},
+		{name: "negative", id: "-1-0", expected: false},
+		{name: "uuid", id: "0b6e2f5b-7b9c-4e0c-9a39-5a1f6f1b6c2e", expected: false},
+		{name: "special", id: "$", expected: false},
+		{name: "start", id: "0-0", expected: true},
+		{name: "valid", id: "1686400000000-3", expected: true},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.expected, ValidLogID(test.id), "log ID validation mismatch.")
+		})
+	}
+}
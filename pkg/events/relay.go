@@ -0,0 +1,97 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/poller"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+	"go.uber.org/zap"
+)
+
+// Relay will periodically poll the transactional outbox and publish the committed account events to the Redis channels
+// of the clients they occurred on. Events are also appended to the clients' bounded event logs so that event streams can
+// be resumed. Events are relayed at least once, and clients should use the event IDs to discard duplicates.
+type Relay struct {
+	db     postgres.Postgres
+	cache  redis.Redis
+	logger *logger.Logger
+	wg     *sync.WaitGroup
+}
+
+// NewRelay will create a new event relay instance in a non-running state.
+func NewRelay(db postgres.Postgres, cache redis.Redis, logger *logger.Logger, wg *sync.WaitGroup) (*Relay, error) {
+	if db == nil || cache == nil || logger == nil || wg == nil {
+		return nil, errors.New("nil database, cache, logger, or wait group supplied")
+	}
+
+	return &Relay{db: db, cache: cache, logger: logger, wg: wg}, nil
+}
+
+// Run will poll and relay the outbox events until a shutdown signal is received.
+func (r *Relay) Run() {
+	poller.Run("Event relay", constants.RelayInterval(), r.logger, r.wg, r.poll)
+}
+
+// poll will claim a batch of the oldest unrelayed outbox events and publish them in order. If an event cannot be
+// published, it and the remainder of the batch are released to be claimed again on the next poll so that events are not
+// published out of order.
+func (r *Relay) poll() {
+	claimed, err := r.db.OutboxUnrelayed(constants.RelayBatchSize())
+	if err != nil {
+		r.logger.Warn("failed to retrieve unrelayed outbox events", zap.Error(err))
+
+		return
+	}
+
+	for idx := range claimed {
+		if err = r.publish(&claimed[idx]); err != nil {
+			r.logger.Warn("failed to relay outbox event, releasing remaining events",
+				zap.String("eventID", claimed[idx].EventID.String()), zap.Error(err))
+
+			r.release(claimed[idx:])
+
+			return
+		}
+	}
+}
+
+// publish will append an outbox event to the event log of the client it occurred on and send it to their Redis channel.
+func (r *Relay) publish(outbox *postgres.Outbox) error {
+	message, err := json.Marshal(&Event{
+		EventID:   outbox.EventID,
+		EventType: outbox.EventType,
+		ClientID:  outbox.ClientID,
+		CreatedAt: outbox.CreatedAt.Time.UTC(),
+		Payload:   outbox.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf(constants.ErrorFormatMessage(), "failed to serialize event", err)
+	}
+
+	if _, err = r.cache.AppendLog(AccountEventsLog(outbox.ClientID), constants.EventLogLength(),
+		constants.EventLogTTL(), message); err != nil {
+		return fmt.Errorf(constants.ErrorFormatMessage(), "failed to append event to log", err)
+	}
+
+	if err = r.cache.Publish(AccountEventsChannel(outbox.ClientID), message); err != nil {
+		return fmt.Errorf(constants.ErrorFormatMessage(), "failed to publish event", err)
+	}
+
+	return nil
+}
+
+// release will return outbox events that could not be relayed so that they will be claimed again.
+func (r *Relay) release(events []postgres.Outbox) {
+	for idx := range events {
+		if err := r.db.OutboxReleaseRelay(events[idx].EventID); err != nil {
+			r.logger.Error("failed to release outbox event",
+				zap.String("eventID", events[idx].EventID.String()), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestRelay_NewRelay(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var waitGroup sync.WaitGroup
+
+	relay, err := NewRelay(mocks.NewMockPostgres(mockCtrl), nil, zapLogger, &waitGroup)
+	require.Error(t, err, "created relay with nil cache.")
+	require.Nil(t, relay, "relay returned with nil cache.")
+
+	relay, err = NewRelay(mocks.NewMockPostgres(mockCtrl), mocks.NewMockRedis(mockCtrl), zapLogger, &waitGroup)
+	require.NoError(t, err, "failed to create relay.")
+	require.NotNil(t, relay, "nil relay returned.")
+}
+
+func TestRelay_Poll(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.Must(uuid.NewV4())
+	createdAt := time.Now().Truncate(time.Microsecond).UTC()
+
+	claimed := []postgres.Outbox{
+		{
+			EventID:   uuid.Must(uuid.NewV4()),
+			ClientID:  clientID,
+			EventType: postgres.OutboxEventTypeFiatDeposit,
+			Payload:   []byte(`{"currency":"USD"}`),
+			CreatedAt: pgtype.Timestamptz{Time: createdAt, Valid: true},
+		}, {
+			EventID:   uuid.Must(uuid.NewV4()),
+			ClientID:  clientID,
+			EventType: postgres.OutboxEventTypeCryptoPurchase,
+			Payload:   []byte(`{"fiatCurrency":"USD","ticker":"BTC"}`),
+			CreatedAt: pgtype.Timestamptz{Time: createdAt, Valid: true},
+		}, {
+			EventID:   uuid.Must(uuid.NewV4()),
+			ClientID:  clientID,
+			EventType: postgres.OutboxEventTypeCryptoSale,
+			Payload:   []byte(`{"fiatCurrency":"USD","ticker":"ETH"}`),
+			CreatedAt: pgtype.Timestamptz{Time: createdAt, Valid: true},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		claimErr      error
+		appendErrs    []error
+		appendTimes   int
+		publishErrs   []error
+		publishTimes  int
+		releaseEvents []postgres.Outbox
+	}{
+		{
+			name:         "claim failure",
+			claimErr:     postgres.ErrTransactOutbox,
+			publishTimes: 0,
+		}, {
+			name:          "append failure",
+			appendErrs:    []error{nil, errors.New("append failure")},
+			appendTimes:   2,
+			publishErrs:   []error{nil},
+			publishTimes:  1,
+			releaseEvents: claimed[1:],
+		}, {
+			name:          "publish failure",
+			appendErrs:    []error{nil, nil},
+			appendTimes:   2,
+			publishErrs:   []error{nil, errors.New("publish failure")},
+			publishTimes:  2,
+			releaseEvents: claimed[1:],
+		}, {
+			name:         "valid",
+			appendErrs:   []error{nil, nil, nil},
+			appendTimes:  3,
+			publishErrs:  []error{nil, nil, nil},
+			publishTimes: 3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+
+			var published []Event
+
+			mockDB.EXPECT().OutboxUnrelayed(constants.RelayBatchSize()).Return(claimed, test.claimErr).Times(1)
+
+			appendCall := 0
+			mockCache.EXPECT().AppendLog(AccountEventsLog(clientID), constants.EventLogLength(),
+				constants.EventLogTTL(), gomock.Any()).
+				DoAndReturn(func(_ string, _ int64, _ time.Duration, message []byte) (string, error) {
+					var event Event
+					require.NoError(t, json.Unmarshal(message, &event), "failed to unmarshal logged event.")
+					require.Equal(t, claimed[appendCall].EventID, event.EventID, "events logged out of order.")
+
+					err := test.appendErrs[appendCall]
+					appendCall++
+
+					return "1-0", err
+				}).
+				Times(test.appendTimes)
+
+			publishCall := 0
+			mockCache.EXPECT().Publish(AccountEventsChannel(clientID), gomock.Any()).
+				DoAndReturn(func(_ string, message []byte) error {
+					var event Event
+					require.NoError(t, json.Unmarshal(message, &event), "failed to unmarshal published event.")
+					published = append(published, event)
+
+					err := test.publishErrs[publishCall]
+					publishCall++
+
+					return err
+				}).
+				Times(test.publishTimes)
+
+			for _, released := range test.releaseEvents {
+				mockDB.EXPECT().OutboxReleaseRelay(released.EventID).Return(nil).Times(1)
+			}
+
+			relay := &Relay{db: mockDB, cache: mockCache, logger: zapLogger}
+			relay.poll()
+
+			require.Len(t, published, test.publishTimes, "published event count mismatch.")
+
+			for idx, event := range published {
+				require.Equal(t, claimed[idx].EventID, event.EventID, "events published out of order.")
+				require.Equal(t, claimed[idx].EventType, event.EventType, "event type mismatch.")
+				require.Equal(t, createdAt, event.CreatedAt, "created at mismatch.")
+				require.JSONEq(t, string(claimed[idx].Payload), string(event.Payload), "payload mismatch.")
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/afero"
 	"github.com/surahman/FTeX/pkg/configloader"
 	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/models"
 )
 
 // config is the configuration container for the HTTP REST endpoint.
@@ -15,20 +16,22 @@ import (
 type config struct {
 	Server        serverConfig        `json:"server,omitempty"        mapstructure:"server"        validate:"required" yaml:"server,omitempty"`
 	Authorization authorizationConfig `json:"authorization,omitempty" mapstructure:"authorization" validate:"required" yaml:"authorization,omitempty"`
+	RateLimits    rateLimitsConfig    `json:"rateLimits,omitempty"    mapstructure:"rateLimits"    validate:"required" yaml:"rateLimits,omitempty"`
 }
 
 // serverConfig contains the configurations for the HTTP REST server.
 //
 //nolint:lll
 type serverConfig struct {
-	BasePath          string        `json:"basePath,omitempty"          mapstructure:"basePath"          validate:"required"          yaml:"basePath,omitempty"`
-	PlaygroundPath    string        `json:"playgroundPath,omitempty"    mapstructure:"playgroundPath"    validate:"required"          yaml:"playgroundPath,omitempty"`
-	QueryPath         string        `json:"queryPath,omitempty"         mapstructure:"queryPath"         validate:"required"          yaml:"queryPath,omitempty"`
-	PortNumber        int           `json:"portNumber,omitempty"        mapstructure:"portNumber"        validate:"required,min=1000" yaml:"portNumber,omitempty"`
-	ShutdownDelay     time.Duration `json:"shutdownDelay,omitempty"     mapstructure:"shutdownDelay"     validate:"required,min=0"    yaml:"shutdownDelay,omitempty"`
-	ReadTimeout       time.Duration `json:"readTimeout,omitempty"       mapstructure:"readTimeout"       validate:"required,min=1"    yaml:"readTimeout,omitempty"`
-	WriteTimeout      time.Duration `json:"writeTimeout,omitempty"      mapstructure:"writeTimeout"      validate:"required,min=1"    yaml:"writeTimeout,omitempty"`
-	ReadHeaderTimeout time.Duration `json:"readHeaderTimeout,omitempty" mapstructure:"readHeaderTimeout" validate:"required,min=1"    yaml:"readHeaderTimeout,omitempty"`
+	BasePath          string        `json:"basePath,omitempty"          mapstructure:"basePath"          validate:"required"               yaml:"basePath,omitempty"`
+	PlaygroundPath    string        `json:"playgroundPath,omitempty"    mapstructure:"playgroundPath"    validate:"required"               yaml:"playgroundPath,omitempty"`
+	QueryPath         string        `json:"queryPath,omitempty"         mapstructure:"queryPath"         validate:"required"               yaml:"queryPath,omitempty"`
+	PortNumber        int           `json:"portNumber,omitempty"        mapstructure:"portNumber"        validate:"required,min=1000"      yaml:"portNumber,omitempty"`
+	ShutdownDelay     time.Duration `json:"shutdownDelay,omitempty"     mapstructure:"shutdownDelay"     validate:"required,min=0"         yaml:"shutdownDelay,omitempty"`
+	ReadTimeout       time.Duration `json:"readTimeout,omitempty"       mapstructure:"readTimeout"       validate:"required,min=1"         yaml:"readTimeout,omitempty"`
+	WriteTimeout      time.Duration `json:"writeTimeout,omitempty"      mapstructure:"writeTimeout"      validate:"required,min=1"         yaml:"writeTimeout,omitempty"`
+	ReadHeaderTimeout time.Duration `json:"readHeaderTimeout,omitempty" mapstructure:"readHeaderTimeout" validate:"required,min=1"         yaml:"readHeaderTimeout,omitempty"`
+	TrustedProxies    []string      `json:"trustedProxies,omitempty"    mapstructure:"trustedProxies"    validate:"omitempty,dive,ip|cidr" yaml:"trustedProxies,omitempty"`
 }
 
 // authorizationConfig contains the configurations for request authorization.
@@ -36,6 +39,16 @@ type authorizationConfig struct {
 	HeaderKey string `json:"headerKey,omitempty" mapstructure:"headerKey" validate:"required" yaml:"headerKey,omitempty"`
 }
 
+// rateLimitsConfig contains the token bucket rate limits for the operations in GraphQL requests. Requests are throttled
+// by IP address and by client. Price quote mutations are throttled separately from the other mutations.
+//
+//nolint:lll
+type rateLimitsConfig struct {
+	Queries   models.RateLimit `json:"queries,omitempty"   mapstructure:"queries"   validate:"required" yaml:"queries,omitempty"`
+	Mutations models.RateLimit `json:"mutations,omitempty" mapstructure:"mutations" validate:"required" yaml:"mutations,omitempty"`
+	Quotes    models.RateLimit `json:"quotes,omitempty"    mapstructure:"quotes"    validate:"required" yaml:"quotes,omitempty"`
+}
+
 // newConfig creates a blank configuration struct for the authorization.
 func newConfig() *config {
 	return &config{}
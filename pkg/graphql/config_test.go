@@ -4,6 +4,7 @@ import (
 	"errors"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 func TestGraphQLConfigs_Load(t *testing.T) {
 	keyspaceServer := constants.HTTPGraphQLPrefix() + "_SERVER."
 	keyspaceAuth := constants.HTTPGraphQLPrefix() + "_AUTHORIZATION."
+	keyspaceRateLimits := constants.HTTPGraphQLPrefix() + "_RATELIMITS."
 
 	testCases := []struct {
 		name         string
@@ -29,7 +31,7 @@ func TestGraphQLConfigs_Load(t *testing.T) {
 			name:         "empty - etc dir",
 			input:        graphQLConfigTestData["empty"],
 			expectErr:    require.Error,
-			expectErrCnt: 9,
+			expectErrCnt: 18,
 		}, {
 			name:         "valid - etc dir",
 			input:        graphQLConfigTestData["valid"],
@@ -80,6 +82,21 @@ func TestGraphQLConfigs_Load(t *testing.T) {
 			input:        graphQLConfigTestData["no auth header"],
 			expectErr:    require.Error,
 			expectErrCnt: 1,
+		}, {
+			name:         "out of range rate limit - etc dir",
+			input:        graphQLConfigTestData["out of range rate limit"],
+			expectErr:    require.Error,
+			expectErrCnt: 3,
+		}, {
+			name:         "no rate limits - etc dir",
+			input:        graphQLConfigTestData["no rate limits"],
+			expectErr:    require.Error,
+			expectErrCnt: 9,
+		}, {
+			name:         "invalid trusted proxy - etc dir",
+			input:        graphQLConfigTestData["invalid trusted proxy"],
+			expectErr:    require.Error,
+			expectErrCnt: 1,
 		},
 	}
 
@@ -120,6 +137,10 @@ func TestGraphQLConfigs_Load(t *testing.T) {
 			readTimeout := time.Duration(4)
 			writeTimeout := time.Duration(5)
 			readHeaderTimeout := time.Duration(7)
+			trustedProxies := []string{"192.168.0.0/16", "172.16.0.1"}
+			quotesRequests := int64(42)
+			quotesPeriod := 3 * time.Minute
+			quotesBurst := int64(7)
 
 			t.Setenv(keyspaceServer+"BASEPATH", basePath)
 			t.Setenv(keyspaceServer+"PLAYGROUNDPATH", playgroundPath)
@@ -129,7 +150,11 @@ func TestGraphQLConfigs_Load(t *testing.T) {
 			t.Setenv(keyspaceServer+"READTIMEOUT", readTimeout.String())
 			t.Setenv(keyspaceServer+"WRITETIMEOUT", writeTimeout.String())
 			t.Setenv(keyspaceServer+"READHEADERTIMEOUT", readHeaderTimeout.String())
+			t.Setenv(keyspaceServer+"TRUSTEDPROXIES", strings.Join(trustedProxies, ","))
 			t.Setenv(keyspaceAuth+"HEADERKEY", headerKey)
+			t.Setenv(keyspaceRateLimits+"QUOTES.REQUESTS", strconv.FormatInt(quotesRequests, 10))
+			t.Setenv(keyspaceRateLimits+"QUOTES.PERIOD", quotesPeriod.String())
+			t.Setenv(keyspaceRateLimits+"QUOTES.BURST", strconv.FormatInt(quotesBurst, 10))
 
 			err = actual.Load(fs)
 			require.NoErrorf(t, err, "Failed to load constants file: %v", err)
@@ -148,8 +173,16 @@ func TestGraphQLConfigs_Load(t *testing.T) {
 				"failed to load write timeout environment variable into configs")
 			require.Equal(t, readHeaderTimeout, actual.Server.ReadHeaderTimeout,
 				"failed to load read header timeout environment variable into configs")
+			require.Equal(t, trustedProxies, actual.Server.TrustedProxies,
+				"failed to load trusted proxies environment variable into configs")
 			require.Equal(t, headerKey, actual.Authorization.HeaderKey,
 				"Failed to load authorization header key environment variable into configs")
+			require.Equal(t, quotesRequests, actual.RateLimits.Quotes.Requests,
+				"Failed to load quotes rate limit requests environment variable into configs")
+			require.Equal(t, quotesPeriod, actual.RateLimits.Quotes.Period,
+				"Failed to load quotes rate limit period environment variable into configs")
+			require.Equal(t, quotesBurst, actual.RateLimits.Quotes.Burst,
+				"Failed to load quotes rate limit burst environment variable into configs")
 		})
 	}
 }
@@ -39,7 +39,7 @@ func (ec *executionContext) _JWTAuthResponse_token(ctx context.Context, field gr
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return obj.Token, nil
 	})
@@ -83,7 +83,7 @@ func (ec *executionContext) _JWTAuthResponse_expires(ctx context.Context, field
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return obj.Expires, nil
 	})
@@ -127,7 +127,7 @@ func (ec *executionContext) _JWTAuthResponse_threshold(ctx context.Context, fiel
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return obj.Threshold, nil
 	})
@@ -238,4 +238,11 @@ func (ec *executionContext) marshalNJWTAuthResponse2ᚖgithubᚗcomᚋsurahman
 	return ec._JWTAuthResponse(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOJWTAuthResponse2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐJWTAuthResponse(ctx context.Context, sel ast.SelectionSet, v *models.JWTAuthResponse) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._JWTAuthResponse(ctx, sel, v)
+}
+
 // endregion ***************************** type.gotpl *****************************
@@ -24,6 +24,9 @@ type CryptoAccountResolver interface {
 	LastTxTs(ctx context.Context, obj *postgres.CryptoAccount) (string, error)
 	CreatedAt(ctx context.Context, obj *postgres.CryptoAccount) (string, error)
 	ClientID(ctx context.Context, obj *postgres.CryptoAccount) (string, error)
+	Status(ctx context.Context, obj *postgres.CryptoAccount) (string, error)
+	Held(ctx context.Context, obj *postgres.CryptoAccount) (float64, error)
+	Available(ctx context.Context, obj *postgres.CryptoAccount) (float64, error)
 }
 type CryptoJournalResolver interface {
 	Amount(ctx context.Context, obj *postgres.CryptoJournal) (float64, error)
@@ -31,6 +34,10 @@ type CryptoJournalResolver interface {
 	ClientID(ctx context.Context, obj *postgres.CryptoJournal) (string, error)
 	TxID(ctx context.Context, obj *postgres.CryptoJournal) (string, error)
 }
+type CryptoSwapResponseResolver interface {
+	SourceReceipt(ctx context.Context, obj *models.HTTPCryptoSwapResponse) (*postgres.CryptoJournal, error)
+	DestinationReceipt(ctx context.Context, obj *models.HTTPCryptoSwapResponse) (*postgres.CryptoJournal, error)
+}
 type CryptoTransactionsPaginatedResolver interface {
 	Transactions(ctx context.Context, obj *models.HTTPCryptoTransactionsPaginated) ([]postgres.CryptoJournal, error)
 }
@@ -38,6 +45,9 @@ type CryptoTransactionsPaginatedResolver interface {
 type CryptoOfferRequestResolver interface {
 	SourceAmount(ctx context.Context, obj *models.HTTPCryptoOfferRequest, data float64) error
 }
+type CryptoSwapOfferRequestResolver interface {
+	SourceAmount(ctx context.Context, obj *models.HTTPExchangeOfferRequest, data float64) error
+}
 
 // endregion ************************** generated!.gotpl **************************
 
@@ -63,7 +73,7 @@ func (ec *executionContext) _CryptoAccount_ticker(ctx context.Context, field gra
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return obj.Ticker, nil
 	})
@@ -107,7 +117,7 @@ func (ec *executionContext) _CryptoAccount_balance(ctx context.Context, field gr
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.CryptoAccount().Balance(rctx, obj)
 	})
@@ -151,7 +161,7 @@ func (ec *executionContext) _CryptoAccount_lastTx(ctx context.Context, field gra
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.CryptoAccount().LastTx(rctx, obj)
 	})
@@ -195,7 +205,7 @@ func (ec *executionContext) _CryptoAccount_lastTxTs(ctx context.Context, field g
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.CryptoAccount().LastTxTs(rctx, obj)
 	})
@@ -239,7 +249,7 @@ func (ec *executionContext) _CryptoAccount_createdAt(ctx context.Context, field
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.CryptoAccount().CreatedAt(rctx, obj)
 	})
@@ -283,7 +293,7 @@ func (ec *executionContext) _CryptoAccount_clientID(ctx context.Context, field g
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.CryptoAccount().ClientID(rctx, obj)
 	})
@@ -315,6 +325,138 @@ func (ec *executionContext) fieldContext_CryptoAccount_clientID(_ context.Contex
 	return fc, nil
 }
 
+func (ec *executionContext) _CryptoAccount_status(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoAccount_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoAccount().Status(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoAccount_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoAccount",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoAccount_held(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoAccount_held(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoAccount().Held(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoAccount_held(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoAccount",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoAccount_available(ctx context.Context, field graphql.CollectedField, obj *postgres.CryptoAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoAccount_available(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoAccount().Available(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoAccount_available(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoAccount",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _CryptoBalancesPaginated_accountBalances(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoDetailsPaginated) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_CryptoBalancesPaginated_accountBalances(ctx, field)
 	if err != nil {
@@ -327,7 +469,7 @@ func (ec *executionContext) _CryptoBalancesPaginated_accountBalances(ctx context
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return obj.AccountBalances, nil
 	})
@@ -366,6 +508,12 @@ func (ec *executionContext) fieldContext_CryptoBalancesPaginated_accountBalances
 				return ec.fieldContext_CryptoAccount_createdAt(ctx, field)
 			case "clientID":
 				return ec.fieldContext_CryptoAccount_clientID(ctx, field)
+			case "status":
+				return ec.fieldContext_CryptoAccount_status(ctx, field)
+			case "held":
+				return ec.fieldContext_CryptoAccount_held(ctx, field)
+			case "available":
+				return ec.fieldContext_CryptoAccount_available(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type CryptoAccount", field.Name)
 		},
@@ -385,7 +533,7 @@ func (ec *executionContext) _CryptoBalancesPaginated_links(ctx context.Context,
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return obj.Links, nil
 	})
@@ -435,7 +583,7 @@ func (ec *executionContext) _CryptoJournal_ticker(ctx context.Context, field gra
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return obj.Ticker, nil
 	})
@@ -479,7 +627,7 @@ func (ec *executionContext) _CryptoJournal_amount(ctx context.Context, field gra
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.CryptoJournal().Amount(rctx, obj)
 	})
@@ -523,7 +671,7 @@ func (ec *executionContext) _CryptoJournal_transactedAt(ctx context.Context, fie
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.CryptoJournal().TransactedAt(rctx, obj)
 	})
@@ -567,7 +715,7 @@ func (ec *executionContext) _CryptoJournal_clientID(ctx context.Context, field g
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.CryptoJournal().ClientID(rctx, obj)
 	})
@@ -611,7 +759,7 @@ func (ec *executionContext) _CryptoJournal_txID(ctx context.Context, field graph
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.CryptoJournal().TxID(rctx, obj)
 	})
@@ -655,7 +803,7 @@ func (ec *executionContext) _CryptoOpenAccountResponse_clientID(ctx context.Cont
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return obj.ClientID, nil
 	})
@@ -699,7 +847,7 @@ func (ec *executionContext) _CryptoOpenAccountResponse_ticker(ctx context.Contex
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return obj.Ticker, nil
 	})
@@ -731,6 +879,118 @@ func (ec *executionContext) fieldContext_CryptoOpenAccountResponse_ticker(_ cont
 	return fc, nil
 }
 
+func (ec *executionContext) _CryptoSwapResponse_sourceReceipt(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoSwapResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoSwapResponse_sourceReceipt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoSwapResponse().SourceReceipt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*postgres.CryptoJournal)
+	fc.Result = res
+	return ec.marshalNCryptoJournal2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoJournal(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoSwapResponse_sourceReceipt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoSwapResponse",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "ticker":
+				return ec.fieldContext_CryptoJournal_ticker(ctx, field)
+			case "amount":
+				return ec.fieldContext_CryptoJournal_amount(ctx, field)
+			case "transactedAt":
+				return ec.fieldContext_CryptoJournal_transactedAt(ctx, field)
+			case "clientID":
+				return ec.fieldContext_CryptoJournal_clientID(ctx, field)
+			case "txID":
+				return ec.fieldContext_CryptoJournal_txID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CryptoJournal", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CryptoSwapResponse_destinationReceipt(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoSwapResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CryptoSwapResponse_destinationReceipt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CryptoSwapResponse().DestinationReceipt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*postgres.CryptoJournal)
+	fc.Result = res
+	return ec.marshalNCryptoJournal2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoJournal(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CryptoSwapResponse_destinationReceipt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CryptoSwapResponse",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "ticker":
+				return ec.fieldContext_CryptoJournal_ticker(ctx, field)
+			case "amount":
+				return ec.fieldContext_CryptoJournal_amount(ctx, field)
+			case "transactedAt":
+				return ec.fieldContext_CryptoJournal_transactedAt(ctx, field)
+			case "clientID":
+				return ec.fieldContext_CryptoJournal_clientID(ctx, field)
+			case "txID":
+				return ec.fieldContext_CryptoJournal_txID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CryptoJournal", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _CryptoTransactionsPaginated_transactions(ctx context.Context, field graphql.CollectedField, obj *models.HTTPCryptoTransactionsPaginated) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_CryptoTransactionsPaginated_transactions(ctx, field)
 	if err != nil {
@@ -743,7 +1003,7 @@ func (ec *executionContext) _CryptoTransactionsPaginated_transactions(ctx contex
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.CryptoTransactionsPaginated().Transactions(rctx, obj)
 	})
@@ -799,7 +1059,7 @@ func (ec *executionContext) _CryptoTransactionsPaginated_links(ctx context.Conte
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return obj.Links, nil
 	})
@@ -849,7 +1109,7 @@ func (ec *executionContext) _CryptoTransferResponse_fiatTxReceipt(ctx context.Co
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return obj.FiatTxReceipt, nil
 	})
@@ -902,7 +1162,7 @@ func (ec *executionContext) _CryptoTransferResponse_cryptoTxReceipt(ctx context.
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return obj.CryptoTxReceipt, nil
 	})
@@ -947,10 +1207,10 @@ func (ec *executionContext) fieldContext_CryptoTransferResponse_cryptoTxReceipt(
 
 // region    **************************** input.gotpl *****************************
 
-func (ec *executionContext) unmarshalInputCryptoOfferRequest(ctx context.Context, obj interface{}) (models.HTTPCryptoOfferRequest, error) {
+func (ec *executionContext) unmarshalInputCryptoOfferRequest(ctx context.Context, obj any) (models.HTTPCryptoOfferRequest, error) {
 	var it models.HTTPCryptoOfferRequest
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
 	}
 
@@ -997,10 +1257,10 @@ func (ec *executionContext) unmarshalInputCryptoOfferRequest(ctx context.Context
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputCryptoPaginatedTxDetailsRequest(ctx context.Context, obj interface{}) (models.CryptoPaginatedTxDetailsRequest, error) {
+func (ec *executionContext) unmarshalInputCryptoPaginatedTxDetailsRequest(ctx context.Context, obj any) (models.CryptoPaginatedTxDetailsRequest, error) {
 	var it models.CryptoPaginatedTxDetailsRequest
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
 	}
 
@@ -1059,6 +1319,49 @@ func (ec *executionContext) unmarshalInputCryptoPaginatedTxDetailsRequest(ctx co
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputCryptoSwapOfferRequest(ctx context.Context, obj any) (models.HTTPExchangeOfferRequest, error) {
+	var it models.HTTPExchangeOfferRequest
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"sourceCurrency", "destinationCurrency", "sourceAmount"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "sourceCurrency":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sourceCurrency"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SourceCurrency = data
+		case "destinationCurrency":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("destinationCurrency"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DestinationCurrency = data
+		case "sourceAmount":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sourceAmount"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			if err = ec.resolvers.CryptoSwapOfferRequest().SourceAmount(ctx, &it, data); err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 // endregion **************************** input.gotpl *****************************
 
 // region    ************************** interface.gotpl ***************************
@@ -1262,6 +1565,114 @@ func (ec *executionContext) _CryptoAccount(ctx context.Context, sel ast.Selectio
 				continue
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "status":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoAccount_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "held":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoAccount_held(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "available":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoAccount_available(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -1557,6 +1968,112 @@ func (ec *executionContext) _CryptoOpenAccountResponse(ctx context.Context, sel
 	return out
 }
 
+var cryptoSwapResponseImplementors = []string{"CryptoSwapResponse"}
+
+func (ec *executionContext) _CryptoSwapResponse(ctx context.Context, sel ast.SelectionSet, obj *models.HTTPCryptoSwapResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cryptoSwapResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CryptoSwapResponse")
+		case "sourceReceipt":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoSwapResponse_sourceReceipt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "destinationReceipt":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CryptoSwapResponse_destinationReceipt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var cryptoTransactionsPaginatedImplementors = []string{"CryptoTransactionsPaginated"}
 
 func (ec *executionContext) _CryptoTransactionsPaginated(ctx context.Context, sel ast.SelectionSet, obj *models.HTTPCryptoTransactionsPaginated) graphql.Marshaler {
@@ -1794,7 +2311,17 @@ func (ec *executionContext) marshalNCryptoJournal2ᚕgithubᚗcomᚋsurahmanᚋF
 	return ret
 }
 
-func (ec *executionContext) unmarshalNCryptoOfferRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoOfferRequest(ctx context.Context, v interface{}) (models.HTTPCryptoOfferRequest, error) {
+func (ec *executionContext) marshalNCryptoJournal2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐCryptoJournal(ctx context.Context, sel ast.SelectionSet, v *postgres.CryptoJournal) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CryptoJournal(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNCryptoOfferRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoOfferRequest(ctx context.Context, v any) (models.HTTPCryptoOfferRequest, error) {
 	res, err := ec.unmarshalInputCryptoOfferRequest(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
@@ -1813,11 +2340,30 @@ func (ec *executionContext) marshalNCryptoOpenAccountResponse2ᚖgithubᚗcomᚋ
 	return ec._CryptoOpenAccountResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNCryptoPaginatedTxDetailsRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐCryptoPaginatedTxDetailsRequest(ctx context.Context, v interface{}) (models.CryptoPaginatedTxDetailsRequest, error) {
+func (ec *executionContext) unmarshalNCryptoPaginatedTxDetailsRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐCryptoPaginatedTxDetailsRequest(ctx context.Context, v any) (models.CryptoPaginatedTxDetailsRequest, error) {
 	res, err := ec.unmarshalInputCryptoPaginatedTxDetailsRequest(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNCryptoSwapOfferRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPExchangeOfferRequest(ctx context.Context, v any) (models.HTTPExchangeOfferRequest, error) {
+	res, err := ec.unmarshalInputCryptoSwapOfferRequest(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCryptoSwapResponse2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoSwapResponse(ctx context.Context, sel ast.SelectionSet, v models.HTTPCryptoSwapResponse) graphql.Marshaler {
+	return ec._CryptoSwapResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCryptoSwapResponse2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoSwapResponse(ctx context.Context, sel ast.SelectionSet, v *models.HTTPCryptoSwapResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CryptoSwapResponse(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNCryptoTransactionsPaginated2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPCryptoTransactionsPaginated(ctx context.Context, sel ast.SelectionSet, v models.HTTPCryptoTransactionsPaginated) graphql.Marshaler {
 	return ec._CryptoTransactionsPaginated(ctx, sel, &v)
 }
@@ -25,6 +25,9 @@ type FiatAccountResolver interface {
 	LastTxTs(ctx context.Context, obj *postgres.FiatAccount) (string, error)
 	CreatedAt(ctx context.Context, obj *postgres.FiatAccount) (string, error)
 	ClientID(ctx context.Context, obj *postgres.FiatAccount) (string, error)
+	Status(ctx context.Context, obj *postgres.FiatAccount) (string, error)
+	Held(ctx context.Context, obj *postgres.FiatAccount) (float64, error)
+	Available(ctx context.Context, obj *postgres.FiatAccount) (float64, error)
 }
 type FiatDepositResponseResolver interface {
 	TxID(ctx context.Context, obj *postgres.FiatAccountTransferResult) (string, error)
@@ -55,6 +58,12 @@ type FiatDepositRequestResolver interface {
 type FiatExchangeOfferRequestResolver interface {
 	SourceAmount(ctx context.Context, obj *models.HTTPExchangeOfferRequest, data float64) error
 }
+type FiatP2PTransferRequestResolver interface {
+	Amount(ctx context.Context, obj *models.HTTPFiatP2PTransferRequest, data float64) error
+}
+type FiatWithdrawRequestResolver interface {
+	Amount(ctx context.Context, obj *models.HTTPWithdrawCurrencyRequest, data float64) error
+}
 
 // endregion ************************** generated!.gotpl **************************
 
@@ -80,7 +89,7 @@ func (ec *executionContext) _FiatAccount_currency(ctx context.Context, field gra
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatAccount().Currency(rctx, obj)
 	})
@@ -124,7 +133,7 @@ func (ec *executionContext) _FiatAccount_balance(ctx context.Context, field grap
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatAccount().Balance(rctx, obj)
 	})
@@ -168,7 +177,7 @@ func (ec *executionContext) _FiatAccount_lastTx(ctx context.Context, field graph
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatAccount().LastTx(rctx, obj)
 	})
@@ -212,7 +221,7 @@ func (ec *executionContext) _FiatAccount_lastTxTs(ctx context.Context, field gra
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatAccount().LastTxTs(rctx, obj)
 	})
@@ -256,7 +265,7 @@ func (ec *executionContext) _FiatAccount_createdAt(ctx context.Context, field gr
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatAccount().CreatedAt(rctx, obj)
 	})
@@ -300,7 +309,7 @@ func (ec *executionContext) _FiatAccount_clientID(ctx context.Context, field gra
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatAccount().ClientID(rctx, obj)
 	})
@@ -332,6 +341,138 @@ func (ec *executionContext) fieldContext_FiatAccount_clientID(_ context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _FiatAccount_status(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatAccount_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.FiatAccount().Status(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FiatAccount_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FiatAccount",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FiatAccount_held(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatAccount_held(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.FiatAccount().Held(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FiatAccount_held(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FiatAccount",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FiatAccount_available(ctx context.Context, field graphql.CollectedField, obj *postgres.FiatAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FiatAccount_available(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.FiatAccount().Available(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FiatAccount_available(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FiatAccount",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _FiatBalancesPaginated_accountBalances(ctx context.Context, field graphql.CollectedField, obj *models.HTTPFiatDetailsPaginated) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_FiatBalancesPaginated_accountBalances(ctx, field)
 	if err != nil {
@@ -344,7 +485,7 @@ func (ec *executionContext) _FiatBalancesPaginated_accountBalances(ctx context.C
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return obj.AccountBalances, nil
 	})
@@ -383,6 +524,12 @@ func (ec *executionContext) fieldContext_FiatBalancesPaginated_accountBalances(_
 				return ec.fieldContext_FiatAccount_createdAt(ctx, field)
 			case "clientID":
 				return ec.fieldContext_FiatAccount_clientID(ctx, field)
+			case "status":
+				return ec.fieldContext_FiatAccount_status(ctx, field)
+			case "held":
+				return ec.fieldContext_FiatAccount_held(ctx, field)
+			case "available":
+				return ec.fieldContext_FiatAccount_available(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type FiatAccount", field.Name)
 		},
@@ -402,7 +549,7 @@ func (ec *executionContext) _FiatBalancesPaginated_links(ctx context.Context, fi
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return obj.Links, nil
 	})
@@ -452,7 +599,7 @@ func (ec *executionContext) _FiatDepositResponse_txId(ctx context.Context, field
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatDepositResponse().TxID(rctx, obj)
 	})
@@ -496,7 +643,7 @@ func (ec *executionContext) _FiatDepositResponse_clientId(ctx context.Context, f
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatDepositResponse().ClientID(rctx, obj)
 	})
@@ -540,7 +687,7 @@ func (ec *executionContext) _FiatDepositResponse_txTimestamp(ctx context.Context
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatDepositResponse().TxTimestamp(rctx, obj)
 	})
@@ -584,7 +731,7 @@ func (ec *executionContext) _FiatDepositResponse_balance(ctx context.Context, fi
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatDepositResponse().Balance(rctx, obj)
 	})
@@ -628,7 +775,7 @@ func (ec *executionContext) _FiatDepositResponse_lastTx(ctx context.Context, fie
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatDepositResponse().LastTx(rctx, obj)
 	})
@@ -672,7 +819,7 @@ func (ec *executionContext) _FiatDepositResponse_currency(ctx context.Context, f
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatDepositResponse().Currency(rctx, obj)
 	})
@@ -716,7 +863,7 @@ func (ec *executionContext) _FiatExchangeTransferResponse_sourceReceipt(ctx cont
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatExchangeTransferResponse().SourceReceipt(rctx, obj)
 	})
@@ -774,7 +921,7 @@ func (ec *executionContext) _FiatExchangeTransferResponse_destinationReceipt(ctx
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatExchangeTransferResponse().DestinationReceipt(rctx, obj)
 	})
@@ -832,7 +979,7 @@ func (ec *executionContext) _FiatJournal_currency(ctx context.Context, field gra
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatJournal().Currency(rctx, obj)
 	})
@@ -876,7 +1023,7 @@ func (ec *executionContext) _FiatJournal_amount(ctx context.Context, field graph
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatJournal().Amount(rctx, obj)
 	})
@@ -920,7 +1067,7 @@ func (ec *executionContext) _FiatJournal_transactedAt(ctx context.Context, field
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatJournal().TransactedAt(rctx, obj)
 	})
@@ -964,7 +1111,7 @@ func (ec *executionContext) _FiatJournal_clientID(ctx context.Context, field gra
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatJournal().ClientID(rctx, obj)
 	})
@@ -1008,7 +1155,7 @@ func (ec *executionContext) _FiatJournal_txID(ctx context.Context, field graphql
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatJournal().TxID(rctx, obj)
 	})
@@ -1052,7 +1199,7 @@ func (ec *executionContext) _FiatOpenAccountResponse_clientID(ctx context.Contex
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return obj.ClientID, nil
 	})
@@ -1096,7 +1243,7 @@ func (ec *executionContext) _FiatOpenAccountResponse_currency(ctx context.Contex
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return obj.Currency, nil
 	})
@@ -1140,7 +1287,7 @@ func (ec *executionContext) _FiatTransactionsPaginated_transactions(ctx context.
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return ec.resolvers.FiatTransactionsPaginated().Transactions(rctx, obj)
 	})
@@ -1196,7 +1343,7 @@ func (ec *executionContext) _FiatTransactionsPaginated_links(ctx context.Context
 			ret = graphql.Null
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
 		return obj.Links, nil
 	})
@@ -1238,10 +1385,10 @@ func (ec *executionContext) fieldContext_FiatTransactionsPaginated_links(_ conte
 
 // region    **************************** input.gotpl *****************************
 
-func (ec *executionContext) unmarshalInputFiatDepositRequest(ctx context.Context, obj interface{}) (models.HTTPDepositCurrencyRequest, error) {
+func (ec *executionContext) unmarshalInputFiatDepositRequest(ctx context.Context, obj any) (models.HTTPDepositCurrencyRequest, error) {
 	var it models.HTTPDepositCurrencyRequest
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
 	}
 
@@ -1274,10 +1421,10 @@ func (ec *executionContext) unmarshalInputFiatDepositRequest(ctx context.Context
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputFiatExchangeOfferRequest(ctx context.Context, obj interface{}) (models.HTTPExchangeOfferRequest, error) {
+func (ec *executionContext) unmarshalInputFiatExchangeOfferRequest(ctx context.Context, obj any) (models.HTTPExchangeOfferRequest, error) {
 	var it models.HTTPExchangeOfferRequest
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
 	}
 
@@ -1317,10 +1464,53 @@ func (ec *executionContext) unmarshalInputFiatExchangeOfferRequest(ctx context.C
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputFiatPaginatedTxDetailsRequest(ctx context.Context, obj interface{}) (models.FiatPaginatedTxDetailsRequest, error) {
+func (ec *executionContext) unmarshalInputFiatP2PTransferRequest(ctx context.Context, obj any) (models.HTTPFiatP2PTransferRequest, error) {
+	var it models.HTTPFiatP2PTransferRequest
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"username", "currency", "amount"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "username":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("username"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Username = data
+		case "currency":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currency"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Currency = data
+		case "amount":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amount"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			if err = ec.resolvers.FiatP2PTransferRequest().Amount(ctx, &it, data); err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputFiatPaginatedTxDetailsRequest(ctx context.Context, obj any) (models.FiatPaginatedTxDetailsRequest, error) {
 	var it models.FiatPaginatedTxDetailsRequest
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
 	}
 
@@ -1379,6 +1569,42 @@ func (ec *executionContext) unmarshalInputFiatPaginatedTxDetailsRequest(ctx cont
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputFiatWithdrawRequest(ctx context.Context, obj any) (models.HTTPWithdrawCurrencyRequest, error) {
+	var it models.HTTPWithdrawCurrencyRequest
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"amount", "currency"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "amount":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amount"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			if err = ec.resolvers.FiatWithdrawRequest().Amount(ctx, &it, data); err != nil {
+				return it, err
+			}
+		case "currency":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currency"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Currency = data
+		}
+	}
+
+	return it, nil
+}
+
 // endregion **************************** input.gotpl *****************************
 
 // region    ************************** interface.gotpl ***************************
@@ -1613,6 +1839,114 @@ func (ec *executionContext) _FiatAccount(ctx context.Context, sel ast.SelectionS
 				continue
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "status":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FiatAccount_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "held":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FiatAccount_held(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "available":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FiatAccount_available(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -2446,7 +2780,7 @@ func (ec *executionContext) marshalNFiatBalancesPaginated2ᚖgithubᚗcomᚋsura
 	return ec._FiatBalancesPaginated(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNFiatDepositRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPDepositCurrencyRequest(ctx context.Context, v interface{}) (models.HTTPDepositCurrencyRequest, error) {
+func (ec *executionContext) unmarshalNFiatDepositRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPDepositCurrencyRequest(ctx context.Context, v any) (models.HTTPDepositCurrencyRequest, error) {
 	res, err := ec.unmarshalInputFiatDepositRequest(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
@@ -2465,7 +2799,7 @@ func (ec *executionContext) marshalNFiatDepositResponse2ᚖgithubᚗcomᚋsurahm
 	return ec._FiatDepositResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNFiatExchangeOfferRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPExchangeOfferRequest(ctx context.Context, v interface{}) (models.HTTPExchangeOfferRequest, error) {
+func (ec *executionContext) unmarshalNFiatExchangeOfferRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPExchangeOfferRequest(ctx context.Context, v any) (models.HTTPExchangeOfferRequest, error) {
 	res, err := ec.unmarshalInputFiatExchangeOfferRequest(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
@@ -2546,7 +2880,12 @@ func (ec *executionContext) marshalNFiatOpenAccountResponse2ᚖgithubᚗcomᚋsu
 	return ec._FiatOpenAccountResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNFiatPaginatedTxDetailsRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐFiatPaginatedTxDetailsRequest(ctx context.Context, v interface{}) (models.FiatPaginatedTxDetailsRequest, error) {
+func (ec *executionContext) unmarshalNFiatP2PTransferRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPFiatP2PTransferRequest(ctx context.Context, v any) (models.HTTPFiatP2PTransferRequest, error) {
+	res, err := ec.unmarshalInputFiatP2PTransferRequest(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNFiatPaginatedTxDetailsRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐFiatPaginatedTxDetailsRequest(ctx context.Context, v any) (models.FiatPaginatedTxDetailsRequest, error) {
 	res, err := ec.unmarshalInputFiatPaginatedTxDetailsRequest(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
@@ -2565,6 +2904,11 @@ func (ec *executionContext) marshalNFiatTransactionsPaginated2ᚖgithubᚗcomᚋ
 	return ec._FiatTransactionsPaginated(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNFiatWithdrawRequest2githubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋmodelsᚐHTTPWithdrawCurrencyRequest(ctx context.Context, v any) (models.HTTPWithdrawCurrencyRequest, error) {
+	res, err := ec.unmarshalInputFiatWithdrawRequest(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalOFiatJournal2ᚖgithubᚗcomᚋsurahmanᚋFTeXᚋpkgᚋpostgresᚐFiatJournal(ctx context.Context, sel ast.SelectionSet, v *postgres.FiatJournal) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -34,33 +34,89 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	APIKey() APIKeyResolver
+	BalanceUpdate() BalanceUpdateResolver
 	CryptoAccount() CryptoAccountResolver
 	CryptoJournal() CryptoJournalResolver
+	CryptoSwapResponse() CryptoSwapResponseResolver
 	CryptoTransactionsPaginated() CryptoTransactionsPaginatedResolver
 	FiatAccount() FiatAccountResolver
 	FiatDepositResponse() FiatDepositResponseResolver
 	FiatExchangeTransferResponse() FiatExchangeTransferResponseResolver
 	FiatJournal() FiatJournalResolver
 	FiatTransactionsPaginated() FiatTransactionsPaginatedResolver
+	LoginEvent() LoginEventResolver
 	Mutation() MutationResolver
 	OfferResponse() OfferResponseResolver
+	Order() OrderResolver
 	PriceQuote() PriceQuoteResolver
 	Query() QueryResolver
+	RateCandle() RateCandleResolver
+	RateTick() RateTickResolver
+	Schedule() ScheduleResolver
+	ScheduleRun() ScheduleRunResolver
+	Subscription() SubscriptionResolver
+	Webhook() WebhookResolver
+	WebhookDeadLetter() WebhookDeadLetterResolver
+	AdminAccountHoldRequest() AdminAccountHoldRequestResolver
 	CryptoOfferRequest() CryptoOfferRequestResolver
+	CryptoSwapOfferRequest() CryptoSwapOfferRequestResolver
 	FiatDepositRequest() FiatDepositRequestResolver
 	FiatExchangeOfferRequest() FiatExchangeOfferRequestResolver
+	FiatP2PTransferRequest() FiatP2PTransferRequestResolver
+	FiatWithdrawRequest() FiatWithdrawRequestResolver
+	OrderRequest() OrderRequestResolver
+	ScheduleRequest() ScheduleRequestResolver
+	ScheduleUpdateRequest() ScheduleUpdateRequestResolver
 }
 
 type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	APIKey struct {
+		ClientID  func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		KeyID     func(childComplexity int) int
+		Name      func(childComplexity int) int
+		Scopes    func(childComplexity int) int
+	}
+
+	APIKeyResponse struct {
+		APIKey func(childComplexity int) int
+		Key    func(childComplexity int) int
+	}
+
+	AdminUser struct {
+		ClientID  func(childComplexity int) int
+		Email     func(childComplexity int) int
+		FirstName func(childComplexity int) int
+		IsDeleted func(childComplexity int) int
+		IsFrozen  func(childComplexity int) int
+		LastName  func(childComplexity int) int
+		Role      func(childComplexity int) int
+		Username  func(childComplexity int) int
+	}
+
+	BalanceUpdate struct {
+		Available func(childComplexity int) int
+		Balance   func(childComplexity int) int
+		Currency  func(childComplexity int) int
+		EventID   func(childComplexity int) int
+		EventType func(childComplexity int) int
+		Held      func(childComplexity int) int
+		UpdatedAt func(childComplexity int) int
+	}
+
 	CryptoAccount struct {
+		Available func(childComplexity int) int
 		Balance   func(childComplexity int) int
 		ClientID  func(childComplexity int) int
 		CreatedAt func(childComplexity int) int
+		Held      func(childComplexity int) int
 		LastTx    func(childComplexity int) int
 		LastTxTs  func(childComplexity int) int
+		Status    func(childComplexity int) int
 		Ticker    func(childComplexity int) int
 	}
 
@@ -82,6 +138,11 @@ type ComplexityRoot struct {
 		Ticker   func(childComplexity int) int
 	}
 
+	CryptoSwapResponse struct {
+		DestinationReceipt func(childComplexity int) int
+		SourceReceipt      func(childComplexity int) int
+	}
+
 	CryptoTransactionsPaginated struct {
 		Links        func(childComplexity int) int
 		Transactions func(childComplexity int) int
@@ -93,12 +154,15 @@ type ComplexityRoot struct {
 	}
 
 	FiatAccount struct {
+		Available func(childComplexity int) int
 		Balance   func(childComplexity int) int
 		ClientID  func(childComplexity int) int
 		CreatedAt func(childComplexity int) int
 		Currency  func(childComplexity int) int
+		Held      func(childComplexity int) int
 		LastTx    func(childComplexity int) int
 		LastTxTs  func(childComplexity int) int
+		Status    func(childComplexity int) int
 	}
 
 	FiatBalancesPaginated struct {
@@ -149,27 +213,118 @@ type ComplexityRoot struct {
 		PageCursor func(childComplexity int) int
 	}
 
+	LoginEvent struct {
+		CreatedAt func(childComplexity int) int
+		EventID   func(childComplexity int) int
+		IpAddress func(childComplexity int) int
+		Outcome   func(childComplexity int) int
+		UserAgent func(childComplexity int) int
+	}
+
+	LoginEventsPaginated struct {
+		Events func(childComplexity int) int
+		Links  func(childComplexity int) int
+	}
+
+	LoginResponse struct {
+		MfaChallenge func(childComplexity int) int
+		Token        func(childComplexity int) int
+	}
+
+	MFAChallenge struct {
+		ChallengeID func(childComplexity int) int
+		Expires     func(childComplexity int) int
+	}
+
+	MFAEnrollResponse struct {
+		RecoveryCodes func(childComplexity int) int
+		Secret        func(childComplexity int) int
+		URI           func(childComplexity int) int
+	}
+
+	MFARecoveryCodes struct {
+		RecoveryCodes func(childComplexity int) int
+	}
+
 	Mutation struct {
-		DeleteUser           func(childComplexity int, input models.HTTPDeleteUserRequest) int
-		DepositFiat          func(childComplexity int, input models.HTTPDepositCurrencyRequest) int
-		ExchangeCrypto       func(childComplexity int, offerID string) int
-		ExchangeOfferFiat    func(childComplexity int, input models.HTTPExchangeOfferRequest) int
-		ExchangeTransferFiat func(childComplexity int, offerID string) int
-		LoginUser            func(childComplexity int, input models1.UserLoginCredentials) int
-		OfferCrypto          func(childComplexity int, input models.HTTPCryptoOfferRequest) int
-		OpenCrypto           func(childComplexity int, ticker string) int
-		OpenFiat             func(childComplexity int, currency string) int
-		RefreshToken         func(childComplexity int) int
-		RegisterUser         func(childComplexity int, input *models1.UserAccount) int
+		AdminFreezeAccount      func(childComplexity int, clientID string) int
+		AdminHoldCrypto         func(childComplexity int, clientID string, input models.HTTPAccountHoldRequest) int
+		AdminHoldFiat           func(childComplexity int, clientID string, input models.HTTPAccountHoldRequest) int
+		AdminReleaseCrypto      func(childComplexity int, clientID string, input models.HTTPAccountHoldRequest) int
+		AdminReleaseFiat        func(childComplexity int, clientID string, input models.HTTPAccountHoldRequest) int
+		AdminReverseDeposit     func(childComplexity int, clientID string, transactionID string) int
+		AdminSetStatusCrypto    func(childComplexity int, clientID string, input models.HTTPAccountStatusRequest) int
+		AdminSetStatusFiat      func(childComplexity int, clientID string, input models.HTTPAccountStatusRequest) int
+		AdminUnfreezeAccount    func(childComplexity int, clientID string) int
+		CancelOrder             func(childComplexity int, orderID string) int
+		ChangePassword          func(childComplexity int, input models.HTTPChangePasswordRequest) int
+		CreateAPIKey            func(childComplexity int, input models.HTTPAPIKeyRequest) int
+		CreateSchedule          func(childComplexity int, input models.HTTPScheduleRequest, idempotencyKey *string) int
+		CreateWebhook           func(childComplexity int, input models.HTTPWebhookRequest) int
+		DeleteSchedule          func(childComplexity int, scheduleID string) int
+		DeleteUser              func(childComplexity int, input models.HTTPDeleteUserRequest) int
+		DeleteWebhook           func(childComplexity int, webhookID string) int
+		DepositFiat             func(childComplexity int, input models.HTTPDepositCurrencyRequest, idempotencyKey *string) int
+		DisableMfa              func(childComplexity int, input models.HTTPMFACodeRequest) int
+		EnrollMfa               func(childComplexity int) int
+		ExchangeCrypto          func(childComplexity int, offerID string, idempotencyKey *string) int
+		ExchangeOfferFiat       func(childComplexity int, input models.HTTPExchangeOfferRequest) int
+		ExchangeSwapCrypto      func(childComplexity int, offerID string, idempotencyKey *string) int
+		ExchangeTransferFiat    func(childComplexity int, offerID string, idempotencyKey *string) int
+		LoginUser               func(childComplexity int, input models1.UserLoginCredentials) int
+		LoginUserMfa            func(childComplexity int, input models.HTTPMFALoginRequest) int
+		LogoutUser              func(childComplexity int) int
+		LogoutUserEverywhere    func(childComplexity int) int
+		OfferCrypto             func(childComplexity int, input models.HTTPCryptoOfferRequest) int
+		OfferSwapCrypto         func(childComplexity int, input models.HTTPExchangeOfferRequest) int
+		OpenCrypto              func(childComplexity int, ticker string) int
+		OpenFiat                func(childComplexity int, currency string) int
+		PlaceOrder              func(childComplexity int, input models.HTTPOrderRequest, idempotencyKey *string) int
+		RefreshToken            func(childComplexity int) int
+		RegenerateRecoveryCodes func(childComplexity int, input models.HTTPMFACodeRequest) int
+		RegisterUser            func(childComplexity int, input *models1.UserAccount) int
+		RequestPasswordReset    func(childComplexity int, input models.HTTPPasswordResetRequest) int
+		ResetPassword           func(childComplexity int, input models.HTTPResetPasswordRequest) int
+		RevokeAPIKey            func(childComplexity int, keyID string) int
+		TransferP2PFiat         func(childComplexity int, input models.HTTPFiatP2PTransferRequest, idempotencyKey *string) int
+		UpdateSchedule          func(childComplexity int, scheduleID string, input models.HTTPScheduleUpdateRequest) int
+		VerifyMfa               func(childComplexity int, input models.HTTPMFACodeRequest) int
+		WithdrawFiat            func(childComplexity int, input models.HTTPWithdrawCurrencyRequest, idempotencyKey *string) int
 	}
 
 	OfferResponse struct {
 		DebitAmount func(childComplexity int) int
 		Expires     func(childComplexity int) int
+		Fee         func(childComplexity int) int
 		OfferID     func(childComplexity int) int
 		PriceQuote  func(childComplexity int) int
 	}
 
+	Order struct {
+		Amount      func(childComplexity int) int
+		ClientID    func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		Destination func(childComplexity int) int
+		Fee         func(childComplexity int) int
+		FillAmount  func(childComplexity int) int
+		FillRate    func(childComplexity int) int
+		LimitRate   func(childComplexity int) int
+		OrderID     func(childComplexity int) int
+		Source      func(childComplexity int) int
+		Status      func(childComplexity int) int
+		TxID        func(childComplexity int) int
+		UpdatedAt   func(childComplexity int) int
+	}
+
+	OrdersPaginated struct {
+		Links  func(childComplexity int) int
+		Orders func(childComplexity int) int
+	}
+
+	PasswordResetResponse struct {
+		Expires func(childComplexity int) int
+	}
+
 	PriceQuote struct {
 		Amount         func(childComplexity int) int
 		ClientID       func(childComplexity int) int
@@ -179,15 +334,124 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
-		BalanceAllCrypto            func(childComplexity int, pageCursor *string, pageSize *int32) int
-		BalanceAllFiat              func(childComplexity int, pageCursor *string, pageSize *int32) int
-		BalanceCrypto               func(childComplexity int, ticker string) int
-		BalanceFiat                 func(childComplexity int, currencyCode string) int
-		Healthcheck                 func(childComplexity int) int
-		TransactionDetailsAllCrypto func(childComplexity int, input models.CryptoPaginatedTxDetailsRequest) int
-		TransactionDetailsAllFiat   func(childComplexity int, input models.FiatPaginatedTxDetailsRequest) int
-		TransactionDetailsCrypto    func(childComplexity int, transactionID string) int
-		TransactionDetailsFiat      func(childComplexity int, transactionID string) int
+		APIKeys                          func(childComplexity int) int
+		AdminBalanceAllCrypto            func(childComplexity int, clientID string, pageCursor *string, pageSize *int32) int
+		AdminBalanceAllFiat              func(childComplexity int, clientID string, pageCursor *string, pageSize *int32) int
+		AdminTransactionDetails          func(childComplexity int, clientID string, transactionID string) int
+		AdminTransactionDetailsAllCrypto func(childComplexity int, clientID string, input models.CryptoPaginatedTxDetailsRequest) int
+		AdminTransactionDetailsAllFiat   func(childComplexity int, clientID string, input models.FiatPaginatedTxDetailsRequest) int
+		AdminUser                        func(childComplexity int, username string) int
+		BalanceAllCrypto                 func(childComplexity int, pageCursor *string, pageSize *int32) int
+		BalanceAllFiat                   func(childComplexity int, pageCursor *string, pageSize *int32) int
+		BalanceCrypto                    func(childComplexity int, ticker string) int
+		BalanceFiat                      func(childComplexity int, currencyCode string) int
+		Healthcheck                      func(childComplexity int) int
+		LoginEvents                      func(childComplexity int, pageCursor *string, pageSize *int32) int
+		Orders                           func(childComplexity int, pageCursor *string, pageSize *int32) int
+		RateHistory                      func(childComplexity int, input models.HTTPRateHistoryRequest) int
+		ScheduleRuns                     func(childComplexity int, scheduleID string, pageCursor *string, pageSize *int32) int
+		Schedules                        func(childComplexity int, pageCursor *string, pageSize *int32) int
+		TransactionDetailsAllCrypto      func(childComplexity int, input models.CryptoPaginatedTxDetailsRequest) int
+		TransactionDetailsAllFiat        func(childComplexity int, input models.FiatPaginatedTxDetailsRequest) int
+		TransactionDetailsCrypto         func(childComplexity int, transactionID string) int
+		TransactionDetailsFiat           func(childComplexity int, transactionID string) int
+		WebhookDeadLetters               func(childComplexity int, pageCursor *string, pageSize *int32) int
+		Webhooks                         func(childComplexity int) int
+	}
+
+	RateCandle struct {
+		Close  func(childComplexity int) int
+		High   func(childComplexity int) int
+		Low    func(childComplexity int) int
+		Open   func(childComplexity int) int
+		Quotes func(childComplexity int) int
+		Start  func(childComplexity int) int
+	}
+
+	RateHistory struct {
+		Candles     func(childComplexity int) int
+		Destination func(childComplexity int) int
+		Interval    func(childComplexity int) int
+		Source      func(childComplexity int) int
+	}
+
+	RateTick struct {
+		Destination func(childComplexity int) int
+		QuotedAt    func(childComplexity int) int
+		Rate        func(childComplexity int) int
+		Source      func(childComplexity int) int
+	}
+
+	Schedule struct {
+		Amount       func(childComplexity int) int
+		ClientID     func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		FiatCurrency func(childComplexity int) int
+		Frequency    func(childComplexity int) int
+		IsActive     func(childComplexity int) int
+		NextRunAt    func(childComplexity int) int
+		ScheduleID   func(childComplexity int) int
+		Ticker       func(childComplexity int) int
+		UpdatedAt    func(childComplexity int) int
+	}
+
+	ScheduleRun struct {
+		ClientID     func(childComplexity int) int
+		CryptoAmount func(childComplexity int) int
+		ExecutedAt   func(childComplexity int) int
+		Fee          func(childComplexity int) int
+		FiatAmount   func(childComplexity int) int
+		Message      func(childComplexity int) int
+		Rate         func(childComplexity int) int
+		RunID        func(childComplexity int) int
+		ScheduleID   func(childComplexity int) int
+		Status       func(childComplexity int) int
+		TxID         func(childComplexity int) int
+	}
+
+	ScheduleRunsPaginated struct {
+		Links func(childComplexity int) int
+		Runs  func(childComplexity int) int
+	}
+
+	SchedulesPaginated struct {
+		Links     func(childComplexity int) int
+		Schedules func(childComplexity int) int
+	}
+
+	Subscription struct {
+		BalanceUpdated func(childComplexity int, currency string) int
+		RateTicker     func(childComplexity int, pair string) int
+	}
+
+	Webhook struct {
+		ClientID  func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		Url       func(childComplexity int) int
+		WebhookID func(childComplexity int) int
+	}
+
+	WebhookDeadLetter struct {
+		Attempts   func(childComplexity int) int
+		CreatedAt  func(childComplexity int) int
+		DeliveryID func(childComplexity int) int
+		EventID    func(childComplexity int) int
+		EventType  func(childComplexity int) int
+		FailedAt   func(childComplexity int) int
+		LastError  func(childComplexity int) int
+		Payload    func(childComplexity int) int
+		URL        func(childComplexity int) int
+		WebhookID  func(childComplexity int) int
+	}
+
+	WebhookDeadLettersPaginated struct {
+		DeadLetters func(childComplexity int) int
+		Links       func(childComplexity int) int
+	}
+
+	WebhookResponse struct {
+		Secret  func(childComplexity int) int
+		Webhook func(childComplexity int) int
 	}
 }
 
@@ -205,11 +469,172 @@ func (e *executableSchema) Schema() *ast.Schema {
 	return parsedSchema
 }
 
-func (e *executableSchema) Complexity(typeName, field string, childComplexity int, rawArgs map[string]interface{}) (int, bool) {
+func (e *executableSchema) Complexity(typeName, field string, childComplexity int, rawArgs map[string]any) (int, bool) {
 	ec := executionContext{nil, e, 0, 0, nil}
 	_ = ec
 	switch typeName + "." + field {
 
+	case "APIKey.clientID":
+		if e.complexity.APIKey.ClientID == nil {
+			break
+		}
+
+		return e.complexity.APIKey.ClientID(childComplexity), true
+
+	case "APIKey.createdAt":
+		if e.complexity.APIKey.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.APIKey.CreatedAt(childComplexity), true
+
+	case "APIKey.keyID":
+		if e.complexity.APIKey.KeyID == nil {
+			break
+		}
+
+		return e.complexity.APIKey.KeyID(childComplexity), true
+
+	case "APIKey.name":
+		if e.complexity.APIKey.Name == nil {
+			break
+		}
+
+		return e.complexity.APIKey.Name(childComplexity), true
+
+	case "APIKey.scopes":
+		if e.complexity.APIKey.Scopes == nil {
+			break
+		}
+
+		return e.complexity.APIKey.Scopes(childComplexity), true
+
+	case "APIKeyResponse.apiKey":
+		if e.complexity.APIKeyResponse.APIKey == nil {
+			break
+		}
+
+		return e.complexity.APIKeyResponse.APIKey(childComplexity), true
+
+	case "APIKeyResponse.key":
+		if e.complexity.APIKeyResponse.Key == nil {
+			break
+		}
+
+		return e.complexity.APIKeyResponse.Key(childComplexity), true
+
+	case "AdminUser.clientID":
+		if e.complexity.AdminUser.ClientID == nil {
+			break
+		}
+
+		return e.complexity.AdminUser.ClientID(childComplexity), true
+
+	case "AdminUser.email":
+		if e.complexity.AdminUser.Email == nil {
+			break
+		}
+
+		return e.complexity.AdminUser.Email(childComplexity), true
+
+	case "AdminUser.firstName":
+		if e.complexity.AdminUser.FirstName == nil {
+			break
+		}
+
+		return e.complexity.AdminUser.FirstName(childComplexity), true
+
+	case "AdminUser.isDeleted":
+		if e.complexity.AdminUser.IsDeleted == nil {
+			break
+		}
+
+		return e.complexity.AdminUser.IsDeleted(childComplexity), true
+
+	case "AdminUser.isFrozen":
+		if e.complexity.AdminUser.IsFrozen == nil {
+			break
+		}
+
+		return e.complexity.AdminUser.IsFrozen(childComplexity), true
+
+	case "AdminUser.lastName":
+		if e.complexity.AdminUser.LastName == nil {
+			break
+		}
+
+		return e.complexity.AdminUser.LastName(childComplexity), true
+
+	case "AdminUser.role":
+		if e.complexity.AdminUser.Role == nil {
+			break
+		}
+
+		return e.complexity.AdminUser.Role(childComplexity), true
+
+	case "AdminUser.username":
+		if e.complexity.AdminUser.Username == nil {
+			break
+		}
+
+		return e.complexity.AdminUser.Username(childComplexity), true
+
+	case "BalanceUpdate.available":
+		if e.complexity.BalanceUpdate.Available == nil {
+			break
+		}
+
+		return e.complexity.BalanceUpdate.Available(childComplexity), true
+
+	case "BalanceUpdate.balance":
+		if e.complexity.BalanceUpdate.Balance == nil {
+			break
+		}
+
+		return e.complexity.BalanceUpdate.Balance(childComplexity), true
+
+	case "BalanceUpdate.currency":
+		if e.complexity.BalanceUpdate.Currency == nil {
+			break
+		}
+
+		return e.complexity.BalanceUpdate.Currency(childComplexity), true
+
+	case "BalanceUpdate.eventID":
+		if e.complexity.BalanceUpdate.EventID == nil {
+			break
+		}
+
+		return e.complexity.BalanceUpdate.EventID(childComplexity), true
+
+	case "BalanceUpdate.eventType":
+		if e.complexity.BalanceUpdate.EventType == nil {
+			break
+		}
+
+		return e.complexity.BalanceUpdate.EventType(childComplexity), true
+
+	case "BalanceUpdate.held":
+		if e.complexity.BalanceUpdate.Held == nil {
+			break
+		}
+
+		return e.complexity.BalanceUpdate.Held(childComplexity), true
+
+	case "BalanceUpdate.updatedAt":
+		if e.complexity.BalanceUpdate.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.BalanceUpdate.UpdatedAt(childComplexity), true
+
+	case "CryptoAccount.available":
+		if e.complexity.CryptoAccount.Available == nil {
+			break
+		}
+
+		return e.complexity.CryptoAccount.Available(childComplexity), true
+
 	case "CryptoAccount.balance":
 		if e.complexity.CryptoAccount.Balance == nil {
 			break
@@ -231,6 +656,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CryptoAccount.CreatedAt(childComplexity), true
 
+	case "CryptoAccount.held":
+		if e.complexity.CryptoAccount.Held == nil {
+			break
+		}
+
+		return e.complexity.CryptoAccount.Held(childComplexity), true
+
 	case "CryptoAccount.lastTx":
 		if e.complexity.CryptoAccount.LastTx == nil {
 			break
@@ -245,6 +677,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CryptoAccount.LastTxTs(childComplexity), true
 
+	case "CryptoAccount.status":
+		if e.complexity.CryptoAccount.Status == nil {
+			break
+		}
+
+		return e.complexity.CryptoAccount.Status(childComplexity), true
+
 	case "CryptoAccount.ticker":
 		if e.complexity.CryptoAccount.Ticker == nil {
 			break
@@ -315,6 +754,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CryptoOpenAccountResponse.Ticker(childComplexity), true
 
+	case "CryptoSwapResponse.destinationReceipt":
+		if e.complexity.CryptoSwapResponse.DestinationReceipt == nil {
+			break
+		}
+
+		return e.complexity.CryptoSwapResponse.DestinationReceipt(childComplexity), true
+
+	case "CryptoSwapResponse.sourceReceipt":
+		if e.complexity.CryptoSwapResponse.SourceReceipt == nil {
+			break
+		}
+
+		return e.complexity.CryptoSwapResponse.SourceReceipt(childComplexity), true
+
 	case "CryptoTransactionsPaginated.links":
 		if e.complexity.CryptoTransactionsPaginated.Links == nil {
 			break
@@ -343,6 +796,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CryptoTransferResponse.FiatTxReceipt(childComplexity), true
 
+	case "FiatAccount.available":
+		if e.complexity.FiatAccount.Available == nil {
+			break
+		}
+
+		return e.complexity.FiatAccount.Available(childComplexity), true
+
 	case "FiatAccount.balance":
 		if e.complexity.FiatAccount.Balance == nil {
 			break
@@ -371,6 +831,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.FiatAccount.Currency(childComplexity), true
 
+	case "FiatAccount.held":
+		if e.complexity.FiatAccount.Held == nil {
+			break
+		}
+
+		return e.complexity.FiatAccount.Held(childComplexity), true
+
 	case "FiatAccount.lastTx":
 		if e.complexity.FiatAccount.LastTx == nil {
 			break
@@ -385,6 +852,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.FiatAccount.LastTxTs(childComplexity), true
 
+	case "FiatAccount.status":
+		if e.complexity.FiatAccount.Status == nil {
+			break
+		}
+
+		return e.complexity.FiatAccount.Status(childComplexity), true
+
 	case "FiatBalancesPaginated.accountBalances":
 		if e.complexity.FiatBalancesPaginated.AccountBalances == nil {
 			break
@@ -553,319 +1027,1509 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Links.PageCursor(childComplexity), true
 
-	case "Mutation.deleteUser":
-		if e.complexity.Mutation.DeleteUser == nil {
+	case "LoginEvent.createdAt":
+		if e.complexity.LoginEvent.CreatedAt == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteUser_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.LoginEvent.CreatedAt(childComplexity), true
+
+	case "LoginEvent.eventID":
+		if e.complexity.LoginEvent.EventID == nil {
+			break
 		}
 
-		return e.complexity.Mutation.DeleteUser(childComplexity, args["input"].(models.HTTPDeleteUserRequest)), true
+		return e.complexity.LoginEvent.EventID(childComplexity), true
 
-	case "Mutation.depositFiat":
-		if e.complexity.Mutation.DepositFiat == nil {
+	case "LoginEvent.ipAddress":
+		if e.complexity.LoginEvent.IpAddress == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_depositFiat_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.LoginEvent.IpAddress(childComplexity), true
+
+	case "LoginEvent.outcome":
+		if e.complexity.LoginEvent.Outcome == nil {
+			break
 		}
 
-		return e.complexity.Mutation.DepositFiat(childComplexity, args["input"].(models.HTTPDepositCurrencyRequest)), true
+		return e.complexity.LoginEvent.Outcome(childComplexity), true
 
-	case "Mutation.exchangeCrypto":
-		if e.complexity.Mutation.ExchangeCrypto == nil {
+	case "LoginEvent.userAgent":
+		if e.complexity.LoginEvent.UserAgent == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_exchangeCrypto_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.LoginEvent.UserAgent(childComplexity), true
+
+	case "LoginEventsPaginated.events":
+		if e.complexity.LoginEventsPaginated.Events == nil {
+			break
 		}
 
-		return e.complexity.Mutation.ExchangeCrypto(childComplexity, args["offerID"].(string)), true
+		return e.complexity.LoginEventsPaginated.Events(childComplexity), true
 
-	case "Mutation.exchangeOfferFiat":
-		if e.complexity.Mutation.ExchangeOfferFiat == nil {
+	case "LoginEventsPaginated.links":
+		if e.complexity.LoginEventsPaginated.Links == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_exchangeOfferFiat_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.LoginEventsPaginated.Links(childComplexity), true
+
+	case "LoginResponse.mfaChallenge":
+		if e.complexity.LoginResponse.MfaChallenge == nil {
+			break
 		}
 
-		return e.complexity.Mutation.ExchangeOfferFiat(childComplexity, args["input"].(models.HTTPExchangeOfferRequest)), true
+		return e.complexity.LoginResponse.MfaChallenge(childComplexity), true
 
-	case "Mutation.exchangeTransferFiat":
-		if e.complexity.Mutation.ExchangeTransferFiat == nil {
+	case "LoginResponse.token":
+		if e.complexity.LoginResponse.Token == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_exchangeTransferFiat_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.LoginResponse.Token(childComplexity), true
+
+	case "MFAChallenge.challengeID":
+		if e.complexity.MFAChallenge.ChallengeID == nil {
+			break
 		}
 
-		return e.complexity.Mutation.ExchangeTransferFiat(childComplexity, args["offerID"].(string)), true
+		return e.complexity.MFAChallenge.ChallengeID(childComplexity), true
 
-	case "Mutation.loginUser":
-		if e.complexity.Mutation.LoginUser == nil {
+	case "MFAChallenge.expires":
+		if e.complexity.MFAChallenge.Expires == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_loginUser_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.MFAChallenge.Expires(childComplexity), true
+
+	case "MFAEnrollResponse.recoveryCodes":
+		if e.complexity.MFAEnrollResponse.RecoveryCodes == nil {
+			break
 		}
 
-		return e.complexity.Mutation.LoginUser(childComplexity, args["input"].(models1.UserLoginCredentials)), true
+		return e.complexity.MFAEnrollResponse.RecoveryCodes(childComplexity), true
 
-	case "Mutation.offerCrypto":
-		if e.complexity.Mutation.OfferCrypto == nil {
+	case "MFAEnrollResponse.secret":
+		if e.complexity.MFAEnrollResponse.Secret == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_offerCrypto_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.MFAEnrollResponse.Secret(childComplexity), true
+
+	case "MFAEnrollResponse.uri":
+		if e.complexity.MFAEnrollResponse.URI == nil {
+			break
 		}
 
-		return e.complexity.Mutation.OfferCrypto(childComplexity, args["input"].(models.HTTPCryptoOfferRequest)), true
+		return e.complexity.MFAEnrollResponse.URI(childComplexity), true
 
-	case "Mutation.openCrypto":
-		if e.complexity.Mutation.OpenCrypto == nil {
+	case "MFARecoveryCodes.recoveryCodes":
+		if e.complexity.MFARecoveryCodes.RecoveryCodes == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_openCrypto_args(context.TODO(), rawArgs)
+		return e.complexity.MFARecoveryCodes.RecoveryCodes(childComplexity), true
+
+	case "Mutation.adminFreezeAccount":
+		if e.complexity.Mutation.AdminFreezeAccount == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_adminFreezeAccount_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.OpenCrypto(childComplexity, args["ticker"].(string)), true
+		return e.complexity.Mutation.AdminFreezeAccount(childComplexity, args["clientID"].(string)), true
 
-	case "Mutation.openFiat":
-		if e.complexity.Mutation.OpenFiat == nil {
+	case "Mutation.adminHoldCrypto":
+		if e.complexity.Mutation.AdminHoldCrypto == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_openFiat_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_adminHoldCrypto_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.OpenFiat(childComplexity, args["currency"].(string)), true
+		return e.complexity.Mutation.AdminHoldCrypto(childComplexity, args["clientID"].(string), args["input"].(models.HTTPAccountHoldRequest)), true
 
-	case "Mutation.refreshToken":
+	case "Mutation.adminHoldFiat":
+		if e.complexity.Mutation.AdminHoldFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_adminHoldFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AdminHoldFiat(childComplexity, args["clientID"].(string), args["input"].(models.HTTPAccountHoldRequest)), true
+
+	case "Mutation.adminReleaseCrypto":
+		if e.complexity.Mutation.AdminReleaseCrypto == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_adminReleaseCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AdminReleaseCrypto(childComplexity, args["clientID"].(string), args["input"].(models.HTTPAccountHoldRequest)), true
+
+	case "Mutation.adminReleaseFiat":
+		if e.complexity.Mutation.AdminReleaseFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_adminReleaseFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AdminReleaseFiat(childComplexity, args["clientID"].(string), args["input"].(models.HTTPAccountHoldRequest)), true
+
+	case "Mutation.adminReverseDeposit":
+		if e.complexity.Mutation.AdminReverseDeposit == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_adminReverseDeposit_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AdminReverseDeposit(childComplexity, args["clientID"].(string), args["transactionID"].(string)), true
+
+	case "Mutation.adminSetStatusCrypto":
+		if e.complexity.Mutation.AdminSetStatusCrypto == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_adminSetStatusCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AdminSetStatusCrypto(childComplexity, args["clientID"].(string), args["input"].(models.HTTPAccountStatusRequest)), true
+
+	case "Mutation.adminSetStatusFiat":
+		if e.complexity.Mutation.AdminSetStatusFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_adminSetStatusFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AdminSetStatusFiat(childComplexity, args["clientID"].(string), args["input"].(models.HTTPAccountStatusRequest)), true
+
+	case "Mutation.adminUnfreezeAccount":
+		if e.complexity.Mutation.AdminUnfreezeAccount == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_adminUnfreezeAccount_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AdminUnfreezeAccount(childComplexity, args["clientID"].(string)), true
+
+	case "Mutation.cancelOrder":
+		if e.complexity.Mutation.CancelOrder == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_cancelOrder_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CancelOrder(childComplexity, args["orderID"].(string)), true
+
+	case "Mutation.changePassword":
+		if e.complexity.Mutation.ChangePassword == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_changePassword_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ChangePassword(childComplexity, args["input"].(models.HTTPChangePasswordRequest)), true
+
+	case "Mutation.createAPIKey":
+		if e.complexity.Mutation.CreateAPIKey == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createAPIKey_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateAPIKey(childComplexity, args["input"].(models.HTTPAPIKeyRequest)), true
+
+	case "Mutation.createSchedule":
+		if e.complexity.Mutation.CreateSchedule == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createSchedule_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateSchedule(childComplexity, args["input"].(models.HTTPScheduleRequest), args["idempotencyKey"].(*string)), true
+
+	case "Mutation.createWebhook":
+		if e.complexity.Mutation.CreateWebhook == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createWebhook_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateWebhook(childComplexity, args["input"].(models.HTTPWebhookRequest)), true
+
+	case "Mutation.deleteSchedule":
+		if e.complexity.Mutation.DeleteSchedule == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteSchedule_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteSchedule(childComplexity, args["scheduleID"].(string)), true
+
+	case "Mutation.deleteUser":
+		if e.complexity.Mutation.DeleteUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteUser(childComplexity, args["input"].(models.HTTPDeleteUserRequest)), true
+
+	case "Mutation.deleteWebhook":
+		if e.complexity.Mutation.DeleteWebhook == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteWebhook_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteWebhook(childComplexity, args["webhookID"].(string)), true
+
+	case "Mutation.depositFiat":
+		if e.complexity.Mutation.DepositFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_depositFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DepositFiat(childComplexity, args["input"].(models.HTTPDepositCurrencyRequest), args["idempotencyKey"].(*string)), true
+
+	case "Mutation.disableMFA":
+		if e.complexity.Mutation.DisableMfa == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_disableMFA_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DisableMfa(childComplexity, args["input"].(models.HTTPMFACodeRequest)), true
+
+	case "Mutation.enrollMFA":
+		if e.complexity.Mutation.EnrollMfa == nil {
+			break
+		}
+
+		return e.complexity.Mutation.EnrollMfa(childComplexity), true
+
+	case "Mutation.exchangeCrypto":
+		if e.complexity.Mutation.ExchangeCrypto == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_exchangeCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ExchangeCrypto(childComplexity, args["offerID"].(string), args["idempotencyKey"].(*string)), true
+
+	case "Mutation.exchangeOfferFiat":
+		if e.complexity.Mutation.ExchangeOfferFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_exchangeOfferFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ExchangeOfferFiat(childComplexity, args["input"].(models.HTTPExchangeOfferRequest)), true
+
+	case "Mutation.exchangeSwapCrypto":
+		if e.complexity.Mutation.ExchangeSwapCrypto == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_exchangeSwapCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ExchangeSwapCrypto(childComplexity, args["offerID"].(string), args["idempotencyKey"].(*string)), true
+
+	case "Mutation.exchangeTransferFiat":
+		if e.complexity.Mutation.ExchangeTransferFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_exchangeTransferFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ExchangeTransferFiat(childComplexity, args["offerID"].(string), args["idempotencyKey"].(*string)), true
+
+	case "Mutation.loginUser":
+		if e.complexity.Mutation.LoginUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_loginUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.LoginUser(childComplexity, args["input"].(models1.UserLoginCredentials)), true
+
+	case "Mutation.loginUserMFA":
+		if e.complexity.Mutation.LoginUserMfa == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_loginUserMFA_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.LoginUserMfa(childComplexity, args["input"].(models.HTTPMFALoginRequest)), true
+
+	case "Mutation.logoutUser":
+		if e.complexity.Mutation.LogoutUser == nil {
+			break
+		}
+
+		return e.complexity.Mutation.LogoutUser(childComplexity), true
+
+	case "Mutation.logoutUserEverywhere":
+		if e.complexity.Mutation.LogoutUserEverywhere == nil {
+			break
+		}
+
+		return e.complexity.Mutation.LogoutUserEverywhere(childComplexity), true
+
+	case "Mutation.offerCrypto":
+		if e.complexity.Mutation.OfferCrypto == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_offerCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.OfferCrypto(childComplexity, args["input"].(models.HTTPCryptoOfferRequest)), true
+
+	case "Mutation.offerSwapCrypto":
+		if e.complexity.Mutation.OfferSwapCrypto == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_offerSwapCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.OfferSwapCrypto(childComplexity, args["input"].(models.HTTPExchangeOfferRequest)), true
+
+	case "Mutation.openCrypto":
+		if e.complexity.Mutation.OpenCrypto == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_openCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.OpenCrypto(childComplexity, args["ticker"].(string)), true
+
+	case "Mutation.openFiat":
+		if e.complexity.Mutation.OpenFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_openFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.OpenFiat(childComplexity, args["currency"].(string)), true
+
+	case "Mutation.placeOrder":
+		if e.complexity.Mutation.PlaceOrder == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_placeOrder_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PlaceOrder(childComplexity, args["input"].(models.HTTPOrderRequest), args["idempotencyKey"].(*string)), true
+
+	case "Mutation.refreshToken":
 		if e.complexity.Mutation.RefreshToken == nil {
 			break
 		}
 
-		return e.complexity.Mutation.RefreshToken(childComplexity), true
+		return e.complexity.Mutation.RefreshToken(childComplexity), true
+
+	case "Mutation.regenerateRecoveryCodes":
+		if e.complexity.Mutation.RegenerateRecoveryCodes == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_regenerateRecoveryCodes_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RegenerateRecoveryCodes(childComplexity, args["input"].(models.HTTPMFACodeRequest)), true
+
+	case "Mutation.registerUser":
+		if e.complexity.Mutation.RegisterUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_registerUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RegisterUser(childComplexity, args["input"].(*models1.UserAccount)), true
+
+	case "Mutation.requestPasswordReset":
+		if e.complexity.Mutation.RequestPasswordReset == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_requestPasswordReset_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RequestPasswordReset(childComplexity, args["input"].(models.HTTPPasswordResetRequest)), true
+
+	case "Mutation.resetPassword":
+		if e.complexity.Mutation.ResetPassword == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_resetPassword_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ResetPassword(childComplexity, args["input"].(models.HTTPResetPasswordRequest)), true
+
+	case "Mutation.revokeAPIKey":
+		if e.complexity.Mutation.RevokeAPIKey == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeAPIKey_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeAPIKey(childComplexity, args["keyID"].(string)), true
+
+	case "Mutation.transferP2PFiat":
+		if e.complexity.Mutation.TransferP2PFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_transferP2PFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.TransferP2PFiat(childComplexity, args["input"].(models.HTTPFiatP2PTransferRequest), args["idempotencyKey"].(*string)), true
+
+	case "Mutation.updateSchedule":
+		if e.complexity.Mutation.UpdateSchedule == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateSchedule_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateSchedule(childComplexity, args["scheduleID"].(string), args["input"].(models.HTTPScheduleUpdateRequest)), true
+
+	case "Mutation.verifyMFA":
+		if e.complexity.Mutation.VerifyMfa == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_verifyMFA_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.VerifyMfa(childComplexity, args["input"].(models.HTTPMFACodeRequest)), true
+
+	case "Mutation.withdrawFiat":
+		if e.complexity.Mutation.WithdrawFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_withdrawFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.WithdrawFiat(childComplexity, args["input"].(models.HTTPWithdrawCurrencyRequest), args["idempotencyKey"].(*string)), true
+
+	case "OfferResponse.debitAmount":
+		if e.complexity.OfferResponse.DebitAmount == nil {
+			break
+		}
+
+		return e.complexity.OfferResponse.DebitAmount(childComplexity), true
+
+	case "OfferResponse.expires":
+		if e.complexity.OfferResponse.Expires == nil {
+			break
+		}
+
+		return e.complexity.OfferResponse.Expires(childComplexity), true
+
+	case "OfferResponse.fee":
+		if e.complexity.OfferResponse.Fee == nil {
+			break
+		}
+
+		return e.complexity.OfferResponse.Fee(childComplexity), true
+
+	case "OfferResponse.offerID":
+		if e.complexity.OfferResponse.OfferID == nil {
+			break
+		}
+
+		return e.complexity.OfferResponse.OfferID(childComplexity), true
+
+	case "OfferResponse.priceQuote":
+		if e.complexity.OfferResponse.PriceQuote == nil {
+			break
+		}
+
+		return e.complexity.OfferResponse.PriceQuote(childComplexity), true
+
+	case "Order.amount":
+		if e.complexity.Order.Amount == nil {
+			break
+		}
+
+		return e.complexity.Order.Amount(childComplexity), true
+
+	case "Order.clientID":
+		if e.complexity.Order.ClientID == nil {
+			break
+		}
+
+		return e.complexity.Order.ClientID(childComplexity), true
+
+	case "Order.createdAt":
+		if e.complexity.Order.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Order.CreatedAt(childComplexity), true
+
+	case "Order.destination":
+		if e.complexity.Order.Destination == nil {
+			break
+		}
+
+		return e.complexity.Order.Destination(childComplexity), true
+
+	case "Order.fee":
+		if e.complexity.Order.Fee == nil {
+			break
+		}
+
+		return e.complexity.Order.Fee(childComplexity), true
+
+	case "Order.fillAmount":
+		if e.complexity.Order.FillAmount == nil {
+			break
+		}
+
+		return e.complexity.Order.FillAmount(childComplexity), true
+
+	case "Order.fillRate":
+		if e.complexity.Order.FillRate == nil {
+			break
+		}
+
+		return e.complexity.Order.FillRate(childComplexity), true
+
+	case "Order.limitRate":
+		if e.complexity.Order.LimitRate == nil {
+			break
+		}
+
+		return e.complexity.Order.LimitRate(childComplexity), true
+
+	case "Order.orderID":
+		if e.complexity.Order.OrderID == nil {
+			break
+		}
+
+		return e.complexity.Order.OrderID(childComplexity), true
+
+	case "Order.source":
+		if e.complexity.Order.Source == nil {
+			break
+		}
+
+		return e.complexity.Order.Source(childComplexity), true
+
+	case "Order.status":
+		if e.complexity.Order.Status == nil {
+			break
+		}
+
+		return e.complexity.Order.Status(childComplexity), true
+
+	case "Order.txID":
+		if e.complexity.Order.TxID == nil {
+			break
+		}
+
+		return e.complexity.Order.TxID(childComplexity), true
+
+	case "Order.updatedAt":
+		if e.complexity.Order.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Order.UpdatedAt(childComplexity), true
+
+	case "OrdersPaginated.links":
+		if e.complexity.OrdersPaginated.Links == nil {
+			break
+		}
+
+		return e.complexity.OrdersPaginated.Links(childComplexity), true
+
+	case "OrdersPaginated.orders":
+		if e.complexity.OrdersPaginated.Orders == nil {
+			break
+		}
+
+		return e.complexity.OrdersPaginated.Orders(childComplexity), true
+
+	case "PasswordResetResponse.expires":
+		if e.complexity.PasswordResetResponse.Expires == nil {
+			break
+		}
+
+		return e.complexity.PasswordResetResponse.Expires(childComplexity), true
+
+	case "PriceQuote.amount":
+		if e.complexity.PriceQuote.Amount == nil {
+			break
+		}
+
+		return e.complexity.PriceQuote.Amount(childComplexity), true
+
+	case "PriceQuote.clientID":
+		if e.complexity.PriceQuote.ClientID == nil {
+			break
+		}
+
+		return e.complexity.PriceQuote.ClientID(childComplexity), true
+
+	case "PriceQuote.destinationAcc":
+		if e.complexity.PriceQuote.DestinationAcc == nil {
+			break
+		}
+
+		return e.complexity.PriceQuote.DestinationAcc(childComplexity), true
+
+	case "PriceQuote.rate":
+		if e.complexity.PriceQuote.Rate == nil {
+			break
+		}
+
+		return e.complexity.PriceQuote.Rate(childComplexity), true
+
+	case "PriceQuote.sourceAcc":
+		if e.complexity.PriceQuote.SourceAcc == nil {
+			break
+		}
+
+		return e.complexity.PriceQuote.SourceAcc(childComplexity), true
+
+	case "Query.apiKeys":
+		if e.complexity.Query.APIKeys == nil {
+			break
+		}
+
+		return e.complexity.Query.APIKeys(childComplexity), true
+
+	case "Query.adminBalanceAllCrypto":
+		if e.complexity.Query.AdminBalanceAllCrypto == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminBalanceAllCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminBalanceAllCrypto(childComplexity, args["clientID"].(string), args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+
+	case "Query.adminBalanceAllFiat":
+		if e.complexity.Query.AdminBalanceAllFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminBalanceAllFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminBalanceAllFiat(childComplexity, args["clientID"].(string), args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+
+	case "Query.adminTransactionDetails":
+		if e.complexity.Query.AdminTransactionDetails == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminTransactionDetails_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminTransactionDetails(childComplexity, args["clientID"].(string), args["transactionID"].(string)), true
+
+	case "Query.adminTransactionDetailsAllCrypto":
+		if e.complexity.Query.AdminTransactionDetailsAllCrypto == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminTransactionDetailsAllCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminTransactionDetailsAllCrypto(childComplexity, args["clientID"].(string), args["input"].(models.CryptoPaginatedTxDetailsRequest)), true
+
+	case "Query.adminTransactionDetailsAllFiat":
+		if e.complexity.Query.AdminTransactionDetailsAllFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminTransactionDetailsAllFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminTransactionDetailsAllFiat(childComplexity, args["clientID"].(string), args["input"].(models.FiatPaginatedTxDetailsRequest)), true
+
+	case "Query.adminUser":
+		if e.complexity.Query.AdminUser == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminUser(childComplexity, args["username"].(string)), true
+
+	case "Query.balanceAllCrypto":
+		if e.complexity.Query.BalanceAllCrypto == nil {
+			break
+		}
+
+		args, err := ec.field_Query_balanceAllCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.BalanceAllCrypto(childComplexity, args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+
+	case "Query.balanceAllFiat":
+		if e.complexity.Query.BalanceAllFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Query_balanceAllFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.BalanceAllFiat(childComplexity, args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+
+	case "Query.balanceCrypto":
+		if e.complexity.Query.BalanceCrypto == nil {
+			break
+		}
+
+		args, err := ec.field_Query_balanceCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.BalanceCrypto(childComplexity, args["ticker"].(string)), true
+
+	case "Query.balanceFiat":
+		if e.complexity.Query.BalanceFiat == nil {
+			break
+		}
+
+		args, err := ec.field_Query_balanceFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.BalanceFiat(childComplexity, args["currencyCode"].(string)), true
+
+	case "Query.healthcheck":
+		if e.complexity.Query.Healthcheck == nil {
+			break
+		}
+
+		return e.complexity.Query.Healthcheck(childComplexity), true
+
+	case "Query.loginEvents":
+		if e.complexity.Query.LoginEvents == nil {
+			break
+		}
+
+		args, err := ec.field_Query_loginEvents_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.LoginEvents(childComplexity, args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+
+	case "Query.orders":
+		if e.complexity.Query.Orders == nil {
+			break
+		}
+
+		args, err := ec.field_Query_orders_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Orders(childComplexity, args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+
+	case "Query.rateHistory":
+		if e.complexity.Query.RateHistory == nil {
+			break
+		}
+
+		args, err := ec.field_Query_rateHistory_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Mutation.registerUser":
-		if e.complexity.Mutation.RegisterUser == nil {
+		return e.complexity.Query.RateHistory(childComplexity, args["input"].(models.HTTPRateHistoryRequest)), true
+
+	case "Query.scheduleRuns":
+		if e.complexity.Query.ScheduleRuns == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_registerUser_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_scheduleRuns_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.RegisterUser(childComplexity, args["input"].(*models1.UserAccount)), true
+		return e.complexity.Query.ScheduleRuns(childComplexity, args["scheduleID"].(string), args["pageCursor"].(*string), args["pageSize"].(*int32)), true
 
-	case "OfferResponse.debitAmount":
-		if e.complexity.OfferResponse.DebitAmount == nil {
+	case "Query.schedules":
+		if e.complexity.Query.Schedules == nil {
 			break
 		}
 
-		return e.complexity.OfferResponse.DebitAmount(childComplexity), true
+		args, err := ec.field_Query_schedules_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "OfferResponse.expires":
-		if e.complexity.OfferResponse.Expires == nil {
+		return e.complexity.Query.Schedules(childComplexity, args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+
+	case "Query.transactionDetailsAllCrypto":
+		if e.complexity.Query.TransactionDetailsAllCrypto == nil {
 			break
 		}
 
-		return e.complexity.OfferResponse.Expires(childComplexity), true
+		args, err := ec.field_Query_transactionDetailsAllCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "OfferResponse.offerID":
-		if e.complexity.OfferResponse.OfferID == nil {
+		return e.complexity.Query.TransactionDetailsAllCrypto(childComplexity, args["input"].(models.CryptoPaginatedTxDetailsRequest)), true
+
+	case "Query.transactionDetailsAllFiat":
+		if e.complexity.Query.TransactionDetailsAllFiat == nil {
 			break
 		}
 
-		return e.complexity.OfferResponse.OfferID(childComplexity), true
+		args, err := ec.field_Query_transactionDetailsAllFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "OfferResponse.priceQuote":
-		if e.complexity.OfferResponse.PriceQuote == nil {
+		return e.complexity.Query.TransactionDetailsAllFiat(childComplexity, args["input"].(models.FiatPaginatedTxDetailsRequest)), true
+
+	case "Query.transactionDetailsCrypto":
+		if e.complexity.Query.TransactionDetailsCrypto == nil {
 			break
 		}
 
-		return e.complexity.OfferResponse.PriceQuote(childComplexity), true
+		args, err := ec.field_Query_transactionDetailsCrypto_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "PriceQuote.amount":
-		if e.complexity.PriceQuote.Amount == nil {
+		return e.complexity.Query.TransactionDetailsCrypto(childComplexity, args["transactionID"].(string)), true
+
+	case "Query.transactionDetailsFiat":
+		if e.complexity.Query.TransactionDetailsFiat == nil {
 			break
 		}
 
-		return e.complexity.PriceQuote.Amount(childComplexity), true
+		args, err := ec.field_Query_transactionDetailsFiat_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "PriceQuote.clientID":
-		if e.complexity.PriceQuote.ClientID == nil {
+		return e.complexity.Query.TransactionDetailsFiat(childComplexity, args["transactionID"].(string)), true
+
+	case "Query.webhookDeadLetters":
+		if e.complexity.Query.WebhookDeadLetters == nil {
 			break
 		}
 
-		return e.complexity.PriceQuote.ClientID(childComplexity), true
+		args, err := ec.field_Query_webhookDeadLetters_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "PriceQuote.destinationAcc":
-		if e.complexity.PriceQuote.DestinationAcc == nil {
+		return e.complexity.Query.WebhookDeadLetters(childComplexity, args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+
+	case "Query.webhooks":
+		if e.complexity.Query.Webhooks == nil {
 			break
 		}
 
-		return e.complexity.PriceQuote.DestinationAcc(childComplexity), true
+		return e.complexity.Query.Webhooks(childComplexity), true
 
-	case "PriceQuote.rate":
-		if e.complexity.PriceQuote.Rate == nil {
+	case "RateCandle.close":
+		if e.complexity.RateCandle.Close == nil {
 			break
 		}
 
-		return e.complexity.PriceQuote.Rate(childComplexity), true
+		return e.complexity.RateCandle.Close(childComplexity), true
 
-	case "PriceQuote.sourceAcc":
-		if e.complexity.PriceQuote.SourceAcc == nil {
+	case "RateCandle.high":
+		if e.complexity.RateCandle.High == nil {
 			break
 		}
 
-		return e.complexity.PriceQuote.SourceAcc(childComplexity), true
+		return e.complexity.RateCandle.High(childComplexity), true
 
-	case "Query.balanceAllCrypto":
-		if e.complexity.Query.BalanceAllCrypto == nil {
+	case "RateCandle.low":
+		if e.complexity.RateCandle.Low == nil {
 			break
 		}
 
-		args, err := ec.field_Query_balanceAllCrypto_args(context.TODO(), rawArgs)
+		return e.complexity.RateCandle.Low(childComplexity), true
+
+	case "RateCandle.open":
+		if e.complexity.RateCandle.Open == nil {
+			break
+		}
+
+		return e.complexity.RateCandle.Open(childComplexity), true
+
+	case "RateCandle.quotes":
+		if e.complexity.RateCandle.Quotes == nil {
+			break
+		}
+
+		return e.complexity.RateCandle.Quotes(childComplexity), true
+
+	case "RateCandle.start":
+		if e.complexity.RateCandle.Start == nil {
+			break
+		}
+
+		return e.complexity.RateCandle.Start(childComplexity), true
+
+	case "RateHistory.candles":
+		if e.complexity.RateHistory.Candles == nil {
+			break
+		}
+
+		return e.complexity.RateHistory.Candles(childComplexity), true
+
+	case "RateHistory.destination":
+		if e.complexity.RateHistory.Destination == nil {
+			break
+		}
+
+		return e.complexity.RateHistory.Destination(childComplexity), true
+
+	case "RateHistory.interval":
+		if e.complexity.RateHistory.Interval == nil {
+			break
+		}
+
+		return e.complexity.RateHistory.Interval(childComplexity), true
+
+	case "RateHistory.source":
+		if e.complexity.RateHistory.Source == nil {
+			break
+		}
+
+		return e.complexity.RateHistory.Source(childComplexity), true
+
+	case "RateTick.destination":
+		if e.complexity.RateTick.Destination == nil {
+			break
+		}
+
+		return e.complexity.RateTick.Destination(childComplexity), true
+
+	case "RateTick.quotedAt":
+		if e.complexity.RateTick.QuotedAt == nil {
+			break
+		}
+
+		return e.complexity.RateTick.QuotedAt(childComplexity), true
+
+	case "RateTick.rate":
+		if e.complexity.RateTick.Rate == nil {
+			break
+		}
+
+		return e.complexity.RateTick.Rate(childComplexity), true
+
+	case "RateTick.source":
+		if e.complexity.RateTick.Source == nil {
+			break
+		}
+
+		return e.complexity.RateTick.Source(childComplexity), true
+
+	case "Schedule.amount":
+		if e.complexity.Schedule.Amount == nil {
+			break
+		}
+
+		return e.complexity.Schedule.Amount(childComplexity), true
+
+	case "Schedule.clientID":
+		if e.complexity.Schedule.ClientID == nil {
+			break
+		}
+
+		return e.complexity.Schedule.ClientID(childComplexity), true
+
+	case "Schedule.createdAt":
+		if e.complexity.Schedule.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Schedule.CreatedAt(childComplexity), true
+
+	case "Schedule.fiatCurrency":
+		if e.complexity.Schedule.FiatCurrency == nil {
+			break
+		}
+
+		return e.complexity.Schedule.FiatCurrency(childComplexity), true
+
+	case "Schedule.frequency":
+		if e.complexity.Schedule.Frequency == nil {
+			break
+		}
+
+		return e.complexity.Schedule.Frequency(childComplexity), true
+
+	case "Schedule.isActive":
+		if e.complexity.Schedule.IsActive == nil {
+			break
+		}
+
+		return e.complexity.Schedule.IsActive(childComplexity), true
+
+	case "Schedule.nextRunAt":
+		if e.complexity.Schedule.NextRunAt == nil {
+			break
+		}
+
+		return e.complexity.Schedule.NextRunAt(childComplexity), true
+
+	case "Schedule.scheduleID":
+		if e.complexity.Schedule.ScheduleID == nil {
+			break
+		}
+
+		return e.complexity.Schedule.ScheduleID(childComplexity), true
+
+	case "Schedule.ticker":
+		if e.complexity.Schedule.Ticker == nil {
+			break
+		}
+
+		return e.complexity.Schedule.Ticker(childComplexity), true
+
+	case "Schedule.updatedAt":
+		if e.complexity.Schedule.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Schedule.UpdatedAt(childComplexity), true
+
+	case "ScheduleRun.clientID":
+		if e.complexity.ScheduleRun.ClientID == nil {
+			break
+		}
+
+		return e.complexity.ScheduleRun.ClientID(childComplexity), true
+
+	case "ScheduleRun.cryptoAmount":
+		if e.complexity.ScheduleRun.CryptoAmount == nil {
+			break
+		}
+
+		return e.complexity.ScheduleRun.CryptoAmount(childComplexity), true
+
+	case "ScheduleRun.executedAt":
+		if e.complexity.ScheduleRun.ExecutedAt == nil {
+			break
+		}
+
+		return e.complexity.ScheduleRun.ExecutedAt(childComplexity), true
+
+	case "ScheduleRun.fee":
+		if e.complexity.ScheduleRun.Fee == nil {
+			break
+		}
+
+		return e.complexity.ScheduleRun.Fee(childComplexity), true
+
+	case "ScheduleRun.fiatAmount":
+		if e.complexity.ScheduleRun.FiatAmount == nil {
+			break
+		}
+
+		return e.complexity.ScheduleRun.FiatAmount(childComplexity), true
+
+	case "ScheduleRun.message":
+		if e.complexity.ScheduleRun.Message == nil {
+			break
+		}
+
+		return e.complexity.ScheduleRun.Message(childComplexity), true
+
+	case "ScheduleRun.rate":
+		if e.complexity.ScheduleRun.Rate == nil {
+			break
+		}
+
+		return e.complexity.ScheduleRun.Rate(childComplexity), true
+
+	case "ScheduleRun.runID":
+		if e.complexity.ScheduleRun.RunID == nil {
+			break
+		}
+
+		return e.complexity.ScheduleRun.RunID(childComplexity), true
+
+	case "ScheduleRun.scheduleID":
+		if e.complexity.ScheduleRun.ScheduleID == nil {
+			break
+		}
+
+		return e.complexity.ScheduleRun.ScheduleID(childComplexity), true
+
+	case "ScheduleRun.status":
+		if e.complexity.ScheduleRun.Status == nil {
+			break
+		}
+
+		return e.complexity.ScheduleRun.Status(childComplexity), true
+
+	case "ScheduleRun.txID":
+		if e.complexity.ScheduleRun.TxID == nil {
+			break
+		}
+
+		return e.complexity.ScheduleRun.TxID(childComplexity), true
+
+	case "ScheduleRunsPaginated.links":
+		if e.complexity.ScheduleRunsPaginated.Links == nil {
+			break
+		}
+
+		return e.complexity.ScheduleRunsPaginated.Links(childComplexity), true
+
+	case "ScheduleRunsPaginated.runs":
+		if e.complexity.ScheduleRunsPaginated.Runs == nil {
+			break
+		}
+
+		return e.complexity.ScheduleRunsPaginated.Runs(childComplexity), true
+
+	case "SchedulesPaginated.links":
+		if e.complexity.SchedulesPaginated.Links == nil {
+			break
+		}
+
+		return e.complexity.SchedulesPaginated.Links(childComplexity), true
+
+	case "SchedulesPaginated.schedules":
+		if e.complexity.SchedulesPaginated.Schedules == nil {
+			break
+		}
+
+		return e.complexity.SchedulesPaginated.Schedules(childComplexity), true
+
+	case "Subscription.balanceUpdated":
+		if e.complexity.Subscription.BalanceUpdated == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_balanceUpdated_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.BalanceAllCrypto(childComplexity, args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+		return e.complexity.Subscription.BalanceUpdated(childComplexity, args["currency"].(string)), true
 
-	case "Query.balanceAllFiat":
-		if e.complexity.Query.BalanceAllFiat == nil {
+	case "Subscription.rateTicker":
+		if e.complexity.Subscription.RateTicker == nil {
 			break
 		}
 
-		args, err := ec.field_Query_balanceAllFiat_args(context.TODO(), rawArgs)
+		args, err := ec.field_Subscription_rateTicker_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.BalanceAllFiat(childComplexity, args["pageCursor"].(*string), args["pageSize"].(*int32)), true
+		return e.complexity.Subscription.RateTicker(childComplexity, args["pair"].(string)), true
 
-	case "Query.balanceCrypto":
-		if e.complexity.Query.BalanceCrypto == nil {
+	case "Webhook.clientID":
+		if e.complexity.Webhook.ClientID == nil {
+			break
+		}
+
+		return e.complexity.Webhook.ClientID(childComplexity), true
+
+	case "Webhook.createdAt":
+		if e.complexity.Webhook.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Webhook.CreatedAt(childComplexity), true
+
+	case "Webhook.url":
+		if e.complexity.Webhook.Url == nil {
+			break
+		}
+
+		return e.complexity.Webhook.Url(childComplexity), true
+
+	case "Webhook.webhookID":
+		if e.complexity.Webhook.WebhookID == nil {
+			break
+		}
+
+		return e.complexity.Webhook.WebhookID(childComplexity), true
+
+	case "WebhookDeadLetter.attempts":
+		if e.complexity.WebhookDeadLetter.Attempts == nil {
+			break
+		}
+
+		return e.complexity.WebhookDeadLetter.Attempts(childComplexity), true
+
+	case "WebhookDeadLetter.createdAt":
+		if e.complexity.WebhookDeadLetter.CreatedAt == nil {
 			break
 		}
 
-		args, err := ec.field_Query_balanceCrypto_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.WebhookDeadLetter.CreatedAt(childComplexity), true
+
+	case "WebhookDeadLetter.deliveryID":
+		if e.complexity.WebhookDeadLetter.DeliveryID == nil {
+			break
 		}
 
-		return e.complexity.Query.BalanceCrypto(childComplexity, args["ticker"].(string)), true
+		return e.complexity.WebhookDeadLetter.DeliveryID(childComplexity), true
 
-	case "Query.balanceFiat":
-		if e.complexity.Query.BalanceFiat == nil {
+	case "WebhookDeadLetter.eventID":
+		if e.complexity.WebhookDeadLetter.EventID == nil {
 			break
 		}
 
-		args, err := ec.field_Query_balanceFiat_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.WebhookDeadLetter.EventID(childComplexity), true
+
+	case "WebhookDeadLetter.eventType":
+		if e.complexity.WebhookDeadLetter.EventType == nil {
+			break
 		}
 
-		return e.complexity.Query.BalanceFiat(childComplexity, args["currencyCode"].(string)), true
+		return e.complexity.WebhookDeadLetter.EventType(childComplexity), true
 
-	case "Query.healthcheck":
-		if e.complexity.Query.Healthcheck == nil {
+	case "WebhookDeadLetter.failedAt":
+		if e.complexity.WebhookDeadLetter.FailedAt == nil {
 			break
 		}
 
-		return e.complexity.Query.Healthcheck(childComplexity), true
+		return e.complexity.WebhookDeadLetter.FailedAt(childComplexity), true
 
-	case "Query.transactionDetailsAllCrypto":
-		if e.complexity.Query.TransactionDetailsAllCrypto == nil {
+	case "WebhookDeadLetter.lastError":
+		if e.complexity.WebhookDeadLetter.LastError == nil {
 			break
 		}
 
-		args, err := ec.field_Query_transactionDetailsAllCrypto_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.WebhookDeadLetter.LastError(childComplexity), true
+
+	case "WebhookDeadLetter.payload":
+		if e.complexity.WebhookDeadLetter.Payload == nil {
+			break
 		}
 
-		return e.complexity.Query.TransactionDetailsAllCrypto(childComplexity, args["input"].(models.CryptoPaginatedTxDetailsRequest)), true
+		return e.complexity.WebhookDeadLetter.Payload(childComplexity), true
 
-	case "Query.transactionDetailsAllFiat":
-		if e.complexity.Query.TransactionDetailsAllFiat == nil {
+	case "WebhookDeadLetter.url":
+		if e.complexity.WebhookDeadLetter.URL == nil {
 			break
 		}
 
-		args, err := ec.field_Query_transactionDetailsAllFiat_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.WebhookDeadLetter.URL(childComplexity), true
+
+	case "WebhookDeadLetter.webhookID":
+		if e.complexity.WebhookDeadLetter.WebhookID == nil {
+			break
 		}
 
-		return e.complexity.Query.TransactionDetailsAllFiat(childComplexity, args["input"].(models.FiatPaginatedTxDetailsRequest)), true
+		return e.complexity.WebhookDeadLetter.WebhookID(childComplexity), true
 
-	case "Query.transactionDetailsCrypto":
-		if e.complexity.Query.TransactionDetailsCrypto == nil {
+	case "WebhookDeadLettersPaginated.deadLetters":
+		if e.complexity.WebhookDeadLettersPaginated.DeadLetters == nil {
 			break
 		}
 
-		args, err := ec.field_Query_transactionDetailsCrypto_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.WebhookDeadLettersPaginated.DeadLetters(childComplexity), true
+
+	case "WebhookDeadLettersPaginated.links":
+		if e.complexity.WebhookDeadLettersPaginated.Links == nil {
+			break
 		}
 
-		return e.complexity.Query.TransactionDetailsCrypto(childComplexity, args["transactionID"].(string)), true
+		return e.complexity.WebhookDeadLettersPaginated.Links(childComplexity), true
 
-	case "Query.transactionDetailsFiat":
-		if e.complexity.Query.TransactionDetailsFiat == nil {
+	case "WebhookResponse.secret":
+		if e.complexity.WebhookResponse.Secret == nil {
 			break
 		}
 
-		args, err := ec.field_Query_transactionDetailsFiat_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.WebhookResponse.Secret(childComplexity), true
+
+	case "WebhookResponse.webhook":
+		if e.complexity.WebhookResponse.Webhook == nil {
+			break
 		}
 
-		return e.complexity.Query.TransactionDetailsFiat(childComplexity, args["transactionID"].(string)), true
+		return e.complexity.WebhookResponse.Webhook(childComplexity), true
 
 	}
 	return 0, false
 }
 
 func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	rc := graphql.GetOperationContext(ctx)
-	ec := executionContext{rc, e, 0, 0, make(chan graphql.DeferredResult)}
+	opCtx := graphql.GetOperationContext(ctx)
+	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAPIKeyRequest,
+		ec.unmarshalInputAdminAccountHoldRequest,
+		ec.unmarshalInputAdminAccountStatusRequest,
+		ec.unmarshalInputChangePasswordRequest,
 		ec.unmarshalInputCryptoOfferRequest,
 		ec.unmarshalInputCryptoPaginatedTxDetailsRequest,
+		ec.unmarshalInputCryptoSwapOfferRequest,
 		ec.unmarshalInputDeleteUserRequest,
 		ec.unmarshalInputFiatDepositRequest,
 		ec.unmarshalInputFiatExchangeOfferRequest,
+		ec.unmarshalInputFiatP2PTransferRequest,
 		ec.unmarshalInputFiatPaginatedTxDetailsRequest,
+		ec.unmarshalInputFiatWithdrawRequest,
+		ec.unmarshalInputMFACodeRequest,
+		ec.unmarshalInputMFALoginRequest,
+		ec.unmarshalInputOrderRequest,
+		ec.unmarshalInputPasswordResetRequest,
+		ec.unmarshalInputRateHistoryRequest,
+		ec.unmarshalInputResetPasswordRequest,
+		ec.unmarshalInputScheduleRequest,
+		ec.unmarshalInputScheduleUpdateRequest,
 		ec.unmarshalInputUserAccount,
 		ec.unmarshalInputUserLoginCredentials,
+		ec.unmarshalInputWebhookRequest,
 	)
 	first := true
 
-	switch rc.Operation.Operation {
+	switch opCtx.Operation.Operation {
 	case ast.Query:
 		return func(ctx context.Context) *graphql.Response {
 			var response graphql.Response
@@ -873,7 +2537,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			if first {
 				first = false
 				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-				data = ec._Query(ctx, rc.Operation.SelectionSet)
+				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
 			} else {
 				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
 					result := <-ec.deferredResults
@@ -903,10 +2567,27 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			}
 			first = false
 			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-			data := ec._Mutation(ctx, rc.Operation.SelectionSet)
+			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -959,6 +2640,115 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 }
 
 var sources = []*ast.Source{
+	{Name: "../schema/admin.graphqls", Input: `# AdminUser is a client's account details as seen by an administrator.
+type AdminUser {
+    clientID:   String!
+    username:   String!
+    firstName:  String!
+    lastName:   String!
+    email:      String!
+    role:       String!
+    isFrozen:   Boolean!
+    isDeleted:  Boolean!
+}
+
+# AdminAccountStatusRequest is a request to set the status of a Fiat currency or Cryptocurrency account.
+input AdminAccountStatusRequest {
+    currency:   String!
+    status:     String!
+}
+
+# AdminAccountHoldRequest is a request to place or release a hold on funds in a Fiat currency or Cryptocurrency account.
+input AdminAccountHoldRequest {
+    currency:   String!
+    amount:     Float!
+}
+
+# Requests that might alter the state of data in the database.
+extend type Mutation {
+    # adminFreezeAccount is a request to freeze a client's account. Frozen accounts cannot move funds.
+    adminFreezeAccount(clientID: String!): String!
+
+    # adminUnfreezeAccount is a request to unfreeze a client's account.
+    adminUnfreezeAccount(clientID: String!): String!
+
+    # adminReverseDeposit is a request to reverse a Fiat deposit into a client's account with a compensating entry.
+    adminReverseDeposit(clientID: String!, transactionID: String!): FiatDepositResponse!
+
+    # adminSetStatusFiat is a request to set the status of a client's Fiat account to active, frozen, or closed.
+    adminSetStatusFiat(clientID: String!, input: AdminAccountStatusRequest!): FiatAccount!
+
+    # adminHoldFiat is a request to place a hold on funds in a client's Fiat account.
+    adminHoldFiat(clientID: String!, input: AdminAccountHoldRequest!): FiatAccount!
+
+    # adminReleaseFiat is a request to release funds on hold in a client's Fiat account.
+    adminReleaseFiat(clientID: String!, input: AdminAccountHoldRequest!): FiatAccount!
+
+    # adminSetStatusCrypto is a request to set the status of a client's Crypto account to active, frozen, or closed.
+    adminSetStatusCrypto(clientID: String!, input: AdminAccountStatusRequest!): CryptoAccount!
+
+    # adminHoldCrypto is a request to place a hold on funds in a client's Crypto account.
+    adminHoldCrypto(clientID: String!, input: AdminAccountHoldRequest!): CryptoAccount!
+
+    # adminReleaseCrypto is a request to release funds on hold in a client's Crypto account.
+    adminReleaseCrypto(clientID: String!, input: AdminAccountHoldRequest!): CryptoAccount!
+}
+
+extend type Query {
+    # adminUser is a request to look up a client's account details by username.
+    adminUser(username: String!): AdminUser!
+
+    # adminBalanceAllFiat is a request to retrieve all of a client's Fiat account balances.
+    adminBalanceAllFiat(clientID: String!, pageCursor: String, pageSize: Int32): FiatBalancesPaginated!
+
+    # adminTransactionDetailsAllFiat is a request to retrieve a client's Fiat transactions for a specific currency.
+    adminTransactionDetailsAllFiat(clientID: String!, input: FiatPaginatedTxDetailsRequest!): FiatTransactionsPaginated!
+
+    # adminBalanceAllCrypto is a request to retrieve all of a client's Crypto account balances.
+    adminBalanceAllCrypto(clientID: String!, pageCursor: String, pageSize: Int32): CryptoBalancesPaginated!
+
+    # adminTransactionDetailsAllCrypto is a request to retrieve a client's Crypto transactions for a specific ticker.
+    adminTransactionDetailsAllCrypto(clientID: String!, input: CryptoPaginatedTxDetailsRequest!): CryptoTransactionsPaginated!
+
+    # adminTransactionDetails is a request to retrieve the details for a specific transaction on a client's account.
+    adminTransactionDetails(clientID: String!, transactionID: String!): [Any!]!
+}
+`, BuiltIn: false},
+	{Name: "../schema/apikeys.graphqls", Input: `# APIKey is an API key that a programmatic client can use in place of a JWT. The key itself is never returned.
+type APIKey {
+    keyID:      UUID!
+    clientID:   UUID!
+    name:       String!
+    scopes:     [String!]!
+    createdAt:  String!
+}
+
+# APIKeyResponse is a freshly generated API key and its details. The key is only ever returned once.
+type APIKeyResponse {
+    key:        String!
+    apiKey:     APIKey!
+}
+
+# APIKeyRequest is the name of an API key and the scopes it grants: read-balances, trade, deposit, and withdraw.
+input APIKeyRequest {
+    name:       String!
+    scopes:     [String!]!
+}
+
+# Requests that might alter the state of data in the database.
+extend type Mutation {
+    # createAPIKey is a request to generate an API key with scopes for a programmatic client.
+    createAPIKey(input: APIKeyRequest!): APIKeyResponse!
+
+    # revokeAPIKey is a request to revoke an API key. Requests made with the key are rejected immediately.
+    revokeAPIKey(keyID: String!): String!
+}
+
+extend type Query {
+    # apiKeys is a request to retrieve the API keys for a client that have not been revoked, oldest first.
+    apiKeys: [APIKey!]!
+}
+`, BuiltIn: false},
 	{Name: "../schema/auth.graphqls", Input: `# JWT Authorization Response.
 type JWTAuthResponse {
     token: String!
@@ -979,6 +2769,7 @@ type PriceQuote {
 type OfferResponse {
     priceQuote: PriceQuote!
     debitAmount: Float!
+    fee: Float!
     offerID: String!
     expires: Int64!
 }
@@ -997,6 +2788,9 @@ type CryptoAccount {
     lastTxTs:   String!
     createdAt:  String!
     clientID:   UUID!
+    status:     String!
+    held:       Float!
+    available:  Float!
 }
 
 # CryptoOpenAccountResponse is the response returned when opening a Cryptocurrency account.
@@ -1020,6 +2814,12 @@ type CryptoTransferResponse {
     cryptoTxReceipt:    CryptoJournal
 }
 
+# CryptoSwapResponse is the response to a successful Cryptocurrency to Cryptocurrency swap request.
+type CryptoSwapResponse {
+    sourceReceipt:      CryptoJournal!
+    destinationReceipt: CryptoJournal!
+}
+
 # CryptoBalancesPaginated are all of the Crypto account balances retrieved via pagination.
 type CryptoBalancesPaginated {
     accountBalances:    [CryptoAccount!]!
@@ -1040,6 +2840,13 @@ input CryptoOfferRequest {
     isPurchase:             Boolean!
 }
 
+# CryptoSwapOfferRequest is the request parameters to swap one Cryptocurrency for another.
+input CryptoSwapOfferRequest {
+    sourceCurrency:         String!
+    destinationCurrency:    String!
+    sourceAmount:           Float!
+}
+
 # CryptoPaginatedTxDetailsRequest request input parameters for all transaction records for a specific currency.
 input CryptoPaginatedTxDetailsRequest{
     ticker:     String!
@@ -1059,7 +2866,13 @@ extend type Mutation {
     offerCrypto(input: CryptoOfferRequest!): OfferResponse!
 
     # offerCrypto is a request for a Cryptocurrency purchase/sale quote. The exchange quote provided will expire after a fixed period.
-    exchangeCrypto(offerID: String!): CryptoTransferResponse!
+    exchangeCrypto(offerID: String!, idempotencyKey: String): CryptoTransferResponse!
+
+    # offerSwapCrypto is a request for a Cryptocurrency to Cryptocurrency swap quote. The exchange quote provided will expire after a fixed period.
+    offerSwapCrypto(input: CryptoSwapOfferRequest!): OfferResponse!
+
+    # exchangeSwapCrypto will execute and complete a valid Cryptocurrency swap offer.
+    exchangeSwapCrypto(offerID: String!, idempotencyKey: String): CryptoSwapResponse!
 }
 
 
@@ -1107,6 +2920,9 @@ type FiatAccount {
     lastTxTs:   String!
     createdAt:  String!
     clientID:   UUID!
+    status:     String!
+    held:       Float!
+    available:  Float!
 }
 
 # FiatJournal are the Fiat transactional records for a specific transaction.
@@ -1136,6 +2952,19 @@ input FiatDepositRequest {
     currency:   String!
 }
 
+# FiatWithdrawRequest is a request to withdraw Fiat currency to an external destination.
+input FiatWithdrawRequest {
+    amount:     Float!
+    currency:   String!
+}
+
+# FiatP2PTransferRequest is a request to transfer Fiat currency to another client in the same currency.
+input FiatP2PTransferRequest {
+    username:   String!
+    currency:   String!
+    amount:     Float!
+}
+
 # FiatExchangeOfferRequest is a request to exchange Fiat currency from one to another.
 input FiatExchangeOfferRequest {
     sourceCurrency:         String!
@@ -1159,13 +2988,19 @@ extend type Mutation {
     openFiat(currency: String!): FiatOpenAccountResponse!
 
     # depositFiat is a request to deposit Fiat currency from an external source.
-    depositFiat(input: FiatDepositRequest!): FiatDepositResponse!
+    depositFiat(input: FiatDepositRequest!, idempotencyKey: String): FiatDepositResponse!
+
+    # withdrawFiat is a request to withdraw Fiat currency to an external destination.
+    withdrawFiat(input: FiatWithdrawRequest!, idempotencyKey: String): FiatDepositResponse!
 
     # exchangeOfferFiat is a request for an exchange quote. The exchange quote provided will expire after a fixed period.
     exchangeOfferFiat(input: FiatExchangeOfferRequest!): OfferResponse!
 
     # exchangeTransferFiat will execute and complete a valid Fiat currency exchange offer.
-    exchangeTransferFiat(offerID: String!): FiatExchangeTransferResponse!
+    exchangeTransferFiat(offerID: String!, idempotencyKey: String): FiatExchangeTransferResponse!
+
+    # transferP2PFiat will transfer Fiat currency to another client's account in the same currency.
+    transferP2PFiat(input: FiatP2PTransferRequest!, idempotencyKey: String): FiatDepositResponse!
 }
 
 extend type Query {
@@ -1186,11 +3021,249 @@ extend type Query {
     # healthcheck will ping the data tier to check for connectivity.
     healthcheck: String!
 }
+`, BuiltIn: false},
+	{Name: "../schema/mfa.graphqls", Input: `# MFAChallenge is a login challenge for a user enrolled in multifactor authentication. The challenge must be completed
+# before it expires to receive a JWT.
+type MFAChallenge {
+    challengeID:    String!
+    expires:        Int64!
+}
+
+# MFAEnrollResponse is a Time-based One-Time Password secret, the provisioning URI for authenticator applications, and
+# single-use recovery codes. These are only ever returned once.
+type MFAEnrollResponse {
+    secret:         String!
+    uri:            String!
+    recoveryCodes:  [String!]!
+}
+
+# MFARecoveryCodes are freshly generated single-use recovery codes.
+type MFARecoveryCodes {
+    recoveryCodes:  [String!]!
+}
+
+# MFALoginRequest is a login challenge ID with a one-time password or recovery code.
+input MFALoginRequest {
+    challengeID:    String!
+    code:           String!
+}
+
+# MFACodeRequest is a one-time password or, where permitted, a recovery code.
+input MFACodeRequest {
+    code:           String!
+}
+
+extend type Mutation {
+    # loginUserMFA completes a login challenge with a one-time password or recovery code. A challenge can only be
+    # attempted once.
+    loginUserMFA(input: MFALoginRequest!): JWTAuthResponse!
+
+    # enrollMFA generates a multifactor authentication secret and recovery codes. The enrollment must be verified before
+    # it is enabled. Pending enrollments are replaced.
+    enrollMFA: MFAEnrollResponse!
+
+    # verifyMFA enables a pending multifactor authentication enrollment with a one-time password.
+    verifyMFA(input: MFACodeRequest!): String!
+
+    # disableMFA removes a multifactor authentication enrollment. Enabled enrollments require a one-time password or
+    # recovery code.
+    disableMFA(input: MFACodeRequest!): String!
+
+    # regenerateRecoveryCodes replaces the recovery codes with a one-time password. Unused recovery codes are
+    # invalidated.
+    regenerateRecoveryCodes(input: MFACodeRequest!): MFARecoveryCodes!
+}
+`, BuiltIn: false},
+	{Name: "../schema/orders.graphqls", Input: `# Order is a limit order to convert a source to a destination currency once the conversion rate is at or above the limit rate.
+type Order {
+    orderID:        UUID!
+    clientID:       UUID!
+    source:         String!
+    destination:    String!
+    amount:         Float!
+    limitRate:      Float!
+    status:         String!
+    fillRate:       Float!
+    fillAmount:     Float!
+    fee:            Float!
+    txID:           UUID
+    createdAt:      String!
+    updatedAt:      String!
+}
+
+# OrdersPaginated are all of the limit orders retrieved via pagination.
+type OrdersPaginated {
+    orders: [Order!]!
+    links:  Links!
+}
+
+# OrderRequest is the request parameters to place a limit order.
+input OrderRequest {
+    sourceCurrency:         String!
+    destinationCurrency:    String!
+    sourceAmount:           Float!
+    limitRate:              Float!
+}
+
+# Requests that might alter the state of data in the database.
+extend type Mutation {
+    # placeOrder is a request to place a limit order that will be settled once the conversion rate is at or above the limit rate.
+    placeOrder(input: OrderRequest!, idempotencyKey: String): Order!
+
+    # cancelOrder is a request to cancel an open limit order.
+    cancelOrder(orderID: String!): Order!
+}
+
+extend type Query {
+    # orders is a request to retrieve the limit orders for a client, newest first.
+    orders(pageCursor: String, pageSize: Int32): OrdersPaginated!
+}
+`, BuiltIn: false},
+	{Name: "../schema/rates.graphqls", Input: `# RateCandle contains the open, high, low, and close rates for a currency pair over an interval, and the number of price
+# quotes recorded in it.
+type RateCandle {
+    start:  String!
+    open:   Float!
+    high:   Float!
+    low:    Float!
+    close:  Float!
+    quotes: Int64!
+}
+
+# RateHistory is the rate history for a currency pair. Intervals without any recorded price quotes are omitted.
+type RateHistory {
+    source:         String!
+    destination:    String!
+    interval:       String!
+    candles:        [RateCandle!]!
+}
+
+# RateHistoryRequest is the request parameters to retrieve the rate history for a currency pair over an RFC3339 time
+# range, bucketed into intervals of a duration such as 15m or 1h.
+input RateHistoryRequest {
+    source:         String!
+    destination:    String!
+    from:           String!
+    to:             String!
+    interval:       String!
+}
+
+extend type Query {
+    # rateHistory is a request to retrieve the open, high, low, and close rates for a currency pair over a time range.
+    rateHistory(input: RateHistoryRequest!): RateHistory!
+}
 `, BuiltIn: false},
 	{Name: "../schema/scalars.graphqls", Input: `scalar Any
 scalar Int32
 scalar Int64
 scalar UUID
+`, BuiltIn: false},
+	{Name: "../schema/schedules.graphqls", Input: `# Schedule is a recurring purchase of a Cryptocurrency, in a Fiat currency amount, at a set frequency.
+type Schedule {
+    scheduleID:     UUID!
+    clientID:       UUID!
+    fiatCurrency:   String!
+    ticker:         String!
+    amount:         Float!
+    frequency:      String!
+    nextRunAt:      String!
+    isActive:       Boolean!
+    createdAt:      String!
+    updatedAt:      String!
+}
+
+# ScheduleRun is the result of a single run of a recurring purchase schedule.
+type ScheduleRun {
+    runID:          UUID!
+    scheduleID:     UUID!
+    clientID:       UUID!
+    status:         String!
+    rate:           Float!
+    fiatAmount:     Float!
+    cryptoAmount:   Float!
+    fee:            Float!
+    txID:           UUID
+    message:        String!
+    executedAt:     String!
+}
+
+# SchedulesPaginated are all of the recurring purchase schedules retrieved via pagination.
+type SchedulesPaginated {
+    schedules:  [Schedule!]!
+    links:      Links!
+}
+
+# ScheduleRunsPaginated are all of the runs of a recurring purchase schedule retrieved via pagination.
+type ScheduleRunsPaginated {
+    runs:   [ScheduleRun!]!
+    links:  Links!
+}
+
+# ScheduleRequest is the request parameters to create a recurring purchase schedule.
+input ScheduleRequest {
+    fiatCurrency:   String!
+    ticker:         String!
+    amount:         Float!
+    frequency:      String!
+    startAt:        String
+}
+
+# ScheduleUpdateRequest is the request parameters to update a recurring purchase schedule.
+input ScheduleUpdateRequest {
+    amount:     Float!
+    frequency:  String!
+    isActive:   Boolean!
+}
+
+# Requests that might alter the state of data in the database.
+extend type Mutation {
+    # createSchedule is a request to create a schedule that will purchase a Cryptocurrency at a set frequency.
+    createSchedule(input: ScheduleRequest!, idempotencyKey: String): Schedule!
+
+    # updateSchedule is a request to update the amount, frequency, and active state of a recurring purchase schedule.
+    updateSchedule(scheduleID: String!, input: ScheduleUpdateRequest!): Schedule!
+
+    # deleteSchedule is a request to delete a recurring purchase schedule and its run history.
+    deleteSchedule(scheduleID: String!): String!
+}
+
+extend type Query {
+    # schedules is a request to retrieve the recurring purchase schedules for a client, newest first.
+    schedules(pageCursor: String, pageSize: Int32): SchedulesPaginated!
+
+    # scheduleRuns is a request to retrieve the run history for a recurring purchase schedule, newest first.
+    scheduleRuns(scheduleID: String!, pageCursor: String, pageSize: Int32): ScheduleRunsPaginated!
+}
+`, BuiltIn: false},
+	{Name: "../schema/subscriptions.graphqls", Input: `# BalanceUpdate is the balance of a Fiat currency or Cryptocurrency account after an account event that changed it has
+# been committed.
+type BalanceUpdate {
+    eventID:    UUID!
+    eventType:  String!
+    currency:   String!
+    balance:    Float!
+    held:       Float!
+    available:  Float!
+    updatedAt:  String!
+}
+
+# RateTick is a fresh price quote for a currency pair that has been retrieved from a price quote provider.
+type RateTick {
+    source:         String!
+    destination:    String!
+    rate:           Float!
+    quotedAt:       String!
+}
+
+# Live updates that are pushed to the client over a websocket connection.
+type Subscription {
+    # balanceUpdated is a request to receive the balance of a Fiat currency or Cryptocurrency account every time a
+    # deposit, currency exchange, transfer, or Cryptocurrency purchase or sale that changes it is committed.
+    balanceUpdated(currency: String!): BalanceUpdate!
+
+    # rateTicker is a request to receive the fresh price quotes retrieved for a currency pair, formatted as SRC-DST.
+    rateTicker(pair: String!): RateTick!
+}
 `, BuiltIn: false},
 	{Name: "../schema/user.graphqls", Input: `# UserAccount is user information.
 input UserAccount {
@@ -1213,6 +3286,50 @@ input DeleteUserRequest {
     confirmation: String!
 }
 
+# ChangePasswordRequest is a request by an authenticated user to replace their password.
+input ChangePasswordRequest {
+    currentPassword: String!
+    newPassword: String!
+}
+
+# PasswordResetRequest is a request to have a password reset token delivered to the owner of an account.
+input PasswordResetRequest {
+    username: String!
+}
+
+# ResetPasswordRequest is a request to replace a password using a password reset token.
+input ResetPasswordRequest {
+    token: String!
+    password: String!
+}
+
+# PasswordResetResponse is the expiration deadline of a password reset token, if the account exists.
+type PasswordResetResponse {
+    expires: Int64!
+}
+
+# LoginResponse is either a JWT authorization token or, for users enrolled in multifactor authentication, a login
+# challenge to be completed with loginUserMFA.
+type LoginResponse {
+    token: JWTAuthResponse
+    mfaChallenge: MFAChallenge
+}
+
+# LoginEvent is a login attempt against a user account.
+type LoginEvent {
+    eventID:    UUID!
+    outcome:    String!
+    ipAddress:  String!
+    userAgent:  String!
+    createdAt:  String!
+}
+
+# LoginEventsPaginated are all of the login attempts against a user account retrieved via pagination.
+type LoginEventsPaginated {
+    events: [LoginEvent!]!
+    links:  Links!
+}
+
 # Requests that might alter the state of data in the database.
 type Mutation {
     # registerUser is a user registration request. A JWT authorization token is returned as a successful response.
@@ -1223,11 +3340,93 @@ type Mutation {
 
     # loginUser is a login request And receive a JWT authorization token in response. This has no side effects but is a
     # mutation to force sequential execution. This stops operations such as delete and refresh from being run in
-    # parallel with a login.
-    loginUser(input: UserLoginCredentials!): JWTAuthResponse!
+    # parallel with a login. Users enrolled in multifactor authentication receive a challenge instead of a token.
+    loginUser(input: UserLoginCredentials!): LoginResponse!
 
     # refreshToken refreshes a users JWT if it is within the refresh time window.
     refreshToken: JWTAuthResponse!
+
+    # logoutUser revokes the JWT supplied in the request header for the remainder of its validity interval.
+    logoutUser: String!
+
+    # logoutUserEverywhere revokes all JWTs issued to the user up to and including the time of the request.
+    logoutUserEverywhere: String!
+
+    # changePassword replaces the password of the user and logs out all of their sessions.
+    changePassword(input: ChangePasswordRequest!): String!
+
+    # requestPasswordReset delivers a single-use, time-limited password reset token to the owner of an account. The same
+    # response is returned whether the account exists.
+    requestPasswordReset(input: PasswordResetRequest!): PasswordResetResponse!
+
+    # resetPassword replaces the password of an account using a password reset token and logs out all of its sessions.
+    resetPassword(input: ResetPasswordRequest!): String!
+}
+
+extend type Query {
+    # loginEvents is a request to retrieve the successful, failed, and locked out login attempts against the user's
+    # account with the originating IP address and user agent, newest first.
+    loginEvents(pageCursor: String, pageSize: Int32): LoginEventsPaginated!
+}
+`, BuiltIn: false},
+	{Name: "../schema/webhooks.graphqls", Input: `# Webhook is an HTTPS URL that account events are delivered to. The signing secret is never returned.
+type Webhook {
+    webhookID:  UUID!
+    clientID:   UUID!
+    url:        String!
+    createdAt:  String!
+}
+
+# WebhookResponse is a freshly registered webhook and the secret its requests are signed with. The secret is only ever
+# returned once.
+type WebhookResponse {
+    secret:     String!
+    webhook:    Webhook!
+}
+
+# WebhookRequest is the HTTPS URL to deliver account events to.
+input WebhookRequest {
+    url:        String!
+}
+
+# WebhookDeadLetter is an account event that was abandoned after exhausting its delivery attempts to a webhook.
+type WebhookDeadLetter {
+    deliveryID: UUID!
+    eventID:    UUID!
+    webhookID:  UUID!
+    url:        String!
+    eventType:  String!
+    payload:    Any!
+    attempts:   Int32!
+    lastError:  String!
+    createdAt:  String!
+    failedAt:   String!
+}
+
+# WebhookDeadLettersPaginated are the account events that could not be delivered to a client's webhooks retrieved via
+# pagination.
+type WebhookDeadLettersPaginated {
+    deadLetters:    [WebhookDeadLetter!]!
+    links:          Links!
+}
+
+# Requests that might alter the state of data in the database.
+extend type Mutation {
+    # createWebhook is a request to register an HTTPS URL that deposits, currency exchanges, transfers, and
+    # Cryptocurrency purchases and sales will be delivered to as signed JSON events.
+    createWebhook(input: WebhookRequest!): WebhookResponse!
+
+    # deleteWebhook is a request to delete a webhook. Events that have not been delivered to it are discarded.
+    deleteWebhook(webhookID: String!): String!
+}
+
+extend type Query {
+    # webhooks is a request to retrieve the webhooks registered by a client, oldest first.
+    webhooks: [Webhook!]!
+
+    # webhookDeadLetters is a request to retrieve the account events that could not be delivered to a client's
+    # webhooks, most recent first.
+    webhookDeadLetters(pageCursor: String, pageSize: Int32): WebhookDeadLettersPaginated!
 }
 `, BuiltIn: false},
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/surahman/FTeX/pkg/auth"
 	graphql "github.com/surahman/FTeX/pkg/graphql/resolvers"
 	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/notifier"
 	"github.com/surahman/FTeX/pkg/postgres"
 	"github.com/surahman/FTeX/pkg/quotes"
 	"github.com/surahman/FTeX/pkg/redis"
@@ -29,6 +30,7 @@ type Server struct {
 	cache  redis.Redis
 	db     postgres.Postgres
 	quotes quotes.Quotes
+	notify notifier.Notifier
 	conf   *config
 	logger *logger.Logger
 	router *gin.Engine
@@ -37,7 +39,7 @@ type Server struct {
 
 // NewServer will create a new GraphQL server instance in a non-running state.
 func NewServer(fs *afero.Fs, auth auth.Auth, postgres postgres.Postgres, redis redis.Redis, quotes quotes.Quotes,
-	logger *logger.Logger, wg *sync.WaitGroup) (server *Server, err error) {
+	notify notifier.Notifier, logger *logger.Logger, wg *sync.WaitGroup) (server *Server, err error) {
 	// Load configurations.
 	conf := newConfig()
 	if err = conf.Load(*fs); err != nil {
@@ -50,22 +52,37 @@ func NewServer(fs *afero.Fs, auth auth.Auth, postgres postgres.Postgres, redis r
 			cache:  redis,
 			db:     postgres,
 			quotes: quotes,
+			notify: notify,
 			logger: logger,
 			wg:     wg,
 		},
 		err
 }
 
-// initialize will configure the HTTP server routes.
-func (s *Server) initialize() {
+// initialize will configure the HTTP server routes. Client IP addresses are only taken from forwarding headers set by
+// the trusted proxies.
+func (s *Server) initialize() error {
 	s.router = gin.Default()
 
+	if err := s.router.SetTrustedProxies(s.conf.Server.TrustedProxies); err != nil {
+		return fmt.Errorf("failed to configure trusted proxies: %w", err)
+	}
+
 	// Endpoint configurations
 	api := s.router.Group(s.conf.Server.BasePath)
 	api.Use(graphql.GinContextToContextMiddleware())
-	api.POST(s.conf.Server.QueryPath,
-		graphql.QueryHandler(s.conf.Authorization.HeaderKey, s.auth, s.cache, s.db, s.quotes, s.logger))
+	queryHandler := graphql.QueryHandler(s.conf.Authorization.HeaderKey, s.auth, s.cache, s.db, s.quotes, s.notify,
+		&graphql.RateLimits{
+			Queries:   &s.conf.RateLimits.Queries,
+			Mutations: &s.conf.RateLimits.Mutations,
+			Quotes:    &s.conf.RateLimits.Quotes,
+		},
+		s.logger)
+	api.POST(s.conf.Server.QueryPath, queryHandler)
+	api.GET(s.conf.Server.QueryPath, queryHandler) // Websocket upgrades for subscriptions.
 	api.GET(s.conf.Server.PlaygroundPath, graphql.PlaygroundHandler(s.conf.Server.BasePath, s.conf.Server.QueryPath))
+
+	return nil
 }
 
 // Run brings the HTTP GraphQL service up.
@@ -74,7 +91,11 @@ func (s *Server) Run() {
 	defer s.wg.Done()
 
 	// Configure routes.
-	s.initialize()
+	if err := s.initialize(); err != nil {
+		s.logger.Error("GraphQL server failed to initialize", zap.Error(err))
+
+		return
+	}
 
 	// Create server.
 	srv := &http.Server{
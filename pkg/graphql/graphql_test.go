@@ -20,13 +20,15 @@ func TestNewGraphQLServer(t *testing.T) {
 	mockPostgres := mocks.NewMockPostgres(mockCtrl)
 	mockRedis := mocks.NewMockRedis(mockCtrl)
 	mockQuotes := quotes.NewMockQuotes(mockCtrl)
+	mockNotifier := mocks.NewMockNotifier(mockCtrl)
 
 	fs := afero.NewMemMapFs()
 	require.NoError(t, fs.MkdirAll(constants.EtcDir(), 0644), "Failed to create in memory directory")
 	require.NoError(t, afero.WriteFile(fs, constants.EtcDir()+constants.HTTPGraphQLFileName(),
 		[]byte(graphQLConfigTestData["valid"]), 0644), "Failed to write in memory file")
 
-	server, err := NewServer(&fs, mockAuth, mockPostgres, mockRedis, mockQuotes, zapLogger, &sync.WaitGroup{})
+	server, err := NewServer(&fs, mockAuth, mockPostgres, mockRedis, mockQuotes, mockNotifier,
+		zapLogger, &sync.WaitGroup{})
 	require.NoError(t, err, "error whilst creating mock server")
 	require.NotNil(t, server, "failed to create mock server")
 }
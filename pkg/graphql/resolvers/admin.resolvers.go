@@ -0,0 +1,416 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.63
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	graphql_generated "github.com/surahman/FTeX/pkg/graphql/generated"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// AdminFreezeAccount is the resolver for the adminFreezeAccount field.
+func (r *mutationResolver) AdminFreezeAccount(ctx context.Context, clientID string) (string, error) {
+	var (
+		err     error
+		httpMsg string
+	)
+
+	if _, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone); err != nil {
+		return "", err
+	}
+
+	if httpMsg, _, err = common.HTTPAdminSetFrozen(r.db, r.logger, clientID, true); err != nil {
+		return "", errors.New(httpMsg)
+	}
+
+	return "account frozen", nil
+}
+
+// AdminUnfreezeAccount is the resolver for the adminUnfreezeAccount field.
+func (r *mutationResolver) AdminUnfreezeAccount(ctx context.Context, clientID string) (string, error) {
+	var (
+		err     error
+		httpMsg string
+	)
+
+	if _, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone); err != nil {
+		return "", err
+	}
+
+	if httpMsg, _, err = common.HTTPAdminSetFrozen(r.db, r.logger, clientID, false); err != nil {
+		return "", errors.New(httpMsg)
+	}
+
+	return "account unfrozen", nil
+}
+
+// AdminReverseDeposit is the resolver for the adminReverseDeposit field.
+func (r *mutationResolver) AdminReverseDeposit(ctx context.Context, clientID string, transactionID string) (*postgres.FiatAccountTransferResult, error) {
+	var (
+		adminID uuid.UUID
+		err     error
+		httpMsg string
+		receipt *postgres.FiatAccountTransferResult
+	)
+
+	if adminID, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpTransfers); err != nil {
+		return nil, err
+	}
+
+	if receipt, httpMsg, _, err = common.HTTPAdminReverseDeposit(r.db, r.logger, adminID, clientID, transactionID); err != nil {
+		return nil, errors.New(httpMsg)
+	}
+
+	return receipt, nil
+}
+
+// AdminSetStatusFiat is the resolver for the adminSetStatusFiat field.
+func (r *mutationResolver) AdminSetStatusFiat(ctx context.Context, clientID string, input models.HTTPAccountStatusRequest) (*postgres.FiatAccount, error) {
+	var (
+		account *postgres.FiatAccount
+		err     error
+		httpMsg string
+	)
+
+	if _, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone); err != nil {
+		return nil, err
+	}
+
+	if account, httpMsg, _, _, err = common.HTTPAdminFiatSetStatus(r.db, r.logger, clientID, &input); err != nil {
+		return nil, errors.New(httpMsg)
+	}
+
+	return account, nil
+}
+
+// AdminHoldFiat is the resolver for the adminHoldFiat field.
+func (r *mutationResolver) AdminHoldFiat(ctx context.Context, clientID string, input models.HTTPAccountHoldRequest) (*postgres.FiatAccount, error) {
+	var (
+		account *postgres.FiatAccount
+		err     error
+		httpMsg string
+	)
+
+	if _, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone); err != nil {
+		return nil, err
+	}
+
+	if account, httpMsg, _, _, err = common.HTTPAdminFiatUpdateHold(r.db, r.logger, clientID, &input, false); err != nil {
+		return nil, errors.New(httpMsg)
+	}
+
+	return account, nil
+}
+
+// AdminReleaseFiat is the resolver for the adminReleaseFiat field.
+func (r *mutationResolver) AdminReleaseFiat(ctx context.Context, clientID string, input models.HTTPAccountHoldRequest) (*postgres.FiatAccount, error) {
+	var (
+		account *postgres.FiatAccount
+		err     error
+		httpMsg string
+	)
+
+	if _, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone); err != nil {
+		return nil, err
+	}
+
+	if account, httpMsg, _, _, err = common.HTTPAdminFiatUpdateHold(r.db, r.logger, clientID, &input, true); err != nil {
+		return nil, errors.New(httpMsg)
+	}
+
+	return account, nil
+}
+
+// AdminSetStatusCrypto is the resolver for the adminSetStatusCrypto field.
+func (r *mutationResolver) AdminSetStatusCrypto(ctx context.Context, clientID string, input models.HTTPAccountStatusRequest) (*postgres.CryptoAccount, error) {
+	var (
+		account *postgres.CryptoAccount
+		err     error
+		httpMsg string
+	)
+
+	if _, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone); err != nil {
+		return nil, err
+	}
+
+	if account, httpMsg, _, _, err = common.HTTPAdminCryptoSetStatus(r.db, r.logger, clientID, &input); err != nil {
+		return nil, errors.New(httpMsg)
+	}
+
+	return account, nil
+}
+
+// AdminHoldCrypto is the resolver for the adminHoldCrypto field.
+func (r *mutationResolver) AdminHoldCrypto(ctx context.Context, clientID string, input models.HTTPAccountHoldRequest) (*postgres.CryptoAccount, error) {
+	var (
+		account *postgres.CryptoAccount
+		err     error
+		httpMsg string
+	)
+
+	if _, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone); err != nil {
+		return nil, err
+	}
+
+	if account, httpMsg, _, _, err = common.HTTPAdminCryptoUpdateHold(r.db, r.logger, clientID, &input, false); err != nil {
+		return nil, errors.New(httpMsg)
+	}
+
+	return account, nil
+}
+
+// AdminReleaseCrypto is the resolver for the adminReleaseCrypto field.
+func (r *mutationResolver) AdminReleaseCrypto(ctx context.Context, clientID string, input models.HTTPAccountHoldRequest) (*postgres.CryptoAccount, error) {
+	var (
+		account *postgres.CryptoAccount
+		err     error
+		httpMsg string
+	)
+
+	if _, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone); err != nil {
+		return nil, err
+	}
+
+	if account, httpMsg, _, _, err = common.HTTPAdminCryptoUpdateHold(r.db, r.logger, clientID, &input, true); err != nil {
+		return nil, errors.New(httpMsg)
+	}
+
+	return account, nil
+}
+
+// AdminUser is the resolver for the adminUser field.
+func (r *queryResolver) AdminUser(ctx context.Context, username string) (*models.HTTPAdminUserResponse, error) {
+	var (
+		err     error
+		httpMsg string
+		user    *models.HTTPAdminUserResponse
+	)
+
+	if _, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone); err != nil {
+		return nil, err
+	}
+
+	if user, httpMsg, _, err = common.HTTPAdminUser(r.db, r.logger, username); err != nil {
+		return nil, errors.New(httpMsg)
+	}
+
+	return user, nil
+}
+
+// AdminBalanceAllFiat is the resolver for the adminBalanceAllFiat field.
+func (r *queryResolver) AdminBalanceAllFiat(ctx context.Context, clientID string, pageCursor *string, pageSize *int32) (*models.HTTPFiatDetailsPaginated, error) {
+	var (
+		accDetails  *models.HTTPFiatDetailsPaginated
+		clientUUID  uuid.UUID
+		err         error
+		httpMessage string
+	)
+
+	if pageSize == nil {
+		pageSize = new(int32)
+	}
+
+	if pageCursor == nil {
+		pageCursor = new(string)
+	}
+
+	if _, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone); err != nil {
+		return nil, err
+	}
+
+	if clientUUID, httpMessage, _, err = common.HTTPAdminParseClientID(clientID); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	if accDetails, _, httpMessage, err = common.HTTPFiatBalancePaginated(r.auth, r.db, r.logger,
+		clientUUID, *pageCursor, strconv.Itoa(int(*pageSize)), false); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return accDetails, nil
+}
+
+// AdminTransactionDetailsAllFiat is the resolver for the adminTransactionDetailsAllFiat field.
+func (r *queryResolver) AdminTransactionDetailsAllFiat(ctx context.Context, clientID string, input models.FiatPaginatedTxDetailsRequest) (*models.HTTPFiatTransactionsPaginated, error) {
+	var (
+		journalEntries *models.HTTPFiatTransactionsPaginated
+		clientUUID     uuid.UUID
+		err            error
+		params         common.HTTPPaginatedTxParams
+		httpMessage    string
+		payload        any
+	)
+
+	if input.PageSize == nil {
+		input.PageSize = new(string)
+	}
+	params.PageSizeStr = *input.PageSize
+
+	if input.PageCursor == nil {
+		input.PageCursor = new(string)
+	}
+	params.PageCursorStr = *input.PageCursor
+
+	if input.Timezone == nil {
+		input.Timezone = new(string)
+	}
+	params.TimezoneStr = *input.Timezone
+
+	if input.Month == nil {
+		input.Month = new(string)
+	}
+	params.MonthStr = *input.Month
+
+	if input.Year == nil {
+		input.Year = new(string)
+	}
+	params.YearStr = *input.Year
+
+	if _, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone); err != nil {
+		return nil, err
+	}
+
+	if clientUUID, httpMessage, _, err = common.HTTPAdminParseClientID(clientID); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	if journalEntries, _, httpMessage, payload, err = common.HTTPFiatTransactionsPaginated(r.auth, r.db,
+		r.logger, clientUUID, input.Currency, &params, false); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+	}
+
+	return journalEntries, nil
+}
+
+// AdminBalanceAllCrypto is the resolver for the adminBalanceAllCrypto field.
+func (r *queryResolver) AdminBalanceAllCrypto(ctx context.Context, clientID string, pageCursor *string, pageSize *int32) (*models.HTTPCryptoDetailsPaginated, error) {
+	var (
+		accDetails  models.HTTPCryptoDetailsPaginated
+		clientUUID  uuid.UUID
+		err         error
+		httpMessage string
+	)
+
+	if pageSize == nil {
+		pageSize = new(int32)
+	}
+
+	if pageCursor == nil {
+		pageCursor = new(string)
+	}
+
+	if _, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone); err != nil {
+		return nil, err
+	}
+
+	if clientUUID, httpMessage, _, err = common.HTTPAdminParseClientID(clientID); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	if accDetails, _, httpMessage, err = common.HTTPCryptoBalancePaginated(r.auth, r.db, r.logger,
+		clientUUID, *pageCursor, strconv.Itoa(int(*pageSize)), false); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return &accDetails, nil
+}
+
+// AdminTransactionDetailsAllCrypto is the resolver for the adminTransactionDetailsAllCrypto field.
+func (r *queryResolver) AdminTransactionDetailsAllCrypto(ctx context.Context, clientID string, input models.CryptoPaginatedTxDetailsRequest) (*models.HTTPCryptoTransactionsPaginated, error) {
+	var (
+		journalEntries models.HTTPCryptoTransactionsPaginated
+		clientUUID     uuid.UUID
+		err            error
+		params         common.HTTPPaginatedTxParams
+		httpMessage    string
+	)
+
+	if input.PageSize == nil {
+		input.PageSize = new(string)
+	}
+	params.PageSizeStr = *input.PageSize
+
+	if input.PageCursor == nil {
+		input.PageCursor = new(string)
+	}
+	params.PageCursorStr = *input.PageCursor
+
+	if input.Timezone == nil {
+		input.Timezone = new(string)
+	}
+	params.TimezoneStr = *input.Timezone
+
+	if input.Month == nil {
+		input.Month = new(string)
+	}
+	params.MonthStr = *input.Month
+
+	if input.Year == nil {
+		input.Year = new(string)
+	}
+	params.YearStr = *input.Year
+
+	if _, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone); err != nil {
+		return nil, err
+	}
+
+	if clientUUID, httpMessage, _, err = common.HTTPAdminParseClientID(clientID); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	if journalEntries, _, httpMessage, err = common.HTTPCryptoTransactionsPaginated(r.auth, r.db,
+		r.logger, &params, clientUUID, input.Ticker, false); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return &journalEntries, nil
+}
+
+// AdminTransactionDetails is the resolver for the adminTransactionDetails field.
+func (r *queryResolver) AdminTransactionDetails(ctx context.Context, clientID string, transactionID string) ([]any, error) {
+	var (
+		journalEntries []any
+		clientUUID     uuid.UUID
+		err            error
+		httpMessage    string
+	)
+
+	if _, err = AdminAuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone); err != nil {
+		return nil, err
+	}
+
+	if clientUUID, httpMessage, _, err = common.HTTPAdminParseClientID(clientID); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	if journalEntries, _, httpMessage, err = common.HTTPTxDetails(r.db, r.logger, clientUUID, transactionID); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return journalEntries, nil
+}
+
+// Amount is the resolver for the amount field.
+func (r *adminAccountHoldRequestResolver) Amount(ctx context.Context, obj *models.HTTPAccountHoldRequest, data float64) error {
+	obj.Amount = decimal.NewFromFloat(data)
+
+	return nil
+}
+
+// AdminAccountHoldRequest returns graphql_generated.AdminAccountHoldRequestResolver implementation.
+func (r *Resolver) AdminAccountHoldRequest() graphql_generated.AdminAccountHoldRequestResolver {
+	return &adminAccountHoldRequestResolver{r}
+}
+
+type adminAccountHoldRequestResolver struct{ *Resolver }
@@ -0,0 +1,952 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/quotes"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+// adminTestAuthorization will configure the mock expectations for an administrator's authorization check.
+func adminTestAuthorization(mockAuth *mocks.MockAuth, mockPostgres *mocks.MockPostgres, mockRedis *mocks.MockRedis,
+	authValidateJWTErr error, authValidateJWTTimes int, getRole postgres.UserRole, getRoleTimes int) []*gomock.Call {
+	return []*gomock.Call{
+		mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+			Return(uuid.UUID{}, int64(-1), authValidateJWTErr).
+			Times(authValidateJWTTimes),
+
+		mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+			Return("session-id", int64(0), nil).
+			AnyTimes(),
+
+		mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+			Return(redis.ErrCacheMiss).
+			AnyTimes(),
+
+		mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+			Return(false, nil).
+			Times(getRoleTimes),
+
+		mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+			Return(false).
+			AnyTimes(),
+
+		mockPostgres.EXPECT().UserGetRole(gomock.Any()).
+			Return(getRole, nil).
+			Times(getRoleTimes),
+	}
+}
+
+// adminTestRequest will submit a query to the GraphQL endpoint and verify the response.
+func adminTestRequest(t *testing.T, mockAuth *mocks.MockAuth, mockPostgres *mocks.MockPostgres,
+	mockRedis *mocks.MockRedis, mockQuotes *quotes.MockQuotes, mockNotifier *mocks.MockNotifier,
+	path, query string, expectErr bool) {
+	t.Helper()
+
+	// Endpoint setup for test.
+	router := gin.Default()
+	router.Use(GinContextToContextMiddleware())
+	router.POST(path,
+		QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+	req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, path, bytes.NewBufferString(query))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "some valid auth token goes here")
+
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, req)
+
+	// Verify responses
+	require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+	response := map[string]any{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+	// Error is expected check to ensure one is set.
+	if expectErr {
+		verifyErrorReturned(t, response)
+	} else {
+		require.Nil(t, response["errors"], "unexpected error returned")
+	}
+}
+
+func TestAdminResolver_AdminUser(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		getRole              postgres.UserRole
+		getRoleTimes         int
+		getClientIDErr       error
+		getClientIDTimes     int
+		getInfoTimes         int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/admin-user/invalid-jwt",
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+			getRole:              postgres.UserRoleAdmin,
+			getRoleTimes:         0,
+			getClientIDErr:       nil,
+			getClientIDTimes:     0,
+			getInfoTimes:         0,
+		}, {
+			name:                 "not an admin",
+			path:                 "/admin-user/not-an-admin",
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			getRole:              postgres.UserRoleUser,
+			getRoleTimes:         1,
+			getClientIDErr:       nil,
+			getClientIDTimes:     0,
+			getInfoTimes:         0,
+		}, {
+			name:                 "unknown user",
+			path:                 "/admin-user/unknown-user",
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			getRole:              postgres.UserRoleAdmin,
+			getRoleTimes:         1,
+			getClientIDErr:       postgres.ErrNotFoundUser,
+			getClientIDTimes:     1,
+			getInfoTimes:         0,
+		}, {
+			name:                 "valid",
+			path:                 "/admin-user/valid",
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			getRole:              postgres.UserRoleAdmin,
+			getRoleTimes:         1,
+			getClientIDErr:       nil,
+			getClientIDTimes:     1,
+			getInfoTimes:         1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			calls := adminTestAuthorization(mockAuth, mockPostgres, mockRedis, test.authValidateJWTErr,
+				test.authValidateJWTTimes, test.getRole, test.getRoleTimes)
+			calls = append(calls,
+				mockPostgres.EXPECT().UserGetClientID("username1").
+					Return(uuid.UUID{}, test.getClientIDErr).
+					Times(test.getClientIDTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{}}, nil).
+					Times(test.getInfoTimes),
+			)
+			gomock.InOrder(calls...)
+
+			adminTestRequest(t, mockAuth, mockPostgres, mockRedis, mockQuotes, mockNotifier, test.path,
+				fmt.Sprintf(testAdminQuery["adminUser"], "username1"), test.expectErr)
+		})
+	}
+}
+
+func TestAdminResolver_AdminBalanceAllFiat(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	testCases := []struct {
+		name             string
+		path             string
+		clientID         string
+		expectErr        bool
+		getRole          postgres.UserRole
+		fiatBalanceErr   error
+		fiatBalanceTimes int
+	}{
+		{
+			name:             "not an admin",
+			path:             "/admin-balance-all-fiat/not-an-admin",
+			clientID:         clientID.String(),
+			expectErr:        true,
+			getRole:          postgres.UserRoleUser,
+			fiatBalanceErr:   nil,
+			fiatBalanceTimes: 0,
+		}, {
+			name:             "invalid client id",
+			path:             "/admin-balance-all-fiat/invalid-client-id",
+			clientID:         "invalid-client-id",
+			expectErr:        true,
+			getRole:          postgres.UserRoleAdmin,
+			fiatBalanceErr:   nil,
+			fiatBalanceTimes: 0,
+		}, {
+			name:             "db failure",
+			path:             "/admin-balance-all-fiat/db-failure",
+			clientID:         clientID.String(),
+			expectErr:        true,
+			getRole:          postgres.UserRoleAdmin,
+			fiatBalanceErr:   postgres.ErrNotFound,
+			fiatBalanceTimes: 1,
+		}, {
+			name:             "valid",
+			path:             "/admin-balance-all-fiat/valid",
+			clientID:         clientID.String(),
+			expectErr:        false,
+			getRole:          postgres.UserRoleAdmin,
+			fiatBalanceErr:   nil,
+			fiatBalanceTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			calls := adminTestAuthorization(mockAuth, mockPostgres, mockRedis, nil, 1, test.getRole, 1)
+			calls = append(calls,
+				mockPostgres.EXPECT().FiatBalancePaginated(clientID, gomock.Any(), gomock.Any()).
+					Return([]postgres.FiatAccount{{ClientID: clientID}}, test.fiatBalanceErr).
+					Times(test.fiatBalanceTimes),
+			)
+			gomock.InOrder(calls...)
+
+			adminTestRequest(t, mockAuth, mockPostgres, mockRedis, mockQuotes, mockNotifier, test.path,
+				fmt.Sprintf(testAdminQuery["adminBalanceAllFiat"], test.clientID), test.expectErr)
+		})
+	}
+}
+
+func TestAdminResolver_AdminTransactionDetailsAllFiat(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	testCases := []struct {
+		name        string
+		path        string
+		clientID    string
+		month       string
+		expectErr   bool
+		fiatTxTimes int
+	}{
+		{
+			name:        "invalid client id",
+			path:        "/admin-transaction-details-all-fiat/invalid-client-id",
+			clientID:    "invalid-client-id",
+			month:       "6",
+			expectErr:   true,
+			fiatTxTimes: 0,
+		}, {
+			name:        "invalid month",
+			path:        "/admin-transaction-details-all-fiat/invalid-month",
+			clientID:    clientID.String(),
+			month:       "-6",
+			expectErr:   true,
+			fiatTxTimes: 0,
+		}, {
+			name:        "valid",
+			path:        "/admin-transaction-details-all-fiat/valid",
+			clientID:    clientID.String(),
+			month:       "6",
+			expectErr:   false,
+			fiatTxTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			mockAuth.EXPECT().EncryptToString(gomock.Any()).
+				Return("encrypted-page-cursor", nil).
+				AnyTimes()
+
+			calls := adminTestAuthorization(mockAuth, mockPostgres, mockRedis, nil, 1, postgres.UserRoleAdmin, 1)
+			calls = append(calls,
+				mockPostgres.EXPECT().FiatTransactionsPaginated(
+					clientID, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]postgres.FiatJournal{{ClientID: clientID}}, nil).
+					Times(test.fiatTxTimes),
+			)
+			gomock.InOrder(calls...)
+
+			adminTestRequest(t, mockAuth, mockPostgres, mockRedis, mockQuotes, mockNotifier, test.path,
+				fmt.Sprintf(testAdminQuery["adminTransactionDetailsAllFiat"], test.clientID, test.month, "2023"),
+				test.expectErr)
+		})
+	}
+}
+
+func TestAdminResolver_AdminBalanceAllCrypto(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	testCases := []struct {
+		name               string
+		path               string
+		clientID           string
+		expectErr          bool
+		cryptoBalanceErr   error
+		cryptoBalanceTimes int
+	}{
+		{
+			name:               "invalid client id",
+			path:               "/admin-balance-all-crypto/invalid-client-id",
+			clientID:           "invalid-client-id",
+			expectErr:          true,
+			cryptoBalanceErr:   nil,
+			cryptoBalanceTimes: 0,
+		}, {
+			name:               "db failure",
+			path:               "/admin-balance-all-crypto/db-failure",
+			clientID:           clientID.String(),
+			expectErr:          true,
+			cryptoBalanceErr:   postgres.ErrNotFound,
+			cryptoBalanceTimes: 1,
+		}, {
+			name:               "valid",
+			path:               "/admin-balance-all-crypto/valid",
+			clientID:           clientID.String(),
+			expectErr:          false,
+			cryptoBalanceErr:   nil,
+			cryptoBalanceTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			calls := adminTestAuthorization(mockAuth, mockPostgres, mockRedis, nil, 1, postgres.UserRoleAdmin, 1)
+			calls = append(calls,
+				mockPostgres.EXPECT().CryptoBalancesPaginated(clientID, gomock.Any(), gomock.Any()).
+					Return([]postgres.CryptoAccount{{ClientID: clientID}}, test.cryptoBalanceErr).
+					Times(test.cryptoBalanceTimes),
+			)
+			gomock.InOrder(calls...)
+
+			adminTestRequest(t, mockAuth, mockPostgres, mockRedis, mockQuotes, mockNotifier, test.path,
+				fmt.Sprintf(testAdminQuery["adminBalanceAllCrypto"], test.clientID), test.expectErr)
+		})
+	}
+}
+
+func TestAdminResolver_AdminTransactionDetailsAllCrypto(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	testCases := []struct {
+		name          string
+		path          string
+		clientID      string
+		month         string
+		expectErr     bool
+		cryptoTxTimes int
+	}{
+		{
+			name:          "invalid client id",
+			path:          "/admin-transaction-details-all-crypto/invalid-client-id",
+			clientID:      "invalid-client-id",
+			month:         "6",
+			expectErr:     true,
+			cryptoTxTimes: 0,
+		}, {
+			name:          "invalid month",
+			path:          "/admin-transaction-details-all-crypto/invalid-month",
+			clientID:      clientID.String(),
+			month:         "-6",
+			expectErr:     true,
+			cryptoTxTimes: 0,
+		}, {
+			name:          "valid",
+			path:          "/admin-transaction-details-all-crypto/valid",
+			clientID:      clientID.String(),
+			month:         "6",
+			expectErr:     false,
+			cryptoTxTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			mockAuth.EXPECT().EncryptToString(gomock.Any()).
+				Return("encrypted-page-cursor", nil).
+				AnyTimes()
+
+			calls := adminTestAuthorization(mockAuth, mockPostgres, mockRedis, nil, 1, postgres.UserRoleAdmin, 1)
+			calls = append(calls,
+				mockPostgres.EXPECT().CryptoTransactionsPaginated(
+					clientID, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]postgres.CryptoJournal{{ClientID: clientID}}, nil).
+					Times(test.cryptoTxTimes),
+			)
+			gomock.InOrder(calls...)
+
+			adminTestRequest(t, mockAuth, mockPostgres, mockRedis, mockQuotes, mockNotifier, test.path,
+				fmt.Sprintf(testAdminQuery["adminTransactionDetailsAllCrypto"], test.clientID, test.month, "2023"),
+				test.expectErr)
+		})
+	}
+}
+
+func TestAdminResolver_AdminTransactionDetails(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	txID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate transaction id.")
+
+	testCases := []struct {
+		name        string
+		path        string
+		clientID    string
+		txID        string
+		expectErr   bool
+		fiatTxErr   error
+		fiatTxTimes int
+	}{
+		{
+			name:        "invalid client id",
+			path:        "/admin-transaction-details/invalid-client-id",
+			clientID:    "invalid-client-id",
+			txID:        txID.String(),
+			expectErr:   true,
+			fiatTxErr:   nil,
+			fiatTxTimes: 0,
+		}, {
+			name:        "invalid transaction id",
+			path:        "/admin-transaction-details/invalid-transaction-id",
+			clientID:    clientID.String(),
+			txID:        "invalid-transaction-id",
+			expectErr:   true,
+			fiatTxErr:   nil,
+			fiatTxTimes: 0,
+		}, {
+			name:        "db failure",
+			path:        "/admin-transaction-details/db-failure",
+			clientID:    clientID.String(),
+			txID:        txID.String(),
+			expectErr:   true,
+			fiatTxErr:   postgres.ErrNotFound,
+			fiatTxTimes: 1,
+		}, {
+			name:        "valid",
+			path:        "/admin-transaction-details/valid",
+			clientID:    clientID.String(),
+			txID:        txID.String(),
+			expectErr:   false,
+			fiatTxErr:   nil,
+			fiatTxTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			calls := adminTestAuthorization(mockAuth, mockPostgres, mockRedis, nil, 1, postgres.UserRoleAdmin, 1)
+			calls = append(calls,
+				mockPostgres.EXPECT().FiatTxDetails(clientID, txID).
+					Return([]postgres.FiatJournal{{ClientID: clientID}}, test.fiatTxErr).
+					Times(test.fiatTxTimes),
+			)
+			gomock.InOrder(calls...)
+
+			mockPostgres.EXPECT().CryptoTxDetails(clientID, txID).
+				Return([]postgres.CryptoJournal{}, nil).
+				AnyTimes()
+
+			mockPostgres.EXPECT().TradeTxDetails(clientID, txID).
+				Return([]postgres.Trade{}, nil).
+				AnyTimes()
+
+			adminTestRequest(t, mockAuth, mockPostgres, mockRedis, mockQuotes, mockNotifier, test.path,
+				fmt.Sprintf(testAdminQuery["adminTransactionDetails"], test.clientID, test.txID), test.expectErr)
+		})
+	}
+}
+
+func TestAdminResolver_AdminFreezeAccount(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	testCases := []struct {
+		name           string
+		path           string
+		query          string
+		isFrozen       bool
+		expectErr      bool
+		getRole        postgres.UserRole
+		setFrozenErr   error
+		setFrozenTimes int
+	}{
+		{
+			name:           "not an admin",
+			path:           "/admin-freeze-account/not-an-admin",
+			query:          fmt.Sprintf(testAdminQuery["adminFreezeAccount"], clientID),
+			isFrozen:       true,
+			expectErr:      true,
+			getRole:        postgres.UserRoleUser,
+			setFrozenErr:   nil,
+			setFrozenTimes: 0,
+		}, {
+			name:           "invalid client id",
+			path:           "/admin-freeze-account/invalid-client-id",
+			query:          fmt.Sprintf(testAdminQuery["adminFreezeAccount"], "invalid-client-id"),
+			isFrozen:       true,
+			expectErr:      true,
+			getRole:        postgres.UserRoleAdmin,
+			setFrozenErr:   nil,
+			setFrozenTimes: 0,
+		}, {
+			name:           "unknown user",
+			path:           "/admin-freeze-account/unknown-user",
+			query:          fmt.Sprintf(testAdminQuery["adminFreezeAccount"], clientID),
+			isFrozen:       true,
+			expectErr:      true,
+			getRole:        postgres.UserRoleAdmin,
+			setFrozenErr:   postgres.ErrNotFoundUser,
+			setFrozenTimes: 1,
+		}, {
+			name:           "freeze",
+			path:           "/admin-freeze-account/freeze",
+			query:          fmt.Sprintf(testAdminQuery["adminFreezeAccount"], clientID),
+			isFrozen:       true,
+			expectErr:      false,
+			getRole:        postgres.UserRoleAdmin,
+			setFrozenErr:   nil,
+			setFrozenTimes: 1,
+		}, {
+			name:           "unfreeze",
+			path:           "/admin-freeze-account/unfreeze",
+			query:          fmt.Sprintf(testAdminQuery["adminUnfreezeAccount"], clientID),
+			isFrozen:       false,
+			expectErr:      false,
+			getRole:        postgres.UserRoleAdmin,
+			setFrozenErr:   nil,
+			setFrozenTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			calls := adminTestAuthorization(mockAuth, mockPostgres, mockRedis, nil, 1, test.getRole, 1)
+			calls = append(calls,
+				mockPostgres.EXPECT().UserSetFrozen(clientID, test.isFrozen).
+					Return(test.setFrozenErr).
+					Times(test.setFrozenTimes),
+			)
+			gomock.InOrder(calls...)
+
+			adminTestRequest(t, mockAuth, mockPostgres, mockRedis, mockQuotes, mockNotifier, test.path, test.query,
+				test.expectErr)
+		})
+	}
+}
+
+func TestAdminResolver_AdminReverseDeposit(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	txID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate transaction id.")
+
+	testCases := []struct {
+		name         string
+		path         string
+		txID         string
+		expectErr    bool
+		getRole      postgres.UserRole
+		reverseErr   error
+		reverseTimes int
+	}{
+		{
+			name:         "not an admin",
+			path:         "/admin-reverse-deposit/not-an-admin",
+			txID:         txID.String(),
+			expectErr:    true,
+			getRole:      postgres.UserRoleUser,
+			reverseErr:   nil,
+			reverseTimes: 0,
+		}, {
+			name:         "invalid transaction id",
+			path:         "/admin-reverse-deposit/invalid-transaction-id",
+			txID:         "invalid-transaction-id",
+			expectErr:    true,
+			getRole:      postgres.UserRoleAdmin,
+			reverseErr:   nil,
+			reverseTimes: 0,
+		}, {
+			name:         "already reversed",
+			path:         "/admin-reverse-deposit/already-reversed",
+			txID:         txID.String(),
+			expectErr:    true,
+			getRole:      postgres.UserRoleAdmin,
+			reverseErr:   postgres.ErrReverseFiat,
+			reverseTimes: 1,
+		}, {
+			name:         "valid",
+			path:         "/admin-reverse-deposit/valid",
+			txID:         txID.String(),
+			expectErr:    false,
+			getRole:      postgres.UserRoleAdmin,
+			reverseErr:   nil,
+			reverseTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			calls := adminTestAuthorization(mockAuth, mockPostgres, mockRedis, nil, 1, test.getRole, 1)
+			calls = append(calls,
+				mockPostgres.EXPECT().FiatReverseDeposit(gomock.Any(), gomock.Any(), clientID, txID).
+					Return(&postgres.FiatAccountTransferResult{ClientID: clientID, TxID: txID}, test.reverseErr).
+					Times(test.reverseTimes),
+			)
+			gomock.InOrder(calls...)
+
+			adminTestRequest(t, mockAuth, mockPostgres, mockRedis, mockQuotes, mockNotifier, test.path,
+				fmt.Sprintf(testAdminQuery["adminReverseDeposit"], clientID, test.txID), test.expectErr)
+		})
+	}
+}
+
+func TestAdminResolver_AdminSetStatus(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	testCases := []struct {
+		name        string
+		path        string
+		query       string
+		expectErr   bool
+		getRole     postgres.UserRole
+		fiatErr     error
+		fiatTimes   int
+		cryptoErr   error
+		cryptoTimes int
+	}{
+		{
+			name:      "not an admin",
+			path:      "/admin-set-status/not-an-admin",
+			query:     fmt.Sprintf(testAdminQuery["adminSetStatusFiat"], clientID, "USD", "frozen"),
+			expectErr: true,
+			getRole:   postgres.UserRoleUser,
+		}, {
+			name:      "invalid status",
+			path:      "/admin-set-status/invalid-status",
+			query:     fmt.Sprintf(testAdminQuery["adminSetStatusFiat"], clientID, "USD", "suspended"),
+			expectErr: true,
+			getRole:   postgres.UserRoleAdmin,
+		}, {
+			name:      "fiat account not found",
+			path:      "/admin-set-status/fiat-not-found",
+			query:     fmt.Sprintf(testAdminQuery["adminSetStatusFiat"], clientID, "USD", "frozen"),
+			expectErr: true,
+			getRole:   postgres.UserRoleAdmin,
+			fiatErr:   postgres.ErrNotFound,
+			fiatTimes: 1,
+		}, {
+			name:      "fiat valid",
+			path:      "/admin-set-status/fiat-valid",
+			query:     fmt.Sprintf(testAdminQuery["adminSetStatusFiat"], clientID, "USD", "frozen"),
+			expectErr: false,
+			getRole:   postgres.UserRoleAdmin,
+			fiatTimes: 1,
+		}, {
+			name:        "crypto account not found",
+			path:        "/admin-set-status/crypto-not-found",
+			query:       fmt.Sprintf(testAdminQuery["adminSetStatusCrypto"], clientID, "BTC", "frozen"),
+			expectErr:   true,
+			getRole:     postgres.UserRoleAdmin,
+			cryptoErr:   postgres.ErrNotFound,
+			cryptoTimes: 1,
+		}, {
+			name:        "crypto valid",
+			path:        "/admin-set-status/crypto-valid",
+			query:       fmt.Sprintf(testAdminQuery["adminSetStatusCrypto"], clientID, "BTC", "frozen"),
+			expectErr:   false,
+			getRole:     postgres.UserRoleAdmin,
+			cryptoTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			calls := adminTestAuthorization(mockAuth, mockPostgres, mockRedis, nil, 1, test.getRole, 1)
+			calls = append(calls,
+				mockPostgres.EXPECT().FiatSetStatus(clientID, postgres.CurrencyUSD, postgres.AccountStatusFrozen).
+					Return(postgres.FiatAccount{Currency: postgres.CurrencyUSD, Status: postgres.AccountStatusFrozen},
+						test.fiatErr).
+					Times(test.fiatTimes),
+
+				mockPostgres.EXPECT().CryptoSetStatus(clientID, "BTC", postgres.AccountStatusFrozen).
+					Return(postgres.CryptoAccount{Ticker: "BTC", Status: postgres.AccountStatusFrozen}, test.cryptoErr).
+					Times(test.cryptoTimes),
+			)
+			gomock.InOrder(calls...)
+
+			adminTestRequest(t, mockAuth, mockPostgres, mockRedis, mockQuotes, mockNotifier, test.path, test.query,
+				test.expectErr)
+		})
+	}
+}
+
+func TestAdminResolver_AdminUpdateHold(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	testCases := []struct {
+		name           string
+		path           string
+		query          string
+		expectErr      bool
+		getRole        postgres.UserRole
+		expectedAmount decimal.Decimal
+		fiatErr        error
+		fiatTimes      int
+		cryptoErr      error
+		cryptoTimes    int
+	}{
+		{
+			name:      "not an admin",
+			path:      "/admin-update-hold/not-an-admin",
+			query:     fmt.Sprintf(testAdminQuery["adminHoldFiat"], clientID, "USD", 10.0),
+			expectErr: true,
+			getRole:   postgres.UserRoleUser,
+		}, {
+			name:      "invalid amount",
+			path:      "/admin-update-hold/invalid-amount",
+			query:     fmt.Sprintf(testAdminQuery["adminHoldFiat"], clientID, "USD", -10.0),
+			expectErr: true,
+			getRole:   postgres.UserRoleAdmin,
+		}, {
+			name:           "fiat hold exceeds balance",
+			path:           "/admin-update-hold/fiat-hold-exceeds-balance",
+			query:          fmt.Sprintf(testAdminQuery["adminHoldFiat"], clientID, "USD", 10.0),
+			expectErr:      true,
+			getRole:        postgres.UserRoleAdmin,
+			expectedAmount: decimal.NewFromFloat(10),
+			fiatErr:        postgres.ErrUpdateHold,
+			fiatTimes:      1,
+		}, {
+			name:           "fiat hold",
+			path:           "/admin-update-hold/fiat-hold",
+			query:          fmt.Sprintf(testAdminQuery["adminHoldFiat"], clientID, "USD", 10.0),
+			expectErr:      false,
+			getRole:        postgres.UserRoleAdmin,
+			expectedAmount: decimal.NewFromFloat(10),
+			fiatTimes:      1,
+		}, {
+			name:           "fiat release",
+			path:           "/admin-update-hold/fiat-release",
+			query:          fmt.Sprintf(testAdminQuery["adminReleaseFiat"], clientID, "USD", 10.0),
+			expectErr:      false,
+			getRole:        postgres.UserRoleAdmin,
+			expectedAmount: decimal.NewFromFloat(-10),
+			fiatTimes:      1,
+		}, {
+			name:           "crypto hold",
+			path:           "/admin-update-hold/crypto-hold",
+			query:          fmt.Sprintf(testAdminQuery["adminHoldCrypto"], clientID, "BTC", 0.5),
+			expectErr:      false,
+			getRole:        postgres.UserRoleAdmin,
+			expectedAmount: decimal.NewFromFloat(0.5),
+			cryptoTimes:    1,
+		}, {
+			name:           "crypto release exceeds hold",
+			path:           "/admin-update-hold/crypto-release-exceeds-hold",
+			query:          fmt.Sprintf(testAdminQuery["adminReleaseCrypto"], clientID, "BTC", 0.5),
+			expectErr:      true,
+			getRole:        postgres.UserRoleAdmin,
+			expectedAmount: decimal.NewFromFloat(-0.5),
+			cryptoErr:      postgres.ErrUpdateHold,
+			cryptoTimes:    1,
+		}, {
+			name:           "crypto release",
+			path:           "/admin-update-hold/crypto-release",
+			query:          fmt.Sprintf(testAdminQuery["adminReleaseCrypto"], clientID, "BTC", 0.5),
+			expectErr:      false,
+			getRole:        postgres.UserRoleAdmin,
+			expectedAmount: decimal.NewFromFloat(-0.5),
+			cryptoTimes:    1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			calls := adminTestAuthorization(mockAuth, mockPostgres, mockRedis, nil, 1, test.getRole, 1)
+			calls = append(calls,
+				mockPostgres.EXPECT().FiatUpdateHold(clientID, postgres.CurrencyUSD, test.expectedAmount).
+					Return(postgres.FiatAccount{Currency: postgres.CurrencyUSD}, test.fiatErr).
+					Times(test.fiatTimes),
+
+				mockPostgres.EXPECT().CryptoUpdateHold(clientID, "BTC", test.expectedAmount).
+					Return(postgres.CryptoAccount{Ticker: "BTC"}, test.cryptoErr).
+					Times(test.cryptoTimes),
+			)
+			gomock.InOrder(calls...)
+
+			adminTestRequest(t, mockAuth, mockPostgres, mockRedis, mockQuotes, mockNotifier, test.path, test.query,
+				test.expectErr)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.63
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	graphql_generated "github.com/surahman/FTeX/pkg/graphql/generated"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// KeyID is the resolver for the keyID field.
+func (r *aPIKeyResolver) KeyID(ctx context.Context, obj *postgres.ApiKey) (string, error) {
+	return obj.KeyID.String(), nil
+}
+
+// ClientID is the resolver for the clientID field.
+func (r *aPIKeyResolver) ClientID(ctx context.Context, obj *postgres.ApiKey) (string, error) {
+	return obj.ClientID.String(), nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *aPIKeyResolver) CreatedAt(ctx context.Context, obj *postgres.ApiKey) (string, error) {
+	return obj.CreatedAt.Time.String(), nil
+}
+
+// CreateAPIKey is the resolver for the createAPIKey field.
+func (r *mutationResolver) CreateAPIKey(ctx context.Context, input models.HTTPAPIKeyRequest) (*models.HTTPAPIKeyResponse, error) {
+	var (
+		apiKey   *models.HTTPAPIKeyResponse
+		clientID uuid.UUID
+		err      error
+		httpMsg  string
+		payload  any
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeNone); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if apiKey, httpMsg, _, payload, err = common.HTTPAPIKeyCreate(r.auth, r.db, r.logger, clientID, &input); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMsg, payload)
+	}
+
+	return apiKey, nil
+}
+
+// RevokeAPIKey is the resolver for the revokeAPIKey field.
+func (r *mutationResolver) RevokeAPIKey(ctx context.Context, keyID string) (string, error) {
+	var (
+		clientID uuid.UUID
+		err      error
+		httpMsg  string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeNone); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if httpMsg, _, err = common.HTTPAPIKeyRevoke(r.db, r.logger, clientID, keyID); err != nil {
+		return "", errors.New(httpMsg)
+	}
+
+	return keyID, nil
+}
+
+// APIKeys is the resolver for the apiKeys field.
+func (r *queryResolver) APIKeys(ctx context.Context) ([]postgres.ApiKey, error) {
+	var (
+		apiKeys  *models.HTTPAPIKeysResponse
+		clientID uuid.UUID
+		err      error
+		httpMsg  string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeNone); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if apiKeys, httpMsg, _, err = common.HTTPAPIKeyList(r.db, r.logger, clientID); err != nil {
+		return nil, errors.New(httpMsg)
+	}
+
+	return apiKeys.APIKeys, nil
+}
+
+// APIKey returns graphql_generated.APIKeyResolver implementation.
+func (r *Resolver) APIKey() graphql_generated.APIKeyResolver { return &aPIKeyResolver{r} }
+
+type aPIKeyResolver struct{ *Resolver }
@@ -0,0 +1,452 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/quotes"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestAPIKeysResolver_APIKeyResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := aPIKeyResolver{}
+
+	keyID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate key id.")
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	timestamp := pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	apiKey := &postgres.ApiKey{
+		KeyID:     keyID,
+		ClientID:  clientID,
+		Name:      "trading bot",
+		Scopes:    []string{"read-balances", "trade"},
+		CreatedAt: timestamp,
+	}
+
+	t.Run("KeyID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.KeyID(context.TODO(), apiKey)
+		require.NoError(t, err, "key id should always return a nil error.")
+		require.Equal(t, keyID.String(), result, "key id mismatched.")
+	})
+
+	t.Run("ClientID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.ClientID(context.TODO(), apiKey)
+		require.NoError(t, err, "client id should always return a nil error.")
+		require.Equal(t, clientID.String(), result, "client id mismatched.")
+	})
+
+	t.Run("CreatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.CreatedAt(context.TODO(), apiKey)
+		require.NoError(t, err, "created at should always return a nil error.")
+		require.Equal(t, timestamp.Time.String(), result, "created at mismatched.")
+	})
+}
+
+func TestAPIKeysResolver_CreateAPIKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		generateKeyTimes     int
+		apiKeyCreateErr      error
+		apiKeyCreateTimes    int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/create-api-key/invalid-jwt",
+			query:                fmt.Sprintf(testAPIKeysQuery["createAPIKey"], "trading bot", "trade"),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       0,
+			generateKeyTimes:     0,
+			apiKeyCreateErr:      nil,
+			apiKeyCreateTimes:    0,
+		}, {
+			name:                 "unknown scope",
+			path:                 "/create-api-key/unknown-scope",
+			query:                fmt.Sprintf(testAPIKeysQuery["createAPIKey"], "trading bot", "admin"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			generateKeyTimes:     0,
+			apiKeyCreateErr:      nil,
+			apiKeyCreateTimes:    0,
+		}, {
+			name:                 "db failure",
+			path:                 "/create-api-key/db-failure",
+			query:                fmt.Sprintf(testAPIKeysQuery["createAPIKey"], "trading bot", "trade"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			generateKeyTimes:     1,
+			apiKeyCreateErr:      postgres.ErrTransactAPIKey,
+			apiKeyCreateTimes:    1,
+		}, {
+			name:                 "valid",
+			path:                 "/create-api-key/valid",
+			query:                fmt.Sprintf(testAPIKeysQuery["createAPIKey"], "trading bot", "trade"),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			generateKeyTimes:     1,
+			apiKeyCreateErr:      nil,
+			apiKeyCreateTimes:    1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockAuth.EXPECT().GenerateAPIKey().
+					Return("ftex_api-key", "hashed-api-key", nil).
+					Times(test.generateKeyTimes),
+
+				mockPostgres.EXPECT().APIKeyCreate(gomock.Any(), "trading bot", "hashed-api-key", []string{"trade"}).
+					Return(postgres.ApiKey{Name: "trading bot", Scopes: []string{"trade"}}, test.apiKeyCreateErr).
+					Times(test.apiKeyCreateTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestAPIKeysResolver_RevokeAPIKey(t *testing.T) {
+	t.Parallel()
+
+	keyID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate key id.")
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		apiKeyRevokeErr      error
+		apiKeyRevokeTimes    int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/revoke-api-key/invalid-jwt",
+			query:                fmt.Sprintf(testAPIKeysQuery["revokeAPIKey"], keyID),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       0,
+			apiKeyRevokeErr:      nil,
+			apiKeyRevokeTimes:    0,
+		}, {
+			name:                 "invalid key id",
+			path:                 "/revoke-api-key/invalid-key-id",
+			query:                fmt.Sprintf(testAPIKeysQuery["revokeAPIKey"], "invalid-id"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			apiKeyRevokeErr:      nil,
+			apiKeyRevokeTimes:    0,
+		}, {
+			name:                 "not found",
+			path:                 "/revoke-api-key/not-found",
+			query:                fmt.Sprintf(testAPIKeysQuery["revokeAPIKey"], keyID),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			apiKeyRevokeErr:      postgres.ErrNotFoundAPIKey,
+			apiKeyRevokeTimes:    1,
+		}, {
+			name:                 "valid",
+			path:                 "/revoke-api-key/valid",
+			query:                fmt.Sprintf(testAPIKeysQuery["revokeAPIKey"], keyID),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			apiKeyRevokeErr:      nil,
+			apiKeyRevokeTimes:    1,
+		},
+	}
+
+	for _, testCase := range testCases { //nolint:dupl
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().APIKeyRevoke(gomock.Any(), keyID).
+					Return(test.apiKeyRevokeErr).
+					Times(test.apiKeyRevokeTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestAPIKeysResolver_APIKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		apiKeyListErr        error
+		apiKeyListTimes      int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/api-keys/invalid-jwt",
+			query:                testAPIKeysQuery["apiKeys"],
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       0,
+			apiKeyListErr:        nil,
+			apiKeyListTimes:      0,
+		}, {
+			name:                 "db failure",
+			path:                 "/api-keys/db-failure",
+			query:                testAPIKeysQuery["apiKeys"],
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			apiKeyListErr:        postgres.ErrTransactAPIKey,
+			apiKeyListTimes:      1,
+		}, {
+			name:                 "valid",
+			path:                 "/api-keys/valid",
+			query:                testAPIKeysQuery["apiKeys"],
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			apiKeyListErr:        nil,
+			apiKeyListTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().APIKeyList(gomock.Any()).
+					Return([]postgres.ApiKey{{Name: "trading bot"}, {Name: "reporting"}}, test.apiKeyListErr).
+					Times(test.apiKeyListTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
@@ -8,9 +8,11 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
 	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/logger"
 	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
 	"go.uber.org/zap"
 )
 
@@ -35,14 +37,18 @@ func GinContextFromContext(ctx context.Context, logger *logger.Logger) (*gin.Con
 	return ginContext, nil
 }
 
-// AuthorizationCheck will validate the JWT payload for valid authorization information.
-func AuthorizationCheck(ctx context.Context, auth auth.Auth, db postgres.Postgres, logger *logger.Logger,
-	authHeaderKey string) (uuid.UUID, int64, error) {
+// AuthorizationCheck will validate the JWT payload, or an API key that grants the operation's scope, for valid
+// authorization information that has not been revoked. Sensitive operations will also require a step-up multifactor
+// authentication code if configured. Operations without a scope cannot be performed with API keys, and operations that
+// move funds cannot be performed by frozen accounts.
+func AuthorizationCheck(ctx context.Context, auth auth.Auth, cache redis.Redis, db postgres.Postgres,
+	logger *logger.Logger, authHeaderKey string, stepUp auth.StepUp, scope auth.Scope) (uuid.UUID, int64, error) {
 	var (
 		clientID   uuid.UUID
 		expiresAt  int64
 		err        error
 		isDeleted  bool
+		httpMsg    string
 		ginContext *gin.Context
 	)
 
@@ -51,14 +57,27 @@ func AuthorizationCheck(ctx context.Context, auth auth.Auth, db postgres.Postgre
 	}
 
 	tokenString := ginContext.GetHeader(authHeaderKey)
-	if tokenString == "" {
+	apiKey := ginContext.GetHeader(constants.APIKeyHeader())
+
+	switch {
+	case tokenString != "":
+		if clientID, expiresAt, err = auth.ValidateJWT(tokenString); err != nil {
+			return clientID, expiresAt, fmt.Errorf("failed to validate JWT %w", err)
+		}
+
+		// Check for a logout from the session or all the user's sessions.
+		if _, _, err = common.HTTPSessionRevoked(auth, cache, logger, clientID, tokenString); err != nil {
+			return clientID, expiresAt, fmt.Errorf("%w", err)
+		}
+	case apiKey != "":
+		// API keys do not expire and are checked for revocation when they are retrieved.
+		if clientID, httpMsg, _, err = common.HTTPAPIKeyAuth(auth, db, logger, apiKey, scope); err != nil {
+			return clientID, expiresAt, errors.New(httpMsg)
+		}
+	default:
 		return clientID, -1, errors.New("request does not contain an access token")
 	}
 
-	if clientID, expiresAt, err = auth.ValidateJWT(tokenString); err != nil {
-		return clientID, expiresAt, fmt.Errorf("failed to validate JWT %w", err)
-	}
-
 	// Check for user deleted status.
 	if isDeleted, err = db.UserIsDeleted(clientID); err != nil {
 		logger.Error("unable to retrieve client account status", zap.Error(err))
@@ -70,5 +89,38 @@ func AuthorizationCheck(ctx context.Context, auth auth.Auth, db postgres.Postgre
 		return clientID, expiresAt, errors.New("request contains invalid or expired authorization token")
 	}
 
+	// Check for frozen accounts on operations that move funds.
+	if httpMsg, _, err = common.HTTPAccountFrozen(db, logger, clientID, scope); err != nil {
+		return clientID, expiresAt, errors.New(httpMsg)
+	}
+
+	// Check for a step-up multifactor authentication code on sensitive operations.
+	if _, _, err = common.HTTPMFAStepUp(
+		auth, cache, db, logger, clientID, stepUp, ginContext.GetHeader(constants.MFACodeHeader())); err != nil {
+		return clientID, expiresAt, fmt.Errorf("%w", err)
+	}
+
 	return clientID, expiresAt, nil
 }
+
+// AdminAuthorizationCheck will perform the authorization check for an operation and then verify that the client has
+// been granted the administrator role.
+func AdminAuthorizationCheck(ctx context.Context, authorizer auth.Auth, cache redis.Redis, db postgres.Postgres,
+	logger *logger.Logger, authHeaderKey string, stepUp auth.StepUp) (uuid.UUID, error) {
+	var (
+		clientID uuid.UUID
+		err      error
+		httpMsg  string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, authorizer, cache, db, logger, authHeaderKey, stepUp,
+		auth.ScopeNone); err != nil {
+		return clientID, errors.New("authorization failure")
+	}
+
+	if httpMsg, _, err = common.HTTPAdminCheck(db, logger, clientID); err != nil {
+		return clientID, errors.New(httpMsg)
+	}
+
+	return clientID, nil
+}
@@ -5,12 +5,18 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/mocks"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
 )
 
 func TestGinContextFromContext(t *testing.T) {
@@ -64,6 +70,13 @@ func TestAuthorizationCheck(t *testing.T) {
 	ginCtxAuth := &gin.Context{Request: &http.Request{Header: http.Header{}}}
 	ginCtxAuth.Request.Header.Add(testAuthHeaderKey, "test-token")
 
+	ginCtxMFA := &gin.Context{Request: &http.Request{Header: http.Header{}}}
+	ginCtxMFA.Request.Header.Add(testAuthHeaderKey, "test-token")
+	ginCtxMFA.Request.Header.Add(constants.MFACodeHeader(), "ABCD-EFGH-IJKL-MNOP")
+
+	ginCtxAPIKey := &gin.Context{Request: &http.Request{Header: http.Header{}}}
+	ginCtxAPIKey.Request.Header.Add(constants.APIKeyHeader(), "ftex_api-key")
+
 	testCases := []struct {
 		name                 string
 		expectedMsg          string
@@ -71,9 +84,25 @@ func TestAuthorizationCheck(t *testing.T) {
 		ctx                  context.Context //nolint:containedctx
 		authValidateJWTErr   error
 		authValidateJWTTimes int
+		sessionErr           error
+		sessionTimes         int
+		cacheGetErr          error
+		cacheGetTimes        int
 		isDeletedError       error
 		isDeletedTimes       int
 		isDeletedValue       bool
+		isFrozenError        error
+		isFrozenTimes        int
+		isFrozenValue        bool
+		stepUpRequired       bool
+		stepUpTimes          int
+		mfaGetEnrollment     postgres.MfaEnrollment
+		mfaGetErr            error
+		mfaGetTimes          int
+		stepUpFailTimes      int
+		apiKeyGetScopes      []string
+		apiKeyGetErr         error
+		apiKeyGetTimes       int
 	}{
 		{
 			name:                 "no context",
@@ -82,9 +111,25 @@ func TestAuthorizationCheck(t *testing.T) {
 			ctx:                  context.TODO(),
 			authValidateJWTErr:   nil,
 			authValidateJWTTimes: 0,
+			sessionErr:           nil,
+			sessionTimes:         0,
+			cacheGetErr:          nil,
+			cacheGetTimes:        0,
 			isDeletedError:       nil,
 			isDeletedTimes:       0,
 			isDeletedValue:       false,
+			isFrozenError:        nil,
+			isFrozenTimes:        0,
+			isFrozenValue:        false,
+			stepUpRequired:       false,
+			stepUpTimes:          0,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            nil,
+			mfaGetTimes:          0,
+			stepUpFailTimes:      0,
+			apiKeyGetScopes:      nil,
+			apiKeyGetErr:         nil,
+			apiKeyGetTimes:       0,
 		}, {
 			name:                 "incorrect context",
 			expectedMsg:          "information malformed",
@@ -92,9 +137,25 @@ func TestAuthorizationCheck(t *testing.T) {
 			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, context.TODO()),
 			authValidateJWTErr:   nil,
 			authValidateJWTTimes: 0,
+			sessionErr:           nil,
+			sessionTimes:         0,
+			cacheGetErr:          nil,
+			cacheGetTimes:        0,
 			isDeletedError:       nil,
 			isDeletedTimes:       0,
 			isDeletedValue:       false,
+			isFrozenError:        nil,
+			isFrozenTimes:        0,
+			isFrozenValue:        false,
+			stepUpRequired:       false,
+			stepUpTimes:          0,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            nil,
+			mfaGetTimes:          0,
+			stepUpFailTimes:      0,
+			apiKeyGetScopes:      nil,
+			apiKeyGetErr:         nil,
+			apiKeyGetTimes:       0,
 		}, {
 			name:                 "no token",
 			expectedMsg:          "does not contain",
@@ -102,9 +163,25 @@ func TestAuthorizationCheck(t *testing.T) {
 			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxNoAuth),
 			authValidateJWTErr:   nil,
 			authValidateJWTTimes: 0,
+			sessionErr:           nil,
+			sessionTimes:         0,
+			cacheGetErr:          nil,
+			cacheGetTimes:        0,
 			isDeletedError:       nil,
 			isDeletedTimes:       0,
 			isDeletedValue:       false,
+			isFrozenError:        nil,
+			isFrozenTimes:        0,
+			isFrozenValue:        false,
+			stepUpRequired:       false,
+			stepUpTimes:          0,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            nil,
+			mfaGetTimes:          0,
+			stepUpFailTimes:      0,
+			apiKeyGetScopes:      nil,
+			apiKeyGetErr:         nil,
+			apiKeyGetTimes:       0,
 		}, {
 			name:                 "bad token",
 			expectedMsg:          "failed to authenticate token",
@@ -112,36 +189,359 @@ func TestAuthorizationCheck(t *testing.T) {
 			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
 			authValidateJWTErr:   errors.New("failed to authenticate token"),
 			authValidateJWTTimes: 1,
+			sessionErr:           nil,
+			sessionTimes:         0,
+			cacheGetErr:          nil,
+			cacheGetTimes:        0,
+			isDeletedError:       nil,
+			isDeletedTimes:       0,
+			isDeletedValue:       false,
+			isFrozenError:        nil,
+			isFrozenTimes:        0,
+			isFrozenValue:        false,
+			stepUpRequired:       false,
+			stepUpTimes:          0,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            nil,
+			mfaGetTimes:          0,
+			stepUpFailTimes:      0,
+			apiKeyGetScopes:      nil,
+			apiKeyGetErr:         nil,
+			apiKeyGetTimes:       0,
+		}, {
+			name:                 "invalid session",
+			expectedMsg:          "session failure",
+			expectErr:            require.Error,
+			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			sessionErr:           errors.New("session failure"),
+			sessionTimes:         1,
+			cacheGetErr:          nil,
+			cacheGetTimes:        0,
 			isDeletedError:       nil,
 			isDeletedTimes:       0,
 			isDeletedValue:       false,
+			isFrozenError:        nil,
+			isFrozenTimes:        0,
+			isFrozenValue:        false,
+			stepUpRequired:       false,
+			stepUpTimes:          0,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            nil,
+			mfaGetTimes:          0,
+			stepUpFailTimes:      0,
+			apiKeyGetScopes:      nil,
+			apiKeyGetErr:         nil,
+			apiKeyGetTimes:       0,
+		}, {
+			name:                 "revocation cache failure",
+			expectedMsg:          "unknown Redis cache error",
+			expectErr:            require.Error,
+			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			sessionErr:           nil,
+			sessionTimes:         1,
+			cacheGetErr:          redis.ErrCacheUnknown,
+			cacheGetTimes:        1,
+			isDeletedError:       nil,
+			isDeletedTimes:       0,
+			isDeletedValue:       false,
+			isFrozenError:        nil,
+			isFrozenTimes:        0,
+			isFrozenValue:        false,
+			stepUpRequired:       false,
+			stepUpTimes:          0,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            nil,
+			mfaGetTimes:          0,
+			stepUpFailTimes:      0,
+			apiKeyGetScopes:      nil,
+			apiKeyGetErr:         nil,
+			apiKeyGetTimes:       0,
 		}, {
 			name:                 "db failure",
 			expectErr:            require.Error,
 			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
 			authValidateJWTErr:   nil,
 			authValidateJWTTimes: 1,
+			sessionErr:           nil,
+			sessionTimes:         1,
+			cacheGetErr:          redis.ErrCacheMiss,
+			cacheGetTimes:        2,
 			isDeletedError:       errors.New("db failure"),
 			isDeletedTimes:       1,
 			isDeletedValue:       false,
+			isFrozenError:        nil,
+			isFrozenTimes:        0,
+			isFrozenValue:        false,
+			stepUpRequired:       false,
+			stepUpTimes:          0,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            nil,
+			mfaGetTimes:          0,
+			stepUpFailTimes:      0,
+			apiKeyGetScopes:      nil,
+			apiKeyGetErr:         nil,
+			apiKeyGetTimes:       0,
 		}, {
 			name:                 "deleted user",
 			expectErr:            require.Error,
 			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
 			authValidateJWTErr:   nil,
 			authValidateJWTTimes: 1,
+			sessionErr:           nil,
+			sessionTimes:         1,
+			cacheGetErr:          redis.ErrCacheMiss,
+			cacheGetTimes:        2,
 			isDeletedError:       nil,
 			isDeletedTimes:       1,
 			isDeletedValue:       true,
+			isFrozenError:        nil,
+			isFrozenTimes:        0,
+			isFrozenValue:        false,
+			stepUpRequired:       false,
+			stepUpTimes:          0,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            nil,
+			mfaGetTimes:          0,
+			stepUpFailTimes:      0,
+			apiKeyGetScopes:      nil,
+			apiKeyGetErr:         nil,
+			apiKeyGetTimes:       0,
 		}, {
 			name:                 "success",
 			expectErr:            require.NoError,
 			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
 			authValidateJWTErr:   nil,
 			authValidateJWTTimes: 1,
+			sessionErr:           nil,
+			sessionTimes:         1,
+			cacheGetErr:          redis.ErrCacheMiss,
+			cacheGetTimes:        2,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			isFrozenError:        nil,
+			isFrozenTimes:        1,
+			isFrozenValue:        false,
+			stepUpRequired:       false,
+			stepUpTimes:          1,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            nil,
+			mfaGetTimes:          0,
+			stepUpFailTimes:      0,
+			apiKeyGetScopes:      nil,
+			apiKeyGetErr:         nil,
+			apiKeyGetTimes:       0,
+		}, {
+			name:                 "frozen status failure",
+			expectedMsg:          "please retry your request later",
+			expectErr:            require.Error,
+			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			sessionErr:           nil,
+			sessionTimes:         1,
+			cacheGetErr:          redis.ErrCacheMiss,
+			cacheGetTimes:        2,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			isFrozenError:        errors.New("db failure"),
+			isFrozenTimes:        1,
+			isFrozenValue:        false,
+			stepUpRequired:       false,
+			stepUpTimes:          0,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            nil,
+			mfaGetTimes:          0,
+			stepUpFailTimes:      0,
+			apiKeyGetScopes:      nil,
+			apiKeyGetErr:         nil,
+			apiKeyGetTimes:       0,
+		}, {
+			name:                 "frozen user",
+			expectedMsg:          "account is frozen",
+			expectErr:            require.Error,
+			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			sessionErr:           nil,
+			sessionTimes:         1,
+			cacheGetErr:          redis.ErrCacheMiss,
+			cacheGetTimes:        2,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			isFrozenError:        nil,
+			isFrozenTimes:        1,
+			isFrozenValue:        true,
+			stepUpRequired:       false,
+			stepUpTimes:          0,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            nil,
+			mfaGetTimes:          0,
+			stepUpFailTimes:      0,
+			apiKeyGetScopes:      nil,
+			apiKeyGetErr:         nil,
+			apiKeyGetTimes:       0,
+		}, {
+			name:                 "step-up not enrolled",
+			expectErr:            require.NoError,
+			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			sessionErr:           nil,
+			sessionTimes:         1,
+			cacheGetErr:          redis.ErrCacheMiss,
+			cacheGetTimes:        2,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			isFrozenError:        nil,
+			isFrozenTimes:        1,
+			isFrozenValue:        false,
+			stepUpRequired:       true,
+			stepUpTimes:          1,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:          1,
+			stepUpFailTimes:      0,
+			apiKeyGetScopes:      nil,
+			apiKeyGetErr:         nil,
+			apiKeyGetTimes:       0,
+		}, {
+			name:                 "step-up code missing",
+			expectedMsg:          "code required",
+			expectErr:            require.Error,
+			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAuth),
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			sessionErr:           nil,
+			sessionTimes:         1,
+			cacheGetErr:          redis.ErrCacheMiss,
+			cacheGetTimes:        2,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			isFrozenError:        nil,
+			isFrozenTimes:        1,
+			isFrozenValue:        false,
+			stepUpRequired:       true,
+			stepUpTimes:          1,
+			mfaGetEnrollment:     postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetErr:            nil,
+			mfaGetTimes:          1,
+			stepUpFailTimes:      0,
+			apiKeyGetScopes:      nil,
+			apiKeyGetErr:         nil,
+			apiKeyGetTimes:       0,
+		}, {
+			name:                 "step-up recovery code",
+			expectedMsg:          "invalid multifactor authentication code",
+			expectErr:            require.Error,
+			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxMFA),
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			sessionErr:           nil,
+			sessionTimes:         1,
+			cacheGetErr:          redis.ErrCacheMiss,
+			cacheGetTimes:        2,
 			isDeletedError:       nil,
 			isDeletedTimes:       1,
 			isDeletedValue:       false,
+			isFrozenError:        nil,
+			isFrozenTimes:        1,
+			isFrozenValue:        false,
+			stepUpRequired:       true,
+			stepUpTimes:          1,
+			mfaGetEnrollment:     postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetErr:            nil,
+			mfaGetTimes:          1,
+			stepUpFailTimes:      1,
+			apiKeyGetScopes:      nil,
+			apiKeyGetErr:         nil,
+			apiKeyGetTimes:       0,
+		}, {
+			name:                 "invalid api key",
+			expectedMsg:          "invalid or revoked api key",
+			expectErr:            require.Error,
+			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAPIKey),
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 0,
+			sessionErr:           nil,
+			sessionTimes:         0,
+			cacheGetErr:          nil,
+			cacheGetTimes:        0,
+			isDeletedError:       nil,
+			isDeletedTimes:       0,
+			isDeletedValue:       false,
+			isFrozenError:        nil,
+			isFrozenTimes:        0,
+			isFrozenValue:        false,
+			stepUpRequired:       false,
+			stepUpTimes:          0,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            nil,
+			mfaGetTimes:          0,
+			stepUpFailTimes:      0,
+			apiKeyGetScopes:      nil,
+			apiKeyGetErr:         postgres.ErrNotFoundAPIKey,
+			apiKeyGetTimes:       1,
+		}, {
+			name:                 "api key missing scope",
+			expectedMsg:          "does not grant the withdraw scope",
+			expectErr:            require.Error,
+			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAPIKey),
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 0,
+			sessionErr:           nil,
+			sessionTimes:         0,
+			cacheGetErr:          nil,
+			cacheGetTimes:        0,
+			isDeletedError:       nil,
+			isDeletedTimes:       0,
+			isDeletedValue:       false,
+			isFrozenError:        nil,
+			isFrozenTimes:        0,
+			isFrozenValue:        false,
+			stepUpRequired:       false,
+			stepUpTimes:          0,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            nil,
+			mfaGetTimes:          0,
+			stepUpFailTimes:      0,
+			apiKeyGetScopes:      []string{"read-balances"},
+			apiKeyGetErr:         nil,
+			apiKeyGetTimes:       1,
+		}, {
+			name:                 "valid api key",
+			expectedMsg:          "",
+			expectErr:            require.NoError,
+			ctx:                  context.WithValue(context.TODO(), GinContextKey{}, ginCtxAPIKey),
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 0,
+			sessionErr:           nil,
+			sessionTimes:         0,
+			cacheGetErr:          nil,
+			cacheGetTimes:        0,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			isFrozenError:        nil,
+			isFrozenTimes:        1,
+			isFrozenValue:        false,
+			stepUpRequired:       false,
+			stepUpTimes:          1,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            nil,
+			mfaGetTimes:          0,
+			stepUpFailTimes:      0,
+			apiKeyGetScopes:      []string{"read-balances", "withdraw"},
+			apiKeyGetErr:         nil,
+			apiKeyGetTimes:       1,
 		},
 	}
 
@@ -153,6 +553,7 @@ func TestAuthorizationCheck(t *testing.T) {
 			mockCtrl := gomock.NewController(t)
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
 			mockDB := mocks.NewMockPostgres(mockCtrl)
 
 			gomock.InOrder(
@@ -160,12 +561,63 @@ func TestAuthorizationCheck(t *testing.T) {
 					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
+				mockAuth.EXPECT().SessionFromJWT("test-token").
+					Return("session-id", int64(100), test.sessionErr).
+					Times(test.sessionTimes),
+
+				mockCache.EXPECT().Get(gomock.Any(), gomock.Any()).
+					Return(test.cacheGetErr).
+					Times(test.cacheGetTimes),
+
+				mockAuth.EXPECT().HashAPIKey("ftex_api-key").
+					Return("hashed-api-key").
+					Times(test.apiKeyGetTimes),
+
+				mockDB.EXPECT().APIKeyGet("hashed-api-key").
+					Return(postgres.ApiKey{Scopes: test.apiKeyGetScopes}, test.apiKeyGetErr).
+					Times(test.apiKeyGetTimes),
+
 				mockDB.EXPECT().UserIsDeleted(gomock.Any()).
 					Return(test.isDeletedValue, test.isDeletedError).
 					Times(test.isDeletedTimes),
+
+				mockDB.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(test.isFrozenValue, test.isFrozenError).
+					Times(test.isFrozenTimes),
+
+				mockAuth.EXPECT().StepUpRequired(auth.StepUpTransfers).
+					Return(test.stepUpRequired).
+					Times(test.stepUpTimes),
+
+				mockDB.EXPECT().MFAGet(gomock.Any()).
+					Return(test.mfaGetEnrollment, test.mfaGetErr).
+					Times(test.mfaGetTimes),
+
+				mockDB.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{
+						UserLoginCredentials: modelsPostgres.UserLoginCredentials{Username: "username1"},
+					}}, nil).
+					Times(test.stepUpFailTimes),
+
+				mockCache.EXPECT().Get(constants.LoginLockoutKeyPrefix()+"username1", gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					Times(test.stepUpFailTimes),
+
+				mockAuth.EXPECT().LoginFailureWindow().
+					Return(time.Hour).
+					Times(test.stepUpFailTimes),
+
+				mockCache.EXPECT().Incr(constants.LoginFailuresKeyPrefix()+"username1", time.Hour).
+					Return(int64(1), nil).
+					Times(test.stepUpFailTimes),
+
+				mockAuth.EXPECT().LoginLockout(int64(1)).
+					Return(time.Duration(0)).
+					Times(test.stepUpFailTimes),
 			)
 
-			_, _, err := AuthorizationCheck(test.ctx, mockAuth, mockDB, zapLogger, testAuthHeaderKey)
+			_, _, err := AuthorizationCheck(test.ctx, mockAuth, mockCache, mockDB, zapLogger, testAuthHeaderKey,
+				auth.StepUpTransfers, auth.ScopeWithdraw)
 
 			test.expectErr(t, err, "error expectation failed")
 
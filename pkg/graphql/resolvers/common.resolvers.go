@@ -2,7 +2,7 @@ package graphql
 
 // This file will be automatically regenerated based on the schema, any resolver implementations
 // will be copied through when generating and any unknown code will be moved to the end.
-// Code generated by github.com/99designs/gqlgen version v0.17.49
+// Code generated by github.com/99designs/gqlgen version v0.17.63
 
 import (
 	"context"
@@ -16,6 +16,11 @@ func (r *offerResponseResolver) DebitAmount(ctx context.Context, obj *models.HTT
 	return obj.DebitAmount.InexactFloat64(), nil
 }
 
+// Fee is the resolver for the fee field.
+func (r *offerResponseResolver) Fee(ctx context.Context, obj *models.HTTPExchangeOfferResponse) (float64, error) {
+	return obj.Fee.InexactFloat64(), nil
+}
+
 // ClientID is the resolver for the ClientID field.
 func (r *priceQuoteResolver) ClientID(ctx context.Context, obj *models.PriceQuote) (string, error) {
 	return obj.ClientID.String(), nil
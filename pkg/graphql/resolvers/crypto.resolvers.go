@@ -2,7 +2,7 @@ package graphql
 
 // This file will be automatically regenerated based on the schema, any resolver implementations
 // will be copied through when generating and any unknown code will be moved to the end.
-// Code generated by github.com/99designs/gqlgen version v0.17.49
+// Code generated by github.com/99designs/gqlgen version v0.17.63
 
 import (
 	"context"
@@ -12,6 +12,7 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/auth"
 	"github.com/surahman/FTeX/pkg/common"
 	"github.com/surahman/FTeX/pkg/constants"
 	graphql_generated "github.com/surahman/FTeX/pkg/graphql/generated"
@@ -44,6 +45,21 @@ func (r *cryptoAccountResolver) ClientID(ctx context.Context, obj *postgres.Cryp
 	return obj.ClientID.String(), nil
 }
 
+// Status is the resolver for the status field.
+func (r *cryptoAccountResolver) Status(ctx context.Context, obj *postgres.CryptoAccount) (string, error) {
+	return string(obj.Status), nil
+}
+
+// Held is the resolver for the held field.
+func (r *cryptoAccountResolver) Held(ctx context.Context, obj *postgres.CryptoAccount) (float64, error) {
+	return obj.Held.InexactFloat64(), nil
+}
+
+// Available is the resolver for the available field.
+func (r *cryptoAccountResolver) Available(ctx context.Context, obj *postgres.CryptoAccount) (float64, error) {
+	return obj.Available.InexactFloat64(), nil
+}
+
 // Amount is the resolver for the amount field.
 func (r *cryptoJournalResolver) Amount(ctx context.Context, obj *postgres.CryptoJournal) (float64, error) {
 	return obj.Amount.InexactFloat64(), nil
@@ -64,6 +80,16 @@ func (r *cryptoJournalResolver) TxID(ctx context.Context, obj *postgres.CryptoJo
 	return obj.TxID.String(), nil
 }
 
+// SourceReceipt is the resolver for the sourceReceipt field.
+func (r *cryptoSwapResponseResolver) SourceReceipt(ctx context.Context, obj *models.HTTPCryptoSwapResponse) (*postgres.CryptoJournal, error) {
+	return obj.SrcTxReceipt, nil
+}
+
+// DestinationReceipt is the resolver for the destinationReceipt field.
+func (r *cryptoSwapResponseResolver) DestinationReceipt(ctx context.Context, obj *models.HTTPCryptoSwapResponse) (*postgres.CryptoJournal, error) {
+	return obj.DstTxReceipt, nil
+}
+
 // Transactions is the resolver for the transactions field.
 func (r *cryptoTransactionsPaginatedResolver) Transactions(ctx context.Context, obj *models.HTTPCryptoTransactionsPaginated) ([]postgres.CryptoJournal, error) {
 	return obj.TransactionDetails, nil
@@ -77,7 +103,7 @@ func (r *mutationResolver) OpenCrypto(ctx context.Context, ticker string) (*mode
 		err        error
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeTrade); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -97,7 +123,7 @@ func (r *mutationResolver) OfferCrypto(ctx context.Context, input models.HTTPCry
 		statusMessage string
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeTrade); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -116,23 +142,88 @@ func (r *mutationResolver) OfferCrypto(ctx context.Context, input models.HTTPCry
 }
 
 // ExchangeCrypto is the resolver for the exchangeCrypto field.
-func (r *mutationResolver) ExchangeCrypto(ctx context.Context, offerID string) (*models.HTTPCryptoTransferResponse, error) {
+func (r *mutationResolver) ExchangeCrypto(ctx context.Context, offerID string, idempotencyKey *string) (*models.HTTPCryptoTransferResponse, error) {
+	var (
+		clientID      uuid.UUID
+		err           error
+		receipt       *models.HTTPCryptoTransferResponse
+		statusMessage string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpTransfers, auth.ScopeTrade); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if idempotencyKey == nil {
+		idempotencyKey = new(string)
+	}
+
+	if receipt, _, statusMessage, _, err = common.HTTPIdempotentRequest(r.cache, r.logger, clientID, *idempotencyKey,
+		&models.HTTPTransferRequest{OfferID: offerID}, func() (*models.HTTPCryptoTransferResponse, int, string, any, error) {
+			exchangeReceipt, httpStatus, httpMessage, err := common.HTTPExchangeCrypto(r.auth, r.cache, r.db, r.logger, clientID, offerID)
+
+			return &exchangeReceipt, httpStatus, httpMessage, nil, err
+		}); err != nil {
+		return nil, errors.New(statusMessage)
+	}
+
+	return receipt, nil
+}
+
+// OfferSwapCrypto is the resolver for the offerSwapCrypto field.
+func (r *mutationResolver) OfferSwapCrypto(ctx context.Context, input models.HTTPExchangeOfferRequest) (*models.HTTPExchangeOfferResponse, error) {
+	var (
+		clientID      uuid.UUID
+		err           error
+		offer         models.HTTPExchangeOfferResponse
+		statusMessage string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeTrade); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if offer, _, statusMessage, err = common.HTTPCryptoSwapOffer(r.auth, r.cache, r.logger, r.quotes,
+		clientID, input.SourceCurrency, input.DestinationCurrency, input.SourceAmount); err != nil {
+		if statusMessage == constants.InvalidRequestString() {
+			statusMessage = err.Error()
+		}
+
+		return nil, errors.New(statusMessage)
+	}
+
+	offer.ClientID = clientID
+
+	return &offer, nil
+}
+
+// ExchangeSwapCrypto is the resolver for the exchangeSwapCrypto field.
+func (r *mutationResolver) ExchangeSwapCrypto(ctx context.Context, offerID string, idempotencyKey *string) (*models.HTTPCryptoSwapResponse, error) {
 	var (
 		clientID      uuid.UUID
 		err           error
-		receipt       models.HTTPCryptoTransferResponse
+		receipt       *models.HTTPCryptoSwapResponse
 		statusMessage string
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpTransfers, auth.ScopeTrade); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
-	if receipt, _, statusMessage, err = common.HTTPExchangeCrypto(r.auth, r.cache, r.db, r.logger, clientID, offerID); err != nil {
+	if idempotencyKey == nil {
+		idempotencyKey = new(string)
+	}
+
+	if receipt, _, statusMessage, _, err = common.HTTPIdempotentRequest(r.cache, r.logger, clientID, *idempotencyKey,
+		&models.HTTPTransferRequest{OfferID: offerID}, func() (*models.HTTPCryptoSwapResponse, int, string, any, error) {
+			swapReceipt, httpStatus, httpMessage, err := common.HTTPSwapCrypto(r.auth, r.cache, r.db, r.logger, clientID, offerID)
+
+			return &swapReceipt, httpStatus, httpMessage, nil, err
+		}); err != nil {
 		return nil, errors.New(statusMessage)
 	}
 
-	return &receipt, nil
+	return receipt, nil
 }
 
 // BalanceCrypto is the resolver for the balanceCrypto field.
@@ -145,7 +236,7 @@ func (r *queryResolver) BalanceCrypto(ctx context.Context, ticker string) (*post
 		payload     any
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeReadBalances); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -174,7 +265,7 @@ func (r *queryResolver) BalanceAllCrypto(ctx context.Context, pageCursor *string
 		pageCursor = new(string)
 	}
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeReadBalances); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -195,7 +286,7 @@ func (r *queryResolver) TransactionDetailsCrypto(ctx context.Context, transactio
 		httpMessage    string
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeReadBalances); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -242,7 +333,7 @@ func (r *queryResolver) TransactionDetailsAllCrypto(ctx context.Context, input m
 	}
 	params.YearStr = *input.Year
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeReadBalances); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -262,6 +353,13 @@ func (r *cryptoOfferRequestResolver) SourceAmount(ctx context.Context, obj *mode
 	return nil
 }
 
+// SourceAmount is the resolver for the sourceAmount field.
+func (r *cryptoSwapOfferRequestResolver) SourceAmount(ctx context.Context, obj *models.HTTPExchangeOfferRequest, data float64) error {
+	obj.SourceAmount = decimal.NewFromFloat(data)
+
+	return nil
+}
+
 // CryptoAccount returns graphql_generated.CryptoAccountResolver implementation.
 func (r *Resolver) CryptoAccount() graphql_generated.CryptoAccountResolver {
 	return &cryptoAccountResolver{r}
@@ -272,6 +370,11 @@ func (r *Resolver) CryptoJournal() graphql_generated.CryptoJournalResolver {
 	return &cryptoJournalResolver{r}
 }
 
+// CryptoSwapResponse returns graphql_generated.CryptoSwapResponseResolver implementation.
+func (r *Resolver) CryptoSwapResponse() graphql_generated.CryptoSwapResponseResolver {
+	return &cryptoSwapResponseResolver{r}
+}
+
 // CryptoTransactionsPaginated returns graphql_generated.CryptoTransactionsPaginatedResolver implementation.
 func (r *Resolver) CryptoTransactionsPaginated() graphql_generated.CryptoTransactionsPaginatedResolver {
 	return &cryptoTransactionsPaginatedResolver{r}
@@ -282,7 +385,14 @@ func (r *Resolver) CryptoOfferRequest() graphql_generated.CryptoOfferRequestReso
 	return &cryptoOfferRequestResolver{r}
 }
 
+// CryptoSwapOfferRequest returns graphql_generated.CryptoSwapOfferRequestResolver implementation.
+func (r *Resolver) CryptoSwapOfferRequest() graphql_generated.CryptoSwapOfferRequestResolver {
+	return &cryptoSwapOfferRequestResolver{r}
+}
+
 type cryptoAccountResolver struct{ *Resolver }
 type cryptoJournalResolver struct{ *Resolver }
+type cryptoSwapResponseResolver struct{ *Resolver }
 type cryptoTransactionsPaginatedResolver struct{ *Resolver }
 type cryptoOfferRequestResolver struct{ *Resolver }
+type cryptoSwapOfferRequestResolver struct{ *Resolver }
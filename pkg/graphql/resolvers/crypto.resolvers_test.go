@@ -22,6 +22,7 @@ import (
 	"github.com/surahman/FTeX/pkg/models"
 	"github.com/surahman/FTeX/pkg/postgres"
 	"github.com/surahman/FTeX/pkg/quotes"
+	"github.com/surahman/FTeX/pkg/redis"
 )
 
 func TestCryptoResolver_OpenCrypto(t *testing.T) {
@@ -102,18 +103,35 @@ func TestCryptoResolver_OpenCrypto(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
-			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
 					Return(test.isDeletedValue, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().CryptoCreateAccount(gomock.Any(), gomock.Any()).
 					Return(test.cryptoCreateAccErr).
 					Times(test.cryptoCreateAccTimes),
@@ -122,7 +140,8 @@ func TestCryptoResolver_OpenCrypto(t *testing.T) {
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -398,16 +417,37 @@ func TestCryptoResolver_OfferCrypto(t *testing.T) { //nolint:maintidx
 			mockPostgres := mocks.NewMockPostgres(mockCtrl) // Not called.
 			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateTimes),
 
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
 					Return(test.isDeletedValue, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockQuotes.EXPECT().Fee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(decimal.Zero).
+					Times(test.quotesTimes),
+
 				mockQuotes.EXPECT().CryptoConversion(gomock.Any(), gomock.Any(), gomock.Any(), test.isPurchase, nil).
 					Return(amountValid, test.quotesAmount, test.quotesErr).
 					Times(test.quotesTimes),
@@ -424,7 +464,8 @@ func TestCryptoResolver_OfferCrypto(t *testing.T) { //nolint:maintidx
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -509,6 +550,7 @@ func TestCryptoResolver_ExchangeCrypto(t *testing.T) {
 
 	cryptoAmount := decimal.NewFromFloat(1234.56)
 	fiatAmount := decimal.NewFromFloat(78910.11)
+	feeAmount := decimal.NewFromFloat(0.25)
 
 	validSale := models.HTTPExchangeOfferResponse{
 		PriceQuote: models.PriceQuote{
@@ -519,6 +561,7 @@ func TestCryptoResolver_ExchangeCrypto(t *testing.T) {
 			Amount:         fiatAmount,
 		},
 		DebitAmount:      cryptoAmount,
+		Fee:              feeAmount,
 		OfferID:          "OFFER-ID",
 		Expires:          0,
 		IsCryptoPurchase: false,
@@ -534,6 +577,7 @@ func TestCryptoResolver_ExchangeCrypto(t *testing.T) {
 			Amount:         cryptoAmount,
 		},
 		DebitAmount:      fiatAmount,
+		Fee:              feeAmount,
 		OfferID:          "OFFER-ID",
 		Expires:          0,
 		IsCryptoPurchase: true,
@@ -658,17 +702,34 @@ func TestCryptoResolver_ExchangeCrypto(t *testing.T) {
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
 			mockRedis := mocks.NewMockRedis(mockCtrl)
-			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(validClientID, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateTimes),
 
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
 					Return(test.isDeletedValue, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
 				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
 					Return([]byte("OFFER-ID"), test.authEncryptErr).
 					Times(test.authEncryptTimes),
@@ -683,12 +744,12 @@ func TestCryptoResolver_ExchangeCrypto(t *testing.T) {
 					Times(test.redisDelTimes),
 
 				mockPostgres.EXPECT().CryptoPurchase(
-					gomock.Any(), postgres.CurrencyUSD, fiatAmount, "BTC", cryptoAmount).
+					gomock.Any(), postgres.CurrencyUSD, fiatAmount, "BTC", cryptoAmount, feeAmount, gomock.Any()).
 					Return(&postgres.FiatJournal{}, &postgres.CryptoJournal{}, nil).
 					Times(test.purchaseTimes),
 
 				mockPostgres.EXPECT().CryptoSell(
-					gomock.Any(), postgres.CurrencyUSD, fiatAmount, "BTC", cryptoAmount).
+					gomock.Any(), postgres.CurrencyUSD, fiatAmount, "BTC", cryptoAmount, feeAmount, gomock.Any()).
 					Return(&postgres.FiatJournal{}, &postgres.CryptoJournal{}, nil).
 					Times(test.sellTimes),
 			)
@@ -696,7 +757,411 @@ func TestCryptoResolver_ExchangeCrypto(t *testing.T) {
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestCryptoResolver_CryptoSwapOfferRequestResolver(t *testing.T) {
+	t.Parallel()
+
+	var (
+		resolver     cryptoSwapOfferRequestResolver
+		input        models.HTTPExchangeOfferRequest
+		sourceFloat  = 1.12345678
+		sourceAmount = decimal.NewFromFloat(sourceFloat)
+	)
+
+	t.Run("SourceAmount", func(t *testing.T) {
+		t.Parallel()
+
+		err := resolver.SourceAmount(context.TODO(), &input, sourceFloat)
+		require.NoError(t, err, "source amount should always return a nil error.")
+		require.Equal(t, sourceAmount, input.SourceAmount, "source amounts mismatched.")
+	})
+}
+
+func TestCryptoResolver_CryptoSwapResponseResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := cryptoSwapResponseResolver{}
+
+	response := &models.HTTPCryptoSwapResponse{
+		SrcTxReceipt: &postgres.CryptoJournal{Ticker: "BTC"},
+		DstTxReceipt: &postgres.CryptoJournal{Ticker: "ETH"},
+	}
+
+	source, err := resolver.SourceReceipt(context.TODO(), response)
+	require.NoError(t, err, "source should always return a nil error.")
+	require.Equal(t, response.SrcTxReceipt, source, "source and returned struct addresses mismatched.")
+
+	destination, err := resolver.DestinationReceipt(context.TODO(), response)
+	require.NoError(t, err, "destination should always return a nil error.")
+	require.Equal(t, response.DstTxReceipt, destination, "destination and returned struct addresses mismatched.")
+}
+
+func TestCryptoResolver_OfferSwapCrypto(t *testing.T) {
+	t.Parallel()
+
+	var (
+		validFloat    = 1.123456
+		negativeFloat = float64(-1)
+		amountValid   = decimal.NewFromFloat(validFloat)
+	)
+
+	testCases := []struct {
+		name               string
+		path               string
+		query              string
+		expectErr          bool
+		authValidateJWTErr error
+		authValidateTimes  int
+		isDeletedTimes     int
+		quotesErr          error
+		quotesTimes        int
+		authEncryptTimes   int
+		redisErr           error
+		redisTimes         int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/offer-swap-crypto/invalid-jwt",
+			query:              fmt.Sprintf(testCryptoQuery["offerSwapCrypto"], validFloat, "BTC", "ETH"),
+			expectErr:          true,
+			authValidateJWTErr: errors.New("invalid jwt"),
+			authValidateTimes:  1,
+			isDeletedTimes:     0,
+			quotesTimes:        0,
+			authEncryptTimes:   0,
+			redisTimes:         0,
+		}, {
+			name:              "same source and destination",
+			path:              "/offer-swap-crypto/same-currency",
+			query:             fmt.Sprintf(testCryptoQuery["offerSwapCrypto"], validFloat, "BTC", "BTC"),
+			expectErr:         true,
+			authValidateTimes: 1,
+			isDeletedTimes:    1,
+			quotesTimes:       0,
+			authEncryptTimes:  0,
+			redisTimes:        0,
+		}, {
+			name:              "negative",
+			path:              "/offer-swap-crypto/negative",
+			query:             fmt.Sprintf(testCryptoQuery["offerSwapCrypto"], negativeFloat, "BTC", "ETH"),
+			expectErr:         true,
+			authValidateTimes: 1,
+			isDeletedTimes:    1,
+			quotesTimes:       0,
+			authEncryptTimes:  0,
+			redisTimes:        0,
+		}, {
+			name:              "quote failure",
+			path:              "/offer-swap-crypto/quote-failure",
+			query:             fmt.Sprintf(testCryptoQuery["offerSwapCrypto"], validFloat, "BTC", "ETH"),
+			expectErr:         true,
+			authValidateTimes: 1,
+			isDeletedTimes:    1,
+			quotesErr:         errors.New("quote failure"),
+			quotesTimes:       1,
+			authEncryptTimes:  0,
+			redisTimes:        0,
+		}, {
+			name:              "cache failure",
+			path:              "/offer-swap-crypto/cache-failure",
+			query:             fmt.Sprintf(testCryptoQuery["offerSwapCrypto"], validFloat, "BTC", "ETH"),
+			expectErr:         true,
+			authValidateTimes: 1,
+			isDeletedTimes:    1,
+			quotesTimes:       1,
+			authEncryptTimes:  1,
+			redisErr:          errors.New("cache failure"),
+			redisTimes:        1,
+		}, {
+			name:              "valid",
+			path:              "/offer-swap-crypto/valid",
+			query:             fmt.Sprintf(testCryptoQuery["offerSwapCrypto"], validFloat, "BTC", "ETH"),
+			expectErr:         false,
+			authValidateTimes: 1,
+			isDeletedTimes:    1,
+			quotesTimes:       1,
+			authEncryptTimes:  1,
+			redisTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockQuotes.EXPECT().Fee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(decimal.Zero).
+					Times(test.quotesTimes),
+
+				mockQuotes.EXPECT().CryptoConversion("BTC", "ETH", amountValid, true, nil).
+					Return(amountValid, amountValid, test.quotesErr).
+					Times(test.quotesTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("OFFER-ID", nil).
+					Times(test.authEncryptTimes),
+
+				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.redisErr).
+					Times(test.redisTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestCryptoResolver_ExchangeSwapCrypto(t *testing.T) {
+	t.Parallel()
+
+	validClientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate a valid uuid.")
+
+	sourceAmount := decimal.NewFromFloat(1.12345678)
+	feeAmount := decimal.NewFromFloat(0.00012345)
+	destinationAmount := decimal.NewFromFloat(18.53703687)
+
+	validSwap := models.HTTPExchangeOfferResponse{
+		PriceQuote: models.PriceQuote{
+			ClientID:       validClientID,
+			SourceAcc:      "BTC",
+			DestinationAcc: "ETH",
+			Rate:           decimal.Decimal{},
+			Amount:         destinationAmount,
+		},
+		DebitAmount:  sourceAmount,
+		Fee:          feeAmount,
+		OfferID:      "OFFER-ID",
+		Expires:      0,
+		IsCryptoSwap: true,
+	}
+
+	testCases := []struct {
+		name               string
+		path               string
+		query              string
+		expectErr          bool
+		authValidateJWTErr error
+		authValidateTimes  int
+		isDeletedTimes     int
+		isDeletedValue     bool
+		authDecryptTimes   int
+		authDecryptErr     error
+		redisGetTimes      int
+		redisDelTimes      int
+		swapTimes          int
+		swapErr            error
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/exchange-swap-crypto/invalid-jwt",
+			query:              fmt.Sprintf(testCryptoQuery["exchangeSwapCrypto"], "OFFER-ID"),
+			expectErr:          true,
+			authValidateTimes:  1,
+			authValidateJWTErr: errors.New("invalid jwt"),
+			isDeletedTimes:     0,
+			authDecryptTimes:   0,
+			redisGetTimes:      0,
+			redisDelTimes:      0,
+			swapTimes:          0,
+		}, {
+			name:              "deleted account",
+			path:              "/exchange-swap-crypto/deleted-account",
+			query:             fmt.Sprintf(testCryptoQuery["exchangeSwapCrypto"], "OFFER-ID"),
+			expectErr:         true,
+			authValidateTimes: 1,
+			isDeletedTimes:    1,
+			isDeletedValue:    true,
+			authDecryptTimes:  0,
+			redisGetTimes:     0,
+			redisDelTimes:     0,
+			swapTimes:         0,
+		}, {
+			name:              "decrypt failure",
+			path:              "/exchange-swap-crypto/decrypt-failure",
+			query:             fmt.Sprintf(testCryptoQuery["exchangeSwapCrypto"], "OFFER-ID"),
+			expectErr:         true,
+			authValidateTimes: 1,
+			isDeletedTimes:    1,
+			authDecryptTimes:  1,
+			authDecryptErr:    errors.New("decrypt failure"),
+			redisGetTimes:     0,
+			redisDelTimes:     0,
+			swapTimes:         0,
+		}, {
+			name:              "transaction failure",
+			path:              "/exchange-swap-crypto/transaction-failure",
+			query:             fmt.Sprintf(testCryptoQuery["exchangeSwapCrypto"], "OFFER-ID"),
+			expectErr:         true,
+			authValidateTimes: 1,
+			isDeletedTimes:    1,
+			authDecryptTimes:  1,
+			redisGetTimes:     1,
+			redisDelTimes:     1,
+			swapTimes:         1,
+			swapErr:           errors.New("swap failure"),
+		}, {
+			name:              "valid",
+			path:              "/exchange-swap-crypto/valid",
+			query:             fmt.Sprintf(testCryptoQuery["exchangeSwapCrypto"], "OFFER-ID"),
+			expectErr:         false,
+			authValidateTimes: 1,
+			isDeletedTimes:    1,
+			authDecryptTimes:  1,
+			redisGetTimes:     1,
+			redisDelTimes:     1,
+			swapTimes:         1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(validClientID, int64(0), test.authValidateJWTErr).
+					Times(test.authValidateTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(test.isDeletedValue, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("OFFER-ID"), test.authDecryptErr).
+					Times(test.authDecryptTimes),
+
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).
+					Return(nil).
+					SetArg(1, validSwap).
+					Times(test.redisGetTimes),
+
+				mockRedis.EXPECT().Del(gomock.Any()).
+					Return(nil).
+					Times(test.redisDelTimes),
+
+				mockPostgres.EXPECT().CryptoSwap(gomock.Any(), "BTC", sourceAmount, "ETH", destinationAmount, feeAmount).
+					Return(&postgres.CryptoJournal{}, &postgres.CryptoJournal{}, test.swapErr).
+					Times(test.swapTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -877,18 +1342,35 @@ func TestCryptoResolver_BalanceCrypto(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
-			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateTimes),
 
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
 					Return(test.isDeletedValue, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().CryptoBalance(gomock.Any(), gomock.Any()).
 					Return(postgres.CryptoAccount{}, test.balanceErr).
 					Times(test.balanceTimes),
@@ -897,7 +1379,8 @@ func TestCryptoResolver_BalanceCrypto(t *testing.T) {
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -1129,18 +1612,35 @@ func TestCryptoResolver_BalanceAllCrypto(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // not called.
-			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
 					Return(test.isDeletedValue, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
 				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
 					Return([]byte{}, test.authDecryptStrErr).
 					Times(test.authDecryptStrTimes),
@@ -1157,7 +1657,8 @@ func TestCryptoResolver_BalanceAllCrypto(t *testing.T) {
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -1207,6 +1708,8 @@ func TestCryptoResolver_TransactionDetailsCrypto(t *testing.T) { //nolint:dupl
 		fiatTxDetailsTimes   int
 		cryptoTxDetailsErr   error
 		cryptoTxDetailsTimes int
+		tradeTxDetailsErr    error
+		tradeTxDetailsTimes  int
 	}{
 		{
 			name:                 "invalid jwt",
@@ -1222,6 +1725,8 @@ func TestCryptoResolver_TransactionDetailsCrypto(t *testing.T) { //nolint:dupl
 			fiatTxDetailsTimes:   0,
 			cryptoTxDetailsErr:   nil,
 			cryptoTxDetailsTimes: 0,
+			tradeTxDetailsErr:    nil,
+			tradeTxDetailsTimes:  0,
 		}, {
 			name:                 "deleted account",
 			path:                 "/transaction-details-crypto/deleted-account",
@@ -1236,6 +1741,8 @@ func TestCryptoResolver_TransactionDetailsCrypto(t *testing.T) { //nolint:dupl
 			fiatTxDetailsTimes:   0,
 			cryptoTxDetailsErr:   nil,
 			cryptoTxDetailsTimes: 0,
+			tradeTxDetailsErr:    nil,
+			tradeTxDetailsTimes:  0,
 		}, {
 			name:                 "db failure fiat",
 			path:                 "/transaction-details-crypto/db-failure-fiat",
@@ -1249,6 +1756,8 @@ func TestCryptoResolver_TransactionDetailsCrypto(t *testing.T) { //nolint:dupl
 			fiatTxDetailsTimes:   1,
 			fiatTxDetailsErr:     postgres.ErrTransactCryptoDetails,
 			cryptoTxDetailsTimes: 0,
+			tradeTxDetailsErr:    nil,
+			tradeTxDetailsTimes:  0,
 			cryptoTxDetailsErr:   nil,
 		}, {
 			name:                 "db failure crypto",
@@ -1263,6 +1772,8 @@ func TestCryptoResolver_TransactionDetailsCrypto(t *testing.T) { //nolint:dupl
 			fiatTxDetailsTimes:   1,
 			fiatTxDetailsErr:     nil,
 			cryptoTxDetailsTimes: 1,
+			tradeTxDetailsErr:    nil,
+			tradeTxDetailsTimes:  0,
 			cryptoTxDetailsErr:   postgres.ErrTransactCryptoDetails,
 		}, {
 			name:                 "valid",
@@ -1277,6 +1788,8 @@ func TestCryptoResolver_TransactionDetailsCrypto(t *testing.T) { //nolint:dupl
 			fiatTxDetailsTimes:   1,
 			fiatTxDetailsErr:     nil,
 			cryptoTxDetailsTimes: 1,
+			tradeTxDetailsErr:    nil,
+			tradeTxDetailsTimes:  1,
 			cryptoTxDetailsErr:   nil,
 		},
 	}
@@ -1292,18 +1805,35 @@ func TestCryptoResolver_TransactionDetailsCrypto(t *testing.T) { //nolint:dupl
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
-			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(clientID, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateTimes),
 
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
 					Return(test.isDeletedValue, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().FiatTxDetails(gomock.Any(), gomock.Any()).
 					Return([]postgres.FiatJournal{{}}, test.fiatTxDetailsErr).
 					Times(test.fiatTxDetailsTimes),
@@ -1311,12 +1841,17 @@ func TestCryptoResolver_TransactionDetailsCrypto(t *testing.T) { //nolint:dupl
 				mockPostgres.EXPECT().CryptoTxDetails(gomock.Any(), gomock.Any()).
 					Return([]postgres.CryptoJournal{{}}, test.cryptoTxDetailsErr).
 					Times(test.cryptoTxDetailsTimes),
+
+				mockPostgres.EXPECT().TradeTxDetails(gomock.Any(), gomock.Any()).
+					Return([]postgres.Trade{{}}, test.tradeTxDetailsErr).
+					Times(test.tradeTxDetailsTimes),
 			)
 
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -1539,18 +2074,35 @@ func TestCryptoResolver_TransactionDetailsAllCrypto(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // not called.
-			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
 					Return(test.isDeletedValue, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
 				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
 					Return([]byte(decryptedCursor), test.authDecryptCursorErr).
 					Times(test.authDecryptCursorTimes),
@@ -1568,7 +2120,8 @@ func TestCryptoResolver_TransactionDetailsAllCrypto(t *testing.T) {
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
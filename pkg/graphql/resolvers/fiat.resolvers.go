@@ -2,7 +2,7 @@ package graphql
 
 // This file will be automatically regenerated based on the schema, any resolver implementations
 // will be copied through when generating and any unknown code will be moved to the end.
-// Code generated by github.com/99designs/gqlgen version v0.17.49
+// Code generated by github.com/99designs/gqlgen version v0.17.63
 
 import (
 	"context"
@@ -12,6 +12,7 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/auth"
 	"github.com/surahman/FTeX/pkg/common"
 	graphql_generated "github.com/surahman/FTeX/pkg/graphql/generated"
 	"github.com/surahman/FTeX/pkg/models"
@@ -48,6 +49,21 @@ func (r *fiatAccountResolver) ClientID(ctx context.Context, obj *postgres.FiatAc
 	return obj.ClientID.String(), nil
 }
 
+// Status is the resolver for the status field.
+func (r *fiatAccountResolver) Status(ctx context.Context, obj *postgres.FiatAccount) (string, error) {
+	return string(obj.Status), nil
+}
+
+// Held is the resolver for the held field.
+func (r *fiatAccountResolver) Held(ctx context.Context, obj *postgres.FiatAccount) (float64, error) {
+	return obj.Held.InexactFloat64(), nil
+}
+
+// Available is the resolver for the available field.
+func (r *fiatAccountResolver) Available(ctx context.Context, obj *postgres.FiatAccount) (float64, error) {
+	return obj.Available.InexactFloat64(), nil
+}
+
 // TxID is the resolver for the txId field.
 func (r *fiatDepositResponseResolver) TxID(ctx context.Context, obj *postgres.FiatAccountTransferResult) (string, error) {
 	return obj.TxID.String(), nil
@@ -126,7 +142,7 @@ func (r *mutationResolver) OpenFiat(ctx context.Context, currency string) (*mode
 		err        error
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeDeposit); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -138,7 +154,7 @@ func (r *mutationResolver) OpenFiat(ctx context.Context, currency string) (*mode
 }
 
 // DepositFiat is the resolver for the depositFiat field.
-func (r *mutationResolver) DepositFiat(ctx context.Context, input models.HTTPDepositCurrencyRequest) (*postgres.FiatAccountTransferResult, error) {
+func (r *mutationResolver) DepositFiat(ctx context.Context, input models.HTTPDepositCurrencyRequest, idempotencyKey *string) (*postgres.FiatAccountTransferResult, error) {
 	var (
 		clientID        uuid.UUID
 		err             error
@@ -146,13 +162,45 @@ func (r *mutationResolver) DepositFiat(ctx context.Context, input models.HTTPDep
 		transferReceipt *postgres.FiatAccountTransferResult
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeDeposit); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
-	if transferReceipt, _, httpMessage, _, err =
-		common.HTTPFiatDeposit(r.db, r.logger, clientID, &input); err != nil {
+	if idempotencyKey == nil {
+		idempotencyKey = new(string)
+	}
 
+	if transferReceipt, _, httpMessage, _, err = common.HTTPIdempotentRequest(r.cache, r.logger, clientID,
+		*idempotencyKey, &input, func() (*postgres.FiatAccountTransferResult, int, string, any, error) {
+			return common.HTTPFiatDeposit(r.db, r.logger, clientID, &input)
+		}); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return transferReceipt, nil
+}
+
+// WithdrawFiat is the resolver for the withdrawFiat field.
+func (r *mutationResolver) WithdrawFiat(ctx context.Context, input models.HTTPWithdrawCurrencyRequest, idempotencyKey *string) (*postgres.FiatAccountTransferResult, error) {
+	var (
+		clientID        uuid.UUID
+		err             error
+		httpMessage     string
+		transferReceipt *postgres.FiatAccountTransferResult
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpTransfers, auth.ScopeWithdraw); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if idempotencyKey == nil {
+		idempotencyKey = new(string)
+	}
+
+	if transferReceipt, _, httpMessage, _, err = common.HTTPIdempotentRequest(r.cache, r.logger, clientID,
+		*idempotencyKey, &input, func() (*postgres.FiatAccountTransferResult, int, string, any, error) {
+			return common.HTTPFiatWithdraw(r.db, r.logger, clientID, &input)
+		}); err != nil {
 		return nil, errors.New(httpMessage)
 	}
 
@@ -169,7 +217,7 @@ func (r *mutationResolver) ExchangeOfferFiat(ctx context.Context, input models.H
 		offer       *models.HTTPExchangeOfferResponse
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeTrade); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -183,7 +231,7 @@ func (r *mutationResolver) ExchangeOfferFiat(ctx context.Context, input models.H
 }
 
 // ExchangeTransferFiat is the resolver for the exchangeTransferFiat field.
-func (r *mutationResolver) ExchangeTransferFiat(ctx context.Context, offerID string) (*models.HTTPFiatTransferResponse, error) {
+func (r *mutationResolver) ExchangeTransferFiat(ctx context.Context, offerID string, idempotencyKey *string) (*models.HTTPFiatTransferResponse, error) {
 	var (
 		err         error
 		clientID    uuid.UUID
@@ -192,12 +240,48 @@ func (r *mutationResolver) ExchangeTransferFiat(ctx context.Context, offerID str
 		receipt     *models.HTTPFiatTransferResponse
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpTransfers, auth.ScopeTrade); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if idempotencyKey == nil {
+		idempotencyKey = new(string)
+	}
+
+	request := &models.HTTPTransferRequest{OfferID: offerID}
+
+	if receipt, _, httpMessage, payload, err = common.HTTPIdempotentRequest(r.cache, r.logger, clientID,
+		*idempotencyKey, request, func() (*models.HTTPFiatTransferResponse, int, string, any, error) {
+			return common.HTTPFiatTransfer(r.auth, r.cache, r.db, r.logger, clientID, request)
+		}); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+	}
+
+	return receipt, nil
+}
+
+// TransferP2PFiat is the resolver for the transferP2PFiat field.
+func (r *mutationResolver) TransferP2PFiat(ctx context.Context, input models.HTTPFiatP2PTransferRequest, idempotencyKey *string) (*postgres.FiatAccountTransferResult, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		payload     any
+		receipt     *postgres.FiatAccountTransferResult
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpTransfers, auth.ScopeWithdraw); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
-	if receipt, _, httpMessage, payload, err = common.HTTPFiatTransfer(r.auth, r.cache, r.db, r.logger,
-		clientID, &models.HTTPTransferRequest{OfferID: offerID}); err != nil {
+	if idempotencyKey == nil {
+		idempotencyKey = new(string)
+	}
+
+	if receipt, _, httpMessage, payload, err = common.HTTPIdempotentRequest(r.cache, r.logger, clientID,
+		*idempotencyKey, &input, func() (*postgres.FiatAccountTransferResult, int, string, any, error) {
+			return common.HTTPFiatTransferP2P(r.db, r.logger, clientID, &input)
+		}); err != nil {
 		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
 	}
 
@@ -214,7 +298,7 @@ func (r *queryResolver) BalanceFiat(ctx context.Context, currencyCode string) (*
 		payload     any
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeReadBalances); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -243,7 +327,7 @@ func (r *queryResolver) BalanceAllFiat(ctx context.Context, pageCursor *string,
 		pageCursor = new(string)
 	}
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeReadBalances); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -264,7 +348,7 @@ func (r *queryResolver) TransactionDetailsFiat(ctx context.Context, transactionI
 		httpMessage    string
 	)
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeReadBalances); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -311,7 +395,7 @@ func (r *queryResolver) TransactionDetailsAllFiat(ctx context.Context, input mod
 	}
 	params.YearStr = *input.Year
 
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeReadBalances); err != nil {
 		return nil, errors.New("authorization failure")
 	}
 
@@ -338,6 +422,20 @@ func (r *fiatExchangeOfferRequestResolver) SourceAmount(ctx context.Context, obj
 	return nil
 }
 
+// Amount is the resolver for the amount field.
+func (r *fiatP2PTransferRequestResolver) Amount(ctx context.Context, obj *models.HTTPFiatP2PTransferRequest, data float64) error {
+	obj.Amount = decimal.NewFromFloat(data)
+
+	return nil
+}
+
+// Amount is the resolver for the amount field.
+func (r *fiatWithdrawRequestResolver) Amount(ctx context.Context, obj *models.HTTPWithdrawCurrencyRequest, data float64) error {
+	obj.Amount = decimal.NewFromFloat(data)
+
+	return nil
+}
+
 // FiatAccount returns graphql_generated.FiatAccountResolver implementation.
 func (r *Resolver) FiatAccount() graphql_generated.FiatAccountResolver {
 	return &fiatAccountResolver{r}
@@ -373,6 +471,16 @@ func (r *Resolver) FiatExchangeOfferRequest() graphql_generated.FiatExchangeOffe
 	return &fiatExchangeOfferRequestResolver{r}
 }
 
+// FiatP2PTransferRequest returns graphql_generated.FiatP2PTransferRequestResolver implementation.
+func (r *Resolver) FiatP2PTransferRequest() graphql_generated.FiatP2PTransferRequestResolver {
+	return &fiatP2PTransferRequestResolver{r}
+}
+
+// FiatWithdrawRequest returns graphql_generated.FiatWithdrawRequestResolver implementation.
+func (r *Resolver) FiatWithdrawRequest() graphql_generated.FiatWithdrawRequestResolver {
+	return &fiatWithdrawRequestResolver{r}
+}
+
 type fiatAccountResolver struct{ *Resolver }
 type fiatDepositResponseResolver struct{ *Resolver }
 type fiatExchangeTransferResponseResolver struct{ *Resolver }
@@ -380,3 +488,5 @@ type fiatJournalResolver struct{ *Resolver }
 type fiatTransactionsPaginatedResolver struct{ *Resolver }
 type fiatDepositRequestResolver struct{ *Resolver }
 type fiatExchangeOfferRequestResolver struct{ *Resolver }
+type fiatP2PTransferRequestResolver struct{ *Resolver }
+type fiatWithdrawRequestResolver struct{ *Resolver }
@@ -115,18 +115,35 @@ func TestFiatResolver_OpenFiat(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
-			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
 					Return(test.isDeletedValue, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().FiatCreateAccount(gomock.Any(), gomock.Any()).
 					Return(test.fiatCreateAccErr).
 					Times(test.fiatCreateAccTimes),
@@ -135,7 +152,8 @@ func TestFiatResolver_OpenFiat(t *testing.T) {
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -340,18 +358,35 @@ func TestFiatResolver_DepositFiat(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
-			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
 					Return(test.isDeletedValue, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().FiatExternalTransfer(gomock.Any(), gomock.Any()).
 					Return(&postgres.FiatAccountTransferResult{}, test.fiatDepositAccErr).
 					Times(test.fiatDepositAccTimes),
@@ -360,7 +395,173 @@ func TestFiatResolver_DepositFiat(t *testing.T) {
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestFiatResolver_WithdrawFiat(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedError       error
+		isDeletedTimes       int
+		isDeletedValue       bool
+		fiatWithdrawAccErr   error
+		fiatWithdrawAccTimes int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/withdraw-fiat/invalid-jwt",
+			query:                fmt.Sprintf(testFiatQuery["withdrawFiat"], 1234.56, "USD"),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("authorization failure"),
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       0,
+			isDeletedValue:       false,
+			fiatWithdrawAccErr:   nil,
+			fiatWithdrawAccTimes: 0,
+		}, {
+			name:                 "deleted account",
+			path:                 "/withdraw-fiat/deleted-account",
+			query:                fmt.Sprintf(testFiatQuery["withdrawFiat"], 1234.56, "USD"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       true,
+			fiatWithdrawAccErr:   nil,
+			fiatWithdrawAccTimes: 0,
+		}, {
+			name:                 "invalid currency",
+			path:                 "/withdraw-fiat/invalid-currency",
+			query:                fmt.Sprintf(testFiatQuery["withdrawFiat"], 1234.56, "INVALID"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			fiatWithdrawAccErr:   nil,
+			fiatWithdrawAccTimes: 0,
+		}, {
+			name:                 "too many decimal places",
+			path:                 "/withdraw-fiat/too-many-decimal-places",
+			query:                fmt.Sprintf(testFiatQuery["withdrawFiat"], 1234.567, "USD"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			fiatWithdrawAccErr:   nil,
+			fiatWithdrawAccTimes: 0,
+		}, {
+			name:                 "negative amount",
+			path:                 "/withdraw-fiat/negative-amount",
+			query:                fmt.Sprintf(testFiatQuery["withdrawFiat"], -1234.56, "USD"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			fiatWithdrawAccErr:   nil,
+			fiatWithdrawAccTimes: 0,
+		}, {
+			name:                 "valid",
+			path:                 "/withdraw-fiat/valid",
+			query:                fmt.Sprintf(testFiatQuery["withdrawFiat"], 1234.56, "USD"),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedError:       nil,
+			isDeletedTimes:       1,
+			isDeletedValue:       false,
+			fiatWithdrawAccErr:   nil,
+			fiatWithdrawAccTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(test.isDeletedValue, test.isDeletedError).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().FiatExternalWithdrawal(gomock.Any(), gomock.Any()).
+					Return(&postgres.FiatAccountTransferResult{}, test.fiatWithdrawAccErr).
+					Times(test.fiatWithdrawAccTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -385,6 +586,26 @@ func TestFiatResolver_DepositFiat(t *testing.T) {
 	}
 }
 
+func TestFiatResolver_FiatWithdrawRequestResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := fiatWithdrawRequestResolver{}
+	expected := 9876.54
+
+	withdrawRequest := &models.HTTPWithdrawCurrencyRequest{
+		Amount:   decimal.NewFromFloat(123456.78),
+		Currency: "",
+	}
+
+	t.Run("Amount", func(t *testing.T) {
+		t.Parallel()
+
+		err := resolver.Amount(context.TODO(), withdrawRequest, expected)
+		require.NoError(t, err, "failed to resolve amount")
+		require.InDelta(t, expected, withdrawRequest.Amount.InexactFloat64(), 0.01, "amount mismatched.")
+	})
+}
+
 func TestFiatResolver_FiatExchangeOfferRequestResolver(t *testing.T) {
 	t.Parallel()
 
@@ -412,10 +633,12 @@ func TestFiatResolver_OfferResponseResolver(t *testing.T) {
 	resolver := offerResponseResolver{}
 
 	debitAmount := decimal.NewFromFloat(123456.78)
+	feeAmount := decimal.NewFromFloat(308.64)
 
 	exchangeOfferResponse := &models.HTTPExchangeOfferResponse{
 		PriceQuote:  models.PriceQuote{},
 		DebitAmount: debitAmount,
+		Fee:         feeAmount,
 		OfferID:     "",
 		Expires:     0,
 	}
@@ -427,6 +650,14 @@ func TestFiatResolver_OfferResponseResolver(t *testing.T) {
 		require.NoError(t, err, "failed to resolve debit amount")
 		require.InDelta(t, debitAmount.InexactFloat64(), result, 0.01, "debit amount mismatched.")
 	})
+
+	t.Run("Fee", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Fee(context.TODO(), exchangeOfferResponse)
+		require.NoError(t, err, "failed to resolve fee")
+		require.InDelta(t, feeAmount.InexactFloat64(), result, 0.01, "fee mismatched.")
+	})
 }
 
 func TestFiatResolver_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
@@ -644,16 +875,37 @@ func TestFiatResolver_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			mockPostgres := mocks.NewMockPostgres(mockCtrl) // Not called.
 			mockRedis := mocks.NewMockRedis(mockCtrl)
 			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
 					Return(test.isDeletedValue, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockQuotes.EXPECT().Fee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(decimal.Zero).
+					Times(test.quotesTimes),
+
 				mockQuotes.EXPECT().FiatConversion(gomock.Any(), gomock.Any(), gomock.Any(), nil).
 					Return(amountValid, amountValid, test.quotesErr).
 					Times(test.quotesTimes),
@@ -670,7 +922,8 @@ func TestFiatResolver_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -1015,17 +1268,34 @@ func TestFiatResolver_ExchangeTransferFiat(t *testing.T) { //nolint:maintidx
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
 			mockRedis := mocks.NewMockRedis(mockCtrl)
-			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(validClientID, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
 					Return(test.isDeletedValue, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
 				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
 					Return(validOfferID, test.authDecryptErr).
 					Times(test.authDecryptTimes),
@@ -1039,7 +1309,7 @@ func TestFiatResolver_ExchangeTransferFiat(t *testing.T) { //nolint:maintidx
 					Return(test.redisDelErr).
 					Times(test.redisDelTimes),
 
-				mockPostgres.EXPECT().FiatInternalTransfer(gomock.Any(), gomock.Any(), gomock.Any()).
+				mockPostgres.EXPECT().FiatInternalTransfer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil, nil, test.internalXferErr).
 					Times(test.internalXferTimes),
 			)
@@ -1047,7 +1317,184 @@ func TestFiatResolver_ExchangeTransferFiat(t *testing.T) { //nolint:maintidx
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestFiatResolver_FiatP2PTransferRequestResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := fiatP2PTransferRequestResolver{}
+	expected := 9876.54
+
+	transferRequest := &models.HTTPFiatP2PTransferRequest{
+		Username: "",
+		Currency: "",
+		Amount:   decimal.NewFromFloat(123456.78),
+	}
+
+	t.Run("Amount", func(t *testing.T) {
+		t.Parallel()
+
+		err := resolver.Amount(context.TODO(), transferRequest, expected)
+		require.NoError(t, err, "failed to resolve amount")
+		require.InDelta(t, expected, transferRequest.Amount.InexactFloat64(), 0.01, "amount mismatched.")
+	})
+}
+
+func TestFiatResolver_TransferP2PFiat(t *testing.T) {
+	t.Parallel()
+
+	recipientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate recipient id.")
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		getClientIDErr       error
+		getClientIDTimes     int
+		transferErr          error
+		transferTimes        int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/transfer-p2p-fiat/invalid-jwt",
+			query:                fmt.Sprintf(testFiatQuery["transferP2PFiat"], "recipient", "USD", 1234.56),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("authorization failure"),
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       0,
+			getClientIDTimes:     0,
+			transferTimes:        0,
+		}, {
+			name:                 "invalid currency",
+			path:                 "/transfer-p2p-fiat/invalid-currency",
+			query:                fmt.Sprintf(testFiatQuery["transferP2PFiat"], "recipient", "INVALID", 1234.56),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			getClientIDTimes:     0,
+			transferTimes:        0,
+		}, {
+			name:                 "too many decimal places",
+			path:                 "/transfer-p2p-fiat/too-many-decimal-places",
+			query:                fmt.Sprintf(testFiatQuery["transferP2PFiat"], "recipient", "USD", 1234.567),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			getClientIDTimes:     0,
+			transferTimes:        0,
+		}, {
+			name:                 "recipient not found",
+			path:                 "/transfer-p2p-fiat/recipient-not-found",
+			query:                fmt.Sprintf(testFiatQuery["transferP2PFiat"], "recipient", "USD", 1234.56),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			getClientIDErr:       postgres.ErrNotFoundUser,
+			getClientIDTimes:     1,
+			transferTimes:        0,
+		}, {
+			name:                 "transfer failure",
+			path:                 "/transfer-p2p-fiat/transfer-failure",
+			query:                fmt.Sprintf(testFiatQuery["transferP2PFiat"], "recipient", "USD", 1234.56),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       2,
+			getClientIDTimes:     1,
+			transferErr:          postgres.ErrTransactFiat,
+			transferTimes:        1,
+		}, {
+			name:                 "valid",
+			path:                 "/transfer-p2p-fiat/valid",
+			query:                fmt.Sprintf(testFiatQuery["transferP2PFiat"], "recipient", "USD", 1234.56),
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       2,
+			getClientIDTimes:     1,
+			transferTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+				Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+				Times(test.authValidateJWTTimes)
+
+			mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+				Return("session-id", int64(0), nil).
+				AnyTimes()
+
+			mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+				Return(redis.ErrCacheMiss).
+				AnyTimes()
+
+			mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+				Return(false, nil).
+				Times(test.isDeletedTimes)
+
+			mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+				Return(false, nil).
+				AnyTimes()
+
+			mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+				Return(false).
+				AnyTimes()
+
+			mockPostgres.EXPECT().UserGetClientID(gomock.Any()).
+				Return(recipientID, test.getClientIDErr).
+				Times(test.getClientIDTimes)
+
+			mockPostgres.EXPECT().FiatInternalTransfer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&postgres.FiatAccountTransferResult{}, &postgres.FiatAccountTransferResult{}, test.transferErr).
+				Times(test.transferTimes)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -1239,18 +1686,35 @@ func TestFiatResolver_BalanceFiat(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // not called.
-			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
 					Return(test.isDeletedValue, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().FiatBalance(gomock.Any(), gomock.Any()).
 					Return(postgres.FiatAccount{}, test.fiatBalanceErr).
 					Times(test.fiatBalanceTimes),
@@ -1259,7 +1723,8 @@ func TestFiatResolver_BalanceFiat(t *testing.T) {
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -1491,18 +1956,35 @@ func TestFiatResolver_BalanceAllFiat(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // not called.
-			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
 					Return(test.isDeletedValue, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
 				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
 					Return([]byte{}, test.authDecryptStrErr).
 					Times(test.authDecryptStrTimes),
@@ -1519,7 +2001,8 @@ func TestFiatResolver_BalanceAllFiat(t *testing.T) {
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -1635,6 +2118,8 @@ func TestFiatResolver_TransactionDetailsFiat(t *testing.T) { //nolint:dupl
 		fiatTxDetailsTimes   int
 		cryptoTxDetailsErr   error
 		cryptoTxDetailsTimes int
+		tradeTxDetailsErr    error
+		tradeTxDetailsTimes  int
 	}{
 		{
 			name:                 "invalid jwt",
@@ -1650,6 +2135,8 @@ func TestFiatResolver_TransactionDetailsFiat(t *testing.T) { //nolint:dupl
 			fiatTxDetailsTimes:   0,
 			cryptoTxDetailsErr:   nil,
 			cryptoTxDetailsTimes: 0,
+			tradeTxDetailsErr:    nil,
+			tradeTxDetailsTimes:  0,
 		}, {
 			name:                 "deleted account",
 			path:                 "/transaction-details-fiat/deleted-account",
@@ -1664,6 +2151,8 @@ func TestFiatResolver_TransactionDetailsFiat(t *testing.T) { //nolint:dupl
 			fiatTxDetailsTimes:   0,
 			cryptoTxDetailsErr:   nil,
 			cryptoTxDetailsTimes: 0,
+			tradeTxDetailsErr:    nil,
+			tradeTxDetailsTimes:  0,
 		}, {
 			name:                 "db failure fiat",
 			path:                 "/transaction-details-fiat/db-failure-fiat",
@@ -1677,6 +2166,8 @@ func TestFiatResolver_TransactionDetailsFiat(t *testing.T) { //nolint:dupl
 			fiatTxDetailsTimes:   1,
 			fiatTxDetailsErr:     postgres.ErrTransactCryptoDetails,
 			cryptoTxDetailsTimes: 0,
+			tradeTxDetailsErr:    nil,
+			tradeTxDetailsTimes:  0,
 			cryptoTxDetailsErr:   nil,
 		}, {
 			name:                 "db failure crypto",
@@ -1691,6 +2182,8 @@ func TestFiatResolver_TransactionDetailsFiat(t *testing.T) { //nolint:dupl
 			fiatTxDetailsTimes:   1,
 			fiatTxDetailsErr:     nil,
 			cryptoTxDetailsTimes: 1,
+			tradeTxDetailsErr:    nil,
+			tradeTxDetailsTimes:  0,
 			cryptoTxDetailsErr:   postgres.ErrTransactCryptoDetails,
 		}, {
 			name:                 "valid",
@@ -1705,6 +2198,8 @@ func TestFiatResolver_TransactionDetailsFiat(t *testing.T) { //nolint:dupl
 			fiatTxDetailsTimes:   1,
 			fiatTxDetailsErr:     nil,
 			cryptoTxDetailsTimes: 1,
+			tradeTxDetailsErr:    nil,
+			tradeTxDetailsTimes:  1,
 			cryptoTxDetailsErr:   nil,
 		},
 	}
@@ -1720,18 +2215,35 @@ func TestFiatResolver_TransactionDetailsFiat(t *testing.T) { //nolint:dupl
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
-			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(clientID, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateTimes),
 
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
 					Return(test.isDeletedValue, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().FiatTxDetails(gomock.Any(), gomock.Any()).
 					Return([]postgres.FiatJournal{{}}, test.fiatTxDetailsErr).
 					Times(test.fiatTxDetailsTimes),
@@ -1739,12 +2251,17 @@ func TestFiatResolver_TransactionDetailsFiat(t *testing.T) { //nolint:dupl
 				mockPostgres.EXPECT().CryptoTxDetails(gomock.Any(), gomock.Any()).
 					Return([]postgres.CryptoJournal{{}}, test.cryptoTxDetailsErr).
 					Times(test.cryptoTxDetailsTimes),
+
+				mockPostgres.EXPECT().TradeTxDetails(gomock.Any(), gomock.Any()).
+					Return([]postgres.Trade{{}}, test.tradeTxDetailsErr).
+					Times(test.tradeTxDetailsTimes),
 			)
 
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
@@ -1973,18 +2490,35 @@ func TestFiatResolver_TransactionDetailsAllFiat(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // not called.
-			mockQuotes := quotes.NewMockQuotes(mockCtrl) // not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
 					Return(uuid.UUID{}, int64(0), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
 					Return(test.isDeletedValue, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
 				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
 					Return([]byte(decryptedCursor), test.authDecryptCursorErr).
 					Times(test.authDecryptCursorTimes),
@@ -2002,7 +2536,8 @@ func TestFiatResolver_TransactionDetailsAllFiat(t *testing.T) {
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(test.query))
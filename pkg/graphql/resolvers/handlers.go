@@ -9,16 +9,19 @@ import (
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/gin-gonic/gin"
 	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
 	graphql_generated "github.com/surahman/FTeX/pkg/graphql/generated"
 	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/notifier"
 	"github.com/surahman/FTeX/pkg/postgres"
 	"github.com/surahman/FTeX/pkg/quotes"
 	"github.com/surahman/FTeX/pkg/redis"
 )
 
-// QueryHandler is the endpoint through which GraphQL can be accessed.
+// QueryHandler is the endpoint through which GraphQL can be accessed. Subscriptions are served over websocket
+// connections that are upgraded from GET requests. Operations are not rate limited if no limits are supplied.
 func QueryHandler(authHeaderKey string, auth auth.Auth, cache redis.Redis, db postgres.Postgres,
-	quotes quotes.Quotes, logger *logger.Logger) gin.HandlerFunc {
+	quotes quotes.Quotes, notify notifier.Notifier, limits *RateLimits, logger *logger.Logger) gin.HandlerFunc {
 	gqlHandler := handler.New(graphql_generated.NewExecutableSchema(
 		graphql_generated.Config{
 			Resolvers: &Resolver{
@@ -27,17 +30,52 @@ func QueryHandler(authHeaderKey string, auth auth.Auth, cache redis.Redis, db po
 				cache:         cache,
 				db:            db,
 				quotes:        quotes,
+				notify:        notify,
 				logger:        logger,
 			},
 		},
 	))
 	gqlHandler.AddTransport(transport.POST{})
+	gqlHandler.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: constants.SubscriptionKeepAlive(),
+		PingPongInterval:      constants.SubscriptionKeepAlive(),
+		InitFunc:              websocketInit(authHeaderKey, logger),
+	})
+
+	if limits != nil {
+		gqlHandler.Use(&rateLimiter{
+			authHeaderKey: authHeaderKey,
+			auth:          auth,
+			cache:         cache,
+			limits:        limits,
+			logger:        logger,
+		})
+	}
 
 	return func(c *gin.Context) {
 		gqlHandler.ServeHTTP(c.Writer, c.Request)
 	}
 }
 
+// websocketInit will copy the authorization credentials in a websocket connection's initialization payload into the
+// request headers, as browsers cannot set headers on websocket connections. Credentials in the headers take precedence.
+func websocketInit(authHeaderKey string, logger *logger.Logger) transport.WebsocketInitFunc {
+	return func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+		ginContext, err := GinContextFromContext(ctx, logger)
+		if err != nil {
+			return ctx, nil, err
+		}
+
+		for _, header := range []string{authHeaderKey, constants.APIKeyHeader()} {
+			if value := initPayload.GetString(header); value != "" && ginContext.GetHeader(header) == "" {
+				ginContext.Request.Header.Set(header, value)
+			}
+		}
+
+		return ctx, nil, nil
+	}
+}
+
 // PlaygroundHandler is the endpoint through which the GraphQL playground can be accessed.
 func PlaygroundHandler(baseURL, queryURL string) gin.HandlerFunc {
 	h := playground.Handler("GraphQL", fmt.Sprintf("/%s%s", baseURL, queryURL))
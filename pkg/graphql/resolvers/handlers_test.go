@@ -6,9 +6,11 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/mocks"
 	"github.com/surahman/FTeX/pkg/quotes"
 )
@@ -21,8 +23,9 @@ func TestQueryHandler(t *testing.T) {
 	mockPostgres := mocks.NewMockPostgres(mockCtrl)
 	mockRedis := mocks.NewMockRedis(mockCtrl)
 	mockQuotes := quotes.NewMockQuotes(mockCtrl)
+	mockNotifier := mocks.NewMockNotifier(mockCtrl)
 
-	handler := QueryHandler("Authorization", mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger)
+	handler := QueryHandler("Authorization", mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger)
 
 	require.NotNil(t, handler, "failed to create graphql endpoint handler")
 }
@@ -43,3 +46,67 @@ func TestGinContextToContextMiddleware(t *testing.T) {
 	// Verify responses
 	require.Equal(t, http.StatusOK, w.Code, "expected status codes do not match")
 }
+
+func TestWebsocketInit(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		headers      map[string]string
+		initPayload  transport.InitPayload
+		expectErr    require.ErrorAssertionFunc
+		expectedJWT  string
+		expectedKey  string
+		noGinContext bool
+	}{
+		{
+			name:         "no gin context",
+			expectErr:    require.Error,
+			noGinContext: true,
+		}, {
+			name:        "no credentials",
+			initPayload: transport.InitPayload{},
+			expectErr:   require.NoError,
+		}, {
+			name:        "jwt",
+			initPayload: transport.InitPayload{testAuthHeaderKey: "payload-token"},
+			expectErr:   require.NoError,
+			expectedJWT: "payload-token",
+		}, {
+			name:        "api key",
+			initPayload: transport.InitPayload{constants.APIKeyHeader(): "ftex_api-key"},
+			expectErr:   require.NoError,
+			expectedKey: "ftex_api-key",
+		}, {
+			name:        "headers take precedence",
+			headers:     map[string]string{testAuthHeaderKey: "header-token"},
+			initPayload: transport.InitPayload{testAuthHeaderKey: "payload-token"},
+			expectErr:   require.NoError,
+			expectedJWT: "header-token",
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ginCtx := &gin.Context{Request: &http.Request{Header: http.Header{}}}
+			for header, value := range test.headers {
+				ginCtx.Request.Header.Set(header, value)
+			}
+
+			ctx := context.TODO()
+			if !test.noGinContext {
+				ctx = context.WithValue(ctx, GinContextKey{}, ginCtx)
+			}
+
+			_, _, err := websocketInit(testAuthHeaderKey, zapLogger)(ctx, test.initPayload)
+			test.expectErr(t, err, "error expectation failed.")
+
+			require.Equal(t, test.expectedJWT, ginCtx.GetHeader(testAuthHeaderKey), "JWT header mismatch.")
+			require.Equal(t, test.expectedKey, ginCtx.GetHeader(constants.APIKeyHeader()), "API key header mismatch.")
+		})
+	}
+}
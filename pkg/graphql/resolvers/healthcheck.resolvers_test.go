@@ -74,7 +74,8 @@ func TestQueryResolver_Healthcheck(t *testing.T) {
 			mockAuth := mocks.NewMockAuth(mockCtrl) // Not called.
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
 			mockRedis := mocks.NewMockRedis(mockCtrl)
-			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				mockPostgres.EXPECT().Healthcheck().
@@ -88,7 +89,8 @@ func TestQueryResolver_Healthcheck(t *testing.T) {
 
 			// Endpoint setup for test.
 			router := gin.Default()
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBufferString(query))
 			req.Header.Set("Content-Type", "application/json")
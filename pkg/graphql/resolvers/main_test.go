@@ -1,11 +1,14 @@
 package graphql
 
 import (
+	"fmt"
 	"log"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -25,6 +28,27 @@ var testFiatQuery = getFiatQuery()
 // testCryptoQuery is the test Crypto-related mutations and queries.
 var testCryptoQuery = getCryptoQuery()
 
+// testOrdersQuery is the test limit order related mutations and queries.
+var testOrdersQuery = getOrdersQuery()
+
+// testSchedulesQuery is the test recurring purchase schedule related mutations and queries.
+var testSchedulesQuery = getSchedulesQuery()
+
+// testRatesQuery is the test rate history related queries.
+var testRatesQuery = getRatesQuery()
+
+// testMFAQuery is the test multifactor authentication related mutations.
+var testMFAQuery = getMFAQuery()
+
+// testAPIKeysQuery is the test API key related mutations and queries.
+var testAPIKeysQuery = getAPIKeysQuery()
+
+// testAdminQuery is the test administrator related mutations and queries.
+var testAdminQuery = getAdminQuery()
+
+// testWebhooksQuery is the test webhook related mutations and queries.
+var testWebhooksQuery = getWebhooksQuery()
+
 func TestMain(m *testing.M) {
 	var err error
 	// Configure logger.
@@ -51,6 +75,22 @@ func TestMain(m *testing.M) {
 	os.Exit(exitCode)
 }
 
+// revocationKey matches the Redis cache keys of the JWT revocation list.
+type revocationKey struct{}
+
+// Matches will check whether a key belongs to the JWT revocation list.
+func (revocationKey) Matches(x any) bool {
+	key, ok := x.(string)
+
+	return ok && (strings.HasPrefix(key, constants.RevokedSessionKeyPrefix()) ||
+		strings.HasPrefix(key, constants.RevokedClientKeyPrefix()))
+}
+
+// String describes the matcher.
+func (revocationKey) String() string {
+	return fmt.Sprintf("has prefix %q or %q", constants.RevokedSessionKeyPrefix(), constants.RevokedClientKeyPrefix())
+}
+
 // setup will configure the auth test object.
 func setup() error {
 	return nil
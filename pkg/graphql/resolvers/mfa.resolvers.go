@@ -0,0 +1,121 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.63
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/models"
+)
+
+// LoginUserMfa is the resolver for the loginUserMFA field.
+func (r *mutationResolver) LoginUserMfa(ctx context.Context, input models.HTTPMFALoginRequest) (*models.JWTAuthResponse, error) {
+	var (
+		err        error
+		authToken  *models.JWTAuthResponse
+		ginContext *gin.Context
+		httpMsg    string
+		payload    any
+	)
+
+	if ginContext, err = GinContextFromContext(ctx, r.logger); err != nil {
+		return nil, errors.New("malformed request")
+	}
+
+	if authToken, httpMsg, _, payload, err = common.HTTPLoginMFA(r.auth, r.cache, r.db, r.logger, &input,
+		ginContext.ClientIP(), ginContext.Request.UserAgent()); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMsg, payload)
+	}
+
+	return authToken, nil
+}
+
+// EnrollMfa is the resolver for the enrollMFA field.
+func (r *mutationResolver) EnrollMfa(ctx context.Context) (*models.HTTPMFAEnrollResponse, error) {
+	var (
+		clientID   uuid.UUID
+		enrollment *models.HTTPMFAEnrollResponse
+		err        error
+		httpMsg    string
+		payload    any
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeNone); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if enrollment, httpMsg, _, payload, err = common.HTTPMFAEnroll(r.auth, r.db, r.logger, clientID); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMsg, payload)
+	}
+
+	return enrollment, nil
+}
+
+// VerifyMfa is the resolver for the verifyMFA field.
+func (r *mutationResolver) VerifyMfa(ctx context.Context, input models.HTTPMFACodeRequest) (string, error) {
+	var (
+		clientID uuid.UUID
+		err      error
+		httpMsg  string
+		payload  any
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeNone); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if httpMsg, _, payload, err = common.HTTPMFAVerify(r.auth, r.db, r.logger, clientID, &input); err != nil {
+		return "", fmt.Errorf("%s: %v", httpMsg, payload)
+	}
+
+	return "multifactor authentication enabled", nil
+}
+
+// DisableMfa is the resolver for the disableMFA field.
+func (r *mutationResolver) DisableMfa(ctx context.Context, input models.HTTPMFACodeRequest) (string, error) {
+	var (
+		clientID uuid.UUID
+		err      error
+		httpMsg  string
+		payload  any
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeNone); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if httpMsg, _, payload, err = common.HTTPMFADisable(r.auth, r.db, r.logger, clientID, &input); err != nil {
+		return "", fmt.Errorf("%s: %v", httpMsg, payload)
+	}
+
+	return "multifactor authentication disabled", nil
+}
+
+// RegenerateRecoveryCodes is the resolver for the regenerateRecoveryCodes field.
+func (r *mutationResolver) RegenerateRecoveryCodes(ctx context.Context, input models.HTTPMFACodeRequest) (*models.HTTPMFARecoveryCodesResponse, error) {
+	var (
+		clientID uuid.UUID
+		codes    *models.HTTPMFARecoveryCodesResponse
+		err      error
+		httpMsg  string
+		payload  any
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeNone); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if codes, httpMsg, _, payload, err = common.HTTPMFARecoveryCodes(r.auth, r.db, r.logger, clientID, &input); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMsg, payload)
+	}
+
+	return codes, nil
+}
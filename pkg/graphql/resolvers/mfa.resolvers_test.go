@@ -0,0 +1,656 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/quotes"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestMFAResolver_LoginUserMfa(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		path             string
+		query            string
+		expectErr        bool
+		authDecryptErr   error
+		authDecryptTimes int
+		redisGetErr      error
+		redisGetTimes    int
+		redisDelTimes    int
+		mfaGetTimes      int
+		recoveryCodeErr  error
+		recoveryTimes    int
+		authGenJWTTimes  int
+	}{
+		{
+			name:             "empty request",
+			path:             "/login-mfa/empty-request",
+			query:            fmt.Sprintf(testMFAQuery["loginUserMFA"], "", ""),
+			expectErr:        true,
+			authDecryptErr:   nil,
+			authDecryptTimes: 0,
+			redisGetErr:      nil,
+			redisGetTimes:    0,
+			redisDelTimes:    0,
+			mfaGetTimes:      0,
+			recoveryCodeErr:  nil,
+			recoveryTimes:    0,
+			authGenJWTTimes:  0,
+		}, {
+			name:             "invalid challenge",
+			path:             "/login-mfa/invalid-challenge",
+			query:            fmt.Sprintf(testMFAQuery["loginUserMFA"], "challenge", "ABCD-EFGH-IJKL-MNOP"),
+			expectErr:        true,
+			authDecryptErr:   errors.New("decryption failure"),
+			authDecryptTimes: 1,
+			redisGetErr:      nil,
+			redisGetTimes:    0,
+			redisDelTimes:    0,
+			mfaGetTimes:      0,
+			recoveryCodeErr:  nil,
+			recoveryTimes:    0,
+			authGenJWTTimes:  0,
+		}, {
+			name:             "expired challenge",
+			path:             "/login-mfa/expired-challenge",
+			query:            fmt.Sprintf(testMFAQuery["loginUserMFA"], "challenge", "ABCD-EFGH-IJKL-MNOP"),
+			expectErr:        true,
+			authDecryptErr:   nil,
+			authDecryptTimes: 1,
+			redisGetErr:      redis.ErrCacheMiss,
+			redisGetTimes:    1,
+			redisDelTimes:    0,
+			mfaGetTimes:      0,
+			recoveryCodeErr:  nil,
+			recoveryTimes:    0,
+			authGenJWTTimes:  0,
+		}, {
+			name:             "used recovery code",
+			path:             "/login-mfa/used-recovery-code",
+			query:            fmt.Sprintf(testMFAQuery["loginUserMFA"], "challenge", "ABCD-EFGH-IJKL-MNOP"),
+			expectErr:        true,
+			authDecryptErr:   nil,
+			authDecryptTimes: 1,
+			redisGetErr:      nil,
+			redisGetTimes:    1,
+			redisDelTimes:    1,
+			mfaGetTimes:      1,
+			recoveryCodeErr:  postgres.ErrUsedMFA,
+			recoveryTimes:    1,
+			authGenJWTTimes:  0,
+		}, {
+			name:             "valid",
+			path:             "/login-mfa/valid",
+			query:            fmt.Sprintf(testMFAQuery["loginUserMFA"], "challenge", "ABCD-EFGH-IJKL-MNOP"),
+			expectErr:        false,
+			authDecryptErr:   nil,
+			authDecryptTimes: 1,
+			redisGetErr:      nil,
+			redisGetTimes:    1,
+			redisDelTimes:    1,
+			mfaGetTimes:      1,
+			recoveryCodeErr:  nil,
+			recoveryTimes:    1,
+			authGenJWTTimes:  1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			// Login lockout bookkeeping is exercised in the common package.
+			mockRedis.EXPECT().Get(constants.LoginLockoutKeyPrefix(), gomock.Any()).Return(redis.ErrCacheMiss).AnyTimes()
+			mockRedis.EXPECT().Incr(constants.LoginFailuresKeyPrefix(), gomock.Any()).Return(int64(1), nil).AnyTimes()
+			mockRedis.EXPECT().Del(constants.LoginFailuresKeyPrefix()).Return(nil).AnyTimes()
+			mockAuth.EXPECT().LoginFailureWindow().Return(24 * time.Hour).AnyTimes()
+			mockAuth.EXPECT().LoginLockout(gomock.Any()).Return(time.Duration(0)).AnyTimes()
+			mockPostgres.EXPECT().LoginEventCreate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("challenge-id"), test.authDecryptErr).
+					Times(test.authDecryptTimes),
+
+				mockRedis.EXPECT().Get(constants.MFAChallengeKeyPrefix()+"challenge-id", gomock.Any()).
+					Return(test.redisGetErr).
+					Times(test.redisGetTimes),
+
+				mockRedis.EXPECT().Del(constants.MFAChallengeKeyPrefix()+"challenge-id").
+					Return(nil).
+					Times(test.redisDelTimes),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.MfaEnrollment{IsEnabled: true}, nil).
+					Times(test.mfaGetTimes),
+
+				mockAuth.EXPECT().HashRecoveryCode(gomock.Any()).
+					Return("hashed code").
+					Times(test.recoveryTimes),
+
+				mockPostgres.EXPECT().MFAUseRecoveryCode(gomock.Any(), "hashed code").
+					Return(test.recoveryCodeErr).
+					Times(test.recoveryTimes),
+
+				mockAuth.EXPECT().GenerateJWT(gomock.Any()).
+					Return(&models.JWTAuthResponse{}, nil).
+					Times(test.authGenJWTTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestMFAResolver_EnrollMfa(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		userGetInfoTimes     int
+		mfaEnrollErr         error
+		mfaEnrollTimes       int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/enroll-mfa/invalid-jwt",
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       0,
+			userGetInfoTimes:     0,
+			mfaEnrollErr:         nil,
+			mfaEnrollTimes:       0,
+		}, {
+			name:                 "already enabled",
+			path:                 "/enroll-mfa/already-enabled",
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			userGetInfoTimes:     1,
+			mfaEnrollErr:         postgres.ErrEnabledMFA,
+			mfaEnrollTimes:       1,
+		}, {
+			name:                 "valid",
+			path:                 "/enroll-mfa/valid",
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			userGetInfoTimes:     1,
+			mfaEnrollErr:         nil,
+			mfaEnrollTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			authToken := xid.New().String()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(authToken).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{}}, nil).
+					Times(test.userGetInfoTimes),
+
+				mockAuth.EXPECT().GenerateTOTP(gomock.Any()).
+					Return("SECRET", "otpauth://totp/issuer:username", nil).
+					Times(test.userGetInfoTimes),
+
+				mockAuth.EXPECT().GenerateRecoveryCodes().
+					Return([]string{"code"}, []string{"hashed code"}, nil).
+					Times(test.userGetInfoTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted secret", nil).
+					Times(test.userGetInfoTimes),
+
+				mockPostgres.EXPECT().MFAEnroll(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.mfaEnrollErr).
+					Times(test.mfaEnrollTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(testMFAQuery["enrollMFA"]))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", authToken)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match.")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body.")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set.")
+				enrollment, ok := data.(map[string]any)["enrollMFA"].(map[string]any)
+				require.True(t, ok, "failed to extract enrollment.")
+				require.Equal(t, "SECRET", enrollment["secret"], "secret mismatch.")
+			}
+		})
+	}
+}
+
+func TestMFAResolver_VerifyDisableMfa(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		mfaGetEnrollment     postgres.MfaEnrollment
+		mfaGetErr            error
+		mfaGetTimes          int
+		mfaEnableTimes       int
+		mfaDeleteTimes       int
+	}{
+		{
+			name:                 "verify invalid jwt",
+			path:                 "/verify-mfa/invalid-jwt",
+			query:                fmt.Sprintf(testMFAQuery["verifyMFA"], "123456"),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       0,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            nil,
+			mfaGetTimes:          0,
+			mfaEnableTimes:       0,
+			mfaDeleteTimes:       0,
+		}, {
+			name:                 "verify not enrolled",
+			path:                 "/verify-mfa/not-enrolled",
+			query:                fmt.Sprintf(testMFAQuery["verifyMFA"], "123456"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:          1,
+			mfaEnableTimes:       0,
+			mfaDeleteTimes:       0,
+		}, {
+			name:                 "verify valid",
+			path:                 "/verify-mfa/valid",
+			query:                fmt.Sprintf(testMFAQuery["verifyMFA"], "123456"),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            nil,
+			mfaGetTimes:          1,
+			mfaEnableTimes:       1,
+			mfaDeleteTimes:       0,
+		}, {
+			name:                 "disable not enrolled",
+			path:                 "/disable-mfa/not-enrolled",
+			query:                fmt.Sprintf(testMFAQuery["disableMFA"], "123456"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:          1,
+			mfaEnableTimes:       0,
+			mfaDeleteTimes:       0,
+		}, {
+			name:                 "disable pending",
+			path:                 "/disable-mfa/pending",
+			query:                fmt.Sprintf(testMFAQuery["disableMFA"], "123456"),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			mfaGetEnrollment:     postgres.MfaEnrollment{},
+			mfaGetErr:            nil,
+			mfaGetTimes:          1,
+			mfaEnableTimes:       0,
+			mfaDeleteTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			authToken := xid.New().String()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(authToken).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(test.mfaGetEnrollment, test.mfaGetErr).
+					Times(test.mfaGetTimes),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("SECRET"), nil).
+					Times(test.mfaEnableTimes),
+
+				mockAuth.EXPECT().ValidateTOTP("SECRET", "123456").
+					Return(int64(10), nil).
+					Times(test.mfaEnableTimes),
+
+				mockPostgres.EXPECT().MFAEnable(gomock.Any(), int64(10)).
+					Return(nil).
+					Times(test.mfaEnableTimes),
+
+				mockPostgres.EXPECT().MFADelete(gomock.Any()).
+					Return(nil).
+					Times(test.mfaDeleteTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", authToken)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match.")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body.")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestMFAResolver_RegenerateRecoveryCodes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		code                 string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		mfaGetTimes          int
+		verifyTimes          int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/recovery-codes/invalid-jwt",
+			code:                 "123456",
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       0,
+			mfaGetTimes:          0,
+			verifyTimes:          0,
+		}, {
+			name:                 "recovery code rejected",
+			path:                 "/recovery-codes/recovery-code",
+			code:                 "ABCD-EFGH-IJKL-MNOP",
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			mfaGetTimes:          1,
+			verifyTimes:          0,
+		}, {
+			name:                 "valid",
+			path:                 "/recovery-codes/valid",
+			code:                 "123456",
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			mfaGetTimes:          1,
+			verifyTimes:          1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			authToken := xid.New().String()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(authToken).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.MfaEnrollment{IsEnabled: true}, nil).
+					Times(test.mfaGetTimes),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("SECRET"), nil).
+					Times(test.verifyTimes),
+
+				mockAuth.EXPECT().ValidateTOTP("SECRET", test.code).
+					Return(int64(10), nil).
+					Times(test.verifyTimes),
+
+				mockPostgres.EXPECT().MFAUseStep(gomock.Any(), int64(10)).
+					Return(nil).
+					Times(test.verifyTimes),
+
+				mockAuth.EXPECT().GenerateRecoveryCodes().
+					Return([]string{"code"}, []string{"hashed code"}, nil).
+					Times(test.verifyTimes),
+
+				mockPostgres.EXPECT().MFASetRecoveryCodes(gomock.Any(), []string{"hashed code"}).
+					Return(nil).
+					Times(test.verifyTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(fmt.Sprintf(testMFAQuery["regenerateRecoveryCodes"], test.code)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", authToken)
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match.")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body.")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"]
+				require.True(t, ok, "data key expected but not set.")
+				codes, ok := data.(map[string]any)["regenerateRecoveryCodes"].(map[string]any)
+				require.True(t, ok, "failed to extract recovery codes.")
+				require.Equal(t, []any{"code"}, codes["recoveryCodes"], "recovery codes mismatch.")
+			}
+		})
+	}
+}
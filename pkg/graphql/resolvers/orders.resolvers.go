@@ -0,0 +1,183 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.63
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	graphql_generated "github.com/surahman/FTeX/pkg/graphql/generated"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// PlaceOrder is the resolver for the placeOrder field.
+func (r *mutationResolver) PlaceOrder(ctx context.Context, input models.HTTPOrderRequest, idempotencyKey *string) (*postgres.Order, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		order       *postgres.Order
+		payload     any
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeTrade); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if idempotencyKey == nil {
+		idempotencyKey = new(string)
+	}
+
+	if order, _, httpMessage, payload, err = common.HTTPIdempotentRequest(r.cache, r.logger, clientID, *idempotencyKey,
+		&input, func() (*postgres.Order, int, string, any, error) {
+			return common.HTTPOrderPlace(r.db, r.logger, clientID, &input)
+		}); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+	}
+
+	return order, nil
+}
+
+// CancelOrder is the resolver for the cancelOrder field.
+func (r *mutationResolver) CancelOrder(ctx context.Context, orderID string) (*postgres.Order, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		order       *postgres.Order
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeTrade); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if order, _, httpMessage, err = common.HTTPOrderCancel(r.db, r.logger, clientID, orderID); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return order, nil
+}
+
+// OrderID is the resolver for the orderID field.
+func (r *orderResolver) OrderID(ctx context.Context, obj *postgres.Order) (string, error) {
+	return obj.OrderID.String(), nil
+}
+
+// ClientID is the resolver for the clientID field.
+func (r *orderResolver) ClientID(ctx context.Context, obj *postgres.Order) (string, error) {
+	return obj.ClientID.String(), nil
+}
+
+// Amount is the resolver for the amount field.
+func (r *orderResolver) Amount(ctx context.Context, obj *postgres.Order) (float64, error) {
+	return obj.Amount.InexactFloat64(), nil
+}
+
+// LimitRate is the resolver for the limitRate field.
+func (r *orderResolver) LimitRate(ctx context.Context, obj *postgres.Order) (float64, error) {
+	return obj.LimitRate.InexactFloat64(), nil
+}
+
+// Status is the resolver for the status field.
+func (r *orderResolver) Status(ctx context.Context, obj *postgres.Order) (string, error) {
+	return string(obj.Status), nil
+}
+
+// FillRate is the resolver for the fillRate field.
+func (r *orderResolver) FillRate(ctx context.Context, obj *postgres.Order) (float64, error) {
+	return obj.FillRate.InexactFloat64(), nil
+}
+
+// FillAmount is the resolver for the fillAmount field.
+func (r *orderResolver) FillAmount(ctx context.Context, obj *postgres.Order) (float64, error) {
+	return obj.FillAmount.InexactFloat64(), nil
+}
+
+// Fee is the resolver for the fee field.
+func (r *orderResolver) Fee(ctx context.Context, obj *postgres.Order) (float64, error) {
+	return obj.Fee.InexactFloat64(), nil
+}
+
+// TxID is the resolver for the txID field.
+func (r *orderResolver) TxID(ctx context.Context, obj *postgres.Order) (*string, error) {
+	if !obj.TxID.Valid {
+		return nil, nil
+	}
+
+	txID := obj.TxID.UUID.String()
+
+	return &txID, nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *orderResolver) CreatedAt(ctx context.Context, obj *postgres.Order) (string, error) {
+	return obj.CreatedAt.Time.String(), nil
+}
+
+// UpdatedAt is the resolver for the updatedAt field.
+func (r *orderResolver) UpdatedAt(ctx context.Context, obj *postgres.Order) (string, error) {
+	return obj.UpdatedAt.Time.String(), nil
+}
+
+// Orders is the resolver for the orders field.
+func (r *queryResolver) Orders(ctx context.Context, pageCursor *string, pageSize *int32) (*models.HTTPOrdersPaginated, error) {
+	var (
+		clientID     uuid.UUID
+		err          error
+		httpMessage  string
+		orderDetails models.HTTPOrdersPaginated
+	)
+
+	if pageSize == nil {
+		pageSize = new(int32)
+	}
+
+	if pageCursor == nil {
+		pageCursor = new(string)
+	}
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeReadBalances); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if orderDetails, _, httpMessage, err = common.HTTPOrdersPaginated(r.auth, r.db, r.logger,
+		clientID, *pageCursor, strconv.Itoa(int(*pageSize)), false); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return &orderDetails, nil
+}
+
+// SourceAmount is the resolver for the sourceAmount field.
+func (r *orderRequestResolver) SourceAmount(ctx context.Context, obj *models.HTTPOrderRequest, data float64) error {
+	obj.SourceAmount = decimal.NewFromFloat(data)
+
+	return nil
+}
+
+// LimitRate is the resolver for the limitRate field.
+func (r *orderRequestResolver) LimitRate(ctx context.Context, obj *models.HTTPOrderRequest, data float64) error {
+	obj.LimitRate = decimal.NewFromFloat(data)
+
+	return nil
+}
+
+// Order returns graphql_generated.OrderResolver implementation.
+func (r *Resolver) Order() graphql_generated.OrderResolver { return &orderResolver{r} }
+
+// OrderRequest returns graphql_generated.OrderRequestResolver implementation.
+func (r *Resolver) OrderRequest() graphql_generated.OrderRequestResolver {
+	return &orderRequestResolver{r}
+}
+
+type orderResolver struct{ *Resolver }
+type orderRequestResolver struct{ *Resolver }
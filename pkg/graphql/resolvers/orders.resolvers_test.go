@@ -0,0 +1,582 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/quotes"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestOrdersResolver_OrderRequestResolver(t *testing.T) {
+	t.Parallel()
+
+	var (
+		resolver     orderRequestResolver
+		input        models.HTTPOrderRequest
+		sourceFloat  = 7890.1011
+		sourceAmount = decimal.NewFromFloat(sourceFloat)
+		limitFloat   = 1.2345
+		limitRate    = decimal.NewFromFloat(limitFloat)
+	)
+
+	t.Run("SourceAmount", func(t *testing.T) {
+		t.Parallel()
+
+		err := resolver.SourceAmount(context.TODO(), &input, sourceFloat)
+		require.NoError(t, err, "source amount should always return a nil error.")
+		require.Equal(t, sourceAmount, input.SourceAmount, "source amounts mismatched.")
+	})
+
+	t.Run("LimitRate", func(t *testing.T) {
+		t.Parallel()
+
+		err := resolver.LimitRate(context.TODO(), &input, limitFloat)
+		require.NoError(t, err, "limit rate should always return a nil error.")
+		require.Equal(t, limitRate, input.LimitRate, "limit rates mismatched.")
+	})
+}
+
+func TestOrdersResolver_OrderResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := orderResolver{}
+
+	orderID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate order id.")
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	txID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate transaction id.")
+
+	timestamp := pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	order := &postgres.Order{
+		OrderID:     orderID,
+		ClientID:    clientID,
+		Source:      "USD",
+		Destination: "CAD",
+		Amount:      decimal.NewFromFloat(1234.56),
+		LimitRate:   decimal.NewFromFloat(1.3),
+		Status:      postgres.OrderStatusFilled,
+		FillRate:    decimal.NewFromFloat(1.31),
+		FillAmount:  decimal.NewFromFloat(1615.9),
+		Fee:         decimal.NewFromFloat(1.01),
+		TxID:        uuid.NullUUID{UUID: txID, Valid: true},
+		CreatedAt:   timestamp,
+		UpdatedAt:   timestamp,
+	}
+
+	t.Run("OrderID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.OrderID(context.TODO(), order)
+		require.NoError(t, err, "order id should always return a nil error.")
+		require.Equal(t, orderID.String(), result, "order id mismatched.")
+	})
+
+	t.Run("ClientID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.ClientID(context.TODO(), order)
+		require.NoError(t, err, "client id should always return a nil error.")
+		require.Equal(t, clientID.String(), result, "client id mismatched.")
+	})
+
+	t.Run("Amount", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Amount(context.TODO(), order)
+		require.NoError(t, err, "amount should always return a nil error.")
+		require.InDelta(t, order.Amount.InexactFloat64(), result, 0.01, "amount mismatched.")
+	})
+
+	t.Run("LimitRate", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.LimitRate(context.TODO(), order)
+		require.NoError(t, err, "limit rate should always return a nil error.")
+		require.InDelta(t, order.LimitRate.InexactFloat64(), result, 0.01, "limit rate mismatched.")
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Status(context.TODO(), order)
+		require.NoError(t, err, "status should always return a nil error.")
+		require.Equal(t, string(postgres.OrderStatusFilled), result, "status mismatched.")
+	})
+
+	t.Run("FillRate", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.FillRate(context.TODO(), order)
+		require.NoError(t, err, "fill rate should always return a nil error.")
+		require.InDelta(t, order.FillRate.InexactFloat64(), result, 0.01, "fill rate mismatched.")
+	})
+
+	t.Run("FillAmount", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.FillAmount(context.TODO(), order)
+		require.NoError(t, err, "fill amount should always return a nil error.")
+		require.InDelta(t, order.FillAmount.InexactFloat64(), result, 0.01, "fill amount mismatched.")
+	})
+
+	t.Run("Fee", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Fee(context.TODO(), order)
+		require.NoError(t, err, "fee should always return a nil error.")
+		require.InDelta(t, order.Fee.InexactFloat64(), result, 0.01, "fee mismatched.")
+	})
+
+	t.Run("TxID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.TxID(context.TODO(), order)
+		require.NoError(t, err, "transaction id should always return a nil error.")
+		require.NotNil(t, result, "transaction id should be set on a filled order.")
+		require.Equal(t, txID.String(), *result, "transaction id mismatched.")
+
+		result, err = resolver.TxID(context.TODO(), &postgres.Order{})
+		require.NoError(t, err, "transaction id should always return a nil error.")
+		require.Nil(t, result, "transaction id should not be set on an unfilled order.")
+	})
+
+	t.Run("CreatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.CreatedAt(context.TODO(), order)
+		require.NoError(t, err, "created at should always return a nil error.")
+		require.Equal(t, timestamp.Time.String(), result, "created at mismatched.")
+	})
+
+	t.Run("UpdatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.UpdatedAt(context.TODO(), order)
+		require.NoError(t, err, "updated at should always return a nil error.")
+		require.Equal(t, timestamp.Time.String(), result, "updated at mismatched.")
+	})
+}
+
+func TestOrdersResolver_PlaceOrder(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		orderCreateErr       error
+		orderCreateTimes     int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/place-order/invalid-jwt",
+			query:                fmt.Sprintf(testOrdersQuery["placeOrder"], "USD", "BTC", 1337.89, 0.00003),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+		}, {
+			name:                 "crypto to crypto",
+			path:                 "/place-order/crypto-to-crypto",
+			query:                fmt.Sprintf(testOrdersQuery["placeOrder"], "ETH", "BTC", 1.5, 0.06),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+		}, {
+			name:                 "negative limit rate",
+			path:                 "/place-order/negative-limit-rate",
+			query:                fmt.Sprintf(testOrdersQuery["placeOrder"], "USD", "CAD", 100.0, -1.3),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+		}, {
+			name:                 "db failure",
+			path:                 "/place-order/db-failure",
+			query:                fmt.Sprintf(testOrdersQuery["placeOrder"], "USD", "BTC", 1337.89, 0.00003),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			orderCreateErr:       postgres.ErrCreateOrder,
+			orderCreateTimes:     1,
+		}, {
+			name:                 "valid",
+			path:                 "/place-order/valid",
+			query:                fmt.Sprintf(testOrdersQuery["placeOrder"], "USD", "BTC", 1337.89, 0.00003),
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			orderCreateTimes:     1,
+		},
+	}
+
+	for _, testCase := range testCases { //nolint:dupl
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().OrderCreate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(postgres.Order{}, test.orderCreateErr).
+					Times(test.orderCreateTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestOrdersResolver_CancelOrder(t *testing.T) {
+	t.Parallel()
+
+	orderID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate order id.")
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		orderCancelErr       error
+		orderCancelTimes     int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/cancel-order/invalid-jwt",
+			query:                fmt.Sprintf(testOrdersQuery["cancelOrder"], orderID.String()),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+		}, {
+			name:                 "invalid order id",
+			path:                 "/cancel-order/invalid-order-id",
+			query:                fmt.Sprintf(testOrdersQuery["cancelOrder"], "invalid-order-id"),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+		}, {
+			name:                 "not found",
+			path:                 "/cancel-order/not-found",
+			query:                fmt.Sprintf(testOrdersQuery["cancelOrder"], orderID.String()),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			orderCancelErr:       postgres.ErrNotFound,
+			orderCancelTimes:     1,
+		}, {
+			name:                 "valid",
+			path:                 "/cancel-order/valid",
+			query:                fmt.Sprintf(testOrdersQuery["cancelOrder"], orderID.String()),
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			orderCancelTimes:     1,
+		},
+	}
+
+	for _, testCase := range testCases { //nolint:dupl
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().OrderCancel(gomock.Any(), gomock.Any()).
+					Return(postgres.Order{}, test.orderCancelErr).
+					Times(test.orderCancelTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestOrdersResolver_Orders(t *testing.T) {
+	t.Parallel()
+
+	orders := []postgres.Order{{}, {}, {}, {}}
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		authDecryptErr       error
+		authDecryptTimes     int
+		ordersErr            error
+		ordersTimes          int
+		authEncryptTimes     int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/orders/invalid-jwt",
+			query:                fmt.Sprintf(testOrdersQuery["orders"], "page-cursor", 3),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+		}, {
+			name:                 "decrypt cursor failure",
+			path:                 "/orders/decrypt-cursor-failure",
+			query:                fmt.Sprintf(testOrdersQuery["orders"], "page-cursor", 3),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			authDecryptErr:       errors.New("decrypt failure"),
+			authDecryptTimes:     1,
+		}, {
+			name:                 "orders failure",
+			path:                 "/orders/orders-failure",
+			query:                fmt.Sprintf(testOrdersQuery["orders"], "page-cursor", 3),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			authDecryptTimes:     1,
+			ordersErr:            postgres.ErrNotFound,
+			ordersTimes:          1,
+		}, {
+			name:                 "valid",
+			path:                 "/orders/valid",
+			query:                fmt.Sprintf(testOrdersQuery["orders"], "page-cursor", 3),
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			authDecryptTimes:     1,
+			ordersTimes:          1,
+			authEncryptTimes:     1,
+		}, {
+			name:                 "valid no params",
+			path:                 "/orders/valid-no-params",
+			query:                testOrdersQuery["ordersNoParams"],
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			ordersTimes:          1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("3"), test.authDecryptErr).
+					Times(test.authDecryptTimes),
+
+				mockPostgres.EXPECT().OrdersPaginated(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(orders, test.ordersErr).
+					Times(test.ordersTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-page-cursor", nil).
+					Times(test.authEncryptTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
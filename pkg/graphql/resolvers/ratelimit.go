@@ -0,0 +1,133 @@
+package graphql
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/gin-gonic/gin"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/redis"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// RateLimits are the token bucket rate limits for the operations in GraphQL requests. Price quote mutations are
+// throttled separately from the other mutations.
+type RateLimits struct {
+	Queries   *models.RateLimit
+	Mutations *models.RateLimit
+	Quotes    *models.RateLimit
+}
+
+// quoteOperations are the mutations that retrieve price quotes from the quote providers.
+var quoteOperations = map[string]bool{
+	"exchangeOfferFiat": true,
+	"offerCrypto":       true,
+	"offerSwapCrypto":   true,
+}
+
+// rateLimiter is the GraphQL handler extension that throttles requests by the client's IP address and, if the request
+// contains a valid JWT or an API key, the client. Every root field in a request is counted as a separate operation.
+type rateLimiter struct {
+	authHeaderKey string
+	auth          auth.Auth
+	cache         redis.Redis
+	limits        *RateLimits
+	logger        *logger.Logger
+}
+
+// Check to ensure the GraphQL handler extension interfaces have been implemented.
+var (
+	_ graphql.HandlerExtension     = &rateLimiter{}
+	_ graphql.OperationInterceptor = &rateLimiter{}
+)
+
+// ExtensionName is the name of the rate limiting GraphQL handler extension.
+func (r *rateLimiter) ExtensionName() string {
+	return "RateLimiter"
+}
+
+// Validate will check the rate limiting GraphQL handler extension against the schema.
+func (r *rateLimiter) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// identities will extract the identities a request is throttled by. Tokens are not checked for revocation here, as
+// that is left to the resolvers' authorization checks.
+func (r *rateLimiter) identities(ginContext *gin.Context) []string {
+	identities := []string{"ip:" + ginContext.ClientIP()}
+
+	if tokenString := ginContext.GetHeader(r.authHeaderKey); tokenString != "" {
+		if clientID, _, err := r.auth.ValidateJWT(tokenString); err == nil {
+			identities = append(identities, "client:"+clientID.String())
+		}
+	} else if apiKey := ginContext.GetHeader(constants.APIKeyHeader()); apiKey != "" {
+		identities = append(identities, "api-key:"+r.auth.HashAPIKey(apiKey))
+	}
+
+	return identities
+}
+
+// InterceptOperation will take a token for every root field in an operation from the rate limiting token buckets of
+// the queries, mutations, or price quotes group. Operations over the limit are rejected with the number of seconds to
+// wait in the Retry-After header.
+func (r *rateLimiter) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	var (
+		queries, mutations, quotes int64
+		opCtx                      = graphql.GetOperationContext(ctx)
+	)
+
+	ginContext, err := GinContextFromContext(ctx, r.logger)
+	if err != nil {
+		return graphql.OneShot(graphql.ErrorResponse(ctx, err.Error()))
+	}
+
+	for _, field := range graphql.CollectFields(opCtx, opCtx.Operation.SelectionSet, nil) {
+		switch {
+		case quoteOperations[field.Name]:
+			quotes++
+		case opCtx.Operation.Operation == ast.Mutation:
+			mutations++
+		default:
+			queries++
+		}
+	}
+
+	identities := r.identities(ginContext)
+	groups := []struct {
+		name   string
+		limit  *models.RateLimit
+		tokens int64
+	}{
+		{name: "graphql-queries", limit: r.limits.Queries, tokens: queries},
+		{name: "graphql-mutations", limit: r.limits.Mutations, tokens: mutations},
+		{name: "graphql-quotes", limit: r.limits.Quotes, tokens: quotes},
+	}
+
+	for _, group := range groups {
+		if group.tokens == 0 {
+			continue
+		}
+
+		retryAfter, httpMsg, httpStatus, err := common.HTTPRateLimit(
+			r.cache, r.logger, group.limit, group.name, group.tokens, identities...)
+		if err != nil {
+			// Subscriptions run over websocket connections whose HTTP response has already been written.
+			if !ginContext.Writer.Written() {
+				if retryAfter > 0 {
+					ginContext.Header(constants.RetryAfterHeader(), strconv.FormatInt(retryAfter, 10))
+				}
+
+				ginContext.Status(httpStatus)
+			}
+
+			return graphql.OneShot(graphql.ErrorResponse(ctx, "%s", httpMsg))
+		}
+	}
+
+	return next(ctx)
+}
@@ -0,0 +1,178 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/quotes"
+)
+
+func TestRateLimiter_InterceptOperation(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id")
+
+	limits := &RateLimits{
+		Queries:   &models.RateLimit{Requests: 60, Period: time.Minute, Burst: 10},
+		Mutations: &models.RateLimit{Requests: 30, Period: time.Minute, Burst: 5},
+		Quotes:    &models.RateLimit{Requests: 6, Period: time.Minute, Burst: 2},
+	}
+	ipIdentity := "ip:192.0.2.1"
+
+	testCases := []struct {
+		name               string
+		query              string
+		token              string
+		apiKey             string
+		group              string
+		interval           time.Duration
+		tokens             int64
+		identities         []string
+		allowed            bool
+		wait               time.Duration
+		expectedStatus     int
+		expectedRetryAfter string
+		healthcheckTimes   int
+	}{
+		{
+			name:               "query limited",
+			query:              getHealthcheckQuery(),
+			group:              "graphql-queries",
+			interval:           time.Second,
+			tokens:             1,
+			identities:         []string{ipIdentity},
+			allowed:            false,
+			wait:               1200 * time.Millisecond,
+			expectedStatus:     http.StatusTooManyRequests,
+			expectedRetryAfter: "2",
+			healthcheckTimes:   0,
+		}, {
+			name:               "queries allowed",
+			query:              getRateLimitQuery(),
+			token:              "valid-token",
+			group:              "graphql-queries",
+			interval:           time.Second,
+			tokens:             2,
+			identities:         []string{ipIdentity, "client:" + clientID.String()},
+			allowed:            true,
+			expectedStatus:     http.StatusOK,
+			expectedRetryAfter: "",
+			healthcheckTimes:   2,
+		}, {
+			name:               "mutation limited",
+			query:              fmt.Sprintf(getUsersQuery()["login"], "username", "password"),
+			token:              "valid-token",
+			group:              "graphql-mutations",
+			interval:           2 * time.Second,
+			tokens:             1,
+			identities:         []string{ipIdentity, "client:" + clientID.String()},
+			allowed:            false,
+			wait:               time.Second,
+			expectedStatus:     http.StatusTooManyRequests,
+			expectedRetryAfter: "1",
+			healthcheckTimes:   0,
+		}, {
+			name:               "quote limited",
+			query:              fmt.Sprintf(getFiatQuery()["exchangeOfferFiat"], "USD", "CAD", 101.11),
+			apiKey:             "api-key",
+			group:              "graphql-quotes",
+			interval:           10 * time.Second,
+			tokens:             1,
+			identities:         []string{ipIdentity, "api-key:hashed-api-key"},
+			allowed:            false,
+			wait:               9 * time.Second,
+			expectedStatus:     http.StatusTooManyRequests,
+			expectedRetryAfter: "9",
+			healthcheckTimes:   0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			mockAuth.EXPECT().ValidateJWT(test.token).
+				Return(clientID, int64(0), nil).
+				MaxTimes(1)
+
+			mockAuth.EXPECT().HashAPIKey(test.apiKey).
+				Return("hashed-api-key").
+				MaxTimes(1)
+
+			// The first bucket to reject the request stops the remaining buckets from being checked.
+			calls := make([]*gomock.Call, 0, len(test.identities))
+			for _, identity := range test.identities {
+				calls = append(calls, mockRedis.EXPECT().
+					TakeTokens(constants.RateLimitKeyPrefix()+test.group+"-"+identity, gomock.Any(), test.tokens,
+						test.interval).
+					Return(test.allowed, test.wait, nil).
+					MaxTimes(1))
+			}
+
+			gomock.InOrder(calls...)
+
+			mockPostgres.EXPECT().Healthcheck().Return(nil).Times(test.healthcheckTimes)
+			mockRedis.EXPECT().Healthcheck().Return(nil).Times(test.healthcheckTimes)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST("/rate-limit", GinContextToContextMiddleware(),
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, limits,
+					zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, "/rate-limit",
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.RemoteAddr = "192.0.2.1:43210"
+
+			if test.token != "" {
+				req.Header.Set(testAuthHeaderKey, test.token)
+			}
+
+			if test.apiKey != "" {
+				req.Header.Set(constants.APIKeyHeader(), test.apiKey)
+			}
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match.")
+			require.Equal(t, test.expectedRetryAfter, recorder.Header().Get(constants.RetryAfterHeader()),
+				"expected retry after header does not match.")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body.")
+
+			if !test.allowed {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.63
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	graphql_generated "github.com/surahman/FTeX/pkg/graphql/generated"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// RateHistory is the resolver for the rateHistory field.
+func (r *queryResolver) RateHistory(ctx context.Context, input models.HTTPRateHistoryRequest) (*models.HTTPRateHistoryResponse, error) {
+	var (
+		err         error
+		history     *models.HTTPRateHistoryResponse
+		httpMessage string
+		payload     any
+	)
+
+	if _, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeReadBalances); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if history, _, httpMessage, payload, err = common.HTTPRateHistory(r.db, r.logger, &input); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+	}
+
+	return history, nil
+}
+
+// Start is the resolver for the start field.
+func (r *rateCandleResolver) Start(ctx context.Context, obj *postgres.RateCandle) (string, error) {
+	return obj.Start.Format(time.RFC3339), nil
+}
+
+// Open is the resolver for the open field.
+func (r *rateCandleResolver) Open(ctx context.Context, obj *postgres.RateCandle) (float64, error) {
+	return obj.Open.InexactFloat64(), nil
+}
+
+// High is the resolver for the high field.
+func (r *rateCandleResolver) High(ctx context.Context, obj *postgres.RateCandle) (float64, error) {
+	return obj.High.InexactFloat64(), nil
+}
+
+// Low is the resolver for the low field.
+func (r *rateCandleResolver) Low(ctx context.Context, obj *postgres.RateCandle) (float64, error) {
+	return obj.Low.InexactFloat64(), nil
+}
+
+// Close is the resolver for the close field.
+func (r *rateCandleResolver) Close(ctx context.Context, obj *postgres.RateCandle) (float64, error) {
+	return obj.Close.InexactFloat64(), nil
+}
+
+// RateCandle returns graphql_generated.RateCandleResolver implementation.
+func (r *Resolver) RateCandle() graphql_generated.RateCandleResolver { return &rateCandleResolver{r} }
+
+type rateCandleResolver struct{ *Resolver }
@@ -0,0 +1,210 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/quotes"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestRatesResolver_RateCandleResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := rateCandleResolver{}
+
+	candle := &postgres.RateCandle{
+		Start:  time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC),
+		Open:   decimal.NewFromFloat(1.31),
+		High:   decimal.NewFromFloat(1.35),
+		Low:    decimal.NewFromFloat(1.30),
+		Close:  decimal.NewFromFloat(1.33),
+		Quotes: 4,
+	}
+
+	t.Run("Start", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Start(context.TODO(), candle)
+		require.NoError(t, err, "start should always return a nil error.")
+		require.Equal(t, "2023-06-01T12:00:00Z", result, "start mismatched.")
+	})
+
+	t.Run("Open", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Open(context.TODO(), candle)
+		require.NoError(t, err, "open should always return a nil error.")
+		require.InDelta(t, candle.Open.InexactFloat64(), result, 0.01, "open mismatched.")
+	})
+
+	t.Run("High", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.High(context.TODO(), candle)
+		require.NoError(t, err, "high should always return a nil error.")
+		require.InDelta(t, candle.High.InexactFloat64(), result, 0.01, "high mismatched.")
+	})
+
+	t.Run("Low", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Low(context.TODO(), candle)
+		require.NoError(t, err, "low should always return a nil error.")
+		require.InDelta(t, candle.Low.InexactFloat64(), result, 0.01, "low mismatched.")
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Close(context.TODO(), candle)
+		require.NoError(t, err, "close should always return a nil error.")
+		require.InDelta(t, candle.Close.InexactFloat64(), result, 0.01, "close mismatched.")
+	})
+}
+
+func TestRatesResolver_RateHistory(t *testing.T) {
+	t.Parallel()
+
+	const (
+		from = "2023-06-01T00:00:00Z"
+		to   = "2023-06-02T00:00:00Z"
+	)
+
+	candles := []postgres.RateCandle{{}, {}}
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		historyErr           error
+		historyTimes         int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/rate-history/invalid-jwt",
+			query:                fmt.Sprintf(testRatesQuery["rateHistory"], "USD", "CAD", from, to, "1h"),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+		}, {
+			name:                 "invalid interval",
+			path:                 "/rate-history/invalid-interval",
+			query:                fmt.Sprintf(testRatesQuery["rateHistory"], "USD", "CAD", from, to, "1s"),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+		}, {
+			name:                 "history failure",
+			path:                 "/rate-history/history-failure",
+			query:                fmt.Sprintf(testRatesQuery["rateHistory"], "USD", "CAD", from, to, "1h"),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			historyErr:           postgres.ErrNotFound,
+			historyTimes:         1,
+		}, {
+			name:                 "valid",
+			path:                 "/rate-history/valid",
+			query:                fmt.Sprintf(testRatesQuery["rateHistory"], "BTC", "USD", from, to, "15m"),
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			historyTimes:         1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().RateHistory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).
+					Return(candles, test.historyErr).
+					Times(test.historyTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+
+				return
+			}
+
+			history, ok := response["data"].(map[string]any)["rateHistory"].(map[string]any)
+			require.True(t, ok, "failed to extract rate history.")
+			require.Len(t, history["candles"], len(candles), "candle count mismatch.")
+		})
+	}
+}
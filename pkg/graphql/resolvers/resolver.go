@@ -3,6 +3,7 @@ package graphql
 import (
 	"github.com/surahman/FTeX/pkg/auth"
 	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/notifier"
 	"github.com/surahman/FTeX/pkg/postgres"
 	"github.com/surahman/FTeX/pkg/quotes"
 	"github.com/surahman/FTeX/pkg/redis"
@@ -18,5 +19,6 @@ type Resolver struct {
 	cache         redis.Redis
 	db            postgres.Postgres
 	quotes        quotes.Quotes
+	notify        notifier.Notifier
 	logger        *logger.Logger
 }
@@ -0,0 +1,280 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.63
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	graphql_generated "github.com/surahman/FTeX/pkg/graphql/generated"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// CreateSchedule is the resolver for the createSchedule field.
+func (r *mutationResolver) CreateSchedule(ctx context.Context, input models.HTTPScheduleRequest, idempotencyKey *string) (*postgres.Schedule, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		payload     any
+		schedule    *postgres.Schedule
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeTrade); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if idempotencyKey == nil {
+		idempotencyKey = new(string)
+	}
+
+	if schedule, _, httpMessage, payload, err = common.HTTPIdempotentRequest(r.cache, r.logger, clientID,
+		*idempotencyKey, &input, func() (*postgres.Schedule, int, string, any, error) {
+			return common.HTTPScheduleCreate(r.db, r.logger, clientID, &input)
+		}); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+	}
+
+	return schedule, nil
+}
+
+// UpdateSchedule is the resolver for the updateSchedule field.
+func (r *mutationResolver) UpdateSchedule(ctx context.Context, scheduleID string, input models.HTTPScheduleUpdateRequest) (*postgres.Schedule, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		payload     any
+		schedule    *postgres.Schedule
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeTrade); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if schedule, _, httpMessage, payload, err =
+		common.HTTPScheduleUpdate(r.db, r.logger, clientID, scheduleID, &input); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMessage, payload)
+	}
+
+	return schedule, nil
+}
+
+// DeleteSchedule is the resolver for the deleteSchedule field.
+func (r *mutationResolver) DeleteSchedule(ctx context.Context, scheduleID string) (string, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeTrade); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if _, httpMessage, err = common.HTTPScheduleDelete(r.db, r.logger, clientID, scheduleID); err != nil {
+		return "", errors.New(httpMessage)
+	}
+
+	return scheduleID, nil
+}
+
+// Schedules is the resolver for the schedules field.
+func (r *queryResolver) Schedules(ctx context.Context, pageCursor *string, pageSize *int32) (*models.HTTPSchedulesPaginated, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		schedules   models.HTTPSchedulesPaginated
+	)
+
+	if pageSize == nil {
+		pageSize = new(int32)
+	}
+
+	if pageCursor == nil {
+		pageCursor = new(string)
+	}
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeReadBalances); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if schedules, _, httpMessage, err = common.HTTPSchedulesPaginated(r.auth, r.db, r.logger,
+		clientID, *pageCursor, strconv.Itoa(int(*pageSize)), false); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return &schedules, nil
+}
+
+// ScheduleRuns is the resolver for the scheduleRuns field.
+func (r *queryResolver) ScheduleRuns(ctx context.Context, scheduleID string, pageCursor *string, pageSize *int32) (*models.HTTPScheduleRunsPaginated, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		runs        models.HTTPScheduleRunsPaginated
+	)
+
+	if pageSize == nil {
+		pageSize = new(int32)
+	}
+
+	if pageCursor == nil {
+		pageCursor = new(string)
+	}
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeReadBalances); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if runs, _, httpMessage, err = common.HTTPScheduleRunsPaginated(r.auth, r.db, r.logger,
+		clientID, scheduleID, *pageCursor, strconv.Itoa(int(*pageSize)), false); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return &runs, nil
+}
+
+// ScheduleID is the resolver for the scheduleID field.
+func (r *scheduleResolver) ScheduleID(ctx context.Context, obj *postgres.Schedule) (string, error) {
+	return obj.ScheduleID.String(), nil
+}
+
+// ClientID is the resolver for the clientID field.
+func (r *scheduleResolver) ClientID(ctx context.Context, obj *postgres.Schedule) (string, error) {
+	return obj.ClientID.String(), nil
+}
+
+// FiatCurrency is the resolver for the fiatCurrency field.
+func (r *scheduleResolver) FiatCurrency(ctx context.Context, obj *postgres.Schedule) (string, error) {
+	return string(obj.FiatCurrency), nil
+}
+
+// Amount is the resolver for the amount field.
+func (r *scheduleResolver) Amount(ctx context.Context, obj *postgres.Schedule) (float64, error) {
+	return obj.Amount.InexactFloat64(), nil
+}
+
+// Frequency is the resolver for the frequency field.
+func (r *scheduleResolver) Frequency(ctx context.Context, obj *postgres.Schedule) (string, error) {
+	return string(obj.Frequency), nil
+}
+
+// NextRunAt is the resolver for the nextRunAt field.
+func (r *scheduleResolver) NextRunAt(ctx context.Context, obj *postgres.Schedule) (string, error) {
+	return obj.NextRunAt.Time.String(), nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *scheduleResolver) CreatedAt(ctx context.Context, obj *postgres.Schedule) (string, error) {
+	return obj.CreatedAt.Time.String(), nil
+}
+
+// UpdatedAt is the resolver for the updatedAt field.
+func (r *scheduleResolver) UpdatedAt(ctx context.Context, obj *postgres.Schedule) (string, error) {
+	return obj.UpdatedAt.Time.String(), nil
+}
+
+// RunID is the resolver for the runID field.
+func (r *scheduleRunResolver) RunID(ctx context.Context, obj *postgres.ScheduleRun) (string, error) {
+	return obj.RunID.String(), nil
+}
+
+// ScheduleID is the resolver for the scheduleID field.
+func (r *scheduleRunResolver) ScheduleID(ctx context.Context, obj *postgres.ScheduleRun) (string, error) {
+	return obj.ScheduleID.String(), nil
+}
+
+// ClientID is the resolver for the clientID field.
+func (r *scheduleRunResolver) ClientID(ctx context.Context, obj *postgres.ScheduleRun) (string, error) {
+	return obj.ClientID.String(), nil
+}
+
+// Status is the resolver for the status field.
+func (r *scheduleRunResolver) Status(ctx context.Context, obj *postgres.ScheduleRun) (string, error) {
+	return string(obj.Status), nil
+}
+
+// Rate is the resolver for the rate field.
+func (r *scheduleRunResolver) Rate(ctx context.Context, obj *postgres.ScheduleRun) (float64, error) {
+	return obj.Rate.InexactFloat64(), nil
+}
+
+// FiatAmount is the resolver for the fiatAmount field.
+func (r *scheduleRunResolver) FiatAmount(ctx context.Context, obj *postgres.ScheduleRun) (float64, error) {
+	return obj.FiatAmount.InexactFloat64(), nil
+}
+
+// CryptoAmount is the resolver for the cryptoAmount field.
+func (r *scheduleRunResolver) CryptoAmount(ctx context.Context, obj *postgres.ScheduleRun) (float64, error) {
+	return obj.CryptoAmount.InexactFloat64(), nil
+}
+
+// Fee is the resolver for the fee field.
+func (r *scheduleRunResolver) Fee(ctx context.Context, obj *postgres.ScheduleRun) (float64, error) {
+	return obj.Fee.InexactFloat64(), nil
+}
+
+// TxID is the resolver for the txID field.
+func (r *scheduleRunResolver) TxID(ctx context.Context, obj *postgres.ScheduleRun) (*string, error) {
+	if !obj.TxID.Valid {
+		return nil, nil
+	}
+
+	txID := obj.TxID.UUID.String()
+
+	return &txID, nil
+}
+
+// ExecutedAt is the resolver for the executedAt field.
+func (r *scheduleRunResolver) ExecutedAt(ctx context.Context, obj *postgres.ScheduleRun) (string, error) {
+	return obj.ExecutedAt.Time.String(), nil
+}
+
+// Amount is the resolver for the amount field.
+func (r *scheduleRequestResolver) Amount(ctx context.Context, obj *models.HTTPScheduleRequest, data float64) error {
+	obj.Amount = decimal.NewFromFloat(data)
+
+	return nil
+}
+
+// Amount is the resolver for the amount field.
+func (r *scheduleUpdateRequestResolver) Amount(ctx context.Context, obj *models.HTTPScheduleUpdateRequest, data float64) error {
+	obj.Amount = decimal.NewFromFloat(data)
+
+	return nil
+}
+
+// Schedule returns graphql_generated.ScheduleResolver implementation.
+func (r *Resolver) Schedule() graphql_generated.ScheduleResolver { return &scheduleResolver{r} }
+
+// ScheduleRun returns graphql_generated.ScheduleRunResolver implementation.
+func (r *Resolver) ScheduleRun() graphql_generated.ScheduleRunResolver {
+	return &scheduleRunResolver{r}
+}
+
+// ScheduleRequest returns graphql_generated.ScheduleRequestResolver implementation.
+func (r *Resolver) ScheduleRequest() graphql_generated.ScheduleRequestResolver {
+	return &scheduleRequestResolver{r}
+}
+
+// ScheduleUpdateRequest returns graphql_generated.ScheduleUpdateRequestResolver implementation.
+func (r *Resolver) ScheduleUpdateRequest() graphql_generated.ScheduleUpdateRequestResolver {
+	return &scheduleUpdateRequestResolver{r}
+}
+
+type scheduleResolver struct{ *Resolver }
+type scheduleRunResolver struct{ *Resolver }
+type scheduleRequestResolver struct{ *Resolver }
+type scheduleUpdateRequestResolver struct{ *Resolver }
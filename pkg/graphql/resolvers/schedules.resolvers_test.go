@@ -0,0 +1,937 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/quotes"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestSchedulesResolver_ScheduleRequestResolvers(t *testing.T) {
+	t.Parallel()
+
+	var (
+		amountFloat = 50.25
+		amount      = decimal.NewFromFloat(amountFloat)
+	)
+
+	t.Run("ScheduleRequest Amount", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			resolver scheduleRequestResolver
+			input    models.HTTPScheduleRequest
+		)
+
+		err := resolver.Amount(context.TODO(), &input, amountFloat)
+		require.NoError(t, err, "amount should always return a nil error.")
+		require.Equal(t, amount, input.Amount, "amounts mismatched.")
+	})
+
+	t.Run("ScheduleUpdateRequest Amount", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			resolver scheduleUpdateRequestResolver
+			input    models.HTTPScheduleUpdateRequest
+		)
+
+		err := resolver.Amount(context.TODO(), &input, amountFloat)
+		require.NoError(t, err, "amount should always return a nil error.")
+		require.Equal(t, amount, input.Amount, "amounts mismatched.")
+	})
+}
+
+func TestSchedulesResolver_ScheduleResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := scheduleResolver{}
+
+	scheduleID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate schedule id.")
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	timestamp := pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	schedule := &postgres.Schedule{
+		ScheduleID:   scheduleID,
+		ClientID:     clientID,
+		FiatCurrency: postgres.CurrencyUSD,
+		Ticker:       "BTC",
+		Amount:       decimal.NewFromFloat(50),
+		Frequency:    postgres.ScheduleFrequencyWeekly,
+		NextRunAt:    timestamp,
+		IsActive:     true,
+		CreatedAt:    timestamp,
+		UpdatedAt:    timestamp,
+	}
+
+	t.Run("ScheduleID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.ScheduleID(context.TODO(), schedule)
+		require.NoError(t, err, "schedule id should always return a nil error.")
+		require.Equal(t, scheduleID.String(), result, "schedule id mismatched.")
+	})
+
+	t.Run("ClientID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.ClientID(context.TODO(), schedule)
+		require.NoError(t, err, "client id should always return a nil error.")
+		require.Equal(t, clientID.String(), result, "client id mismatched.")
+	})
+
+	t.Run("FiatCurrency", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.FiatCurrency(context.TODO(), schedule)
+		require.NoError(t, err, "fiat currency should always return a nil error.")
+		require.Equal(t, string(postgres.CurrencyUSD), result, "fiat currency mismatched.")
+	})
+
+	t.Run("Amount", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Amount(context.TODO(), schedule)
+		require.NoError(t, err, "amount should always return a nil error.")
+		require.InDelta(t, schedule.Amount.InexactFloat64(), result, 0.01, "amount mismatched.")
+	})
+
+	t.Run("Frequency", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Frequency(context.TODO(), schedule)
+		require.NoError(t, err, "frequency should always return a nil error.")
+		require.Equal(t, string(postgres.ScheduleFrequencyWeekly), result, "frequency mismatched.")
+	})
+
+	t.Run("NextRunAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.NextRunAt(context.TODO(), schedule)
+		require.NoError(t, err, "next run at should always return a nil error.")
+		require.Equal(t, timestamp.Time.String(), result, "next run at mismatched.")
+	})
+
+	t.Run("CreatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.CreatedAt(context.TODO(), schedule)
+		require.NoError(t, err, "created at should always return a nil error.")
+		require.Equal(t, timestamp.Time.String(), result, "created at mismatched.")
+	})
+
+	t.Run("UpdatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.UpdatedAt(context.TODO(), schedule)
+		require.NoError(t, err, "updated at should always return a nil error.")
+		require.Equal(t, timestamp.Time.String(), result, "updated at mismatched.")
+	})
+}
+
+func TestSchedulesResolver_ScheduleRunResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := scheduleRunResolver{}
+
+	runID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate run id.")
+
+	scheduleID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate schedule id.")
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	txID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate transaction id.")
+
+	timestamp := pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	run := &postgres.ScheduleRun{
+		RunID:        runID,
+		ScheduleID:   scheduleID,
+		ClientID:     clientID,
+		Status:       postgres.ScheduleRunStatusSucceeded,
+		Rate:         decimal.NewFromFloat(0.00003),
+		FiatAmount:   decimal.NewFromFloat(50),
+		CryptoAmount: decimal.NewFromFloat(0.0015),
+		Fee:          decimal.NewFromFloat(0.5),
+		TxID:         uuid.NullUUID{UUID: txID, Valid: true},
+		ExecutedAt:   timestamp,
+	}
+
+	t.Run("RunID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.RunID(context.TODO(), run)
+		require.NoError(t, err, "run id should always return a nil error.")
+		require.Equal(t, runID.String(), result, "run id mismatched.")
+	})
+
+	t.Run("ScheduleID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.ScheduleID(context.TODO(), run)
+		require.NoError(t, err, "schedule id should always return a nil error.")
+		require.Equal(t, scheduleID.String(), result, "schedule id mismatched.")
+	})
+
+	t.Run("ClientID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.ClientID(context.TODO(), run)
+		require.NoError(t, err, "client id should always return a nil error.")
+		require.Equal(t, clientID.String(), result, "client id mismatched.")
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Status(context.TODO(), run)
+		require.NoError(t, err, "status should always return a nil error.")
+		require.Equal(t, string(postgres.ScheduleRunStatusSucceeded), result, "status mismatched.")
+	})
+
+	t.Run("Rate", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Rate(context.TODO(), run)
+		require.NoError(t, err, "rate should always return a nil error.")
+		require.InDelta(t, run.Rate.InexactFloat64(), result, 0.01, "rate mismatched.")
+	})
+
+	t.Run("FiatAmount", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.FiatAmount(context.TODO(), run)
+		require.NoError(t, err, "fiat amount should always return a nil error.")
+		require.InDelta(t, run.FiatAmount.InexactFloat64(), result, 0.01, "fiat amount mismatched.")
+	})
+
+	t.Run("CryptoAmount", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.CryptoAmount(context.TODO(), run)
+		require.NoError(t, err, "crypto amount should always return a nil error.")
+		require.InDelta(t, run.CryptoAmount.InexactFloat64(), result, 0.01, "crypto amount mismatched.")
+	})
+
+	t.Run("Fee", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Fee(context.TODO(), run)
+		require.NoError(t, err, "fee should always return a nil error.")
+		require.InDelta(t, run.Fee.InexactFloat64(), result, 0.01, "fee mismatched.")
+	})
+
+	t.Run("TxID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.TxID(context.TODO(), run)
+		require.NoError(t, err, "transaction id should always return a nil error.")
+		require.NotNil(t, result, "transaction id should be set on a successful run.")
+		require.Equal(t, txID.String(), *result, "transaction id mismatched.")
+
+		result, err = resolver.TxID(context.TODO(), &postgres.ScheduleRun{})
+		require.NoError(t, err, "transaction id should always return a nil error.")
+		require.Nil(t, result, "transaction id should not be set on a failed run.")
+	})
+
+	t.Run("ExecutedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.ExecutedAt(context.TODO(), run)
+		require.NoError(t, err, "executed at should always return a nil error.")
+		require.Equal(t, timestamp.Time.String(), result, "executed at mismatched.")
+	})
+}
+
+func TestSchedulesResolver_CreateSchedule(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		scheduleCreateErr    error
+		scheduleCreateTimes  int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/create-schedule/invalid-jwt",
+			query:                fmt.Sprintf(testSchedulesQuery["createSchedule"], "USD", "BTC", 50.0, "weekly"),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+		}, {
+			name:                 "fiat to fiat",
+			path:                 "/create-schedule/fiat-to-fiat",
+			query:                fmt.Sprintf(testSchedulesQuery["createSchedule"], "USD", "CAD", 50.0, "weekly"),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+		}, {
+			name:                 "invalid frequency",
+			path:                 "/create-schedule/invalid-frequency",
+			query:                fmt.Sprintf(testSchedulesQuery["createSchedule"], "USD", "BTC", 50.0, "hourly"),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+		}, {
+			name:                 "db failure",
+			path:                 "/create-schedule/db-failure",
+			query:                fmt.Sprintf(testSchedulesQuery["createSchedule"], "USD", "BTC", 50.0, "weekly"),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			scheduleCreateErr:    postgres.ErrCreateSchedule,
+			scheduleCreateTimes:  1,
+		}, {
+			name:                 "valid",
+			path:                 "/create-schedule/valid",
+			query:                fmt.Sprintf(testSchedulesQuery["createSchedule"], "USD", "BTC", 50.0, "weekly"),
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			scheduleCreateTimes:  1,
+		},
+	}
+
+	for _, testCase := range testCases { //nolint:dupl
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().ScheduleCreate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any()).
+					Return(postgres.Schedule{}, test.scheduleCreateErr).
+					Times(test.scheduleCreateTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestSchedulesResolver_UpdateSchedule(t *testing.T) {
+	t.Parallel()
+
+	scheduleID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate schedule id.")
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		scheduleUpdateErr    error
+		scheduleUpdateTimes  int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/update-schedule/invalid-jwt",
+			query:                fmt.Sprintf(testSchedulesQuery["updateSchedule"], scheduleID, 75.0, "daily", false),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+		}, {
+			name:                 "invalid schedule id",
+			path:                 "/update-schedule/invalid-schedule-id",
+			query:                fmt.Sprintf(testSchedulesQuery["updateSchedule"], "invalid-id", 75.0, "daily", false),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+		}, {
+			name:                 "not found",
+			path:                 "/update-schedule/not-found",
+			query:                fmt.Sprintf(testSchedulesQuery["updateSchedule"], scheduleID, 75.0, "daily", false),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			scheduleUpdateErr:    postgres.ErrNotFound,
+			scheduleUpdateTimes:  1,
+		}, {
+			name:                 "valid",
+			path:                 "/update-schedule/valid",
+			query:                fmt.Sprintf(testSchedulesQuery["updateSchedule"], scheduleID, 75.0, "daily", false),
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			scheduleUpdateTimes:  1,
+		},
+	}
+
+	for _, testCase := range testCases { //nolint:dupl
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().ScheduleUpdate(gomock.Any(), scheduleID, gomock.Any(), gomock.Any(), false).
+					Return(postgres.Schedule{}, test.scheduleUpdateErr).
+					Times(test.scheduleUpdateTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestSchedulesResolver_DeleteSchedule(t *testing.T) {
+	t.Parallel()
+
+	scheduleID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate schedule id.")
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		scheduleDeleteErr    error
+		scheduleDeleteTimes  int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/delete-schedule/invalid-jwt",
+			query:                fmt.Sprintf(testSchedulesQuery["deleteSchedule"], scheduleID),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+		}, {
+			name:                 "invalid schedule id",
+			path:                 "/delete-schedule/invalid-schedule-id",
+			query:                fmt.Sprintf(testSchedulesQuery["deleteSchedule"], "invalid-id"),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+		}, {
+			name:                 "not found",
+			path:                 "/delete-schedule/not-found",
+			query:                fmt.Sprintf(testSchedulesQuery["deleteSchedule"], scheduleID),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			scheduleDeleteErr:    postgres.ErrNotFound,
+			scheduleDeleteTimes:  1,
+		}, {
+			name:                 "valid",
+			path:                 "/delete-schedule/valid",
+			query:                fmt.Sprintf(testSchedulesQuery["deleteSchedule"], scheduleID),
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			scheduleDeleteTimes:  1,
+		},
+	}
+
+	for _, testCase := range testCases { //nolint:dupl
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().ScheduleDelete(gomock.Any(), scheduleID).
+					Return(test.scheduleDeleteErr).
+					Times(test.scheduleDeleteTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestSchedulesResolver_Schedules(t *testing.T) {
+	t.Parallel()
+
+	schedules := []postgres.Schedule{{}, {}, {}, {}}
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		authDecryptErr       error
+		authDecryptTimes     int
+		schedulesErr         error
+		schedulesTimes       int
+		authEncryptTimes     int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/schedules/invalid-jwt",
+			query:                fmt.Sprintf(testSchedulesQuery["schedules"], "page-cursor", 3),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+		}, {
+			name:                 "decrypt cursor failure",
+			path:                 "/schedules/decrypt-cursor-failure",
+			query:                fmt.Sprintf(testSchedulesQuery["schedules"], "page-cursor", 3),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			authDecryptErr:       errors.New("decrypt failure"),
+			authDecryptTimes:     1,
+		}, {
+			name:                 "schedules failure",
+			path:                 "/schedules/schedules-failure",
+			query:                fmt.Sprintf(testSchedulesQuery["schedules"], "page-cursor", 3),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			authDecryptTimes:     1,
+			schedulesErr:         postgres.ErrNotFound,
+			schedulesTimes:       1,
+		}, {
+			name:                 "valid",
+			path:                 "/schedules/valid",
+			query:                fmt.Sprintf(testSchedulesQuery["schedules"], "page-cursor", 3),
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			authDecryptTimes:     1,
+			schedulesTimes:       1,
+			authEncryptTimes:     1,
+		}, {
+			name:                 "valid no params",
+			path:                 "/schedules/valid-no-params",
+			query:                testSchedulesQuery["schedulesNoParams"],
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			schedulesTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("3"), test.authDecryptErr).
+					Times(test.authDecryptTimes),
+
+				mockPostgres.EXPECT().SchedulesPaginated(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(schedules, test.schedulesErr).
+					Times(test.schedulesTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-page-cursor", nil).
+					Times(test.authEncryptTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestSchedulesResolver_ScheduleRuns(t *testing.T) {
+	t.Parallel()
+
+	scheduleID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate schedule id.")
+
+	runs := []postgres.ScheduleRun{{}, {}, {}, {}}
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		authDecryptTimes     int
+		runsErr              error
+		runsTimes            int
+		authEncryptTimes     int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/schedule-runs/invalid-jwt",
+			query:                fmt.Sprintf(testSchedulesQuery["scheduleRuns"], scheduleID, "page-cursor", 3),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+		}, {
+			name:                 "invalid schedule id",
+			path:                 "/schedule-runs/invalid-schedule-id",
+			query:                fmt.Sprintf(testSchedulesQuery["scheduleRuns"], "invalid-id", "page-cursor", 3),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+		}, {
+			name:                 "runs failure",
+			path:                 "/schedule-runs/runs-failure",
+			query:                fmt.Sprintf(testSchedulesQuery["scheduleRuns"], scheduleID, "page-cursor", 3),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			authDecryptTimes:     1,
+			runsErr:              postgres.ErrNotFound,
+			runsTimes:            1,
+		}, {
+			name:                 "valid",
+			path:                 "/schedule-runs/valid",
+			query:                fmt.Sprintf(testSchedulesQuery["scheduleRuns"], scheduleID, "page-cursor", 3),
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			authDecryptTimes:     1,
+			runsTimes:            1,
+			authEncryptTimes:     1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("3"), nil).
+					Times(test.authDecryptTimes),
+
+				mockPostgres.EXPECT().ScheduleRunsPaginated(gomock.Any(), scheduleID, gomock.Any(), gomock.Any()).
+					Return(runs, test.runsErr).
+					Times(test.runsTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-page-cursor", nil).
+					Times(test.authEncryptTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/events"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"go.uber.org/zap"
+)
+
+// subscriptionContext will derive the context a subscription runs in. Subscriptions authorized with a JWT are ended
+// when the token expires, whilst API keys do not expire.
+func subscriptionContext(ctx context.Context, expiresAt int64) (context.Context, context.CancelFunc) {
+	if expiresAt > 0 {
+		return context.WithDeadline(ctx, time.Unix(expiresAt, 0))
+	}
+
+	return context.WithCancel(ctx)
+}
+
+// validTicker will check whether a currency code has the length of a Fiat currency or Cryptocurrency ticker.
+func validTicker(ticker string) bool {
+	return len(ticker) > 0 && len(ticker) < 7
+}
+
+// subscriptionCurrency will validate and normalize the currency of the account a balance subscription is for.
+func subscriptionCurrency(currency string) (string, bool, error) {
+	var fiatCurrency postgres.Currency
+
+	currency = strings.ToUpper(currency)
+
+	if err := fiatCurrency.Scan(currency); err == nil && fiatCurrency.Valid() {
+		return currency, true, nil
+	}
+
+	if !validTicker(currency) {
+		return currency, false, errors.New(constants.InvalidCurrencyString())
+	}
+
+	return currency, false, nil
+}
+
+// parseCurrencyPair will split a currency pair formatted as SRC-DST into its source and destination currencies.
+func parseCurrencyPair(pair string) (string, string, error) {
+	source, destination, found := strings.Cut(strings.ToUpper(pair), "-")
+	if !found || !validTicker(source) || !validTicker(destination) {
+		return source, destination, errors.New("currency pair must be formatted as SRC-DST")
+	}
+
+	return source, destination, nil
+}
+
+// balanceUpdate will retrieve the balance of a client's account after an account event that changed it.
+func balanceUpdate(db postgres.Postgres, logger *logger.Logger, clientID uuid.UUID, currency string, isFiat bool,
+	event *events.Event) (*models.HTTPBalanceUpdate, error) {
+	update := models.HTTPBalanceUpdate{
+		EventID:   event.EventID,
+		EventType: string(event.EventType),
+		Currency:  currency,
+		UpdatedAt: event.CreatedAt,
+	}
+
+	if isFiat {
+		account, _, httpMsg, _, err := common.HTTPFiatBalance(db, logger, clientID, currency)
+		if err != nil {
+			return nil, errors.New(httpMsg)
+		}
+
+		update.Balance, update.Held, update.Available = account.Balance, account.Held, account.Available
+
+		return &update, nil
+	}
+
+	account, _, httpMsg, _, err := common.HTTPCryptoBalance(db, logger, clientID, currency)
+	if err != nil {
+		return nil, errors.New(httpMsg)
+	}
+
+	update.Balance, update.Held, update.Available = account.Balance, account.Held, account.Available
+
+	return &update, nil
+}
+
+// forwardBalanceUpdates will push the balance of a client's account to a subscription every time an account event that
+// changed it is received. Balances that cannot be retrieved are skipped, and the subscription is ended when the
+// account events stop.
+func forwardBalanceUpdates(ctx context.Context, cancel context.CancelFunc, db postgres.Postgres,
+	logger *logger.Logger, clientID uuid.UUID, currency string, isFiat bool, messages <-chan []byte,
+	updates chan<- *models.HTTPBalanceUpdate) {
+	defer cancel()
+	defer close(updates)
+
+	for message := range messages {
+		var event events.Event
+
+		if err := json.Unmarshal(message, &event); err != nil {
+			logger.Warn("failed to unmarshal account event", zap.Error(err))
+
+			continue
+		}
+
+		if !event.AffectsCurrency(currency) {
+			continue
+		}
+
+		update, err := balanceUpdate(db, logger, clientID, currency, isFiat, &event)
+		if err != nil {
+			logger.Warn("failed to retrieve account balance for subscription",
+				zap.String("currency", currency), zap.Error(err))
+
+			continue
+		}
+
+		select {
+		case updates <- update:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// forwardRateTicks will push the fresh price quotes for a currency pair to a subscription. The subscription is ended
+// when the price quotes stop.
+func forwardRateTicks(ctx context.Context, cancel context.CancelFunc, logger *logger.Logger, messages <-chan []byte,
+	ticks chan<- *events.RateTick) {
+	defer cancel()
+	defer close(ticks)
+
+	for message := range messages {
+		var tick events.RateTick
+
+		if err := json.Unmarshal(message, &tick); err != nil {
+			logger.Warn("failed to unmarshal rate tick", zap.Error(err))
+
+			continue
+		}
+
+		select {
+		case ticks <- &tick:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
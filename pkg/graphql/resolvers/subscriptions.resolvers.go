@@ -0,0 +1,133 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.63
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/events"
+	graphql_generated "github.com/surahman/FTeX/pkg/graphql/generated"
+	"github.com/surahman/FTeX/pkg/models"
+)
+
+// EventID is the resolver for the eventID field.
+func (r *balanceUpdateResolver) EventID(ctx context.Context, obj *models.HTTPBalanceUpdate) (string, error) {
+	return obj.EventID.String(), nil
+}
+
+// Balance is the resolver for the balance field.
+func (r *balanceUpdateResolver) Balance(ctx context.Context, obj *models.HTTPBalanceUpdate) (float64, error) {
+	return obj.Balance.InexactFloat64(), nil
+}
+
+// Held is the resolver for the held field.
+func (r *balanceUpdateResolver) Held(ctx context.Context, obj *models.HTTPBalanceUpdate) (float64, error) {
+	return obj.Held.InexactFloat64(), nil
+}
+
+// Available is the resolver for the available field.
+func (r *balanceUpdateResolver) Available(ctx context.Context, obj *models.HTTPBalanceUpdate) (float64, error) {
+	return obj.Available.InexactFloat64(), nil
+}
+
+// UpdatedAt is the resolver for the updatedAt field.
+func (r *balanceUpdateResolver) UpdatedAt(ctx context.Context, obj *models.HTTPBalanceUpdate) (string, error) {
+	return obj.UpdatedAt.Format(time.RFC3339Nano), nil
+}
+
+// Rate is the resolver for the rate field.
+func (r *rateTickResolver) Rate(ctx context.Context, obj *events.RateTick) (float64, error) {
+	return obj.Rate.InexactFloat64(), nil
+}
+
+// QuotedAt is the resolver for the quotedAt field.
+func (r *rateTickResolver) QuotedAt(ctx context.Context, obj *events.RateTick) (string, error) {
+	return obj.QuotedAt.Format(time.RFC3339Nano), nil
+}
+
+// BalanceUpdated is the resolver for the balanceUpdated field.
+func (r *subscriptionResolver) BalanceUpdated(ctx context.Context, currency string) (<-chan *models.HTTPBalanceUpdate, error) {
+	var (
+		clientID  uuid.UUID
+		expiresAt int64
+		err       error
+		isFiat    bool
+		messages  <-chan []byte
+	)
+
+	if clientID, expiresAt, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeReadBalances); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if currency, isFiat, err = subscriptionCurrency(currency); err != nil {
+		return nil, err
+	}
+
+	subCtx, cancel := subscriptionContext(ctx, expiresAt)
+
+	if messages, err = r.cache.Subscribe(subCtx, events.AccountEventsChannel(clientID)); err != nil {
+		cancel()
+
+		return nil, errors.New(constants.RetryMessageString())
+	}
+
+	updates := make(chan *models.HTTPBalanceUpdate)
+	go forwardBalanceUpdates(subCtx, cancel, r.db, r.logger, clientID, currency, isFiat, messages, updates)
+
+	return updates, nil
+}
+
+// RateTicker is the resolver for the rateTicker field.
+func (r *subscriptionResolver) RateTicker(ctx context.Context, pair string) (<-chan *events.RateTick, error) {
+	var (
+		expiresAt           int64
+		err                 error
+		source, destination string
+		messages            <-chan []byte
+	)
+
+	if _, expiresAt, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeReadBalances); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if source, destination, err = parseCurrencyPair(pair); err != nil {
+		return nil, err
+	}
+
+	subCtx, cancel := subscriptionContext(ctx, expiresAt)
+
+	if messages, err = r.cache.Subscribe(subCtx, events.RateTickerChannel(source, destination)); err != nil {
+		cancel()
+
+		return nil, errors.New(constants.RetryMessageString())
+	}
+
+	ticks := make(chan *events.RateTick)
+	go forwardRateTicks(subCtx, cancel, r.logger, messages, ticks)
+
+	return ticks, nil
+}
+
+// BalanceUpdate returns graphql_generated.BalanceUpdateResolver implementation.
+func (r *Resolver) BalanceUpdate() graphql_generated.BalanceUpdateResolver {
+	return &balanceUpdateResolver{r}
+}
+
+// RateTick returns graphql_generated.RateTickResolver implementation.
+func (r *Resolver) RateTick() graphql_generated.RateTickResolver { return &rateTickResolver{r} }
+
+// Subscription returns graphql_generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() graphql_generated.SubscriptionResolver {
+	return &subscriptionResolver{r}
+}
+
+type balanceUpdateResolver struct{ *Resolver }
+type rateTickResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
@@ -0,0 +1,407 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/events"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+// subscriptionTestContext will generate a context containing a Gin context with an authorization header for use in the
+// subscription resolver tests.
+func subscriptionTestContext() context.Context {
+	ginCtx := &gin.Context{Request: &http.Request{Header: http.Header{}}}
+	ginCtx.Request.Header.Set(testAuthHeaderKey, "test-token")
+
+	return context.WithValue(context.TODO(), GinContextKey{}, ginCtx)
+}
+
+func TestSubscriptionsResolver_BalanceUpdateResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := balanceUpdateResolver{}
+
+	update := &models.HTTPBalanceUpdate{
+		EventID:   uuid.Must(uuid.NewV4()),
+		Balance:   decimal.NewFromFloat(1234.56),
+		Held:      decimal.NewFromFloat(34.56),
+		Available: decimal.NewFromFloat(1200),
+		UpdatedAt: time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("EventID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.EventID(context.TODO(), update)
+		require.NoError(t, err, "event id should always return a nil error.")
+		require.Equal(t, update.EventID.String(), result, "event id mismatched.")
+	})
+
+	t.Run("Balance", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Balance(context.TODO(), update)
+		require.NoError(t, err, "balance should always return a nil error.")
+		require.InDelta(t, update.Balance.InexactFloat64(), result, 0.01, "balance mismatched.")
+	})
+
+	t.Run("Held", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Held(context.TODO(), update)
+		require.NoError(t, err, "held should always return a nil error.")
+		require.InDelta(t, update.Held.InexactFloat64(), result, 0.01, "held mismatched.")
+	})
+
+	t.Run("Available", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Available(context.TODO(), update)
+		require.NoError(t, err, "available should always return a nil error.")
+		require.InDelta(t, update.Available.InexactFloat64(), result, 0.01, "available mismatched.")
+	})
+
+	t.Run("UpdatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.UpdatedAt(context.TODO(), update)
+		require.NoError(t, err, "updated at should always return a nil error.")
+		require.Equal(t, "2023-06-01T12:00:00Z", result, "updated at mismatched.")
+	})
+}
+
+func TestSubscriptionsResolver_RateTickResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := rateTickResolver{}
+
+	tick := &events.RateTick{
+		Rate:     decimal.NewFromFloat(1.31),
+		QuotedAt: time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("Rate", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Rate(context.TODO(), tick)
+		require.NoError(t, err, "rate should always return a nil error.")
+		require.InDelta(t, tick.Rate.InexactFloat64(), result, 0.01, "rate mismatched.")
+	})
+
+	t.Run("QuotedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.QuotedAt(context.TODO(), tick)
+		require.NoError(t, err, "quoted at should always return a nil error.")
+		require.Equal(t, "2023-06-01T12:00:00Z", result, "quoted at mismatched.")
+	})
+}
+
+func TestSubscriptionsResolver_BalanceUpdated(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.Must(uuid.NewV4())
+
+	accountEvent := func(payload string) []byte {
+		message, err := json.Marshal(&events.Event{
+			EventID:   uuid.Must(uuid.NewV4()),
+			EventType: postgres.OutboxEventTypeFiatDeposit,
+			ClientID:  clientID,
+			CreatedAt: time.Now(),
+			Payload:   json.RawMessage(payload),
+		})
+		require.NoError(t, err, "failed to marshal account event.")
+
+		return message
+	}
+
+	testCases := []struct {
+		name                 string
+		currency             string
+		expectErr            require.ErrorAssertionFunc
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		subscribeErr         error
+		subscribeTimes       int
+		fiatBalanceTimes     int
+		cryptoBalanceTimes   int
+		messages             [][]byte
+	}{
+		{
+			name:                 "invalid jwt",
+			currency:             "USD",
+			expectErr:            require.Error,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+		}, {
+			name:                 "invalid currency",
+			currency:             "INVALID",
+			expectErr:            require.Error,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+		}, {
+			name:                 "subscribe failure",
+			currency:             "USD",
+			expectErr:            require.Error,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			subscribeErr:         redis.ErrPubSub,
+			subscribeTimes:       1,
+		}, {
+			name:                 "fiat",
+			currency:             "usd",
+			expectErr:            require.NoError,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			subscribeTimes:       1,
+			fiatBalanceTimes:     1,
+			messages: [][]byte{
+				[]byte("malformed"),
+				accountEvent(`{"currency":"CAD"}`),
+				accountEvent(`{"currency":"USD"}`),
+			},
+		}, {
+			name:                 "crypto",
+			currency:             "BTC",
+			expectErr:            require.NoError,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			subscribeTimes:       1,
+			cryptoBalanceTimes:   1,
+			messages: [][]byte{
+				accountEvent(`{"fiatCurrency":"USD","ticker":"ETH"}`),
+				accountEvent(`{"fiatCurrency":"USD","ticker":"BTC"}`),
+			},
+		}, {
+			name:                 "crypto swap",
+			currency:             "BTC",
+			expectErr:            require.NoError,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			subscribeTimes:       1,
+			cryptoBalanceTimes:   1,
+			messages: [][]byte{
+				accountEvent(`{"sourceTicker":"ETH","destinationTicker":"SOL"}`),
+				accountEvent(`{"sourceTicker":"ETH","destinationTicker":"BTC"}`),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			messages := make(chan []byte, len(test.messages))
+			for _, message := range test.messages {
+				messages <- message
+			}
+
+			close(messages)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(clientID, time.Now().Add(time.Minute).Unix(), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Subscribe(gomock.Any(), events.AccountEventsChannel(clientID)).
+					Return(messages, test.subscribeErr).
+					Times(test.subscribeTimes),
+			)
+
+			mockPostgres.EXPECT().FiatBalance(clientID, postgres.CurrencyUSD).
+				Return(postgres.FiatAccount{Currency: postgres.CurrencyUSD, Balance: decimal.NewFromFloat(100)}, nil).
+				Times(test.fiatBalanceTimes)
+
+			mockPostgres.EXPECT().CryptoBalance(clientID, "BTC").
+				Return(postgres.CryptoAccount{Ticker: "BTC", Balance: decimal.NewFromFloat(2)}, nil).
+				Times(test.cryptoBalanceTimes)
+
+			resolver := subscriptionResolver{&Resolver{
+				authHeaderKey: testAuthHeaderKey,
+				auth:          mockAuth,
+				cache:         mockRedis,
+				db:            mockPostgres,
+				logger:        zapLogger,
+			}}
+
+			updates, err := resolver.BalanceUpdated(subscriptionTestContext(), test.currency)
+			test.expectErr(t, err, "error expectation failed.")
+
+			if err != nil {
+				return
+			}
+
+			var received []*models.HTTPBalanceUpdate
+			for update := range updates {
+				received = append(received, update)
+			}
+
+			require.Len(t, received, 1, "balance updates mismatched.")
+			require.Equal(t, strings.ToUpper(test.currency), received[0].Currency, "currency mismatched.")
+		})
+	}
+}
+
+func TestSubscriptionsResolver_RateTicker(t *testing.T) {
+	t.Parallel()
+
+	tick, err := json.Marshal(&events.RateTick{
+		Source:      "BTC",
+		Destination: "USD",
+		Rate:        decimal.NewFromFloat(30000),
+		QuotedAt:    time.Now(),
+	})
+	require.NoError(t, err, "failed to marshal rate tick.")
+
+	testCases := []struct {
+		name                 string
+		pair                 string
+		expectErr            require.ErrorAssertionFunc
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		subscribeErr         error
+		subscribeTimes       int
+		messages             [][]byte
+		expectedTicks        int
+	}{
+		{
+			name:                 "invalid jwt",
+			pair:                 "BTC-USD",
+			expectErr:            require.Error,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+		}, {
+			name:                 "invalid pair",
+			pair:                 "BTCUSD",
+			expectErr:            require.Error,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+		}, {
+			name:                 "subscribe failure",
+			pair:                 "BTC-USD",
+			expectErr:            require.Error,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			subscribeErr:         redis.ErrPubSub,
+			subscribeTimes:       1,
+		}, {
+			name:                 "valid",
+			pair:                 "btc-usd",
+			expectErr:            require.NoError,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			subscribeTimes:       1,
+			messages:             [][]byte{[]byte("malformed"), tick, tick},
+			expectedTicks:        2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			messages := make(chan []byte, len(test.messages))
+			for _, message := range test.messages {
+				messages <- message
+			}
+
+			close(messages)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, time.Now().Add(time.Minute).Unix(), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Subscribe(gomock.Any(), events.RateTickerChannel("BTC", "USD")).
+					Return(messages, test.subscribeErr).
+					Times(test.subscribeTimes),
+			)
+
+			resolver := subscriptionResolver{&Resolver{
+				authHeaderKey: testAuthHeaderKey,
+				auth:          mockAuth,
+				cache:         mockRedis,
+				db:            mockPostgres,
+				logger:        zapLogger,
+			}}
+
+			ticks, err := resolver.RateTicker(subscriptionTestContext(), test.pair)
+			test.expectErr(t, err, "error expectation failed.")
+
+			if err != nil {
+				return
+			}
+
+			var received []*events.RateTick
+			for tick := range ticks {
+				received = append(received, tick)
+			}
+
+			require.Len(t, received, test.expectedTicks, "rate ticks mismatched.")
+		})
+	}
+}
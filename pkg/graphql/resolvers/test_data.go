@@ -7,6 +7,13 @@ func getHealthcheckQuery() string {
 	}`
 }
 
+// getRateLimitQuery is a health check query containing two operations.
+func getRateLimitQuery() string {
+	return `{
+		"query": "query { first: healthcheck, second: healthcheck }"
+	}`
+}
+
 // getUsersQuery is a map of test user mutations and queries.
 //
 //nolint:lll
@@ -17,7 +24,7 @@ func getUsersQuery() map[string]string {
 		}`,
 
 		"login": `{
-		"query": "mutation { loginUser(input: { username:\"%s\", password: \"%s\" }) { token, expires, threshold }}"
+		"query": "mutation { loginUser(input: { username:\"%s\", password: \"%s\" }) { token { token, expires, threshold } mfaChallenge { challengeID, expires } }}"
 		}`,
 
 		"refresh": `{
@@ -27,6 +34,34 @@ func getUsersQuery() map[string]string {
 		"delete": `{
 	    "query": "mutation { deleteUser(input: { username: \"%s\" password: \"%s\" confirmation:\"I understand the consequences, delete my user account %s\" })}"
 		}`,
+
+		"logout": `{
+		"query": "mutation { logoutUser }"
+		}`,
+
+		"logoutEverywhere": `{
+		"query": "mutation { logoutUserEverywhere }"
+		}`,
+
+		"changePassword": `{
+		"query": "mutation { changePassword(input: { currentPassword: \"%s\", newPassword: \"%s\" }) }"
+		}`,
+
+		"requestPasswordReset": `{
+		"query": "mutation { requestPasswordReset(input: { username: \"%s\" }) { expires } }"
+		}`,
+
+		"resetPassword": `{
+		"query": "mutation { resetPassword(input: { token: \"%s\", password: \"%s\" }) }"
+		}`,
+
+		"loginEvents": `{
+		"query": "query { loginEvents(pageCursor: \"%s\", pageSize: %d) { events { eventID, outcome, ipAddress, userAgent, createdAt }, links { pageCursor } } }"
+		}`,
+
+		"loginEventsNoParams": `{
+		"query": "query { loginEvents { events { eventID, outcome, ipAddress, userAgent, createdAt }, links { pageCursor } } }"
+		}`,
 	}
 }
 
@@ -43,16 +78,24 @@ func getFiatQuery() map[string]string {
 		"query": "mutation { depositFiat(input: { amount:%f, currency: \"%s\" }) { txId, clientId, txTimestamp, balance, lastTx, currency } }"
 		}`,
 
+		"withdrawFiat": `{
+		"query": "mutation { withdrawFiat(input: { amount:%f, currency: \"%s\" }) { txId, clientId, txTimestamp, balance, lastTx, currency } }"
+		}`,
+
 		"exchangeOfferFiat": `{
-		"query": "mutation { exchangeOfferFiat(input: { sourceCurrency:\"%s\" destinationCurrency: \"%s\" sourceAmount: %f }) { priceQuote{ clientID, sourceAcc, destinationAcc, rate, amount }, debitAmount, offerID, expires } }"
+		"query": "mutation { exchangeOfferFiat(input: { sourceCurrency:\"%s\" destinationCurrency: \"%s\" sourceAmount: %f }) { priceQuote{ clientID, sourceAcc, destinationAcc, rate, amount }, debitAmount, fee, offerID, expires } }"
 		}`,
 
 		"exchangeTransferFiat": `{
 		"query": "mutation { exchangeTransferFiat(offerID: \"%s\") { sourceReceipt { txId, clientId, txTimestamp, balance, lastTx, currency }, destinationReceipt { txId, clientId, txTimestamp, balance, lastTx, currency } } }"
 		}`,
 
+		"transferP2PFiat": `{
+		"query": "mutation { transferP2PFiat(input: { username: \"%s\", currency: \"%s\", amount:%f }) { txId, clientId, txTimestamp, balance, lastTx, currency } }"
+		}`,
+
 		"balanceFiat": `{
-		"query": "query { balanceFiat(currencyCode: \"%s\") { currency, balance, lastTx, lastTxTs, createdAt, clientID } }"
+		"query": "query { balanceFiat(currencyCode: \"%s\") { currency, balance, lastTx, lastTxTs, createdAt, clientID, status, held, available } }"
 		}`,
 
 		"balanceAllFiat": `{
@@ -87,15 +130,23 @@ func getCryptoQuery() map[string]string {
 		}`,
 
 		"offerCrypto": `{
-		"query": "mutation { offerCrypto(input: { sourceAmount: %f, sourceCurrency:\"%s\", destinationCurrency:\"%s\", isPurchase: %t, }) { priceQuote { clientID, sourceAcc, destinationAcc, rate, amount }, debitAmount, offerID, expires } }"
+		"query": "mutation { offerCrypto(input: { sourceAmount: %f, sourceCurrency:\"%s\", destinationCurrency:\"%s\", isPurchase: %t, }) { priceQuote { clientID, sourceAcc, destinationAcc, rate, amount }, debitAmount, fee, offerID, expires } }"
 		}`,
 
 		"exchangeCrypto": `{
 		"query": "mutation { exchangeCrypto(offerID: \"%s\") { fiatTxReceipt{ currency, amount, transactedAt, clientID, txID, }, cryptoTxReceipt{ ticker, amount, transactedAt, clientID, txID, }, } }"
 		}`,
 
+		"offerSwapCrypto": `{
+		"query": "mutation { offerSwapCrypto(input: { sourceAmount: %f, sourceCurrency:\"%s\", destinationCurrency:\"%s\", }) { priceQuote { clientID, sourceAcc, destinationAcc, rate, amount }, debitAmount, fee, offerID, expires } }"
+		}`,
+
+		"exchangeSwapCrypto": `{
+		"query": "mutation { exchangeSwapCrypto(offerID: \"%s\") { sourceReceipt{ ticker, amount, transactedAt, clientID, txID, }, destinationReceipt{ ticker, amount, transactedAt, clientID, txID, }, } }"
+		}`,
+
 		"balanceCrypto": `{
-		"query": "query { balanceCrypto(ticker: \"%s\") { ticker, balance, lastTx, lastTxTs, createdAt, clientID } }"
+		"query": "query { balanceCrypto(ticker: \"%s\") { ticker, balance, lastTx, lastTxTs, createdAt, clientID, status, held, available } }"
 		}`,
 
 		"balanceAllCrypto": `{
@@ -119,3 +170,208 @@ func getCryptoQuery() map[string]string {
 		}`,
 	}
 }
+
+// getOrdersQuery is a map of test limit order mutations and queries.
+//
+//nolint:lll
+func getOrdersQuery() map[string]string {
+	return map[string]string{
+		"placeOrder": `{
+		"query": "mutation { placeOrder(input: { sourceCurrency:\"%s\", destinationCurrency:\"%s\", sourceAmount: %f, limitRate: %f }) { orderID, clientID, source, destination, amount, limitRate, status, fillRate, fillAmount, fee, txID, createdAt, updatedAt } }"
+		}`,
+
+		"cancelOrder": `{
+		"query": "mutation { cancelOrder(orderID: \"%s\") { orderID, clientID, source, destination, amount, limitRate, status, fillRate, fillAmount, fee, txID, createdAt, updatedAt } }"
+		}`,
+
+		"orders": `{
+		"query": "query { orders(pageCursor: \"%s\", pageSize: %d) { orders { orderID, clientID, source, destination, amount, limitRate, status, fillRate, fillAmount, fee, txID, createdAt, updatedAt }, links { pageCursor } } }"
+		}`,
+
+		"ordersNoParams": `{
+		"query": "query { orders { orders { orderID, clientID, source, destination, amount, limitRate, status, txID }, links { pageCursor } } }"
+		}`,
+	}
+}
+
+// getSchedulesQuery is a map of test recurring purchase schedule mutations and queries.
+//
+//nolint:lll
+func getSchedulesQuery() map[string]string {
+	return map[string]string{
+		"createSchedule": `{
+		"query": "mutation { createSchedule(input: { fiatCurrency:\"%s\", ticker:\"%s\", amount: %f, frequency:\"%s\" }) { scheduleID, clientID, fiatCurrency, ticker, amount, frequency, nextRunAt, isActive, createdAt, updatedAt } }"
+		}`,
+
+		"updateSchedule": `{
+		"query": "mutation { updateSchedule(scheduleID: \"%s\", input: { amount: %f, frequency:\"%s\", isActive: %t }) { scheduleID, clientID, fiatCurrency, ticker, amount, frequency, nextRunAt, isActive, createdAt, updatedAt } }"
+		}`,
+
+		"deleteSchedule": `{
+		"query": "mutation { deleteSchedule(scheduleID: \"%s\") }"
+		}`,
+
+		"schedules": `{
+		"query": "query { schedules(pageCursor: \"%s\", pageSize: %d) { schedules { scheduleID, clientID, fiatCurrency, ticker, amount, frequency, nextRunAt, isActive, createdAt, updatedAt }, links { pageCursor } } }"
+		}`,
+
+		"schedulesNoParams": `{
+		"query": "query { schedules { schedules { scheduleID, fiatCurrency, ticker, amount, frequency, isActive }, links { pageCursor } } }"
+		}`,
+
+		"scheduleRuns": `{
+		"query": "query { scheduleRuns(scheduleID: \"%s\", pageCursor: \"%s\", pageSize: %d) { runs { runID, scheduleID, clientID, status, rate, fiatAmount, cryptoAmount, fee, txID, message, executedAt }, links { pageCursor } } }"
+		}`,
+	}
+}
+
+// getRatesQuery is a map of test rate history queries.
+//
+//nolint:lll
+func getRatesQuery() map[string]string {
+	return map[string]string{
+		"rateHistory": `{
+		"query": "query { rateHistory(input: { source:\"%s\", destination:\"%s\", from:\"%s\", to:\"%s\", interval:\"%s\" }) { source, destination, interval, candles { start, open, high, low, close, quotes } } }"
+		}`,
+	}
+}
+
+// getMFAQuery is a map of test multifactor authentication mutations.
+//
+//nolint:lll
+func getMFAQuery() map[string]string {
+	return map[string]string{
+		"loginUserMFA": `{
+		"query": "mutation { loginUserMFA(input: { challengeID:\"%s\", code:\"%s\" }) { token, expires, threshold } }"
+		}`,
+
+		"enrollMFA": `{
+		"query": "mutation { enrollMFA { secret, uri, recoveryCodes } }"
+		}`,
+
+		"verifyMFA": `{
+		"query": "mutation { verifyMFA(input: { code:\"%s\" }) }"
+		}`,
+
+		"disableMFA": `{
+		"query": "mutation { disableMFA(input: { code:\"%s\" }) }"
+		}`,
+
+		"regenerateRecoveryCodes": `{
+		"query": "mutation { regenerateRecoveryCodes(input: { code:\"%s\" }) { recoveryCodes } }"
+		}`,
+	}
+}
+
+// getAPIKeysQuery is a map of test API key mutations and queries.
+//
+//nolint:lll
+func getAPIKeysQuery() map[string]string {
+	return map[string]string{
+		"createAPIKey": `{
+		"query": "mutation { createAPIKey(input: { name:\"%s\", scopes: [\"%s\"] }) { key, apiKey { keyID, clientID, name, scopes, createdAt } } }"
+		}`,
+
+		"revokeAPIKey": `{
+		"query": "mutation { revokeAPIKey(keyID: \"%s\") }"
+		}`,
+
+		"apiKeys": `{
+		"query": "query { apiKeys { keyID, clientID, name, scopes, createdAt } }"
+		}`,
+	}
+}
+
+// getAdminQuery is a map of test administrator mutations and queries.
+//
+//nolint:lll
+func getAdminQuery() map[string]string {
+	return map[string]string{
+		"adminUser": `{
+		"query": "query { adminUser(username: \"%s\") { clientID, username, firstName, lastName, email, role, isFrozen, isDeleted } }"
+		}`,
+
+		"adminBalanceAllFiat": `{
+		"query": "query { adminBalanceAllFiat(clientID: \"%s\") { accountBalances { currency, balance, lastTx, lastTxTs, createdAt, clientID }, links { pageCursor } } }"
+		}`,
+
+		"adminTransactionDetailsAllFiat": `{
+		"query": "query { adminTransactionDetailsAllFiat(clientID: \"%s\", input: { currency: \"USD\", month: \"%s\", year: \"%s\" }) { transactions { currency, amount, transactedAt, clientID, txID }, links { pageCursor } } }"
+		}`,
+
+		"adminBalanceAllCrypto": `{
+		"query": "query { adminBalanceAllCrypto(clientID: \"%s\") { accountBalances { ticker, balance, lastTx, lastTxTs, createdAt, clientID }, links { pageCursor } } }"
+		}`,
+
+		"adminTransactionDetailsAllCrypto": `{
+		"query": "query { adminTransactionDetailsAllCrypto(clientID: \"%s\", input: { ticker: \"BTC\", month: \"%s\", year: \"%s\" }) { transactions { ticker, amount, transactedAt, clientID, txID }, links { pageCursor } } }"
+		}`,
+
+		"adminTransactionDetails": `{
+		"query": "query { adminTransactionDetails(clientID: \"%s\", transactionID: \"%s\") }"
+		}`,
+
+		"adminFreezeAccount": `{
+		"query": "mutation { adminFreezeAccount(clientID: \"%s\") }"
+		}`,
+
+		"adminUnfreezeAccount": `{
+		"query": "mutation { adminUnfreezeAccount(clientID: \"%s\") }"
+		}`,
+
+		"adminReverseDeposit": `{
+		"query": "mutation { adminReverseDeposit(clientID: \"%s\", transactionID: \"%s\") { txId, clientId, txTimestamp, balance, lastTx, currency } }"
+		}`,
+
+		"adminSetStatusFiat": `{
+		"query": "mutation { adminSetStatusFiat(clientID: \"%s\", input: { currency: \"%s\", status: \"%s\" }) { currency, balance, status, held, available } }"
+		}`,
+
+		"adminHoldFiat": `{
+		"query": "mutation { adminHoldFiat(clientID: \"%s\", input: { currency: \"%s\", amount: %f }) { currency, balance, status, held, available } }"
+		}`,
+
+		"adminReleaseFiat": `{
+		"query": "mutation { adminReleaseFiat(clientID: \"%s\", input: { currency: \"%s\", amount: %f }) { currency, balance, status, held, available } }"
+		}`,
+
+		"adminSetStatusCrypto": `{
+		"query": "mutation { adminSetStatusCrypto(clientID: \"%s\", input: { currency: \"%s\", status: \"%s\" }) { ticker, balance, status, held, available } }"
+		}`,
+
+		"adminHoldCrypto": `{
+		"query": "mutation { adminHoldCrypto(clientID: \"%s\", input: { currency: \"%s\", amount: %f }) { ticker, balance, status, held, available } }"
+		}`,
+
+		"adminReleaseCrypto": `{
+		"query": "mutation { adminReleaseCrypto(clientID: \"%s\", input: { currency: \"%s\", amount: %f }) { ticker, balance, status, held, available } }"
+		}`,
+	}
+}
+
+// getWebhooksQuery is a map of test webhook mutations and queries.
+//
+//nolint:lll
+func getWebhooksQuery() map[string]string {
+	return map[string]string{
+		"createWebhook": `{
+		"query": "mutation { createWebhook(input: { url:\"%s\" }) { secret, webhook { webhookID, clientID, url, createdAt } } }"
+		}`,
+
+		"deleteWebhook": `{
+		"query": "mutation { deleteWebhook(webhookID: \"%s\") }"
+		}`,
+
+		"webhooks": `{
+		"query": "query { webhooks { webhookID, clientID, url, createdAt } }"
+		}`,
+
+		"webhookDeadLetters": `{
+		"query": "query { webhookDeadLetters(pageCursor: \"%s\", pageSize: %d) { deadLetters { deliveryID, eventID, webhookID, url, eventType, payload, attempts, lastError, createdAt, failedAt }, links { pageCursor } } }"
+		}`,
+
+		"webhookDeadLettersNoParams": `{
+		"query": "query { webhookDeadLetters { deadLetters { deliveryID, eventID, webhookID, url, eventType, payload, attempts, lastError, createdAt, failedAt }, links { pageCursor } } }"
+		}`,
+	}
+}
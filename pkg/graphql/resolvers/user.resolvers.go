@@ -2,21 +2,40 @@ package graphql
 
 // This file will be automatically regenerated based on the schema, any resolver implementations
 // will be copied through when generating and any unknown code will be moved to the end.
-// Code generated by github.com/99designs/gqlgen version v0.17.49
+// Code generated by github.com/99designs/gqlgen version v0.17.63
 
 import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
+	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
 	"github.com/surahman/FTeX/pkg/common"
 	graphql_generated "github.com/surahman/FTeX/pkg/graphql/generated"
 	"github.com/surahman/FTeX/pkg/models"
 	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
 	"github.com/surahman/FTeX/pkg/validator"
 )
 
+// EventID is the resolver for the eventID field.
+func (r *loginEventResolver) EventID(ctx context.Context, obj *postgres.LoginEvent) (string, error) {
+	return obj.EventID.String(), nil
+}
+
+// Outcome is the resolver for the outcome field.
+func (r *loginEventResolver) Outcome(ctx context.Context, obj *postgres.LoginEvent) (string, error) {
+	return string(obj.Outcome), nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *loginEventResolver) CreatedAt(ctx context.Context, obj *postgres.LoginEvent) (string, error) {
+	return obj.CreatedAt.Time.String(), nil
+}
+
 // RegisterUser is the resolver for the registerUser field.
 func (r *mutationResolver) RegisterUser(ctx context.Context, input *modelsPostgres.UserAccount) (*models.JWTAuthResponse, error) {
 	var (
@@ -44,7 +63,7 @@ func (r *mutationResolver) DeleteUser(ctx context.Context, input models.HTTPDele
 
 	// Validate the JWT and extract the clientID. Compare the clientID against the deletion request login
 	// credentials.
-	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpDeletes, auth.ScopeNone); err != nil {
 		return "", errors.New("authorization failure")
 	}
 
@@ -56,23 +75,29 @@ func (r *mutationResolver) DeleteUser(ctx context.Context, input models.HTTPDele
 }
 
 // LoginUser is the resolver for the loginUser field.
-func (r *mutationResolver) LoginUser(ctx context.Context, input modelsPostgres.UserLoginCredentials) (*models.JWTAuthResponse, error) {
+func (r *mutationResolver) LoginUser(ctx context.Context, input modelsPostgres.UserLoginCredentials) (*models.LoginResponse, error) {
 	var (
-		err       error
-		authToken *models.JWTAuthResponse
-		httpMsg   string
-		payload   any
+		err        error
+		ginContext *gin.Context
+		response   models.LoginResponse
+		httpMsg    string
+		payload    any
 	)
 
 	if err = validator.ValidateStruct(&input); err != nil {
 		return nil, fmt.Errorf("validation %w", err)
 	}
 
-	if authToken, httpMsg, _, payload, err = common.HTTPLoginUser(r.auth, r.db, r.logger, &input); err != nil {
+	if ginContext, err = GinContextFromContext(ctx, r.logger); err != nil {
+		return nil, errors.New("malformed request")
+	}
+
+	if response.Token, response.MfaChallenge, httpMsg, _, payload, err = common.HTTPLoginUser(r.auth, r.cache, r.db,
+		r.logger, &input, ginContext.ClientIP(), ginContext.Request.UserAgent()); err != nil {
 		return nil, fmt.Errorf("%s: %v", httpMsg, payload)
 	}
 
-	return authToken, nil
+	return &response, nil
 }
 
 // RefreshToken is the resolver for the refreshToken field.
@@ -87,7 +112,7 @@ func (r *mutationResolver) RefreshToken(ctx context.Context) (*models.JWTAuthRes
 
 	// Validate the JWT and extract the clientID. Compare the clientID against the deletion request login
 	// credentials.
-	if clientID, expiresAt, err = AuthorizationCheck(ctx, r.auth, r.db, r.logger, r.authHeaderKey); err != nil {
+	if clientID, expiresAt, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeNone); err != nil {
 		return freshToken, errors.New("authorization failure")
 	}
 
@@ -99,7 +124,135 @@ func (r *mutationResolver) RefreshToken(ctx context.Context) (*models.JWTAuthRes
 	return freshToken, nil
 }
 
+// LogoutUser is the resolver for the logoutUser field.
+func (r *mutationResolver) LogoutUser(ctx context.Context) (string, error) {
+	var (
+		err        error
+		expiresAt  int64
+		ginContext *gin.Context
+		httpMsg    string
+	)
+
+	if _, expiresAt, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeNone); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if ginContext, err = GinContextFromContext(ctx, r.logger); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if httpMsg, _, err = common.HTTPLogout(r.auth, r.cache, r.logger, ginContext.GetHeader(r.authHeaderKey), expiresAt); err != nil {
+		return "", errors.New(httpMsg)
+	}
+
+	return "logged out", nil
+}
+
+// LogoutUserEverywhere is the resolver for the logoutUserEverywhere field.
+func (r *mutationResolver) LogoutUserEverywhere(ctx context.Context) (string, error) {
+	var (
+		err      error
+		clientID uuid.UUID
+		httpMsg  string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeNone); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if httpMsg, _, err = common.HTTPLogoutEverywhere(r.auth, r.cache, r.logger, clientID); err != nil {
+		return "", errors.New(httpMsg)
+	}
+
+	return "logged out of all sessions", nil
+}
+
+// ChangePassword is the resolver for the changePassword field.
+func (r *mutationResolver) ChangePassword(ctx context.Context, input models.HTTPChangePasswordRequest) (string, error) {
+	var (
+		err      error
+		clientID uuid.UUID
+		httpMsg  string
+		payload  any
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeNone); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if httpMsg, _, payload, err = common.HTTPChangePassword(r.auth, r.cache, r.db, r.logger, clientID, &input); err != nil {
+		return "", fmt.Errorf("%s: %v", httpMsg, payload)
+	}
+
+	return "password changed, please log in again", nil
+}
+
+// RequestPasswordReset is the resolver for the requestPasswordReset field.
+func (r *mutationResolver) RequestPasswordReset(ctx context.Context, input models.HTTPPasswordResetRequest) (*models.HTTPPasswordResetResponse, error) {
+	var (
+		err      error
+		response *models.HTTPPasswordResetResponse
+		httpMsg  string
+		payload  any
+	)
+
+	if response, httpMsg, _, payload, err =
+		common.HTTPRequestPasswordReset(r.auth, r.cache, r.db, r.notify, r.logger, &input); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMsg, payload)
+	}
+
+	return response, nil
+}
+
+// ResetPassword is the resolver for the resetPassword field.
+func (r *mutationResolver) ResetPassword(ctx context.Context, input models.HTTPResetPasswordRequest) (string, error) {
+	var (
+		err     error
+		httpMsg string
+		payload any
+	)
+
+	if httpMsg, _, payload, err = common.HTTPResetPassword(r.auth, r.cache, r.db, r.logger, &input); err != nil {
+		return "", fmt.Errorf("%s: %v", httpMsg, payload)
+	}
+
+	return "password reset, please log in", nil
+}
+
+// LoginEvents is the resolver for the loginEvents field.
+func (r *queryResolver) LoginEvents(ctx context.Context, pageCursor *string, pageSize *int32) (*models.HTTPLoginEventsPaginated, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		events      models.HTTPLoginEventsPaginated
+	)
+
+	if pageSize == nil {
+		pageSize = new(int32)
+	}
+
+	if pageCursor == nil {
+		pageCursor = new(string)
+	}
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeNone); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if events, _, httpMessage, err = common.HTTPLoginEventsPaginated(r.auth, r.db, r.logger,
+		clientID, *pageCursor, strconv.Itoa(int(*pageSize)), false); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return &events, nil
+}
+
+// LoginEvent returns graphql_generated.LoginEventResolver implementation.
+func (r *Resolver) LoginEvent() graphql_generated.LoginEventResolver { return &loginEventResolver{r} }
+
 // Mutation returns graphql_generated.MutationResolver implementation.
 func (r *Resolver) Mutation() graphql_generated.MutationResolver { return &mutationResolver{r} }
 
+type loginEventResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
@@ -16,11 +16,13 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/rs/xid"
 	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/mocks"
 	"github.com/surahman/FTeX/pkg/models"
 	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
 	"github.com/surahman/FTeX/pkg/postgres"
 	"github.com/surahman/FTeX/pkg/quotes"
+	"github.com/surahman/FTeX/pkg/redis"
 )
 
 func TestUserResolver_RegisterUser(t *testing.T) {
@@ -110,8 +112,9 @@ func TestUserResolver_RegisterUser(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
-			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)       // Not called.
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				mockAuth.EXPECT().HashPassword(gomock.Any()).
@@ -129,7 +132,8 @@ func TestUserResolver_RegisterUser(t *testing.T) {
 
 			// Endpoint setup for test.
 			router := gin.Default()
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBufferString(test.user))
 			req.Header.Set("Content-Type", "application/json")
@@ -385,8 +389,9 @@ func TestUserResolver_DeleteUser(t *testing.T) { //nolint:maintidx
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
-			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
 
 			authToken := xid.New().String()
 
@@ -395,10 +400,22 @@ func TestUserResolver_DeleteUser(t *testing.T) { //nolint:maintidx
 					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
 					Return(test.isDeletedValue, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
 					Return(test.readUserData, test.readUserErr).
 					Times(test.readUserTimes),
@@ -415,7 +432,8 @@ func TestUserResolver_DeleteUser(t *testing.T) { //nolint:maintidx
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBufferString(test.query))
 			req.Header.Set("Content-Type", "application/json")
@@ -459,6 +477,10 @@ func TestUserResolver_LoginUser(t *testing.T) {
 		userCredentialsTimes   int
 		authCheckPassErr       error
 		authCheckPassTimes     int
+		mfaGetEnrollment       postgres.MfaEnrollment
+		mfaGetErr              error
+		mfaGetTimes            int
+		redisSetTimes          int
 		authGenJWTErr          error
 		authGenJWTTimes        int
 	}{
@@ -471,6 +493,10 @@ func TestUserResolver_LoginUser(t *testing.T) {
 			userCredentialsTimes:   0,
 			authCheckPassErr:       nil,
 			authCheckPassTimes:     0,
+			mfaGetEnrollment:       postgres.MfaEnrollment{},
+			mfaGetErr:              postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:            0,
+			redisSetTimes:          0,
 			authGenJWTErr:          nil,
 			authGenJWTTimes:        0,
 		}, {
@@ -482,6 +508,10 @@ func TestUserResolver_LoginUser(t *testing.T) {
 			userCredentialsTimes:   1,
 			authCheckPassErr:       nil,
 			authCheckPassTimes:     1,
+			mfaGetEnrollment:       postgres.MfaEnrollment{},
+			mfaGetErr:              postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:            1,
+			redisSetTimes:          0,
 			authGenJWTErr:          nil,
 			authGenJWTTimes:        1,
 		},
@@ -494,6 +524,10 @@ func TestUserResolver_LoginUser(t *testing.T) {
 			userCredentialsTimes:   1,
 			authCheckPassErr:       nil,
 			authCheckPassTimes:     0,
+			mfaGetEnrollment:       postgres.MfaEnrollment{},
+			mfaGetErr:              postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:            0,
+			redisSetTimes:          0,
 			authGenJWTErr:          nil,
 			authGenJWTTimes:        0,
 		}, {
@@ -505,6 +539,10 @@ func TestUserResolver_LoginUser(t *testing.T) {
 			userCredentialsTimes:   1,
 			authCheckPassErr:       errors.New("password hash error"),
 			authCheckPassTimes:     1,
+			mfaGetEnrollment:       postgres.MfaEnrollment{},
+			mfaGetErr:              postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:            0,
+			redisSetTimes:          0,
 			authGenJWTErr:          nil,
 			authGenJWTTimes:        0,
 		}, {
@@ -516,8 +554,42 @@ func TestUserResolver_LoginUser(t *testing.T) {
 			userCredentialsTimes:   1,
 			authCheckPassErr:       nil,
 			authCheckPassTimes:     1,
+			mfaGetEnrollment:       postgres.MfaEnrollment{},
+			mfaGetErr:              postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:            1,
+			redisSetTimes:          0,
 			authGenJWTErr:          errors.New("auth token failure"),
 			authGenJWTTimes:        1,
+		}, {
+			name:                   "mfa challenge",
+			path:                   "/login/mfa-challenge",
+			user:                   fmt.Sprintf(testUserQuery["login"], "username999", "password999"),
+			expectErr:              false,
+			userCredentialsReadErr: nil,
+			userCredentialsTimes:   1,
+			authCheckPassErr:       nil,
+			authCheckPassTimes:     1,
+			mfaGetEnrollment:       postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetErr:              nil,
+			mfaGetTimes:            1,
+			redisSetTimes:          1,
+			authGenJWTErr:          nil,
+			authGenJWTTimes:        0,
+		}, {
+			name:                   "mfa enrollment failure",
+			path:                   "/login/mfa-enrollment-failure",
+			user:                   fmt.Sprintf(testUserQuery["login"], "username999", "password999"),
+			expectErr:              true,
+			userCredentialsReadErr: nil,
+			userCredentialsTimes:   1,
+			authCheckPassErr:       nil,
+			authCheckPassTimes:     1,
+			mfaGetEnrollment:       postgres.MfaEnrollment{},
+			mfaGetErr:              postgres.ErrTransactMFA,
+			mfaGetTimes:            1,
+			redisSetTimes:          0,
+			authGenJWTErr:          nil,
+			authGenJWTTimes:        0,
 		},
 	}
 
@@ -532,8 +604,18 @@ func TestUserResolver_LoginUser(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
-			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			// Login lockout bookkeeping is exercised in the common package.
+			mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).Return(redis.ErrCacheMiss).AnyTimes()
+			mockRedis.EXPECT().Incr(gomock.Any(), gomock.Any()).Return(int64(1), nil).AnyTimes()
+			mockRedis.EXPECT().Del(gomock.Any()).Return(nil).AnyTimes()
+			mockAuth.EXPECT().LoginFailureWindow().Return(24 * time.Hour).AnyTimes()
+			mockAuth.EXPECT().LoginLockout(gomock.Any()).Return(time.Duration(0)).AnyTimes()
+			mockPostgres.EXPECT().LoginEventCreate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil).AnyTimes()
 
 			gomock.InOrder(
 				mockPostgres.EXPECT().UserCredentials(gomock.Any()).
@@ -544,6 +626,22 @@ func TestUserResolver_LoginUser(t *testing.T) {
 					Return(test.authCheckPassErr).
 					Times(test.authCheckPassTimes),
 
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(test.mfaGetEnrollment, test.mfaGetErr).
+					Times(test.mfaGetTimes),
+
+				mockAuth.EXPECT().MFAChallengeExpiration().
+					Return(int64(300)).
+					Times(test.redisSetTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-challenge-id", nil).
+					Times(test.redisSetTimes),
+
+				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(test.redisSetTimes),
+
 				mockAuth.EXPECT().GenerateJWT(gomock.Any()).
 					Return(&models.JWTAuthResponse{}, test.authGenJWTErr).
 					Times(test.authGenJWTTimes),
@@ -551,7 +649,9 @@ func TestUserResolver_LoginUser(t *testing.T) {
 
 			// Endpoint setup for test.
 			router := gin.Default()
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBufferString(test.user))
 			req.Header.Set("Content-Type", "application/json")
@@ -773,8 +873,9 @@ func TestUserResolver_RefreshToken(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
-			mockRedis := mocks.NewMockRedis(mockCtrl)    // Not called.
-			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
 
 			gomock.InOrder(
 				// JWT check.
@@ -782,10 +883,22 @@ func TestUserResolver_RefreshToken(t *testing.T) {
 					Return(uuid.UUID{}, test.authValidateJWTExp, test.authValidateJWTErr).
 					Times(test.authValidateJWTTimes),
 
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
 				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
 					Return(test.isDeletedValue, test.isDeletedError).
 					Times(test.isDeletedTimes),
 
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
 				// Database call for user record.
 				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
 					Return(test.userGetInfoData, test.userGetInfoErr).
@@ -805,7 +918,8 @@ func TestUserResolver_RefreshToken(t *testing.T) {
 			// Endpoint setup for test.
 			router := gin.Default()
 			router.Use(GinContextToContextMiddleware())
-			router.POST(test.path, QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, zapLogger))
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
 
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
 				bytes.NewBufferString(testUserQuery["refresh"]))
@@ -829,3 +943,868 @@ func TestUserResolver_RefreshToken(t *testing.T) {
 		})
 	}
 }
+
+func TestUserResolver_LogoutUser(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		redisSetErr          error
+		redisSetTimes        int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/logout/invalid-jwt",
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid token"),
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       0,
+			redisSetErr:          nil,
+			redisSetTimes:        0,
+		}, {
+			name:                 "cache failure",
+			path:                 "/logout/cache-failure",
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			redisSetErr:          redis.ErrCacheSet,
+			redisSetTimes:        1,
+		}, {
+			name:                 "valid",
+			path:                 "/logout/valid",
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			redisSetErr:          nil,
+			redisSetTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, time.Now().Add(time.Minute).Unix(), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					Times(test.redisSetTimes),
+
+				mockRedis.EXPECT().Set(constants.RevokedSessionKeyPrefix()+"session-id", true, gomock.Any()).
+					Return(test.redisSetErr).
+					Times(test.redisSetTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(testUserQuery["logout"]))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestUserResolver_LogoutUserEverywhere(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		redisSetErr          error
+		redisSetTimes        int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/logout-everywhere/invalid-jwt",
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid token"),
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       0,
+			redisSetErr:          nil,
+			redisSetTimes:        0,
+		}, {
+			name:                 "cache failure",
+			path:                 "/logout-everywhere/cache-failure",
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			redisSetErr:          redis.ErrCacheSet,
+			redisSetTimes:        1,
+		}, {
+			name:                 "valid",
+			path:                 "/logout-everywhere/valid",
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			redisSetErr:          nil,
+			redisSetTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, time.Now().Add(time.Minute).Unix(), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockAuth.EXPECT().ExpirationDuration().
+					Return(int64(600)).
+					Times(test.redisSetTimes),
+
+				mockRedis.EXPECT().Set(revocationKey{}, gomock.Any(), gomock.Any()).
+					Return(test.redisSetErr).
+					Times(test.redisSetTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(testUserQuery["logoutEverywhere"]))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestUserResolver_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		userGetInfoTimes     int
+		authCheckPwdErr      error
+		authCheckPwdTimes    int
+		updatePwdTimes       int
+		redisSetErr          error
+		redisSetTimes        int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/change-password/invalid-jwt",
+			query:                fmt.Sprintf(testUserQuery["changePassword"], "current-password", "new-password"),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid token"),
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       0,
+			userGetInfoTimes:     0,
+			authCheckPwdErr:      nil,
+			authCheckPwdTimes:    0,
+			updatePwdTimes:       0,
+			redisSetErr:          nil,
+			redisSetTimes:        0,
+		}, {
+			name:                 "empty request",
+			path:                 "/change-password/empty-request",
+			query:                fmt.Sprintf(testUserQuery["changePassword"], "", ""),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			userGetInfoTimes:     0,
+			authCheckPwdErr:      nil,
+			authCheckPwdTimes:    0,
+			updatePwdTimes:       0,
+			redisSetErr:          nil,
+			redisSetTimes:        0,
+		}, {
+			name:                 "invalid current password",
+			path:                 "/change-password/invalid-current-password",
+			query:                fmt.Sprintf(testUserQuery["changePassword"], "current-password", "new-password"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			userGetInfoTimes:     1,
+			authCheckPwdErr:      errors.New("invalid password"),
+			authCheckPwdTimes:    1,
+			updatePwdTimes:       0,
+			redisSetErr:          nil,
+			redisSetTimes:        0,
+		}, {
+			name:                 "session revocation failure",
+			path:                 "/change-password/session-revocation-failure",
+			query:                fmt.Sprintf(testUserQuery["changePassword"], "current-password", "new-password"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			userGetInfoTimes:     1,
+			authCheckPwdErr:      nil,
+			authCheckPwdTimes:    1,
+			updatePwdTimes:       1,
+			redisSetErr:          redis.ErrCacheSet,
+			redisSetTimes:        1,
+		}, {
+			name:                 "valid",
+			path:                 "/change-password/valid",
+			query:                fmt.Sprintf(testUserQuery["changePassword"], "current-password", "new-password"),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			userGetInfoTimes:     1,
+			authCheckPwdErr:      nil,
+			authCheckPwdTimes:    1,
+			updatePwdTimes:       1,
+			redisSetErr:          nil,
+			redisSetTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, time.Now().Add(time.Minute).Unix(), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{}}, nil).
+					Times(test.userGetInfoTimes),
+
+				mockAuth.EXPECT().CheckPassword(gomock.Any(), "current-password").
+					Return(test.authCheckPwdErr).
+					Times(test.authCheckPwdTimes),
+
+				mockAuth.EXPECT().HashPassword("new-password").
+					Return("new-hashed-password", nil).
+					Times(test.updatePwdTimes),
+
+				mockPostgres.EXPECT().UserUpdatePassword(gomock.Any(), "new-hashed-password").
+					Return(nil).
+					Times(test.updatePwdTimes),
+
+				mockAuth.EXPECT().ExpirationDuration().
+					Return(int64(600)).
+					Times(test.redisSetTimes),
+
+				mockRedis.EXPECT().Set(revocationKey{}, gomock.Any(), gomock.Any()).
+					Return(test.redisSetErr).
+					Times(test.redisSetTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestUserResolver_RequestPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		path             string
+		query            string
+		expectErr        bool
+		clientIDErr      error
+		clientIDTimes    int
+		userGetInfoTimes int
+		redisSetErr      error
+		redisSetTimes    int
+		notifyErr        error
+		notifyTimes      int
+	}{
+		{
+			name:             "empty request",
+			path:             "/request-password-reset/empty-request",
+			query:            fmt.Sprintf(testUserQuery["requestPasswordReset"], ""),
+			expectErr:        true,
+			clientIDErr:      nil,
+			clientIDTimes:    0,
+			userGetInfoTimes: 0,
+			redisSetErr:      nil,
+			redisSetTimes:    0,
+			notifyErr:        nil,
+			notifyTimes:      0,
+		}, {
+			name:             "unknown user",
+			path:             "/request-password-reset/unknown-user",
+			query:            fmt.Sprintf(testUserQuery["requestPasswordReset"], "username1"),
+			expectErr:        false,
+			clientIDErr:      postgres.ErrNotFoundUser,
+			clientIDTimes:    1,
+			userGetInfoTimes: 0,
+			redisSetErr:      nil,
+			redisSetTimes:    0,
+			notifyErr:        nil,
+			notifyTimes:      0,
+		}, {
+			name:             "cache failure",
+			path:             "/request-password-reset/cache-failure",
+			query:            fmt.Sprintf(testUserQuery["requestPasswordReset"], "username1"),
+			expectErr:        true,
+			clientIDErr:      nil,
+			clientIDTimes:    1,
+			userGetInfoTimes: 1,
+			redisSetErr:      redis.ErrCacheSet,
+			redisSetTimes:    1,
+			notifyErr:        nil,
+			notifyTimes:      0,
+		}, {
+			name:             "notification failure",
+			path:             "/request-password-reset/notification-failure",
+			query:            fmt.Sprintf(testUserQuery["requestPasswordReset"], "username1"),
+			expectErr:        true,
+			clientIDErr:      nil,
+			clientIDTimes:    1,
+			userGetInfoTimes: 1,
+			redisSetErr:      nil,
+			redisSetTimes:    1,
+			notifyErr:        errors.New("notification failure"),
+			notifyTimes:      1,
+		}, {
+			name:             "valid",
+			path:             "/request-password-reset/valid",
+			query:            fmt.Sprintf(testUserQuery["requestPasswordReset"], "username1"),
+			expectErr:        false,
+			clientIDErr:      nil,
+			clientIDTimes:    1,
+			userGetInfoTimes: 1,
+			redisSetErr:      nil,
+			redisSetTimes:    1,
+			notifyErr:        nil,
+			notifyTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl) // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl)
+
+			gomock.InOrder(
+				mockPostgres.EXPECT().UserGetClientID("username1").
+					Return(uuid.UUID{}, test.clientIDErr).
+					Times(test.clientIDTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{}}, nil).
+					Times(test.userGetInfoTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-token", nil).
+					Times(test.userGetInfoTimes),
+
+				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any(), constants.PasswordResetTTL()).
+					Return(test.redisSetErr).
+					Times(test.redisSetTimes),
+
+				mockNotifier.EXPECT().PasswordReset(gomock.Any(), "encrypted-token", gomock.Any()).
+					Return(test.notifyErr).
+					Times(test.notifyTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			} else {
+				data, ok := response["data"].(map[string]any)
+				require.True(t, ok, "data key expected but not set")
+				require.NotNil(t, data["requestPasswordReset"], "password reset response not set")
+			}
+		})
+	}
+}
+
+func TestUserResolver_ResetPassword(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		path           string
+		query          string
+		expectErr      bool
+		decryptErr     error
+		decryptTimes   int
+		redisGetErr    error
+		redisGetTimes  int
+		updatePwdTimes int
+		redisSetErr    error
+		redisSetTimes  int
+	}{
+		{
+			name:           "empty request",
+			path:           "/reset-password/empty-request",
+			query:          fmt.Sprintf(testUserQuery["resetPassword"], "", ""),
+			expectErr:      true,
+			decryptErr:     nil,
+			decryptTimes:   0,
+			redisGetErr:    nil,
+			redisGetTimes:  0,
+			updatePwdTimes: 0,
+			redisSetErr:    nil,
+			redisSetTimes:  0,
+		}, {
+			name:           "invalid token",
+			path:           "/reset-password/invalid-token",
+			query:          fmt.Sprintf(testUserQuery["resetPassword"], "encrypted-token", "new-password"),
+			expectErr:      true,
+			decryptErr:     errors.New("decryption failure"),
+			decryptTimes:   1,
+			redisGetErr:    nil,
+			redisGetTimes:  0,
+			updatePwdTimes: 0,
+			redisSetErr:    nil,
+			redisSetTimes:  0,
+		}, {
+			name:           "expired token",
+			path:           "/reset-password/expired-token",
+			query:          fmt.Sprintf(testUserQuery["resetPassword"], "encrypted-token", "new-password"),
+			expectErr:      true,
+			decryptErr:     nil,
+			decryptTimes:   1,
+			redisGetErr:    redis.ErrCacheMiss,
+			redisGetTimes:  1,
+			updatePwdTimes: 0,
+			redisSetErr:    nil,
+			redisSetTimes:  0,
+		}, {
+			name:           "session revocation failure",
+			path:           "/reset-password/session-revocation-failure",
+			query:          fmt.Sprintf(testUserQuery["resetPassword"], "encrypted-token", "new-password"),
+			expectErr:      true,
+			decryptErr:     nil,
+			decryptTimes:   1,
+			redisGetErr:    nil,
+			redisGetTimes:  1,
+			updatePwdTimes: 1,
+			redisSetErr:    redis.ErrCacheSet,
+			redisSetTimes:  1,
+		}, {
+			name:           "valid",
+			path:           "/reset-password/valid",
+			query:          fmt.Sprintf(testUserQuery["resetPassword"], "encrypted-token", "new-password"),
+			expectErr:      false,
+			decryptErr:     nil,
+			decryptTimes:   1,
+			redisGetErr:    nil,
+			redisGetTimes:  1,
+			updatePwdTimes: 1,
+			redisSetErr:    nil,
+			redisSetTimes:  1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			tokenKey := constants.PasswordResetKeyPrefix() + "token-id"
+
+			gomock.InOrder(
+				mockAuth.EXPECT().DecryptFromString("encrypted-token").
+					Return([]byte("token-id"), test.decryptErr).
+					Times(test.decryptTimes),
+
+				mockRedis.EXPECT().Get(tokenKey, gomock.Any()).
+					Return(test.redisGetErr).
+					Times(test.redisGetTimes),
+
+				mockAuth.EXPECT().HashPassword("new-password").
+					Return("new-hashed-password", nil).
+					Times(test.updatePwdTimes),
+
+				mockRedis.EXPECT().Del(tokenKey).
+					Return(nil).
+					Times(test.updatePwdTimes),
+
+				mockPostgres.EXPECT().UserUpdatePassword(gomock.Any(), "new-hashed-password").
+					Return(nil).
+					Times(test.updatePwdTimes),
+
+				mockAuth.EXPECT().ExpirationDuration().
+					Return(int64(600)).
+					Times(test.redisSetTimes),
+
+				mockRedis.EXPECT().Set(revocationKey{}, gomock.Any(), gomock.Any()).
+					Return(test.redisSetErr).
+					Times(test.redisSetTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestUserResolver_LoginEvents(t *testing.T) {
+	t.Parallel()
+
+	events := []postgres.LoginEvent{{}, {}, {}, {}}
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		authDecryptErr       error
+		authDecryptTimes     int
+		eventsErr            error
+		eventsTimes          int
+		authEncryptTimes     int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/login-events/invalid-jwt",
+			query:                fmt.Sprintf(testUserQuery["loginEvents"], "page-cursor", 3),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+		}, {
+			name:                 "decrypt cursor failure",
+			path:                 "/login-events/decrypt-cursor-failure",
+			query:                fmt.Sprintf(testUserQuery["loginEvents"], "page-cursor", 3),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			authDecryptErr:       errors.New("decrypt failure"),
+			authDecryptTimes:     1,
+		}, {
+			name:                 "login events failure",
+			path:                 "/login-events/login-events-failure",
+			query:                fmt.Sprintf(testUserQuery["loginEvents"], "page-cursor", 3),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			authDecryptTimes:     1,
+			eventsErr:            postgres.ErrNotFound,
+			eventsTimes:          1,
+		}, {
+			name:                 "valid",
+			path:                 "/login-events/valid",
+			query:                fmt.Sprintf(testUserQuery["loginEvents"], "page-cursor", 3),
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			authDecryptTimes:     1,
+			eventsTimes:          1,
+			authEncryptTimes:     1,
+		}, {
+			name:                 "valid no params",
+			path:                 "/login-events/valid-no-params",
+			query:                testUserQuery["loginEventsNoParams"],
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			eventsTimes:          1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(false, nil).
+					AnyTimes(),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("3"), test.authDecryptErr).
+					Times(test.authDecryptTimes),
+
+				mockPostgres.EXPECT().LoginEventsPaginated(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(events, test.eventsErr).
+					Times(test.eventsTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-page-cursor", nil).
+					Times(test.authEncryptTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
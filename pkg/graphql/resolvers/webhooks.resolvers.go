@@ -0,0 +1,176 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.63
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	graphql_generated "github.com/surahman/FTeX/pkg/graphql/generated"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// CreateWebhook is the resolver for the createWebhook field.
+func (r *mutationResolver) CreateWebhook(ctx context.Context, input models.HTTPWebhookRequest) (*models.HTTPWebhookResponse, error) {
+	var (
+		clientID uuid.UUID
+		err      error
+		httpMsg  string
+		payload  any
+		webhook  *models.HTTPWebhookResponse
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeNone); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if webhook, httpMsg, _, payload, err = common.HTTPWebhookCreate(r.auth, r.db, r.logger, clientID, &input); err != nil {
+		return nil, fmt.Errorf("%s: %v", httpMsg, payload)
+	}
+
+	return webhook, nil
+}
+
+// DeleteWebhook is the resolver for the deleteWebhook field.
+func (r *mutationResolver) DeleteWebhook(ctx context.Context, webhookID string) (string, error) {
+	var (
+		clientID uuid.UUID
+		err      error
+		httpMsg  string
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeNone); err != nil {
+		return "", errors.New("authorization failure")
+	}
+
+	if httpMsg, _, err = common.HTTPWebhookDelete(r.db, r.logger, clientID, webhookID); err != nil {
+		return "", errors.New(httpMsg)
+	}
+
+	return webhookID, nil
+}
+
+// Webhooks is the resolver for the webhooks field.
+func (r *queryResolver) Webhooks(ctx context.Context) ([]postgres.Webhook, error) {
+	var (
+		clientID uuid.UUID
+		err      error
+		httpMsg  string
+		webhooks *models.HTTPWebhooksResponse
+	)
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeNone); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if webhooks, httpMsg, _, err = common.HTTPWebhookList(r.db, r.logger, clientID); err != nil {
+		return nil, errors.New(httpMsg)
+	}
+
+	return webhooks.Webhooks, nil
+}
+
+// WebhookDeadLetters is the resolver for the webhookDeadLetters field.
+func (r *queryResolver) WebhookDeadLetters(ctx context.Context, pageCursor *string, pageSize *int32) (*models.HTTPWebhookDeadLettersPaginated, error) {
+	var (
+		clientID    uuid.UUID
+		err         error
+		httpMessage string
+		deadLetters models.HTTPWebhookDeadLettersPaginated
+	)
+
+	if pageSize == nil {
+		pageSize = new(int32)
+	}
+
+	if pageCursor == nil {
+		pageCursor = new(string)
+	}
+
+	if clientID, _, err = AuthorizationCheck(ctx, r.auth, r.cache, r.db, r.logger, r.authHeaderKey, auth.StepUpNone, auth.ScopeNone); err != nil {
+		return nil, errors.New("authorization failure")
+	}
+
+	if deadLetters, _, httpMessage, err = common.HTTPWebhookDeadLettersPaginated(r.auth, r.db, r.logger,
+		clientID, *pageCursor, strconv.Itoa(int(*pageSize)), false); err != nil {
+		return nil, errors.New(httpMessage)
+	}
+
+	return &deadLetters, nil
+}
+
+// WebhookID is the resolver for the webhookID field.
+func (r *webhookResolver) WebhookID(ctx context.Context, obj *postgres.Webhook) (string, error) {
+	return obj.WebhookID.String(), nil
+}
+
+// ClientID is the resolver for the clientID field.
+func (r *webhookResolver) ClientID(ctx context.Context, obj *postgres.Webhook) (string, error) {
+	return obj.ClientID.String(), nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *webhookResolver) CreatedAt(ctx context.Context, obj *postgres.Webhook) (string, error) {
+	return obj.CreatedAt.Time.String(), nil
+}
+
+// DeliveryID is the resolver for the deliveryID field.
+func (r *webhookDeadLetterResolver) DeliveryID(ctx context.Context, obj *postgres.WebhookDeadLetter) (string, error) {
+	return obj.DeliveryID.String(), nil
+}
+
+// EventID is the resolver for the eventID field.
+func (r *webhookDeadLetterResolver) EventID(ctx context.Context, obj *postgres.WebhookDeadLetter) (string, error) {
+	return obj.EventID.String(), nil
+}
+
+// WebhookID is the resolver for the webhookID field.
+func (r *webhookDeadLetterResolver) WebhookID(ctx context.Context, obj *postgres.WebhookDeadLetter) (string, error) {
+	return obj.WebhookID.String(), nil
+}
+
+// EventType is the resolver for the eventType field.
+func (r *webhookDeadLetterResolver) EventType(ctx context.Context, obj *postgres.WebhookDeadLetter) (string, error) {
+	return string(obj.EventType), nil
+}
+
+// Payload is the resolver for the payload field.
+func (r *webhookDeadLetterResolver) Payload(ctx context.Context, obj *postgres.WebhookDeadLetter) (any, error) {
+	var payload any
+
+	if err := json.Unmarshal(obj.Payload, &payload); err != nil {
+		return nil, errors.New("failed to unpack event payload")
+	}
+
+	return payload, nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *webhookDeadLetterResolver) CreatedAt(ctx context.Context, obj *postgres.WebhookDeadLetter) (string, error) {
+	return obj.CreatedAt.String(), nil
+}
+
+// FailedAt is the resolver for the failedAt field.
+func (r *webhookDeadLetterResolver) FailedAt(ctx context.Context, obj *postgres.WebhookDeadLetter) (string, error) {
+	return obj.FailedAt.String(), nil
+}
+
+// Webhook returns graphql_generated.WebhookResolver implementation.
+func (r *Resolver) Webhook() graphql_generated.WebhookResolver { return &webhookResolver{r} }
+
+// WebhookDeadLetter returns graphql_generated.WebhookDeadLetterResolver implementation.
+func (r *Resolver) WebhookDeadLetter() graphql_generated.WebhookDeadLetterResolver {
+	return &webhookDeadLetterResolver{r}
+}
+
+type webhookResolver struct{ *Resolver }
+type webhookDeadLetterResolver struct{ *Resolver }
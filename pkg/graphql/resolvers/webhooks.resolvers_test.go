@@ -0,0 +1,692 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/quotes"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestWebhooksResolver_WebhookResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := webhookResolver{}
+
+	webhookID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate webhook id.")
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	timestamp := pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	webhook := &postgres.Webhook{
+		WebhookID: webhookID,
+		ClientID:  clientID,
+		Url:       "https://example.com/hooks",
+		CreatedAt: timestamp,
+	}
+
+	t.Run("WebhookID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.WebhookID(context.TODO(), webhook)
+		require.NoError(t, err, "webhook id should always return a nil error.")
+		require.Equal(t, webhookID.String(), result, "webhook id mismatched.")
+	})
+
+	t.Run("ClientID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.ClientID(context.TODO(), webhook)
+		require.NoError(t, err, "client id should always return a nil error.")
+		require.Equal(t, clientID.String(), result, "client id mismatched.")
+	})
+
+	t.Run("CreatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.CreatedAt(context.TODO(), webhook)
+		require.NoError(t, err, "created at should always return a nil error.")
+		require.Equal(t, timestamp.Time.String(), result, "created at mismatched.")
+	})
+}
+
+func TestWebhooksResolver_WebhookDeadLetterResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver := webhookDeadLetterResolver{}
+
+	deliveryID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate delivery id.")
+
+	eventID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate event id.")
+
+	webhookID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate webhook id.")
+
+	createdAt := time.Now().Add(-time.Hour)
+	failedAt := time.Now()
+
+	deadLetter := &postgres.WebhookDeadLetter{
+		DeliveryID: deliveryID,
+		EventID:    eventID,
+		WebhookID:  webhookID,
+		URL:        "https://example.com/hooks",
+		EventType:  postgres.OutboxEventTypeCryptoPurchase,
+		Payload:    json.RawMessage(`{"currency":"USD"}`),
+		Attempts:   8,
+		LastError:  "unexpected response status 500",
+		CreatedAt:  createdAt,
+		FailedAt:   failedAt,
+	}
+
+	t.Run("DeliveryID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.DeliveryID(context.TODO(), deadLetter)
+		require.NoError(t, err, "delivery id should always return a nil error.")
+		require.Equal(t, deliveryID.String(), result, "delivery id mismatched.")
+	})
+
+	t.Run("EventID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.EventID(context.TODO(), deadLetter)
+		require.NoError(t, err, "event id should always return a nil error.")
+		require.Equal(t, eventID.String(), result, "event id mismatched.")
+	})
+
+	t.Run("WebhookID", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.WebhookID(context.TODO(), deadLetter)
+		require.NoError(t, err, "webhook id should always return a nil error.")
+		require.Equal(t, webhookID.String(), result, "webhook id mismatched.")
+	})
+
+	t.Run("EventType", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.EventType(context.TODO(), deadLetter)
+		require.NoError(t, err, "event type should always return a nil error.")
+		require.Equal(t, string(postgres.OutboxEventTypeCryptoPurchase), result, "event type mismatched.")
+	})
+
+	t.Run("Payload", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.Payload(context.TODO(), deadLetter)
+		require.NoError(t, err, "payload should unpack without error.")
+		require.Equal(t, map[string]any{"currency": "USD"}, result, "payload mismatched.")
+
+		_, err = resolver.Payload(context.TODO(), &postgres.WebhookDeadLetter{Payload: json.RawMessage(`{`)})
+		require.Error(t, err, "malformed payload should fail to unpack.")
+	})
+
+	t.Run("CreatedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.CreatedAt(context.TODO(), deadLetter)
+		require.NoError(t, err, "created at should always return a nil error.")
+		require.Equal(t, createdAt.String(), result, "created at mismatched.")
+	})
+
+	t.Run("FailedAt", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := resolver.FailedAt(context.TODO(), deadLetter)
+		require.NoError(t, err, "failed at should always return a nil error.")
+		require.Equal(t, failedAt.String(), result, "failed at mismatched.")
+	})
+}
+
+func TestWebhooksResolver_CreateWebhook(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		generateSecretTimes  int
+		webhookCreateErr     error
+		webhookCreateTimes   int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/create-webhook/invalid-jwt",
+			query:                fmt.Sprintf(testWebhooksQuery["createWebhook"], "https://example.com/hooks"),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       0,
+			generateSecretTimes:  0,
+			webhookCreateErr:     nil,
+			webhookCreateTimes:   0,
+		}, {
+			name:                 "insecure url",
+			path:                 "/create-webhook/insecure-url",
+			query:                fmt.Sprintf(testWebhooksQuery["createWebhook"], "http://example.com/hooks"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			generateSecretTimes:  0,
+			webhookCreateErr:     nil,
+			webhookCreateTimes:   0,
+		}, {
+			name:                 "db failure",
+			path:                 "/create-webhook/db-failure",
+			query:                fmt.Sprintf(testWebhooksQuery["createWebhook"], "https://example.com/hooks"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			generateSecretTimes:  1,
+			webhookCreateErr:     postgres.ErrCreateWebhook,
+			webhookCreateTimes:   1,
+		}, {
+			name:                 "valid",
+			path:                 "/create-webhook/valid",
+			query:                fmt.Sprintf(testWebhooksQuery["createWebhook"], "https://example.com/hooks"),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			generateSecretTimes:  1,
+			webhookCreateErr:     nil,
+			webhookCreateTimes:   1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockAuth.EXPECT().GenerateWebhookSecret().
+					Return("whsec_secret", "encrypted-secret", nil).
+					Times(test.generateSecretTimes),
+
+				mockPostgres.EXPECT().
+					WebhookCreate(gomock.Any(), "https://example.com/hooks", "encrypted-secret", gomock.Any()).
+					Return(postgres.Webhook{Url: "https://example.com/hooks"}, test.webhookCreateErr).
+					Times(test.webhookCreateTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestWebhooksResolver_DeleteWebhook(t *testing.T) {
+	t.Parallel()
+
+	webhookID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate webhook id.")
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		webhookDeleteErr     error
+		webhookDeleteTimes   int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/delete-webhook/invalid-jwt",
+			query:                fmt.Sprintf(testWebhooksQuery["deleteWebhook"], webhookID),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       0,
+			webhookDeleteErr:     nil,
+			webhookDeleteTimes:   0,
+		}, {
+			name:                 "invalid webhook id",
+			path:                 "/delete-webhook/invalid-webhook-id",
+			query:                fmt.Sprintf(testWebhooksQuery["deleteWebhook"], "invalid-id"),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			webhookDeleteErr:     nil,
+			webhookDeleteTimes:   0,
+		}, {
+			name:                 "not found",
+			path:                 "/delete-webhook/not-found",
+			query:                fmt.Sprintf(testWebhooksQuery["deleteWebhook"], webhookID),
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			webhookDeleteErr:     postgres.ErrNotFoundWebhook,
+			webhookDeleteTimes:   1,
+		}, {
+			name:                 "valid",
+			path:                 "/delete-webhook/valid",
+			query:                fmt.Sprintf(testWebhooksQuery["deleteWebhook"], webhookID),
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			webhookDeleteErr:     nil,
+			webhookDeleteTimes:   1,
+		},
+	}
+
+	for _, testCase := range testCases { //nolint:dupl
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().WebhookDelete(gomock.Any(), webhookID).
+					Return(test.webhookDeleteErr).
+					Times(test.webhookDeleteTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestWebhooksResolver_Webhooks(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		webhookListErr       error
+		webhookListTimes     int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/webhooks/invalid-jwt",
+			query:                testWebhooksQuery["webhooks"],
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       0,
+			webhookListErr:       nil,
+			webhookListTimes:     0,
+		}, {
+			name:                 "db failure",
+			path:                 "/webhooks/db-failure",
+			query:                testWebhooksQuery["webhooks"],
+			expectErr:            true,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			webhookListErr:       postgres.ErrTransactWebhook,
+			webhookListTimes:     1,
+		}, {
+			name:                 "valid",
+			path:                 "/webhooks/valid",
+			query:                testWebhooksQuery["webhooks"],
+			expectErr:            false,
+			authValidateJWTErr:   nil,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			webhookListErr:       nil,
+			webhookListTimes:     1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().WebhookList(gomock.Any()).
+					Return([]postgres.Webhook{{Url: "https://example.com/a"}, {Url: "https://example.com/b"}},
+						test.webhookListErr).
+					Times(test.webhookListTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
+
+func TestWebhooksResolver_WebhookDeadLetters(t *testing.T) {
+	t.Parallel()
+
+	deadLetters := []postgres.WebhookDeadLetter{
+		{Payload: json.RawMessage(`{}`)},
+		{Payload: json.RawMessage(`{}`)},
+		{Payload: json.RawMessage(`{}`)},
+		{Payload: json.RawMessage(`{}`)},
+	}
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		query                string
+		expectErr            bool
+		authValidateJWTErr   error
+		authValidateJWTTimes int
+		isDeletedTimes       int
+		authDecryptErr       error
+		authDecryptTimes     int
+		deadLettersErr       error
+		deadLettersTimes     int
+		authEncryptTimes     int
+	}{
+		{
+			name:                 "invalid jwt",
+			path:                 "/webhook-dead-letters/invalid-jwt",
+			query:                fmt.Sprintf(testWebhooksQuery["webhookDeadLetters"], "page-cursor", 3),
+			expectErr:            true,
+			authValidateJWTErr:   errors.New("invalid jwt"),
+			authValidateJWTTimes: 1,
+		}, {
+			name:                 "decrypt cursor failure",
+			path:                 "/webhook-dead-letters/decrypt-cursor-failure",
+			query:                fmt.Sprintf(testWebhooksQuery["webhookDeadLetters"], "page-cursor", 3),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			authDecryptErr:       errors.New("decrypt failure"),
+			authDecryptTimes:     1,
+		}, {
+			name:                 "dead letters failure",
+			path:                 "/webhook-dead-letters/dead-letters-failure",
+			query:                fmt.Sprintf(testWebhooksQuery["webhookDeadLetters"], "page-cursor", 3),
+			expectErr:            true,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			authDecryptTimes:     1,
+			deadLettersErr:       postgres.ErrNotFound,
+			deadLettersTimes:     1,
+		}, {
+			name:                 "valid",
+			path:                 "/webhook-dead-letters/valid",
+			query:                fmt.Sprintf(testWebhooksQuery["webhookDeadLetters"], "page-cursor", 3),
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			authDecryptTimes:     1,
+			deadLettersTimes:     1,
+			authEncryptTimes:     1,
+		}, {
+			name:                 "valid no params",
+			path:                 "/webhook-dead-letters/valid-no-params",
+			query:                testWebhooksQuery["webhookDeadLettersNoParams"],
+			expectErr:            false,
+			authValidateJWTTimes: 1,
+			isDeletedTimes:       1,
+			deadLettersTimes:     1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)    // Not called.
+			mockNotifier := mocks.NewMockNotifier(mockCtrl) // Not called.
+
+			gomock.InOrder(
+				mockAuth.EXPECT().ValidateJWT(gomock.Any()).
+					Return(uuid.UUID{}, int64(-1), test.authValidateJWTErr).
+					Times(test.authValidateJWTTimes),
+
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(0), nil).
+					AnyTimes(),
+
+				mockRedis.EXPECT().Get(revocationKey{}, gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					AnyTimes(),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockAuth.EXPECT().StepUpRequired(gomock.Any()).
+					Return(false).
+					AnyTimes(),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("3"), test.authDecryptErr).
+					Times(test.authDecryptTimes),
+
+				mockPostgres.EXPECT().WebhookDeadLettersPaginated(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(deadLetters, test.deadLettersErr).
+					Times(test.deadLettersTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-page-cursor", nil).
+					Times(test.authEncryptTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.Use(GinContextToContextMiddleware())
+			router.POST(test.path,
+				QueryHandler(testAuthHeaderKey, mockAuth, mockRedis, mockPostgres, mockQuotes, mockNotifier, nil, zapLogger))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBufferString(test.query))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "some valid auth token goes here")
+
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "failed to unmarshal response body")
+
+			// Error is expected check to ensure one is set.
+			if test.expectErr {
+				verifyErrorReturned(t, response)
+			}
+		})
+	}
+}
@@ -15,8 +15,24 @@ server:
   readTimeout: 3s
   writeTimeout: 3s
   readHeaderTimeout: 3s
+  trustedProxies:
+    - 10.0.0.0/8
+    - 127.0.0.1
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimits:
+  queries:
+    requests: 120
+    period: 1m
+    burst: 30
+  mutations:
+    requests: 60
+    period: 1m
+    burst: 20
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5`,
 
 		"out of range port": `
 server:
@@ -29,7 +45,20 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimits:
+  queries:
+    requests: 120
+    period: 1m
+    burst: 30
+  mutations:
+    requests: 60
+    period: 1m
+    burst: 20
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5`,
 
 		"out of range time delay": `
 server:
@@ -42,7 +71,20 @@ server:
   writeTimeout: 0s
   readHeaderTimeout: 0s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimits:
+  queries:
+    requests: 120
+    period: 1m
+    burst: 30
+  mutations:
+    requests: 60
+    period: 1m
+    burst: 20
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5`,
 
 		"no base path": `
 server:
@@ -54,7 +96,20 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimits:
+  queries:
+    requests: 120
+    period: 1m
+    burst: 30
+  mutations:
+    requests: 60
+    period: 1m
+    burst: 20
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5`,
 
 		"no playground path": `
 server:
@@ -66,7 +121,20 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimits:
+  queries:
+    requests: 120
+    period: 1m
+    burst: 30
+  mutations:
+    requests: 60
+    period: 1m
+    burst: 20
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5`,
 
 		"no query path": `
 server:
@@ -78,7 +146,20 @@ server:
   writeTimeout: 3s
   readHeaderTimeout: 3s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimits:
+  queries:
+    requests: 120
+    period: 1m
+    burst: 30
+  mutations:
+    requests: 60
+    period: 1m
+    burst: 20
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5`,
 
 		"no read timeout": `
 server:
@@ -90,7 +171,20 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimits:
+  queries:
+    requests: 120
+    period: 1m
+    burst: 30
+  mutations:
+    requests: 60
+    period: 1m
+    burst: 20
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5`,
 
 		"no write timeout": `
 server:
@@ -102,7 +196,20 @@ server:
   readTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimits:
+  queries:
+    requests: 120
+    period: 1m
+    burst: 30
+  mutations:
+    requests: 60
+    period: 1m
+    burst: 20
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5`,
 
 		"no read header timeout": `
 server:
@@ -114,7 +221,20 @@ server:
   readTimeout: 1s
   writeTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimits:
+  queries:
+    requests: 120
+    period: 1m
+    burst: 30
+  mutations:
+    requests: 60
+    period: 1m
+    burst: 20
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5`,
 
 		"no auth header": `
 server:
@@ -127,6 +247,87 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey:`,
+  headerKey:
+rateLimits:
+  queries:
+    requests: 120
+    period: 1m
+    burst: 30
+  mutations:
+    requests: 60
+    period: 1m
+    burst: 20
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5`,
+
+		"out of range rate limit": `
+server:
+  portNumber: 33723
+  shutdownDelay: 5s
+  basePath: api/rest/v1
+  playgroundPath: /playground
+  queryPath: /query
+  readTimeout: 3s
+  writeTimeout: 3s
+  readHeaderTimeout: 3s
+authorization:
+  headerKey: Authorization
+rateLimits:
+  queries:
+    requests: 120
+    period: 1m
+    burst: 30
+  mutations:
+    requests: 60
+    period: 1m
+    burst: 20
+  quotes:
+    requests: 0
+    period: 0s
+    burst: 0`,
+
+		"no rate limits": `
+server:
+  portNumber: 33723
+  shutdownDelay: 5s
+  basePath: api/rest/v1
+  playgroundPath: /playground
+  queryPath: /query
+  readTimeout: 3s
+  writeTimeout: 3s
+  readHeaderTimeout: 3s
+authorization:
+  headerKey: Authorization`,
+
+		"invalid trusted proxy": `
+server:
+  portNumber: 33723
+  shutdownDelay: 5s
+  basePath: api/rest/v1
+  playgroundPath: /playground
+  queryPath: /query
+  readTimeout: 3s
+  writeTimeout: 3s
+  readHeaderTimeout: 3s
+  trustedProxies:
+    - 10.0.0.0/8
+    - not-an-address
+authorization:
+  headerKey: Authorization
+rateLimits:
+  queries:
+    requests: 120
+    period: 1m
+    burst: 30
+  mutations:
+    requests: 60
+    period: 1m
+    burst: 20
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5`,
 	}
 }
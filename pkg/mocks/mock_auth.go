@@ -6,10 +6,12 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gin "github.com/gin-gonic/gin"
 	uuid "github.com/gofrs/uuid"
 	gomock "github.com/golang/mock/gomock"
+	auth "github.com/surahman/FTeX/pkg/auth"
 	models "github.com/surahman/FTeX/pkg/models"
 )
 
@@ -80,6 +82,36 @@ func (mr *MockAuthMockRecorder) EncryptToString(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptToString", reflect.TypeOf((*MockAuth)(nil).EncryptToString), arg0)
 }
 
+// ExpirationDuration mocks base method.
+func (m *MockAuth) ExpirationDuration() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpirationDuration")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// ExpirationDuration indicates an expected call of ExpirationDuration.
+func (mr *MockAuthMockRecorder) ExpirationDuration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpirationDuration", reflect.TypeOf((*MockAuth)(nil).ExpirationDuration))
+}
+
+// GenerateAPIKey mocks base method.
+func (m *MockAuth) GenerateAPIKey() (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateAPIKey")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GenerateAPIKey indicates an expected call of GenerateAPIKey.
+func (mr *MockAuthMockRecorder) GenerateAPIKey() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAPIKey", reflect.TypeOf((*MockAuth)(nil).GenerateAPIKey))
+}
+
 // GenerateJWT mocks base method.
 func (m *MockAuth) GenerateJWT(arg0 uuid.UUID) (*models.JWTAuthResponse, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +127,68 @@ func (mr *MockAuthMockRecorder) GenerateJWT(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateJWT", reflect.TypeOf((*MockAuth)(nil).GenerateJWT), arg0)
 }
 
+// GenerateRecoveryCodes mocks base method.
+func (m *MockAuth) GenerateRecoveryCodes() ([]string, []string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateRecoveryCodes")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].([]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GenerateRecoveryCodes indicates an expected call of GenerateRecoveryCodes.
+func (mr *MockAuthMockRecorder) GenerateRecoveryCodes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateRecoveryCodes", reflect.TypeOf((*MockAuth)(nil).GenerateRecoveryCodes))
+}
+
+// GenerateTOTP mocks base method.
+func (m *MockAuth) GenerateTOTP(arg0 string) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateTOTP", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GenerateTOTP indicates an expected call of GenerateTOTP.
+func (mr *MockAuthMockRecorder) GenerateTOTP(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateTOTP", reflect.TypeOf((*MockAuth)(nil).GenerateTOTP), arg0)
+}
+
+// GenerateWebhookSecret mocks base method.
+func (m *MockAuth) GenerateWebhookSecret() (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateWebhookSecret")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GenerateWebhookSecret indicates an expected call of GenerateWebhookSecret.
+func (mr *MockAuthMockRecorder) GenerateWebhookSecret() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateWebhookSecret", reflect.TypeOf((*MockAuth)(nil).GenerateWebhookSecret))
+}
+
+// HashAPIKey mocks base method.
+func (m *MockAuth) HashAPIKey(arg0 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashAPIKey", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HashAPIKey indicates an expected call of HashAPIKey.
+func (mr *MockAuthMockRecorder) HashAPIKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashAPIKey", reflect.TypeOf((*MockAuth)(nil).HashAPIKey), arg0)
+}
+
 // HashPassword mocks base method.
 func (m *MockAuth) HashPassword(arg0 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +204,76 @@ func (mr *MockAuthMockRecorder) HashPassword(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashPassword", reflect.TypeOf((*MockAuth)(nil).HashPassword), arg0)
 }
 
+// HashRecoveryCode mocks base method.
+func (m *MockAuth) HashRecoveryCode(arg0 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashRecoveryCode", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HashRecoveryCode indicates an expected call of HashRecoveryCode.
+func (mr *MockAuthMockRecorder) HashRecoveryCode(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashRecoveryCode", reflect.TypeOf((*MockAuth)(nil).HashRecoveryCode), arg0)
+}
+
+// JWKS mocks base method.
+func (m *MockAuth) JWKS() *models.JWKSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JWKS")
+	ret0, _ := ret[0].(*models.JWKSet)
+	return ret0
+}
+
+// JWKS indicates an expected call of JWKS.
+func (mr *MockAuthMockRecorder) JWKS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JWKS", reflect.TypeOf((*MockAuth)(nil).JWKS))
+}
+
+// LoginFailureWindow mocks base method.
+func (m *MockAuth) LoginFailureWindow() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginFailureWindow")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// LoginFailureWindow indicates an expected call of LoginFailureWindow.
+func (mr *MockAuthMockRecorder) LoginFailureWindow() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginFailureWindow", reflect.TypeOf((*MockAuth)(nil).LoginFailureWindow))
+}
+
+// LoginLockout mocks base method.
+func (m *MockAuth) LoginLockout(arg0 int64) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginLockout", arg0)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// LoginLockout indicates an expected call of LoginLockout.
+func (mr *MockAuthMockRecorder) LoginLockout(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginLockout", reflect.TypeOf((*MockAuth)(nil).LoginLockout), arg0)
+}
+
+// MFAChallengeExpiration mocks base method.
+func (m *MockAuth) MFAChallengeExpiration() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MFAChallengeExpiration")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// MFAChallengeExpiration indicates an expected call of MFAChallengeExpiration.
+func (mr *MockAuthMockRecorder) MFAChallengeExpiration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFAChallengeExpiration", reflect.TypeOf((*MockAuth)(nil).MFAChallengeExpiration))
+}
+
 // RefreshJWT mocks base method.
 func (m *MockAuth) RefreshJWT(arg0 string) (*models.JWTAuthResponse, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +303,36 @@ func (mr *MockAuthMockRecorder) RefreshThreshold() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshThreshold", reflect.TypeOf((*MockAuth)(nil).RefreshThreshold))
 }
 
+// SessionFromJWT mocks base method.
+func (m *MockAuth) SessionFromJWT(arg0 string) (string, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SessionFromJWT", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SessionFromJWT indicates an expected call of SessionFromJWT.
+func (mr *MockAuthMockRecorder) SessionFromJWT(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SessionFromJWT", reflect.TypeOf((*MockAuth)(nil).SessionFromJWT), arg0)
+}
+
+// StepUpRequired mocks base method.
+func (m *MockAuth) StepUpRequired(arg0 auth.StepUp) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StepUpRequired", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// StepUpRequired indicates an expected call of StepUpRequired.
+func (mr *MockAuthMockRecorder) StepUpRequired(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StepUpRequired", reflect.TypeOf((*MockAuth)(nil).StepUpRequired), arg0)
+}
+
 // TokenInfoFromGinCtx mocks base method.
 func (m *MockAuth) TokenInfoFromGinCtx(arg0 *gin.Context) (uuid.UUID, int64, error) {
 	m.ctrl.T.Helper()
@@ -170,3 +364,18 @@ func (mr *MockAuthMockRecorder) ValidateJWT(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateJWT", reflect.TypeOf((*MockAuth)(nil).ValidateJWT), arg0)
 }
+
+// ValidateTOTP mocks base method.
+func (m *MockAuth) ValidateTOTP(arg0, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateTOTP", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateTOTP indicates an expected call of ValidateTOTP.
+func (mr *MockAuthMockRecorder) ValidateTOTP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateTOTP", reflect.TypeOf((*MockAuth)(nil).ValidateTOTP), arg0, arg1)
+}
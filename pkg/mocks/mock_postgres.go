@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/gofrs/uuid"
 	gomock "github.com/golang/mock/gomock"
@@ -39,6 +40,65 @@ func (m *MockPostgres) EXPECT() *MockPostgresMockRecorder {
 	return m.recorder
 }
 
+// APIKeyCreate mocks base method.
+func (m *MockPostgres) APIKeyCreate(arg0 uuid.UUID, arg1, arg2 string, arg3 []string) (postgres.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIKeyCreate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(postgres.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// APIKeyCreate indicates an expected call of APIKeyCreate.
+func (mr *MockPostgresMockRecorder) APIKeyCreate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIKeyCreate", reflect.TypeOf((*MockPostgres)(nil).APIKeyCreate), arg0, arg1, arg2, arg3)
+}
+
+// APIKeyGet mocks base method.
+func (m *MockPostgres) APIKeyGet(arg0 string) (postgres.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIKeyGet", arg0)
+	ret0, _ := ret[0].(postgres.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// APIKeyGet indicates an expected call of APIKeyGet.
+func (mr *MockPostgresMockRecorder) APIKeyGet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIKeyGet", reflect.TypeOf((*MockPostgres)(nil).APIKeyGet), arg0)
+}
+
+// APIKeyList mocks base method.
+func (m *MockPostgres) APIKeyList(arg0 uuid.UUID) ([]postgres.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIKeyList", arg0)
+	ret0, _ := ret[0].([]postgres.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// APIKeyList indicates an expected call of APIKeyList.
+func (mr *MockPostgresMockRecorder) APIKeyList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIKeyList", reflect.TypeOf((*MockPostgres)(nil).APIKeyList), arg0)
+}
+
+// APIKeyRevoke mocks base method.
+func (m *MockPostgres) APIKeyRevoke(arg0, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIKeyRevoke", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// APIKeyRevoke indicates an expected call of APIKeyRevoke.
+func (mr *MockPostgresMockRecorder) APIKeyRevoke(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIKeyRevoke", reflect.TypeOf((*MockPostgres)(nil).APIKeyRevoke), arg0, arg1)
+}
+
 // Close mocks base method.
 func (m *MockPostgres) Close() error {
 	m.ctrl.T.Helper()
@@ -98,9 +158,9 @@ func (mr *MockPostgresMockRecorder) CryptoCreateAccount(arg0, arg1 interface{})
 }
 
 // CryptoPurchase mocks base method.
-func (m *MockPostgres) CryptoPurchase(arg0 uuid.UUID, arg1 postgres.Currency, arg2 decimal.Decimal, arg3 string, arg4 decimal.Decimal) (*postgres.FiatJournal, *postgres.CryptoJournal, error) {
+func (m *MockPostgres) CryptoPurchase(arg0 uuid.UUID, arg1 postgres.Currency, arg2 decimal.Decimal, arg3 string, arg4, arg5 decimal.Decimal, arg6 *postgres.TradeDetails) (*postgres.FiatJournal, *postgres.CryptoJournal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CryptoPurchase", arg0, arg1, arg2, arg3, arg4)
+	ret := m.ctrl.Call(m, "CryptoPurchase", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 	ret0, _ := ret[0].(*postgres.FiatJournal)
 	ret1, _ := ret[1].(*postgres.CryptoJournal)
 	ret2, _ := ret[2].(error)
@@ -108,15 +168,15 @@ func (m *MockPostgres) CryptoPurchase(arg0 uuid.UUID, arg1 postgres.Currency, ar
 }
 
 // CryptoPurchase indicates an expected call of CryptoPurchase.
-func (mr *MockPostgresMockRecorder) CryptoPurchase(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+func (mr *MockPostgresMockRecorder) CryptoPurchase(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoPurchase", reflect.TypeOf((*MockPostgres)(nil).CryptoPurchase), arg0, arg1, arg2, arg3, arg4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoPurchase", reflect.TypeOf((*MockPostgres)(nil).CryptoPurchase), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 }
 
 // CryptoSell mocks base method.
-func (m *MockPostgres) CryptoSell(arg0 uuid.UUID, arg1 postgres.Currency, arg2 decimal.Decimal, arg3 string, arg4 decimal.Decimal) (*postgres.FiatJournal, *postgres.CryptoJournal, error) {
+func (m *MockPostgres) CryptoSell(arg0 uuid.UUID, arg1 postgres.Currency, arg2 decimal.Decimal, arg3 string, arg4, arg5 decimal.Decimal, arg6 *postgres.TradeDetails) (*postgres.FiatJournal, *postgres.CryptoJournal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CryptoSell", arg0, arg1, arg2, arg3, arg4)
+	ret := m.ctrl.Call(m, "CryptoSell", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 	ret0, _ := ret[0].(*postgres.FiatJournal)
 	ret1, _ := ret[1].(*postgres.CryptoJournal)
 	ret2, _ := ret[2].(error)
@@ -124,9 +184,40 @@ func (m *MockPostgres) CryptoSell(arg0 uuid.UUID, arg1 postgres.Currency, arg2 d
 }
 
 // CryptoSell indicates an expected call of CryptoSell.
-func (mr *MockPostgresMockRecorder) CryptoSell(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+func (mr *MockPostgresMockRecorder) CryptoSell(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoSell", reflect.TypeOf((*MockPostgres)(nil).CryptoSell), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// CryptoSetStatus mocks base method.
+func (m *MockPostgres) CryptoSetStatus(arg0 uuid.UUID, arg1 string, arg2 postgres.AccountStatus) (postgres.CryptoAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CryptoSetStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(postgres.CryptoAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CryptoSetStatus indicates an expected call of CryptoSetStatus.
+func (mr *MockPostgresMockRecorder) CryptoSetStatus(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoSell", reflect.TypeOf((*MockPostgres)(nil).CryptoSell), arg0, arg1, arg2, arg3, arg4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoSetStatus", reflect.TypeOf((*MockPostgres)(nil).CryptoSetStatus), arg0, arg1, arg2)
+}
+
+// CryptoSwap mocks base method.
+func (m *MockPostgres) CryptoSwap(arg0 uuid.UUID, arg1 string, arg2 decimal.Decimal, arg3 string, arg4, arg5 decimal.Decimal) (*postgres.CryptoJournal, *postgres.CryptoJournal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CryptoSwap", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*postgres.CryptoJournal)
+	ret1, _ := ret[1].(*postgres.CryptoJournal)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CryptoSwap indicates an expected call of CryptoSwap.
+func (mr *MockPostgresMockRecorder) CryptoSwap(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoSwap", reflect.TypeOf((*MockPostgres)(nil).CryptoSwap), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
 // CryptoTransactionsPaginated mocks base method.
@@ -159,6 +250,21 @@ func (mr *MockPostgresMockRecorder) CryptoTxDetails(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoTxDetails", reflect.TypeOf((*MockPostgres)(nil).CryptoTxDetails), arg0, arg1)
 }
 
+// CryptoUpdateHold mocks base method.
+func (m *MockPostgres) CryptoUpdateHold(arg0 uuid.UUID, arg1 string, arg2 decimal.Decimal) (postgres.CryptoAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CryptoUpdateHold", arg0, arg1, arg2)
+	ret0, _ := ret[0].(postgres.CryptoAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CryptoUpdateHold indicates an expected call of CryptoUpdateHold.
+func (mr *MockPostgresMockRecorder) CryptoUpdateHold(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoUpdateHold", reflect.TypeOf((*MockPostgres)(nil).CryptoUpdateHold), arg0, arg1, arg2)
+}
+
 // FiatBalance mocks base method.
 func (m *MockPostgres) FiatBalance(arg0 uuid.UUID, arg1 postgres.Currency) (postgres.FiatAccount, error) {
 	m.ctrl.T.Helper()
@@ -218,10 +324,25 @@ func (mr *MockPostgresMockRecorder) FiatExternalTransfer(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatExternalTransfer", reflect.TypeOf((*MockPostgres)(nil).FiatExternalTransfer), arg0, arg1)
 }
 
+// FiatExternalWithdrawal mocks base method.
+func (m *MockPostgres) FiatExternalWithdrawal(arg0 context.Context, arg1 *postgres.FiatTransactionDetails) (*postgres.FiatAccountTransferResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatExternalWithdrawal", arg0, arg1)
+	ret0, _ := ret[0].(*postgres.FiatAccountTransferResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FiatExternalWithdrawal indicates an expected call of FiatExternalWithdrawal.
+func (mr *MockPostgresMockRecorder) FiatExternalWithdrawal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatExternalWithdrawal", reflect.TypeOf((*MockPostgres)(nil).FiatExternalWithdrawal), arg0, arg1)
+}
+
 // FiatInternalTransfer mocks base method.
-func (m *MockPostgres) FiatInternalTransfer(arg0 context.Context, arg1, arg2 *postgres.FiatTransactionDetails) (*postgres.FiatAccountTransferResult, *postgres.FiatAccountTransferResult, error) {
+func (m *MockPostgres) FiatInternalTransfer(arg0 context.Context, arg1, arg2 *postgres.FiatTransactionDetails, arg3 *postgres.TradeDetails) (*postgres.FiatAccountTransferResult, *postgres.FiatAccountTransferResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FiatInternalTransfer", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "FiatInternalTransfer", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*postgres.FiatAccountTransferResult)
 	ret1, _ := ret[1].(*postgres.FiatAccountTransferResult)
 	ret2, _ := ret[2].(error)
@@ -229,9 +350,39 @@ func (m *MockPostgres) FiatInternalTransfer(arg0 context.Context, arg1, arg2 *po
 }
 
 // FiatInternalTransfer indicates an expected call of FiatInternalTransfer.
-func (mr *MockPostgresMockRecorder) FiatInternalTransfer(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockPostgresMockRecorder) FiatInternalTransfer(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatInternalTransfer", reflect.TypeOf((*MockPostgres)(nil).FiatInternalTransfer), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatInternalTransfer", reflect.TypeOf((*MockPostgres)(nil).FiatInternalTransfer), arg0, arg1, arg2, arg3)
+}
+
+// FiatReverseDeposit mocks base method.
+func (m *MockPostgres) FiatReverseDeposit(arg0 context.Context, arg1, arg2, arg3 uuid.UUID) (*postgres.FiatAccountTransferResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatReverseDeposit", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*postgres.FiatAccountTransferResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FiatReverseDeposit indicates an expected call of FiatReverseDeposit.
+func (mr *MockPostgresMockRecorder) FiatReverseDeposit(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatReverseDeposit", reflect.TypeOf((*MockPostgres)(nil).FiatReverseDeposit), arg0, arg1, arg2, arg3)
+}
+
+// FiatSetStatus mocks base method.
+func (m *MockPostgres) FiatSetStatus(arg0 uuid.UUID, arg1 postgres.Currency, arg2 postgres.AccountStatus) (postgres.FiatAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatSetStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(postgres.FiatAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FiatSetStatus indicates an expected call of FiatSetStatus.
+func (mr *MockPostgresMockRecorder) FiatSetStatus(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatSetStatus", reflect.TypeOf((*MockPostgres)(nil).FiatSetStatus), arg0, arg1, arg2)
 }
 
 // FiatTransactionsPaginated mocks base method.
@@ -264,6 +415,21 @@ func (mr *MockPostgresMockRecorder) FiatTxDetails(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatTxDetails", reflect.TypeOf((*MockPostgres)(nil).FiatTxDetails), arg0, arg1)
 }
 
+// FiatUpdateHold mocks base method.
+func (m *MockPostgres) FiatUpdateHold(arg0 uuid.UUID, arg1 postgres.Currency, arg2 decimal.Decimal) (postgres.FiatAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FiatUpdateHold", arg0, arg1, arg2)
+	ret0, _ := ret[0].(postgres.FiatAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FiatUpdateHold indicates an expected call of FiatUpdateHold.
+func (mr *MockPostgresMockRecorder) FiatUpdateHold(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FiatUpdateHold", reflect.TypeOf((*MockPostgres)(nil).FiatUpdateHold), arg0, arg1, arg2)
+}
+
 // Healthcheck mocks base method.
 func (m *MockPostgres) Healthcheck() error {
 	m.ctrl.T.Helper()
@@ -278,6 +444,134 @@ func (mr *MockPostgresMockRecorder) Healthcheck() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Healthcheck", reflect.TypeOf((*MockPostgres)(nil).Healthcheck))
 }
 
+// LoginEventCreate mocks base method.
+func (m *MockPostgres) LoginEventCreate(arg0 uuid.UUID, arg1 postgres.LoginOutcome, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginEventCreate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LoginEventCreate indicates an expected call of LoginEventCreate.
+func (mr *MockPostgresMockRecorder) LoginEventCreate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginEventCreate", reflect.TypeOf((*MockPostgres)(nil).LoginEventCreate), arg0, arg1, arg2, arg3)
+}
+
+// LoginEventsPaginated mocks base method.
+func (m *MockPostgres) LoginEventsPaginated(arg0 uuid.UUID, arg1, arg2 int32) ([]postgres.LoginEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginEventsPaginated", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]postgres.LoginEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginEventsPaginated indicates an expected call of LoginEventsPaginated.
+func (mr *MockPostgresMockRecorder) LoginEventsPaginated(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginEventsPaginated", reflect.TypeOf((*MockPostgres)(nil).LoginEventsPaginated), arg0, arg1, arg2)
+}
+
+// MFADelete mocks base method.
+func (m *MockPostgres) MFADelete(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MFADelete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MFADelete indicates an expected call of MFADelete.
+func (mr *MockPostgresMockRecorder) MFADelete(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFADelete", reflect.TypeOf((*MockPostgres)(nil).MFADelete), arg0)
+}
+
+// MFAEnable mocks base method.
+func (m *MockPostgres) MFAEnable(arg0 uuid.UUID, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MFAEnable", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MFAEnable indicates an expected call of MFAEnable.
+func (mr *MockPostgresMockRecorder) MFAEnable(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFAEnable", reflect.TypeOf((*MockPostgres)(nil).MFAEnable), arg0, arg1)
+}
+
+// MFAEnroll mocks base method.
+func (m *MockPostgres) MFAEnroll(arg0 uuid.UUID, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MFAEnroll", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MFAEnroll indicates an expected call of MFAEnroll.
+func (mr *MockPostgresMockRecorder) MFAEnroll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFAEnroll", reflect.TypeOf((*MockPostgres)(nil).MFAEnroll), arg0, arg1, arg2)
+}
+
+// MFAGet mocks base method.
+func (m *MockPostgres) MFAGet(arg0 uuid.UUID) (postgres.MfaEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MFAGet", arg0)
+	ret0, _ := ret[0].(postgres.MfaEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MFAGet indicates an expected call of MFAGet.
+func (mr *MockPostgresMockRecorder) MFAGet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFAGet", reflect.TypeOf((*MockPostgres)(nil).MFAGet), arg0)
+}
+
+// MFASetRecoveryCodes mocks base method.
+func (m *MockPostgres) MFASetRecoveryCodes(arg0 uuid.UUID, arg1 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MFASetRecoveryCodes", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MFASetRecoveryCodes indicates an expected call of MFASetRecoveryCodes.
+func (mr *MockPostgresMockRecorder) MFASetRecoveryCodes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFASetRecoveryCodes", reflect.TypeOf((*MockPostgres)(nil).MFASetRecoveryCodes), arg0, arg1)
+}
+
+// MFAUseRecoveryCode mocks base method.
+func (m *MockPostgres) MFAUseRecoveryCode(arg0 uuid.UUID, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MFAUseRecoveryCode", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MFAUseRecoveryCode indicates an expected call of MFAUseRecoveryCode.
+func (mr *MockPostgresMockRecorder) MFAUseRecoveryCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFAUseRecoveryCode", reflect.TypeOf((*MockPostgres)(nil).MFAUseRecoveryCode), arg0, arg1)
+}
+
+// MFAUseStep mocks base method.
+func (m *MockPostgres) MFAUseStep(arg0 uuid.UUID, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MFAUseStep", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MFAUseStep indicates an expected call of MFAUseStep.
+func (mr *MockPostgresMockRecorder) MFAUseStep(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFAUseStep", reflect.TypeOf((*MockPostgres)(nil).MFAUseStep), arg0, arg1)
+}
+
 // Open mocks base method.
 func (m *MockPostgres) Open() error {
 	m.ctrl.T.Helper()
@@ -292,6 +586,314 @@ func (mr *MockPostgresMockRecorder) Open() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockPostgres)(nil).Open))
 }
 
+// OrderCancel mocks base method.
+func (m *MockPostgres) OrderCancel(arg0, arg1 uuid.UUID) (postgres.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderCancel", arg0, arg1)
+	ret0, _ := ret[0].(postgres.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrderCancel indicates an expected call of OrderCancel.
+func (mr *MockPostgresMockRecorder) OrderCancel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderCancel", reflect.TypeOf((*MockPostgres)(nil).OrderCancel), arg0, arg1)
+}
+
+// OrderClaim mocks base method.
+func (m *MockPostgres) OrderClaim(arg0 uuid.UUID, arg1, arg2, arg3 decimal.Decimal, arg4 uuid.NullUUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderClaim", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OrderClaim indicates an expected call of OrderClaim.
+func (mr *MockPostgresMockRecorder) OrderClaim(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderClaim", reflect.TypeOf((*MockPostgres)(nil).OrderClaim), arg0, arg1, arg2, arg3, arg4)
+}
+
+// OrderCreate mocks base method.
+func (m *MockPostgres) OrderCreate(arg0 uuid.UUID, arg1, arg2 string, arg3, arg4 decimal.Decimal) (postgres.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderCreate", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(postgres.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrderCreate indicates an expected call of OrderCreate.
+func (mr *MockPostgresMockRecorder) OrderCreate(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderCreate", reflect.TypeOf((*MockPostgres)(nil).OrderCreate), arg0, arg1, arg2, arg3, arg4)
+}
+
+// OrderFill mocks base method.
+func (m *MockPostgres) OrderFill(arg0, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderFill", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OrderFill indicates an expected call of OrderFill.
+func (mr *MockPostgresMockRecorder) OrderFill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderFill", reflect.TypeOf((*MockPostgres)(nil).OrderFill), arg0, arg1)
+}
+
+// OrderUpdateStatus mocks base method.
+func (m *MockPostgres) OrderUpdateStatus(arg0 uuid.UUID, arg1, arg2 postgres.OrderStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderUpdateStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OrderUpdateStatus indicates an expected call of OrderUpdateStatus.
+func (mr *MockPostgresMockRecorder) OrderUpdateStatus(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderUpdateStatus", reflect.TypeOf((*MockPostgres)(nil).OrderUpdateStatus), arg0, arg1, arg2)
+}
+
+// OrdersOpen mocks base method.
+func (m *MockPostgres) OrdersOpen(arg0 int32) ([]postgres.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrdersOpen", arg0)
+	ret0, _ := ret[0].([]postgres.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrdersOpen indicates an expected call of OrdersOpen.
+func (mr *MockPostgresMockRecorder) OrdersOpen(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrdersOpen", reflect.TypeOf((*MockPostgres)(nil).OrdersOpen), arg0)
+}
+
+// OrdersPaginated mocks base method.
+func (m *MockPostgres) OrdersPaginated(arg0 uuid.UUID, arg1, arg2 int32) ([]postgres.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrdersPaginated", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]postgres.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrdersPaginated indicates an expected call of OrdersPaginated.
+func (mr *MockPostgresMockRecorder) OrdersPaginated(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrdersPaginated", reflect.TypeOf((*MockPostgres)(nil).OrdersPaginated), arg0, arg1, arg2)
+}
+
+// OrdersRecoverStale mocks base method.
+func (m *MockPostgres) OrdersRecoverStale(arg0 time.Time) (int64, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrdersRecoverStale", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// OrdersRecoverStale indicates an expected call of OrdersRecoverStale.
+func (mr *MockPostgresMockRecorder) OrdersRecoverStale(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrdersRecoverStale", reflect.TypeOf((*MockPostgres)(nil).OrdersRecoverStale), arg0)
+}
+
+// OutboxReleaseRelay mocks base method.
+func (m *MockPostgres) OutboxReleaseRelay(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutboxReleaseRelay", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OutboxReleaseRelay indicates an expected call of OutboxReleaseRelay.
+func (mr *MockPostgresMockRecorder) OutboxReleaseRelay(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboxReleaseRelay", reflect.TypeOf((*MockPostgres)(nil).OutboxReleaseRelay), arg0)
+}
+
+// OutboxUnrelayed mocks base method.
+func (m *MockPostgres) OutboxUnrelayed(arg0 int32) ([]postgres.Outbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutboxUnrelayed", arg0)
+	ret0, _ := ret[0].([]postgres.Outbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutboxUnrelayed indicates an expected call of OutboxUnrelayed.
+func (mr *MockPostgresMockRecorder) OutboxUnrelayed(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboxUnrelayed", reflect.TypeOf((*MockPostgres)(nil).OutboxUnrelayed), arg0)
+}
+
+// RateCreate mocks base method.
+func (m *MockPostgres) RateCreate(arg0, arg1, arg2 string, arg3 decimal.Decimal, arg4 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateCreate", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RateCreate indicates an expected call of RateCreate.
+func (mr *MockPostgresMockRecorder) RateCreate(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateCreate", reflect.TypeOf((*MockPostgres)(nil).RateCreate), arg0, arg1, arg2, arg3, arg4)
+}
+
+// RateHistory mocks base method.
+func (m *MockPostgres) RateHistory(arg0, arg1 string, arg2, arg3 time.Time, arg4 time.Duration) ([]postgres.RateCandle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateHistory", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]postgres.RateCandle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RateHistory indicates an expected call of RateHistory.
+func (mr *MockPostgresMockRecorder) RateHistory(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateHistory", reflect.TypeOf((*MockPostgres)(nil).RateHistory), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ScheduleAdvance mocks base method.
+func (m *MockPostgres) ScheduleAdvance(arg0 uuid.UUID, arg1, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleAdvance", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScheduleAdvance indicates an expected call of ScheduleAdvance.
+func (mr *MockPostgresMockRecorder) ScheduleAdvance(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleAdvance", reflect.TypeOf((*MockPostgres)(nil).ScheduleAdvance), arg0, arg1, arg2)
+}
+
+// ScheduleCreate mocks base method.
+func (m *MockPostgres) ScheduleCreate(arg0 uuid.UUID, arg1 postgres.Currency, arg2 string, arg3 decimal.Decimal, arg4 postgres.ScheduleFrequency, arg5 time.Time) (postgres.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleCreate", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(postgres.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleCreate indicates an expected call of ScheduleCreate.
+func (mr *MockPostgresMockRecorder) ScheduleCreate(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleCreate", reflect.TypeOf((*MockPostgres)(nil).ScheduleCreate), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// ScheduleDelete mocks base method.
+func (m *MockPostgres) ScheduleDelete(arg0, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScheduleDelete indicates an expected call of ScheduleDelete.
+func (mr *MockPostgresMockRecorder) ScheduleDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleDelete", reflect.TypeOf((*MockPostgres)(nil).ScheduleDelete), arg0, arg1)
+}
+
+// ScheduleRunCreate mocks base method.
+func (m *MockPostgres) ScheduleRunCreate(arg0 *postgres.ScheduleRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleRunCreate", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScheduleRunCreate indicates an expected call of ScheduleRunCreate.
+func (mr *MockPostgresMockRecorder) ScheduleRunCreate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleRunCreate", reflect.TypeOf((*MockPostgres)(nil).ScheduleRunCreate), arg0)
+}
+
+// ScheduleRunsPaginated mocks base method.
+func (m *MockPostgres) ScheduleRunsPaginated(arg0, arg1 uuid.UUID, arg2, arg3 int32) ([]postgres.ScheduleRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleRunsPaginated", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]postgres.ScheduleRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleRunsPaginated indicates an expected call of ScheduleRunsPaginated.
+func (mr *MockPostgresMockRecorder) ScheduleRunsPaginated(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleRunsPaginated", reflect.TypeOf((*MockPostgres)(nil).ScheduleRunsPaginated), arg0, arg1, arg2, arg3)
+}
+
+// ScheduleUpdate mocks base method.
+func (m *MockPostgres) ScheduleUpdate(arg0, arg1 uuid.UUID, arg2 decimal.Decimal, arg3 postgres.ScheduleFrequency, arg4 bool) (postgres.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleUpdate", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(postgres.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleUpdate indicates an expected call of ScheduleUpdate.
+func (mr *MockPostgresMockRecorder) ScheduleUpdate(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleUpdate", reflect.TypeOf((*MockPostgres)(nil).ScheduleUpdate), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SchedulesDue mocks base method.
+func (m *MockPostgres) SchedulesDue(arg0 int32) ([]postgres.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchedulesDue", arg0)
+	ret0, _ := ret[0].([]postgres.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SchedulesDue indicates an expected call of SchedulesDue.
+func (mr *MockPostgresMockRecorder) SchedulesDue(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulesDue", reflect.TypeOf((*MockPostgres)(nil).SchedulesDue), arg0)
+}
+
+// SchedulesPaginated mocks base method.
+func (m *MockPostgres) SchedulesPaginated(arg0 uuid.UUID, arg1, arg2 int32) ([]postgres.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchedulesPaginated", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]postgres.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SchedulesPaginated indicates an expected call of SchedulesPaginated.
+func (mr *MockPostgresMockRecorder) SchedulesPaginated(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulesPaginated", reflect.TypeOf((*MockPostgres)(nil).SchedulesPaginated), arg0, arg1, arg2)
+}
+
+// TradeTxDetails mocks base method.
+func (m *MockPostgres) TradeTxDetails(arg0, arg1 uuid.UUID) ([]postgres.Trade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TradeTxDetails", arg0, arg1)
+	ret0, _ := ret[0].([]postgres.Trade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TradeTxDetails indicates an expected call of TradeTxDetails.
+func (mr *MockPostgresMockRecorder) TradeTxDetails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TradeTxDetails", reflect.TypeOf((*MockPostgres)(nil).TradeTxDetails), arg0, arg1)
+}
+
 // UserCredentials mocks base method.
 func (m *MockPostgres) UserCredentials(arg0 string) (uuid.UUID, string, error) {
 	m.ctrl.T.Helper()
@@ -322,6 +924,21 @@ func (mr *MockPostgresMockRecorder) UserDelete(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDelete", reflect.TypeOf((*MockPostgres)(nil).UserDelete), arg0)
 }
 
+// UserGetClientID mocks base method.
+func (m *MockPostgres) UserGetClientID(arg0 string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserGetClientID", arg0)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserGetClientID indicates an expected call of UserGetClientID.
+func (mr *MockPostgresMockRecorder) UserGetClientID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserGetClientID", reflect.TypeOf((*MockPostgres)(nil).UserGetClientID), arg0)
+}
+
 // UserGetInfo mocks base method.
 func (m *MockPostgres) UserGetInfo(arg0 uuid.UUID) (models.User, error) {
 	m.ctrl.T.Helper()
@@ -337,6 +954,21 @@ func (mr *MockPostgresMockRecorder) UserGetInfo(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserGetInfo", reflect.TypeOf((*MockPostgres)(nil).UserGetInfo), arg0)
 }
 
+// UserGetRole mocks base method.
+func (m *MockPostgres) UserGetRole(arg0 uuid.UUID) (postgres.UserRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserGetRole", arg0)
+	ret0, _ := ret[0].(postgres.UserRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserGetRole indicates an expected call of UserGetRole.
+func (mr *MockPostgresMockRecorder) UserGetRole(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserGetRole", reflect.TypeOf((*MockPostgres)(nil).UserGetRole), arg0)
+}
+
 // UserIsDeleted mocks base method.
 func (m *MockPostgres) UserIsDeleted(arg0 uuid.UUID) (bool, error) {
 	m.ctrl.T.Helper()
@@ -352,6 +984,21 @@ func (mr *MockPostgresMockRecorder) UserIsDeleted(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserIsDeleted", reflect.TypeOf((*MockPostgres)(nil).UserIsDeleted), arg0)
 }
 
+// UserIsFrozen mocks base method.
+func (m *MockPostgres) UserIsFrozen(arg0 uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserIsFrozen", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserIsFrozen indicates an expected call of UserIsFrozen.
+func (mr *MockPostgresMockRecorder) UserIsFrozen(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserIsFrozen", reflect.TypeOf((*MockPostgres)(nil).UserIsFrozen), arg0)
+}
+
 // UserRegister mocks base method.
 func (m *MockPostgres) UserRegister(arg0 *models.UserAccount) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -366,3 +1013,119 @@ func (mr *MockPostgresMockRecorder) UserRegister(arg0 interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserRegister", reflect.TypeOf((*MockPostgres)(nil).UserRegister), arg0)
 }
+
+// UserSetFrozen mocks base method.
+func (m *MockPostgres) UserSetFrozen(arg0 uuid.UUID, arg1 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserSetFrozen", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserSetFrozen indicates an expected call of UserSetFrozen.
+func (mr *MockPostgresMockRecorder) UserSetFrozen(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserSetFrozen", reflect.TypeOf((*MockPostgres)(nil).UserSetFrozen), arg0, arg1)
+}
+
+// UserUpdatePassword mocks base method.
+func (m *MockPostgres) UserUpdatePassword(arg0 uuid.UUID, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserUpdatePassword", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserUpdatePassword indicates an expected call of UserUpdatePassword.
+func (mr *MockPostgresMockRecorder) UserUpdatePassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserUpdatePassword", reflect.TypeOf((*MockPostgres)(nil).UserUpdatePassword), arg0, arg1)
+}
+
+// WebhookCreate mocks base method.
+func (m *MockPostgres) WebhookCreate(arg0 uuid.UUID, arg1, arg2 string, arg3 int64) (postgres.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebhookCreate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(postgres.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WebhookCreate indicates an expected call of WebhookCreate.
+func (mr *MockPostgresMockRecorder) WebhookCreate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebhookCreate", reflect.TypeOf((*MockPostgres)(nil).WebhookCreate), arg0, arg1, arg2, arg3)
+}
+
+// WebhookDeadLettersPaginated mocks base method.
+func (m *MockPostgres) WebhookDeadLettersPaginated(arg0 uuid.UUID, arg1, arg2 int32) ([]postgres.WebhookDeadLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebhookDeadLettersPaginated", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]postgres.WebhookDeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WebhookDeadLettersPaginated indicates an expected call of WebhookDeadLettersPaginated.
+func (mr *MockPostgresMockRecorder) WebhookDeadLettersPaginated(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebhookDeadLettersPaginated", reflect.TypeOf((*MockPostgres)(nil).WebhookDeadLettersPaginated), arg0, arg1, arg2)
+}
+
+// WebhookDelete mocks base method.
+func (m *MockPostgres) WebhookDelete(arg0, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebhookDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WebhookDelete indicates an expected call of WebhookDelete.
+func (mr *MockPostgresMockRecorder) WebhookDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebhookDelete", reflect.TypeOf((*MockPostgres)(nil).WebhookDelete), arg0, arg1)
+}
+
+// WebhookDeliveriesDue mocks base method.
+func (m *MockPostgres) WebhookDeliveriesDue(arg0 int32, arg1 time.Time) ([]postgres.WebhookDeliveryDue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebhookDeliveriesDue", arg0, arg1)
+	ret0, _ := ret[0].([]postgres.WebhookDeliveryDue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WebhookDeliveriesDue indicates an expected call of WebhookDeliveriesDue.
+func (mr *MockPostgresMockRecorder) WebhookDeliveriesDue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebhookDeliveriesDue", reflect.TypeOf((*MockPostgres)(nil).WebhookDeliveriesDue), arg0, arg1)
+}
+
+// WebhookDeliveryUpdate mocks base method.
+func (m *MockPostgres) WebhookDeliveryUpdate(arg0 uuid.UUID, arg1 postgres.WebhookDeliveryStatus, arg2 time.Time, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebhookDeliveryUpdate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WebhookDeliveryUpdate indicates an expected call of WebhookDeliveryUpdate.
+func (mr *MockPostgresMockRecorder) WebhookDeliveryUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebhookDeliveryUpdate", reflect.TypeOf((*MockPostgres)(nil).WebhookDeliveryUpdate), arg0, arg1, arg2, arg3)
+}
+
+// WebhookList mocks base method.
+func (m *MockPostgres) WebhookList(arg0 uuid.UUID) ([]postgres.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebhookList", arg0)
+	ret0, _ := ret[0].([]postgres.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WebhookList indicates an expected call of WebhookList.
+func (mr *MockPostgresMockRecorder) WebhookList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebhookList", reflect.TypeOf((*MockPostgres)(nil).WebhookList), arg0)
+}
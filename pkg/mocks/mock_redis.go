@@ -5,10 +5,12 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	redis "github.com/surahman/FTeX/pkg/redis"
 )
 
 // MockRedis is a mock of Redis interface.
@@ -34,6 +36,21 @@ func (m *MockRedis) EXPECT() *MockRedisMockRecorder {
 	return m.recorder
 }
 
+// AppendLog mocks base method.
+func (m *MockRedis) AppendLog(arg0 string, arg1 int64, arg2 time.Duration, arg3 []byte) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendLog", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendLog indicates an expected call of AppendLog.
+func (mr *MockRedisMockRecorder) AppendLog(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendLog", reflect.TypeOf((*MockRedis)(nil).AppendLog), arg0, arg1, arg2, arg3)
+}
+
 // Close mocks base method.
 func (m *MockRedis) Close() error {
 	m.ctrl.T.Helper()
@@ -94,6 +111,36 @@ func (mr *MockRedisMockRecorder) Healthcheck() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Healthcheck", reflect.TypeOf((*MockRedis)(nil).Healthcheck))
 }
 
+// Incr mocks base method.
+func (m *MockRedis) Incr(arg0 string, arg1 time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Incr", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Incr indicates an expected call of Incr.
+func (mr *MockRedisMockRecorder) Incr(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Incr", reflect.TypeOf((*MockRedis)(nil).Incr), arg0, arg1)
+}
+
+// LogTail mocks base method.
+func (m *MockRedis) LogTail(arg0 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogTail", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogTail indicates an expected call of LogTail.
+func (mr *MockRedisMockRecorder) LogTail(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogTail", reflect.TypeOf((*MockRedis)(nil).LogTail), arg0)
+}
+
 // Open mocks base method.
 func (m *MockRedis) Open() error {
 	m.ctrl.T.Helper()
@@ -108,6 +155,35 @@ func (mr *MockRedisMockRecorder) Open() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockRedis)(nil).Open))
 }
 
+// Publish mocks base method.
+func (m *MockRedis) Publish(arg0 string, arg1 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockRedisMockRecorder) Publish(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockRedis)(nil).Publish), arg0, arg1)
+}
+
+// ReadLog mocks base method.
+func (m *MockRedis) ReadLog(arg0 context.Context, arg1, arg2 string, arg3 time.Duration) ([]redis.LogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadLog", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]redis.LogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadLog indicates an expected call of ReadLog.
+func (mr *MockRedisMockRecorder) ReadLog(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadLog", reflect.TypeOf((*MockRedis)(nil).ReadLog), arg0, arg1, arg2, arg3)
+}
+
 // Set mocks base method.
 func (m *MockRedis) Set(arg0 string, arg1 interface{}, arg2 time.Duration) error {
 	m.ctrl.T.Helper()
@@ -121,3 +197,49 @@ func (mr *MockRedisMockRecorder) Set(arg0, arg1, arg2 interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockRedis)(nil).Set), arg0, arg1, arg2)
 }
+
+// SetNX mocks base method.
+func (m *MockRedis) SetNX(arg0 string, arg1 interface{}, arg2 time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNX", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNX indicates an expected call of SetNX.
+func (mr *MockRedisMockRecorder) SetNX(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNX", reflect.TypeOf((*MockRedis)(nil).SetNX), arg0, arg1, arg2)
+}
+
+// Subscribe mocks base method.
+func (m *MockRedis) Subscribe(arg0 context.Context, arg1 string) (<-chan []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0, arg1)
+	ret0, _ := ret[0].(<-chan []byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockRedisMockRecorder) Subscribe(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockRedis)(nil).Subscribe), arg0, arg1)
+}
+
+// TakeTokens mocks base method.
+func (m *MockRedis) TakeTokens(arg0 string, arg1, arg2 int64, arg3 time.Duration) (bool, time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeTokens", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(time.Duration)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TakeTokens indicates an expected call of TakeTokens.
+func (mr *MockRedisMockRecorder) TakeTokens(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeTokens", reflect.TypeOf((*MockRedis)(nil).TakeTokens), arg0, arg1, arg2, arg3)
+}
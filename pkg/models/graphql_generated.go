@@ -30,8 +30,16 @@ type FiatPaginatedTxDetailsRequest struct {
 	Year       *string `json:"year,omitempty"`
 }
 
+type LoginResponse struct {
+	Token        *JWTAuthResponse          `json:"token,omitempty"`
+	MfaChallenge *HTTPMFAChallengeResponse `json:"mfaChallenge,omitempty"`
+}
+
 type Mutation struct {
 }
 
 type Query struct {
 }
+
+type Subscription struct {
+}
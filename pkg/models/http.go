@@ -1,6 +1,9 @@
 package models
 
 import (
+	"time"
+
+	"github.com/gofrs/uuid"
 	"github.com/shopspring/decimal"
 	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
 	"github.com/surahman/FTeX/pkg/postgres"
@@ -16,6 +19,26 @@ type JWTAuthResponse struct {
 	Threshold int64  `json:"threshold" validate:"required" yaml:"threshold"` // The window in seconds before expiration during which the token can be refreshed.
 }
 
+// JWKSet is a JSON Web Key Set containing the public keys that JWTs issued by the service can be verified with.
+type JWKSet struct {
+	Keys []JWK `json:"keys" yaml:"keys"`
+}
+
+// JWK is the public portion of an asymmetric JWT signing key as a JSON Web Key. RSA keys set the modulus and exponent
+// and Ed25519 keys set the curve and public key.
+//
+//nolint:lll
+type JWK struct {
+	Kty string `json:"kty"           yaml:"kty"`           // Key type of RSA or OKP.
+	Use string `json:"use"           yaml:"use"`           // Public key use, always sig for signatures.
+	Alg string `json:"alg"           yaml:"alg"`           // Signing algorithm of RS256 or EdDSA.
+	Kid string `json:"kid"           yaml:"kid"`           // Key ID that is set in the header of JWTs signed by the key.
+	Crv string `json:"crv,omitempty" yaml:"crv,omitempty"` // Curve of an Ed25519 key.
+	X   string `json:"x,omitempty"   yaml:"x,omitempty"`   // Base64 URL encoded Ed25519 public key.
+	N   string `json:"n,omitempty"   yaml:"n,omitempty"`   // Base64 URL encoded RSA modulus.
+	E   string `json:"e,omitempty"   yaml:"e,omitempty"`   // Base64 URL encoded RSA public exponent.
+}
+
 // HTTPError is a generic error message that is returned to the requester.
 type HTTPError struct {
 	Message string `json:"message,omitempty" yaml:"message,omitempty"`
@@ -35,6 +58,49 @@ type HTTPDeleteUserRequest struct {
 	Confirmation string `json:"confirmation" validate:"required" yaml:"confirmation"`
 }
 
+// HTTPMFALoginRequest is the second step of a login for a user enrolled in multifactor authentication. The challenge
+// issued by the first step must be completed with a one-time password or a recovery code.
+type HTTPMFALoginRequest struct {
+	ChallengeID string `json:"challengeId" validate:"required"        yaml:"challengeId"`
+	Code        string `json:"code"        validate:"required,max=32" yaml:"code"`
+}
+
+// HTTPMFACodeRequest is a request that must be confirmed with a one-time password or, where permitted, a recovery code.
+type HTTPMFACodeRequest struct {
+	Code string `json:"code" validate:"required,max=32" yaml:"code"`
+}
+
+// HTTPChangePasswordRequest is a request by an authenticated user to replace their password. The current password must
+// be supplied.
+type HTTPChangePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword" validate:"required,max=32"                             yaml:"currentPassword"`
+	NewPassword     string `json:"newPassword"     validate:"required,min=8,max=32,nefield=CurrentPassword" yaml:"newPassword"`
+}
+
+// HTTPPasswordResetRequest is a request to have a single-use password reset token sent to the owner of an account.
+type HTTPPasswordResetRequest struct {
+	Username string `json:"username" validate:"required,min=8,max=32" yaml:"username"`
+}
+
+// HTTPResetPasswordRequest is a request to replace a password using a password reset token.
+type HTTPResetPasswordRequest struct {
+	Token    string `json:"token"    validate:"required"              yaml:"token"`
+	Password string `json:"password" validate:"required,min=8,max=32" yaml:"password"`
+}
+
+// HTTPAPIKeyRequest is a request to create an API key for a programmatic client with the scopes it is granted.
+//
+//nolint:lll
+type HTTPAPIKeyRequest struct {
+	Name   string   `json:"name"   validate:"required,max=64"                                                          yaml:"name"`
+	Scopes []string `json:"scopes" validate:"required,min=1,unique,dive,oneof=read-balances trade deposit withdraw" yaml:"scopes"`
+}
+
+// HTTPWebhookRequest is a request to register an HTTPS URL that account events will be delivered to.
+type HTTPWebhookRequest struct {
+	URL string `json:"url" validate:"required,url,startswith=https://,max=2048" yaml:"url"`
+}
+
 // HTTPOpenCurrencyAccountRequest is a request to open an account in a specified Fiat currency.
 type HTTPOpenCurrencyAccountRequest struct {
 	Currency string `json:"currency" validate:"required" yaml:"currency"`
@@ -46,6 +112,19 @@ type HTTPDepositCurrencyRequest struct {
 	Currency string          `json:"currency" validate:"required" yaml:"currency"`
 }
 
+// HTTPWithdrawCurrencyRequest is a request to withdraw currency from a specified Fiat currency account.
+type HTTPWithdrawCurrencyRequest struct {
+	Amount   decimal.Decimal `json:"amount"   validate:"required" yaml:"amount"`
+	Currency string          `json:"currency" validate:"required" yaml:"currency"`
+}
+
+// HTTPFiatP2PTransferRequest is a request to transfer Fiat currency to another client's account in the same currency.
+type HTTPFiatP2PTransferRequest struct {
+	Username string          `json:"username" validate:"required" yaml:"username"`
+	Currency string          `json:"currency" validate:"required" yaml:"currency"`
+	Amount   decimal.Decimal `json:"amount"   validate:"required" yaml:"amount"`
+}
+
 // HTTPExchangeOfferRequest is a request to convert a source to destination currency in the source currency amount.
 type HTTPExchangeOfferRequest struct {
 	SourceCurrency      string          `json:"sourceCurrency"      validate:"required" yaml:"sourceCurrency"`
@@ -59,14 +138,53 @@ type HTTPCryptoOfferRequest struct {
 	IsPurchase               *bool `json:"isPurchase" validate:"required" yaml:"isPurchase"`
 }
 
+// HTTPOrderRequest is a request to place a limit order to convert a source to destination currency, in the source
+// currency amount, once the conversion rate is at or above the limit rate.
+type HTTPOrderRequest struct {
+	SourceCurrency      string          `json:"sourceCurrency"      validate:"required" yaml:"sourceCurrency"`
+	DestinationCurrency string          `json:"destinationCurrency" validate:"required" yaml:"destinationCurrency"`
+	SourceAmount        decimal.Decimal `json:"sourceAmount"        validate:"required" yaml:"sourceAmount"`
+	LimitRate           decimal.Decimal `json:"limitRate"           validate:"required" yaml:"limitRate"`
+}
+
+// HTTPScheduleRequest is a request to create a recurring purchase of a Cryptocurrency, in the Fiat currency amount, at
+// a set frequency. The first purchase will be made at the optional RFC3339 start time, or immediately if not provided.
+type HTTPScheduleRequest struct {
+	FiatCurrency string          `json:"fiatCurrency"      validate:"required" yaml:"fiatCurrency"`
+	Ticker       string          `json:"ticker"            validate:"required" yaml:"ticker"`
+	Amount       decimal.Decimal `json:"amount"            validate:"required" yaml:"amount"`
+	Frequency    string          `json:"frequency"         validate:"required" yaml:"frequency"`
+	StartAt      string          `json:"startAt,omitempty" yaml:"startAt,omitempty"`
+}
+
+// HTTPScheduleUpdateRequest is a request to update the amount, frequency, and active state of a recurring purchase.
+type HTTPScheduleUpdateRequest struct {
+	Amount    decimal.Decimal `json:"amount"    validate:"required" yaml:"amount"`
+	Frequency string          `json:"frequency" validate:"required" yaml:"frequency"`
+	IsActive  *bool           `json:"isActive"  validate:"required" yaml:"isActive"`
+}
+
+// HTTPRateHistoryRequest is a request for the open, high, low, and close rates of a currency pair over an RFC3339 time
+// range. The time range is split into intervals of the duration, such as 15m or 1h, starting from the beginning of the
+// range.
+type HTTPRateHistoryRequest struct {
+	Source      string `json:"source"      validate:"required" yaml:"source"`
+	Destination string `json:"destination" validate:"required" yaml:"destination"`
+	From        string `json:"from"        validate:"required" yaml:"from"`
+	To          string `json:"to"          validate:"required" yaml:"to"`
+	Interval    string `json:"interval"    validate:"required" yaml:"interval"`
+}
+
 // HTTPExchangeOfferResponse is an offer to convert a source to destination currency in the source currency amount.
 type HTTPExchangeOfferResponse struct {
 	PriceQuote       `json:"offer"                      yaml:"offer"`
 	DebitAmount      decimal.Decimal `json:"debitAmount"                yaml:"debitAmount"`
+	Fee              decimal.Decimal `json:"fee"                        yaml:"fee"`
 	OfferID          string          `json:"offerId"                    yaml:"offerId"`
 	Expires          int64           `json:"expires"                    yaml:"expires"`
 	IsCryptoPurchase bool            `json:"isCryptoPurchase,omitempty" yaml:"isCryptoPurchase,omitempty"`
 	IsCryptoSale     bool            `json:"isCryptoSale,omitempty"     yaml:"isCryptoSale,omitempty"`
+	IsCryptoSwap     bool            `json:"isCryptoSwap,omitempty"     yaml:"isCryptoSwap,omitempty"`
 }
 
 // HTTPTransferRequest is the request to accept and execute an existing exchange offer.
@@ -86,6 +204,12 @@ type HTTPCryptoTransferResponse struct {
 	CryptoTxReceipt *postgres.CryptoJournal `json:"cryptoReceipt" yaml:"cryptoReceipt"`
 }
 
+// HTTPCryptoSwapResponse is the response to a successful Cryptocurrency to Cryptocurrency swap request.
+type HTTPCryptoSwapResponse struct {
+	SrcTxReceipt *postgres.CryptoJournal `json:"sourceReceipt"      yaml:"sourceReceipt"`
+	DstTxReceipt *postgres.CryptoJournal `json:"destinationReceipt" yaml:"destinationReceipt"`
+}
+
 // HTTPFiatDetailsPaginated is the response to paginated account details request. It returns a link to the next page of
 // information.
 type HTTPFiatDetailsPaginated struct {
@@ -100,6 +224,138 @@ type HTTPFiatTransactionsPaginated struct {
 	Links              HTTPLinks              `json:"links,omitempty"`
 }
 
+// HTTPOrdersPaginated is the response to a paginated limit orders request. It returns a link to the next page of
+// information.
+type HTTPOrdersPaginated struct {
+	Orders []postgres.Order `json:"orders"`
+	Links  HTTPLinks        `json:"links,omitempty"`
+}
+
+// HTTPLoginEventsPaginated is the response to a paginated login events request. It returns a link to the next page of
+// information.
+type HTTPLoginEventsPaginated struct {
+	Events []postgres.LoginEvent `json:"events"`
+	Links  HTTPLinks             `json:"links,omitempty"`
+}
+
+// HTTPWebhookDeadLettersPaginated is the response to a paginated webhook dead letters request. It returns a link to the
+// next page of information.
+type HTTPWebhookDeadLettersPaginated struct {
+	DeadLetters []postgres.WebhookDeadLetter `json:"deadLetters"`
+	Links       HTTPLinks                    `json:"links,omitempty"`
+}
+
+// HTTPBalanceUpdate is the balance of a Fiat currency or Cryptocurrency account after an account event that changed it
+// has been committed.
+type HTTPBalanceUpdate struct {
+	EventID   uuid.UUID       `json:"eventId"`
+	EventType string          `json:"eventType"`
+	Currency  string          `json:"currency"`
+	Balance   decimal.Decimal `json:"balance"`
+	Held      decimal.Decimal `json:"held"`
+	Available decimal.Decimal `json:"available"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+}
+
+// HTTPSchedulesPaginated is the response to a paginated recurring purchase schedules request. It returns a link to the
+// next page of information.
+type HTTPSchedulesPaginated struct {
+	Schedules []postgres.Schedule `json:"schedules"`
+	Links     HTTPLinks           `json:"links,omitempty"`
+}
+
+// HTTPScheduleRunsPaginated is the response to a paginated recurring purchase schedule run history request. It returns
+// a link to the next page of information.
+type HTTPScheduleRunsPaginated struct {
+	Runs  []postgres.ScheduleRun `json:"runs"`
+	Links HTTPLinks              `json:"links,omitempty"`
+}
+
+// HTTPRateHistoryResponse is the response to a rate history request. Intervals without any recorded price quotes are
+// omitted.
+type HTTPRateHistoryResponse struct {
+	Source      string                `json:"source"`
+	Destination string                `json:"destination"`
+	Interval    string                `json:"interval"`
+	Candles     []postgres.RateCandle `json:"candles"`
+}
+
+// HTTPMFAChallengeResponse is the response to a login for a user enrolled in multifactor authentication. The challenge
+// must be completed before it expires to receive a JWT.
+type HTTPMFAChallengeResponse struct {
+	ChallengeID string `json:"challengeId"`
+	Expires     int64  `json:"expires"`
+}
+
+// HTTPMFAEnrollResponse is the response to a multifactor authentication enrollment request. The secret and recovery
+// codes are only ever returned once.
+type HTTPMFAEnrollResponse struct {
+	Secret        string   `json:"secret"`
+	URI           string   `json:"uri"`
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// HTTPMFARecoveryCodesResponse is the response to a request to regenerate the multifactor authentication recovery codes.
+type HTTPMFARecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// HTTPPasswordResetResponse is the response to a password reset request. It is returned regardless of whether the
+// account exists so as not to disclose registered usernames.
+type HTTPPasswordResetResponse struct {
+	Expires int64 `json:"expires"`
+}
+
+// HTTPAPIKeyResponse is the response to an API key creation request. The plaintext key is only ever returned once.
+type HTTPAPIKeyResponse struct {
+	Key    string          `json:"key"`
+	APIKey postgres.ApiKey `json:"apiKey"`
+}
+
+// HTTPAPIKeysResponse is the response to a request for the API keys of a client that have not been revoked.
+type HTTPAPIKeysResponse struct {
+	APIKeys []postgres.ApiKey `json:"apiKeys"`
+}
+
+// HTTPWebhookResponse is the response to a webhook registration request. The plaintext signing secret is only ever
+// returned once.
+type HTTPWebhookResponse struct {
+	Secret  string           `json:"secret"`
+	Webhook postgres.Webhook `json:"webhook"`
+}
+
+// HTTPWebhooksResponse is the response to a request for the webhooks registered by a client.
+type HTTPWebhooksResponse struct {
+	Webhooks []postgres.Webhook `json:"webhooks"`
+}
+
+// HTTPAccountStatusRequest is a request by an administrator to set the status of a Fiat currency or Cryptocurrency
+// account. The status must be one of active, frozen, or closed.
+type HTTPAccountStatusRequest struct {
+	Currency string `json:"currency" validate:"required" yaml:"currency"`
+	Status   string `json:"status"   validate:"required" yaml:"status"`
+}
+
+// HTTPAccountHoldRequest is a request by an administrator to place or release a hold on funds in a Fiat currency or
+// Cryptocurrency account.
+type HTTPAccountHoldRequest struct {
+	Currency string          `json:"currency" validate:"required" yaml:"currency"`
+	Amount   decimal.Decimal `json:"amount"   validate:"required" yaml:"amount"`
+}
+
+// HTTPAdminUserResponse is the account information of a user that is returned to administrators. The hashed password is
+// never returned.
+type HTTPAdminUserResponse struct {
+	ClientID  string `json:"clientID"`
+	Username  string `json:"username"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	IsFrozen  bool   `json:"isFrozen"`
+	IsDeleted bool   `json:"isDeleted"`
+}
+
 // HTTPLinks are links used in HTTP responses to retrieve pages of information.
 type HTTPLinks struct {
 	NextPage   string `json:"nextPage,omitempty"`
@@ -9,6 +9,8 @@ type User struct {
 	*UserAccount
 	ClientID  uuid.UUID `json:"clientId,omitempty"`
 	IsDeleted bool      `json:"isDeleted"`
+	IsFrozen  bool      `json:"isFrozen"`
+	Role      string    `json:"role,omitempty"`
 }
 
 // UserAccount is the core user account information.
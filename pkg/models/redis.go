@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"github.com/gofrs/uuid"
 	"github.com/shopspring/decimal"
 )
@@ -13,3 +15,20 @@ type PriceQuote struct {
 	Rate           decimal.Decimal `json:"rate"           validate:"required"`
 	Amount         decimal.Decimal `json:"amount"         validate:"required"`
 }
+
+// IdempotencyRecord is the outcome of a request made with an idempotency key and will be stored in the Redis cache.
+type IdempotencyRecord struct {
+	RequestHash string `json:"requestHash"`
+	InProgress  bool   `json:"inProgress"`
+	Response    []byte `json:"response"`
+}
+
+// RateLimit is the token bucket rate limit for a group of endpoints, whose buckets are stored in the Redis cache. Each
+// bucket holds up to Burst tokens and refills at a rate of Requests tokens every Period.
+//
+//nolint:lll
+type RateLimit struct {
+	Requests int64         `json:"requests,omitempty" mapstructure:"requests" validate:"required,min=1" yaml:"requests,omitempty"`
+	Period   time.Duration `json:"period,omitempty"   mapstructure:"period"   validate:"required,min=1" yaml:"period,omitempty"`
+	Burst    int64         `json:"burst,omitempty"    mapstructure:"burst"    validate:"required,min=1" yaml:"burst,omitempty"`
+}
@@ -0,0 +1,44 @@
+package notifier
+
+import (
+	"fmt"
+
+	"github.com/spf13/afero"
+	"github.com/surahman/FTeX/pkg/configloader"
+	"github.com/surahman/FTeX/pkg/constants"
+)
+
+// config contains the configurations for delivering notifications to users.
+//
+//nolint:lll
+type config struct {
+	Provider      string              `json:"provider,omitempty"      mapstructure:"provider"      validate:"required,oneof=log" yaml:"provider,omitempty"`
+	PasswordReset passwordResetConfig `json:"passwordReset,omitempty" mapstructure:"passwordReset" validate:"required"           yaml:"passwordReset,omitempty"`
+}
+
+// passwordResetConfig contains the address of the page users are directed to in order to reset their passwords. The
+// password reset token is appended to the address as a query parameter.
+//
+//nolint:lll
+type passwordResetConfig struct {
+	URL string `json:"url,omitempty" mapstructure:"url" validate:"required,url" yaml:"url,omitempty"`
+}
+
+// newConfig creates a blank configuration struct for the notifier.
+func newConfig() *config {
+	return &config{}
+}
+
+// Load will attempt to load configurations from a file on a file system.
+func (cfg *config) Load(fs afero.Fs) error {
+	if err := configloader.Load(
+		fs,
+		cfg,
+		constants.NotifierFileName(),
+		constants.NotifierPrefix(),
+		"yaml"); err != nil {
+		return fmt.Errorf("notifier config loading failed: %w", err)
+	}
+
+	return nil
+}
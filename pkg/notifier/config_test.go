@@ -0,0 +1,94 @@
+package notifier
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/validator"
+	"gopkg.in/yaml.v3"
+)
+
+func TestNotifierConfigs_Load(t *testing.T) {
+	keyspaceReset := constants.NotifierPrefix() + "_PASSWORDRESET."
+
+	testCases := []struct {
+		name         string
+		input        string
+		expectErr    require.ErrorAssertionFunc
+		expectErrCnt int
+	}{
+		{
+			name:         "empty - etc dir",
+			input:        notifierConfigTestData["empty"],
+			expectErr:    require.Error,
+			expectErrCnt: 2,
+		}, {
+			name:         "valid - etc dir",
+			input:        notifierConfigTestData["valid"],
+			expectErr:    require.NoError,
+			expectErrCnt: 0,
+		}, {
+			name:         "no provider - etc dir",
+			input:        notifierConfigTestData["no_provider"],
+			expectErr:    require.Error,
+			expectErrCnt: 1,
+		}, {
+			name:         "unsupported provider - etc dir",
+			input:        notifierConfigTestData["unsupported_provider"],
+			expectErr:    require.Error,
+			expectErrCnt: 1,
+		}, {
+			name:         "no url - etc dir",
+			input:        notifierConfigTestData["no_url"],
+			expectErr:    require.Error,
+			expectErrCnt: 1,
+		}, {
+			name:         "invalid url - etc dir",
+			input:        notifierConfigTestData["invalid_url"],
+			expectErr:    require.Error,
+			expectErrCnt: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			// Configure mock filesystem.
+			fs := afero.NewMemMapFs()
+			require.NoError(t, fs.MkdirAll(constants.EtcDir(), 0644), "Failed to create in memory directory")
+			require.NoError(t, afero.WriteFile(fs, constants.EtcDir()+constants.NotifierFileName(),
+				[]byte(test.input), 0644), "Failed to write in memory file")
+
+			// Load from mock filesystem.
+			actual := &config{}
+			err := actual.Load(fs)
+			test.expectErr(t, err)
+
+			validationError := &validator.ValidationError{}
+			if errors.As(err, &validationError) {
+				require.Lenf(t, validationError.Errors, test.expectErrCnt, "expected errors count is incorrect: %v", err)
+
+				return
+			}
+
+			// Load expected struct.
+			expected := &config{}
+			require.NoError(t, yaml.Unmarshal([]byte(test.input), expected), "failed to unmarshal expected constants")
+			require.True(t, reflect.DeepEqual(expected, actual))
+
+			// Test configuring of environment variable.
+			testURL := "https://ftex.com/password/reset"
+
+			t.Setenv(keyspaceReset+"URL", testURL)
+
+			err = actual.Load(fs)
+			require.NoErrorf(t, err, "Failed to load constants file: %v", err)
+
+			require.Equal(t, testURL, actual.PasswordReset.URL, "Failed to load url environment variable into configs")
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package notifier
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/surahman/FTeX/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Check to ensure the Notifier interface has been implemented.
+var _ Notifier = &logProvider{}
+
+// logProvider delivers notifications by writing them to the log. It does not require an external messaging service and
+// is intended for development and testing environments.
+type logProvider struct {
+	conf   *config
+	logger *logger.Logger
+}
+
+// PasswordReset will write the password reset link for a user to the log.
+func (p *logProvider) PasswordReset(recipient *Recipient, token string, expiresAt int64) error {
+	link, err := passwordResetLink(p.conf, token)
+	if err != nil {
+		p.logger.Error("failed to generate password reset link", zap.Error(err))
+
+		return fmt.Errorf("%w", err)
+	}
+
+	p.logger.Info("password reset requested",
+		zap.String("username", recipient.Username),
+		zap.String("email", recipient.Email),
+		zap.String("link", link),
+		zap.Time("expires", time.Unix(expiresAt, 0)))
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package notifier
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/spf13/afero"
+	"github.com/surahman/FTeX/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Mock Notifier interface stub generation.
+//go:generate mockgen -destination=../mocks/mock_notifier.go -package=mocks github.com/surahman/FTeX/pkg/notifier Notifier
+
+// Notifier is the interface through which notifications are delivered to users. Created to support mock testing and
+// interchangeable delivery providers.
+type Notifier interface {
+	// PasswordReset will deliver a link containing a single-use password reset token to the owner of an account. The
+	// expiration deadline of the token is a Unix timestamp.
+	PasswordReset(recipient *Recipient, token string, expiresAt int64) error
+}
+
+// Recipient contains the account and contact information of the user a notification is delivered to.
+type Recipient struct {
+	Username  string
+	FirstName string
+	Email     string
+}
+
+const (
+	// providerLog is the name of the provider that writes notifications to the log.
+	providerLog = "log"
+)
+
+// NewNotifier will create a new notification provider based on the configurations loaded from disk.
+func NewNotifier(fs *afero.Fs, logger *logger.Logger) (Notifier, error) {
+	if fs == nil || logger == nil {
+		return nil, errors.New("nil file system or logger supplied")
+	}
+
+	conf := newConfig()
+	if err := conf.Load(*fs); err != nil {
+		logger.Error("failed to load Notifier configurations from disk", zap.Error(err))
+
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return newProvider(conf, logger)
+}
+
+// newProvider will create the notification provider selected in the configurations.
+func newProvider(conf *config, logger *logger.Logger) (Notifier, error) {
+	switch conf.Provider {
+	case providerLog:
+		return &logProvider{conf: conf, logger: logger}, nil
+	default:
+		return nil, fmt.Errorf("unsupported notification provider %s", conf.Provider)
+	}
+}
+
+// passwordResetLink will generate the link a user follows to reset their password.
+func passwordResetLink(conf *config, token string) (string, error) {
+	link, err := url.Parse(conf.PasswordReset.URL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse password reset url %w", err)
+	}
+
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return link.String(), nil
+}
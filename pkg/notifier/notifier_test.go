@@ -0,0 +1,199 @@
+package notifier
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+)
+
+func TestNewNotifier(t *testing.T) {
+	t.Parallel()
+
+	validFs := afero.NewMemMapFs()
+	require.NoError(t, validFs.MkdirAll(constants.EtcDir(), 0644), "Failed to create in memory directory")
+	require.NoError(t, afero.WriteFile(validFs, constants.EtcDir()+constants.NotifierFileName(),
+		[]byte(notifierConfigTestData["valid"]), 0644), "Failed to write in memory file")
+
+	invalidFs := afero.NewMemMapFs()
+	require.NoError(t, invalidFs.MkdirAll(constants.EtcDir(), 0644), "Failed to create in memory directory")
+	require.NoError(t, afero.WriteFile(invalidFs, constants.EtcDir()+constants.NotifierFileName(),
+		[]byte(notifierConfigTestData["unsupported_provider"]), 0644), "Failed to write in memory file")
+
+	testCases := []struct {
+		name      string
+		fs        *afero.Fs
+		log       *logger.Logger
+		expectErr require.ErrorAssertionFunc
+		expectNil require.ValueAssertionFunc
+	}{
+		{
+			name:      "Invalid file system and logger",
+			fs:        nil,
+			log:       nil,
+			expectErr: require.Error,
+			expectNil: require.Nil,
+		}, {
+			name:      "Invalid file system",
+			fs:        nil,
+			log:       zapLogger,
+			expectErr: require.Error,
+			expectNil: require.Nil,
+		}, {
+			name:      "Invalid logger",
+			fs:        &validFs,
+			log:       nil,
+			expectErr: require.Error,
+			expectNil: require.Nil,
+		}, {
+			name:      "Invalid configuration",
+			fs:        &invalidFs,
+			log:       zapLogger,
+			expectErr: require.Error,
+			expectNil: require.Nil,
+		}, {
+			name:      "Valid",
+			fs:        &validFs,
+			log:       zapLogger,
+			expectErr: require.NoError,
+			expectNil: require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			notifier, err := NewNotifier(test.fs, test.log)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectNil(t, notifier, "notifier nil return expectation failed.")
+		})
+	}
+}
+
+func TestNewProvider(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		provider  string
+		expectErr require.ErrorAssertionFunc
+		expectNil require.ValueAssertionFunc
+	}{
+		{
+			name:      "log",
+			provider:  providerLog,
+			expectErr: require.NoError,
+			expectNil: require.NotNil,
+		}, {
+			name:      "unsupported",
+			provider:  "carrier-pigeon",
+			expectErr: require.Error,
+			expectNil: require.Nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			notifier, err := newProvider(&config{Provider: test.provider}, zapLogger)
+			test.expectErr(t, err, "error expectation failed.")
+			test.expectNil(t, notifier, "notifier nil return expectation failed.")
+		})
+	}
+}
+
+func TestPasswordResetLink(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		url          string
+		expectedLink string
+		expectErr    require.ErrorAssertionFunc
+	}{
+		{
+			name:         "no query",
+			url:          "http://localhost:33723/reset-password",
+			expectedLink: "http://localhost:33723/reset-password?token=abc%2B%2F%3D",
+			expectErr:    require.NoError,
+		}, {
+			name:         "existing query",
+			url:          "https://ftex.com/reset?lang=en",
+			expectedLink: "https://ftex.com/reset?lang=en&token=abc%2B%2F%3D",
+			expectErr:    require.NoError,
+		}, {
+			name:         "invalid url",
+			url:          "http://local host:%zz",
+			expectedLink: "",
+			expectErr:    require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			conf := &config{PasswordReset: passwordResetConfig{URL: test.url}}
+
+			link, err := passwordResetLink(conf, "abc+/=")
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectedLink, link, "password reset link mismatched.")
+
+			if err == nil {
+				parsed, err := url.Parse(link)
+				require.NoError(t, err, "failed to parse generated link.")
+				require.Equal(t, "abc+/=", parsed.Query().Get("token"), "token mismatched.")
+			}
+		})
+	}
+}
+
+func TestLogProvider_PasswordReset(t *testing.T) {
+	t.Parallel()
+
+	recipient := &Recipient{Username: "username1", FirstName: "first name", Email: "user@email-address.com"}
+	expiresAt := time.Now().Add(constants.PasswordResetTTL()).Unix()
+
+	testCases := []struct {
+		name      string
+		url       string
+		expectErr require.ErrorAssertionFunc
+	}{
+		{
+			name:      "valid",
+			url:       "http://localhost:33723/reset-password",
+			expectErr: require.NoError,
+		}, {
+			name:      "invalid url",
+			url:       "http://local host:%zz",
+			expectErr: require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := &logProvider{
+				conf:   &config{Provider: providerLog, PasswordReset: passwordResetConfig{URL: test.url}},
+				logger: zapLogger,
+			}
+
+			test.expectErr(t, provider.PasswordReset(recipient, "token", expiresAt), "error expectation failed.")
+		})
+	}
+}
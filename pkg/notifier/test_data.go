@@ -0,0 +1,30 @@
+package notifier
+
+// configTestData will return a map of test data containing valid and invalid Notifier configs.
+func configTestData() map[string]string {
+	return map[string]string{
+		"empty": ``,
+
+		"valid": `
+provider: log
+passwordReset:
+  url: http://localhost:33723/reset-password`,
+
+		"no_provider": `
+passwordReset:
+  url: http://localhost:33723/reset-password`,
+
+		"unsupported_provider": `
+provider: carrier-pigeon
+passwordReset:
+  url: http://localhost:33723/reset-password`,
+
+		"no_url": `
+provider: log`,
+
+		"invalid_url": `
+provider: log
+passwordReset:
+  url: not a url`,
+	}
+}
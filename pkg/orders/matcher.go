@@ -0,0 +1,214 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/poller"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/quotes"
+	"go.uber.org/zap"
+)
+
+// Matcher will periodically poll the open limit orders and settle those whose limit rate has been met.
+type Matcher struct {
+	db     postgres.Postgres
+	quotes quotes.Quotes
+	logger *logger.Logger
+	wg     *sync.WaitGroup
+}
+
+// NewMatcher will create a new limit order matcher instance in a non-running state.
+func NewMatcher(db postgres.Postgres, quotes quotes.Quotes, logger *logger.Logger, wg *sync.WaitGroup) (
+	*Matcher, error) {
+	if db == nil || quotes == nil || logger == nil || wg == nil {
+		return nil, errors.New("nil database, quotes, logger, or wait group supplied")
+	}
+
+	return &Matcher{db: db, quotes: quotes, logger: logger, wg: wg}, nil
+}
+
+// Run will poll and settle the open limit orders until a shutdown signal is received.
+func (m *Matcher) Run() {
+	poller.Run("Order matcher", constants.OrderMatcherInterval(), m.logger, m.wg, m.match)
+}
+
+// match will recover abandoned limit orders, then retrieve a batch of the least recently checked open limit orders and
+// attempt to settle each of them.
+func (m *Matcher) match() {
+	filled, reopened, err := m.db.OrdersRecoverStale(time.Now().Add(-constants.OrderMatcherStaleInterval()))
+	if err != nil {
+		m.logger.Warn("failed to recover stale limit orders", zap.Error(err))
+	} else if filled > 0 || reopened > 0 {
+		m.logger.Info("recovered stale limit orders", zap.Int64("filled", filled), zap.Int64("reopened", reopened))
+	}
+
+	orders, err := m.db.OrdersOpen(constants.OrderMatcherBatchSize())
+	if err != nil {
+		m.logger.Warn("failed to retrieve open limit orders", zap.Error(err))
+
+		return
+	}
+
+	for idx := range orders {
+		m.settle(&orders[idx])
+	}
+}
+
+// settle will retrieve a quote for a limit order and, if the limit rate has been met, claim and execute the order.
+// Orders that cannot be quoted are left open to be retried on the next poll.
+/*
+	Fiat orders are filled in the same transaction block as the transfer that settles them. Cryptocurrency orders are
+	settled by stored procedures that commit their own transaction blocks, so the transaction ID is assigned when the
+	order is claimed. An order that is abandoned in the executing state is recovered on a later poll by filling it if
+	its trade was recorded, or by reopening it otherwise.
+*/
+func (m *Matcher) settle(order *postgres.Order) {
+	orderType, err := OrderType(order.Source, order.Destination)
+	if err != nil {
+		m.fail(order.OrderID, postgres.OrderStatusOpen, err)
+
+		return
+	}
+
+	// Compute the fee, which is collected in the source currency, and ensure there are funds left to convert.
+	fee := m.quotes.Fee(order.Source, order.Destination, order.Amount, orderType.Precision())
+	if !order.Amount.GreaterThan(fee) {
+		m.fail(order.OrderID, postgres.OrderStatusOpen, errors.New("limit order amount does not cover the fee"))
+
+		return
+	}
+
+	rate, amount, err := m.quote(orderType, order.Source, order.Destination, order.Amount.Sub(fee))
+	if err != nil {
+		m.logger.Warn("failed to retrieve quote for limit order",
+			zap.String("orderID", order.OrderID.String()), zap.Error(err))
+
+		return
+	}
+
+	if rate.LessThan(order.LimitRate) || !amount.IsPositive() {
+		return
+	}
+
+	trade := &postgres.TradeDetails{Rate: rate}
+	claimTxID := uuid.NullUUID{}
+
+	if orderType == TypeFiat {
+		trade.OrderID = order.OrderID
+	} else {
+		if trade.TxID, err = uuid.NewV4(); err != nil {
+			m.logger.Warn("failed to generate transaction id for limit order",
+				zap.String("orderID", order.OrderID.String()), zap.Error(err))
+
+			return
+		}
+
+		claimTxID = uuid.NullUUID{UUID: trade.TxID, Valid: true}
+	}
+
+	// Claim the order. This will fail if the order has been cancelled since it was retrieved.
+	if err = m.db.OrderClaim(order.OrderID, rate, amount, fee, claimTxID); err != nil {
+		return
+	}
+
+	if err = m.execute(orderType, order, amount, fee, trade); err != nil {
+		// A Cryptocurrency transaction that succeeded but whose details could not be retrieved has still settled.
+		var dbErr *postgres.Error
+		if !errors.As(err, &dbErr) || error(dbErr) != postgres.ErrTransactCryptoDetails {
+			m.fail(order.OrderID, postgres.OrderStatusExecuting, err)
+
+			return
+		}
+
+		m.logger.Warn("limit order settled but transaction details could not be retrieved",
+			zap.String("orderID", order.OrderID.String()), zap.Error(err))
+	}
+
+	// Fiat orders are filled when they are settled.
+	if orderType == TypeFiat {
+		return
+	}
+
+	// An order whose fill could not be recorded will be filled when it is recovered.
+	if err = m.db.OrderFill(order.OrderID, trade.TxID); err != nil {
+		m.logger.Error("limit order settled but fill could not be recorded",
+			zap.String("orderID", order.OrderID.String()), zap.String("txID", trade.TxID.String()), zap.Error(err))
+	}
+}
+
+// quote will retrieve the conversion rate and converted amount for a limit order.
+func (m *Matcher) quote(orderType Type, source, destination string, amount decimal.Decimal) (
+	decimal.Decimal, decimal.Decimal, error) {
+	var (
+		err       error
+		rate      decimal.Decimal
+		converted decimal.Decimal
+	)
+
+	if orderType == TypeFiat {
+		rate, converted, err = m.quotes.FiatConversion(source, destination, amount, nil)
+	} else {
+		rate, converted, err = m.quotes.CryptoConversion(source, destination, amount, orderType == TypeCryptoPurchase, nil)
+	}
+
+	if err != nil {
+		return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("%w", err)
+	}
+
+	return rate, converted, nil
+}
+
+// execute will settle a claimed limit order through the Fiat or Cryptocurrency transfer paths. Limit orders are not
+// priced by a price quote offer, so the trade is recorded with the fill rate only.
+func (m *Matcher) execute(orderType Type, order *postgres.Order, amount, fee decimal.Decimal,
+	trade *postgres.TradeDetails) error {
+	var err error
+
+	switch orderType {
+	case TypeFiat:
+		srcTxDetails := &postgres.FiatTransactionDetails{
+			ClientID: order.ClientID,
+			Currency: postgres.Currency(order.Source),
+			Amount:   order.Amount,
+			Fee:      fee,
+		}
+		dstTxDetails := &postgres.FiatTransactionDetails{
+			ClientID: order.ClientID,
+			Currency: postgres.Currency(order.Destination),
+			Amount:   amount,
+		}
+
+		_, _, err = m.db.FiatInternalTransfer(context.Background(), srcTxDetails, dstTxDetails, trade)
+	case TypeCryptoPurchase:
+		_, _, err = m.db.CryptoPurchase(order.ClientID, postgres.Currency(order.Source), order.Amount,
+			order.Destination, amount, fee, trade)
+	case TypeCryptoSale:
+		_, _, err = m.db.CryptoSell(order.ClientID, postgres.Currency(order.Destination), amount,
+			order.Source, order.Amount, fee, trade)
+	default:
+		return fmt.Errorf("unsupported limit order type %d", orderType)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	return nil
+}
+
+// fail will transition a limit order from its current status to failed and log the reason.
+func (m *Matcher) fail(orderID uuid.UUID, current postgres.OrderStatus, reason error) {
+	m.logger.Warn("limit order failed", zap.String("orderID", orderID.String()), zap.Error(reason))
+
+	if err := m.db.OrderUpdateStatus(orderID, current, postgres.OrderStatusFailed); err != nil {
+		m.logger.Warn("failed to mark limit order as failed", zap.String("orderID", orderID.String()), zap.Error(err))
+	}
+}
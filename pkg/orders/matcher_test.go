@@ -0,0 +1,291 @@
+package orders
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/quotes"
+)
+
+func TestMatcher_NewMatcher(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var waitGroup sync.WaitGroup
+
+	matcher, err := NewMatcher(nil, quotes.NewMockQuotes(mockCtrl), zapLogger, &waitGroup)
+	require.Error(t, err, "created matcher with nil database.")
+	require.Nil(t, matcher, "matcher returned with nil database.")
+
+	matcher, err = NewMatcher(mocks.NewMockPostgres(mockCtrl), quotes.NewMockQuotes(mockCtrl), zapLogger, &waitGroup)
+	require.NoError(t, err, "failed to create matcher.")
+	require.NotNil(t, matcher, "nil matcher returned.")
+}
+
+func TestMatcher_Match(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockDB := mocks.NewMockPostgres(mockCtrl)
+	mockQuotes := quotes.NewMockQuotes(mockCtrl)
+
+	gomock.InOrder(
+		mockDB.EXPECT().OrdersRecoverStale(gomock.Any()).
+			Return(int64(0), int64(0), postgres.ErrUpdateOrder).
+			Times(1),
+
+		mockDB.EXPECT().OrdersOpen(gomock.Any()).
+			Return(nil, postgres.ErrNotFound).
+			Times(1),
+
+		mockDB.EXPECT().OrdersRecoverStale(gomock.Any()).
+			Return(int64(1), int64(1), nil).
+			Times(1),
+
+		mockDB.EXPECT().OrdersOpen(gomock.Any()).
+			Return([]postgres.Order{{Source: "ETH", Destination: "BTC"}, {Source: "USD", Destination: "USD"}}, nil).
+			Times(1),
+	)
+
+	mockDB.EXPECT().OrderUpdateStatus(gomock.Any(), postgres.OrderStatusOpen, postgres.OrderStatusFailed).
+		Return(nil).
+		Times(2)
+
+	matcher := &Matcher{db: mockDB, quotes: mockQuotes, logger: zapLogger}
+
+	matcher.match()
+	matcher.match()
+}
+
+func TestMatcher_Settle(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	txID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate transaction id.")
+
+	var (
+		fee        = decimal.NewFromFloat(1)
+		limitRate  = decimal.NewFromFloat(1.25)
+		goodRate   = decimal.NewFromFloat(1.3)
+		poorRate   = decimal.NewFromFloat(1.2)
+		amount     = decimal.NewFromFloat(100)
+		converted  = decimal.NewFromFloat(128.7)
+		fiatResult = &postgres.FiatAccountTransferResult{TxID: txID}
+		fiatJrnl   = &postgres.FiatJournal{TxID: txID}
+	)
+
+	testCases := []struct {
+		name           string
+		source         string
+		destination    string
+		feeAmount      decimal.Decimal
+		quoteRate      decimal.Decimal
+		quoteErr       error
+		fiatQuoteTimes int
+		cryptoTimes    int
+		claimErr       error
+		claimTimes     int
+		fiatTransTimes int
+		purchaseTimes  int
+		sellTimes      int
+		transferErr    error
+		failTimes      int
+		failOpenTimes  int
+		fillErr        error
+		fillTimes      int
+	}{
+		{
+			name:           "fiat order filled",
+			source:         "USD",
+			destination:    "CAD",
+			feeAmount:      fee,
+			quoteRate:      goodRate,
+			fiatQuoteTimes: 1,
+			claimTimes:     1,
+			fiatTransTimes: 1,
+		}, {
+			name:          "crypto purchase filled",
+			source:        "USD",
+			destination:   "BTC",
+			feeAmount:     fee,
+			quoteRate:     goodRate,
+			cryptoTimes:   1,
+			claimTimes:    1,
+			purchaseTimes: 1,
+			fillTimes:     1,
+		}, {
+			name:        "crypto sale filled",
+			source:      "BTC",
+			destination: "USD",
+			feeAmount:   fee,
+			quoteRate:   goodRate,
+			cryptoTimes: 1,
+			claimTimes:  1,
+			sellTimes:   1,
+			fillTimes:   1,
+		}, {
+			name:           "rate below limit",
+			source:         "USD",
+			destination:    "CAD",
+			feeAmount:      fee,
+			quoteRate:      poorRate,
+			fiatQuoteTimes: 1,
+		}, {
+			name:           "quote failure",
+			source:         "USD",
+			destination:    "CAD",
+			feeAmount:      fee,
+			quoteRate:      goodRate,
+			quoteErr:       errors.New("quote failure"),
+			fiatQuoteTimes: 1,
+		}, {
+			name:          "fee exceeds amount",
+			source:        "USD",
+			destination:   "CAD",
+			feeAmount:     amount,
+			failOpenTimes: 1,
+		}, {
+			name:           "order cancelled before claim",
+			source:         "USD",
+			destination:    "CAD",
+			feeAmount:      fee,
+			quoteRate:      goodRate,
+			fiatQuoteTimes: 1,
+			claimErr:       postgres.ErrUpdateOrder,
+			claimTimes:     1,
+		}, {
+			name:           "transfer failure",
+			source:         "USD",
+			destination:    "CAD",
+			feeAmount:      fee,
+			quoteRate:      goodRate,
+			fiatQuoteTimes: 1,
+			claimTimes:     1,
+			fiatTransTimes: 1,
+			transferErr:    postgres.ErrTransactFiat,
+			failTimes:      1,
+		}, {
+			name:          "transaction details failure",
+			source:        "USD",
+			destination:   "BTC",
+			feeAmount:     fee,
+			quoteRate:     goodRate,
+			cryptoTimes:   1,
+			claimTimes:    1,
+			purchaseTimes: 1,
+			transferErr:   postgres.ErrTransactCryptoDetails,
+			fillTimes:     1,
+		}, {
+			name:        "crypto transfer failure",
+			source:      "BTC",
+			destination: "USD",
+			feeAmount:   fee,
+			quoteRate:   goodRate,
+			cryptoTimes: 1,
+			claimTimes:  1,
+			sellTimes:   1,
+			transferErr: postgres.ErrTransactCrypto,
+			failTimes:   1,
+		}, {
+			name:          "fill failure",
+			source:        "USD",
+			destination:   "BTC",
+			feeAmount:     fee,
+			quoteRate:     goodRate,
+			cryptoTimes:   1,
+			claimTimes:    1,
+			purchaseTimes: 1,
+			fillErr:       postgres.ErrUpdateOrder,
+			fillTimes:     1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+
+			orderID, err := uuid.NewV4()
+			require.NoError(t, err, "failed to generate order id.")
+
+			order := &postgres.Order{
+				OrderID:     orderID,
+				ClientID:    clientID,
+				Source:      test.source,
+				Destination: test.destination,
+				Amount:      amount,
+				LimitRate:   limitRate,
+				Status:      postgres.OrderStatusOpen,
+			}
+
+			mockQuotes.EXPECT().Fee(test.source, test.destination, amount, gomock.Any()).
+				Return(test.feeAmount).
+				Times(1)
+
+			mockQuotes.EXPECT().FiatConversion(test.source, test.destination, amount.Sub(test.feeAmount), nil).
+				Return(test.quoteRate, converted, test.quoteErr).
+				Times(test.fiatQuoteTimes)
+
+			mockQuotes.EXPECT().CryptoConversion(test.source, test.destination, amount.Sub(test.feeAmount),
+				gomock.Any(), nil).
+				Return(test.quoteRate, converted, test.quoteErr).
+				Times(test.cryptoTimes)
+
+			mockDB.EXPECT().OrderClaim(orderID, test.quoteRate, converted, test.feeAmount, gomock.Any()).
+				Return(test.claimErr).
+				Times(test.claimTimes)
+
+			mockDB.EXPECT().FiatInternalTransfer(gomock.Any(), gomock.Any(), gomock.Any(),
+				&postgres.TradeDetails{Rate: test.quoteRate, OrderID: orderID}).
+				Return(fiatResult, fiatResult, test.transferErr).
+				Times(test.fiatTransTimes)
+
+			mockDB.EXPECT().CryptoPurchase(clientID, postgres.Currency(test.source), amount, test.destination,
+				converted, test.feeAmount, gomock.Any()).
+				Return(fiatJrnl, nil, test.transferErr).
+				Times(test.purchaseTimes)
+
+			mockDB.EXPECT().CryptoSell(clientID, postgres.Currency(test.destination), converted, test.source,
+				amount, test.feeAmount, gomock.Any()).
+				Return(fiatJrnl, nil, test.transferErr).
+				Times(test.sellTimes)
+
+			mockDB.EXPECT().OrderUpdateStatus(orderID, postgres.OrderStatusExecuting, postgres.OrderStatusFailed).
+				Return(nil).
+				Times(test.failTimes)
+
+			mockDB.EXPECT().OrderUpdateStatus(orderID, postgres.OrderStatusOpen, postgres.OrderStatusFailed).
+				Return(nil).
+				Times(test.failOpenTimes)
+
+			mockDB.EXPECT().OrderFill(orderID, gomock.Any()).
+				Return(test.fillErr).
+				Times(test.fillTimes)
+
+			matcher := &Matcher{db: mockDB, quotes: mockQuotes, logger: zapLogger}
+			matcher.settle(order)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package orders
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// Type is the settlement path of a limit order and is derived from the order's source and destination currencies.
+type Type int
+
+const (
+	TypeFiat           Type = iota + 1 // TypeFiat is a Fiat to Fiat currency conversion.
+	TypeCryptoPurchase                 // TypeCryptoPurchase is a Fiat to Cryptocurrency purchase.
+	TypeCryptoSale                     // TypeCryptoSale is a Cryptocurrency to Fiat sale.
+)
+
+// OrderType will determine the settlement path for a limit order. Any ticker that is not a supported Fiat currency is
+// treated as a Cryptocurrency. Cryptocurrency to Cryptocurrency limit orders are not supported.
+func OrderType(source, destination string) (Type, error) {
+	var (
+		sourceFiat      = isFiat(source)
+		destinationFiat = isFiat(destination)
+	)
+
+	for _, ticker := range []string{source, destination} {
+		if len(ticker) < 1 || len(ticker) > 6 {
+			return 0, fmt.Errorf("invalid currency ticker %s", ticker)
+		}
+	}
+
+	if source == destination {
+		return 0, errors.New("source and destination currencies must differ")
+	}
+
+	switch {
+	case sourceFiat && destinationFiat:
+		return TypeFiat, nil
+	case sourceFiat:
+		return TypeCryptoPurchase, nil
+	case destinationFiat:
+		return TypeCryptoSale, nil
+	default:
+		return 0, errors.New("cryptocurrency to cryptocurrency limit orders are not supported")
+	}
+}
+
+// Precision is the number of decimal places of the source currency for the order type.
+func (t Type) Precision() int32 {
+	if t == TypeCryptoSale {
+		return constants.DecimalPlacesCrypto()
+	}
+
+	return constants.DecimalPlacesFiat()
+}
+
+// isFiat will check whether a ticker is a supported Fiat currency code.
+func isFiat(ticker string) bool {
+	var currency postgres.Currency
+
+	return currency.Scan(ticker) == nil && currency.Valid()
+}
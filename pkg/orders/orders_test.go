@@ -0,0 +1,82 @@
+package orders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+)
+
+func TestOrders_OrderType(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name              string
+		source            string
+		destination       string
+		expectedType      Type
+		expectedPrecision int32
+		expectErr         require.ErrorAssertionFunc
+	}{
+		{
+			name:              "fiat to fiat",
+			source:            "USD",
+			destination:       "CAD",
+			expectedType:      TypeFiat,
+			expectedPrecision: constants.DecimalPlacesFiat(),
+			expectErr:         require.NoError,
+		}, {
+			name:              "fiat to crypto",
+			source:            "USD",
+			destination:       "BTC",
+			expectedType:      TypeCryptoPurchase,
+			expectedPrecision: constants.DecimalPlacesFiat(),
+			expectErr:         require.NoError,
+		}, {
+			name:              "crypto to fiat",
+			source:            "ETH",
+			destination:       "USD",
+			expectedType:      TypeCryptoSale,
+			expectedPrecision: constants.DecimalPlacesCrypto(),
+			expectErr:         require.NoError,
+		}, {
+			name:        "crypto to crypto",
+			source:      "ETH",
+			destination: "BTC",
+			expectErr:   require.Error,
+		}, {
+			name:        "same currency",
+			source:      "USD",
+			destination: "USD",
+			expectErr:   require.Error,
+		}, {
+			name:        "empty source",
+			source:      "",
+			destination: "USD",
+			expectErr:   require.Error,
+		}, {
+			name:        "destination too long",
+			source:      "USD",
+			destination: "BITCOIN",
+			expectErr:   require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			orderType, err := OrderType(test.source, test.destination)
+			test.expectErr(t, err, "error expectation failed.")
+
+			if err != nil {
+				return
+			}
+
+			require.Equal(t, test.expectedType, orderType, "order type mismatch.")
+			require.Equal(t, test.expectedPrecision, orderType.Precision(), "precision mismatch.")
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package poller
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/surahman/FTeX/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Run will invoke the poll function at every interval until a shutdown signal is received. The name identifies the
+// background worker in the logs. The wait group is marked done when the poller exits.
+func Run(name string, interval time.Duration, logger *logger.Logger, wg *sync.WaitGroup, poll func()) {
+	// Wait for interrupt signal to gracefully shut down the poller.
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+	defer signal.Stop(quit)
+
+	run(name, interval, logger, wg, poll, quit)
+}
+
+// run will invoke the poll function at every interval until a signal is received on the quit channel.
+func run(name string, interval time.Duration, logger *logger.Logger, wg *sync.WaitGroup, poll func(),
+	quit <-chan os.Signal) {
+	// Indicate to bootstrapping thread to wait for completion.
+	defer wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	logger.Info(name+" started", zap.Duration("interval", interval))
+
+	for {
+		select {
+		case <-ticker.C:
+			poll()
+		case <-quit:
+			logger.Info(name + " exited")
+
+			return
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package poller
+
+import (
+	"os"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoller_Run(t *testing.T) {
+	t.Parallel()
+
+	var (
+		waitGroup sync.WaitGroup
+		polls     atomic.Int32
+	)
+
+	quit := make(chan os.Signal, 1)
+	polled := make(chan struct{}, 1)
+
+	waitGroup.Add(1)
+
+	go run("Test poller", time.Millisecond, zapLogger, &waitGroup, func() {
+		if polls.Add(1) == 2 {
+			polled <- struct{}{}
+		}
+	}, quit)
+
+	select {
+	case <-polled:
+	case <-time.After(time.Second):
+		require.FailNow(t, "poller did not poll at its interval.")
+	}
+
+	quit <- syscall.SIGTERM
+
+	waitGroup.Wait()
+
+	count := polls.Load()
+	time.Sleep(5 * time.Millisecond)
+	require.Equal(t, count, polls.Load(), "poller continued polling after it exited.")
+}
@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeys_APIKeys(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	clientIDs := insertTestUsers(t)
+	resetTestAPIKeys(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	defer cancel()
+
+	var keyID uuid.UUID
+
+	t.Run("create", func(t *testing.T) {
+		apiKey, err := connection.Query.apiKeyCreate(ctx, &apiKeyCreateParams{
+			ClientID: clientIDs[0],
+			Name:     "trading bot",
+			KeyHash:  "hash-1",
+			Scopes:   []string{"read-balances", "trade"},
+		})
+		require.NoError(t, err, "failed to create api key.")
+		require.Equal(t, clientIDs[0], apiKey.ClientID, "client ID mismatch.")
+		require.Equal(t, []string{"read-balances", "trade"}, apiKey.Scopes, "scopes mismatch.")
+		require.False(t, apiKey.RevokedAt.Valid, "new api key is revoked.")
+
+		keyID = apiKey.KeyID
+
+		_, err = connection.Query.apiKeyCreate(ctx, &apiKeyCreateParams{
+			ClientID: clientIDs[1],
+			Name:     "duplicate",
+			KeyHash:  "hash-1",
+			Scopes:   []string{"trade"},
+		})
+		require.Error(t, err, "created an api key with a duplicate hash.")
+
+		_, err = connection.Query.apiKeyCreate(ctx, &apiKeyCreateParams{
+			ClientID: uuid.Must(uuid.NewV4()),
+			Name:     "unknown",
+			KeyHash:  "hash-2",
+			Scopes:   []string{"trade"},
+		})
+		require.Error(t, err, "created an api key for an unknown client.")
+	})
+
+	t.Run("get and list", func(t *testing.T) {
+		apiKey, err := connection.Query.apiKeyGetByHash(ctx, "hash-1")
+		require.NoError(t, err, "failed to retrieve api key.")
+		require.Equal(t, keyID, apiKey.KeyID, "key ID mismatch.")
+
+		_, err = connection.Query.apiKeyGetByHash(ctx, "unknown-hash")
+		require.Error(t, err, "retrieved an unknown api key.")
+
+		apiKeys, err := connection.Query.apiKeyList(ctx, clientIDs[0])
+		require.NoError(t, err, "failed to list api keys.")
+		require.Len(t, apiKeys, 1, "api key count mismatch.")
+
+		apiKeys, err = connection.Query.apiKeyList(ctx, clientIDs[1])
+		require.NoError(t, err, "failed to list api keys for a client without any.")
+		require.Empty(t, apiKeys, "listed api keys for a client without any.")
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		rows, err := connection.Query.apiKeyRevoke(ctx, &apiKeyRevokeParams{ClientID: clientIDs[1], KeyID: keyID})
+		require.NoError(t, err, "failed to attempt revoking another client's api key.")
+		require.Equal(t, int64(0), rows, "revoked another client's api key.")
+
+		rows, err = connection.Query.apiKeyRevoke(ctx, &apiKeyRevokeParams{ClientID: clientIDs[0], KeyID: keyID})
+		require.NoError(t, err, "failed to revoke api key.")
+		require.Equal(t, int64(1), rows, "incorrect rows affected on revoke.")
+
+		rows, err = connection.Query.apiKeyRevoke(ctx, &apiKeyRevokeParams{ClientID: clientIDs[0], KeyID: keyID})
+		require.NoError(t, err, "failed to revoke api key twice.")
+		require.Equal(t, int64(0), rows, "revoked an api key twice.")
+
+		_, err = connection.Query.apiKeyGetByHash(ctx, "hash-1")
+		require.Error(t, err, "retrieved a revoked api key.")
+
+		apiKeys, err := connection.Query.apiKeyList(ctx, clientIDs[0])
+		require.NoError(t, err, "failed to list api keys after revocation.")
+		require.Empty(t, apiKeys, "listed a revoked api key.")
+	})
+}
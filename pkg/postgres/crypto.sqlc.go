@@ -33,7 +33,7 @@ func (q *Queries) cryptoCreateAccount(ctx context.Context, arg *cryptoCreateAcco
 }
 
 const cryptoGetAccount = `-- name: cryptoGetAccount :one
-SELECT ticker, balance, last_tx, last_tx_ts, created_at, client_id
+SELECT ticker, balance, last_tx, last_tx_ts, created_at, client_id, status, held, available
 FROM crypto_accounts
 WHERE client_id=$1 AND ticker=$2
 `
@@ -54,12 +54,15 @@ func (q *Queries) cryptoGetAccount(ctx context.Context, arg *cryptoGetAccountPar
 		&i.LastTxTs,
 		&i.CreatedAt,
 		&i.ClientID,
+		&i.Status,
+		&i.Held,
+		&i.Available,
 	)
 	return i, err
 }
 
 const cryptoGetAllAccounts = `-- name: cryptoGetAllAccounts :many
-SELECT ticker, balance, last_tx, last_tx_ts, created_at, client_id
+SELECT ticker, balance, last_tx, last_tx_ts, created_at, client_id, status, held, available
 FROM crypto_accounts
 WHERE client_id=$1 AND ticker >= $2
 ORDER BY ticker
@@ -89,6 +92,9 @@ func (q *Queries) cryptoGetAllAccounts(ctx context.Context, arg *cryptoGetAllAcc
 			&i.LastTxTs,
 			&i.CreatedAt,
 			&i.ClientID,
+			&i.Status,
+			&i.Held,
+			&i.Available,
 		); err != nil {
 			return nil, err
 		}
@@ -196,7 +202,8 @@ func (q *Queries) cryptoGetJournalTransaction(ctx context.Context, arg *cryptoGe
 }
 
 const cryptoPurchase = `-- name: cryptoPurchase :exec
-CALL purchase_cryptocurrency($1,$2,$3, $5::numeric(18, 2), $4, $6::numeric(24, 8))
+CALL purchase_cryptocurrency($1,$2,$3, $5::numeric(18, 2), $4, $6::numeric(24, 8),
+    $7::numeric(18, 2), $8::numeric(32, 16), $9::varchar(32))
 `
 
 type cryptoPurchaseParams struct {
@@ -206,9 +213,12 @@ type cryptoPurchaseParams struct {
 	CryptoTicker       string          `json:"CryptoTicker"`
 	FiatDebitAmount    decimal.Decimal `json:"fiatDebitAmount"`
 	CryptoCreditAmount decimal.Decimal `json:"cryptoCreditAmount"`
+	FiatFeeAmount      decimal.Decimal `json:"fiatFeeAmount"`
+	Rate               decimal.Decimal `json:"rate"`
+	OfferID            string          `json:"offerID"`
 }
 
-// cryptoPurchase will execute a transaction to purchase a Cryptocurrency using a Fiat currency.
+// cryptoPurchase will execute a transaction to purchase a Cryptocurrency using a Fiat currency and record the trade.
 func (q *Queries) cryptoPurchase(ctx context.Context, arg *cryptoPurchaseParams) error {
 	_, err := q.db.Exec(ctx, cryptoPurchase,
 		arg.TransactionID,
@@ -217,12 +227,16 @@ func (q *Queries) cryptoPurchase(ctx context.Context, arg *cryptoPurchaseParams)
 		arg.CryptoTicker,
 		arg.FiatDebitAmount,
 		arg.CryptoCreditAmount,
+		arg.FiatFeeAmount,
+		arg.Rate,
+		arg.OfferID,
 	)
 	return err
 }
 
 const cryptoSell = `-- name: cryptoSell :exec
-CALL sell_cryptocurrency($1,$2,$3, $5::numeric(18, 2), $4, $6::numeric(24, 8))
+CALL sell_cryptocurrency($1,$2,$3, $5::numeric(18, 2), $4, $6::numeric(24, 8),
+    $7::numeric(24, 8), $8::numeric(32, 16), $9::varchar(32))
 `
 
 type cryptoSellParams struct {
@@ -232,9 +246,12 @@ type cryptoSellParams struct {
 	CryptoTicker      string          `json:"CryptoTicker"`
 	FiatCreditAmount  decimal.Decimal `json:"fiatCreditAmount"`
 	CryptoDebitAmount decimal.Decimal `json:"cryptoDebitAmount"`
+	CryptoFeeAmount   decimal.Decimal `json:"cryptoFeeAmount"`
+	Rate              decimal.Decimal `json:"rate"`
+	OfferID           string          `json:"offerID"`
 }
 
-// cryptoSell will execute a transaction to sell a Cryptocurrency and purchase a Fiat currency.
+// cryptoSell will execute a transaction to sell a Cryptocurrency, purchase a Fiat currency, and record the trade.
 func (q *Queries) cryptoSell(ctx context.Context, arg *cryptoSellParams) error {
 	_, err := q.db.Exec(ctx, cryptoSell,
 		arg.TransactionID,
@@ -243,6 +260,101 @@ func (q *Queries) cryptoSell(ctx context.Context, arg *cryptoSellParams) error {
 		arg.CryptoTicker,
 		arg.FiatCreditAmount,
 		arg.CryptoDebitAmount,
+		arg.CryptoFeeAmount,
+		arg.Rate,
+		arg.OfferID,
 	)
 	return err
 }
+
+const cryptoSetAccountStatus = `-- name: cryptoSetAccountStatus :one
+UPDATE crypto_accounts
+SET status=$3
+WHERE client_id=$1 AND ticker=$2
+RETURNING ticker, balance, last_tx, last_tx_ts, created_at, client_id, status, held, available
+`
+
+type cryptoSetAccountStatusParams struct {
+	ClientID uuid.UUID     `json:"clientID"`
+	Ticker   string        `json:"ticker"`
+	Status   AccountStatus `json:"status"`
+}
+
+// cryptoSetAccountStatus will set the status of a specific user's account for a given cryptocurrency ticker.
+func (q *Queries) cryptoSetAccountStatus(ctx context.Context, arg *cryptoSetAccountStatusParams) (CryptoAccount, error) {
+	row := q.db.QueryRow(ctx, cryptoSetAccountStatus, arg.ClientID, arg.Ticker, arg.Status)
+	var i CryptoAccount
+	err := row.Scan(
+		&i.Ticker,
+		&i.Balance,
+		&i.LastTx,
+		&i.LastTxTs,
+		&i.CreatedAt,
+		&i.ClientID,
+		&i.Status,
+		&i.Held,
+		&i.Available,
+	)
+	return i, err
+}
+
+const cryptoSwap = `-- name: cryptoSwap :exec
+CALL swap_cryptocurrency($1,$2,$3, $5::numeric(24, 8), $4, $6::numeric(24, 8),
+    $7::numeric(24, 8))
+`
+
+type cryptoSwapParams struct {
+	TransactionID           uuid.UUID       `json:"TransactionID"`
+	ClientID                uuid.UUID       `json:"ClientID"`
+	SourceTicker            string          `json:"SourceTicker"`
+	DestinationTicker       string          `json:"DestinationTicker"`
+	SourceDebitAmount       decimal.Decimal `json:"sourceDebitAmount"`
+	DestinationCreditAmount decimal.Decimal `json:"destinationCreditAmount"`
+	SourceFeeAmount         decimal.Decimal `json:"sourceFeeAmount"`
+}
+
+// cryptoSwap will execute a transaction to sell a source Cryptocurrency and purchase a destination Cryptocurrency.
+func (q *Queries) cryptoSwap(ctx context.Context, arg *cryptoSwapParams) error {
+	_, err := q.db.Exec(ctx, cryptoSwap,
+		arg.TransactionID,
+		arg.ClientID,
+		arg.SourceTicker,
+		arg.DestinationTicker,
+		arg.SourceDebitAmount,
+		arg.DestinationCreditAmount,
+		arg.SourceFeeAmount,
+	)
+	return err
+}
+
+const cryptoUpdateAccountHold = `-- name: cryptoUpdateAccountHold :one
+UPDATE crypto_accounts
+SET held=held + $3::numeric(24, 8)
+WHERE client_id=$1 AND ticker=$2 AND held + $3::numeric(24, 8) BETWEEN 0 AND balance
+RETURNING ticker, balance, last_tx, last_tx_ts, created_at, client_id, status, held, available
+`
+
+type cryptoUpdateAccountHoldParams struct {
+	ClientID uuid.UUID       `json:"clientID"`
+	Ticker   string          `json:"ticker"`
+	Amount   decimal.Decimal `json:"amount"`
+}
+
+// cryptoUpdateAccountHold will add an amount to the funds on hold in a specific user's account for a given
+// cryptocurrency ticker. The funds on hold cannot be negative or exceed the balance.
+func (q *Queries) cryptoUpdateAccountHold(ctx context.Context, arg *cryptoUpdateAccountHoldParams) (CryptoAccount, error) {
+	row := q.db.QueryRow(ctx, cryptoUpdateAccountHold, arg.ClientID, arg.Ticker, arg.Amount)
+	var i CryptoAccount
+	err := row.Scan(
+		&i.Ticker,
+		&i.Balance,
+		&i.LastTx,
+		&i.LastTxTs,
+		&i.CreatedAt,
+		&i.ClientID,
+		&i.Status,
+		&i.Held,
+		&i.Available,
+	)
+	return i, err
+}
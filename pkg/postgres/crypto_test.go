@@ -97,6 +97,7 @@ func TestCrypto_CryptoPurchase(t *testing.T) {
 				CryptoTicker:       "BTC",
 				FiatDebitAmount:    decimal.NewFromFloat(456.78),
 				CryptoCreditAmount: decimal.NewFromFloat(13.12345678),
+				Rate:               decimal.NewFromFloat(0.00002873),
 			},
 			expectErr: require.NoError,
 		}, {
@@ -108,6 +109,7 @@ func TestCrypto_CryptoPurchase(t *testing.T) {
 				CryptoTicker:       "BTC",
 				FiatDebitAmount:    decimal.NewFromFloat(2389.33),
 				CryptoCreditAmount: decimal.NewFromFloat(104.80808081),
+				Rate:               decimal.NewFromFloat(0.00002873),
 			},
 			expectErr: require.NoError,
 		}, {
@@ -119,6 +121,7 @@ func TestCrypto_CryptoPurchase(t *testing.T) {
 				CryptoTicker:       "BTC",
 				FiatDebitAmount:    decimal.NewFromFloat(456.78),
 				CryptoCreditAmount: decimal.NewFromFloat(13.12345678),
+				Rate:               decimal.NewFromFloat(0.00002873),
 			},
 			expectErr: require.Error,
 		}, {
@@ -130,6 +133,7 @@ func TestCrypto_CryptoPurchase(t *testing.T) {
 				CryptoTicker:       "BAD",
 				FiatDebitAmount:    decimal.NewFromFloat(77.99),
 				CryptoCreditAmount: decimal.NewFromFloat(4.0000003),
+				Rate:               decimal.NewFromFloat(0.00002873),
 			},
 			expectErr: require.Error,
 		}, {
@@ -141,6 +145,7 @@ func TestCrypto_CryptoPurchase(t *testing.T) {
 				CryptoTicker:       "BTC",
 				FiatDebitAmount:    decimal.NewFromFloat(9999999.99),
 				CryptoCreditAmount: decimal.NewFromFloat(6.1100005),
+				Rate:               decimal.NewFromFloat(0.00002873),
 			},
 			expectErr: require.Error,
 		},
@@ -333,6 +338,7 @@ func TestCrypto_CryptoSell(t *testing.T) {
 				CryptoTicker:      "BTC",
 				FiatCreditAmount:  decimal.NewFromFloat(992.91),
 				CryptoDebitAmount: decimal.NewFromFloat(9.11992012),
+				Rate:              decimal.NewFromFloat(34806.43),
 			},
 			expectErr: require.NoError,
 		}, {
@@ -344,6 +350,7 @@ func TestCrypto_CryptoSell(t *testing.T) {
 				CryptoTicker:      "BTC",
 				FiatCreditAmount:  decimal.NewFromFloat(7765.32),
 				CryptoDebitAmount: decimal.NewFromFloat(11.40404049),
+				Rate:              decimal.NewFromFloat(34806.43),
 			},
 			expectErr: require.NoError,
 		}, {
@@ -355,6 +362,7 @@ func TestCrypto_CryptoSell(t *testing.T) {
 				CryptoTicker:      "BTC",
 				FiatCreditAmount:  decimal.NewFromFloat(555.11),
 				CryptoDebitAmount: decimal.NewFromFloat(88888.12345678),
+				Rate:              decimal.NewFromFloat(34806.43),
 			},
 			expectErr: require.Error,
 		}, {
@@ -366,6 +374,7 @@ func TestCrypto_CryptoSell(t *testing.T) {
 				CryptoTicker:      "BAD",
 				FiatCreditAmount:  decimal.NewFromFloat(77.99),
 				CryptoDebitAmount: decimal.NewFromFloat(4.0000003),
+				Rate:              decimal.NewFromFloat(34806.43),
 			},
 			expectErr: require.Error,
 		}, {
@@ -377,6 +386,7 @@ func TestCrypto_CryptoSell(t *testing.T) {
 				CryptoTicker:      "BTC",
 				FiatCreditAmount:  decimal.NewFromFloat(9999999.99),
 				CryptoDebitAmount: decimal.NewFromFloat(9191919191.1100005),
+				Rate:              decimal.NewFromFloat(34806.43),
 			},
 			expectErr: require.Error,
 		},
@@ -402,7 +412,8 @@ func TestCrypto_CryptoSell(t *testing.T) {
 	require.NoError(t, err, "error expectation condition failed.")
 
 	_, _, err = connection.CryptoPurchase(
-		clientID1, CurrencyUSD, decimal.NewFromFloat(22.22), "BTC", decimal.NewFromFloat(4444.4444))
+		clientID1, CurrencyUSD, decimal.NewFromFloat(22.22), "BTC", decimal.NewFromFloat(4444.4444), decimal.Zero,
+		&TradeDetails{Rate: decimal.NewFromFloat(0.00002873)})
 	require.NoError(t, err, "error expectation condition failed.")
 
 	// Configure wait groups for parallel run of all threads.
@@ -521,6 +532,210 @@ func TestCrypto_CryptoSell(t *testing.T) {
 		require.Empty(t, cryptoJournal, "Crypto journal entry for insufficient funds sale found.")
 	})
 }
+func TestCrypto_CryptoSwap(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	insertTestUsers(t)
+
+	// Insert an initial set of test fiat accounts.
+	clientID1, clientID2 := resetTestFiatAccounts(t)
+
+	// Insert the initial set of test fiat journal entries.
+	resetTestFiatJournal(t, clientID1, clientID2)
+
+	// Insert an initial set of test crypto accounts.
+	resetTestCryptoAccounts(t, clientID1, clientID2)
+
+	// Reset Crypto Journal entries.
+	resetTestCryptoJournal(t)
+
+	// Configure test grid.
+	txIDValid1, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate first tx id for BTC to ETH.")
+
+	txIDValid2, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate second tx id for BTC to ETH.")
+
+	txIDSame, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate tx id for BTC to BTC.")
+
+	txIDBAD, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate tx id for bad crypto ticker.")
+
+	txIDNoFunds, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate tx id for insufficient funds.")
+
+	testCases := []struct {
+		name      string
+		params    *cryptoSwapParams
+		expectErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "valid - BTC to ETH (first)",
+			params: &cryptoSwapParams{
+				TransactionID:           txIDValid1,
+				ClientID:                clientID1,
+				SourceTicker:            "BTC",
+				DestinationTicker:       "ETH",
+				SourceDebitAmount:       decimal.NewFromFloat(2.5),
+				DestinationCreditAmount: decimal.NewFromFloat(40.12345678),
+			},
+			expectErr: require.NoError,
+		}, {
+			name: "valid - BTC to ETH (second)",
+			params: &cryptoSwapParams{
+				TransactionID:           txIDValid2,
+				ClientID:                clientID1,
+				SourceTicker:            "BTC",
+				DestinationTicker:       "ETH",
+				SourceDebitAmount:       decimal.NewFromFloat(1.25),
+				DestinationCreditAmount: decimal.NewFromFloat(20.5),
+			},
+			expectErr: require.NoError,
+		}, {
+			name: "invalid - BTC to BTC",
+			params: &cryptoSwapParams{
+				TransactionID:           txIDSame,
+				ClientID:                clientID1,
+				SourceTicker:            "BTC",
+				DestinationTicker:       "BTC",
+				SourceDebitAmount:       decimal.NewFromFloat(1),
+				DestinationCreditAmount: decimal.NewFromFloat(1),
+			},
+			expectErr: require.Error,
+		}, {
+			name: "invalid - BTC to invalid crypto",
+			params: &cryptoSwapParams{
+				TransactionID:           txIDBAD,
+				ClientID:                clientID1,
+				SourceTicker:            "BTC",
+				DestinationTicker:       "BAD",
+				SourceDebitAmount:       decimal.NewFromFloat(1),
+				DestinationCreditAmount: decimal.NewFromFloat(4.0000003),
+			},
+			expectErr: require.Error,
+		}, {
+			name: "invalid - BTC insufficient funds",
+			params: &cryptoSwapParams{
+				TransactionID:           txIDNoFunds,
+				ClientID:                clientID1,
+				SourceTicker:            "BTC",
+				DestinationTicker:       "ETH",
+				SourceDebitAmount:       decimal.NewFromFloat(99999),
+				DestinationCreditAmount: decimal.NewFromFloat(6.1100005),
+			},
+			expectErr: require.Error,
+		},
+	}
+
+	// Configure context.
+	ctx, cancel := context.WithTimeout(context.TODO(), 3*time.Second)
+
+	t.Cleanup(func() {
+		cancel()
+	})
+
+	// Insert a test amount and purchase the source Cryptocurrency to check the final balances against.
+	ts1 := pgtype.Timestamptz{}
+	require.NoError(t, ts1.Scan(time.Now().UTC()), "time stamp 1 parse failed.")
+
+	_, err = connection.Query.fiatUpdateAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
+		ClientID: clientID1,
+		Currency: CurrencyUSD,
+		Amount:   decimal.NewFromFloat(5643.17),
+		LastTxTs: ts1,
+	})
+	require.NoError(t, err, "failed to deposit Fiat funds.")
+
+	txIDPurchase, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate tx id for USD to BTC.")
+
+	err = connection.Query.cryptoPurchase(ctx, &cryptoPurchaseParams{
+		TransactionID:      txIDPurchase,
+		ClientID:           clientID1,
+		FiatCurrency:       CurrencyUSD,
+		CryptoTicker:       "BTC",
+		FiatDebitAmount:    decimal.NewFromFloat(1000),
+		CryptoCreditAmount: decimal.NewFromFloat(10),
+		Rate:               decimal.NewFromFloat(0.00002873),
+	})
+	require.NoError(t, err, "failed to purchase Cryptocurrency.")
+
+	// Configure wait groups for parallel run of all threads.
+	wg := sync.WaitGroup{}
+	wg.Add(len(testCases))
+
+	// Run test grid.
+	for _, testCase := range testCases {
+		test := testCase
+
+		go func() {
+			defer wg.Done()
+
+			t.Run(test.name, func(t *testing.T) {
+				err := connection.Query.cryptoSwap(ctx, test.params)
+				test.expectErr(t, err, "error expectation failed.")
+			})
+		}()
+	}
+
+	// Wait (tie-threads).
+	wg.Wait()
+
+	// Verify results.
+	//nolint:contextcheck
+	t.Run("check end results", func(t *testing.T) {
+		cryptoOpsAcc, err := connection.Query.userGetClientId(ctx, "crypto-currencies")
+		require.NoError(t, err, "failed to retrieve Crypto operations user id.")
+
+		// Check balances.
+		sourceAccount, err := connection.CryptoBalance(clientID1, "BTC")
+		require.NoError(t, err, "failed to retrieve source Crypto account balance.")
+		require.Equal(t, sourceAccount.Balance, decimal.NewFromFloat(6.25), "source Crypto balance mismatch.")
+
+		destinationAccount, err := connection.CryptoBalance(clientID1, "ETH")
+		require.NoError(t, err, "failed to retrieve destination Crypto account balance.")
+		require.Equal(t, destinationAccount.Balance, decimal.NewFromFloat(60.62345678),
+			"destination Crypto balance mismatch.")
+
+		// Check Crypto Journal entries.
+		for idx, txID := range []uuid.UUID{txIDValid1, txIDValid2} {
+			params := testCases[idx].params
+
+			cryptoJournal, err := connection.CryptoTxDetails(clientID1, txID)
+			require.NoError(t, err, "failed to retrieve Crypto journal for valid swap.")
+			require.Len(t, cryptoJournal, 2, "invalid Crypto journal count for valid swap.")
+
+			opsJournal, err := connection.CryptoTxDetails(cryptoOpsAcc, txID)
+			require.NoError(t, err, "failed to retrieve ops Crypto journal for valid swap.")
+			require.Len(t, opsJournal, 2, "invalid ops Crypto journal count for valid swap.")
+
+			for _, entry := range append(cryptoJournal, opsJournal...) {
+				expected := params.SourceDebitAmount
+				if entry.Ticker == params.DestinationTicker {
+					expected = params.DestinationCreditAmount.Neg()
+				}
+
+				if entry.ClientID == clientID1 {
+					expected = expected.Neg()
+				}
+
+				require.Equal(t, expected, entry.Amount, "Crypto journal amount mismatch for %s.", entry.Ticker)
+			}
+		}
+
+		for _, txID := range []uuid.UUID{txIDSame, txIDBAD, txIDNoFunds} {
+			cryptoJournal, err := connection.CryptoTxDetails(clientID1, txID)
+			require.NoError(t, err, "failed to retrieve Crypto journal for invalid swap.")
+			require.Empty(t, cryptoJournal, "Crypto journal entry for invalid swap found.")
+		}
+	})
+}
+
 func TestCrypto_CryptoGetAllAccounts(t *testing.T) {
 	// Skip integration tests for short test runs.
 	if testing.Short() {
@@ -51,10 +51,31 @@ var (
 	ErrNotFoundUser          = errorNotFoundUser()             // ErrNotFoundUser is returned if a user account is not found.
 	ErrCreateFiat            = errorCreateFiat()               // ErrCreateFiat is returned if a Fiat account could not be opened.
 	ErrTransactFiat          = errorTransactionFiat()          // ErrTransactFiat is returned if a Fiat transaction fails.
+	ErrWithdrawFiat          = errorWithdrawFiat()             // ErrWithdrawFiat is returned if a Fiat withdrawal cannot be completed.
 	ErrNotFound              = errorNotFound()                 // ErrNotFound is returned as a generic not found error.
 	ErrUnhealthy             = errorUnhealthy()                // ErrUnhealthy is returned if the database cannot be pinged.
 	ErrTransactCrypto        = errorTransactionCrypto()        // ErrTransactCrypto is returned if a Crypto transaction fails.
 	ErrTransactCryptoDetails = errorTransactionCryptoDetails() // ErrTransactCryptoDetails is returned if a Crypto transaction succeeds, but transaction retrieval fails.
+	ErrCreateOrder           = errorCreateOrder()              // ErrCreateOrder is returned if a limit order could not be placed.
+	ErrUpdateOrder           = errorUpdateOrder()              // ErrUpdateOrder is returned if a limit order is not in the expected state for an update.
+	ErrCreateSchedule        = errorCreateSchedule()           // ErrCreateSchedule is returned if a recurring purchase schedule could not be created.
+	ErrUpdateSchedule        = errorUpdateSchedule()           // ErrUpdateSchedule is returned if a recurring purchase schedule is not in the expected state for an update.
+	ErrCreateScheduleRun     = errorCreateScheduleRun()        // ErrCreateScheduleRun is returned if a recurring purchase schedule run could not be recorded.
+	ErrCreateRate            = errorCreateRate()               // ErrCreateRate is returned if a currency price quote could not be recorded.
+	ErrNotEnrolledMFA        = errorNotEnrolledMFA()           // ErrNotEnrolledMFA is returned if a user is not enrolled in multifactor authentication.
+	ErrEnabledMFA            = errorEnabledMFA()               // ErrEnabledMFA is returned if a multifactor authentication enrollment is already enabled.
+	ErrUsedMFA               = errorUsedMFA()                  // ErrUsedMFA is returned if a multifactor authentication code has already been used.
+	ErrTransactMFA           = errorTransactionMFA()           // ErrTransactMFA is returned if a multifactor authentication enrollment could not be updated.
+	ErrNotFoundAPIKey        = errorNotFoundAPIKey()           // ErrNotFoundAPIKey is returned if an API key does not exist or has been revoked.
+	ErrTransactAPIKey        = errorTransactionAPIKey()        // ErrTransactAPIKey is returned if an API key could not be created, retrieved, or revoked.
+	ErrNotFoundDeposit       = errorNotFoundDeposit()          // ErrNotFoundDeposit is returned if a Fiat deposit does not exist for a client.
+	ErrReverseFiat           = errorReverseFiat()              // ErrReverseFiat is returned if a Fiat deposit has already been reversed or the account has insufficient funds.
+	ErrUpdateHold            = errorUpdateHold()               // ErrUpdateHold is returned if the funds on hold in an account would be negative or exceed its balance.
+	ErrCreateLoginEvent      = errorCreateLoginEvent()         // ErrCreateLoginEvent is returned if a login attempt could not be recorded.
+	ErrCreateWebhook         = errorCreateWebhook()            // ErrCreateWebhook is returned if a webhook is already registered or the limit has been reached.
+	ErrNotFoundWebhook       = errorNotFoundWebhook()          // ErrNotFoundWebhook is returned if a webhook does not exist for a client.
+	ErrTransactWebhook       = errorTransactionWebhook()       // ErrTransactWebhook is returned if a webhook or its deliveries could not be retrieved or updated.
+	ErrTransactOutbox        = errorTransactionOutbox()        // ErrTransactOutbox is returned if outbox events could not be claimed or released for relaying.
 )
 
 func errorRegisterUser() error {
@@ -92,6 +113,13 @@ func errorTransactionFiat() error {
 	}
 }
 
+func errorWithdrawFiat() error {
+	return &Error{
+		Message: "could not complete Fiat withdrawal, please check the account exists and has sufficient funds",
+		Code:    http.StatusBadRequest,
+	}
+}
+
 func errorNotFound() error {
 	return &Error{
 		Message: "records not found",
@@ -119,3 +147,143 @@ func errorTransactionCryptoDetails() error {
 		Code:    http.StatusInternalServerError,
 	}
 }
+
+func errorCreateOrder() error {
+	return &Error{
+		Message: "could not place limit order",
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func errorUpdateOrder() error {
+	return &Error{
+		Message: "limit order is not in the expected state",
+		Code:    http.StatusConflict,
+	}
+}
+
+func errorCreateSchedule() error {
+	return &Error{
+		Message: "could not create recurring purchase schedule",
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func errorUpdateSchedule() error {
+	return &Error{
+		Message: "recurring purchase schedule is not in the expected state",
+		Code:    http.StatusConflict,
+	}
+}
+
+func errorCreateScheduleRun() error {
+	return &Error{
+		Message: "could not record recurring purchase schedule run",
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func errorCreateRate() error {
+	return &Error{
+		Message: "could not record currency price quote",
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func errorNotEnrolledMFA() error {
+	return &Error{
+		Message: "multifactor authentication is not enrolled",
+		Code:    http.StatusNotFound,
+	}
+}
+
+func errorEnabledMFA() error {
+	return &Error{
+		Message: "multifactor authentication is already enabled",
+		Code:    http.StatusConflict,
+	}
+}
+
+func errorUsedMFA() error {
+	return &Error{
+		Message: "multifactor authentication code is invalid or has already been used",
+		Code:    http.StatusForbidden,
+	}
+}
+
+func errorTransactionMFA() error {
+	return &Error{
+		Message: "could not complete multifactor authentication request",
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func errorNotFoundAPIKey() error {
+	return &Error{
+		Message: "api key not found",
+		Code:    http.StatusNotFound,
+	}
+}
+
+func errorTransactionAPIKey() error {
+	return &Error{
+		Message: "could not complete api key request",
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func errorNotFoundDeposit() error {
+	return &Error{
+		Message: "deposit not found",
+		Code:    http.StatusNotFound,
+	}
+}
+
+func errorReverseFiat() error {
+	return &Error{
+		Message: "could not reverse Fiat deposit, please check it has not been reversed and the account has sufficient funds",
+		Code:    http.StatusConflict,
+	}
+}
+
+func errorUpdateHold() error {
+	return &Error{
+		Message: "could not update funds on hold, please check the account exists and the hold is within its balance",
+		Code:    http.StatusConflict,
+	}
+}
+
+func errorCreateLoginEvent() error {
+	return &Error{
+		Message: "could not record login attempt",
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func errorCreateWebhook() error {
+	return &Error{
+		Message: "could not register webhook, please check it is not already registered and the limit has not been reached",
+		Code:    http.StatusConflict,
+	}
+}
+
+func errorNotFoundWebhook() error {
+	return &Error{
+		Message: "webhook not found",
+		Code:    http.StatusNotFound,
+	}
+}
+
+func errorTransactionWebhook() error {
+	return &Error{
+		Message: "could not complete webhook request",
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func errorTransactionOutbox() error {
+	return &Error{
+		Message: "could not relay account events",
+		Code:    http.StatusInternalServerError,
+	}
+}
@@ -86,8 +86,97 @@ func (q *Queries) fiatExternalTransferJournalEntry(ctx context.Context, arg *fia
 	return i, err
 }
 
+const fiatExternalWithdrawalJournalEntry = `-- name: fiatExternalWithdrawalJournalEntry :one
+WITH withdrawal AS (
+    INSERT INTO fiat_journal (
+        client_id,
+        currency,
+        amount,
+        transacted_at,
+        tx_id)
+    SELECT
+        $1,
+        $2,
+        round_half_even(-1 * $3::numeric(18, 2), 2),
+        now(),
+        gen_random_uuid()
+    RETURNING tx_id, transacted_at
+)
+INSERT INTO fiat_journal (
+    client_id,
+    currency,
+    amount,
+    transacted_at,
+    tx_id)
+SELECT
+    (   SELECT client_id
+        FROM users
+        WHERE username = 'fiat-currencies'),
+    $2,
+    round_half_even($3::numeric(18, 2), 2),
+    (   SELECT transacted_at
+        FROM withdrawal),
+    (   SELECT tx_id
+        FROM withdrawal)
+RETURNING tx_id, transacted_at
+`
+
+type fiatExternalWithdrawalJournalEntryParams struct {
+	ClientID uuid.UUID       `json:"clientID"`
+	Currency Currency        `json:"currency"`
+	Amount   decimal.Decimal `json:"amount"`
+}
+
+type fiatExternalWithdrawalJournalEntryRow struct {
+	TxID         uuid.UUID          `json:"txID"`
+	TransactedAt pgtype.Timestamptz `json:"transactedAt"`
+}
+
+// fiatExternalWithdrawalJournalEntry will create both journal entries for fiat accounts outbound withdrawals.
+func (q *Queries) fiatExternalWithdrawalJournalEntry(ctx context.Context, arg *fiatExternalWithdrawalJournalEntryParams) (fiatExternalWithdrawalJournalEntryRow, error) {
+	row := q.db.QueryRow(ctx, fiatExternalWithdrawalJournalEntry, arg.ClientID, arg.Currency, arg.Amount)
+	var i fiatExternalWithdrawalJournalEntryRow
+	err := row.Scan(&i.TxID, &i.TransactedAt)
+	return i, err
+}
+
+const fiatFeeJournalEntry = `-- name: fiatFeeJournalEntry :exec
+INSERT INTO fiat_journal (
+    client_id,
+    currency,
+    amount,
+    transacted_at,
+    tx_id)
+SELECT
+    (   SELECT client_id
+        FROM users
+        WHERE username = 'fee-revenue'),
+    $1,
+    round_half_even($4::numeric(18, 2), 2),
+    $2,
+    $3
+`
+
+type fiatFeeJournalEntryParams struct {
+	Currency     Currency           `json:"currency"`
+	TransactedAt pgtype.Timestamptz `json:"transactedAt"`
+	TxID         uuid.UUID          `json:"txID"`
+	Amount       decimal.Decimal    `json:"amount"`
+}
+
+// fiatFeeJournalEntry will create the journal entry for fees collected in the fee revenue operations account.
+func (q *Queries) fiatFeeJournalEntry(ctx context.Context, arg *fiatFeeJournalEntryParams) error {
+	_, err := q.db.Exec(ctx, fiatFeeJournalEntry,
+		arg.Currency,
+		arg.TransactedAt,
+		arg.TxID,
+		arg.Amount,
+	)
+	return err
+}
+
 const fiatGetAccount = `-- name: fiatGetAccount :one
-SELECT currency, balance, last_tx, last_tx_ts, created_at, client_id
+SELECT currency, balance, last_tx, last_tx_ts, created_at, client_id, status, held, available
 FROM fiat_accounts
 WHERE client_id=$1 AND currency=$2
 `
@@ -108,12 +197,15 @@ func (q *Queries) fiatGetAccount(ctx context.Context, arg *fiatGetAccountParams)
 		&i.LastTxTs,
 		&i.CreatedAt,
 		&i.ClientID,
+		&i.Status,
+		&i.Held,
+		&i.Available,
 	)
 	return i, err
 }
 
 const fiatGetAllAccounts = `-- name: fiatGetAllAccounts :many
-SELECT currency, balance, last_tx, last_tx_ts, created_at, client_id
+SELECT currency, balance, last_tx, last_tx_ts, created_at, client_id, status, held, available
 FROM fiat_accounts
 WHERE client_id=$1 AND currency >= $2
 ORDER BY currency
@@ -143,6 +235,9 @@ func (q *Queries) fiatGetAllAccounts(ctx context.Context, arg *fiatGetAllAccount
 			&i.LastTxTs,
 			&i.CreatedAt,
 			&i.ClientID,
+			&i.Status,
+			&i.Held,
+			&i.Available,
 		); err != nil {
 			return nil, err
 		}
@@ -211,6 +306,57 @@ func (q *Queries) fiatGetAllJournalTransactionsPaginated(ctx context.Context, ar
 	return items, nil
 }
 
+const fiatGetDepositJournalEntry = `-- name: fiatGetDepositJournalEntry :one
+SELECT deposit.currency, deposit.amount, deposit.transacted_at, deposit.client_id, deposit.tx_id
+FROM fiat_journal AS deposit
+WHERE deposit.client_id = $1
+      AND deposit.tx_id = $2
+      AND deposit.amount > 0
+      AND EXISTS (
+          SELECT 1
+          FROM fiat_journal AS operations
+          WHERE operations.tx_id = deposit.tx_id
+                AND operations.amount = - deposit.amount
+                AND operations.client_id = (
+                    SELECT client_id
+                    FROM users
+                    WHERE username = 'fiat-currencies'))
+      AND (
+          SELECT COUNT(*)
+          FROM fiat_journal AS entries
+          WHERE entries.tx_id = deposit.tx_id) = 2
+      AND NOT EXISTS (
+          SELECT 1
+          FROM crypto_journal AS crypto
+          WHERE crypto.tx_id = deposit.tx_id)
+      AND NOT EXISTS (
+          SELECT 1
+          FROM trades
+          WHERE trades.tx_id = deposit.tx_id)
+LIMIT 1
+`
+
+type fiatGetDepositJournalEntryParams struct {
+	ClientID uuid.UUID `json:"clientID"`
+	TxID     uuid.UUID `json:"txID"`
+}
+
+// fiatGetDepositJournalEntry will retrieve a user's journal entry for an inbound deposit from the Fiat currency
+// operations account. Deposits consist of only the two Fiat Journal entries, which excludes the Fiat proceeds of
+// Cryptocurrency sales.
+func (q *Queries) fiatGetDepositJournalEntry(ctx context.Context, arg *fiatGetDepositJournalEntryParams) (FiatJournal, error) {
+	row := q.db.QueryRow(ctx, fiatGetDepositJournalEntry, arg.ClientID, arg.TxID)
+	var i FiatJournal
+	err := row.Scan(
+		&i.Currency,
+		&i.Amount,
+		&i.TransactedAt,
+		&i.ClientID,
+		&i.TxID,
+	)
+	return i, err
+}
+
 const fiatGetJournalTransaction = `-- name: fiatGetJournalTransaction :many
 SELECT currency, amount, transacted_at, client_id, tx_id
 FROM fiat_journal
@@ -349,8 +495,41 @@ func (q *Queries) fiatInternalTransferJournalEntry(ctx context.Context, arg *fia
 	return i, err
 }
 
+const fiatReversalCreate = `-- name: fiatReversalCreate :execrows
+INSERT INTO fiat_reversals (tx_id, client_id, currency, amount, reversal_tx_id, reversed_by, reversed_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (tx_id) DO NOTHING
+`
+
+type fiatReversalCreateParams struct {
+	TxID         uuid.UUID          `json:"txID"`
+	ClientID     uuid.UUID          `json:"clientID"`
+	Currency     Currency           `json:"currency"`
+	Amount       decimal.Decimal    `json:"amount"`
+	ReversalTxID uuid.UUID          `json:"reversalTxID"`
+	ReversedBy   uuid.UUID          `json:"reversedBy"`
+	ReversedAt   pgtype.Timestamptz `json:"reversedAt"`
+}
+
+// fiatReversalCreate will record the reversal of a Fiat deposit. Deposits that have already been reversed are ignored.
+func (q *Queries) fiatReversalCreate(ctx context.Context, arg *fiatReversalCreateParams) (int64, error) {
+	result, err := q.db.Exec(ctx, fiatReversalCreate,
+		arg.TxID,
+		arg.ClientID,
+		arg.Currency,
+		arg.Amount,
+		arg.ReversalTxID,
+		arg.ReversedBy,
+		arg.ReversedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const fiatRowLockAccount = `-- name: fiatRowLockAccount :one
-SELECT balance
+SELECT balance, held, status
 FROM fiat_accounts
 WHERE client_id=$1 AND currency=$2
 LIMIT 1
@@ -362,12 +541,49 @@ type fiatRowLockAccountParams struct {
 	Currency Currency  `json:"currency"`
 }
 
+type fiatRowLockAccountRow struct {
+	Balance decimal.Decimal `json:"balance"`
+	Held    decimal.Decimal `json:"held"`
+	Status  AccountStatus   `json:"status"`
+}
+
 // fiatRowLockAccount will acquire a row level lock without locks on the foreign keys.
-func (q *Queries) fiatRowLockAccount(ctx context.Context, arg *fiatRowLockAccountParams) (decimal.Decimal, error) {
+func (q *Queries) fiatRowLockAccount(ctx context.Context, arg *fiatRowLockAccountParams) (fiatRowLockAccountRow, error) {
 	row := q.db.QueryRow(ctx, fiatRowLockAccount, arg.ClientID, arg.Currency)
-	var balance decimal.Decimal
-	err := row.Scan(&balance)
-	return balance, err
+	var i fiatRowLockAccountRow
+	err := row.Scan(&i.Balance, &i.Held, &i.Status)
+	return i, err
+}
+
+const fiatSetAccountStatus = `-- name: fiatSetAccountStatus :one
+UPDATE fiat_accounts
+SET status=$3
+WHERE client_id=$1 AND currency=$2
+RETURNING currency, balance, last_tx, last_tx_ts, created_at, client_id, status, held, available
+`
+
+type fiatSetAccountStatusParams struct {
+	ClientID uuid.UUID     `json:"clientID"`
+	Currency Currency      `json:"currency"`
+	Status   AccountStatus `json:"status"`
+}
+
+// fiatSetAccountStatus will set the status of a specific user's account for a given currency.
+func (q *Queries) fiatSetAccountStatus(ctx context.Context, arg *fiatSetAccountStatusParams) (FiatAccount, error) {
+	row := q.db.QueryRow(ctx, fiatSetAccountStatus, arg.ClientID, arg.Currency, arg.Status)
+	var i FiatAccount
+	err := row.Scan(
+		&i.Currency,
+		&i.Balance,
+		&i.LastTx,
+		&i.LastTxTs,
+		&i.CreatedAt,
+		&i.ClientID,
+		&i.Status,
+		&i.Held,
+		&i.Available,
+	)
+	return i, err
 }
 
 const fiatUpdateAccountBalance = `-- name: fiatUpdateAccountBalance :one
@@ -404,3 +620,35 @@ func (q *Queries) fiatUpdateAccountBalance(ctx context.Context, arg *fiatUpdateA
 	err := row.Scan(&i.Balance, &i.LastTx, &i.LastTxTs)
 	return i, err
 }
+
+const fiatUpdateAccountHold = `-- name: fiatUpdateAccountHold :one
+UPDATE fiat_accounts
+SET held=held + $3::numeric(18, 2)
+WHERE client_id=$1 AND currency=$2 AND held + $3::numeric(18, 2) BETWEEN 0 AND balance
+RETURNING currency, balance, last_tx, last_tx_ts, created_at, client_id, status, held, available
+`
+
+type fiatUpdateAccountHoldParams struct {
+	ClientID uuid.UUID       `json:"clientID"`
+	Currency Currency        `json:"currency"`
+	Amount   decimal.Decimal `json:"amount"`
+}
+
+// fiatUpdateAccountHold will add an amount to the funds on hold in a specific user's account for a given currency. The
+// funds on hold cannot be negative or exceed the balance.
+func (q *Queries) fiatUpdateAccountHold(ctx context.Context, arg *fiatUpdateAccountHoldParams) (FiatAccount, error) {
+	row := q.db.QueryRow(ctx, fiatUpdateAccountHold, arg.ClientID, arg.Currency, arg.Amount)
+	var i FiatAccount
+	err := row.Scan(
+		&i.Currency,
+		&i.Balance,
+		&i.LastTx,
+		&i.LastTxTs,
+		&i.CreatedAt,
+		&i.ClientID,
+		&i.Status,
+		&i.Held,
+		&i.Available,
+	)
+	return i, err
+}
@@ -0,0 +1,139 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginEvents_LoginEventCreate(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	clientIDs := insertTestUsers(t)
+	resetTestLoginEvents(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	defer cancel()
+
+	testCases := []struct {
+		name      string
+		params    *loginEventCreateParams
+		expectErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "valid - success",
+			params: &loginEventCreateParams{
+				ClientID:  clientIDs[0],
+				Outcome:   LoginOutcomeSuccess,
+				IpAddress: "127.0.0.1",
+				UserAgent: "test-agent",
+			},
+			expectErr: require.NoError,
+		}, {
+			name: "valid - failure",
+			params: &loginEventCreateParams{
+				ClientID:  clientIDs[0],
+				Outcome:   LoginOutcomeFailure,
+				IpAddress: "::1",
+				UserAgent: "",
+			},
+			expectErr: require.NoError,
+		}, {
+			name: "unknown client",
+			params: &loginEventCreateParams{
+				ClientID:  uuid.Must(uuid.NewV4()),
+				Outcome:   LoginOutcomeLocked,
+				IpAddress: "127.0.0.1",
+				UserAgent: "test-agent",
+			},
+			expectErr: require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			test.expectErr(t, connection.Query.loginEventCreate(ctx, test.params), "error expectation failed.")
+		})
+	}
+}
+
+func TestLoginEvents_LoginEventGetAllPaginated(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	clientIDs := insertTestUsers(t)
+	resetTestLoginEvents(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	defer cancel()
+
+	outcomes := []LoginOutcome{LoginOutcomeFailure, LoginOutcomeLocked, LoginOutcomeSuccess}
+	for _, outcome := range outcomes {
+		require.NoError(t, connection.Query.loginEventCreate(ctx, &loginEventCreateParams{
+			ClientID:  clientIDs[0],
+			Outcome:   outcome,
+			IpAddress: "127.0.0.1",
+			UserAgent: "test-agent",
+		}), "failed to record login event.")
+	}
+
+	testCases := []struct {
+		name        string
+		clientID    uuid.UUID
+		offset      int32
+		limit       int32
+		expectedLen int
+	}{
+		{
+			name:        "all events",
+			clientID:    clientIDs[0],
+			offset:      0,
+			limit:       10,
+			expectedLen: 3,
+		}, {
+			name:        "first page",
+			clientID:    clientIDs[0],
+			offset:      0,
+			limit:       2,
+			expectedLen: 2,
+		}, {
+			name:        "last page",
+			clientID:    clientIDs[0],
+			offset:      2,
+			limit:       2,
+			expectedLen: 1,
+		}, {
+			name:        "no events",
+			clientID:    clientIDs[1],
+			offset:      0,
+			limit:       10,
+			expectedLen: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			events, err := connection.Query.loginEventGetAllPaginated(ctx, &loginEventGetAllPaginatedParams{
+				ClientID: test.clientID,
+				Offset:   test.offset,
+				Limit:    test.limit,
+			})
+			require.NoError(t, err, "failed to retrieve login events.")
+			require.Len(t, events, test.expectedLen, "incorrect number of login events.")
+		})
+	}
+}
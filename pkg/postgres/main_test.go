@@ -276,3 +276,108 @@ func resetTestCryptoJournal(t *testing.T) {
 
 	require.NoError(t, err, "failed to wipe crypto journal table.")
 }
+
+// resetTestRates will wipe the rates table.
+func resetTestRates(t *testing.T) {
+	t.Helper()
+
+	query := "TRUNCATE TABLE rates;"
+	ctx, cancel := context.WithTimeout(context.TODO(), constants.TwoSeconds())
+
+	defer cancel()
+
+	rows, err := connection.queries.db.Query(ctx, query)
+	rows.Close()
+
+	require.NoError(t, err, "failed to wipe rates table.")
+}
+
+// resetTestTrades will wipe the trades table.
+func resetTestTrades(t *testing.T) {
+	t.Helper()
+
+	query := "TRUNCATE TABLE trades;"
+	ctx, cancel := context.WithTimeout(context.TODO(), constants.TwoSeconds())
+
+	defer cancel()
+
+	rows, err := connection.queries.db.Query(ctx, query)
+	rows.Close()
+
+	require.NoError(t, err, "failed to wipe trades table.")
+}
+
+// resetTestMFA will wipe the multifactor authentication enrollments table.
+func resetTestMFA(t *testing.T) {
+	t.Helper()
+
+	query := "TRUNCATE TABLE mfa_enrollments;"
+	ctx, cancel := context.WithTimeout(context.TODO(), constants.TwoSeconds())
+
+	defer cancel()
+
+	rows, err := connection.queries.db.Query(ctx, query)
+	rows.Close()
+
+	require.NoError(t, err, "failed to wipe multifactor authentication enrollments table.")
+}
+
+// resetTestAPIKeys will wipe the API keys table.
+func resetTestAPIKeys(t *testing.T) {
+	t.Helper()
+
+	query := "TRUNCATE TABLE api_keys;"
+	ctx, cancel := context.WithTimeout(context.TODO(), constants.TwoSeconds())
+
+	defer cancel()
+
+	rows, err := connection.queries.db.Query(ctx, query)
+	rows.Close()
+
+	require.NoError(t, err, "failed to wipe api keys table.")
+}
+
+// resetTestFiatReversals will wipe the Fiat deposit reversals table.
+func resetTestFiatReversals(t *testing.T) {
+	t.Helper()
+
+	query := "TRUNCATE TABLE fiat_reversals;"
+	ctx, cancel := context.WithTimeout(context.TODO(), constants.TwoSeconds())
+
+	defer cancel()
+
+	rows, err := connection.queries.db.Query(ctx, query)
+	rows.Close()
+
+	require.NoError(t, err, "failed to wipe Fiat deposit reversals table.")
+}
+
+// resetTestLoginEvents will wipe the login events table.
+func resetTestLoginEvents(t *testing.T) {
+	t.Helper()
+
+	query := "TRUNCATE TABLE login_events;"
+	ctx, cancel := context.WithTimeout(context.TODO(), constants.TwoSeconds())
+
+	defer cancel()
+
+	rows, err := connection.queries.db.Query(ctx, query)
+	rows.Close()
+
+	require.NoError(t, err, "failed to wipe login events table.")
+}
+
+// resetTestWebhooks will wipe the outbox, webhooks, and webhook deliveries tables.
+func resetTestWebhooks(t *testing.T) {
+	t.Helper()
+
+	query := "TRUNCATE TABLE webhook_deliveries, webhooks, outbox;"
+	ctx, cancel := context.WithTimeout(context.TODO(), constants.TwoSeconds())
+
+	defer cancel()
+
+	rows, err := connection.queries.db.Query(ctx, query)
+	rows.Close()
+
+	require.NoError(t, err, "failed to wipe outbox and webhook tables.")
+}
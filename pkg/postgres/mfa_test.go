@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMFA_MFAEnrollment(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	clientIDs := insertTestUsers(t)
+	resetTestMFA(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	defer cancel()
+
+	t.Run("enroll", func(t *testing.T) {
+		rows, err := connection.Query.mfaEnroll(ctx, &mfaEnrollParams{
+			ClientID:      clientIDs[0],
+			Secret:        "first secret",
+			RecoveryCodes: []string{"code-1", "code-2"},
+		})
+		require.NoError(t, err, "failed to enroll.")
+		require.Equal(t, int64(1), rows, "incorrect rows affected on enrollment.")
+
+		rows, err = connection.Query.mfaEnroll(ctx, &mfaEnrollParams{
+			ClientID:      clientIDs[0],
+			Secret:        "second secret",
+			RecoveryCodes: []string{"code-3", "code-4"},
+		})
+		require.NoError(t, err, "failed to replace pending enrollment.")
+		require.Equal(t, int64(1), rows, "incorrect rows affected on pending enrollment replacement.")
+
+		enrollment, err := connection.Query.mfaGet(ctx, clientIDs[0])
+		require.NoError(t, err, "failed to retrieve enrollment.")
+		require.Equal(t, "second secret", enrollment.Secret, "pending enrollment was not replaced.")
+		require.False(t, enrollment.IsEnabled, "pending enrollment is enabled.")
+
+		_, err = connection.Query.mfaEnroll(ctx, &mfaEnrollParams{
+			ClientID:      uuid.Must(uuid.NewV4()),
+			Secret:        "secret",
+			RecoveryCodes: []string{},
+		})
+		require.Error(t, err, "enrolled an unknown client.")
+	})
+
+	t.Run("enable", func(t *testing.T) {
+		rows, err := connection.Query.mfaUseStep(ctx, &mfaUseStepParams{ClientID: clientIDs[0], LastStep: 100})
+		require.NoError(t, err, "failed to use step on pending enrollment.")
+		require.Equal(t, int64(0), rows, "used a step on a pending enrollment.")
+
+		rows, err = connection.Query.mfaEnable(ctx, &mfaEnableParams{ClientID: clientIDs[0], LastStep: 100})
+		require.NoError(t, err, "failed to enable enrollment.")
+		require.Equal(t, int64(1), rows, "incorrect rows affected on enable.")
+
+		rows, err = connection.Query.mfaEnable(ctx, &mfaEnableParams{ClientID: clientIDs[0], LastStep: 101})
+		require.NoError(t, err, "failed to enable enrollment twice.")
+		require.Equal(t, int64(0), rows, "enabled an enrollment twice.")
+
+		rows, err = connection.Query.mfaEnroll(ctx, &mfaEnrollParams{
+			ClientID:      clientIDs[0],
+			Secret:        "third secret",
+			RecoveryCodes: []string{},
+		})
+		require.NoError(t, err, "failed to attempt replacing an enabled enrollment.")
+		require.Equal(t, int64(0), rows, "replaced an enabled enrollment.")
+	})
+
+	t.Run("use step", func(t *testing.T) {
+		rows, err := connection.Query.mfaUseStep(ctx, &mfaUseStepParams{ClientID: clientIDs[0], LastStep: 100})
+		require.NoError(t, err, "failed to replay step.")
+		require.Equal(t, int64(0), rows, "replayed a step.")
+
+		rows, err = connection.Query.mfaUseStep(ctx, &mfaUseStepParams{ClientID: clientIDs[0], LastStep: 101})
+		require.NoError(t, err, "failed to use step.")
+		require.Equal(t, int64(1), rows, "incorrect rows affected on step use.")
+	})
+
+	t.Run("recovery codes", func(t *testing.T) {
+		rows, err := connection.Query.mfaUseRecoveryCode(ctx, &mfaUseRecoveryCodeParams{
+			RecoveryCode: "code-3",
+			ClientID:     clientIDs[0],
+		})
+		require.NoError(t, err, "failed to use recovery code.")
+		require.Equal(t, int64(1), rows, "incorrect rows affected on recovery code use.")
+
+		rows, err = connection.Query.mfaUseRecoveryCode(ctx, &mfaUseRecoveryCodeParams{
+			RecoveryCode: "code-3",
+			ClientID:     clientIDs[0],
+		})
+		require.NoError(t, err, "failed to reuse recovery code.")
+		require.Equal(t, int64(0), rows, "reused a recovery code.")
+
+		rows, err = connection.Query.mfaSetRecoveryCodes(ctx, &mfaSetRecoveryCodesParams{
+			ClientID:      clientIDs[0],
+			RecoveryCodes: []string{"code-5"},
+		})
+		require.NoError(t, err, "failed to replace recovery codes.")
+		require.Equal(t, int64(1), rows, "incorrect rows affected on recovery code replacement.")
+
+		enrollment, err := connection.Query.mfaGet(ctx, clientIDs[0])
+		require.NoError(t, err, "failed to retrieve enrollment.")
+		require.Equal(t, []string{"code-5"}, enrollment.RecoveryCodes, "recovery codes mismatch.")
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		rows, err := connection.Query.mfaDelete(ctx, clientIDs[0])
+		require.NoError(t, err, "failed to delete enrollment.")
+		require.Equal(t, int64(1), rows, "incorrect rows affected on delete.")
+
+		rows, err = connection.Query.mfaDelete(ctx, clientIDs[0])
+		require.NoError(t, err, "failed to delete enrollment twice.")
+		require.Equal(t, int64(0), rows, "deleted an enrollment twice.")
+
+		_, err = connection.Query.mfaGet(ctx, clientIDs[0])
+		require.Error(t, err, "retrieved a deleted enrollment.")
+	})
+}
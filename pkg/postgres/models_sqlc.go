@@ -13,6 +13,59 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+type AccountStatus string
+
+const (
+	AccountStatusActive AccountStatus = "active"
+	AccountStatusFrozen AccountStatus = "frozen"
+	AccountStatusClosed AccountStatus = "closed"
+)
+
+func (e *AccountStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AccountStatus(s)
+	case string:
+		*e = AccountStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AccountStatus: %T", src)
+	}
+	return nil
+}
+
+type NullAccountStatus struct {
+	AccountStatus AccountStatus
+	Valid         bool // Valid is true if AccountStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAccountStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.AccountStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AccountStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAccountStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AccountStatus), nil
+}
+
+func (e AccountStatus) Valid() bool {
+	switch e {
+	case AccountStatusActive,
+		AccountStatusFrozen,
+		AccountStatusClosed:
+		return true
+	}
+	return false
+}
+
 type Currency string
 
 const (
@@ -388,6 +441,399 @@ func (e Currency) Valid() bool {
 	return false
 }
 
+type LoginOutcome string
+
+const (
+	LoginOutcomeSuccess LoginOutcome = "success"
+	LoginOutcomeFailure LoginOutcome = "failure"
+	LoginOutcomeLocked  LoginOutcome = "locked"
+)
+
+func (e *LoginOutcome) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LoginOutcome(s)
+	case string:
+		*e = LoginOutcome(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LoginOutcome: %T", src)
+	}
+	return nil
+}
+
+type NullLoginOutcome struct {
+	LoginOutcome LoginOutcome
+	Valid        bool // Valid is true if LoginOutcome is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLoginOutcome) Scan(value interface{}) error {
+	if value == nil {
+		ns.LoginOutcome, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LoginOutcome.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLoginOutcome) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LoginOutcome), nil
+}
+
+func (e LoginOutcome) Valid() bool {
+	switch e {
+	case LoginOutcomeSuccess,
+		LoginOutcomeFailure,
+		LoginOutcomeLocked:
+		return true
+	}
+	return false
+}
+
+type OrderStatus string
+
+const (
+	OrderStatusOpen      OrderStatus = "open"
+	OrderStatusExecuting OrderStatus = "executing"
+	OrderStatusFilled    OrderStatus = "filled"
+	OrderStatusCancelled OrderStatus = "cancelled"
+	OrderStatusFailed    OrderStatus = "failed"
+)
+
+func (e *OrderStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrderStatus(s)
+	case string:
+		*e = OrderStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrderStatus: %T", src)
+	}
+	return nil
+}
+
+type NullOrderStatus struct {
+	OrderStatus OrderStatus
+	Valid       bool // Valid is true if OrderStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrderStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrderStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrderStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrderStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrderStatus), nil
+}
+
+func (e OrderStatus) Valid() bool {
+	switch e {
+	case OrderStatusOpen,
+		OrderStatusExecuting,
+		OrderStatusFilled,
+		OrderStatusCancelled,
+		OrderStatusFailed:
+		return true
+	}
+	return false
+}
+
+type OutboxEventType string
+
+const (
+	OutboxEventTypeFiatDeposit          OutboxEventType = "fiat_deposit"
+	OutboxEventTypeFiatExchange         OutboxEventType = "fiat_exchange"
+	OutboxEventTypeFiatTransferSent     OutboxEventType = "fiat_transfer_sent"
+	OutboxEventTypeFiatTransferReceived OutboxEventType = "fiat_transfer_received"
+	OutboxEventTypeCryptoPurchase       OutboxEventType = "crypto_purchase"
+	OutboxEventTypeCryptoSale           OutboxEventType = "crypto_sale"
+	OutboxEventTypeFiatWithdrawal       OutboxEventType = "fiat_withdrawal"
+	OutboxEventTypeFiatDepositReversal  OutboxEventType = "fiat_deposit_reversal"
+	OutboxEventTypeCryptoSwap           OutboxEventType = "crypto_swap"
+)
+
+func (e *OutboxEventType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OutboxEventType(s)
+	case string:
+		*e = OutboxEventType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OutboxEventType: %T", src)
+	}
+	return nil
+}
+
+type NullOutboxEventType struct {
+	OutboxEventType OutboxEventType
+	Valid           bool // Valid is true if OutboxEventType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOutboxEventType) Scan(value interface{}) error {
+	if value == nil {
+		ns.OutboxEventType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OutboxEventType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOutboxEventType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OutboxEventType), nil
+}
+
+func (e OutboxEventType) Valid() bool {
+	switch e {
+	case OutboxEventTypeFiatDeposit,
+		OutboxEventTypeFiatExchange,
+		OutboxEventTypeFiatTransferSent,
+		OutboxEventTypeFiatTransferReceived,
+		OutboxEventTypeCryptoPurchase,
+		OutboxEventTypeCryptoSale,
+		OutboxEventTypeFiatWithdrawal,
+		OutboxEventTypeFiatDepositReversal,
+		OutboxEventTypeCryptoSwap:
+		return true
+	}
+	return false
+}
+
+type ScheduleFrequency string
+
+const (
+	ScheduleFrequencyDaily   ScheduleFrequency = "daily"
+	ScheduleFrequencyWeekly  ScheduleFrequency = "weekly"
+	ScheduleFrequencyMonthly ScheduleFrequency = "monthly"
+)
+
+func (e *ScheduleFrequency) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ScheduleFrequency(s)
+	case string:
+		*e = ScheduleFrequency(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ScheduleFrequency: %T", src)
+	}
+	return nil
+}
+
+type NullScheduleFrequency struct {
+	ScheduleFrequency ScheduleFrequency
+	Valid             bool // Valid is true if ScheduleFrequency is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullScheduleFrequency) Scan(value interface{}) error {
+	if value == nil {
+		ns.ScheduleFrequency, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ScheduleFrequency.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullScheduleFrequency) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ScheduleFrequency), nil
+}
+
+func (e ScheduleFrequency) Valid() bool {
+	switch e {
+	case ScheduleFrequencyDaily,
+		ScheduleFrequencyWeekly,
+		ScheduleFrequencyMonthly:
+		return true
+	}
+	return false
+}
+
+type ScheduleRunStatus string
+
+const (
+	ScheduleRunStatusSucceeded ScheduleRunStatus = "succeeded"
+	ScheduleRunStatusFailed    ScheduleRunStatus = "failed"
+)
+
+func (e *ScheduleRunStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ScheduleRunStatus(s)
+	case string:
+		*e = ScheduleRunStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ScheduleRunStatus: %T", src)
+	}
+	return nil
+}
+
+type NullScheduleRunStatus struct {
+	ScheduleRunStatus ScheduleRunStatus
+	Valid             bool // Valid is true if ScheduleRunStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullScheduleRunStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ScheduleRunStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ScheduleRunStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullScheduleRunStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ScheduleRunStatus), nil
+}
+
+func (e ScheduleRunStatus) Valid() bool {
+	switch e {
+	case ScheduleRunStatusSucceeded,
+		ScheduleRunStatusFailed:
+		return true
+	}
+	return false
+}
+
+type UserRole string
+
+const (
+	UserRoleUser  UserRole = "user"
+	UserRoleAdmin UserRole = "admin"
+)
+
+func (e *UserRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UserRole(s)
+	case string:
+		*e = UserRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UserRole: %T", src)
+	}
+	return nil
+}
+
+type NullUserRole struct {
+	UserRole UserRole
+	Valid    bool // Valid is true if UserRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullUserRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.UserRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UserRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUserRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UserRole), nil
+}
+
+func (e UserRole) Valid() bool {
+	switch e {
+	case UserRoleUser,
+		UserRoleAdmin:
+		return true
+	}
+	return false
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "dead"
+)
+
+func (e *WebhookDeliveryStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WebhookDeliveryStatus(s)
+	case string:
+		*e = WebhookDeliveryStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WebhookDeliveryStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWebhookDeliveryStatus struct {
+	WebhookDeliveryStatus WebhookDeliveryStatus
+	Valid                 bool // Valid is true if WebhookDeliveryStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWebhookDeliveryStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WebhookDeliveryStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WebhookDeliveryStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWebhookDeliveryStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WebhookDeliveryStatus), nil
+}
+
+func (e WebhookDeliveryStatus) Valid() bool {
+	switch e {
+	case WebhookDeliveryStatusPending,
+		WebhookDeliveryStatusDelivered,
+		WebhookDeliveryStatusDead:
+		return true
+	}
+	return false
+}
+
+type ApiKey struct {
+	KeyID     uuid.UUID          `json:"keyID"`
+	ClientID  uuid.UUID          `json:"clientID"`
+	Name      string             `json:"name"`
+	KeyHash   string             `json:"-"`
+	Scopes    []string           `json:"scopes"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	RevokedAt pgtype.Timestamptz `json:"revokedAt"`
+}
+
 type CryptoAccount struct {
 	Ticker    string             `json:"ticker"`
 	Balance   decimal.Decimal    `json:"balance"`
@@ -395,6 +841,9 @@ type CryptoAccount struct {
 	LastTxTs  pgtype.Timestamptz `json:"lastTxTs"`
 	CreatedAt pgtype.Timestamptz `json:"createdAt"`
 	ClientID  uuid.UUID          `json:"clientID"`
+	Status    AccountStatus      `json:"status"`
+	Held      decimal.Decimal    `json:"held"`
+	Available decimal.Decimal    `json:"available"`
 }
 
 type CryptoJournal struct {
@@ -412,6 +861,9 @@ type FiatAccount struct {
 	LastTxTs  pgtype.Timestamptz `json:"lastTxTs"`
 	CreatedAt pgtype.Timestamptz `json:"createdAt"`
 	ClientID  uuid.UUID          `json:"clientID"`
+	Status    AccountStatus      `json:"status"`
+	Held      decimal.Decimal    `json:"held"`
+	Available decimal.Decimal    `json:"available"`
 }
 
 type FiatJournal struct {
@@ -422,6 +874,108 @@ type FiatJournal struct {
 	TxID         uuid.UUID          `json:"txID"`
 }
 
+type FiatReversal struct {
+	TxID         uuid.UUID          `json:"txID"`
+	ClientID     uuid.UUID          `json:"clientID"`
+	Currency     Currency           `json:"currency"`
+	Amount       decimal.Decimal    `json:"amount"`
+	ReversalTxID uuid.UUID          `json:"reversalTxID"`
+	ReversedBy   uuid.UUID          `json:"reversedBy"`
+	ReversedAt   pgtype.Timestamptz `json:"reversedAt"`
+}
+
+type LoginEvent struct {
+	EventID   uuid.UUID          `json:"eventID"`
+	ClientID  uuid.UUID          `json:"clientID"`
+	Outcome   LoginOutcome       `json:"outcome"`
+	IpAddress string             `json:"ipAddress"`
+	UserAgent string             `json:"userAgent"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+}
+
+type MfaEnrollment struct {
+	ClientID      uuid.UUID          `json:"clientID"`
+	Secret        string             `json:"secret"`
+	RecoveryCodes []string           `json:"recoveryCodes"`
+	LastStep      int64              `json:"lastStep"`
+	IsEnabled     bool               `json:"isEnabled"`
+	CreatedAt     pgtype.Timestamptz `json:"createdAt"`
+}
+
+type Order struct {
+	OrderID     uuid.UUID          `json:"orderID"`
+	ClientID    uuid.UUID          `json:"clientID"`
+	Source      string             `json:"source"`
+	Destination string             `json:"destination"`
+	Amount      decimal.Decimal    `json:"amount"`
+	LimitRate   decimal.Decimal    `json:"limitRate"`
+	Status      OrderStatus        `json:"status"`
+	FillRate    decimal.Decimal    `json:"fillRate"`
+	FillAmount  decimal.Decimal    `json:"fillAmount"`
+	Fee         decimal.Decimal    `json:"fee"`
+	TxID        uuid.NullUUID      `json:"txID"`
+	CreatedAt   pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt   pgtype.Timestamptz `json:"updatedAt"`
+	CheckedAt   pgtype.Timestamptz `json:"-"`
+}
+
+type Outbox struct {
+	EventID   uuid.UUID          `json:"eventID"`
+	ClientID  uuid.UUID          `json:"clientID"`
+	EventType OutboxEventType    `json:"eventType"`
+	Payload   []byte             `json:"payload"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	RelayedAt pgtype.Timestamptz `json:"relayedAt"`
+}
+
+type Rate struct {
+	RateID      uuid.UUID          `json:"rateID"`
+	Source      string             `json:"source"`
+	Destination string             `json:"destination"`
+	Rate        decimal.Decimal    `json:"rate"`
+	Provider    string             `json:"provider"`
+	QuotedAt    pgtype.Timestamptz `json:"quotedAt"`
+	FetchedAt   pgtype.Timestamptz `json:"fetchedAt"`
+}
+
+type Schedule struct {
+	ScheduleID   uuid.UUID          `json:"scheduleID"`
+	ClientID     uuid.UUID          `json:"clientID"`
+	FiatCurrency Currency           `json:"fiatCurrency"`
+	Ticker       string             `json:"ticker"`
+	Amount       decimal.Decimal    `json:"amount"`
+	Frequency    ScheduleFrequency  `json:"frequency"`
+	NextRunAt    pgtype.Timestamptz `json:"nextRunAt"`
+	IsActive     bool               `json:"isActive"`
+	CreatedAt    pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt    pgtype.Timestamptz `json:"updatedAt"`
+	AnchorDay    int16              `json:"-"`
+}
+
+type ScheduleRun struct {
+	RunID        uuid.UUID          `json:"runID"`
+	ScheduleID   uuid.UUID          `json:"scheduleID"`
+	ClientID     uuid.UUID          `json:"clientID"`
+	Status       ScheduleRunStatus  `json:"status"`
+	Rate         decimal.Decimal    `json:"rate"`
+	FiatAmount   decimal.Decimal    `json:"fiatAmount"`
+	CryptoAmount decimal.Decimal    `json:"cryptoAmount"`
+	Fee          decimal.Decimal    `json:"fee"`
+	TxID         uuid.NullUUID      `json:"txID"`
+	Message      string             `json:"message"`
+	ExecutedAt   pgtype.Timestamptz `json:"executedAt"`
+}
+
+type Trade struct {
+	TxID        uuid.UUID          `json:"txID"`
+	ClientID    uuid.UUID          `json:"clientID"`
+	OfferID     pgtype.Text        `json:"offerID"`
+	Source      string             `json:"source"`
+	Destination string             `json:"destination"`
+	Rate        decimal.Decimal    `json:"rate"`
+	TradedAt    pgtype.Timestamptz `json:"tradedAt"`
+}
+
 type User struct {
 	FirstName string    `json:"firstName"`
 	LastName  string    `json:"lastName"`
@@ -430,4 +984,27 @@ type User struct {
 	Password  string    `json:"password"`
 	ClientID  uuid.UUID `json:"clientID"`
 	IsDeleted bool      `json:"isDeleted"`
+	Role      UserRole  `json:"role"`
+	IsFrozen  bool      `json:"isFrozen"`
+}
+
+type Webhook struct {
+	WebhookID uuid.UUID          `json:"webhookID"`
+	ClientID  uuid.UUID          `json:"clientID"`
+	Url       string             `json:"url"`
+	Secret    string             `json:"-"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+}
+
+type WebhookDelivery struct {
+	DeliveryID    uuid.UUID             `json:"deliveryID"`
+	EventID       uuid.UUID             `json:"eventID"`
+	WebhookID     uuid.UUID             `json:"webhookID"`
+	ClientID      uuid.UUID             `json:"clientID"`
+	Status        WebhookDeliveryStatus `json:"status"`
+	Attempts      int32                 `json:"attempts"`
+	NextAttemptAt pgtype.Timestamptz    `json:"nextAttemptAt"`
+	LastError     string                `json:"lastError"`
+	CreatedAt     pgtype.Timestamptz    `json:"createdAt"`
+	UpdatedAt     pgtype.Timestamptz    `json:"updatedAt"`
 }
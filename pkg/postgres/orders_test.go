@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrders_OrderLifecycle(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	clientIDs := insertTestUsers(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	defer cancel()
+
+	// Place orders.
+	orderIDs := make([]uuid.UUID, 0, 3)
+
+	for _, params := range []orderCreateParams{
+		{ClientID: clientIDs[0], Source: "USD", Destination: "CAD",
+			Amount: decimal.NewFromFloat(100), LimitRate: decimal.NewFromFloat(1.35)},
+		{ClientID: clientIDs[0], Source: "USD", Destination: "BTC",
+			Amount: decimal.NewFromFloat(1000), LimitRate: decimal.NewFromFloat(0.00003)},
+		{ClientID: clientIDs[0], Source: "ETH", Destination: "USD",
+			Amount: decimal.NewFromFloat(1.5), LimitRate: decimal.NewFromFloat(2100)},
+	} {
+		param := params
+		order, err := connection.Query.orderCreate(ctx, &param)
+		require.NoError(t, err, "failed to place order.")
+		require.Equal(t, OrderStatusOpen, order.Status, "new order is not open.")
+		require.False(t, order.TxID.Valid, "new order has a transaction id.")
+		require.True(t, param.Amount.Equal(order.Amount), "order amount mismatch.")
+		require.True(t, param.LimitRate.Equal(order.LimitRate), "order limit rate mismatch.")
+
+		orderIDs = append(orderIDs, order.OrderID)
+	}
+
+	// Invalid order amount.
+	_, err := connection.Query.orderCreate(ctx, &orderCreateParams{
+		ClientID: clientIDs[0], Source: "USD", Destination: "CAD",
+		Amount: decimal.NewFromFloat(-1), LimitRate: decimal.NewFromFloat(1.35)})
+	require.Error(t, err, "placed order with a negative amount.")
+
+	// Open orders.
+	open, err := connection.Query.orderGetOpen(ctx, 10)
+	require.NoError(t, err, "failed to retrieve open orders.")
+	require.Len(t, open, 3, "incorrect number of open orders.")
+
+	// Successive polls should rotate through the open orders.
+	first, err := connection.Query.orderGetOpen(ctx, 1)
+	require.NoError(t, err, "failed to retrieve first polled open order.")
+	require.Len(t, first, 1, "incorrect number of first polled open orders.")
+	require.True(t, first[0].CheckedAt.Valid, "polled open order not marked as checked.")
+
+	second, err := connection.Query.orderGetOpen(ctx, 1)
+	require.NoError(t, err, "failed to retrieve second polled open order.")
+	require.Len(t, second, 1, "incorrect number of second polled open orders.")
+	require.NotEqual(t, first[0].OrderID, second[0].OrderID, "polling did not rotate through the open orders.")
+
+	// Paginated orders.
+	page, err := connection.Query.orderGetAllPaginated(ctx,
+		&orderGetAllPaginatedParams{ClientID: clientIDs[0], Offset: 0, Limit: 2})
+	require.NoError(t, err, "failed to retrieve first page of orders.")
+	require.Len(t, page, 2, "incorrect number of orders on first page.")
+
+	page, err = connection.Query.orderGetAllPaginated(ctx,
+		&orderGetAllPaginatedParams{ClientID: clientIDs[1], Offset: 0, Limit: 10})
+	require.NoError(t, err, "failed to retrieve orders for client without orders.")
+	require.Empty(t, page, "retrieved orders for another client.")
+
+	// Cancel order.
+	_, err = connection.Query.orderCancel(ctx, &orderCancelParams{ClientID: clientIDs[1], OrderID: orderIDs[0]})
+	require.Error(t, err, "cancelled another client's order.")
+
+	cancelled, err := connection.Query.orderCancel(ctx, &orderCancelParams{ClientID: clientIDs[0], OrderID: orderIDs[0]})
+	require.NoError(t, err, "failed to cancel order.")
+	require.Equal(t, OrderStatusCancelled, cancelled.Status, "order status not cancelled.")
+
+	_, err = connection.Query.orderCancel(ctx, &orderCancelParams{ClientID: clientIDs[0], OrderID: orderIDs[0]})
+	require.Error(t, err, "cancelled an order twice.")
+
+	// Claim and fill order.
+	txID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate transaction id.")
+
+	claim := orderClaimParams{
+		OrderID:    orderIDs[0],
+		FillRate:   decimal.NewFromFloat(0.000031),
+		FillAmount: decimal.NewFromFloat(0.031),
+		Fee:        decimal.NewFromFloat(1),
+		TxID:       uuid.NullUUID{UUID: txID, Valid: true},
+	}
+
+	rowCount, err := connection.Query.orderClaim(ctx, &claim)
+	require.NoError(t, err, "failed to attempt claiming cancelled order.")
+	require.Zero(t, rowCount, "claimed a cancelled order.")
+
+	claim.OrderID = orderIDs[1]
+	rowCount, err = connection.Query.orderClaim(ctx, &claim)
+	require.NoError(t, err, "failed to claim order.")
+	require.Equal(t, int64(1), rowCount, "failed to claim open order.")
+
+	rowCount, err = connection.Query.orderClaim(ctx, &claim)
+	require.NoError(t, err, "failed to attempt claiming order twice.")
+	require.Zero(t, rowCount, "claimed an order twice.")
+
+	fill := orderFillParams{OrderID: orderIDs[1], TxID: uuid.NullUUID{UUID: txID, Valid: true}}
+
+	rowCount, err = connection.Query.orderFill(ctx, &fill)
+	require.NoError(t, err, "failed to fill order.")
+	require.Equal(t, int64(1), rowCount, "failed to fill executing order.")
+
+	rowCount, err = connection.Query.orderFill(ctx, &fill)
+	require.NoError(t, err, "failed to attempt filling order twice.")
+	require.Zero(t, rowCount, "filled an order twice.")
+
+	// Recover an abandoned executing order.
+	claim.OrderID = orderIDs[2]
+	rowCount, err = connection.Query.orderClaim(ctx, &claim)
+	require.NoError(t, err, "failed to claim order to abandon.")
+	require.Equal(t, int64(1), rowCount, "failed to claim open order to abandon.")
+
+	cutoff := pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true}
+
+	rowCount, err = connection.Query.orderReopenStale(ctx, cutoff)
+	require.NoError(t, err, "failed to attempt reopening recently claimed order.")
+	require.Zero(t, rowCount, "reopened a recently claimed order.")
+
+	cutoff.Time = time.Now().Add(time.Minute)
+
+	rowCount, err = connection.Query.orderFillStale(ctx, cutoff)
+	require.NoError(t, err, "failed to attempt filling abandoned order without a trade.")
+	require.Zero(t, rowCount, "filled an abandoned order without a trade.")
+
+	rowCount, err = connection.Query.orderReopenStale(ctx, cutoff)
+	require.NoError(t, err, "failed to reopen abandoned order.")
+	require.Equal(t, int64(1), rowCount, "failed to reopen abandoned order.")
+
+	// Only the last order should remain open.
+	open, err = connection.Query.orderGetOpen(ctx, 10)
+	require.NoError(t, err, "failed to retrieve remaining open orders.")
+	require.Len(t, open, 1, "incorrect number of remaining open orders.")
+	require.Equal(t, orderIDs[2], open[0].OrderID, "incorrect remaining open order.")
+}
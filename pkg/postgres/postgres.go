@@ -44,6 +44,9 @@ type Postgres interface {
 	// UserCredentials will retrieve the ClientID and hashed password associated with a provided username.
 	UserCredentials(username string) (uuid.UUID, string, error)
 
+	// UserGetClientID will retrieve the Client ID associated with a provided username.
+	UserGetClientID(username string) (uuid.UUID, error)
+
 	// UserGetInfo will retrieve the account information associated with a Client ID.
 	UserGetInfo(clientID uuid.UUID) (modelsPostgres.User, error)
 
@@ -53,6 +56,18 @@ type Postgres interface {
 	// UserIsDeleted is the interface through which external methods can check if a user account is soft-deleted.
 	UserIsDeleted(clientID uuid.UUID) (bool, error)
 
+	// UserUpdatePassword will replace the hashed password of the active account associated with a Client ID.
+	UserUpdatePassword(clientID uuid.UUID, hashedPassword string) error
+
+	// UserGetRole is the interface through which external methods can retrieve the role of a user account.
+	UserGetRole(clientID uuid.UUID) (UserRole, error)
+
+	// UserIsFrozen is the interface through which external methods can check if a user account is frozen.
+	UserIsFrozen(clientID uuid.UUID) (bool, error)
+
+	// UserSetFrozen is the interface through which external methods can freeze or unfreeze an active user account.
+	UserSetFrozen(clientID uuid.UUID, isFrozen bool) error
+
 	// FiatCreateAccount will open an account associated with a Client ID for a specific currency.
 	FiatCreateAccount(clientID uuid.UUID, ticker Currency) error
 
@@ -60,10 +75,18 @@ type Postgres interface {
 	// currency.
 	FiatExternalTransfer(ctx context.Context, txDetails *FiatTransactionDetails) (*FiatAccountTransferResult, error)
 
+	// FiatExternalWithdrawal will transfer Fiat funds out of an account associated with a Client ID for a specific
+	// currency.
+	FiatExternalWithdrawal(ctx context.Context, txDetails *FiatTransactionDetails) (*FiatAccountTransferResult, error)
+
+	// FiatReverseDeposit will reverse an external Fiat deposit into an account associated with a Client ID by posting
+	// compensating Journal entries. The reversal is recorded against the Client ID of the administrator.
+	FiatReverseDeposit(ctx context.Context, adminID, clientID, txID uuid.UUID) (*FiatAccountTransferResult, error)
+
 	// FiatInternalTransfer will transfer Fiat funds for a specific Client ID between two Fiat currency accounts for
-	// that client.
-	FiatInternalTransfer(ctx context.Context, source *FiatTransactionDetails, destination *FiatTransactionDetails) (
-		*FiatAccountTransferResult, *FiatAccountTransferResult, error)
+	// that client. The trade details will be recorded with the transfer when supplied.
+	FiatInternalTransfer(ctx context.Context, source *FiatTransactionDetails, destination *FiatTransactionDetails,
+		trade *TradeDetails) (*FiatAccountTransferResult, *FiatAccountTransferResult, error)
 
 	// FiatBalance is the interface through which external methods can retrieve a Fiat account balance for a specific
 	// currency.
@@ -82,6 +105,14 @@ type Postgres interface {
 	FiatTransactionsPaginated(clientID uuid.UUID, ticker Currency, pageSize int32, offset int32,
 		start pgtype.Timestamptz, end pgtype.Timestamptz) ([]FiatJournal, error)
 
+	// FiatSetStatus is the interface through which external methods can set the status of a Fiat account for a specific
+	// currency.
+	FiatSetStatus(clientID uuid.UUID, ticker Currency, status AccountStatus) (FiatAccount, error)
+
+	// FiatUpdateHold is the interface through which external methods can place funds on hold in a Fiat account for a
+	// specific currency. Negative amounts will release funds that are on hold.
+	FiatUpdateHold(clientID uuid.UUID, ticker Currency, amount decimal.Decimal) (FiatAccount, error)
+
 	// CryptoCreateAccount is the interface through which external methods can create a Crypto account.
 	CryptoCreateAccount(clientID uuid.UUID, ticker string) error
 
@@ -93,13 +124,20 @@ type Postgres interface {
 	// specific transaction.
 	CryptoTxDetails(clientID uuid.UUID, txID uuid.UUID) ([]CryptoJournal, error)
 
-	// CryptoPurchase is the interface through which external methods can purchase a specific Cryptocurrency.
+	// CryptoPurchase is the interface through which external methods can purchase a specific Cryptocurrency. The fee
+	// is collected from the Fiat amount and the trade details are recorded with the purchase.
 	CryptoPurchase(clientID uuid.UUID, fiatTicker Currency, fiatAmount decimal.Decimal, cryptoTicker string,
-		cryptoAmount decimal.Decimal) (*FiatJournal, *CryptoJournal, error)
+		cryptoAmount decimal.Decimal, fee decimal.Decimal, trade *TradeDetails) (*FiatJournal, *CryptoJournal, error)
 
-	// CryptoSell is the interface through which external methods can sell a specific Cryptocurrency.
+	// CryptoSell is the interface through which external methods can sell a specific Cryptocurrency. The fee is
+	// collected from the Cryptocurrency amount and the trade details are recorded with the sale.
 	CryptoSell(clientID uuid.UUID, fiatTicker Currency, fiatAmount decimal.Decimal, cryptoTicker string,
-		cryptoAmount decimal.Decimal) (*FiatJournal, *CryptoJournal, error)
+		cryptoAmount decimal.Decimal, fee decimal.Decimal, trade *TradeDetails) (*FiatJournal, *CryptoJournal, error)
+
+	// CryptoSwap is the interface through which external methods can swap a source Cryptocurrency for a destination
+	// Cryptocurrency. The fee is collected from the source Cryptocurrency amount.
+	CryptoSwap(clientID uuid.UUID, sourceTicker string, sourceAmount decimal.Decimal, destinationTicker string,
+		destinationAmount decimal.Decimal, fee decimal.Decimal) (*CryptoJournal, *CryptoJournal, error)
 
 	// CryptoBalancesPaginated is the interface through which external methods can retrieve all Crypto account balances
 	// for a specific client.
@@ -109,6 +147,167 @@ type Postgres interface {
 	// account for a specific client during a specific month.
 	CryptoTransactionsPaginated(clientID uuid.UUID, cryptoTicker string, pageSize int32, offset int32,
 		start pgtype.Timestamptz, end pgtype.Timestamptz) ([]CryptoJournal, error)
+
+	// CryptoSetStatus is the interface through which external methods can set the status of a Crypto account for a
+	// specific cryptocurrency.
+	CryptoSetStatus(clientID uuid.UUID, ticker string, status AccountStatus) (CryptoAccount, error)
+
+	// CryptoUpdateHold is the interface through which external methods can place funds on hold in a Crypto account for
+	// a specific cryptocurrency. Negative amounts will release funds that are on hold.
+	CryptoUpdateHold(clientID uuid.UUID, ticker string, amount decimal.Decimal) (CryptoAccount, error)
+
+	// OrderCreate is the interface through which external methods can place a limit order for a specific client.
+	OrderCreate(clientID uuid.UUID, source, destination string, amount, limitRate decimal.Decimal) (Order, error)
+
+	// OrderCancel is the interface through which external methods can cancel an open limit order for a specific client.
+	OrderCancel(clientID uuid.UUID, orderID uuid.UUID) (Order, error)
+
+	// OrdersPaginated is the interface through which external methods can retrieve limit orders for a specific client.
+	OrdersPaginated(clientID uuid.UUID, pageSize int32, offset int32) ([]Order, error)
+
+	// OrdersOpen is the interface through which external methods can retrieve the least recently checked open limit
+	// orders. Successive calls will rotate through all the open limit orders.
+	OrdersOpen(limit int32) ([]Order, error)
+
+	// OrderUpdateStatus is the interface through which external methods can transition a limit order from its current
+	// status to the next status.
+	OrderUpdateStatus(orderID uuid.UUID, current OrderStatus, next OrderStatus) error
+
+	// OrderClaim is the interface through which external methods can move an open limit order to executing and record
+	// the execution details ahead of settlement.
+	OrderClaim(orderID uuid.UUID, rate, amount, fee decimal.Decimal, txID uuid.NullUUID) error
+
+	// OrderFill is the interface through which external methods can record the settlement of an executing limit order.
+	OrderFill(orderID uuid.UUID, txID uuid.UUID) error
+
+	// OrdersRecoverStale is the interface through which external methods can recover limit orders that have been
+	// executing since before a cutoff. Orders whose trade was recorded are filled and the remainder are reopened.
+	OrdersRecoverStale(cutoff time.Time) (int64, int64, error)
+
+	// ScheduleCreate is the interface through which external methods can create a recurring Cryptocurrency purchase
+	// schedule for a specific client.
+	ScheduleCreate(clientID uuid.UUID, fiatCurrency Currency, ticker string, amount decimal.Decimal,
+		frequency ScheduleFrequency, nextRunAt time.Time) (Schedule, error)
+
+	// ScheduleUpdate is the interface through which external methods can update the amount, frequency, and active
+	// state of a recurring purchase schedule for a specific client.
+	ScheduleUpdate(clientID, scheduleID uuid.UUID, amount decimal.Decimal, frequency ScheduleFrequency,
+		isActive bool) (Schedule, error)
+
+	// ScheduleDelete is the interface through which external methods can delete a recurring purchase schedule for a
+	// specific client.
+	ScheduleDelete(clientID, scheduleID uuid.UUID) error
+
+	// SchedulesPaginated is the interface through which external methods can retrieve recurring purchase schedules for
+	// a specific client.
+	SchedulesPaginated(clientID uuid.UUID, pageSize int32, offset int32) ([]Schedule, error)
+
+	// SchedulesDue is the interface through which external methods can retrieve the active schedules that are due.
+	SchedulesDue(limit int32) ([]Schedule, error)
+
+	// ScheduleAdvance is the interface through which external methods can claim a due schedule by moving its next run
+	// time forward. The update will fail if the schedule has been run, updated, or deactivated since it was retrieved.
+	ScheduleAdvance(scheduleID uuid.UUID, current, next time.Time) error
+
+	// ScheduleRunCreate is the interface through which external methods can record the outcome of a schedule's run.
+	ScheduleRunCreate(run *ScheduleRun) error
+
+	// ScheduleRunsPaginated is the interface through which external methods can retrieve the run history of a
+	// recurring purchase schedule for a specific client.
+	ScheduleRunsPaginated(clientID, scheduleID uuid.UUID, pageSize int32, offset int32) ([]ScheduleRun, error)
+
+	// RateCreate is the interface through which external methods can record a currency price quote fetched from a quote
+	// provider.
+	RateCreate(source, destination, provider string, rate decimal.Decimal, quotedAt time.Time) error
+
+	// RateHistory is the interface through which external methods can retrieve the open, high, low, and close rates for a
+	// currency pair in fixed intervals over a time range.
+	RateHistory(source, destination string, start, end time.Time, interval time.Duration) ([]RateCandle, error)
+
+	// TradeTxDetails is the interface through which external methods can retrieve the exchange rate and price quote
+	// offer that priced a specific transaction.
+	TradeTxDetails(clientID uuid.UUID, txID uuid.UUID) ([]Trade, error)
+
+	// MFAEnroll is the interface through which external methods can create or replace a pending multifactor
+	// authentication enrollment for a client.
+	MFAEnroll(clientID uuid.UUID, secret string, recoveryCodes []string) error
+
+	// MFAGet is the interface through which external methods can retrieve the multifactor authentication enrollment for
+	// a client.
+	MFAGet(clientID uuid.UUID) (MfaEnrollment, error)
+
+	// MFAEnable is the interface through which external methods can enable a pending multifactor authentication
+	// enrollment.
+	MFAEnable(clientID uuid.UUID, step int64) error
+
+	// MFAUseStep is the interface through which external methods can record the use of a one-time password code to
+	// block its reuse.
+	MFAUseStep(clientID uuid.UUID, step int64) error
+
+	// MFAUseRecoveryCode is the interface through which external methods can consume a single-use hashed recovery code.
+	MFAUseRecoveryCode(clientID uuid.UUID, hashedCode string) error
+
+	// MFASetRecoveryCodes is the interface through which external methods can replace the hashed recovery codes for an
+	// enabled multifactor authentication enrollment.
+	MFASetRecoveryCodes(clientID uuid.UUID, recoveryCodes []string) error
+
+	// MFADelete is the interface through which external methods can remove the multifactor authentication enrollment
+	// for a client.
+	MFADelete(clientID uuid.UUID) error
+
+	// APIKeyCreate is the interface through which external methods can store a hashed API key with its scopes for a
+	// client.
+	APIKeyCreate(clientID uuid.UUID, name, keyHash string, scopes []string) (ApiKey, error)
+
+	// APIKeyGet is the interface through which external methods can retrieve an API key that has not been revoked
+	// using its hash.
+	APIKeyGet(keyHash string) (ApiKey, error)
+
+	// APIKeyList is the interface through which external methods can retrieve all of a client's API keys that have not
+	// been revoked.
+	APIKeyList(clientID uuid.UUID) ([]ApiKey, error)
+
+	// APIKeyRevoke is the interface through which external methods can revoke one of a client's API keys.
+	APIKeyRevoke(clientID, keyID uuid.UUID) error
+
+	// LoginEventCreate is the interface through which external methods can record a login attempt against a user
+	// account.
+	LoginEventCreate(clientID uuid.UUID, outcome LoginOutcome, ipAddress, userAgent string) error
+
+	// LoginEventsPaginated is the interface through which external methods can retrieve the login attempts against a
+	// user account.
+	LoginEventsPaginated(clientID uuid.UUID, pageSize int32, offset int32) ([]LoginEvent, error)
+
+	// WebhookCreate is the interface through which external methods can register a webhook URL with its encrypted
+	// signing secret for a client, up to a maximum number of webhooks.
+	WebhookCreate(clientID uuid.UUID, url, secret string, limit int64) (Webhook, error)
+
+	// WebhookList is the interface through which external methods can retrieve all of a client's webhooks.
+	WebhookList(clientID uuid.UUID) ([]Webhook, error)
+
+	// WebhookDelete is the interface through which external methods can delete one of a client's webhooks.
+	WebhookDelete(clientID, webhookID uuid.UUID) error
+
+	// WebhookDeliveriesDue is the interface through which external methods can claim a batch of pending webhook
+	// deliveries that are due until the lease expires.
+	WebhookDeliveriesDue(limit int32, leasedUntil time.Time) ([]WebhookDeliveryDue, error)
+
+	// WebhookDeliveryUpdate is the interface through which external methods can record the outcome of a webhook
+	// delivery attempt.
+	WebhookDeliveryUpdate(deliveryID uuid.UUID, status WebhookDeliveryStatus, nextAttemptAt time.Time,
+		lastError string) error
+
+	// WebhookDeadLettersPaginated is the interface through which external methods can retrieve a client's webhook
+	// deliveries that were abandoned after exhausting their delivery attempts.
+	WebhookDeadLettersPaginated(clientID uuid.UUID, pageSize int32, offset int32) ([]WebhookDeadLetter, error)
+
+	// OutboxUnrelayed is the interface through which external methods can claim a batch of the oldest outbox events
+	// that have not been relayed to the Redis event streams.
+	OutboxUnrelayed(limit int32) ([]Outbox, error)
+
+	// OutboxReleaseRelay is the interface through which external methods can return an outbox event that could not be
+	// relayed so that it will be claimed again.
+	OutboxReleaseRelay(eventID uuid.UUID) error
 }
 
 // Check to ensure the Postgres interface has been implemented.
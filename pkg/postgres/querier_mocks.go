@@ -10,6 +10,7 @@ import (
 
 	uuid "github.com/gofrs/uuid"
 	gomock "github.com/golang/mock/gomock"
+	pgtype "github.com/jackc/pgx/v5/pgtype"
 	decimal "github.com/shopspring/decimal"
 )
 
@@ -36,6 +37,66 @@ func (m *MockQuerier) EXPECT() *MockQuerierMockRecorder {
 	return m.recorder
 }
 
+// apiKeyCreate mocks base method.
+func (m *MockQuerier) apiKeyCreate(arg0 context.Context, arg1 *apiKeyCreateParams) (ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "apiKeyCreate", arg0, arg1)
+	ret0, _ := ret[0].(ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// apiKeyCreate indicates an expected call of apiKeyCreate.
+func (mr *MockQuerierMockRecorder) apiKeyCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "apiKeyCreate", reflect.TypeOf((*MockQuerier)(nil).apiKeyCreate), arg0, arg1)
+}
+
+// apiKeyGetByHash mocks base method.
+func (m *MockQuerier) apiKeyGetByHash(arg0 context.Context, arg1 string) (ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "apiKeyGetByHash", arg0, arg1)
+	ret0, _ := ret[0].(ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// apiKeyGetByHash indicates an expected call of apiKeyGetByHash.
+func (mr *MockQuerierMockRecorder) apiKeyGetByHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "apiKeyGetByHash", reflect.TypeOf((*MockQuerier)(nil).apiKeyGetByHash), arg0, arg1)
+}
+
+// apiKeyList mocks base method.
+func (m *MockQuerier) apiKeyList(arg0 context.Context, arg1 uuid.UUID) ([]ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "apiKeyList", arg0, arg1)
+	ret0, _ := ret[0].([]ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// apiKeyList indicates an expected call of apiKeyList.
+func (mr *MockQuerierMockRecorder) apiKeyList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "apiKeyList", reflect.TypeOf((*MockQuerier)(nil).apiKeyList), arg0, arg1)
+}
+
+// apiKeyRevoke mocks base method.
+func (m *MockQuerier) apiKeyRevoke(arg0 context.Context, arg1 *apiKeyRevokeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "apiKeyRevoke", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// apiKeyRevoke indicates an expected call of apiKeyRevoke.
+func (mr *MockQuerierMockRecorder) apiKeyRevoke(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "apiKeyRevoke", reflect.TypeOf((*MockQuerier)(nil).apiKeyRevoke), arg0, arg1)
+}
+
 // cryptoCreateAccount mocks base method.
 func (m *MockQuerier) cryptoCreateAccount(arg0 context.Context, arg1 *cryptoCreateAccountParams) (int64, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +200,50 @@ func (mr *MockQuerierMockRecorder) cryptoSell(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoSell", reflect.TypeOf((*MockQuerier)(nil).cryptoSell), arg0, arg1)
 }
 
+// cryptoSetAccountStatus mocks base method.
+func (m *MockQuerier) cryptoSetAccountStatus(arg0 context.Context, arg1 *cryptoSetAccountStatusParams) (CryptoAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoSetAccountStatus", arg0, arg1)
+	ret0, _ := ret[0].(CryptoAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoSetAccountStatus indicates an expected call of cryptoSetAccountStatus.
+func (mr *MockQuerierMockRecorder) cryptoSetAccountStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoSetAccountStatus", reflect.TypeOf((*MockQuerier)(nil).cryptoSetAccountStatus), arg0, arg1)
+}
+
+// cryptoSwap mocks base method.
+func (m *MockQuerier) cryptoSwap(arg0 context.Context, arg1 *cryptoSwapParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoSwap", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// cryptoSwap indicates an expected call of cryptoSwap.
+func (mr *MockQuerierMockRecorder) cryptoSwap(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoSwap", reflect.TypeOf((*MockQuerier)(nil).cryptoSwap), arg0, arg1)
+}
+
+// cryptoUpdateAccountHold mocks base method.
+func (m *MockQuerier) cryptoUpdateAccountHold(arg0 context.Context, arg1 *cryptoUpdateAccountHoldParams) (CryptoAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "cryptoUpdateAccountHold", arg0, arg1)
+	ret0, _ := ret[0].(CryptoAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// cryptoUpdateAccountHold indicates an expected call of cryptoUpdateAccountHold.
+func (mr *MockQuerierMockRecorder) cryptoUpdateAccountHold(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cryptoUpdateAccountHold", reflect.TypeOf((*MockQuerier)(nil).cryptoUpdateAccountHold), arg0, arg1)
+}
+
 // fiatCreateAccount mocks base method.
 func (m *MockQuerier) fiatCreateAccount(arg0 context.Context, arg1 *fiatCreateAccountParams) (int64, error) {
 	m.ctrl.T.Helper()
@@ -169,6 +274,35 @@ func (mr *MockQuerierMockRecorder) fiatExternalTransferJournalEntry(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatExternalTransferJournalEntry", reflect.TypeOf((*MockQuerier)(nil).fiatExternalTransferJournalEntry), arg0, arg1)
 }
 
+// fiatExternalWithdrawalJournalEntry mocks base method.
+func (m *MockQuerier) fiatExternalWithdrawalJournalEntry(arg0 context.Context, arg1 *fiatExternalWithdrawalJournalEntryParams) (fiatExternalWithdrawalJournalEntryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatExternalWithdrawalJournalEntry", arg0, arg1)
+	ret0, _ := ret[0].(fiatExternalWithdrawalJournalEntryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatExternalWithdrawalJournalEntry indicates an expected call of fiatExternalWithdrawalJournalEntry.
+func (mr *MockQuerierMockRecorder) fiatExternalWithdrawalJournalEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatExternalWithdrawalJournalEntry", reflect.TypeOf((*MockQuerier)(nil).fiatExternalWithdrawalJournalEntry), arg0, arg1)
+}
+
+// fiatFeeJournalEntry mocks base method.
+func (m *MockQuerier) fiatFeeJournalEntry(arg0 context.Context, arg1 *fiatFeeJournalEntryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatFeeJournalEntry", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// fiatFeeJournalEntry indicates an expected call of fiatFeeJournalEntry.
+func (mr *MockQuerierMockRecorder) fiatFeeJournalEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatFeeJournalEntry", reflect.TypeOf((*MockQuerier)(nil).fiatFeeJournalEntry), arg0, arg1)
+}
+
 // fiatGetAccount mocks base method.
 func (m *MockQuerier) fiatGetAccount(arg0 context.Context, arg1 *fiatGetAccountParams) (FiatAccount, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +348,21 @@ func (mr *MockQuerierMockRecorder) fiatGetAllJournalTransactionsPaginated(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatGetAllJournalTransactionsPaginated", reflect.TypeOf((*MockQuerier)(nil).fiatGetAllJournalTransactionsPaginated), arg0, arg1)
 }
 
+// fiatGetDepositJournalEntry mocks base method.
+func (m *MockQuerier) fiatGetDepositJournalEntry(arg0 context.Context, arg1 *fiatGetDepositJournalEntryParams) (FiatJournal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatGetDepositJournalEntry", arg0, arg1)
+	ret0, _ := ret[0].(FiatJournal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatGetDepositJournalEntry indicates an expected call of fiatGetDepositJournalEntry.
+func (mr *MockQuerierMockRecorder) fiatGetDepositJournalEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatGetDepositJournalEntry", reflect.TypeOf((*MockQuerier)(nil).fiatGetDepositJournalEntry), arg0, arg1)
+}
+
 // fiatGetJournalTransaction mocks base method.
 func (m *MockQuerier) fiatGetJournalTransaction(arg0 context.Context, arg1 *fiatGetJournalTransactionParams) ([]FiatJournal, error) {
 	m.ctrl.T.Helper()
@@ -259,11 +408,26 @@ func (mr *MockQuerierMockRecorder) fiatInternalTransferJournalEntry(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatInternalTransferJournalEntry", reflect.TypeOf((*MockQuerier)(nil).fiatInternalTransferJournalEntry), arg0, arg1)
 }
 
+// fiatReversalCreate mocks base method.
+func (m *MockQuerier) fiatReversalCreate(arg0 context.Context, arg1 *fiatReversalCreateParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatReversalCreate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatReversalCreate indicates an expected call of fiatReversalCreate.
+func (mr *MockQuerierMockRecorder) fiatReversalCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatReversalCreate", reflect.TypeOf((*MockQuerier)(nil).fiatReversalCreate), arg0, arg1)
+}
+
 // fiatRowLockAccount mocks base method.
-func (m *MockQuerier) fiatRowLockAccount(arg0 context.Context, arg1 *fiatRowLockAccountParams) (decimal.Decimal, error) {
+func (m *MockQuerier) fiatRowLockAccount(arg0 context.Context, arg1 *fiatRowLockAccountParams) (fiatRowLockAccountRow, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "fiatRowLockAccount", arg0, arg1)
-	ret0, _ := ret[0].(decimal.Decimal)
+	ret0, _ := ret[0].(fiatRowLockAccountRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -274,6 +438,21 @@ func (mr *MockQuerierMockRecorder) fiatRowLockAccount(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatRowLockAccount", reflect.TypeOf((*MockQuerier)(nil).fiatRowLockAccount), arg0, arg1)
 }
 
+// fiatSetAccountStatus mocks base method.
+func (m *MockQuerier) fiatSetAccountStatus(arg0 context.Context, arg1 *fiatSetAccountStatusParams) (FiatAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatSetAccountStatus", arg0, arg1)
+	ret0, _ := ret[0].(FiatAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatSetAccountStatus indicates an expected call of fiatSetAccountStatus.
+func (mr *MockQuerierMockRecorder) fiatSetAccountStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatSetAccountStatus", reflect.TypeOf((*MockQuerier)(nil).fiatSetAccountStatus), arg0, arg1)
+}
+
 // fiatUpdateAccountBalance mocks base method.
 func (m *MockQuerier) fiatUpdateAccountBalance(arg0 context.Context, arg1 *fiatUpdateAccountBalanceParams) (fiatUpdateAccountBalanceRow, error) {
 	m.ctrl.T.Helper()
@@ -289,6 +468,482 @@ func (mr *MockQuerierMockRecorder) fiatUpdateAccountBalance(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatUpdateAccountBalance", reflect.TypeOf((*MockQuerier)(nil).fiatUpdateAccountBalance), arg0, arg1)
 }
 
+// fiatUpdateAccountHold mocks base method.
+func (m *MockQuerier) fiatUpdateAccountHold(arg0 context.Context, arg1 *fiatUpdateAccountHoldParams) (FiatAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "fiatUpdateAccountHold", arg0, arg1)
+	ret0, _ := ret[0].(FiatAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// fiatUpdateAccountHold indicates an expected call of fiatUpdateAccountHold.
+func (mr *MockQuerierMockRecorder) fiatUpdateAccountHold(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "fiatUpdateAccountHold", reflect.TypeOf((*MockQuerier)(nil).fiatUpdateAccountHold), arg0, arg1)
+}
+
+// loginEventCreate mocks base method.
+func (m *MockQuerier) loginEventCreate(arg0 context.Context, arg1 *loginEventCreateParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "loginEventCreate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// loginEventCreate indicates an expected call of loginEventCreate.
+func (mr *MockQuerierMockRecorder) loginEventCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "loginEventCreate", reflect.TypeOf((*MockQuerier)(nil).loginEventCreate), arg0, arg1)
+}
+
+// loginEventGetAllPaginated mocks base method.
+func (m *MockQuerier) loginEventGetAllPaginated(arg0 context.Context, arg1 *loginEventGetAllPaginatedParams) ([]LoginEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "loginEventGetAllPaginated", arg0, arg1)
+	ret0, _ := ret[0].([]LoginEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// loginEventGetAllPaginated indicates an expected call of loginEventGetAllPaginated.
+func (mr *MockQuerierMockRecorder) loginEventGetAllPaginated(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "loginEventGetAllPaginated", reflect.TypeOf((*MockQuerier)(nil).loginEventGetAllPaginated), arg0, arg1)
+}
+
+// mfaDelete mocks base method.
+func (m *MockQuerier) mfaDelete(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "mfaDelete", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// mfaDelete indicates an expected call of mfaDelete.
+func (mr *MockQuerierMockRecorder) mfaDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mfaDelete", reflect.TypeOf((*MockQuerier)(nil).mfaDelete), arg0, arg1)
+}
+
+// mfaEnable mocks base method.
+func (m *MockQuerier) mfaEnable(arg0 context.Context, arg1 *mfaEnableParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "mfaEnable", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// mfaEnable indicates an expected call of mfaEnable.
+func (mr *MockQuerierMockRecorder) mfaEnable(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mfaEnable", reflect.TypeOf((*MockQuerier)(nil).mfaEnable), arg0, arg1)
+}
+
+// mfaEnroll mocks base method.
+func (m *MockQuerier) mfaEnroll(arg0 context.Context, arg1 *mfaEnrollParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "mfaEnroll", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// mfaEnroll indicates an expected call of mfaEnroll.
+func (mr *MockQuerierMockRecorder) mfaEnroll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mfaEnroll", reflect.TypeOf((*MockQuerier)(nil).mfaEnroll), arg0, arg1)
+}
+
+// mfaGet mocks base method.
+func (m *MockQuerier) mfaGet(arg0 context.Context, arg1 uuid.UUID) (MfaEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "mfaGet", arg0, arg1)
+	ret0, _ := ret[0].(MfaEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// mfaGet indicates an expected call of mfaGet.
+func (mr *MockQuerierMockRecorder) mfaGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mfaGet", reflect.TypeOf((*MockQuerier)(nil).mfaGet), arg0, arg1)
+}
+
+// mfaSetRecoveryCodes mocks base method.
+func (m *MockQuerier) mfaSetRecoveryCodes(arg0 context.Context, arg1 *mfaSetRecoveryCodesParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "mfaSetRecoveryCodes", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// mfaSetRecoveryCodes indicates an expected call of mfaSetRecoveryCodes.
+func (mr *MockQuerierMockRecorder) mfaSetRecoveryCodes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mfaSetRecoveryCodes", reflect.TypeOf((*MockQuerier)(nil).mfaSetRecoveryCodes), arg0, arg1)
+}
+
+// mfaUseRecoveryCode mocks base method.
+func (m *MockQuerier) mfaUseRecoveryCode(arg0 context.Context, arg1 *mfaUseRecoveryCodeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "mfaUseRecoveryCode", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// mfaUseRecoveryCode indicates an expected call of mfaUseRecoveryCode.
+func (mr *MockQuerierMockRecorder) mfaUseRecoveryCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mfaUseRecoveryCode", reflect.TypeOf((*MockQuerier)(nil).mfaUseRecoveryCode), arg0, arg1)
+}
+
+// mfaUseStep mocks base method.
+func (m *MockQuerier) mfaUseStep(arg0 context.Context, arg1 *mfaUseStepParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "mfaUseStep", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// mfaUseStep indicates an expected call of mfaUseStep.
+func (mr *MockQuerierMockRecorder) mfaUseStep(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mfaUseStep", reflect.TypeOf((*MockQuerier)(nil).mfaUseStep), arg0, arg1)
+}
+
+// orderCancel mocks base method.
+func (m *MockQuerier) orderCancel(arg0 context.Context, arg1 *orderCancelParams) (Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "orderCancel", arg0, arg1)
+	ret0, _ := ret[0].(Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// orderCancel indicates an expected call of orderCancel.
+func (mr *MockQuerierMockRecorder) orderCancel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "orderCancel", reflect.TypeOf((*MockQuerier)(nil).orderCancel), arg0, arg1)
+}
+
+// orderClaim mocks base method.
+func (m *MockQuerier) orderClaim(arg0 context.Context, arg1 *orderClaimParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "orderClaim", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// orderClaim indicates an expected call of orderClaim.
+func (mr *MockQuerierMockRecorder) orderClaim(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "orderClaim", reflect.TypeOf((*MockQuerier)(nil).orderClaim), arg0, arg1)
+}
+
+// orderCreate mocks base method.
+func (m *MockQuerier) orderCreate(arg0 context.Context, arg1 *orderCreateParams) (Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "orderCreate", arg0, arg1)
+	ret0, _ := ret[0].(Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// orderCreate indicates an expected call of orderCreate.
+func (mr *MockQuerierMockRecorder) orderCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "orderCreate", reflect.TypeOf((*MockQuerier)(nil).orderCreate), arg0, arg1)
+}
+
+// orderFill mocks base method.
+func (m *MockQuerier) orderFill(arg0 context.Context, arg1 *orderFillParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "orderFill", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// orderFill indicates an expected call of orderFill.
+func (mr *MockQuerierMockRecorder) orderFill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "orderFill", reflect.TypeOf((*MockQuerier)(nil).orderFill), arg0, arg1)
+}
+
+// orderFillStale mocks base method.
+func (m *MockQuerier) orderFillStale(arg0 context.Context, arg1 pgtype.Timestamptz) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "orderFillStale", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// orderFillStale indicates an expected call of orderFillStale.
+func (mr *MockQuerierMockRecorder) orderFillStale(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "orderFillStale", reflect.TypeOf((*MockQuerier)(nil).orderFillStale), arg0, arg1)
+}
+
+// orderGetAllPaginated mocks base method.
+func (m *MockQuerier) orderGetAllPaginated(arg0 context.Context, arg1 *orderGetAllPaginatedParams) ([]Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "orderGetAllPaginated", arg0, arg1)
+	ret0, _ := ret[0].([]Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// orderGetAllPaginated indicates an expected call of orderGetAllPaginated.
+func (mr *MockQuerierMockRecorder) orderGetAllPaginated(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "orderGetAllPaginated", reflect.TypeOf((*MockQuerier)(nil).orderGetAllPaginated), arg0, arg1)
+}
+
+// orderGetOpen mocks base method.
+func (m *MockQuerier) orderGetOpen(arg0 context.Context, arg1 int32) ([]Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "orderGetOpen", arg0, arg1)
+	ret0, _ := ret[0].([]Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// orderGetOpen indicates an expected call of orderGetOpen.
+func (mr *MockQuerierMockRecorder) orderGetOpen(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "orderGetOpen", reflect.TypeOf((*MockQuerier)(nil).orderGetOpen), arg0, arg1)
+}
+
+// orderReopenStale mocks base method.
+func (m *MockQuerier) orderReopenStale(arg0 context.Context, arg1 pgtype.Timestamptz) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "orderReopenStale", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// orderReopenStale indicates an expected call of orderReopenStale.
+func (mr *MockQuerierMockRecorder) orderReopenStale(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "orderReopenStale", reflect.TypeOf((*MockQuerier)(nil).orderReopenStale), arg0, arg1)
+}
+
+// orderUpdateStatus mocks base method.
+func (m *MockQuerier) orderUpdateStatus(arg0 context.Context, arg1 *orderUpdateStatusParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "orderUpdateStatus", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// orderUpdateStatus indicates an expected call of orderUpdateStatus.
+func (mr *MockQuerierMockRecorder) orderUpdateStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "orderUpdateStatus", reflect.TypeOf((*MockQuerier)(nil).orderUpdateStatus), arg0, arg1)
+}
+
+// outboxClaimUnrelayed mocks base method.
+func (m *MockQuerier) outboxClaimUnrelayed(arg0 context.Context, arg1 int32) ([]Outbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "outboxClaimUnrelayed", arg0, arg1)
+	ret0, _ := ret[0].([]Outbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// outboxClaimUnrelayed indicates an expected call of outboxClaimUnrelayed.
+func (mr *MockQuerierMockRecorder) outboxClaimUnrelayed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "outboxClaimUnrelayed", reflect.TypeOf((*MockQuerier)(nil).outboxClaimUnrelayed), arg0, arg1)
+}
+
+// outboxPublish mocks base method.
+func (m *MockQuerier) outboxPublish(arg0 context.Context, arg1 *outboxPublishParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "outboxPublish", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// outboxPublish indicates an expected call of outboxPublish.
+func (mr *MockQuerierMockRecorder) outboxPublish(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "outboxPublish", reflect.TypeOf((*MockQuerier)(nil).outboxPublish), arg0, arg1)
+}
+
+// outboxReleaseRelay mocks base method.
+func (m *MockQuerier) outboxReleaseRelay(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "outboxReleaseRelay", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// outboxReleaseRelay indicates an expected call of outboxReleaseRelay.
+func (mr *MockQuerierMockRecorder) outboxReleaseRelay(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "outboxReleaseRelay", reflect.TypeOf((*MockQuerier)(nil).outboxReleaseRelay), arg0, arg1)
+}
+
+// rateCreate mocks base method.
+func (m *MockQuerier) rateCreate(arg0 context.Context, arg1 *rateCreateParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "rateCreate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// rateCreate indicates an expected call of rateCreate.
+func (mr *MockQuerierMockRecorder) rateCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "rateCreate", reflect.TypeOf((*MockQuerier)(nil).rateCreate), arg0, arg1)
+}
+
+// rateHistory mocks base method.
+func (m *MockQuerier) rateHistory(arg0 context.Context, arg1 *rateHistoryParams) ([]rateHistoryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "rateHistory", arg0, arg1)
+	ret0, _ := ret[0].([]rateHistoryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// rateHistory indicates an expected call of rateHistory.
+func (mr *MockQuerierMockRecorder) rateHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "rateHistory", reflect.TypeOf((*MockQuerier)(nil).rateHistory), arg0, arg1)
+}
+
+// scheduleAdvance mocks base method.
+func (m *MockQuerier) scheduleAdvance(arg0 context.Context, arg1 *scheduleAdvanceParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "scheduleAdvance", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// scheduleAdvance indicates an expected call of scheduleAdvance.
+func (mr *MockQuerierMockRecorder) scheduleAdvance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "scheduleAdvance", reflect.TypeOf((*MockQuerier)(nil).scheduleAdvance), arg0, arg1)
+}
+
+// scheduleCreate mocks base method.
+func (m *MockQuerier) scheduleCreate(arg0 context.Context, arg1 *scheduleCreateParams) (Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "scheduleCreate", arg0, arg1)
+	ret0, _ := ret[0].(Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// scheduleCreate indicates an expected call of scheduleCreate.
+func (mr *MockQuerierMockRecorder) scheduleCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "scheduleCreate", reflect.TypeOf((*MockQuerier)(nil).scheduleCreate), arg0, arg1)
+}
+
+// scheduleDelete mocks base method.
+func (m *MockQuerier) scheduleDelete(arg0 context.Context, arg1 *scheduleDeleteParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "scheduleDelete", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// scheduleDelete indicates an expected call of scheduleDelete.
+func (mr *MockQuerierMockRecorder) scheduleDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "scheduleDelete", reflect.TypeOf((*MockQuerier)(nil).scheduleDelete), arg0, arg1)
+}
+
+// scheduleGetAllPaginated mocks base method.
+func (m *MockQuerier) scheduleGetAllPaginated(arg0 context.Context, arg1 *scheduleGetAllPaginatedParams) ([]Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "scheduleGetAllPaginated", arg0, arg1)
+	ret0, _ := ret[0].([]Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// scheduleGetAllPaginated indicates an expected call of scheduleGetAllPaginated.
+func (mr *MockQuerierMockRecorder) scheduleGetAllPaginated(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "scheduleGetAllPaginated", reflect.TypeOf((*MockQuerier)(nil).scheduleGetAllPaginated), arg0, arg1)
+}
+
+// scheduleGetDue mocks base method.
+func (m *MockQuerier) scheduleGetDue(arg0 context.Context, arg1 int32) ([]Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "scheduleGetDue", arg0, arg1)
+	ret0, _ := ret[0].([]Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// scheduleGetDue indicates an expected call of scheduleGetDue.
+func (mr *MockQuerierMockRecorder) scheduleGetDue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "scheduleGetDue", reflect.TypeOf((*MockQuerier)(nil).scheduleGetDue), arg0, arg1)
+}
+
+// scheduleRunCreate mocks base method.
+func (m *MockQuerier) scheduleRunCreate(arg0 context.Context, arg1 *scheduleRunCreateParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "scheduleRunCreate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// scheduleRunCreate indicates an expected call of scheduleRunCreate.
+func (mr *MockQuerierMockRecorder) scheduleRunCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "scheduleRunCreate", reflect.TypeOf((*MockQuerier)(nil).scheduleRunCreate), arg0, arg1)
+}
+
+// scheduleRunGetAllPaginated mocks base method.
+func (m *MockQuerier) scheduleRunGetAllPaginated(arg0 context.Context, arg1 *scheduleRunGetAllPaginatedParams) ([]ScheduleRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "scheduleRunGetAllPaginated", arg0, arg1)
+	ret0, _ := ret[0].([]ScheduleRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// scheduleRunGetAllPaginated indicates an expected call of scheduleRunGetAllPaginated.
+func (mr *MockQuerierMockRecorder) scheduleRunGetAllPaginated(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "scheduleRunGetAllPaginated", reflect.TypeOf((*MockQuerier)(nil).scheduleRunGetAllPaginated), arg0, arg1)
+}
+
+// scheduleUpdate mocks base method.
+func (m *MockQuerier) scheduleUpdate(arg0 context.Context, arg1 *scheduleUpdateParams) (Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "scheduleUpdate", arg0, arg1)
+	ret0, _ := ret[0].(Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// scheduleUpdate indicates an expected call of scheduleUpdate.
+func (mr *MockQuerierMockRecorder) scheduleUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "scheduleUpdate", reflect.TypeOf((*MockQuerier)(nil).scheduleUpdate), arg0, arg1)
+}
+
 // testRoundHalfEven mocks base method.
 func (m *MockQuerier) testRoundHalfEven(arg0 context.Context, arg1 *testRoundHalfEvenParams) (decimal.Decimal, error) {
 	m.ctrl.T.Helper()
@@ -304,6 +959,35 @@ func (mr *MockQuerierMockRecorder) testRoundHalfEven(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "testRoundHalfEven", reflect.TypeOf((*MockQuerier)(nil).testRoundHalfEven), arg0, arg1)
 }
 
+// tradeCreate mocks base method.
+func (m *MockQuerier) tradeCreate(arg0 context.Context, arg1 *tradeCreateParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "tradeCreate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// tradeCreate indicates an expected call of tradeCreate.
+func (mr *MockQuerierMockRecorder) tradeCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "tradeCreate", reflect.TypeOf((*MockQuerier)(nil).tradeCreate), arg0, arg1)
+}
+
+// tradeGetTransaction mocks base method.
+func (m *MockQuerier) tradeGetTransaction(arg0 context.Context, arg1 *tradeGetTransactionParams) ([]Trade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "tradeGetTransaction", arg0, arg1)
+	ret0, _ := ret[0].([]Trade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// tradeGetTransaction indicates an expected call of tradeGetTransaction.
+func (mr *MockQuerierMockRecorder) tradeGetTransaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "tradeGetTransaction", reflect.TypeOf((*MockQuerier)(nil).tradeGetTransaction), arg0, arg1)
+}
+
 // userCreate mocks base method.
 func (m *MockQuerier) userCreate(arg0 context.Context, arg1 *userCreateParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -379,6 +1063,21 @@ func (mr *MockQuerierMockRecorder) userGetInfo(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "userGetInfo", reflect.TypeOf((*MockQuerier)(nil).userGetInfo), arg0, arg1)
 }
 
+// userGetRole mocks base method.
+func (m *MockQuerier) userGetRole(arg0 context.Context, arg1 uuid.UUID) (UserRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "userGetRole", arg0, arg1)
+	ret0, _ := ret[0].(UserRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// userGetRole indicates an expected call of userGetRole.
+func (mr *MockQuerierMockRecorder) userGetRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "userGetRole", reflect.TypeOf((*MockQuerier)(nil).userGetRole), arg0, arg1)
+}
+
 // userIsDeleted mocks base method.
 func (m *MockQuerier) userIsDeleted(arg0 context.Context, arg1 uuid.UUID) (bool, error) {
 	m.ctrl.T.Helper()
@@ -393,3 +1092,138 @@ func (mr *MockQuerierMockRecorder) userIsDeleted(arg0, arg1 interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "userIsDeleted", reflect.TypeOf((*MockQuerier)(nil).userIsDeleted), arg0, arg1)
 }
+
+// userIsFrozen mocks base method.
+func (m *MockQuerier) userIsFrozen(arg0 context.Context, arg1 uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "userIsFrozen", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// userIsFrozen indicates an expected call of userIsFrozen.
+func (mr *MockQuerierMockRecorder) userIsFrozen(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "userIsFrozen", reflect.TypeOf((*MockQuerier)(nil).userIsFrozen), arg0, arg1)
+}
+
+// userSetFrozen mocks base method.
+func (m *MockQuerier) userSetFrozen(arg0 context.Context, arg1 *userSetFrozenParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "userSetFrozen", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// userSetFrozen indicates an expected call of userSetFrozen.
+func (mr *MockQuerierMockRecorder) userSetFrozen(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "userSetFrozen", reflect.TypeOf((*MockQuerier)(nil).userSetFrozen), arg0, arg1)
+}
+
+// userUpdatePassword mocks base method.
+func (m *MockQuerier) userUpdatePassword(arg0 context.Context, arg1 *userUpdatePasswordParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "userUpdatePassword", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// userUpdatePassword indicates an expected call of userUpdatePassword.
+func (mr *MockQuerierMockRecorder) userUpdatePassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "userUpdatePassword", reflect.TypeOf((*MockQuerier)(nil).userUpdatePassword), arg0, arg1)
+}
+
+// webhookCreate mocks base method.
+func (m *MockQuerier) webhookCreate(arg0 context.Context, arg1 *webhookCreateParams) (Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "webhookCreate", arg0, arg1)
+	ret0, _ := ret[0].(Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// webhookCreate indicates an expected call of webhookCreate.
+func (mr *MockQuerierMockRecorder) webhookCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "webhookCreate", reflect.TypeOf((*MockQuerier)(nil).webhookCreate), arg0, arg1)
+}
+
+// webhookDelete mocks base method.
+func (m *MockQuerier) webhookDelete(arg0 context.Context, arg1 *webhookDeleteParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "webhookDelete", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// webhookDelete indicates an expected call of webhookDelete.
+func (mr *MockQuerierMockRecorder) webhookDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "webhookDelete", reflect.TypeOf((*MockQuerier)(nil).webhookDelete), arg0, arg1)
+}
+
+// webhookDeliveryClaimDue mocks base method.
+func (m *MockQuerier) webhookDeliveryClaimDue(arg0 context.Context, arg1 *webhookDeliveryClaimDueParams) ([]webhookDeliveryClaimDueRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "webhookDeliveryClaimDue", arg0, arg1)
+	ret0, _ := ret[0].([]webhookDeliveryClaimDueRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// webhookDeliveryClaimDue indicates an expected call of webhookDeliveryClaimDue.
+func (mr *MockQuerierMockRecorder) webhookDeliveryClaimDue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "webhookDeliveryClaimDue", reflect.TypeOf((*MockQuerier)(nil).webhookDeliveryClaimDue), arg0, arg1)
+}
+
+// webhookDeliveryGetDeadPaginated mocks base method.
+func (m *MockQuerier) webhookDeliveryGetDeadPaginated(arg0 context.Context, arg1 *webhookDeliveryGetDeadPaginatedParams) ([]webhookDeliveryGetDeadPaginatedRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "webhookDeliveryGetDeadPaginated", arg0, arg1)
+	ret0, _ := ret[0].([]webhookDeliveryGetDeadPaginatedRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// webhookDeliveryGetDeadPaginated indicates an expected call of webhookDeliveryGetDeadPaginated.
+func (mr *MockQuerierMockRecorder) webhookDeliveryGetDeadPaginated(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "webhookDeliveryGetDeadPaginated", reflect.TypeOf((*MockQuerier)(nil).webhookDeliveryGetDeadPaginated), arg0, arg1)
+}
+
+// webhookDeliveryUpdate mocks base method.
+func (m *MockQuerier) webhookDeliveryUpdate(arg0 context.Context, arg1 *webhookDeliveryUpdateParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "webhookDeliveryUpdate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// webhookDeliveryUpdate indicates an expected call of webhookDeliveryUpdate.
+func (mr *MockQuerierMockRecorder) webhookDeliveryUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "webhookDeliveryUpdate", reflect.TypeOf((*MockQuerier)(nil).webhookDeliveryUpdate), arg0, arg1)
+}
+
+// webhookGetAll mocks base method.
+func (m *MockQuerier) webhookGetAll(arg0 context.Context, arg1 uuid.UUID) ([]Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "webhookGetAll", arg0, arg1)
+	ret0, _ := ret[0].([]Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// webhookGetAll indicates an expected call of webhookGetAll.
+func (mr *MockQuerierMockRecorder) webhookGetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "webhookGetAll", reflect.TypeOf((*MockQuerier)(nil).webhookGetAll), arg0, arg1)
+}
@@ -8,10 +8,19 @@ import (
 	"context"
 
 	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shopspring/decimal"
 )
 
 type Querier interface {
+	// apiKeyCreate will insert a hashed API key with its scopes for a client.
+	apiKeyCreate(ctx context.Context, arg *apiKeyCreateParams) (ApiKey, error)
+	// apiKeyGetByHash will retrieve an API key that has not been revoked using its hash.
+	apiKeyGetByHash(ctx context.Context, keyHash string) (ApiKey, error)
+	// apiKeyList will retrieve all of a client's API keys that have not been revoked, oldest first.
+	apiKeyList(ctx context.Context, clientID uuid.UUID) ([]ApiKey, error)
+	// apiKeyRevoke will revoke one of a client's API keys.
+	apiKeyRevoke(ctx context.Context, arg *apiKeyRevokeParams) (int64, error)
 	// cryptoCreateAccount inserts a fiat account record.
 	cryptoCreateAccount(ctx context.Context, arg *cryptoCreateAccountParams) (int64, error)
 	// cryptoGetAccount will retrieve a specific user's account for a given cryptocurrency ticker.
@@ -23,14 +32,25 @@ type Querier interface {
 	cryptoGetAllJournalTransactionsPaginated(ctx context.Context, arg *cryptoGetAllJournalTransactionsPaginatedParams) ([]CryptoJournal, error)
 	// cryptoGetJournalTransaction will retrieve the journal entries associated with a transaction.
 	cryptoGetJournalTransaction(ctx context.Context, arg *cryptoGetJournalTransactionParams) ([]CryptoJournal, error)
-	// cryptoPurchase will execute a transaction to purchase a Cryptocurrency using a Fiat currency.
+	// cryptoPurchase will execute a transaction to purchase a Cryptocurrency using a Fiat currency and record the trade.
 	cryptoPurchase(ctx context.Context, arg *cryptoPurchaseParams) error
-	// cryptoSell will execute a transaction to sell a Cryptocurrency and purchase a Fiat currency.
+	// cryptoSell will execute a transaction to sell a Cryptocurrency, purchase a Fiat currency, and record the trade.
 	cryptoSell(ctx context.Context, arg *cryptoSellParams) error
+	// cryptoSetAccountStatus will set the status of a specific user's account for a given cryptocurrency ticker.
+	cryptoSetAccountStatus(ctx context.Context, arg *cryptoSetAccountStatusParams) (CryptoAccount, error)
+	// cryptoSwap will execute a transaction to sell a source Cryptocurrency and purchase a destination Cryptocurrency.
+	cryptoSwap(ctx context.Context, arg *cryptoSwapParams) error
+	// cryptoUpdateAccountHold will add an amount to the funds on hold in a specific user's account for a given
+	// cryptocurrency ticker. The funds on hold cannot be negative or exceed the balance.
+	cryptoUpdateAccountHold(ctx context.Context, arg *cryptoUpdateAccountHoldParams) (CryptoAccount, error)
 	// fiatCreateAccount inserts a fiat account record.
 	fiatCreateAccount(ctx context.Context, arg *fiatCreateAccountParams) (int64, error)
 	// fiatExternalTransferJournalEntry will create both journal entries for fiat accounts inbound deposits.
 	fiatExternalTransferJournalEntry(ctx context.Context, arg *fiatExternalTransferJournalEntryParams) (fiatExternalTransferJournalEntryRow, error)
+	// fiatExternalWithdrawalJournalEntry will create both journal entries for fiat accounts outbound withdrawals.
+	fiatExternalWithdrawalJournalEntry(ctx context.Context, arg *fiatExternalWithdrawalJournalEntryParams) (fiatExternalWithdrawalJournalEntryRow, error)
+	// fiatFeeJournalEntry will create the journal entry for fees collected in the fee revenue operations account.
+	fiatFeeJournalEntry(ctx context.Context, arg *fiatFeeJournalEntryParams) error
 	// fiatGetAccount will retrieve a specific user's account for a given currency.
 	fiatGetAccount(ctx context.Context, arg *fiatGetAccountParams) (FiatAccount, error)
 	// fiatGetAllAccounts will retrieve all accounts associated with a specific user.
@@ -38,16 +58,96 @@ type Querier interface {
 	// fiatGetAllJournalTransactionsPaginated will retrieve the journal entries associated with a specific account
 	// in a date range.
 	fiatGetAllJournalTransactionsPaginated(ctx context.Context, arg *fiatGetAllJournalTransactionsPaginatedParams) ([]FiatJournal, error)
+	// fiatGetDepositJournalEntry will retrieve a user's journal entry for an inbound deposit from the Fiat currency
+	// operations account.
+	fiatGetDepositJournalEntry(ctx context.Context, arg *fiatGetDepositJournalEntryParams) (FiatJournal, error)
 	// fiatGetJournalTransaction will retrieve the journal entries associated with a transaction.
 	fiatGetJournalTransaction(ctx context.Context, arg *fiatGetJournalTransactionParams) ([]FiatJournal, error)
 	// fiatGetJournalTransactionForAccount will retrieve the journal entries associated with a specific account.
 	fiatGetJournalTransactionForAccount(ctx context.Context, arg *fiatGetJournalTransactionForAccountParams) ([]FiatJournal, error)
 	// fiatInternalTransferJournalEntry will create both journal entries for fiat account internal transfers.
 	fiatInternalTransferJournalEntry(ctx context.Context, arg *fiatInternalTransferJournalEntryParams) (fiatInternalTransferJournalEntryRow, error)
+	// fiatReversalCreate will record the reversal of a Fiat deposit. Deposits that have already been reversed are ignored.
+	fiatReversalCreate(ctx context.Context, arg *fiatReversalCreateParams) (int64, error)
 	// fiatRowLockAccount will acquire a row level lock without locks on the foreign keys.
-	fiatRowLockAccount(ctx context.Context, arg *fiatRowLockAccountParams) (decimal.Decimal, error)
+	fiatRowLockAccount(ctx context.Context, arg *fiatRowLockAccountParams) (fiatRowLockAccountRow, error)
+	// fiatSetAccountStatus will set the status of a specific user's account for a given currency.
+	fiatSetAccountStatus(ctx context.Context, arg *fiatSetAccountStatusParams) (FiatAccount, error)
 	// fiatUpdateAccountBalance will add an amount to a fiat accounts balance.
 	fiatUpdateAccountBalance(ctx context.Context, arg *fiatUpdateAccountBalanceParams) (fiatUpdateAccountBalanceRow, error)
+	// fiatUpdateAccountHold will add an amount to the funds on hold in a specific user's account for a given currency. The
+	// funds on hold cannot be negative or exceed the balance.
+	fiatUpdateAccountHold(ctx context.Context, arg *fiatUpdateAccountHoldParams) (FiatAccount, error)
+	// loginEventCreate will record a login attempt against a user account.
+	loginEventCreate(ctx context.Context, arg *loginEventCreateParams) error
+	// loginEventGetAllPaginated will retrieve a page of login attempts against a user account, newest first.
+	loginEventGetAllPaginated(ctx context.Context, arg *loginEventGetAllPaginatedParams) ([]LoginEvent, error)
+	// mfaDelete will remove the multifactor authentication enrollment for a client.
+	mfaDelete(ctx context.Context, clientID uuid.UUID) (int64, error)
+	// mfaEnable will enable a pending multifactor authentication enrollment and record the time step of the code used.
+	mfaEnable(ctx context.Context, arg *mfaEnableParams) (int64, error)
+	// mfaEnroll will create or replace a pending multifactor authentication enrollment. Enabled enrollments are not replaced.
+	mfaEnroll(ctx context.Context, arg *mfaEnrollParams) (int64, error)
+	// mfaGet will retrieve the multifactor authentication enrollment for a client.
+	mfaGet(ctx context.Context, clientID uuid.UUID) (MfaEnrollment, error)
+	// mfaSetRecoveryCodes will replace the hashed recovery codes for an enabled enrollment.
+	mfaSetRecoveryCodes(ctx context.Context, arg *mfaSetRecoveryCodesParams) (int64, error)
+	// mfaUseRecoveryCode will remove a single-use hashed recovery code from an enabled enrollment.
+	mfaUseRecoveryCode(ctx context.Context, arg *mfaUseRecoveryCodeParams) (int64, error)
+	// mfaUseStep will record the time step of a one-time password code. Codes from the same or earlier time steps are
+	// rejected to stop replays.
+	mfaUseStep(ctx context.Context, arg *mfaUseStepParams) (int64, error)
+	// orderCancel will cancel a specific user's order if it is still open.
+	orderCancel(ctx context.Context, arg *orderCancelParams) (Order, error)
+	// orderClaim will move an open order to executing and record the execution details. The transaction ID is recorded if
+	// it is assigned before the order is settled.
+	orderClaim(ctx context.Context, arg *orderClaimParams) (int64, error)
+	// orderCreate will insert a new open limit order.
+	orderCreate(ctx context.Context, arg *orderCreateParams) (Order, error)
+	// orderFill will mark an executing order as filled with the transaction that settled it.
+	orderFill(ctx context.Context, arg *orderFillParams) (int64, error)
+	// orderFillStale will mark orders that have been executing since before a cutoff as filled if their trade was recorded.
+	orderFillStale(ctx context.Context, updatedAt pgtype.Timestamptz) (int64, error)
+	// orderGetAllPaginated will retrieve a page of orders associated with a specific user, newest first.
+	orderGetAllPaginated(ctx context.Context, arg *orderGetAllPaginatedParams) ([]Order, error)
+	// orderGetOpen will retrieve the least recently checked open orders of unfrozen users and mark them as checked, so that
+	// successive calls rotate through all the open orders.
+	orderGetOpen(ctx context.Context, limit int32) ([]Order, error)
+	// orderReopenStale will reopen orders that have been executing since before a cutoff without their trade being recorded.
+	orderReopenStale(ctx context.Context, updatedAt pgtype.Timestamptz) (int64, error)
+	// orderUpdateStatus will move an order from its current status to the next status.
+	orderUpdateStatus(ctx context.Context, arg *orderUpdateStatusParams) (int64, error)
+	// outboxClaimUnrelayed will claim a batch of the oldest events that have not been relayed to the Redis event streams by
+	// marking them as relayed. Events claimed by another instance are skipped.
+	outboxClaimUnrelayed(ctx context.Context, batchSize int32) ([]Outbox, error)
+	// outboxPublish will publish an account event to the outbox and queue its delivery to the client's webhooks.
+	outboxPublish(ctx context.Context, arg *outboxPublishParams) error
+	// outboxReleaseRelay will return an event that could not be relayed to the Redis event streams to be claimed again.
+	outboxReleaseRelay(ctx context.Context, eventID uuid.UUID) (int64, error)
+	// rateCreate will record a currency price quote fetched from a quote provider.
+	rateCreate(ctx context.Context, arg *rateCreateParams) error
+	// rateHistory will retrieve the open, high, low, and close rates for a currency pair in fixed intervals over a time range.
+	rateHistory(ctx context.Context, arg *rateHistoryParams) ([]rateHistoryRow, error)
+	// scheduleAdvance will move an active schedule's next run time forward if it has not changed since it was retrieved.
+	scheduleAdvance(ctx context.Context, arg *scheduleAdvanceParams) (int64, error)
+	// scheduleCreate will insert a new active recurring purchase schedule.
+	scheduleCreate(ctx context.Context, arg *scheduleCreateParams) (Schedule, error)
+	// scheduleDelete will delete a specific user's schedule along with its run history.
+	scheduleDelete(ctx context.Context, arg *scheduleDeleteParams) (int64, error)
+	// scheduleGetAllPaginated will retrieve a page of schedules associated with a specific user, newest first.
+	scheduleGetAllPaginated(ctx context.Context, arg *scheduleGetAllPaginatedParams) ([]Schedule, error)
+	// scheduleGetDue will retrieve the active schedules that are due to run, most overdue first.
+	scheduleGetDue(ctx context.Context, limit int32) ([]Schedule, error)
+	// scheduleRunCreate will record the outcome of a schedule's execution.
+	scheduleRunCreate(ctx context.Context, arg *scheduleRunCreateParams) error
+	// scheduleRunGetAllPaginated will retrieve a page of a specific user's schedule run history, newest first.
+	scheduleRunGetAllPaginated(ctx context.Context, arg *scheduleRunGetAllPaginatedParams) ([]ScheduleRun, error)
+	// scheduleUpdate will update the amount, frequency, and active state of a specific user's schedule.
+	scheduleUpdate(ctx context.Context, arg *scheduleUpdateParams) (Schedule, error)
+	// tradeCreate will record the exchange rate and price quote offer that priced a trade.
+	tradeCreate(ctx context.Context, arg *tradeCreateParams) error
+	// tradeGetTransaction will retrieve the trade associated with a transaction.
+	tradeGetTransaction(ctx context.Context, arg *tradeGetTransactionParams) ([]Trade, error)
 	// testRoundHalfEven
 	testRoundHalfEven(ctx context.Context, arg *testRoundHalfEvenParams) (decimal.Decimal, error)
 	// userCreate will create a new user record.
@@ -60,8 +160,30 @@ type Querier interface {
 	userGetCredentials(ctx context.Context, username string) (userGetCredentialsRow, error)
 	// userGetInfo will retrieve a single users account information.
 	userGetInfo(ctx context.Context, clientID uuid.UUID) (userGetInfoRow, error)
+	// userGetRole will return the role of a user account.
+	userGetRole(ctx context.Context, clientID uuid.UUID) (UserRole, error)
 	// userIsDeleted will return the soft delete status of a user account.
 	userIsDeleted(ctx context.Context, clientID uuid.UUID) (bool, error)
+	// userIsFrozen will return the frozen status of a user account.
+	userIsFrozen(ctx context.Context, clientID uuid.UUID) (bool, error)
+	// userSetFrozen will freeze or unfreeze an active users account.
+	userSetFrozen(ctx context.Context, arg *userSetFrozenParams) (int64, error)
+	// userUpdatePassword will replace the hashed password of an active users account.
+	userUpdatePassword(ctx context.Context, arg *userUpdatePasswordParams) (int64, error)
+	// webhookCreate will register a webhook URL with its encrypted signing secret for a client that has fewer than the
+	// maximum number of webhooks.
+	webhookCreate(ctx context.Context, arg *webhookCreateParams) (Webhook, error)
+	// webhookDelete will delete one of a client's webhooks along with its deliveries.
+	webhookDelete(ctx context.Context, arg *webhookDeleteParams) (int64, error)
+	// webhookDeliveryClaimDue will claim a batch of the most overdue pending deliveries by moving their next attempt time
+	// forward, and retrieve them along with their events and webhooks. Deliveries claimed by another instance are skipped.
+	webhookDeliveryClaimDue(ctx context.Context, arg *webhookDeliveryClaimDueParams) ([]webhookDeliveryClaimDueRow, error)
+	// webhookDeliveryGetDeadPaginated will retrieve a page of a client's dead-lettered deliveries, most recent first.
+	webhookDeliveryGetDeadPaginated(ctx context.Context, arg *webhookDeliveryGetDeadPaginatedParams) ([]webhookDeliveryGetDeadPaginatedRow, error)
+	// webhookDeliveryUpdate will record the outcome of a delivery attempt.
+	webhookDeliveryUpdate(ctx context.Context, arg *webhookDeliveryUpdateParams) (int64, error)
+	// webhookGetAll will retrieve all of a client's webhooks, oldest first.
+	webhookGetAll(ctx context.Context, clientID uuid.UUID) ([]Webhook, error)
 }
 
 var _ Querier = (*Queries)(nil)
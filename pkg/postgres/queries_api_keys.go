@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/surahman/FTeX/pkg/constants"
+	"go.uber.org/zap"
+)
+
+// APIKeyCreate is the interface through which external methods can store a hashed API key with its scopes for a
+// client. The plaintext key is never stored.
+func (p *postgresImpl) APIKeyCreate(clientID uuid.UUID, name, keyHash string, scopes []string) (ApiKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	apiKey, err := p.Query.apiKeyCreate(ctx, &apiKeyCreateParams{
+		ClientID: clientID,
+		Name:     name,
+		KeyHash:  keyHash,
+		Scopes:   scopes,
+	})
+	if err != nil {
+		p.logger.Error("failed to create api key", zap.Error(err))
+
+		return ApiKey{}, ErrTransactAPIKey
+	}
+
+	return apiKey, nil
+}
+
+// APIKeyGet is the interface through which external methods can retrieve an API key that has not been revoked using
+// its hash. Unknown and revoked keys will receive an ErrNotFoundAPIKey error.
+func (p *postgresImpl) APIKeyGet(keyHash string) (ApiKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	apiKey, err := p.Query.apiKeyGetByHash(ctx, keyHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ApiKey{}, ErrNotFoundAPIKey
+		}
+
+		p.logger.Error("failed to retrieve api key", zap.Error(err))
+
+		return ApiKey{}, ErrTransactAPIKey
+	}
+
+	return apiKey, nil
+}
+
+// APIKeyList is the interface through which external methods can retrieve all of a client's API keys that have not
+// been revoked, oldest first.
+func (p *postgresImpl) APIKeyList(clientID uuid.UUID) ([]ApiKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	apiKeys, err := p.Query.apiKeyList(ctx, clientID)
+	if err != nil {
+		p.logger.Error("failed to retrieve api keys", zap.Error(err))
+
+		return nil, ErrTransactAPIKey
+	}
+
+	return apiKeys, nil
+}
+
+// APIKeyRevoke is the interface through which external methods can revoke one of a client's API keys. Revoked keys
+// can no longer be used to authenticate requests.
+func (p *postgresImpl) APIKeyRevoke(clientID, keyID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.apiKeyRevoke(ctx, &apiKeyRevokeParams{ClientID: clientID, KeyID: keyID})
+	if err != nil {
+		p.logger.Error("failed to revoke api key", zap.Error(err))
+
+		return ErrTransactAPIKey
+	}
+
+	if rowsAffected != int64(1) {
+		return ErrNotFoundAPIKey
+	}
+
+	return nil
+}
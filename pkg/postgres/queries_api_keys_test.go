@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueries_APIKeys(t *testing.T) {
+	// Integration test check.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	clientIDs := insertTestUsers(t)
+	resetTestAPIKeys(t)
+
+	_, err := connection.APIKeyGet("hash-1")
+	require.ErrorIs(t, err, ErrNotFoundAPIKey, "retrieved a missing api key.")
+
+	apiKey, err := connection.APIKeyCreate(clientIDs[0], "trading bot", "hash-1", []string{"read-balances"})
+	require.NoError(t, err, "failed to create api key.")
+
+	_, err = connection.APIKeyCreate(clientIDs[1], "duplicate", "hash-1", []string{"read-balances"})
+	require.ErrorIs(t, err, ErrTransactAPIKey, "created an api key with a duplicate hash.")
+
+	retrieved, err := connection.APIKeyGet("hash-1")
+	require.NoError(t, err, "failed to retrieve api key.")
+	require.Equal(t, apiKey.KeyID, retrieved.KeyID, "key ID mismatch.")
+
+	apiKeys, err := connection.APIKeyList(clientIDs[0])
+	require.NoError(t, err, "failed to list api keys.")
+	require.Len(t, apiKeys, 1, "api key count mismatch.")
+
+	require.ErrorIs(t, connection.APIKeyRevoke(clientIDs[0], uuid.Must(uuid.NewV4())), ErrNotFoundAPIKey,
+		"revoked an unknown api key.")
+	require.NoError(t, connection.APIKeyRevoke(clientIDs[0], apiKey.KeyID), "failed to revoke api key.")
+	require.ErrorIs(t, connection.APIKeyRevoke(clientIDs[0], apiKey.KeyID), ErrNotFoundAPIKey,
+		"revoked an api key twice.")
+
+	_, err = connection.APIKeyGet("hash-1")
+	require.ErrorIs(t, err, ErrNotFoundAPIKey, "retrieved a revoked api key.")
+}
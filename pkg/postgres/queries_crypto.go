@@ -55,7 +55,8 @@ func (p *postgresImpl) CryptoTxDetails(clientID uuid.UUID, transactionID uuid.UU
 	return journal, nil
 }
 
-// CryptoPurchase is the interface through which external methods can purchase a specific Cryptocurrency.
+// CryptoPurchase is the interface through which external methods can purchase a specific Cryptocurrency. The fee is
+// collected from the Fiat debit amount and posted to the fee revenue operations account.
 //
 //nolint:dupl
 func (p *postgresImpl) CryptoPurchase(
@@ -63,17 +64,29 @@ func (p *postgresImpl) CryptoPurchase(
 	fiatCurrency Currency,
 	fiatDebitAmount decimal.Decimal,
 	cryptoTicker string,
-	cryptoCreditAmount decimal.Decimal) (*FiatJournal, *CryptoJournal, error) {
+	cryptoCreditAmount decimal.Decimal,
+	fee decimal.Decimal,
+	trade *TradeDetails) (*FiatJournal, *CryptoJournal, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
 	defer cancel()
 
-	txID, err := uuid.NewV4()
-	if err != nil {
-		p.logger.Error("failed to generate transaction id for Crypto purchase", zap.Error(err))
+	if trade == nil {
+		p.logger.Error("missing trade details for Crypto purchase")
 
 		return nil, nil, ErrTransactCrypto
 	}
 
+	var err error
+
+	txID := trade.TxID
+	if txID.IsNil() {
+		if txID, err = uuid.NewV4(); err != nil {
+			p.logger.Error("failed to generate transaction id for Crypto purchase", zap.Error(err))
+
+			return nil, nil, ErrTransactCrypto
+		}
+	}
+
 	err = p.Query.cryptoPurchase(ctx, &cryptoPurchaseParams{
 		TransactionID:      txID,
 		ClientID:           clientID,
@@ -81,6 +94,9 @@ func (p *postgresImpl) CryptoPurchase(
 		CryptoTicker:       cryptoTicker,
 		FiatDebitAmount:    fiatDebitAmount,
 		CryptoCreditAmount: cryptoCreditAmount,
+		FiatFeeAmount:      fee,
+		Rate:               trade.Rate,
+		OfferID:            trade.OfferID,
 	})
 	if err != nil {
 		return nil, nil, ErrTransactCrypto
@@ -109,7 +125,8 @@ func (p *postgresImpl) CryptoPurchase(
 	return &fiatJournal[0], &cryptoJournal[0], nil
 }
 
-// CryptoSell is the interface through which external methods can sell a specific Cryptocurrency.
+// CryptoSell is the interface through which external methods can sell a specific Cryptocurrency. The fee is collected
+// from the Cryptocurrency debit amount and posted to the fee revenue operations account.
 //
 //nolint:dupl
 func (p *postgresImpl) CryptoSell(
@@ -117,18 +134,30 @@ func (p *postgresImpl) CryptoSell(
 	fiatCurrency Currency,
 	fiatCreditAmount decimal.Decimal,
 	cryptoTicker string,
-	cryptoDebitAmount decimal.Decimal) (*FiatJournal, *CryptoJournal, error) {
+	cryptoDebitAmount decimal.Decimal,
+	fee decimal.Decimal,
+	trade *TradeDetails) (*FiatJournal, *CryptoJournal, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
 
 	defer cancel()
 
-	txID, err := uuid.NewV4()
-	if err != nil {
-		p.logger.Error("failed to generate transaction id for Crypto sale", zap.Error(err))
+	if trade == nil {
+		p.logger.Error("missing trade details for Crypto sale")
 
 		return nil, nil, ErrTransactCrypto
 	}
 
+	var err error
+
+	txID := trade.TxID
+	if txID.IsNil() {
+		if txID, err = uuid.NewV4(); err != nil {
+			p.logger.Error("failed to generate transaction id for Crypto sale", zap.Error(err))
+
+			return nil, nil, ErrTransactCrypto
+		}
+	}
+
 	err = p.Query.cryptoSell(ctx, &cryptoSellParams{
 		TransactionID:     txID,
 		ClientID:          clientID,
@@ -136,6 +165,9 @@ func (p *postgresImpl) CryptoSell(
 		CryptoTicker:      cryptoTicker,
 		FiatCreditAmount:  fiatCreditAmount,
 		CryptoDebitAmount: cryptoDebitAmount,
+		CryptoFeeAmount:   fee,
+		Rate:              trade.Rate,
+		OfferID:           trade.OfferID,
 	})
 	if err != nil {
 		return nil, nil, ErrTransactCrypto
@@ -164,6 +196,70 @@ func (p *postgresImpl) CryptoSell(
 	return &fiatJournal[0], &cryptoJournal[0], nil
 }
 
+// CryptoSwap is the interface through which external methods can swap a source Cryptocurrency for a destination
+// Cryptocurrency. The fee is collected from the source debit amount and posted to the fee revenue operations account.
+// The source and destination journal entries are returned in that order.
+func (p *postgresImpl) CryptoSwap(
+	clientID uuid.UUID,
+	sourceTicker string,
+	sourceDebitAmount decimal.Decimal,
+	destinationTicker string,
+	destinationCreditAmount decimal.Decimal,
+	fee decimal.Decimal) (*CryptoJournal, *CryptoJournal, error) {
+	var sourceJournal, destinationJournal *CryptoJournal
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	txID, err := uuid.NewV4()
+	if err != nil {
+		p.logger.Error("failed to generate transaction id for Crypto swap", zap.Error(err))
+
+		return nil, nil, ErrTransactCrypto
+	}
+
+	err = p.Query.cryptoSwap(ctx, &cryptoSwapParams{
+		TransactionID:           txID,
+		ClientID:                clientID,
+		SourceTicker:            sourceTicker,
+		DestinationTicker:       destinationTicker,
+		SourceDebitAmount:       sourceDebitAmount,
+		DestinationCreditAmount: destinationCreditAmount,
+		SourceFeeAmount:         fee,
+	})
+	if err != nil {
+		return nil, nil, ErrTransactCrypto
+	}
+
+	journal, err := p.Query.cryptoGetJournalTransaction(ctx, &cryptoGetJournalTransactionParams{
+		ClientID: clientID,
+		TxID:     txID,
+	})
+	if err != nil {
+		p.logger.Error("failed to retrieve Crypto transaction details post Crypto swap", zap.Error(err))
+
+		return nil, nil, ErrTransactCryptoDetails
+	}
+
+	for idx := range journal {
+		switch journal[idx].Ticker {
+		case sourceTicker:
+			sourceJournal = &journal[idx]
+		case destinationTicker:
+			destinationJournal = &journal[idx]
+		}
+	}
+
+	if sourceJournal == nil || destinationJournal == nil {
+		p.logger.Error("incomplete Crypto transaction details post Crypto swap", zap.String("txID", txID.String()))
+
+		return nil, nil, ErrTransactCryptoDetails
+	}
+
+	return sourceJournal, destinationJournal, nil
+}
+
 // CryptoBalancesPaginated is the interface through which external methods can retrieve all Crypto account balances for
 // a specific client.
 func (p *postgresImpl) CryptoBalancesPaginated(clientID uuid.UUID, ticker string, limit int32) (
@@ -211,3 +307,46 @@ func (p *postgresImpl) CryptoTransactionsPaginated(
 
 	return balance, nil
 }
+
+// CryptoSetStatus is the interface through which external methods can set the status of a Crypto account for a
+// specific cryptocurrency.
+func (p *postgresImpl) CryptoSetStatus(clientID uuid.UUID, ticker string, status AccountStatus) (CryptoAccount, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	account, err := p.Query.cryptoSetAccountStatus(ctx, &cryptoSetAccountStatusParams{
+		ClientID: clientID,
+		Ticker:   ticker,
+		Status:   status,
+	})
+	if err != nil {
+		p.logger.Warn("failed to set Crypto account status", zap.Error(err))
+
+		return CryptoAccount{}, ErrNotFound
+	}
+
+	return account, nil
+}
+
+// CryptoUpdateHold is the interface through which external methods can place funds on hold in a Crypto account for a
+// specific cryptocurrency. Negative amounts will release funds that are on hold.
+func (p *postgresImpl) CryptoUpdateHold(clientID uuid.UUID, ticker string, amount decimal.Decimal) (
+	CryptoAccount, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	account, err := p.Query.cryptoUpdateAccountHold(ctx, &cryptoUpdateAccountHoldParams{
+		ClientID: clientID,
+		Ticker:   ticker,
+		Amount:   amount,
+	})
+	if err != nil {
+		p.logger.Warn("failed to update funds on hold in Crypto account", zap.Error(err))
+
+		return CryptoAccount{}, ErrUpdateHold
+	}
+
+	return account, nil
+}
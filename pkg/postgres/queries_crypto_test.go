@@ -104,6 +104,7 @@ func TestQueries_CryptoPurchase(t *testing.T) {
 		cryptoTicker       string
 		fiatDebitAmount    decimal.Decimal
 		cryptoCreditAmount decimal.Decimal
+		fiatFeeAmount      decimal.Decimal
 		expectErr          require.ErrorAssertionFunc
 	}{
 		{
@@ -122,6 +123,24 @@ func TestQueries_CryptoPurchase(t *testing.T) {
 			fiatDebitAmount:    decimal.NewFromFloat(2389.33),
 			cryptoCreditAmount: decimal.NewFromFloat(104.80808081),
 			expectErr:          require.NoError,
+		}, {
+			name:               "valid - USD to BTC with fee",
+			clientID:           clientID1,
+			fiatCurrency:       CurrencyUSD,
+			cryptoTicker:       "BTC",
+			fiatDebitAmount:    decimal.NewFromFloat(100.00),
+			cryptoCreditAmount: decimal.NewFromFloat(2.5),
+			fiatFeeAmount:      decimal.NewFromFloat(0.25),
+			expectErr:          require.NoError,
+		}, {
+			name:               "invalid - USD to BTC fee exceeds debit",
+			clientID:           clientID1,
+			fiatCurrency:       CurrencyUSD,
+			cryptoTicker:       "BTC",
+			fiatDebitAmount:    decimal.NewFromFloat(10.00),
+			cryptoCreditAmount: decimal.NewFromFloat(0.25),
+			fiatFeeAmount:      decimal.NewFromFloat(10.01),
+			expectErr:          require.Error,
 		}, {
 			name:               "invalid - PKR to BTC",
 			clientID:           clientID1,
@@ -169,6 +188,7 @@ func TestQueries_CryptoPurchase(t *testing.T) {
 	require.NoError(t, err, "error expectation condition failed.")
 
 	negOne := decimal.NewFromFloat(-1)
+	trade := &TradeDetails{OfferID: "offer-id", Rate: decimal.NewFromFloat(0.00002873)}
 
 	// Configure wait groups for parallel run of all threads.
 	wg := sync.WaitGroup{}
@@ -183,7 +203,8 @@ func TestQueries_CryptoPurchase(t *testing.T) {
 
 			t.Run(test.name, func(t *testing.T) {
 				fiatJournal, cryptoJournal, err := connection.CryptoPurchase(
-					test.clientID, test.fiatCurrency, test.fiatDebitAmount, test.cryptoTicker, test.cryptoCreditAmount)
+					test.clientID, test.fiatCurrency, test.fiatDebitAmount, test.cryptoTicker, test.cryptoCreditAmount,
+					test.fiatFeeAmount, trade)
 				test.expectErr(t, err, "error expectation failed.")
 
 				if err != nil {
@@ -192,12 +213,26 @@ func TestQueries_CryptoPurchase(t *testing.T) {
 
 				require.Equal(t, test.fiatDebitAmount.Mul(negOne), fiatJournal.Amount, "amount in Fiat Journal mismatched.")
 				require.Equal(t, test.cryptoCreditAmount, cryptoJournal.Amount, "amount in Crypto Journal mismatched.")
+
+				trades, err := connection.TradeTxDetails(test.clientID, fiatJournal.TxID)
+				require.NoError(t, err, "failed to retrieve trade.")
+				require.Len(t, trades, 1, "trade count mismatched.")
+				require.Equal(t, string(test.fiatCurrency), trades[0].Source, "trade source mismatched.")
+				require.Equal(t, test.cryptoTicker, trades[0].Destination, "trade destination mismatched.")
+				require.Equal(t, trade.OfferID, trades[0].OfferID.String, "trade offer id mismatched.")
+				require.True(t, trade.Rate.Equal(trades[0].Rate), "trade rate mismatched.")
 			})
 		}()
 	}
 
 	// Wait (tie-threads).
 	wg.Wait()
+
+	t.Run("missing trade details", func(t *testing.T) {
+		_, _, err := connection.CryptoPurchase(
+			clientID1, CurrencyUSD, decimal.NewFromFloat(1), "BTC", decimal.NewFromFloat(0.1), decimal.Zero, nil)
+		require.Error(t, err, "purchase without trade details succeeded.")
+	})
 }
 
 func TestQueries_CryptoSell(t *testing.T) {
@@ -294,10 +329,12 @@ func TestQueries_CryptoSell(t *testing.T) {
 	require.NoError(t, err, "error expectation condition failed.")
 
 	_, _, err = connection.CryptoPurchase(
-		clientID1, CurrencyUSD, decimal.NewFromFloat(22.22), "BTC", decimal.NewFromFloat(4444.4444))
+		clientID1, CurrencyUSD, decimal.NewFromFloat(22.22), "BTC", decimal.NewFromFloat(4444.4444), decimal.Zero,
+		&TradeDetails{Rate: decimal.NewFromFloat(200.02)})
 	require.NoError(t, err, "error expectation condition failed.")
 
 	negOne := decimal.NewFromFloat(-1)
+	trade := &TradeDetails{OfferID: "offer-id", Rate: decimal.NewFromFloat(34806.43)}
 
 	// Configure wait groups for parallel run of all threads.
 	wg := sync.WaitGroup{}
@@ -312,7 +349,8 @@ func TestQueries_CryptoSell(t *testing.T) {
 
 			t.Run(test.name, func(t *testing.T) {
 				fiatJournal, cryptoJournal, err := connection.CryptoSell(
-					test.clientID, test.fiatCurrency, test.fiatCreditAmount, test.cryptoTicker, test.cryptoDebitAmount)
+					test.clientID, test.fiatCurrency, test.fiatCreditAmount, test.cryptoTicker, test.cryptoDebitAmount,
+					decimal.Zero, trade)
 				test.expectErr(t, err, "error expectation failed.")
 
 				if err != nil {
@@ -321,6 +359,150 @@ func TestQueries_CryptoSell(t *testing.T) {
 
 				require.Equal(t, test.fiatCreditAmount, fiatJournal.Amount, "amount in Fiat Journal mismatched.")
 				require.Equal(t, test.cryptoDebitAmount.Mul(negOne), cryptoJournal.Amount, "amount in Crypto Journal mismatched.")
+
+				trades, err := connection.TradeTxDetails(test.clientID, fiatJournal.TxID)
+				require.NoError(t, err, "failed to retrieve trade.")
+				require.Len(t, trades, 1, "trade count mismatched.")
+				require.Equal(t, test.cryptoTicker, trades[0].Source, "trade source mismatched.")
+				require.Equal(t, string(test.fiatCurrency), trades[0].Destination, "trade destination mismatched.")
+			})
+		}()
+	}
+
+	// Wait (tie-threads).
+	wg.Wait()
+
+	t.Run("missing trade details", func(t *testing.T) {
+		_, _, err := connection.CryptoSell(
+			clientID1, CurrencyUSD, decimal.NewFromFloat(1), "BTC", decimal.NewFromFloat(0.1), decimal.Zero, nil)
+		require.Error(t, err, "sale without trade details succeeded.")
+	})
+}
+
+func TestQueries_CryptoSwap(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	insertTestUsers(t)
+
+	// Insert an initial set of test fiat accounts.
+	clientID1, clientID2 := resetTestFiatAccounts(t)
+
+	// Insert the initial set of test fiat journal entries.
+	resetTestFiatJournal(t, clientID1, clientID2)
+
+	// Insert an initial set of test crypto accounts.
+	resetTestCryptoAccounts(t, clientID1, clientID2)
+
+	// Reset Crypto Journal entries.
+	resetTestCryptoJournal(t)
+
+	// Configure test grid.
+	testCases := []struct {
+		name              string
+		clientID          uuid.UUID
+		sourceTicker      string
+		destinationTicker string
+		sourceAmount      decimal.Decimal
+		destinationAmount decimal.Decimal
+		expectErr         require.ErrorAssertionFunc
+	}{
+		{
+			name:              "valid - BTC to ETH (first)",
+			clientID:          clientID1,
+			sourceTicker:      "BTC",
+			destinationTicker: "ETH",
+			sourceAmount:      decimal.NewFromFloat(2.5),
+			destinationAmount: decimal.NewFromFloat(40.12345678),
+			expectErr:         require.NoError,
+		}, {
+			name:              "valid - BTC to USDT (second)",
+			clientID:          clientID1,
+			sourceTicker:      "BTC",
+			destinationTicker: "USDT",
+			sourceAmount:      decimal.NewFromFloat(1.25),
+			destinationAmount: decimal.NewFromFloat(20.5),
+			expectErr:         require.NoError,
+		}, {
+			name:              "invalid - BTC to BTC",
+			clientID:          clientID1,
+			sourceTicker:      "BTC",
+			destinationTicker: "BTC",
+			sourceAmount:      decimal.NewFromFloat(1),
+			destinationAmount: decimal.NewFromFloat(1),
+			expectErr:         require.Error,
+		}, {
+			name:              "invalid - BTC to invalid crypto",
+			clientID:          clientID1,
+			sourceTicker:      "BTC",
+			destinationTicker: "BAD",
+			sourceAmount:      decimal.NewFromFloat(1),
+			destinationAmount: decimal.NewFromFloat(4.0000003),
+			expectErr:         require.Error,
+		}, {
+			name:              "invalid - BTC insufficient funds",
+			clientID:          clientID1,
+			sourceTicker:      "BTC",
+			destinationTicker: "ETH",
+			sourceAmount:      decimal.NewFromFloat(99999),
+			destinationAmount: decimal.NewFromFloat(6.1100005),
+			expectErr:         require.Error,
+		},
+	}
+
+	// Insert a test amount and purchase the source Cryptocurrency.
+	ts1 := pgtype.Timestamptz{}
+	require.NoError(t, ts1.Scan(time.Now().UTC()), "time stamp parse failed.")
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 3*time.Second)
+
+	t.Cleanup(func() {
+		cancel()
+	})
+
+	_, err := connection.Query.fiatUpdateAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
+		ClientID: clientID1,
+		Currency: CurrencyUSD,
+		Amount:   decimal.NewFromFloat(5643.17),
+		LastTxTs: ts1,
+	})
+	require.NoError(t, err, "failed to deposit Fiat funds.")
+
+	_, _, err = connection.CryptoPurchase(
+		clientID1, CurrencyUSD, decimal.NewFromFloat(1000), "BTC", decimal.NewFromFloat(10), decimal.Zero,
+		&TradeDetails{Rate: decimal.NewFromFloat(0.01)})
+	require.NoError(t, err, "failed to purchase Cryptocurrency.")
+
+	// Configure wait groups for parallel run of all threads.
+	wg := sync.WaitGroup{}
+	wg.Add(len(testCases))
+
+	// Run test grid.
+	for _, testCase := range testCases {
+		test := testCase
+
+		go func() {
+			defer wg.Done()
+
+			t.Run(test.name, func(t *testing.T) {
+				sourceJournal, destinationJournal, err := connection.CryptoSwap(
+					test.clientID, test.sourceTicker, test.sourceAmount, test.destinationTicker, test.destinationAmount,
+					decimal.Zero)
+				test.expectErr(t, err, "error expectation failed.")
+
+				if err != nil {
+					return
+				}
+
+				require.Equal(t, test.sourceTicker, sourceJournal.Ticker, "source ticker mismatched.")
+				require.Equal(t, test.sourceAmount.Neg(), sourceJournal.Amount, "source amount in Crypto Journal mismatched.")
+				require.Equal(t, test.destinationTicker, destinationJournal.Ticker, "destination ticker mismatched.")
+				require.Equal(t, test.destinationAmount, destinationJournal.Amount,
+					"destination amount in Crypto Journal mismatched.")
+				require.Equal(t, sourceJournal.TxID, destinationJournal.TxID, "transaction id mismatched.")
 			})
 		}()
 	}
@@ -579,3 +761,94 @@ func TestCrypto_CryptoTransactionsPaginated(t *testing.T) {
 		})
 	}
 }
+
+func TestQueries_CryptoSetStatusAndUpdateHold(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	insertTestUsers(t)
+
+	// Insert an initial set of test fiat accounts.
+	clientID1, clientID2 := resetTestFiatAccounts(t)
+
+	// Insert the initial set of test fiat journal entries.
+	resetTestFiatJournal(t, clientID1, clientID2)
+
+	// Insert an initial set of test crypto accounts.
+	resetTestCryptoAccounts(t, clientID1, clientID2)
+
+	// Reset Crypto Journal entries.
+	resetTestCryptoJournal(t)
+
+	// Configure context.
+	ctx, cancel := context.WithTimeout(context.TODO(), 3*time.Second)
+
+	t.Cleanup(func() {
+		cancel()
+	})
+
+	// Insert a base Fiat balance to purchase Cryptocurrency with.
+	ts := pgtype.Timestamptz{}
+	require.NoError(t, ts.Scan(time.Now().UTC()), "time stamp parse failed.")
+
+	_, err := connection.Query.fiatUpdateAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
+		ClientID: clientID1,
+		Currency: CurrencyUSD,
+		Amount:   decimal.NewFromFloat(1000),
+		LastTxTs: ts,
+	})
+	require.NoError(t, err, "failed to set base Fiat balance.")
+
+	trade := &TradeDetails{OfferID: "offer-id", Rate: decimal.NewFromFloat(0.01)}
+
+	t.Run("missing account", func(t *testing.T) {
+		_, err := connection.CryptoSetStatus(clientID1, "BAD", AccountStatusFrozen)
+		require.ErrorIs(t, err, ErrNotFound, "set status on missing account.")
+
+		_, err = connection.CryptoUpdateHold(clientID1, "BAD", decimal.NewFromFloat(1))
+		require.ErrorIs(t, err, ErrUpdateHold, "placed hold on missing account.")
+	})
+
+	t.Run("status", func(t *testing.T) {
+		account, err := connection.CryptoSetStatus(clientID1, "BTC", AccountStatusFrozen)
+		require.NoError(t, err, "failed to freeze account.")
+		require.Equal(t, AccountStatusFrozen, account.Status, "account status mismatched.")
+
+		_, _, err = connection.CryptoPurchase(
+			clientID1, CurrencyUSD, decimal.NewFromFloat(100), "BTC", decimal.NewFromFloat(1), decimal.Zero, trade)
+		require.Error(t, err, "purchased into frozen account.")
+
+		account, err = connection.CryptoSetStatus(clientID1, "BTC", AccountStatusActive)
+		require.NoError(t, err, "failed to activate account.")
+		require.Equal(t, AccountStatusActive, account.Status, "account status mismatched.")
+
+		_, _, err = connection.CryptoPurchase(
+			clientID1, CurrencyUSD, decimal.NewFromFloat(100), "BTC", decimal.NewFromFloat(1), decimal.Zero, trade)
+		require.NoError(t, err, "failed to purchase into active account.")
+	})
+
+	t.Run("hold", func(t *testing.T) {
+		_, err := connection.CryptoUpdateHold(clientID1, "BTC", decimal.NewFromFloat(1.00000001))
+		require.ErrorIs(t, err, ErrUpdateHold, "hold exceeding balance placed.")
+
+		account, err := connection.CryptoUpdateHold(clientID1, "BTC", decimal.NewFromFloat(0.75))
+		require.NoError(t, err, "failed to place hold.")
+		require.True(t, decimal.NewFromFloat(0.75).Equal(account.Held), "held amount mismatched.")
+		require.True(t, decimal.NewFromFloat(0.25).Equal(account.Available), "available amount mismatched.")
+
+		_, _, err = connection.CryptoSell(
+			clientID1, CurrencyUSD, decimal.NewFromFloat(50), "BTC", decimal.NewFromFloat(0.5), decimal.Zero, trade)
+		require.Error(t, err, "sold funds on hold.")
+
+		account, err = connection.CryptoUpdateHold(clientID1, "BTC", decimal.NewFromFloat(-0.75))
+		require.NoError(t, err, "failed to release hold.")
+		require.True(t, account.Held.IsZero(), "held amount not released.")
+
+		_, _, err = connection.CryptoSell(
+			clientID1, CurrencyUSD, decimal.NewFromFloat(50), "BTC", decimal.NewFromFloat(0.5), decimal.Zero, trade)
+		require.NoError(t, err, "failed to sell released funds.")
+	})
+}
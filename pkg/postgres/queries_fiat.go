@@ -5,6 +5,7 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
 	"github.com/surahman/FTeX/pkg/constants"
 	"go.uber.org/zap"
 )
@@ -102,3 +103,46 @@ func (p *postgresImpl) FiatTransactionsPaginated(
 
 	return balance, nil
 }
+
+// FiatSetStatus is the interface through which external methods can set the status of a Fiat account for a specific
+// currency.
+func (p *postgresImpl) FiatSetStatus(clientID uuid.UUID, currency Currency, status AccountStatus) (FiatAccount, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	account, err := p.Query.fiatSetAccountStatus(ctx, &fiatSetAccountStatusParams{
+		ClientID: clientID,
+		Currency: currency,
+		Status:   status,
+	})
+	if err != nil {
+		p.logger.Warn("failed to set Fiat account status", zap.Error(err))
+
+		return FiatAccount{}, ErrNotFound
+	}
+
+	return account, nil
+}
+
+// FiatUpdateHold is the interface through which external methods can place funds on hold in a Fiat account for a
+// specific currency. Negative amounts will release funds that are on hold.
+func (p *postgresImpl) FiatUpdateHold(clientID uuid.UUID, currency Currency, amount decimal.Decimal) (
+	FiatAccount, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	account, err := p.Query.fiatUpdateAccountHold(ctx, &fiatUpdateAccountHoldParams{
+		ClientID: clientID,
+		Currency: currency,
+		Amount:   amount,
+	})
+	if err != nil {
+		p.logger.Warn("failed to update funds on hold in Fiat account", zap.Error(err))
+
+		return FiatAccount{}, ErrUpdateHold
+	}
+
+	return account, nil
+}
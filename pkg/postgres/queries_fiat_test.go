@@ -7,6 +7,7 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
@@ -289,3 +290,91 @@ func TestFiat_FiatTransactionsCurrencyPaginated(t *testing.T) {
 		})
 	}
 }
+
+func TestQueries_FiatSetStatusAndUpdateHold(t *testing.T) {
+	// Integration test check.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert test users.
+	insertTestUsers(t)
+
+	// Insert an initial set of test fiat accounts.
+	clientID1, _ := resetTestFiatAccounts(t)
+
+	// Configure context.
+	ctx, cancel := context.WithTimeout(context.TODO(), 3*time.Second)
+
+	t.Cleanup(func() {
+		cancel()
+	})
+
+	// Insert a base balance to place holds against.
+	ts := pgtype.Timestamptz{}
+	require.NoError(t, ts.Scan(time.Now().UTC()), "time stamp parse failed.")
+
+	_, err := connection.Query.fiatUpdateAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
+		ClientID: clientID1,
+		Currency: CurrencyUSD,
+		Amount:   decimal.NewFromFloat(100),
+		LastTxTs: ts,
+	})
+	require.NoError(t, err, "failed to set base balance.")
+
+	t.Run("missing account", func(t *testing.T) {
+		_, err := connection.FiatSetStatus(clientID1, CurrencyEUR, AccountStatusFrozen)
+		require.ErrorIs(t, err, ErrNotFound, "set status on missing account.")
+
+		_, err = connection.FiatUpdateHold(clientID1, CurrencyEUR, decimal.NewFromFloat(1))
+		require.ErrorIs(t, err, ErrUpdateHold, "placed hold on missing account.")
+	})
+
+	t.Run("hold", func(t *testing.T) {
+		account, err := connection.FiatUpdateHold(clientID1, CurrencyUSD, decimal.NewFromFloat(40))
+		require.NoError(t, err, "failed to place hold.")
+		require.True(t, decimal.NewFromFloat(40).Equal(account.Held), "held amount mismatched.")
+		require.True(t, decimal.NewFromFloat(60).Equal(account.Available), "available amount mismatched.")
+
+		_, err = connection.FiatUpdateHold(clientID1, CurrencyUSD, decimal.NewFromFloat(60.01))
+		require.ErrorIs(t, err, ErrUpdateHold, "hold exceeding balance placed.")
+
+		_, err = connection.FiatExternalWithdrawal(ctx, &FiatTransactionDetails{
+			ClientID: clientID1,
+			Currency: CurrencyUSD,
+			Amount:   decimal.NewFromFloat(60.01),
+		})
+		require.Error(t, err, "withdrew funds on hold.")
+
+		_, err = connection.FiatUpdateHold(clientID1, CurrencyUSD, decimal.NewFromFloat(-40.01))
+		require.ErrorIs(t, err, ErrUpdateHold, "released more than the funds on hold.")
+
+		account, err = connection.FiatUpdateHold(clientID1, CurrencyUSD, decimal.NewFromFloat(-40))
+		require.NoError(t, err, "failed to release hold.")
+		require.True(t, account.Held.IsZero(), "held amount not released.")
+	})
+
+	t.Run("status", func(t *testing.T) {
+		account, err := connection.FiatSetStatus(clientID1, CurrencyUSD, AccountStatusFrozen)
+		require.NoError(t, err, "failed to freeze account.")
+		require.Equal(t, AccountStatusFrozen, account.Status, "account status mismatched.")
+
+		_, err = connection.FiatExternalTransfer(ctx, &FiatTransactionDetails{
+			ClientID: clientID1,
+			Currency: CurrencyUSD,
+			Amount:   decimal.NewFromFloat(1),
+		})
+		require.Error(t, err, "deposited into frozen account.")
+
+		account, err = connection.FiatSetStatus(clientID1, CurrencyUSD, AccountStatusActive)
+		require.NoError(t, err, "failed to activate account.")
+		require.Equal(t, AccountStatusActive, account.Status, "account status mismatched.")
+
+		_, err = connection.FiatExternalTransfer(ctx, &FiatTransactionDetails{
+			ClientID: clientID1,
+			Currency: CurrencyUSD,
+			Amount:   decimal.NewFromFloat(1),
+		})
+		require.NoError(t, err, "failed to deposit into active account.")
+	})
+}
@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/constants"
+	"go.uber.org/zap"
+)
+
+// LoginEventCreate is the interface through which external methods can record a login attempt against a user account.
+func (p *postgresImpl) LoginEventCreate(clientID uuid.UUID, outcome LoginOutcome, ipAddress, userAgent string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	if err := p.Query.loginEventCreate(ctx, &loginEventCreateParams{
+		ClientID:  clientID,
+		Outcome:   outcome,
+		IpAddress: ipAddress,
+		UserAgent: userAgent,
+	}); err != nil {
+		p.logger.Error("failed to record login attempt",
+			zap.String("clientID", clientID.String()), zap.String("outcome", string(outcome)), zap.Error(err))
+
+		return ErrCreateLoginEvent
+	}
+
+	return nil
+}
+
+// LoginEventsPaginated is the interface through which external methods can retrieve the login attempts against a user
+// account, newest first.
+func (p *postgresImpl) LoginEventsPaginated(clientID uuid.UUID, limit, offset int32) ([]LoginEvent, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	events, err := p.Query.loginEventGetAllPaginated(ctx, &loginEventGetAllPaginatedParams{
+		ClientID: clientID,
+		Offset:   offset,
+		Limit:    limit,
+	})
+	if err != nil {
+		return []LoginEvent{}, ErrNotFound
+	}
+
+	return events, nil
+}
@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueries_LoginEvents(t *testing.T) {
+	// Integration test check.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	clientIDs := insertTestUsers(t)
+	resetTestLoginEvents(t)
+
+	require.NoError(t, connection.LoginEventCreate(clientIDs[0], LoginOutcomeFailure, "127.0.0.1", "test-agent"),
+		"failed to record failed login.")
+	require.NoError(t, connection.LoginEventCreate(clientIDs[0], LoginOutcomeSuccess, "127.0.0.1", "test-agent"),
+		"failed to record successful login.")
+	require.ErrorIs(t, connection.LoginEventCreate(uuid.Must(uuid.NewV4()), LoginOutcomeSuccess, "127.0.0.1",
+		"test-agent"), ErrCreateLoginEvent, "recorded login for unknown client.")
+
+	events, err := connection.LoginEventsPaginated(clientIDs[0], 10, 0)
+	require.NoError(t, err, "failed to retrieve login events.")
+	require.Len(t, events, 2, "incorrect number of login events.")
+	require.Equal(t, LoginOutcomeSuccess, events[0].Outcome, "login events not ordered newest first.")
+
+	events, err = connection.LoginEventsPaginated(clientIDs[1], 10, 0)
+	require.NoError(t, err, "failed to retrieve empty login events.")
+	require.Empty(t, events, "retrieved another client's login events.")
+}
@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/surahman/FTeX/pkg/constants"
+	"go.uber.org/zap"
+)
+
+// MFAEnroll is the interface through which external methods can create or replace a pending multifactor
+// authentication enrollment with an encrypted secret and hashed recovery codes. Enabled enrollments are not replaced.
+func (p *postgresImpl) MFAEnroll(clientID uuid.UUID, secret string, recoveryCodes []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.mfaEnroll(ctx, &mfaEnrollParams{
+		ClientID:      clientID,
+		Secret:        secret,
+		RecoveryCodes: recoveryCodes,
+	})
+	if err != nil {
+		p.logger.Error("failed to enroll user in multifactor authentication", zap.Error(err))
+
+		return ErrTransactMFA
+	}
+
+	if rowsAffected != int64(1) {
+		return ErrEnabledMFA
+	}
+
+	return nil
+}
+
+// MFAGet is the interface through which external methods can retrieve the multifactor authentication enrollment for
+// a client. Clients without an enrollment will receive an ErrNotEnrolledMFA error.
+func (p *postgresImpl) MFAGet(clientID uuid.UUID) (MfaEnrollment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	enrollment, err := p.Query.mfaGet(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return MfaEnrollment{}, ErrNotEnrolledMFA
+		}
+
+		p.logger.Error("failed to retrieve multifactor authentication enrollment", zap.Error(err))
+
+		return MfaEnrollment{}, ErrTransactMFA
+	}
+
+	return enrollment, nil
+}
+
+// MFAEnable is the interface through which external methods can enable a pending multifactor authentication
+// enrollment. The time step of the code used to verify the enrollment is recorded to block its reuse.
+func (p *postgresImpl) MFAEnable(clientID uuid.UUID, step int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.mfaEnable(ctx, &mfaEnableParams{ClientID: clientID, LastStep: step})
+	if err != nil {
+		p.logger.Error("failed to enable multifactor authentication", zap.Error(err))
+
+		return ErrTransactMFA
+	}
+
+	if rowsAffected != int64(1) {
+		return ErrEnabledMFA
+	}
+
+	return nil
+}
+
+// MFAUseStep is the interface through which external methods can record the use of a one-time password code. Codes
+// from the same or an earlier time step than the last used code are rejected.
+func (p *postgresImpl) MFAUseStep(clientID uuid.UUID, step int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.mfaUseStep(ctx, &mfaUseStepParams{ClientID: clientID, LastStep: step})
+	if err != nil {
+		p.logger.Error("failed to record multifactor authentication code use", zap.Error(err))
+
+		return ErrTransactMFA
+	}
+
+	if rowsAffected != int64(1) {
+		return ErrUsedMFA
+	}
+
+	return nil
+}
+
+// MFAUseRecoveryCode is the interface through which external methods can consume a single-use hashed recovery code.
+func (p *postgresImpl) MFAUseRecoveryCode(clientID uuid.UUID, hashedCode string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.mfaUseRecoveryCode(ctx, &mfaUseRecoveryCodeParams{
+		RecoveryCode: hashedCode,
+		ClientID:     clientID,
+	})
+	if err != nil {
+		p.logger.Error("failed to consume multifactor authentication recovery code", zap.Error(err))
+
+		return ErrTransactMFA
+	}
+
+	if rowsAffected != int64(1) {
+		return ErrUsedMFA
+	}
+
+	return nil
+}
+
+// MFASetRecoveryCodes is the interface through which external methods can replace the hashed recovery codes for an
+// enabled multifactor authentication enrollment.
+func (p *postgresImpl) MFASetRecoveryCodes(clientID uuid.UUID, recoveryCodes []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.mfaSetRecoveryCodes(ctx, &mfaSetRecoveryCodesParams{
+		ClientID:      clientID,
+		RecoveryCodes: recoveryCodes,
+	})
+	if err != nil {
+		p.logger.Error("failed to replace multifactor authentication recovery codes", zap.Error(err))
+
+		return ErrTransactMFA
+	}
+
+	if rowsAffected != int64(1) {
+		return ErrNotEnrolledMFA
+	}
+
+	return nil
+}
+
+// MFADelete is the interface through which external methods can remove the multifactor authentication enrollment for
+// a client.
+func (p *postgresImpl) MFADelete(clientID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.mfaDelete(ctx, clientID)
+	if err != nil {
+		p.logger.Error("failed to remove multifactor authentication enrollment", zap.Error(err))
+
+		return ErrTransactMFA
+	}
+
+	if rowsAffected != int64(1) {
+		return ErrNotEnrolledMFA
+	}
+
+	return nil
+}
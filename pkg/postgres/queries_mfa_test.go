@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueries_MFA(t *testing.T) {
+	// Integration test check.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	clientIDs := insertTestUsers(t)
+	resetTestMFA(t)
+
+	_, err := connection.MFAGet(clientIDs[0])
+	require.ErrorIs(t, err, ErrNotEnrolledMFA, "retrieved a missing enrollment.")
+
+	require.NoError(t, connection.MFAEnroll(clientIDs[0], "secret", []string{"code-1", "code-2"}),
+		"failed to enroll.")
+
+	enrollment, err := connection.MFAGet(clientIDs[0])
+	require.NoError(t, err, "failed to retrieve enrollment.")
+	require.False(t, enrollment.IsEnabled, "pending enrollment is enabled.")
+
+	require.ErrorIs(t, connection.MFAUseRecoveryCode(clientIDs[0], "code-1"), ErrUsedMFA,
+		"used a recovery code on a pending enrollment.")
+	require.NoError(t, connection.MFAEnable(clientIDs[0], 10), "failed to enable enrollment.")
+	require.ErrorIs(t, connection.MFAEnable(clientIDs[0], 11), ErrEnabledMFA, "enabled enrollment twice.")
+	require.ErrorIs(t, connection.MFAEnroll(clientIDs[0], "secret", []string{}), ErrEnabledMFA,
+		"replaced an enabled enrollment.")
+
+	require.ErrorIs(t, connection.MFAUseStep(clientIDs[0], 10), ErrUsedMFA, "replayed a time step.")
+	require.NoError(t, connection.MFAUseStep(clientIDs[0], 11), "failed to use a time step.")
+
+	require.NoError(t, connection.MFAUseRecoveryCode(clientIDs[0], "code-1"), "failed to use a recovery code.")
+	require.ErrorIs(t, connection.MFAUseRecoveryCode(clientIDs[0], "code-1"), ErrUsedMFA, "reused a recovery code.")
+
+	require.NoError(t, connection.MFASetRecoveryCodes(clientIDs[0], []string{"code-3"}),
+		"failed to replace recovery codes.")
+	require.ErrorIs(t, connection.MFASetRecoveryCodes(clientIDs[1], []string{"code-3"}), ErrNotEnrolledMFA,
+		"replaced recovery codes for a client that is not enrolled.")
+
+	require.NoError(t, connection.MFADelete(clientIDs[0]), "failed to delete enrollment.")
+	require.ErrorIs(t, connection.MFADelete(clientIDs[0]), ErrNotEnrolledMFA, "deleted enrollment twice.")
+}
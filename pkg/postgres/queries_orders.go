@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/constants"
+	"go.uber.org/zap"
+)
+
+// OrderCreate is the interface through which external methods can place a limit order for a specific client.
+func (p *postgresImpl) OrderCreate(
+	clientID uuid.UUID,
+	source,
+	destination string,
+	amount,
+	limitRate decimal.Decimal) (Order, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	order, err := p.Query.orderCreate(ctx, &orderCreateParams{
+		ClientID:    clientID,
+		Source:      source,
+		Destination: destination,
+		Amount:      amount,
+		LimitRate:   limitRate,
+	})
+	if err != nil {
+		p.logger.Error("failed to place limit order", zap.Error(err))
+
+		return Order{}, ErrCreateOrder
+	}
+
+	return order, nil
+}
+
+// OrderCancel is the interface through which external methods can cancel an open limit order for a specific client.
+func (p *postgresImpl) OrderCancel(clientID uuid.UUID, orderID uuid.UUID) (Order, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	order, err := p.Query.orderCancel(ctx, &orderCancelParams{ClientID: clientID, OrderID: orderID})
+	if err != nil {
+		return Order{}, ErrNotFound
+	}
+
+	return order, nil
+}
+
+// OrdersPaginated is the interface through which external methods can retrieve limit orders for a specific client.
+func (p *postgresImpl) OrdersPaginated(clientID uuid.UUID, limit, offset int32) ([]Order, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	orders, err := p.Query.orderGetAllPaginated(ctx, &orderGetAllPaginatedParams{
+		ClientID: clientID,
+		Offset:   offset,
+		Limit:    limit,
+	})
+	if err != nil {
+		return []Order{}, ErrNotFound
+	}
+
+	return orders, nil
+}
+
+// OrdersOpen is the interface through which external methods can retrieve the least recently checked open limit orders.
+// Successive calls will rotate through all the open limit orders.
+func (p *postgresImpl) OrdersOpen(limit int32) ([]Order, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	orders, err := p.Query.orderGetOpen(ctx, limit)
+	if err != nil {
+		p.logger.Error("failed to retrieve open limit orders", zap.Error(err))
+
+		return []Order{}, ErrNotFound
+	}
+
+	return orders, nil
+}
+
+// OrderUpdateStatus is the interface through which external methods can transition a limit order from its current
+// status to the next status. The update will fail if the order is not in the current status.
+func (p *postgresImpl) OrderUpdateStatus(orderID uuid.UUID, current OrderStatus, next OrderStatus) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.orderUpdateStatus(ctx, &orderUpdateStatusParams{
+		NextStatus:    next,
+		OrderID:       orderID,
+		CurrentStatus: current,
+	})
+	if err != nil || rowsAffected != int64(1) {
+		return ErrUpdateOrder
+	}
+
+	return nil
+}
+
+// OrderClaim is the interface through which external methods can move an open limit order to executing and record the
+// execution details ahead of settlement. The claim will fail if the order is no longer open.
+func (p *postgresImpl) OrderClaim(orderID uuid.UUID, rate, amount, fee decimal.Decimal, txID uuid.NullUUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.orderClaim(ctx, &orderClaimParams{
+		OrderID:    orderID,
+		FillRate:   rate,
+		FillAmount: amount,
+		Fee:        fee,
+		TxID:       txID,
+	})
+	if err != nil || rowsAffected != int64(1) {
+		return ErrUpdateOrder
+	}
+
+	return nil
+}
+
+// OrderFill is the interface through which external methods can record the settlement of an executing limit order.
+func (p *postgresImpl) OrderFill(orderID uuid.UUID, txID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.orderFill(ctx, &orderFillParams{
+		OrderID: orderID,
+		TxID:    uuid.NullUUID{UUID: txID, Valid: true},
+	})
+	if err != nil || rowsAffected != int64(1) {
+		p.logger.Error("failed to record limit order fill", zap.String("orderID", orderID.String()), zap.Error(err))
+
+		return ErrUpdateOrder
+	}
+
+	return nil
+}
+
+// OrdersRecoverStale is the interface through which external methods can recover limit orders that have been executing
+// since before a cutoff. Orders whose trade was recorded are filled and the remainder are reopened. The number of filled
+// and reopened orders are returned in that order.
+func (p *postgresImpl) OrdersRecoverStale(cutoff time.Time) (int64, int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	before := pgtype.Timestamptz{Time: cutoff, Valid: true}
+
+	filled, err := p.Query.orderFillStale(ctx, before)
+	if err != nil {
+		p.logger.Error("failed to fill stale limit orders", zap.Error(err))
+
+		return 0, 0, ErrUpdateOrder
+	}
+
+	reopened, err := p.Query.orderReopenStale(ctx, before)
+	if err != nil {
+		p.logger.Error("failed to reopen stale limit orders", zap.Error(err))
+
+		return filled, 0, ErrUpdateOrder
+	}
+
+	return filled, reopened, nil
+}
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueries_OrderLifecycle(t *testing.T) {
+	// Integration test check.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert test users.
+	clientIDs := insertTestUsers(t)
+
+	first, err := connection.OrderCreate(clientIDs[0], "USD", "CAD", decimal.NewFromFloat(100),
+		decimal.NewFromFloat(1.35))
+	require.NoError(t, err, "failed to place first order.")
+
+	second, err := connection.OrderCreate(clientIDs[0], "BTC", "USD", decimal.NewFromFloat(0.5),
+		decimal.NewFromFloat(30000))
+	require.NoError(t, err, "failed to place second order.")
+
+	_, err = connection.OrderCreate(clientIDs[0], "USD", "CAD", decimal.NewFromFloat(100), decimal.Zero)
+	require.ErrorIs(t, err, ErrCreateOrder, "placed order with a zero limit rate.")
+
+	orders, err := connection.OrdersPaginated(clientIDs[0], 10, 0)
+	require.NoError(t, err, "failed to retrieve orders.")
+	require.Len(t, orders, 2, "incorrect number of orders.")
+
+	// Cancel.
+	_, err = connection.OrderCancel(clientIDs[1], first.OrderID)
+	require.ErrorIs(t, err, ErrNotFound, "cancelled another client's order.")
+
+	cancelled, err := connection.OrderCancel(clientIDs[0], first.OrderID)
+	require.NoError(t, err, "failed to cancel order.")
+	require.Equal(t, OrderStatusCancelled, cancelled.Status, "cancelled order status mismatch.")
+
+	// Claim and fill.
+	txID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate transaction id.")
+
+	claimTxID := uuid.NullUUID{UUID: txID, Valid: true}
+
+	require.ErrorIs(t, connection.OrderClaim(first.OrderID, decimal.NewFromFloat(30100), decimal.NewFromFloat(15050),
+		decimal.Zero, claimTxID), ErrUpdateOrder, "claimed a cancelled order.")
+	require.NoError(t, connection.OrderClaim(second.OrderID, decimal.NewFromFloat(30100), decimal.NewFromFloat(15050),
+		decimal.Zero, claimTxID), "failed to claim open order.")
+
+	open, err := connection.OrdersOpen(10)
+	require.NoError(t, err, "failed to retrieve open orders.")
+	require.Empty(t, open, "open orders remaining.")
+
+	// A recently claimed order is not stale.
+	filled, reopened, err := connection.OrdersRecoverStale(time.Now().Add(-time.Minute))
+	require.NoError(t, err, "failed to recover stale orders.")
+	require.Zero(t, filled, "filled a recently claimed order.")
+	require.Zero(t, reopened, "reopened a recently claimed order.")
+
+	require.NoError(t, connection.OrderFill(second.OrderID, txID), "failed to fill order.")
+	require.ErrorIs(t, connection.OrderFill(second.OrderID, txID), ErrUpdateOrder, "filled order twice.")
+}
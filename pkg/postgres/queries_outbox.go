@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/constants"
+	"go.uber.org/zap"
+)
+
+// OutboxUnrelayed is the interface through which external methods can claim a batch of the oldest outbox events that
+// have not been relayed to the Redis event streams. Claimed events are marked as relayed and will not be claimed again
+// by this or another instance unless they are released.
+func (p *postgresImpl) OutboxUnrelayed(limit int32) ([]Outbox, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	events, err := p.Query.outboxClaimUnrelayed(ctx, limit)
+	if err != nil {
+		p.logger.Error("failed to claim unrelayed outbox events", zap.Error(err))
+
+		return nil, ErrTransactOutbox
+	}
+
+	return events, nil
+}
+
+// OutboxReleaseRelay is the interface through which external methods can return an outbox event that could not be
+// relayed to the Redis event streams so that it will be claimed again.
+func (p *postgresImpl) OutboxReleaseRelay(eventID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.outboxReleaseRelay(ctx, eventID)
+	if err != nil {
+		p.logger.Error("failed to release outbox event", zap.String("eventID", eventID.String()), zap.Error(err))
+
+		return ErrTransactOutbox
+	}
+
+	if rowsAffected != int64(1) {
+		return ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueries_Outbox(t *testing.T) {
+	// Integration test check.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	clientIDs := insertTestUsers(t)
+	resetTestWebhooks(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 3*time.Second)
+
+	defer cancel()
+
+	for _, clientID := range clientIDs[:2] {
+		require.NoError(t, connection.Query.outboxPublish(ctx, &outboxPublishParams{
+			ClientID:  clientID,
+			EventType: OutboxEventTypeFiatDeposit,
+			Payload:   []byte(`{"currency":"USD"}`),
+		}), "failed to publish event.")
+	}
+
+	// Claim events in batches.
+	events, err := connection.OutboxUnrelayed(1)
+	require.NoError(t, err, "failed to claim first batch.")
+	require.Len(t, events, 1, "first batch size mismatch.")
+	require.Equal(t, clientIDs[0], events[0].ClientID, "oldest event should be claimed first.")
+	require.True(t, events[0].RelayedAt.Valid, "claimed event should be marked as relayed.")
+
+	events, err = connection.OutboxUnrelayed(10)
+	require.NoError(t, err, "failed to claim second batch.")
+	require.Len(t, events, 1, "second batch size mismatch.")
+	require.Equal(t, clientIDs[1], events[0].ClientID, "claimed events should not be claimed again.")
+
+	eventID := events[0].EventID
+
+	events, err = connection.OutboxUnrelayed(10)
+	require.NoError(t, err, "failed to claim empty batch.")
+	require.Empty(t, events, "all events should have been claimed.")
+
+	// Release an event to be claimed again.
+	require.ErrorIs(t, connection.OutboxReleaseRelay(uuid.Must(uuid.NewV4())), ErrNotFound,
+		"released an unknown event.")
+	require.NoError(t, connection.OutboxReleaseRelay(eventID), "failed to release event.")
+
+	events, err = connection.OutboxUnrelayed(10)
+	require.NoError(t, err, "failed to claim released event.")
+	require.Len(t, events, 1, "released event should be claimed again.")
+	require.Equal(t, eventID, events[0].EventID, "released event mismatch.")
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/constants"
+	"go.uber.org/zap"
+)
+
+// RateCandle contains the open, high, low, and close rates for a currency pair over an interval starting at Start.
+type RateCandle struct {
+	Start  time.Time       `json:"start"`
+	Open   decimal.Decimal `json:"open"`
+	High   decimal.Decimal `json:"high"`
+	Low    decimal.Decimal `json:"low"`
+	Close  decimal.Decimal `json:"close"`
+	Quotes int64           `json:"quotes"`
+}
+
+// RateCreate is the interface through which external methods can record a currency price quote fetched from a quote
+// provider.
+func (p *postgresImpl) RateCreate(
+	source,
+	destination,
+	provider string,
+	rate decimal.Decimal,
+	quotedAt time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	if err := p.Query.rateCreate(ctx, &rateCreateParams{
+		Source:      strings.ToUpper(source),
+		Destination: strings.ToUpper(destination),
+		Rate:        rate,
+		Provider:    provider,
+		QuotedAt:    pgtype.Timestamptz{Time: quotedAt, Valid: true},
+	}); err != nil {
+		p.logger.Error("failed to record currency price quote",
+			zap.String("source", source), zap.String("destination", destination), zap.Error(err))
+
+		return ErrCreateRate
+	}
+
+	return nil
+}
+
+// RateHistory is the interface through which external methods can retrieve the open, high, low, and close rates for a
+// currency pair in fixed intervals over a time range. Intervals without any recorded price quotes are omitted.
+func (p *postgresImpl) RateHistory(
+	source,
+	destination string,
+	start,
+	end time.Time,
+	interval time.Duration) ([]RateCandle, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rows, err := p.Query.rateHistory(ctx, &rateHistoryParams{
+		Bucket:      pgtype.Interval{Microseconds: interval.Microseconds(), Valid: true},
+		StartTime:   pgtype.Timestamptz{Time: start, Valid: true},
+		Source:      strings.ToUpper(source),
+		Destination: strings.ToUpper(destination),
+		EndTime:     pgtype.Timestamptz{Time: end, Valid: true},
+	})
+	if err != nil {
+		p.logger.Error("failed to retrieve currency price quote history",
+			zap.String("source", source), zap.String("destination", destination), zap.Error(err))
+
+		return []RateCandle{}, ErrNotFound
+	}
+
+	candles := make([]RateCandle, len(rows))
+	for idx, row := range rows {
+		candles[idx] = RateCandle{
+			Start:  row.BucketStart.Time.UTC(),
+			Open:   row.Open,
+			High:   row.High,
+			Low:    row.Low,
+			Close:  row.Close,
+			Quotes: row.Quotes,
+		}
+	}
+
+	return candles, nil
+}
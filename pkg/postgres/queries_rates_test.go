@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueries_RateHistory(t *testing.T) {
+	// Integration test check.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	resetTestRates(t)
+
+	start := time.Now().UTC().Truncate(time.Hour)
+
+	require.NoError(t, connection.RateCreate("usd", "cad", "rapidapi", decimal.NewFromFloat(1.31), start),
+		"failed to record first rate.")
+	require.NoError(t, connection.RateCreate("USD", "CAD", "rapidapi", decimal.NewFromFloat(1.32),
+		start.Add(30*time.Minute)), "failed to record second rate.")
+	require.ErrorIs(t, connection.RateCreate("USD", "CAD", "rapidapi", decimal.NewFromFloat(-1), start),
+		ErrCreateRate, "recorded a negative rate.")
+
+	candles, err := connection.RateHistory("usd", "cad", start, start.Add(time.Hour), 15*time.Minute)
+	require.NoError(t, err, "failed to retrieve rate history.")
+	require.Len(t, candles, 2, "incorrect number of intervals.")
+	require.True(t, candles[1].Start.Equal(start.Add(30*time.Minute)), "interval start mismatch.")
+
+	candles, err = connection.RateHistory("USD", "CAD", start, start.Add(time.Hour), time.Hour)
+	require.NoError(t, err, "failed to retrieve single interval rate history.")
+	require.Len(t, candles, 1, "incorrect number of single intervals.")
+	require.True(t, decimal.NewFromFloat(1.31).Equal(candles[0].Open), "open rate mismatch.")
+	require.True(t, decimal.NewFromFloat(1.32).Equal(candles[0].Close), "close rate mismatch.")
+	require.Equal(t, int64(2), candles[0].Quotes, "quote count mismatch.")
+
+	candles, err = connection.RateHistory("EUR", "CAD", start, start.Add(time.Hour), time.Hour)
+	require.NoError(t, err, "failed to retrieve empty rate history.")
+	require.Empty(t, candles, "empty rate history returned intervals.")
+}
@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/constants"
+	"go.uber.org/zap"
+)
+
+// ScheduleCreate is the interface through which external methods can create a recurring Cryptocurrency purchase
+// schedule for a specific client. The day of the month of the first run is used as the anchor for monthly runs.
+func (p *postgresImpl) ScheduleCreate(
+	clientID uuid.UUID,
+	fiatCurrency Currency,
+	ticker string,
+	amount decimal.Decimal,
+	frequency ScheduleFrequency,
+	nextRunAt time.Time) (Schedule, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	schedule, err := p.Query.scheduleCreate(ctx, &scheduleCreateParams{
+		ClientID:     clientID,
+		FiatCurrency: fiatCurrency,
+		Ticker:       ticker,
+		Amount:       amount,
+		Frequency:    frequency,
+		NextRunAt:    pgtype.Timestamptz{Time: nextRunAt, Valid: true},
+		AnchorDay:    int16(nextRunAt.UTC().Day()),
+	})
+	if err != nil {
+		p.logger.Error("failed to create recurring purchase schedule", zap.Error(err))
+
+		return Schedule{}, ErrCreateSchedule
+	}
+
+	return schedule, nil
+}
+
+// ScheduleUpdate is the interface through which external methods can update the amount, frequency, and active state of
+// a recurring purchase schedule for a specific client.
+func (p *postgresImpl) ScheduleUpdate(
+	clientID,
+	scheduleID uuid.UUID,
+	amount decimal.Decimal,
+	frequency ScheduleFrequency,
+	isActive bool) (Schedule, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	schedule, err := p.Query.scheduleUpdate(ctx, &scheduleUpdateParams{
+		Amount:     amount,
+		Frequency:  frequency,
+		IsActive:   isActive,
+		ClientID:   clientID,
+		ScheduleID: scheduleID,
+	})
+	if err != nil {
+		return Schedule{}, ErrNotFound
+	}
+
+	return schedule, nil
+}
+
+// ScheduleDelete is the interface through which external methods can delete a recurring purchase schedule for a
+// specific client.
+func (p *postgresImpl) ScheduleDelete(clientID, scheduleID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.scheduleDelete(ctx, &scheduleDeleteParams{ClientID: clientID, ScheduleID: scheduleID})
+	if err != nil || rowsAffected != int64(1) {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// SchedulesPaginated is the interface through which external methods can retrieve recurring purchase schedules for a
+// specific client.
+func (p *postgresImpl) SchedulesPaginated(clientID uuid.UUID, limit, offset int32) ([]Schedule, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	schedules, err := p.Query.scheduleGetAllPaginated(ctx, &scheduleGetAllPaginatedParams{
+		ClientID: clientID,
+		Offset:   offset,
+		Limit:    limit,
+	})
+	if err != nil {
+		return []Schedule{}, ErrNotFound
+	}
+
+	return schedules, nil
+}
+
+// SchedulesDue is the interface through which external methods can retrieve the active schedules that are due.
+func (p *postgresImpl) SchedulesDue(limit int32) ([]Schedule, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	schedules, err := p.Query.scheduleGetDue(ctx, limit)
+	if err != nil {
+		p.logger.Error("failed to retrieve due recurring purchase schedules", zap.Error(err))
+
+		return []Schedule{}, ErrNotFound
+	}
+
+	return schedules, nil
+}
+
+// ScheduleAdvance is the interface through which external methods can claim a due schedule by moving its next run time
+// forward. The update will fail if the schedule has been run, updated, or deactivated since it was retrieved.
+func (p *postgresImpl) ScheduleAdvance(scheduleID uuid.UUID, current, next time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.scheduleAdvance(ctx, &scheduleAdvanceParams{
+		NextRunAt:    pgtype.Timestamptz{Time: next, Valid: true},
+		ScheduleID:   scheduleID,
+		CurrentRunAt: pgtype.Timestamptz{Time: current, Valid: true},
+	})
+	if err != nil || rowsAffected != int64(1) {
+		return ErrUpdateSchedule
+	}
+
+	return nil
+}
+
+// ScheduleRunCreate is the interface through which external methods can record the outcome of a schedule's run.
+func (p *postgresImpl) ScheduleRunCreate(run *ScheduleRun) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	if err := p.Query.scheduleRunCreate(ctx, &scheduleRunCreateParams{
+		ScheduleID:   run.ScheduleID,
+		ClientID:     run.ClientID,
+		Status:       run.Status,
+		Rate:         run.Rate,
+		FiatAmount:   run.FiatAmount,
+		CryptoAmount: run.CryptoAmount,
+		Fee:          run.Fee,
+		TxID:         run.TxID,
+		Message:      run.Message,
+	}); err != nil {
+		p.logger.Error("failed to record recurring purchase schedule run",
+			zap.String("scheduleID", run.ScheduleID.String()), zap.Error(err))
+
+		return ErrCreateScheduleRun
+	}
+
+	return nil
+}
+
+// ScheduleRunsPaginated is the interface through which external methods can retrieve the run history of a recurring
+// purchase schedule for a specific client.
+func (p *postgresImpl) ScheduleRunsPaginated(clientID, scheduleID uuid.UUID, limit, offset int32) (
+	[]ScheduleRun, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	runs, err := p.Query.scheduleRunGetAllPaginated(ctx, &scheduleRunGetAllPaginatedParams{
+		ClientID:   clientID,
+		ScheduleID: scheduleID,
+		Offset:     offset,
+		Limit:      limit,
+	})
+	if err != nil {
+		return []ScheduleRun{}, ErrNotFound
+	}
+
+	return runs, nil
+}
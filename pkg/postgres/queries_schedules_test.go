@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueries_ScheduleLifecycle(t *testing.T) {
+	// Integration test check.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert test users.
+	clientIDs := insertTestUsers(t)
+
+	first, err := connection.ScheduleCreate(clientIDs[0], CurrencyUSD, "BTC", decimal.NewFromFloat(50),
+		ScheduleFrequencyWeekly, time.Now().Add(-time.Minute))
+	require.NoError(t, err, "failed to create first schedule.")
+
+	_, err = connection.ScheduleCreate(clientIDs[0], CurrencyUSD, "ETH", decimal.NewFromFloat(20),
+		ScheduleFrequencyDaily, time.Now().Add(time.Hour))
+	require.NoError(t, err, "failed to create second schedule.")
+
+	_, err = connection.ScheduleCreate(clientIDs[0], CurrencyUSD, "BTC", decimal.Zero,
+		ScheduleFrequencyWeekly, time.Now())
+	require.ErrorIs(t, err, ErrCreateSchedule, "created schedule with a zero amount.")
+
+	schedules, err := connection.SchedulesPaginated(clientIDs[0], 10, 0)
+	require.NoError(t, err, "failed to retrieve schedules.")
+	require.Len(t, schedules, 2, "incorrect number of schedules.")
+
+	// Claim the due schedule.
+	due, err := connection.SchedulesDue(10)
+	require.NoError(t, err, "failed to retrieve due schedules.")
+	require.Len(t, due, 1, "incorrect number of due schedules.")
+
+	next := due[0].NextRunAt.Time.AddDate(0, 0, 7)
+	require.NoError(t, connection.ScheduleAdvance(first.ScheduleID, due[0].NextRunAt.Time, next),
+		"failed to advance schedule.")
+	require.ErrorIs(t, connection.ScheduleAdvance(first.ScheduleID, due[0].NextRunAt.Time, next),
+		ErrUpdateSchedule, "advanced schedule twice.")
+
+	// Record a run.
+	require.NoError(t, connection.ScheduleRunCreate(&ScheduleRun{
+		ScheduleID: first.ScheduleID, ClientID: clientIDs[0], Status: ScheduleRunStatusFailed,
+		Message: "could not complete Crypto transaction"}), "failed to record run.")
+
+	runs, err := connection.ScheduleRunsPaginated(clientIDs[0], first.ScheduleID, 10, 0)
+	require.NoError(t, err, "failed to retrieve run history.")
+	require.Len(t, runs, 1, "incorrect number of runs.")
+
+	// Update and delete.
+	_, err = connection.ScheduleUpdate(clientIDs[1], first.ScheduleID, decimal.NewFromFloat(60),
+		ScheduleFrequencyWeekly, false)
+	require.ErrorIs(t, err, ErrNotFound, "updated another client's schedule.")
+
+	updated, err := connection.ScheduleUpdate(clientIDs[0], first.ScheduleID, decimal.NewFromFloat(60),
+		ScheduleFrequencyWeekly, false)
+	require.NoError(t, err, "failed to update schedule.")
+	require.False(t, updated.IsActive, "schedule not paused.")
+
+	require.ErrorIs(t, connection.ScheduleDelete(clientIDs[1], first.ScheduleID), ErrNotFound,
+		"deleted another client's schedule.")
+	require.NoError(t, connection.ScheduleDelete(clientIDs[0], first.ScheduleID), "failed to delete schedule.")
+}
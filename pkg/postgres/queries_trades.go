@@ -0,0 +1,23 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/constants"
+)
+
+// TradeTxDetails is the interface through which external methods can retrieve the exchange rate and price quote offer
+// that priced a specific transaction. Transactions that are not trades will not have any trade details.
+func (p *postgresImpl) TradeTxDetails(clientID uuid.UUID, transactionID uuid.UUID) ([]Trade, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	trades, err := p.Query.tradeGetTransaction(ctx, &tradeGetTransactionParams{clientID, transactionID})
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	return trades, nil
+}
@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueries_TradeTxDetails(t *testing.T) {
+	// Integration test check.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	clientIDs := insertTestUsers(t)
+	resetTestTrades(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	defer cancel()
+
+	txID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate transaction id.")
+
+	require.NoError(t, connection.Query.tradeCreate(ctx, &tradeCreateParams{
+		TxID:        txID,
+		ClientID:    clientIDs[0],
+		OfferID:     pgtype.Text{String: "offer-id", Valid: true},
+		Source:      "USD",
+		Destination: "CAD",
+		Rate:        decimal.NewFromFloat(1.3456),
+		TradedAt:    pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true},
+	}), "failed to record trade.")
+
+	trades, err := connection.TradeTxDetails(clientIDs[0], txID)
+	require.NoError(t, err, "failed to retrieve trade.")
+	require.Len(t, trades, 1, "incorrect number of trades.")
+	require.Equal(t, txID, trades[0].TxID, "transaction id mismatch.")
+
+	trades, err = connection.TradeTxDetails(clientIDs[1], txID)
+	require.NoError(t, err, "failed to retrieve another client's trade.")
+	require.Empty(t, trades, "retrieved another client's trade.")
+}
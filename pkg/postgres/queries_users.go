@@ -49,6 +49,22 @@ func (p *postgresImpl) UserCredentials(username string) (uuid.UUID, string, erro
 	return credentials.ClientID, credentials.Password, nil
 }
 
+// UserGetClientID is the interface through which external methods can retrieve the Client ID for a username.
+func (p *postgresImpl) UserGetClientID(username string) (uuid.UUID, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	clientID, err := p.Query.userGetClientId(ctx, username)
+	if err != nil {
+		p.logger.Error("failed to retrieve user Client ID", zap.Error(err))
+
+		return uuid.UUID{}, ErrNotFoundUser
+	}
+
+	return clientID, nil
+}
+
 // UserGetInfo is the interface through which external methods can retrieve user account information.
 func (p *postgresImpl) UserGetInfo(clientID uuid.UUID) (modelsPostgres.User, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
@@ -74,6 +90,8 @@ func (p *postgresImpl) UserGetInfo(clientID uuid.UUID) (modelsPostgres.User, err
 			},
 			ClientID:  userAccount.ClientID,
 			IsDeleted: userAccount.IsDeleted,
+			IsFrozen:  userAccount.IsFrozen,
+			Role:      string(userAccount.Role),
 		},
 		nil
 }
@@ -109,3 +127,74 @@ func (p *postgresImpl) UserIsDeleted(clientID uuid.UUID) (bool, error) {
 
 	return isDeleted, nil
 }
+
+// UserUpdatePassword is the interface through which external methods can replace the hashed password of an active user
+// account.
+func (p *postgresImpl) UserUpdatePassword(clientID uuid.UUID, hashedPassword string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.userUpdatePassword(ctx, &userUpdatePasswordParams{
+		ClientID: clientID,
+		Password: hashedPassword,
+	})
+	if err != nil || rowsAffected != int64(1) {
+		p.logger.Error("failed to update user password", zap.Error(err))
+
+		return ErrNotFoundUser
+	}
+
+	return nil
+}
+
+// UserGetRole is the interface through which external methods can retrieve the role of a user account.
+func (p *postgresImpl) UserGetRole(clientID uuid.UUID) (UserRole, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	role, err := p.Query.userGetRole(ctx, clientID)
+	if err != nil {
+		p.logger.Error("failed to retrieve role of user", zap.Error(err))
+
+		return "", ErrNotFoundUser
+	}
+
+	return role, nil
+}
+
+// UserIsFrozen is the interface through which external methods can check if a user account is frozen.
+func (p *postgresImpl) UserIsFrozen(clientID uuid.UUID) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	isFrozen, err := p.Query.userIsFrozen(ctx, clientID)
+	if err != nil {
+		p.logger.Error("failed to check frozen status of user", zap.Error(err))
+
+		return false, ErrNotFound
+	}
+
+	return isFrozen, nil
+}
+
+// UserSetFrozen is the interface through which external methods can freeze or unfreeze an active user account.
+func (p *postgresImpl) UserSetFrozen(clientID uuid.UUID, isFrozen bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.userSetFrozen(ctx, &userSetFrozenParams{
+		ClientID: clientID,
+		IsFrozen: isFrozen,
+	})
+	if err != nil || rowsAffected != int64(1) {
+		p.logger.Error("failed to update frozen status of user", zap.Error(err))
+
+		return ErrNotFoundUser
+	}
+
+	return nil
+}
@@ -74,6 +74,26 @@ func TestQueries_UserCredentials(t *testing.T) {
 	require.Empty(t, hashedPass, "retrieved a password for an invalid user.")
 }
 
+func TestQueries_UserGetClientID(t *testing.T) {
+	// Integration test check.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert an initial set of test users.
+	insertTestUsers(t)
+
+	// Active account.
+	clientID, err := connection.UserGetClientID("username1")
+	require.NoError(t, err, "failed to retrieve user Client ID.")
+	require.False(t, clientID.IsNil(), "retrieved an invalid clientID.")
+
+	// Non-existent user.
+	clientID, err = connection.UserGetClientID("invalid-username")
+	require.Error(t, err, "retrieved invalid users' Client ID.")
+	require.True(t, clientID.IsNil(), "retrieved an invalid users' clientID.")
+}
+
 func TestQueries_UserGetInfo(t *testing.T) {
 	// Integration test check.
 	if testing.Short() {
@@ -139,3 +159,100 @@ func TestQueries_IsDeletedUser(t *testing.T) {
 		})
 	}
 }
+
+func TestQueries_UserUpdatePassword(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert an initial set of test users.
+	clientIDs := insertTestUsers(t)
+
+	// Non-existent user.
+	invalidID, err := uuid.NewV1()
+	require.NoError(t, err, "failed to generate invalid client id.")
+	require.Error(t, connection.UserUpdatePassword(invalidID, "new-password"), "updated non-existent user.")
+
+	for _, clientID := range clientIDs {
+		t.Run("Updating password of user: "+clientID.String(), func(t *testing.T) {
+			// Active account.
+			require.NoError(t, connection.UserUpdatePassword(clientID, "new-password"), "failed to update password.")
+
+			account, err := connection.UserGetInfo(clientID)
+			require.NoError(t, err, "failed to retrieve account info.")
+			require.Equal(t, "new-password", account.Password, "password mismatch.")
+
+			// Deleted account.
+			require.NoError(t, connection.UserDelete(clientID), "failed to execute delete on user.")
+			require.Error(t, connection.UserUpdatePassword(clientID, "newer-password"), "updated deleted user.")
+		})
+	}
+}
+
+func TestQueries_UserGetRole(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert an initial set of test users.
+	clientIDs := insertTestUsers(t)
+
+	// Non-existent user.
+	invalidID, err := uuid.NewV1()
+	require.NoError(t, err, "failed to generate invalid client id.")
+	_, err = connection.UserGetRole(invalidID)
+	require.ErrorIs(t, err, ErrNotFoundUser, "retrieved role of non-existent user.")
+
+	for _, clientID := range clientIDs {
+		t.Run("Checking role of user: "+clientID.String(), func(t *testing.T) {
+			role, err := connection.UserGetRole(clientID)
+			require.NoError(t, err, "failed to retrieve role of user.")
+			require.Equal(t, UserRoleUser, role, "incorrect default role for user.")
+		})
+	}
+}
+
+func TestQueries_UserFrozen(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert an initial set of test users.
+	clientIDs := insertTestUsers(t)
+
+	// Non-existent user.
+	invalidID, err := uuid.NewV1()
+	require.NoError(t, err, "failed to generate invalid client id.")
+	_, err = connection.UserIsFrozen(invalidID)
+	require.Error(t, err, "retrieved frozen status of non-existent user.")
+	require.ErrorIs(t, connection.UserSetFrozen(invalidID, true), ErrNotFoundUser, "froze non-existent user.")
+
+	for _, clientID := range clientIDs {
+		t.Run("Freezing user: "+clientID.String(), func(t *testing.T) {
+			// Freeze.
+			require.NoError(t, connection.UserSetFrozen(clientID, true), "failed to freeze user.")
+
+			isFrozen, err := connection.UserIsFrozen(clientID)
+			require.NoError(t, err, "failed to retrieve frozen status of user.")
+			require.True(t, isFrozen, "user is not frozen.")
+
+			account, err := connection.UserGetInfo(clientID)
+			require.NoError(t, err, "failed to retrieve account info.")
+			require.True(t, account.IsFrozen, "account info frozen status mismatch.")
+
+			// Unfreeze.
+			require.NoError(t, connection.UserSetFrozen(clientID, false), "failed to unfreeze user.")
+
+			isFrozen, err = connection.UserIsFrozen(clientID)
+			require.NoError(t, err, "failed to retrieve unfrozen status of user.")
+			require.False(t, isFrozen, "user is frozen.")
+
+			// Deleted account.
+			require.NoError(t, connection.UserDelete(clientID), "failed to execute delete on user.")
+			require.Error(t, connection.UserSetFrozen(clientID, true), "froze deleted user.")
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/surahman/FTeX/pkg/constants"
+	"go.uber.org/zap"
+)
+
+// WebhookDeliveryDue is a pending webhook delivery that has been claimed for an attempt. It contains the event to be
+// delivered and the webhook URL with its encrypted signing secret.
+type WebhookDeliveryDue struct {
+	DeliveryID uuid.UUID       `json:"deliveryId"`
+	EventID    uuid.UUID       `json:"eventId"`
+	ClientID   uuid.UUID       `json:"clientId"`
+	Attempts   int32           `json:"attempts"`
+	EventType  OutboxEventType `json:"eventType"`
+	Payload    json.RawMessage `json:"payload"`
+	CreatedAt  time.Time       `json:"createdAt"`
+	URL        string          `json:"url"`
+	Secret     string          `json:"-"`
+}
+
+// WebhookDeadLetter is a webhook delivery that was abandoned after exhausting its delivery attempts.
+type WebhookDeadLetter struct {
+	DeliveryID uuid.UUID       `json:"deliveryId"`
+	EventID    uuid.UUID       `json:"eventId"`
+	WebhookID  uuid.UUID       `json:"webhookId"`
+	URL        string          `json:"url"`
+	EventType  OutboxEventType `json:"eventType"`
+	Payload    json.RawMessage `json:"payload" swaggertype:"object"`
+	Attempts   int32           `json:"attempts"`
+	LastError  string          `json:"lastError"`
+	CreatedAt  time.Time       `json:"createdAt"`
+	FailedAt   time.Time       `json:"failedAt"`
+}
+
+// WebhookCreate is the interface through which external methods can register a webhook URL with its encrypted signing
+// secret for a client. Clients are limited to a maximum number of webhooks and cannot register the same URL twice.
+func (p *postgresImpl) WebhookCreate(clientID uuid.UUID, url, secret string, limit int64) (Webhook, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	webhook, err := p.Query.webhookCreate(ctx, &webhookCreateParams{
+		ClientID:    clientID,
+		Url:         url,
+		Secret:      secret,
+		MaxWebhooks: limit,
+	})
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			p.logger.Error("failed to create webhook", zap.Error(err))
+		}
+
+		return Webhook{}, ErrCreateWebhook
+	}
+
+	return webhook, nil
+}
+
+// WebhookList is the interface through which external methods can retrieve all of a client's webhooks, oldest first.
+func (p *postgresImpl) WebhookList(clientID uuid.UUID) ([]Webhook, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	webhooks, err := p.Query.webhookGetAll(ctx, clientID)
+	if err != nil {
+		p.logger.Error("failed to retrieve webhooks", zap.Error(err))
+
+		return nil, ErrTransactWebhook
+	}
+
+	return webhooks, nil
+}
+
+// WebhookDelete is the interface through which external methods can delete one of a client's webhooks. Any pending
+// deliveries and dead letters for the webhook are deleted with it.
+func (p *postgresImpl) WebhookDelete(clientID, webhookID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.webhookDelete(ctx, &webhookDeleteParams{ClientID: clientID, WebhookID: webhookID})
+	if err != nil {
+		p.logger.Error("failed to delete webhook", zap.Error(err))
+
+		return ErrTransactWebhook
+	}
+
+	if rowsAffected != int64(1) {
+		return ErrNotFoundWebhook
+	}
+
+	return nil
+}
+
+// WebhookDeliveriesDue is the interface through which external methods can claim a batch of pending webhook deliveries
+// that are due. Claimed deliveries will not be due again until the lease expires, which allows deliveries that were
+// interrupted to be retried.
+func (p *postgresImpl) WebhookDeliveriesDue(limit int32, leasedUntil time.Time) ([]WebhookDeliveryDue, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rows, err := p.Query.webhookDeliveryClaimDue(ctx, &webhookDeliveryClaimDueParams{
+		LeasedUntil: pgtype.Timestamptz{Time: leasedUntil, Valid: true},
+		BatchSize:   limit,
+	})
+	if err != nil {
+		p.logger.Error("failed to claim due webhook deliveries", zap.Error(err))
+
+		return nil, ErrTransactWebhook
+	}
+
+	deliveries := make([]WebhookDeliveryDue, len(rows))
+	for idx, row := range rows {
+		deliveries[idx] = WebhookDeliveryDue{
+			DeliveryID: row.DeliveryID,
+			EventID:    row.EventID,
+			ClientID:   row.ClientID,
+			Attempts:   row.Attempts,
+			EventType:  row.EventType,
+			Payload:    row.Payload,
+			CreatedAt:  row.CreatedAt.Time.UTC(),
+			URL:        row.Url,
+			Secret:     row.Secret,
+		}
+	}
+
+	return deliveries, nil
+}
+
+// WebhookDeliveryUpdate is the interface through which external methods can record the outcome of a webhook delivery
+// attempt. Pending deliveries will be attempted again at the next attempt time.
+func (p *postgresImpl) WebhookDeliveryUpdate(
+	deliveryID uuid.UUID,
+	status WebhookDeliveryStatus,
+	nextAttemptAt time.Time,
+	lastError string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rowsAffected, err := p.Query.webhookDeliveryUpdate(ctx, &webhookDeliveryUpdateParams{
+		Status:        status,
+		NextAttemptAt: pgtype.Timestamptz{Time: nextAttemptAt, Valid: true},
+		LastError:     lastError,
+		DeliveryID:    deliveryID,
+	})
+	if err != nil {
+		p.logger.Error("failed to update webhook delivery",
+			zap.String("deliveryID", deliveryID.String()), zap.Error(err))
+
+		return ErrTransactWebhook
+	}
+
+	if rowsAffected != int64(1) {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// WebhookDeadLettersPaginated is the interface through which external methods can retrieve a client's webhook
+// deliveries that were abandoned after exhausting their delivery attempts, most recent first.
+func (p *postgresImpl) WebhookDeadLettersPaginated(clientID uuid.UUID, limit, offset int32) (
+	[]WebhookDeadLetter, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ThreeSeconds())
+
+	defer cancel()
+
+	rows, err := p.Query.webhookDeliveryGetDeadPaginated(ctx, &webhookDeliveryGetDeadPaginatedParams{
+		ClientID: clientID,
+		Offset:   offset,
+		Limit:    limit,
+	})
+	if err != nil {
+		return []WebhookDeadLetter{}, ErrNotFound
+	}
+
+	deadLetters := make([]WebhookDeadLetter, len(rows))
+	for idx, row := range rows {
+		deadLetters[idx] = WebhookDeadLetter{
+			DeliveryID: row.DeliveryID,
+			EventID:    row.EventID,
+			WebhookID:  row.WebhookID,
+			URL:        row.Url,
+			EventType:  row.EventType,
+			Payload:    row.Payload,
+			Attempts:   row.Attempts,
+			LastError:  row.LastError,
+			CreatedAt:  row.CreatedAt.Time.UTC(),
+			FailedAt:   row.UpdatedAt.Time.UTC(),
+		}
+	}
+
+	return deadLetters, nil
+}
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueries_Webhooks(t *testing.T) {
+	// Integration test check.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	clientIDs := insertTestUsers(t)
+	resetTestWebhooks(t)
+
+	webhook, err := connection.WebhookCreate(clientIDs[0], "https://example.com/hook-1", "secret-1", 1)
+	require.NoError(t, err, "failed to create webhook.")
+
+	_, err = connection.WebhookCreate(clientIDs[0], "https://example.com/hook-2", "secret-2", 1)
+	require.ErrorIs(t, err, ErrCreateWebhook, "created a webhook above the limit.")
+
+	webhooks, err := connection.WebhookList(clientIDs[0])
+	require.NoError(t, err, "failed to list webhooks.")
+	require.Len(t, webhooks, 1, "webhook count mismatch.")
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 3*time.Second)
+
+	defer cancel()
+
+	// Publish an event and exhaust its delivery.
+	require.NoError(t, connection.Query.outboxPublish(ctx, &outboxPublishParams{
+		ClientID:  clientIDs[0],
+		EventType: OutboxEventTypeCryptoPurchase,
+		Payload:   []byte(`{"ticker":"BTC"}`),
+	}), "failed to publish event.")
+
+	due, err := connection.WebhookDeliveriesDue(10, time.Now().Add(time.Minute))
+	require.NoError(t, err, "failed to claim due deliveries.")
+	require.Len(t, due, 1, "due delivery count mismatch.")
+	require.Equal(t, webhook.Url, due[0].URL, "url mismatch.")
+	require.Equal(t, "secret-1", due[0].Secret, "secret mismatch.")
+
+	require.ErrorIs(t, connection.WebhookDeliveryUpdate(uuid.Must(uuid.NewV4()), WebhookDeliveryStatusDead,
+		time.Now(), ""), ErrNotFound, "updated an unknown delivery.")
+	require.NoError(t, connection.WebhookDeliveryUpdate(due[0].DeliveryID, WebhookDeliveryStatusDead, time.Now(),
+		"connection refused"), "failed to update delivery.")
+
+	deadLetters, err := connection.WebhookDeadLettersPaginated(clientIDs[0], 10, 0)
+	require.NoError(t, err, "failed to retrieve dead letters.")
+	require.Len(t, deadLetters, 1, "dead letter count mismatch.")
+	require.Equal(t, due[0].EventID, deadLetters[0].EventID, "event ID mismatch.")
+	require.Equal(t, "connection refused", deadLetters[0].LastError, "last error mismatch.")
+
+	require.ErrorIs(t, connection.WebhookDelete(clientIDs[1], webhook.WebhookID), ErrNotFoundWebhook,
+		"deleted another client's webhook.")
+	require.NoError(t, connection.WebhookDelete(clientIDs[0], webhook.WebhookID), "failed to delete webhook.")
+	require.ErrorIs(t, connection.WebhookDelete(clientIDs[0], webhook.WebhookID), ErrNotFoundWebhook,
+		"deleted a webhook twice.")
+}
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRates_RateHistory(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	resetTestRates(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	defer cancel()
+
+	start := time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	// Record quotes across two buckets, out of order, and for another pair.
+	for _, params := range []rateCreateParams{
+		{Source: "USD", Destination: "CAD", Rate: decimal.NewFromFloat(1.33), Provider: "frankfurter",
+			QuotedAt: pgtype.Timestamptz{Time: start.Add(3 * time.Minute), Valid: true}},
+		{Source: "USD", Destination: "CAD", Rate: decimal.NewFromFloat(1.31), Provider: "rapidapi",
+			QuotedAt: pgtype.Timestamptz{Time: start.Add(time.Minute), Valid: true}},
+		{Source: "USD", Destination: "CAD", Rate: decimal.NewFromFloat(1.35), Provider: "rapidapi",
+			QuotedAt: pgtype.Timestamptz{Time: start.Add(2 * time.Minute), Valid: true}},
+		{Source: "USD", Destination: "CAD", Rate: decimal.NewFromFloat(1.29), Provider: "rapidapi",
+			QuotedAt: pgtype.Timestamptz{Time: start.Add(7 * time.Minute), Valid: true}},
+		{Source: "USD", Destination: "CAD", Rate: decimal.NewFromFloat(1.40), Provider: "rapidapi",
+			QuotedAt: pgtype.Timestamptz{Time: start.Add(time.Hour), Valid: true}},
+		{Source: "CAD", Destination: "USD", Rate: decimal.NewFromFloat(0.75), Provider: "rapidapi",
+			QuotedAt: pgtype.Timestamptz{Time: start.Add(time.Minute), Valid: true}},
+	} {
+		param := params
+		require.NoError(t, connection.Query.rateCreate(ctx, &param), "failed to record rate.")
+	}
+
+	// Invalid rate.
+	require.Error(t, connection.Query.rateCreate(ctx, &rateCreateParams{
+		Source: "USD", Destination: "CAD", Rate: decimal.Zero, Provider: "rapidapi",
+		QuotedAt: pgtype.Timestamptz{Time: start, Valid: true}}), "recorded a zero rate.")
+
+	candles, err := connection.Query.rateHistory(ctx, &rateHistoryParams{
+		Bucket:      pgtype.Interval{Microseconds: (5 * time.Minute).Microseconds(), Valid: true},
+		StartTime:   pgtype.Timestamptz{Time: start, Valid: true},
+		Source:      "USD",
+		Destination: "CAD",
+		EndTime:     pgtype.Timestamptz{Time: start.Add(30 * time.Minute), Valid: true},
+	})
+	require.NoError(t, err, "failed to retrieve rate history.")
+	require.Len(t, candles, 2, "incorrect number of intervals.")
+
+	require.True(t, candles[0].BucketStart.Time.Equal(start), "first interval start mismatch.")
+	require.True(t, decimal.NewFromFloat(1.31).Equal(candles[0].Open), "open rate mismatch.")
+	require.True(t, decimal.NewFromFloat(1.35).Equal(candles[0].High), "high rate mismatch.")
+	require.True(t, decimal.NewFromFloat(1.31).Equal(candles[0].Low), "low rate mismatch.")
+	require.True(t, decimal.NewFromFloat(1.33).Equal(candles[0].Close), "close rate mismatch.")
+	require.Equal(t, int64(3), candles[0].Quotes, "quote count mismatch.")
+
+	require.True(t, candles[1].BucketStart.Time.Equal(start.Add(5*time.Minute)), "second interval start mismatch.")
+	require.True(t, decimal.NewFromFloat(1.29).Equal(candles[1].Open), "single quote open rate mismatch.")
+	require.True(t, decimal.NewFromFloat(1.29).Equal(candles[1].Close), "single quote close rate mismatch.")
+	require.Equal(t, int64(1), candles[1].Quotes, "single quote count mismatch.")
+}
@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedules_ScheduleLifecycle(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	clientIDs := insertTestUsers(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	defer cancel()
+
+	past := pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true}
+	future := pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true}
+
+	// Create schedules.
+	schedules := make([]Schedule, 0, 2)
+
+	for _, params := range []scheduleCreateParams{
+		{ClientID: clientIDs[0], FiatCurrency: CurrencyUSD, Ticker: "BTC", Amount: decimal.NewFromFloat(50),
+			Frequency: ScheduleFrequencyWeekly, NextRunAt: past, AnchorDay: int16(past.Time.UTC().Day())},
+		{ClientID: clientIDs[0], FiatCurrency: CurrencyCAD, Ticker: "ETH", Amount: decimal.NewFromFloat(25.5),
+			Frequency: ScheduleFrequencyDaily, NextRunAt: future, AnchorDay: int16(future.Time.UTC().Day())},
+	} {
+		param := params
+		schedule, err := connection.Query.scheduleCreate(ctx, &param)
+		require.NoError(t, err, "failed to create schedule.")
+		require.True(t, schedule.IsActive, "new schedule is not active.")
+		require.True(t, param.Amount.Equal(schedule.Amount), "schedule amount mismatch.")
+		require.Equal(t, param.Frequency, schedule.Frequency, "schedule frequency mismatch.")
+		require.Equal(t, param.AnchorDay, schedule.AnchorDay, "schedule anchor day mismatch.")
+
+		schedules = append(schedules, schedule)
+	}
+
+	// Invalid schedule amount.
+	_, err := connection.Query.scheduleCreate(ctx, &scheduleCreateParams{
+		ClientID: clientIDs[0], FiatCurrency: CurrencyUSD, Ticker: "BTC", Amount: decimal.NewFromFloat(-1),
+		Frequency: ScheduleFrequencyWeekly, NextRunAt: past, AnchorDay: 1})
+	require.Error(t, err, "created schedule with a negative amount.")
+
+	// Invalid schedule anchor day.
+	_, err = connection.Query.scheduleCreate(ctx, &scheduleCreateParams{
+		ClientID: clientIDs[0], FiatCurrency: CurrencyUSD, Ticker: "BTC", Amount: decimal.NewFromFloat(50),
+		Frequency: ScheduleFrequencyMonthly, NextRunAt: past, AnchorDay: 32})
+	require.Error(t, err, "created schedule with an invalid anchor day.")
+
+	// Due schedules.
+	due, err := connection.Query.scheduleGetDue(ctx, 10)
+	require.NoError(t, err, "failed to retrieve due schedules.")
+	require.Len(t, due, 1, "incorrect number of due schedules.")
+	require.Equal(t, schedules[0].ScheduleID, due[0].ScheduleID, "due schedule mismatch.")
+
+	// Paginated schedules.
+	page, err := connection.Query.scheduleGetAllPaginated(ctx,
+		&scheduleGetAllPaginatedParams{ClientID: clientIDs[0], Offset: 0, Limit: 1})
+	require.NoError(t, err, "failed to retrieve schedules page.")
+	require.Len(t, page, 1, "incorrect page size.")
+	require.Equal(t, schedules[1].ScheduleID, page[0].ScheduleID, "schedules are not newest first.")
+
+	// Advance.
+	rowsAffected, err := connection.Query.scheduleAdvance(ctx, &scheduleAdvanceParams{
+		NextRunAt: future, ScheduleID: due[0].ScheduleID, CurrentRunAt: due[0].NextRunAt})
+	require.NoError(t, err, "failed to advance schedule.")
+	require.Equal(t, int64(1), rowsAffected, "schedule not advanced.")
+
+	rowsAffected, err = connection.Query.scheduleAdvance(ctx, &scheduleAdvanceParams{
+		NextRunAt: future, ScheduleID: due[0].ScheduleID, CurrentRunAt: due[0].NextRunAt})
+	require.NoError(t, err, "failed to advance schedule a second time.")
+	require.Equal(t, int64(0), rowsAffected, "schedule advanced twice.")
+
+	// Run history.
+	txID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate transaction id.")
+
+	require.NoError(t, connection.Query.scheduleRunCreate(ctx, &scheduleRunCreateParams{
+		ScheduleID: schedules[0].ScheduleID, ClientID: clientIDs[0], Status: ScheduleRunStatusSucceeded,
+		Rate: decimal.NewFromFloat(0.00003), FiatAmount: decimal.NewFromFloat(50),
+		CryptoAmount: decimal.NewFromFloat(0.0015), Fee: decimal.NewFromFloat(0.5),
+		TxID: uuid.NullUUID{UUID: txID, Valid: true}}), "failed to record successful run.")
+	require.NoError(t, connection.Query.scheduleRunCreate(ctx, &scheduleRunCreateParams{
+		ScheduleID: schedules[0].ScheduleID, ClientID: clientIDs[0], Status: ScheduleRunStatusFailed,
+		Message: "insufficient funds"}), "failed to record failed run.")
+
+	runs, err := connection.Query.scheduleRunGetAllPaginated(ctx, &scheduleRunGetAllPaginatedParams{
+		ClientID: clientIDs[0], ScheduleID: schedules[0].ScheduleID, Offset: 0, Limit: 10})
+	require.NoError(t, err, "failed to retrieve run history.")
+	require.Len(t, runs, 2, "incorrect number of runs.")
+
+	runs, err = connection.Query.scheduleRunGetAllPaginated(ctx, &scheduleRunGetAllPaginatedParams{
+		ClientID: clientIDs[1], ScheduleID: schedules[0].ScheduleID, Offset: 0, Limit: 10})
+	require.NoError(t, err, "failed to retrieve another client's run history.")
+	require.Empty(t, runs, "retrieved another client's run history.")
+
+	// Update.
+	updated, err := connection.Query.scheduleUpdate(ctx, &scheduleUpdateParams{
+		Amount: decimal.NewFromFloat(75), Frequency: ScheduleFrequencyMonthly, IsActive: false,
+		ClientID: clientIDs[0], ScheduleID: schedules[0].ScheduleID})
+	require.NoError(t, err, "failed to update schedule.")
+	require.False(t, updated.IsActive, "schedule not deactivated.")
+	require.Equal(t, ScheduleFrequencyMonthly, updated.Frequency, "schedule frequency not updated.")
+
+	_, err = connection.Query.scheduleUpdate(ctx, &scheduleUpdateParams{
+		Amount: decimal.NewFromFloat(75), Frequency: ScheduleFrequencyMonthly, IsActive: false,
+		ClientID: clientIDs[1], ScheduleID: schedules[0].ScheduleID})
+	require.Error(t, err, "updated another client's schedule.")
+
+	// Delete.
+	rowsAffected, err = connection.Query.scheduleDelete(ctx,
+		&scheduleDeleteParams{ClientID: clientIDs[1], ScheduleID: schedules[0].ScheduleID})
+	require.NoError(t, err, "failed to attempt deletion of another client's schedule.")
+	require.Equal(t, int64(0), rowsAffected, "deleted another client's schedule.")
+
+	rowsAffected, err = connection.Query.scheduleDelete(ctx,
+		&scheduleDeleteParams{ClientID: clientIDs[0], ScheduleID: schedules[0].ScheduleID})
+	require.NoError(t, err, "failed to delete schedule.")
+	require.Equal(t, int64(1), rowsAffected, "schedule not deleted.")
+}
@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrades_TradeCreate(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	clientIDs := insertTestUsers(t)
+	resetTestTrades(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	defer cancel()
+
+	txIDOffer, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate transaction id for offer trade.")
+
+	txIDNoOffer, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate transaction id for trade without offer.")
+
+	tradedAt := pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true}
+
+	testCases := []struct {
+		name      string
+		params    *tradeCreateParams
+		expectErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "valid - offer",
+			params: &tradeCreateParams{
+				TxID:        txIDOffer,
+				ClientID:    clientIDs[0],
+				OfferID:     pgtype.Text{String: "offer-id", Valid: true},
+				Source:      "USD",
+				Destination: "CAD",
+				Rate:        decimal.NewFromFloat(1.3456),
+				TradedAt:    tradedAt,
+			},
+			expectErr: require.NoError,
+		}, {
+			name: "valid - no offer",
+			params: &tradeCreateParams{
+				TxID:        txIDNoOffer,
+				ClientID:    clientIDs[0],
+				Source:      "USD",
+				Destination: "BTC",
+				Rate:        decimal.NewFromFloat(0.00002873),
+				TradedAt:    tradedAt,
+			},
+			expectErr: require.NoError,
+		}, {
+			name: "invalid - duplicate transaction",
+			params: &tradeCreateParams{
+				TxID:        txIDOffer,
+				ClientID:    clientIDs[0],
+				Source:      "USD",
+				Destination: "CAD",
+				Rate:        decimal.NewFromFloat(1.3456),
+				TradedAt:    tradedAt,
+			},
+			expectErr: require.Error,
+		}, {
+			name: "invalid - zero rate",
+			params: &tradeCreateParams{
+				TxID:        uuid.Must(uuid.NewV4()),
+				ClientID:    clientIDs[0],
+				Source:      "USD",
+				Destination: "CAD",
+				Rate:        decimal.Zero,
+				TradedAt:    tradedAt,
+			},
+			expectErr: require.Error,
+		}, {
+			name: "invalid - unknown client",
+			params: &tradeCreateParams{
+				TxID:        uuid.Must(uuid.NewV4()),
+				ClientID:    uuid.Must(uuid.NewV4()),
+				Source:      "USD",
+				Destination: "CAD",
+				Rate:        decimal.NewFromFloat(1.3456),
+				TradedAt:    tradedAt,
+			},
+			expectErr: require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			test.expectErr(t, connection.Query.tradeCreate(ctx, test.params), "error expectation failed.")
+		})
+	}
+
+	t.Run("retrieve trades", func(t *testing.T) {
+		trades, err := connection.Query.tradeGetTransaction(ctx, &tradeGetTransactionParams{
+			ClientID: clientIDs[0],
+			TxID:     txIDOffer,
+		})
+		require.NoError(t, err, "failed to retrieve trade with offer.")
+		require.Len(t, trades, 1, "incorrect number of trades with offer.")
+		require.Equal(t, "offer-id", trades[0].OfferID.String, "offer id mismatch.")
+		require.True(t, decimal.NewFromFloat(1.3456).Equal(trades[0].Rate), "rate mismatch.")
+
+		trades, err = connection.Query.tradeGetTransaction(ctx, &tradeGetTransactionParams{
+			ClientID: clientIDs[0],
+			TxID:     txIDNoOffer,
+		})
+		require.NoError(t, err, "failed to retrieve trade without offer.")
+		require.Len(t, trades, 1, "incorrect number of trades without offer.")
+		require.False(t, trades[0].OfferID.Valid, "trade without offer has an offer id.")
+
+		trades, err = connection.Query.tradeGetTransaction(ctx, &tradeGetTransactionParams{
+			ClientID: clientIDs[1],
+			TxID:     txIDOffer,
+		})
+		require.NoError(t, err, "failed to retrieve trade for another client.")
+		require.Empty(t, trades, "retrieved another client's trade.")
+	})
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -19,6 +20,17 @@ type FiatTransactionDetails struct {
 	ClientID uuid.UUID       `json:"clientId"`
 	Currency Currency        `json:"currency"`
 	Amount   decimal.Decimal `json:"amount"`
+	Fee      decimal.Decimal `json:"fee"` // Portion of a source Amount to be collected as fee revenue.
+}
+
+// TradeDetails contains the exchange rate and the price quote offer that priced a trade. Trades that were not priced by
+// a price quote offer, such as limit order fills, will have an empty OfferID. A TxID will be used as the transaction ID
+// when it is set, and an executing limit order with the OrderID will be filled in the same transaction block if set.
+type TradeDetails struct {
+	OfferID string          `json:"offerId"`
+	Rate    decimal.Decimal `json:"rate"`
+	TxID    uuid.UUID       `json:"txId"`
+	OrderID uuid.UUID       `json:"orderId"`
 }
 
 // Less returns a total ordering on two FiatTransactionDetails structs.
@@ -92,6 +104,13 @@ func (p *postgresImpl) FiatExternalTransfer(parentCtx context.Context, xferDetai
 		return nil, ErrTransactFiat
 	}
 
+	// Publish the deposit to the outbox.
+	if err = outboxPublishEvent(ctx, queryTx, txReceipt.ClientID, OutboxEventTypeFiatDeposit, txReceipt); err != nil {
+		p.logger.Warn("failed to publish external Fiat transfer event", zap.Error(err))
+
+		return nil, ErrTransactFiat
+	}
+
 	// Commit transaction.
 	if err = tx.Commit(ctx); err != nil {
 		p.logger.Warn("failed to commit external Fiat account transfer", zap.Error(err))
@@ -112,8 +131,9 @@ func (p *postgresImpl) FiatExternalTransfer(parentCtx context.Context, xferDetai
 
     [1] Acquire a row lock on the destination account without holding a lock on the foreign key for the Client ID.
         There will be no update for the external account balance, so there is no need for a row lock on the account.
-    [2] Make the Journal entries for the external and internal accounts.
-    [3] Update the balance for the internal account.
+    [2] Check that the destination account is active.
+    [3] Make the Journal entries for the external and internal accounts.
+    [4] Update the balance for the internal account.
 */
 func fiatExternalTransfer(
 	ctx context.Context,
@@ -122,12 +142,13 @@ func fiatExternalTransfer(
 	xferDetails *FiatTransactionDetails) (*FiatAccountTransferResult, error) {
 	var (
 		err        error
+		account    fiatRowLockAccountRow
 		journalRow fiatExternalTransferJournalEntryRow
 		updateRow  fiatUpdateAccountBalanceRow
 	)
 
 	// Row lock the destination account.
-	if _, err = queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
+	if account, err = queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
 		ClientID: xferDetails.ClientID,
 		Currency: xferDetails.Currency,
 	}); err != nil {
@@ -137,6 +158,11 @@ func fiatExternalTransfer(
 		return nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
 	}
 
+	// Check the destination account can receive funds.
+	if _, err = fiatAvailableBalance(&account); err != nil {
+		return nil, err
+	}
+
 	// Make General Journal ledger entries.
 	if journalRow, err = queryTx.fiatExternalTransferJournalEntry(ctx, &fiatExternalTransferJournalEntryParams{
 		ClientID: xferDetails.ClientID,
@@ -173,8 +199,328 @@ func fiatExternalTransfer(
 		nil
 }
 
+// FiatExternalWithdrawal controls the transaction block that the external Fiat withdrawal transaction executes in.
+func (p *postgresImpl) FiatExternalWithdrawal(parentCtx context.Context, xferDetails *FiatTransactionDetails) (
+	*FiatAccountTransferResult, error) {
+	ctx, cancel := context.WithTimeout(parentCtx, constants.ThreeSeconds())
+
+	defer cancel()
+
+	var (
+		err       error
+		tx        pgx.Tx
+		txReceipt *FiatAccountTransferResult
+	)
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("external withdrawal Fiat transaction block setup failed", zap.Error(err))
+
+		return nil, ErrTransactFiat
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(parentCtx); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback external Fiat account withdrawal", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Configure transaction query connection.
+	queryTx := p.queries.WithTx(tx)
+
+	// Handoff to external fiat withdrawal core logic.
+	if txReceipt, err = fiatExternalWithdrawal(ctx, p.logger, queryTx, xferDetails); err != nil {
+		p.logger.Warn("failed to complete external Fiat withdrawal transaction", zap.Error(err))
+
+		return nil, ErrWithdrawFiat
+	}
+
+	// Publish the withdrawal to the outbox.
+	if err = outboxPublishEvent(ctx, queryTx, txReceipt.ClientID, OutboxEventTypeFiatWithdrawal, txReceipt); err != nil {
+		p.logger.Warn("failed to publish external Fiat withdrawal event", zap.Error(err))
+
+		return nil, ErrTransactFiat
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit external Fiat account withdrawal", zap.Error(err))
+
+		return nil, ErrTransactFiat
+	}
+
+	return txReceipt, nil
+}
+
+// fiatExternalWithdrawal will execute the logic to complete the external Fiat withdrawal transaction.
+/*
+  		Minimize the duration for which the transaction block will be active by performing as many operations as
+   		possible outside the transaction.
+
+        The queries to update the balance will round Half-to-Even to account for floating point precision
+        representational issues.
+
+    [1] Acquire a row lock on the source account without holding a lock on the foreign key for the Client ID.
+        There will be no update for the external account balance, so there is no need for a row lock on the account.
+    [2] Check that the source account is active and has a sufficient available balance, net of any funds on hold,
+        to cover the withdrawal.
+    [3] Make the Journal entries for the internal and external accounts.
+    [4] Debit the balance for the internal account.
+*/
+func fiatExternalWithdrawal(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	xferDetails *FiatTransactionDetails) (*FiatAccountTransferResult, error) {
+	var (
+		err       error
+		account   fiatRowLockAccountRow
+		available decimal.Decimal
+	)
+
+	// Check for non-positive values.
+	if !xferDetails.Amount.IsPositive() {
+		return nil, errors.New("withdrawal amount must be positive")
+	}
+
+	// Row lock the source account.
+	if account, err = queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
+		ClientID: xferDetails.ClientID,
+		Currency: xferDetails.Currency,
+	}); err != nil {
+		msg := "failed to get row lock on source Fiat account"
+		logger.Warn(msg, zap.Error(err))
+
+		return nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	// Check for sufficient funds.
+	if available, err = fiatAvailableBalance(&account); err != nil {
+		return nil, err
+	}
+
+	if available.LessThan(xferDetails.Amount) {
+		return nil, fmt.Errorf("insufficient balance in source account: %s, %s", available, xferDetails.Amount)
+	}
+
+	return fiatDebitToOperations(ctx, logger, queryTx, xferDetails)
+}
+
+// fiatDebitToOperations will make the Journal entries for a debit from a row locked Fiat account to the Fiat currency
+// operations account and then debit the account balance.
+func fiatDebitToOperations(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	xferDetails *FiatTransactionDetails) (*FiatAccountTransferResult, error) {
+	var (
+		err        error
+		journalRow fiatExternalWithdrawalJournalEntryRow
+		updateRow  fiatUpdateAccountBalanceRow
+	)
+
+	// Make General Journal ledger entries.
+	if journalRow, err = queryTx.fiatExternalWithdrawalJournalEntry(ctx, &fiatExternalWithdrawalJournalEntryParams{
+		ClientID: xferDetails.ClientID,
+		Currency: xferDetails.Currency,
+		Amount:   xferDetails.Amount,
+	}); err != nil {
+		msg := "failed to post Fiat account Journal entries for withdrawal"
+		logger.Warn(msg, zap.Error(err))
+
+		return nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	// Debit the account balance.
+	if updateRow, err = queryTx.fiatUpdateAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
+		ClientID: xferDetails.ClientID,
+		Currency: xferDetails.Currency,
+		Amount:   xferDetails.Amount.Neg(),
+		LastTxTs: journalRow.TransactedAt,
+	}); err != nil {
+		msg := "failed to debit Fiat account balance for withdrawal"
+		logger.Warn(msg, zap.Error(err))
+
+		return nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	return &FiatAccountTransferResult{
+			TxID:     journalRow.TxID,
+			ClientID: xferDetails.ClientID,
+			TxTS:     journalRow.TransactedAt,
+			Balance:  updateRow.Balance,
+			LastTx:   updateRow.LastTx,
+			Currency: xferDetails.Currency,
+		},
+		nil
+}
+
+// FiatReverseDeposit controls the transaction block that the Fiat deposit reversal transaction executes in.
+func (p *postgresImpl) FiatReverseDeposit(parentCtx context.Context, adminID, clientID, txID uuid.UUID) (
+	*FiatAccountTransferResult, error) {
+	ctx, cancel := context.WithTimeout(parentCtx, constants.ThreeSeconds())
+
+	defer cancel()
+
+	var (
+		err       error
+		tx        pgx.Tx
+		txReceipt *FiatAccountTransferResult
+	)
+
+	// Begin transaction.
+	if tx, err = p.pool.Begin(ctx); err != nil {
+		p.logger.Warn("Fiat deposit reversal transaction block setup failed", zap.Error(err))
+
+		return nil, ErrTransactFiat
+	}
+
+	// Set rollback in case of failure.
+	defer func() {
+		if errRollback := tx.Rollback(parentCtx); errRollback != nil {
+			// If the connection is closed, the transaction was committed. Ignore the error from rollback in this case.
+			if !errors.Is(errRollback, pgx.ErrTxClosed) {
+				p.logger.Error("failed to rollback Fiat deposit reversal", zap.Error(errRollback))
+			}
+		}
+	}()
+
+	// Configure transaction query connection.
+	queryTx := p.queries.WithTx(tx)
+
+	// Handoff to Fiat deposit reversal core logic.
+	if txReceipt, err = fiatReverseDeposit(ctx, p.logger, queryTx, adminID, clientID, txID); err != nil {
+		p.logger.Warn("failed to complete Fiat deposit reversal transaction", zap.Error(err))
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFoundDeposit
+		}
+
+		return nil, ErrReverseFiat
+	}
+
+	// Publish the reversal, along with the deposit it reverses, to the outbox.
+	if err = outboxPublishEvent(ctx, queryTx, clientID, OutboxEventTypeFiatDepositReversal, &struct {
+		*FiatAccountTransferResult
+		ReversedTxID uuid.UUID `json:"reversedTxId"`
+	}{txReceipt, txID}); err != nil {
+		p.logger.Warn("failed to publish Fiat deposit reversal event", zap.Error(err))
+
+		return nil, ErrTransactFiat
+	}
+
+	// Commit transaction.
+	if err = tx.Commit(ctx); err != nil {
+		p.logger.Warn("failed to commit Fiat deposit reversal", zap.Error(err))
+
+		return nil, ErrTransactFiat
+	}
+
+	return txReceipt, nil
+}
+
+// fiatReverseDeposit will execute the logic to complete the Fiat deposit reversal transaction.
+/*
+       Deposits are never deleted or edited. They are reversed by withdrawing the deposited amount from the account
+       back to the Fiat currency operations account.
+
+   [1] Retrieve the client's Journal entry for the deposit. The entry must be a credit from the Fiat currency
+       operations account.
+   [2] Acquire a row lock on the account and check that it has a sufficient balance to cover the reversal. Reversals
+       are administrative and will proceed on frozen or closed accounts and against funds on hold.
+   [3] Make the compensating Journal entries for the internal and external accounts.
+   [4] Debit the balance for the internal account.
+   [5] Record the reversal. A deposit that has already been reversed will fail the transaction.
+*/
+func fiatReverseDeposit(
+	ctx context.Context,
+	logger *logger.Logger,
+	queryTx Querier,
+	adminID, clientID, txID uuid.UUID) (*FiatAccountTransferResult, error) {
+	var (
+		err          error
+		deposit      FiatJournal
+		account      fiatRowLockAccountRow
+		txReceipt    *FiatAccountTransferResult
+		rowsAffected int64
+	)
+
+	// Retrieve the deposit.
+	if deposit, err = queryTx.fiatGetDepositJournalEntry(ctx, &fiatGetDepositJournalEntryParams{
+		ClientID: clientID,
+		TxID:     txID,
+	}); err != nil {
+		msg := "failed to retrieve Fiat deposit Journal entry"
+		logger.Warn(msg, zap.Error(err))
+
+		return nil, fmt.Errorf("%s: %w", msg, err)
+	}
+
+	// Row lock the account.
+	if account, err = queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
+		ClientID: clientID,
+		Currency: deposit.Currency,
+	}); err != nil {
+		msg := "failed to get row lock on Fiat account for reversal"
+		logger.Warn(msg, zap.Error(err))
+
+		return nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	// Check for sufficient funds irrespective of the account status and funds on hold.
+	if account.Balance.LessThan(deposit.Amount) {
+		return nil, fmt.Errorf("insufficient balance in account: %s, %s", account.Balance, deposit.Amount)
+	}
+
+	// Withdraw the deposited amount.
+	if txReceipt, err = fiatDebitToOperations(ctx, logger, queryTx, &FiatTransactionDetails{
+		ClientID: clientID,
+		Currency: deposit.Currency,
+		Amount:   deposit.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	// Record the reversal.
+	if rowsAffected, err = queryTx.fiatReversalCreate(ctx, &fiatReversalCreateParams{
+		TxID:         txID,
+		ClientID:     clientID,
+		Currency:     deposit.Currency,
+		Amount:       deposit.Amount,
+		ReversalTxID: txReceipt.TxID,
+		ReversedBy:   adminID,
+		ReversedAt:   txReceipt.TxTS,
+	}); err != nil {
+		msg := "failed to record Fiat deposit reversal"
+		logger.Warn(msg, zap.Error(err))
+
+		return nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
+	if rowsAffected != int64(1) {
+		return nil, errors.New("deposit has already been reversed")
+	}
+
+	return txReceipt, nil
+}
+
+// fiatAvailableBalance will check that a row locked Fiat account is active and return its balance net of any funds on
+// hold.
+func fiatAvailableBalance(account *fiatRowLockAccountRow) (decimal.Decimal, error) {
+	if account.Status != AccountStatusActive {
+		return decimal.Zero, fmt.Errorf("Fiat account is not active: %s", account.Status)
+	}
+
+	return account.Balance.Sub(account.Held), nil
+}
+
 // fiatTransactionRowLockAndBalanceCheck will acquire row locks on the Fiat accounts in a deterministic lock order.
-// It will then check to see if the balance of the source/debit account is sufficient for the transaction.
+// It will then check to see if both accounts are active and the available balance of the source/debit account is
+// sufficient for the transaction.
 func fiatTransactionRowLockAndBalanceCheck(
 	ctx context.Context,
 	queryTx Querier,
@@ -189,7 +535,7 @@ func fiatTransactionRowLockAndBalanceCheck(
 	lockFirst, lockSecond := src.Less(dst)
 
 	// Row lock the accounts in order.
-	balanceFirst, err := queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
+	accountFirst, err := queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
 		ClientID: (*lockFirst).ClientID,
 		Currency: (*lockFirst).Currency,
 	})
@@ -197,7 +543,7 @@ func fiatTransactionRowLockAndBalanceCheck(
 		return fmt.Errorf("failed to get row lock on first Fiat account %w", err)
 	}
 
-	balanceSecond, err := queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
+	accountSecond, err := queryTx.fiatRowLockAccount(ctx, &fiatRowLockAccountParams{
 		ClientID: (*lockSecond).ClientID,
 		Currency: (*lockSecond).Currency,
 	})
@@ -205,10 +551,20 @@ func fiatTransactionRowLockAndBalanceCheck(
 		return fmt.Errorf("failed to get row lock on second Fiat account %w", err)
 	}
 
-	// Check which lock operation returned the source/debit balance.
-	debitBalance := &balanceFirst
+	// Check which lock operation returned the source/debit and destination/credit accounts.
+	debitAccount, creditAccount := &accountFirst, &accountSecond
 	if *lockSecond == src {
-		debitBalance = &balanceSecond
+		debitAccount, creditAccount = &accountSecond, &accountFirst
+	}
+
+	// Check both accounts are active.
+	debitBalance, err := fiatAvailableBalance(debitAccount)
+	if err != nil {
+		return fmt.Errorf("source account: %w", err)
+	}
+
+	if _, err = fiatAvailableBalance(creditAccount); err != nil {
+		return fmt.Errorf("destination account: %w", err)
 	}
 
 	// Check for sufficient funds.
@@ -219,11 +575,13 @@ func fiatTransactionRowLockAndBalanceCheck(
 	return nil
 }
 
-// FiatInternalTransfer controls the transaction block that the internal Fiat transfer transaction executes in.
+// FiatInternalTransfer controls the transaction block that the internal Fiat transfer transaction executes in. The
+// trade will be recorded in the same transaction block if its details are supplied.
 func (p *postgresImpl) FiatInternalTransfer(
 	parentCtx context.Context,
 	src,
-	dst *FiatTransactionDetails) (*FiatAccountTransferResult, *FiatAccountTransferResult, error) {
+	dst *FiatTransactionDetails,
+	trade *TradeDetails) (*FiatAccountTransferResult, *FiatAccountTransferResult, error) {
 	ctx, cancel := context.WithTimeout(parentCtx, constants.ThreeSeconds())
 
 	defer cancel()
@@ -257,13 +615,21 @@ func (p *postgresImpl) FiatInternalTransfer(
 	queryTx := p.queries.WithTx(tx)
 
 	// Handoff to internal fiat transaction core logic.
-	if srcTxReceipt, dstTxReceipt, err = fiatInternalTransfer(ctx, p.logger, queryTx, src, dst); err != nil {
+	if srcTxReceipt, dstTxReceipt, err = fiatInternalTransfer(ctx, p.logger, queryTx, src, dst, trade); err != nil {
 		msg := "failed to complete internal Fiat transfer transaction"
 		p.logger.Warn(msg, zap.Error(err))
 
 		return nil, nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
 	}
 
+	// Publish the transfer to the outbox.
+	if err = outboxPublishInternalTransfer(ctx, queryTx, srcTxReceipt, dstTxReceipt); err != nil {
+		msg := "failed to publish internal Fiat transfer events"
+		p.logger.Warn(msg, zap.Error(err))
+
+		return nil, nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+	}
+
 	// Commit transaction.
 	if err = tx.Commit(ctx); err != nil {
 		msg := "failed to commit internal Fiat account transfer"
@@ -286,14 +652,18 @@ func (p *postgresImpl) FiatInternalTransfer(
     [1] Acquire a row lock on the accounts without holding a lock on the foreign key for the Client ID.
         Their accounts will be compared against each other using a total order rule.
     [2] Make the Journal entries for both of the accounts.
-    [3] Update the balance for the source and destination accounts.
+    [3] Make the Journal entry for the fee revenue operations account if a fee is being collected from the source.
+    [4] Record the exchange rate and price quote offer of the trade if the trade details are supplied, and fill the
+        limit order that the trade settles if there is one.
+    [5] Update the balance for the source and destination accounts.
 */
 func fiatInternalTransfer(
 	ctx context.Context,
 	logger *logger.Logger,
 	queryTx Querier,
 	src,
-	dst *FiatTransactionDetails) (*FiatAccountTransferResult, *FiatAccountTransferResult, error) {
+	dst *FiatTransactionDetails,
+	trade *TradeDetails) (*FiatAccountTransferResult, *FiatAccountTransferResult, error) {
 	var (
 		err           error
 		journalRow    fiatInternalTransferJournalEntryRow
@@ -301,6 +671,11 @@ func fiatInternalTransfer(
 		postDebitRow  fiatUpdateAccountBalanceRow
 	)
 
+	// The fee is collected from the source amount.
+	if src.Fee.IsNegative() || src.Fee.GreaterThan(src.Amount) {
+		return nil, nil, fmt.Errorf("invalid fee amount: %s", src.Fee)
+	}
+
 	// Row lock the accounts in order and check balances.
 	if err = fiatTransactionRowLockAndBalanceCheck(ctx, queryTx, src, dst); err != nil {
 		msg := "failed to get row lock on Fiat accounts and verify balance of debit account"
@@ -324,6 +699,52 @@ func fiatInternalTransfer(
 		return nil, nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
 	}
 
+	// Make the fee revenue General Journal ledger entry.
+	if src.Fee.IsPositive() {
+		if err = queryTx.fiatFeeJournalEntry(ctx, &fiatFeeJournalEntryParams{
+			Currency:     src.Currency,
+			TransactedAt: journalRow.TransactedAt,
+			TxID:         journalRow.TxID,
+			Amount:       src.Fee,
+		}); err != nil {
+			msg := "failed to post fee revenue Fiat account Journal entry for internal transfer"
+			logger.Warn(msg, zap.Error(err))
+
+			return nil, nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+		}
+	}
+
+	// Record the exchange rate and price quote offer that priced the trade.
+	if trade != nil {
+		if err = queryTx.tradeCreate(ctx, &tradeCreateParams{
+			TxID:        journalRow.TxID,
+			ClientID:    src.ClientID,
+			OfferID:     pgtype.Text{String: trade.OfferID, Valid: len(trade.OfferID) > 0},
+			Source:      string(src.Currency),
+			Destination: string(dst.Currency),
+			Rate:        trade.Rate,
+			TradedAt:    journalRow.TransactedAt,
+		}); err != nil {
+			msg := "failed to record trade for internal transfer"
+			logger.Warn(msg, zap.Error(err))
+
+			return nil, nil, fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
+		}
+
+		if !trade.OrderID.IsNil() {
+			var rowsAffected int64
+			if rowsAffected, err = queryTx.orderFill(ctx, &orderFillParams{
+				OrderID: trade.OrderID,
+				TxID:    uuid.NullUUID{UUID: journalRow.TxID, Valid: true},
+			}); err != nil || rowsAffected != int64(1) {
+				msg := "failed to fill limit order for internal transfer"
+				logger.Warn(msg, zap.String("orderID", trade.OrderID.String()), zap.Error(err))
+
+				return nil, nil, fmt.Errorf("%s: limit order %s is not executing", msg, trade.OrderID)
+			}
+		}
+	}
+
 	// Update the destination and then source account balances.
 	if postCreditRow, err = queryTx.fiatUpdateAccountBalance(ctx, &fiatUpdateAccountBalanceParams{
 		ClientID: dst.ClientID,
@@ -367,3 +788,51 @@ func fiatInternalTransfer(
 		},
 		nil
 }
+
+// outboxPublishEvent will serialize an event payload and publish it to the outbox within the transaction block that
+// produced it. The event will be queued for delivery to each of the client's webhooks.
+func outboxPublishEvent(
+	ctx context.Context,
+	queryTx Querier,
+	clientID uuid.UUID,
+	eventType OutboxEventType,
+	payload any) error {
+	var (
+		err  error
+		data []byte
+	)
+
+	if data, err = json.Marshal(payload); err != nil {
+		return fmt.Errorf(constants.ErrorFormatMessage(), "failed to serialize outbox event payload", err)
+	}
+
+	if err = queryTx.outboxPublish(ctx, &outboxPublishParams{
+		ClientID:  clientID,
+		EventType: eventType,
+		Payload:   data,
+	}); err != nil {
+		return fmt.Errorf(constants.ErrorFormatMessage(), "failed to publish outbox event", err)
+	}
+
+	return nil
+}
+
+// outboxPublishInternalTransfer will publish the events for an internal Fiat transfer. A transfer between two accounts
+// belonging to the same client is published as a single currency exchange event. Transfers between clients are
+// published as separate sent and received events so that neither client receives the other's account details.
+func outboxPublishInternalTransfer(
+	ctx context.Context,
+	queryTx Querier,
+	src,
+	dst *FiatAccountTransferResult) error {
+	if src.ClientID == dst.ClientID {
+		return outboxPublishEvent(ctx, queryTx, src.ClientID, OutboxEventTypeFiatExchange,
+			map[string]*FiatAccountTransferResult{"source": src, "destination": dst})
+	}
+
+	if err := outboxPublishEvent(ctx, queryTx, src.ClientID, OutboxEventTypeFiatTransferSent, src); err != nil {
+		return err
+	}
+
+	return outboxPublishEvent(ctx, queryTx, dst.ClientID, OutboxEventTypeFiatTransferReceived, dst)
+}
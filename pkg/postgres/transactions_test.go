@@ -9,6 +9,7 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
@@ -261,7 +262,7 @@ func TestTransactions_FiatExternalTransfer_Mock(t *testing.T) {
 	t.Parallel()
 
 	txDetails := FiatTransactionDetails{}
-	rowLockDecimal := decimal.Decimal{}
+	rowLockAccount := fiatRowLockAccountRow{Status: AccountStatusActive}
 	journalEntryRow := fiatExternalTransferJournalEntryRow{}
 	accountBalanceRow := fiatUpdateAccountBalanceRow{}
 
@@ -269,7 +270,7 @@ func TestTransactions_FiatExternalTransfer_Mock(t *testing.T) {
 	testCases := []struct {
 		name                string
 		expectedErrMsg      string
-		rowLockReturn       *decimal.Decimal
+		rowLockReturn       *fiatRowLockAccountRow
 		rowLockError        error
 		rowLockTimes        int
 		extJournalReturn    *fiatExternalTransferJournalEntryRow
@@ -283,7 +284,7 @@ func TestTransactions_FiatExternalTransfer_Mock(t *testing.T) {
 			name:                "Row lock failure.",
 			expectedErrMsg:      "row lock failure",
 			rowLockTimes:        1,
-			rowLockReturn:       &rowLockDecimal,
+			rowLockReturn:       &rowLockAccount,
 			rowLockError:        errors.New("row lock failure"),
 			extJournalTimes:     0,
 			extJournalReturn:    &journalEntryRow,
@@ -295,7 +296,7 @@ func TestTransactions_FiatExternalTransfer_Mock(t *testing.T) {
 			name:                "Journal entry failure.",
 			expectedErrMsg:      "journal entry failure",
 			rowLockTimes:        1,
-			rowLockReturn:       &rowLockDecimal,
+			rowLockReturn:       &rowLockAccount,
 			rowLockError:        nil,
 			extJournalTimes:     1,
 			extJournalReturn:    &journalEntryRow,
@@ -307,7 +308,7 @@ func TestTransactions_FiatExternalTransfer_Mock(t *testing.T) {
 			name:                "Account balance update failure.",
 			expectedErrMsg:      "account balance update failure",
 			rowLockTimes:        1,
-			rowLockReturn:       &rowLockDecimal,
+			rowLockReturn:       &rowLockAccount,
 			rowLockError:        nil,
 			extJournalTimes:     1,
 			extJournalReturn:    &journalEntryRow,
@@ -354,6 +355,511 @@ func TestTransactions_FiatExternalTransfer_Mock(t *testing.T) {
 	}
 }
 
+func TestTransactions_FiatExternalWithdrawal(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	insertTestUsers(t)
+
+	// Insert an initial set of test Fiat accounts.
+	clientID1, clientID2 := resetTestFiatAccounts(t)
+
+	// Reset the Fiat journal entries.
+	resetTestFiatJournal(t, clientID1, clientID2)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 3*time.Second)
+
+	defer cancel()
+
+	ftexID, err := connection.queries.userGetClientId(ctx, constants.SpecialAccountFiat())
+	require.NoError(t, err, "failed to retrieve FTeX internal ID.")
+
+	// Fund the account for withdrawals.
+	_, err = connection.FiatExternalTransfer(ctx, &FiatTransactionDetails{
+		ClientID: clientID1,
+		Currency: CurrencyUSD,
+		Amount:   decimal.NewFromFloat(1000),
+	})
+	require.NoError(t, err, "failed to fund account for withdrawals.")
+
+	// End of test-expected totals.
+	expectedTotal := decimal.NewFromFloat(665.44)
+
+	// Test grid.
+	testCases := []struct {
+		name                 string
+		accountDetails       *FiatTransactionDetails
+		errExpectation       require.ErrorAssertionFunc
+		nilResultExpectation require.ValueAssertionFunc
+	}{
+		{
+			name: "334.56",
+			accountDetails: &FiatTransactionDetails{
+				ClientID: clientID1,
+				Currency: CurrencyUSD,
+				Amount:   decimal.NewFromFloat(334.56),
+			},
+			errExpectation:       require.NoError,
+			nilResultExpectation: require.NotNil,
+		}, {
+			name: "Insufficient funds",
+			accountDetails: &FiatTransactionDetails{
+				ClientID: clientID1,
+				Currency: CurrencyUSD,
+				Amount:   decimal.NewFromFloat(999999),
+			},
+			errExpectation:       require.Error,
+			nilResultExpectation: require.Nil,
+		}, {
+			name: "Negative amount",
+			accountDetails: &FiatTransactionDetails{
+				ClientID: clientID1,
+				Currency: CurrencyUSD,
+				Amount:   decimal.NewFromFloat(-10),
+			},
+			errExpectation:       require.Error,
+			nilResultExpectation: require.Nil,
+		}, {
+			name: "Invalid Account",
+			accountDetails: &FiatTransactionDetails{
+				ClientID: clientID1,
+				Currency: CurrencyGBP,
+				Amount:   decimal.NewFromFloat(12.34),
+			},
+			errExpectation:       require.Error,
+			nilResultExpectation: require.Nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run("Withdrawing "+test.name, func(t *testing.T) {
+			transferResult, err := connection.FiatExternalWithdrawal(ctx, test.accountDetails)
+			test.errExpectation(t, err, "error expectation failed.")
+			test.nilResultExpectation(t, transferResult, "nil transferResult expectation failed.")
+
+			if transferResult == nil {
+				return
+			}
+
+			require.False(t, transferResult.TxID.IsNil(), "invalid transaction ID returned.")
+			require.True(t, transferResult.LastTx.IsNegative(), "withdrawal was not a debit.")
+
+			// Check for journal entries.
+			journalEntry, err := connection.Query.fiatGetJournalTransaction(ctx, &fiatGetJournalTransactionParams{
+				ClientID: test.accountDetails.ClientID,
+				TxID:     transferResult.TxID,
+			})
+			require.NoError(t, err, "failed to retrieve journal entries for withdrawal.")
+			require.Len(t, journalEntry, 1, "incorrect journal entry for withdrawal.")
+
+			journalEntry, err = connection.Query.fiatGetJournalTransaction(ctx, &fiatGetJournalTransactionParams{
+				ClientID: ftexID,
+				TxID:     transferResult.TxID,
+			})
+			require.NoError(t, err, "failed to retrieve internal journal entries for withdrawal.")
+			require.Len(t, journalEntry, 1, "incorrect internal journal entry for withdrawal.")
+		})
+	}
+
+	t.Run("Checking end totals", func(t *testing.T) {
+		actual, err := connection.Query.fiatGetAccount(ctx, &fiatGetAccountParams{
+			ClientID: clientID1,
+			Currency: CurrencyUSD,
+		})
+		require.NoError(t, err, "failed to fiat account.")
+		require.True(t, expectedTotal.Equal(actual.Balance), "end of test expected totals mismatched.")
+	})
+}
+
+func TestTransactions_FiatExternalWithdrawal_Mock(t *testing.T) {
+	t.Parallel()
+
+	txDetails := FiatTransactionDetails{Amount: decimal.NewFromFloat(10)}
+	journalEntryRow := fiatExternalWithdrawalJournalEntryRow{}
+	accountBalanceRow := fiatUpdateAccountBalanceRow{}
+
+	// Test grid.
+	testCases := []struct {
+		name               string
+		expectedErrMsg     string
+		rowLockReturn      fiatRowLockAccountRow
+		rowLockError       error
+		rowLockTimes       int
+		extJournalError    error
+		extJournalTimes    int
+		updateBalanceError error
+		updateBalanceTimes int
+	}{
+		{
+			name:               "Row lock failure.",
+			expectedErrMsg:     "row lock failure",
+			rowLockTimes:       1,
+			rowLockReturn:      fiatRowLockAccountRow{Balance: decimal.NewFromFloat(100), Status: AccountStatusActive},
+			rowLockError:       errors.New("row lock failure"),
+			extJournalTimes:    0,
+			updateBalanceTimes: 0,
+		}, {
+			name:               "Insufficient balance.",
+			expectedErrMsg:     "insufficient balance",
+			rowLockTimes:       1,
+			rowLockReturn:      fiatRowLockAccountRow{Balance: decimal.NewFromFloat(1), Status: AccountStatusActive},
+			extJournalTimes:    0,
+			updateBalanceTimes: 0,
+		}, {
+			name:           "Funds on hold.",
+			expectedErrMsg: "insufficient balance",
+			rowLockTimes:   1,
+			rowLockReturn: fiatRowLockAccountRow{
+				Balance: decimal.NewFromFloat(100),
+				Held:    decimal.NewFromFloat(95),
+				Status:  AccountStatusActive,
+			},
+			extJournalTimes:    0,
+			updateBalanceTimes: 0,
+		}, {
+			name:               "Frozen account.",
+			expectedErrMsg:     "not active",
+			rowLockTimes:       1,
+			rowLockReturn:      fiatRowLockAccountRow{Balance: decimal.NewFromFloat(100), Status: AccountStatusFrozen},
+			extJournalTimes:    0,
+			updateBalanceTimes: 0,
+		}, {
+			name:               "Journal entry failure.",
+			expectedErrMsg:     "journal entry failure",
+			rowLockTimes:       1,
+			rowLockReturn:      fiatRowLockAccountRow{Balance: decimal.NewFromFloat(100), Status: AccountStatusActive},
+			extJournalTimes:    1,
+			extJournalError:    errors.New("journal entry failure"),
+			updateBalanceTimes: 0,
+		}, {
+			name:               "Account balance update failure.",
+			expectedErrMsg:     "account balance update failure",
+			rowLockTimes:       1,
+			rowLockReturn:      fiatRowLockAccountRow{Balance: decimal.NewFromFloat(100), Status: AccountStatusActive},
+			extJournalTimes:    1,
+			updateBalanceTimes: 1,
+			updateBalanceError: errors.New("account balance update failure"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run("Failure: "+test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockQuerier := NewMockQuerier(mockCtrl)
+
+			// Configure mock expectations.
+			gomock.InOrder(
+				mockQuerier.EXPECT().
+					fiatRowLockAccount(gomock.Any(), gomock.Any()).
+					Return(test.rowLockReturn, test.rowLockError).
+					Times(test.rowLockTimes),
+
+				mockQuerier.EXPECT().
+					fiatExternalWithdrawalJournalEntry(gomock.Any(), gomock.Any()).
+					Return(journalEntryRow, test.extJournalError).
+					Times(test.extJournalTimes),
+
+				mockQuerier.EXPECT().
+					fiatUpdateAccountBalance(gomock.Any(), gomock.Any()).
+					Return(accountBalanceRow, test.updateBalanceError).
+					Times(test.updateBalanceTimes),
+			)
+
+			// Check for error.
+			_, err := fiatExternalWithdrawal(context.TODO(), zapLogger, mockQuerier, &txDetails)
+			require.Error(t, err, "failed to get error.")
+			require.Contains(t, err.Error(), test.expectedErrMsg, "error messages mismatched.")
+		})
+	}
+}
+
+func TestTransactions_FiatReverseDeposit(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	// Insert test users.
+	insertTestUsers(t)
+
+	// Insert an initial set of test Fiat accounts.
+	clientID1, clientID2 := resetTestFiatAccounts(t)
+
+	// Reset the Fiat journal entries and reversals.
+	resetTestFiatJournal(t, clientID1, clientID2)
+	resetTestFiatReversals(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 3*time.Second)
+
+	defer cancel()
+
+	// Deposits to reverse.
+	deposit, err := connection.FiatExternalTransfer(ctx, &FiatTransactionDetails{
+		ClientID: clientID1,
+		Currency: CurrencyUSD,
+		Amount:   decimal.NewFromFloat(1000),
+	})
+	require.NoError(t, err, "failed to make deposit for reversal.")
+
+	spentDeposit, err := connection.FiatExternalTransfer(ctx, &FiatTransactionDetails{
+		ClientID: clientID2,
+		Currency: CurrencyUSD,
+		Amount:   decimal.NewFromFloat(500),
+	})
+	require.NoError(t, err, "failed to make deposit to spend.")
+
+	withdrawal, err := connection.FiatExternalWithdrawal(ctx, &FiatTransactionDetails{
+		ClientID: clientID2,
+		Currency: CurrencyUSD,
+		Amount:   decimal.NewFromFloat(250),
+	})
+	require.NoError(t, err, "failed to withdraw deposit.")
+
+	heldDeposit, err := connection.FiatExternalTransfer(ctx, &FiatTransactionDetails{
+		ClientID: clientID1,
+		Currency: CurrencyCAD,
+		Amount:   decimal.NewFromFloat(300),
+	})
+	require.NoError(t, err, "failed to make deposit to hold.")
+
+	_, err = connection.FiatUpdateHold(clientID1, CurrencyCAD, decimal.NewFromFloat(300))
+	require.NoError(t, err, "failed to hold deposit.")
+
+	_, err = connection.FiatSetStatus(clientID1, CurrencyCAD, AccountStatusFrozen)
+	require.NoError(t, err, "failed to freeze account.")
+
+	// The Fiat proceeds of a Crypto sale are credited from the Fiat currency operations account like a deposit.
+	resetTestCryptoAccounts(t, clientID1, clientID2)
+	resetTestCryptoJournal(t)
+
+	_, _, err = connection.CryptoPurchase(
+		clientID2, CurrencyUSD, decimal.NewFromFloat(100), "BTC", decimal.NewFromFloat(0.5), decimal.Zero,
+		&TradeDetails{Rate: decimal.NewFromFloat(0.005)})
+	require.NoError(t, err, "failed to purchase Crypto to sell.")
+
+	sale, _, err := connection.CryptoSell(
+		clientID2, CurrencyUSD, decimal.NewFromFloat(100), "BTC", decimal.NewFromFloat(0.5), decimal.Zero,
+		&TradeDetails{Rate: decimal.NewFromFloat(200)})
+	require.NoError(t, err, "failed to sell Crypto.")
+
+	// Test grid.
+	testCases := []struct {
+		name           string
+		clientID       uuid.UUID
+		txID           uuid.UUID
+		expectedErr    error
+		expectedAmount decimal.Decimal
+	}{
+		{
+			name:           "deposit",
+			clientID:       clientID1,
+			txID:           deposit.TxID,
+			expectedErr:    nil,
+			expectedAmount: decimal.NewFromFloat(-1000),
+		}, {
+			name:           "held deposit in frozen account",
+			clientID:       clientID1,
+			txID:           heldDeposit.TxID,
+			expectedErr:    nil,
+			expectedAmount: decimal.NewFromFloat(-300),
+		}, {
+			name:        "reversed deposit",
+			clientID:    clientID1,
+			txID:        deposit.TxID,
+			expectedErr: ErrReverseFiat,
+		}, {
+			name:        "other client's deposit",
+			clientID:    clientID2,
+			txID:        deposit.TxID,
+			expectedErr: ErrNotFoundDeposit,
+		}, {
+			name:        "insufficient funds",
+			clientID:    clientID2,
+			txID:        spentDeposit.TxID,
+			expectedErr: ErrReverseFiat,
+		}, {
+			name:        "withdrawal",
+			clientID:    clientID2,
+			txID:        withdrawal.TxID,
+			expectedErr: ErrNotFoundDeposit,
+		}, {
+			name:        "crypto sale",
+			clientID:    clientID2,
+			txID:        sale.TxID,
+			expectedErr: ErrNotFoundDeposit,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run("Reversing "+test.name, func(t *testing.T) {
+			result, err := connection.FiatReverseDeposit(ctx, clientID2, test.clientID, test.txID)
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr, "error expectation failed.")
+				require.Nil(t, result, "result returned on failure.")
+
+				return
+			}
+
+			require.NoError(t, err, "failed to reverse deposit.")
+			require.False(t, result.TxID.IsNil(), "invalid transaction ID returned.")
+			require.True(t, test.expectedAmount.Equal(result.LastTx), "reversal amount mismatched.")
+			require.True(t, result.Balance.IsZero(), "reversed balance mismatched.")
+		})
+	}
+}
+
+func TestTransactions_FiatReverseDeposit_Mock(t *testing.T) {
+	t.Parallel()
+
+	deposit := FiatJournal{Currency: CurrencyUSD, Amount: decimal.NewFromFloat(10)}
+
+	// Test grid.
+	testCases := []struct {
+		name                 string
+		expectedErrMsg       string
+		depositError         error
+		rowLockReturn        fiatRowLockAccountRow
+		rowLockError         error
+		rowLockTimes         int
+		extJournalTimes      int
+		updateBalanceTimes   int
+		reversalRows         int64
+		reversalError        error
+		reversalTimes        int
+		errExpectation       require.ErrorAssertionFunc
+		resultNilExpectation require.ValueAssertionFunc
+	}{
+		{
+			name:                 "deposit not found",
+			expectedErrMsg:       pgx.ErrNoRows.Error(),
+			depositError:         pgx.ErrNoRows,
+			errExpectation:       require.Error,
+			resultNilExpectation: require.Nil,
+		}, {
+			name:                 "insufficient balance",
+			expectedErrMsg:       "insufficient balance",
+			rowLockReturn:        fiatRowLockAccountRow{Balance: decimal.NewFromFloat(1), Status: AccountStatusActive},
+			rowLockTimes:         1,
+			errExpectation:       require.Error,
+			resultNilExpectation: require.Nil,
+		}, {
+			name:                 "row lock failure",
+			expectedErrMsg:       "row lock",
+			rowLockError:         errors.New("row lock failure"),
+			rowLockTimes:         1,
+			errExpectation:       require.Error,
+			resultNilExpectation: require.Nil,
+		}, {
+			name: "frozen account with funds on hold",
+			rowLockReturn: fiatRowLockAccountRow{
+				Balance: decimal.NewFromFloat(10),
+				Held:    decimal.NewFromFloat(10),
+				Status:  AccountStatusFrozen,
+			},
+			rowLockTimes:         1,
+			extJournalTimes:      1,
+			updateBalanceTimes:   1,
+			reversalRows:         1,
+			reversalTimes:        1,
+			errExpectation:       require.NoError,
+			resultNilExpectation: require.NotNil,
+		}, {
+			name:                 "reversal failure",
+			expectedErrMsg:       "reversal failure",
+			rowLockReturn:        fiatRowLockAccountRow{Balance: decimal.NewFromFloat(100), Status: AccountStatusActive},
+			rowLockTimes:         1,
+			extJournalTimes:      1,
+			updateBalanceTimes:   1,
+			reversalError:        errors.New("reversal failure"),
+			reversalTimes:        1,
+			errExpectation:       require.Error,
+			resultNilExpectation: require.Nil,
+		}, {
+			name:                 "already reversed",
+			expectedErrMsg:       "already been reversed",
+			rowLockReturn:        fiatRowLockAccountRow{Balance: decimal.NewFromFloat(100), Status: AccountStatusActive},
+			rowLockTimes:         1,
+			extJournalTimes:      1,
+			updateBalanceTimes:   1,
+			reversalRows:         0,
+			reversalTimes:        1,
+			errExpectation:       require.Error,
+			resultNilExpectation: require.Nil,
+		}, {
+			name:                 "valid",
+			rowLockReturn:        fiatRowLockAccountRow{Balance: decimal.NewFromFloat(100), Status: AccountStatusActive},
+			rowLockTimes:         1,
+			extJournalTimes:      1,
+			updateBalanceTimes:   1,
+			reversalRows:         1,
+			reversalTimes:        1,
+			errExpectation:       require.NoError,
+			resultNilExpectation: require.NotNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockQuerier := NewMockQuerier(mockCtrl)
+
+			// Configure mock expectations.
+			gomock.InOrder(
+				mockQuerier.EXPECT().
+					fiatGetDepositJournalEntry(gomock.Any(), gomock.Any()).
+					Return(deposit, test.depositError).
+					Times(1),
+
+				mockQuerier.EXPECT().
+					fiatRowLockAccount(gomock.Any(), gomock.Any()).
+					Return(test.rowLockReturn, test.rowLockError).
+					Times(test.rowLockTimes),
+
+				mockQuerier.EXPECT().
+					fiatExternalWithdrawalJournalEntry(gomock.Any(), gomock.Any()).
+					Return(fiatExternalWithdrawalJournalEntryRow{}, nil).
+					Times(test.extJournalTimes),
+
+				mockQuerier.EXPECT().
+					fiatUpdateAccountBalance(gomock.Any(), gomock.Any()).
+					Return(fiatUpdateAccountBalanceRow{}, nil).
+					Times(test.updateBalanceTimes),
+
+				mockQuerier.EXPECT().
+					fiatReversalCreate(gomock.Any(), gomock.Any()).
+					Return(test.reversalRows, test.reversalError).
+					Times(test.reversalTimes),
+			)
+
+			result, err := fiatReverseDeposit(
+				context.TODO(), zapLogger, mockQuerier, uuid.UUID{}, uuid.UUID{}, uuid.UUID{})
+			test.errExpectation(t, err, "error expectation failed.")
+			test.resultNilExpectation(t, result, "result expectation failed.")
+
+			if err != nil {
+				require.Contains(t, err.Error(), test.expectedErrMsg, "error messages mismatched.")
+			}
+		})
+	}
+}
+
 func TestTransactions_FiatTransactionRowLockAndBalanceCheck(t *testing.T) {
 	t.Parallel()
 
@@ -545,10 +1051,10 @@ func TestTransactions_FiatTransactionRowLockAndBalanceCheck_mock(t *testing.T) {
 		expectedErrMsg       string
 		srcAccount           *FiatTransactionDetails
 		dstAccount           *FiatTransactionDetails
-		firstRowLockBalance  decimal.Decimal
+		firstRowLockAccount  fiatRowLockAccountRow
 		firstRowLockErr      error
 		firstRowLockTimes    int
-		secondRowLockBalance decimal.Decimal
+		secondRowLockAccount fiatRowLockAccountRow
 		secondRowLockErr     error
 		secondRowLockTimes   int
 	}{
@@ -557,10 +1063,10 @@ func TestTransactions_FiatTransactionRowLockAndBalanceCheck_mock(t *testing.T) {
 			expectedErrMsg:       "first row lock failure",
 			srcAccount:           uuid1USD,
 			dstAccount:           uuid2USD,
-			firstRowLockBalance:  decimal.Decimal{},
+			firstRowLockAccount:  fiatRowLockAccountRow{Status: AccountStatusActive},
 			firstRowLockErr:      errors.New("first row lock failure"),
 			firstRowLockTimes:    1,
-			secondRowLockBalance: decimal.Decimal{},
+			secondRowLockAccount: fiatRowLockAccountRow{Status: AccountStatusActive},
 			secondRowLockErr:     nil,
 			secondRowLockTimes:   0,
 		}, {
@@ -568,10 +1074,10 @@ func TestTransactions_FiatTransactionRowLockAndBalanceCheck_mock(t *testing.T) {
 			expectedErrMsg:       "second row lock failure",
 			srcAccount:           uuid1USD,
 			dstAccount:           uuid2USD,
-			firstRowLockBalance:  decimal.Decimal{},
+			firstRowLockAccount:  fiatRowLockAccountRow{Status: AccountStatusActive},
 			firstRowLockErr:      nil,
 			firstRowLockTimes:    1,
-			secondRowLockBalance: decimal.Decimal{},
+			secondRowLockAccount: fiatRowLockAccountRow{Status: AccountStatusActive},
 			secondRowLockErr:     errors.New("second row lock failure"),
 			secondRowLockTimes:   1,
 		}, {
@@ -583,10 +1089,21 @@ func TestTransactions_FiatTransactionRowLockAndBalanceCheck_mock(t *testing.T) {
 				Amount:   decimal.NewFromFloat(101.1),
 			},
 			dstAccount:           uuid2USD,
-			firstRowLockBalance:  decimal.Decimal{},
+			firstRowLockAccount:  fiatRowLockAccountRow{Status: AccountStatusActive},
 			firstRowLockErr:      nil,
 			firstRowLockTimes:    1,
-			secondRowLockBalance: decimal.NewFromFloat(100.0),
+			secondRowLockAccount: fiatRowLockAccountRow{Balance: decimal.NewFromFloat(100.0), Status: AccountStatusActive},
+			secondRowLockErr:     nil,
+			secondRowLockTimes:   1,
+		}, {
+			name:                 "Frozen account failure.",
+			expectedErrMsg:       "not active",
+			srcAccount:           uuid1USD,
+			dstAccount:           uuid2USD,
+			firstRowLockAccount:  fiatRowLockAccountRow{Balance: decimal.NewFromFloat(100.0), Status: AccountStatusActive},
+			firstRowLockErr:      nil,
+			firstRowLockTimes:    1,
+			secondRowLockAccount: fiatRowLockAccountRow{Status: AccountStatusFrozen},
 			secondRowLockErr:     nil,
 			secondRowLockTimes:   1,
 		},
@@ -606,12 +1123,12 @@ func TestTransactions_FiatTransactionRowLockAndBalanceCheck_mock(t *testing.T) {
 			gomock.InOrder(
 				mockQuerier.EXPECT().
 					fiatRowLockAccount(gomock.Any(), gomock.Any()).
-					Return(test.firstRowLockBalance, test.firstRowLockErr).
+					Return(test.firstRowLockAccount, test.firstRowLockErr).
 					Times(test.firstRowLockTimes),
 
 				mockQuerier.EXPECT().
 					fiatRowLockAccount(gomock.Any(), gomock.Any()).
-					Return(test.secondRowLockBalance, test.secondRowLockErr).
+					Return(test.secondRowLockAccount, test.secondRowLockErr).
 					Times(test.secondRowLockTimes),
 			)
 
@@ -823,6 +1340,8 @@ func TestTransactions_FiatInternalTransfer(t *testing.T) { //nolint:maintidx
 		},
 	}
 
+	trade := &TradeDetails{OfferID: "offer-id", Rate: decimal.NewFromFloat(1.3456)}
+
 	// Configure wait groups for parallel run of all threads.
 	wg := sync.WaitGroup{}
 	wg.Add(len(testCases))
@@ -835,7 +1354,7 @@ func TestTransactions_FiatInternalTransfer(t *testing.T) { //nolint:maintidx
 			t.Run(test.name, func(t *testing.T) {
 				defer wg.Done()
 
-				srcResult, dstResult, err := connection.FiatInternalTransfer(ctx, &test.source, &test.destination)
+				srcResult, dstResult, err := connection.FiatInternalTransfer(ctx, &test.source, &test.destination, trade)
 				test.errExpectation(t, err, "failed error expectation")
 
 				if err != nil {
@@ -868,6 +1387,17 @@ func TestTransactions_FiatInternalTransfer(t *testing.T) { //nolint:maintidx
 				})
 				require.NoError(t, err, "failed to retrieve journal entries for transaction.")
 				require.Len(t, journalEntry, 1, "incorrect row count retrieved for destination.")
+
+				// Check for the trade.
+				trades, err := connection.Query.tradeGetTransaction(ctx, &tradeGetTransactionParams{
+					ClientID: test.source.ClientID,
+					TxID:     srcResult.TxID,
+				})
+				require.NoError(t, err, "failed to retrieve trade for transaction.")
+				require.Len(t, trades, 1, "incorrect row count retrieved for trade.")
+				require.Equal(t, string(test.source.Currency), trades[0].Source, "trade source mismatch.")
+				require.Equal(t, string(test.destination.Currency), trades[0].Destination, "trade destination mismatch.")
+				require.True(t, trade.Rate.Equal(trades[0].Rate), "trade rate mismatch.")
 			})
 		}()
 	}
@@ -896,27 +1426,49 @@ func TestTransactions_FiatInternalTransfer_Mock(t *testing.T) {
 	t.Parallel()
 
 	txDetails := FiatTransactionDetails{}
-	rowLockDecimal := decimal.Decimal{}
+	feeTxDetails := FiatTransactionDetails{Amount: decimal.NewFromFloat(10), Fee: decimal.NewFromFloat(0.5)}
+	rowLockAccount := fiatRowLockAccountRow{Balance: decimal.NewFromFloat(100), Status: AccountStatusActive}
 	journalEntryRow := fiatInternalTransferJournalEntryRow{}
 	balanceUpdateRow := fiatUpdateAccountBalanceRow{}
+	trade := &TradeDetails{Rate: decimal.NewFromFloat(1)}
 
 	testCases := []struct {
 		name           string
 		expectedErrMsg string
+		src            *FiatTransactionDetails
 		rowLockError   error
 		journalReturn  *fiatInternalTransferJournalEntryRow
 		journalError   error
 		journalTimes   int
+		feeError       error
+		feeTimes       int
 		creditReturn   *fiatUpdateAccountBalanceRow
 		creditError    error
 		creditTimes    int
+		tradeError     error
+		tradeTimes     int
 		debitReturn    *fiatUpdateAccountBalanceRow
 		debitError     error
 		debitTimes     int
 	}{
 		{
+			name:           "Invalid fee.",
+			expectedErrMsg: "invalid fee amount",
+			src:            &FiatTransactionDetails{Amount: decimal.NewFromFloat(1), Fee: decimal.NewFromFloat(2)},
+			rowLockError:   nil,
+			journalReturn:  &journalEntryRow,
+			journalError:   nil,
+			journalTimes:   0,
+			creditReturn:   &balanceUpdateRow,
+			creditError:    nil,
+			creditTimes:    0,
+			debitReturn:    &balanceUpdateRow,
+			debitError:     nil,
+			debitTimes:     0,
+		}, {
 			name:           "Row lock and balance failure.",
 			expectedErrMsg: "row lock failure",
+			src:            &txDetails,
 			rowLockError:   errors.New("row lock failure"),
 			journalReturn:  &journalEntryRow,
 			journalError:   nil,
@@ -930,6 +1482,7 @@ func TestTransactions_FiatInternalTransfer_Mock(t *testing.T) {
 		}, {
 			name:           "Journal entry failure.",
 			expectedErrMsg: "journal entry failure",
+			src:            &txDetails,
 			rowLockError:   nil,
 			journalReturn:  &journalEntryRow,
 			journalError:   errors.New("journal entry failure"),
@@ -940,13 +1493,48 @@ func TestTransactions_FiatInternalTransfer_Mock(t *testing.T) {
 			debitReturn:    &balanceUpdateRow,
 			debitError:     nil,
 			debitTimes:     0,
+		}, {
+			name:           "Fee journal entry failure.",
+			expectedErrMsg: "fee journal entry failure",
+			src:            &feeTxDetails,
+			rowLockError:   nil,
+			journalReturn:  &journalEntryRow,
+			journalError:   nil,
+			journalTimes:   1,
+			feeError:       errors.New("fee journal entry failure"),
+			feeTimes:       1,
+			creditReturn:   &balanceUpdateRow,
+			creditError:    nil,
+			creditTimes:    0,
+			debitReturn:    &balanceUpdateRow,
+			debitError:     nil,
+			debitTimes:     0,
+		}, {
+			name:           "Trade entry failure.",
+			expectedErrMsg: "trade entry failure",
+			src:            &txDetails,
+			rowLockError:   nil,
+			journalReturn:  &journalEntryRow,
+			journalError:   nil,
+			journalTimes:   1,
+			tradeError:     errors.New("trade entry failure"),
+			tradeTimes:     1,
+			creditReturn:   &balanceUpdateRow,
+			creditError:    nil,
+			creditTimes:    0,
+			debitReturn:    &balanceUpdateRow,
+			debitError:     nil,
+			debitTimes:     0,
 		}, {
 			name:           "Balance credit failure.",
 			expectedErrMsg: "balance credit failure",
+			src:            &txDetails,
 			rowLockError:   nil,
 			journalReturn:  &journalEntryRow,
 			journalError:   nil,
 			journalTimes:   1,
+			tradeError:     nil,
+			tradeTimes:     1,
 			creditReturn:   &balanceUpdateRow,
 			creditError:    errors.New("balance credit failure"),
 			creditTimes:    1,
@@ -956,10 +1544,15 @@ func TestTransactions_FiatInternalTransfer_Mock(t *testing.T) {
 		}, {
 			name:           "Balance debit failure.",
 			expectedErrMsg: "balance debit failure",
+			src:            &feeTxDetails,
 			rowLockError:   nil,
 			journalReturn:  &journalEntryRow,
 			journalError:   nil,
 			journalTimes:   1,
+			feeError:       nil,
+			feeTimes:       1,
+			tradeError:     nil,
+			tradeTimes:     1,
 			creditReturn:   &balanceUpdateRow,
 			creditError:    nil,
 			creditTimes:    1,
@@ -983,7 +1576,7 @@ func TestTransactions_FiatInternalTransfer_Mock(t *testing.T) {
 			gomock.InOrder(
 				mockQuerier.EXPECT().
 					fiatRowLockAccount(gomock.Any(), gomock.Any()).
-					Return(rowLockDecimal, test.rowLockError).
+					Return(rowLockAccount, test.rowLockError).
 					AnyTimes(),
 
 				mockQuerier.EXPECT().
@@ -991,6 +1584,16 @@ func TestTransactions_FiatInternalTransfer_Mock(t *testing.T) {
 					Return(*test.journalReturn, test.journalError).
 					Times(test.journalTimes),
 
+				mockQuerier.EXPECT().
+					fiatFeeJournalEntry(gomock.Any(), gomock.Any()).
+					Return(test.feeError).
+					Times(test.feeTimes),
+
+				mockQuerier.EXPECT().
+					tradeCreate(gomock.Any(), gomock.Any()).
+					Return(test.tradeError).
+					Times(test.tradeTimes),
+
 				mockQuerier.EXPECT().
 					fiatUpdateAccountBalance(gomock.Any(), gomock.Any()).
 					Return(*test.creditReturn, test.creditError).
@@ -1003,9 +1606,78 @@ func TestTransactions_FiatInternalTransfer_Mock(t *testing.T) {
 			)
 
 			// Check for error.
-			_, _, err := fiatInternalTransfer(context.TODO(), connection.logger, mockQuerier, &txDetails, &txDetails)
+			_, _, err := fiatInternalTransfer(context.TODO(), connection.logger, mockQuerier, test.src, &txDetails, trade)
 			require.Error(t, err, "failed to get error.")
 			require.Contains(t, err.Error(), test.expectedErrMsg, "error messages mismatched.")
 		})
 	}
 }
+
+func TestTransactions_OutboxPublishInternalTransfer_Mock(t *testing.T) {
+	t.Parallel()
+
+	clientID1 := uuid.Must(uuid.NewV4())
+	clientID2 := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name          string
+		srcClientID   uuid.UUID
+		dstClientID   uuid.UUID
+		publishErr    error
+		expectedTypes []OutboxEventType
+		expectErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:          "same client exchange",
+			srcClientID:   clientID1,
+			dstClientID:   clientID1,
+			expectedTypes: []OutboxEventType{OutboxEventTypeFiatExchange},
+			expectErr:     require.NoError,
+		}, {
+			name:        "transfer between clients",
+			srcClientID: clientID1,
+			dstClientID: clientID2,
+			expectedTypes: []OutboxEventType{
+				OutboxEventTypeFiatTransferSent,
+				OutboxEventTypeFiatTransferReceived,
+			},
+			expectErr: require.NoError,
+		}, {
+			name:          "publish failure",
+			srcClientID:   clientID1,
+			dstClientID:   clientID2,
+			publishErr:    errors.New("publish failure"),
+			expectedTypes: []OutboxEventType{OutboxEventTypeFiatTransferSent},
+			expectErr:     require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockQuerier := NewMockQuerier(mockCtrl)
+
+			var published []OutboxEventType
+
+			mockQuerier.EXPECT().
+				outboxPublish(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg *outboxPublishParams) error {
+					published = append(published, arg.EventType)
+
+					return test.publishErr
+				}).
+				Times(len(test.expectedTypes))
+
+			err := outboxPublishInternalTransfer(context.TODO(), mockQuerier,
+				&FiatAccountTransferResult{ClientID: test.srcClientID, Currency: CurrencyUSD},
+				&FiatAccountTransferResult{ClientID: test.dstClientID, Currency: CurrencyCAD})
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.expectedTypes, published, "published event types mismatch.")
+		})
+	}
+}
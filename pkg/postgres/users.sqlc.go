@@ -90,7 +90,7 @@ func (q *Queries) userGetCredentials(ctx context.Context, username string) (user
 }
 
 const userGetInfo = `-- name: userGetInfo :one
-SELECT username, client_id, password, first_name, last_name, email, is_deleted
+SELECT username, client_id, password, first_name, last_name, email, is_deleted, is_frozen, role
 FROM users
 WHERE client_id=$1
 LIMIT 1
@@ -104,6 +104,8 @@ type userGetInfoRow struct {
 	LastName  string    `json:"lastName"`
 	Email     string    `json:"email"`
 	IsDeleted bool      `json:"isDeleted"`
+	IsFrozen  bool      `json:"isFrozen"`
+	Role      UserRole  `json:"role"`
 }
 
 // userGetInfo will retrieve a single users account information.
@@ -118,10 +120,27 @@ func (q *Queries) userGetInfo(ctx context.Context, clientID uuid.UUID) (userGetI
 		&i.LastName,
 		&i.Email,
 		&i.IsDeleted,
+		&i.IsFrozen,
+		&i.Role,
 	)
 	return i, err
 }
 
+const userGetRole = `-- name: userGetRole :one
+SELECT role
+FROM users
+WHERE client_id=$1
+LIMIT 1
+`
+
+// userGetRole will return the role of a user account.
+func (q *Queries) userGetRole(ctx context.Context, clientID uuid.UUID) (UserRole, error) {
+	row := q.db.QueryRow(ctx, userGetRole, clientID)
+	var role UserRole
+	err := row.Scan(&role)
+	return role, err
+}
+
 const userIsDeleted = `-- name: userIsDeleted :one
 SELECT is_deleted
 FROM users
@@ -136,3 +155,58 @@ func (q *Queries) userIsDeleted(ctx context.Context, clientID uuid.UUID) (bool,
 	err := row.Scan(&is_deleted)
 	return is_deleted, err
 }
+
+const userIsFrozen = `-- name: userIsFrozen :one
+SELECT is_frozen
+FROM users
+WHERE client_id=$1
+LIMIT 1
+`
+
+// userIsFrozen will return the frozen status of a user account.
+func (q *Queries) userIsFrozen(ctx context.Context, clientID uuid.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, userIsFrozen, clientID)
+	var is_frozen bool
+	err := row.Scan(&is_frozen)
+	return is_frozen, err
+}
+
+const userSetFrozen = `-- name: userSetFrozen :execrows
+UPDATE users
+SET is_frozen=$2
+WHERE client_id=$1 AND is_deleted=false
+`
+
+type userSetFrozenParams struct {
+	ClientID uuid.UUID `json:"clientID"`
+	IsFrozen bool      `json:"isFrozen"`
+}
+
+// userSetFrozen will freeze or unfreeze an active users account.
+func (q *Queries) userSetFrozen(ctx context.Context, arg *userSetFrozenParams) (int64, error) {
+	result, err := q.db.Exec(ctx, userSetFrozen, arg.ClientID, arg.IsFrozen)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const userUpdatePassword = `-- name: userUpdatePassword :execrows
+UPDATE users
+SET password=$2
+WHERE client_id=$1 AND is_deleted=false
+`
+
+type userUpdatePasswordParams struct {
+	ClientID uuid.UUID `json:"clientID"`
+	Password string    `json:"password"`
+}
+
+// userUpdatePassword will replace the hashed password of an active users account.
+func (q *Queries) userUpdatePassword(ctx context.Context, arg *userUpdatePasswordParams) (int64, error) {
+	result, err := q.db.Exec(ctx, userUpdatePassword, arg.ClientID, arg.Password)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
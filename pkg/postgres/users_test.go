@@ -223,3 +223,78 @@ func TestPostgres_IsDeletedUser(t *testing.T) {
 		})
 	}
 }
+
+func TestPostgres_UpdatePasswordUser(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert an initial set of test users.
+	clientIDs := insertTestUsers(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second)
+
+	defer cancel()
+
+	// Non-existent user.
+	invalidID, err := uuid.NewV1()
+	require.NoError(t, err, "failed to generate invalid client id.")
+	rowsAffected, err := connection.Query.userUpdatePassword(ctx,
+		&userUpdatePasswordParams{ClientID: invalidID, Password: "new-password"})
+	require.NoError(t, err, "failed to execute password update for non-existent user.")
+	require.Zero(t, rowsAffected, "updated a non-existent user.")
+
+	for _, clientID := range clientIDs {
+		t.Run("Updating password of user: "+clientID.String(), func(t *testing.T) {
+			rowsAffected, err = connection.Query.userUpdatePassword(ctx,
+				&userUpdatePasswordParams{ClientID: clientID, Password: "new-password"})
+			require.NoError(t, err, "failed to execute password update on user.")
+			require.Equal(t, int64(1), rowsAffected, "failed to execute password update on user.")
+		})
+	}
+}
+
+func TestPostgres_FrozenUser(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	// Insert an initial set of test users.
+	clientIDs := insertTestUsers(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second)
+
+	defer cancel()
+
+	// Non-existent user.
+	invalidID, err := uuid.NewV1()
+	require.NoError(t, err, "failed to generate invalid client id.")
+	_, err = connection.Query.userIsFrozen(ctx, invalidID)
+	require.Error(t, err, "retrieved frozen status for non-existent user.")
+
+	rowsAffected, err := connection.Query.userSetFrozen(ctx, &userSetFrozenParams{ClientID: invalidID, IsFrozen: true})
+	require.NoError(t, err, "failed to execute freeze for non-existent user.")
+	require.Zero(t, rowsAffected, "froze a non-existent user.")
+
+	for _, clientID := range clientIDs {
+		t.Run("Freezing user: "+clientID.String(), func(t *testing.T) {
+			isFrozen, err := connection.Query.userIsFrozen(ctx, clientID)
+			require.NoError(t, err, "failed to retrieve a/c frozen status before freeze.")
+			require.False(t, isFrozen, "incorrect a/c frozen status before freeze.")
+
+			rowsAffected, err := connection.Query.userSetFrozen(ctx, &userSetFrozenParams{ClientID: clientID, IsFrozen: true})
+			require.NoError(t, err, "failed to execute freeze on user.")
+			require.Equal(t, int64(1), rowsAffected, "failed to execute freeze on user.")
+
+			isFrozen, err = connection.Query.userIsFrozen(ctx, clientID)
+			require.NoError(t, err, "failed to retrieve a/c frozen status after freeze.")
+			require.True(t, isFrozen, "incorrect a/c frozen status after freeze.")
+
+			role, err := connection.Query.userGetRole(ctx, clientID)
+			require.NoError(t, err, "failed to retrieve a/c role.")
+			require.Equal(t, UserRoleUser, role, "incorrect a/c role.")
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhooks_Webhooks(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		return
+	}
+
+	clientIDs := insertTestUsers(t)
+	resetTestWebhooks(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+
+	defer cancel()
+
+	var webhookID uuid.UUID
+
+	t.Run("create", func(t *testing.T) {
+		webhook, err := connection.Query.webhookCreate(ctx, &webhookCreateParams{
+			ClientID:    clientIDs[0],
+			Url:         "https://example.com/hook-1",
+			Secret:      "secret-1",
+			MaxWebhooks: 2,
+		})
+		require.NoError(t, err, "failed to create webhook.")
+		require.Equal(t, clientIDs[0], webhook.ClientID, "client ID mismatch.")
+		require.Equal(t, "https://example.com/hook-1", webhook.Url, "url mismatch.")
+
+		webhookID = webhook.WebhookID
+
+		_, err = connection.Query.webhookCreate(ctx, &webhookCreateParams{
+			ClientID:    clientIDs[0],
+			Url:         "https://example.com/hook-1",
+			Secret:      "secret-2",
+			MaxWebhooks: 2,
+		})
+		require.Error(t, err, "created a duplicate webhook.")
+
+		_, err = connection.Query.webhookCreate(ctx, &webhookCreateParams{
+			ClientID:    clientIDs[0],
+			Url:         "https://example.com/hook-2",
+			Secret:      "secret-2",
+			MaxWebhooks: 1,
+		})
+		require.Error(t, err, "created a webhook above the limit.")
+
+		_, err = connection.Query.webhookCreate(ctx, &webhookCreateParams{
+			ClientID:    uuid.Must(uuid.NewV4()),
+			Url:         "https://example.com/hook-1",
+			Secret:      "secret-1",
+			MaxWebhooks: 2,
+		})
+		require.Error(t, err, "created a webhook for an unknown client.")
+	})
+
+	t.Run("list", func(t *testing.T) {
+		webhooks, err := connection.Query.webhookGetAll(ctx, clientIDs[0])
+		require.NoError(t, err, "failed to list webhooks.")
+		require.Len(t, webhooks, 1, "webhook count mismatch.")
+		require.Equal(t, "secret-1", webhooks[0].Secret, "secret mismatch.")
+
+		webhooks, err = connection.Query.webhookGetAll(ctx, clientIDs[1])
+		require.NoError(t, err, "failed to list webhooks for a client without any.")
+		require.Empty(t, webhooks, "listed webhooks for a client without any.")
+	})
+
+	t.Run("publish, claim, and dead letter", func(t *testing.T) {
+		require.NoError(t, connection.Query.outboxPublish(ctx, &outboxPublishParams{
+			ClientID:  clientIDs[0],
+			EventType: OutboxEventTypeFiatDeposit,
+			Payload:   []byte(`{"amount":"10"}`),
+		}), "failed to publish event.")
+
+		// Events for clients without webhooks are recorded without any deliveries.
+		require.NoError(t, connection.Query.outboxPublish(ctx, &outboxPublishParams{
+			ClientID:  clientIDs[1],
+			EventType: OutboxEventTypeFiatDeposit,
+			Payload:   []byte(`{"amount":"10"}`),
+		}), "failed to publish event for a client without webhooks.")
+
+		leasedUntil := pgtype.Timestamptz{Time: time.Now().Add(time.Minute), Valid: true}
+		due, err := connection.Query.webhookDeliveryClaimDue(ctx, &webhookDeliveryClaimDueParams{
+			LeasedUntil: leasedUntil,
+			BatchSize:   10,
+		})
+		require.NoError(t, err, "failed to claim due deliveries.")
+		require.Len(t, due, 1, "due delivery count mismatch.")
+		require.Equal(t, "https://example.com/hook-1", due[0].Url, "url mismatch.")
+		require.Equal(t, OutboxEventTypeFiatDeposit, due[0].EventType, "event type mismatch.")
+		require.JSONEq(t, `{"amount":"10"}`, string(due[0].Payload), "payload mismatch.")
+
+		// Claimed deliveries are leased and are not due again.
+		claimed, err := connection.Query.webhookDeliveryClaimDue(ctx, &webhookDeliveryClaimDueParams{
+			LeasedUntil: leasedUntil,
+			BatchSize:   10,
+		})
+		require.NoError(t, err, "failed to claim leased deliveries.")
+		require.Empty(t, claimed, "claimed a leased delivery.")
+
+		rows, err := connection.Query.webhookDeliveryUpdate(ctx, &webhookDeliveryUpdateParams{
+			Status:        WebhookDeliveryStatusDead,
+			NextAttemptAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+			LastError:     "status 500",
+			DeliveryID:    due[0].DeliveryID,
+		})
+		require.NoError(t, err, "failed to update delivery.")
+		require.Equal(t, int64(1), rows, "updated delivery count mismatch.")
+
+		deadLetters, err := connection.Query.webhookDeliveryGetDeadPaginated(ctx,
+			&webhookDeliveryGetDeadPaginatedParams{ClientID: clientIDs[0], Offset: 0, Limit: 10})
+		require.NoError(t, err, "failed to retrieve dead letters.")
+		require.Len(t, deadLetters, 1, "dead letter count mismatch.")
+		require.Equal(t, int32(1), deadLetters[0].Attempts, "attempts mismatch.")
+		require.Equal(t, "status 500", deadLetters[0].LastError, "last error mismatch.")
+
+		deadLetters, err = connection.Query.webhookDeliveryGetDeadPaginated(ctx,
+			&webhookDeliveryGetDeadPaginatedParams{ClientID: clientIDs[1], Offset: 0, Limit: 10})
+		require.NoError(t, err, "failed to retrieve dead letters for a client without any.")
+		require.Empty(t, deadLetters, "retrieved dead letters for a client without any.")
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		rows, err := connection.Query.webhookDelete(ctx, &webhookDeleteParams{ClientID: clientIDs[1], WebhookID: webhookID})
+		require.NoError(t, err, "failed to attempt deleting another client's webhook.")
+		require.Equal(t, int64(0), rows, "deleted another client's webhook.")
+
+		rows, err = connection.Query.webhookDelete(ctx, &webhookDeleteParams{ClientID: clientIDs[0], WebhookID: webhookID})
+		require.NoError(t, err, "failed to delete webhook.")
+		require.Equal(t, int64(1), rows, "deleted webhook count mismatch.")
+
+		deadLetters, err := connection.Query.webhookDeliveryGetDeadPaginated(ctx,
+			&webhookDeliveryGetDeadPaginatedParams{ClientID: clientIDs[0], Offset: 0, Limit: 10})
+		require.NoError(t, err, "failed to retrieve dead letters.")
+		require.Empty(t, deadLetters, "dead letters retained after webhook deletion.")
+	})
+}
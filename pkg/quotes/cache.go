@@ -0,0 +1,77 @@
+package quotes
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/redis"
+	"go.uber.org/zap"
+)
+
+// errStaleQuote is returned when the only price quote available is older than the configured maximum age.
+var errStaleQuote = NewError("price quote is stale").SetStatus(http.StatusServiceUnavailable)
+
+// quoteCacheKey will generate the Redis cache key for a currency pair's price quote.
+func quoteCacheKey(prefix, source, destination string) string {
+	return prefix + strings.ToUpper(source) + "-" + strings.ToUpper(destination)
+}
+
+// fiatQuoteTime will extract the time at which a Fiat currency price quote was issued.
+func fiatQuoteTime(quote *models.FiatQuote) time.Time {
+	return time.Unix(quote.Info.Timestamp, 0).UTC()
+}
+
+// cryptoQuoteTime will extract the time at which a Cryptocurrency price quote was issued. Price quotes with a malformed
+// time are treated as infinitely old.
+func cryptoQuoteTime(quote *models.CryptoQuote) time.Time {
+	issued, err := time.Parse(time.RFC3339Nano, quote.Time)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return issued.UTC()
+}
+
+// fiatQuoteAmount will reprice a Fiat currency price quote for a different source amount.
+func fiatQuoteAmount(quote models.FiatQuote, amount decimal.Decimal) models.FiatQuote {
+	quote.Query.Amount = amount
+	quote.Result = quote.Info.Rate.Mul(amount).RoundBank(constants.DecimalPlacesFiat())
+
+	return quote
+}
+
+// cacheGet will attempt to retrieve a price quote from the Redis cache. Cache failures are logged and reported as a
+// cache miss so that the price quote providers can be queried.
+func (q *quotesImpl) cacheGet(key string, quote any) bool {
+	if q.cache == nil {
+		return false
+	}
+
+	err := q.cache.Get(key, quote)
+	if err == nil {
+		return true
+	}
+
+	var redisErr *redis.Error
+	if !errors.As(err, &redisErr) || !redisErr.Is(redis.ErrCacheMiss) {
+		q.logger.Warn("failed to retrieve price quote from Redis cache", zap.String("key", key), zap.Error(err))
+	}
+
+	return false
+}
+
+// cacheSet will place a price quote in the Redis cache until it exceeds the maximum age. Cache failures are logged.
+func (q *quotesImpl) cacheSet(key string, quote any, ttl time.Duration) {
+	if q.cache == nil || ttl <= 0 {
+		return
+	}
+
+	if err := q.cache.Set(key, quote, ttl); err != nil {
+		q.logger.Warn("failed to place price quote in Redis cache", zap.String("key", key), zap.Error(err))
+	}
+}
@@ -0,0 +1,321 @@
+package quotes
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+// testCacheConfig is the cache configuration used for the price quote cache tests.
+var testCacheConfig = cacheConfig{
+	FiatFreshness:   time.Minute,
+	FiatMaxAge:      10 * time.Minute,
+	CryptoFreshness: time.Minute,
+	CryptoMaxAge:    10 * time.Minute,
+}
+
+// stubFiatProvider is a Fiat currency price quote provider that returns a preset quote.
+type stubFiatProvider struct {
+	quote models.FiatQuote
+	err   error
+	calls int
+}
+
+func (p *stubFiatProvider) name() string { return "stub" }
+
+func (p *stubFiatProvider) fiatQuote(string, string, decimal.Decimal) (models.FiatQuote, error) {
+	p.calls++
+
+	return p.quote, p.err
+}
+
+// stubCryptoProvider is a Cryptocurrency price quote provider that returns a preset quote.
+type stubCryptoProvider struct {
+	quote models.CryptoQuote
+	err   error
+	calls int
+}
+
+func (p *stubCryptoProvider) name() string { return "stub" }
+
+func (p *stubCryptoProvider) cryptoQuote(string, string) (models.CryptoQuote, error) {
+	p.calls++
+
+	return p.quote, p.err
+}
+
+func TestQuoteCacheKey(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "prefix-USD-CAD", quoteCacheKey("prefix-", "usd", "Cad"), "cache key mismatch.")
+}
+
+func TestCryptoQuoteTime(t *testing.T) {
+	t.Parallel()
+
+	issued := time.Date(2023, 6, 1, 12, 30, 15, 123456700, time.UTC)
+
+	require.Equal(t, issued, cryptoQuoteTime(&models.CryptoQuote{Time: "2023-06-01T12:30:15.1234567Z"}),
+		"failed to parse quote time.")
+	require.True(t, cryptoQuoteTime(&models.CryptoQuote{Time: "invalid"}).IsZero(),
+		"malformed quote time should be zero.")
+}
+
+func TestQuotesImpl_FiatQuote_Cache(t *testing.T) {
+	t.Parallel()
+
+	amount := decimal.NewFromFloat(100)
+	unavailable := NewError("unavailable").SetStatus(http.StatusServiceUnavailable)
+	fiatQuoteAt := func(age time.Duration, rate float64) models.FiatQuote {
+		return models.FiatQuote{
+			Info:    models.FiatInfo{Rate: decimal.NewFromFloat(rate), Timestamp: time.Now().Add(-age).Unix()},
+			Result:  decimal.NewFromFloat(rate).Mul(amount),
+			Success: true,
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		cached        *models.FiatQuote
+		cacheErr      error
+		providerQuote models.FiatQuote
+		providerErr   error
+		providerCalls int
+		setTimes      int
+		expectErr     require.ErrorAssertionFunc
+		expectRate    decimal.Decimal
+	}{
+		{
+			name:          "fresh cache hit",
+			cached:        func() *models.FiatQuote { q := fiatQuoteAt(0, 1.5); return &q }(),
+			providerCalls: 0,
+			expectErr:     require.NoError,
+			expectRate:    decimal.NewFromFloat(1.5),
+		}, {
+			name:          "cache miss",
+			cacheErr:      redis.ErrCacheMiss,
+			providerQuote: fiatQuoteAt(0, 1.25),
+			providerCalls: 1,
+			setTimes:      1,
+			expectErr:     require.NoError,
+			expectRate:    decimal.NewFromFloat(1.25),
+		}, {
+			name:          "cache failure",
+			cacheErr:      redis.NewError("connection failure"),
+			providerQuote: fiatQuoteAt(0, 1.25),
+			providerCalls: 1,
+			setTimes:      1,
+			expectErr:     require.NoError,
+			expectRate:    decimal.NewFromFloat(1.25),
+		}, {
+			name:          "cache outside freshness",
+			cached:        func() *models.FiatQuote { q := fiatQuoteAt(5*time.Minute, 1.5); return &q }(),
+			providerQuote: fiatQuoteAt(0, 1.25),
+			providerCalls: 1,
+			setTimes:      1,
+			expectErr:     require.NoError,
+			expectRate:    decimal.NewFromFloat(1.25),
+		}, {
+			name:          "providers unavailable, cache within max age",
+			cached:        func() *models.FiatQuote { q := fiatQuoteAt(5*time.Minute, 1.5); return &q }(),
+			providerErr:   unavailable,
+			providerCalls: 1,
+			expectErr:     require.NoError,
+			expectRate:    decimal.NewFromFloat(1.5),
+		}, {
+			name:          "providers unavailable, cache outside max age",
+			cached:        func() *models.FiatQuote { q := fiatQuoteAt(time.Hour, 1.5); return &q }(),
+			providerErr:   unavailable,
+			providerCalls: 1,
+			expectErr:     require.Error,
+		}, {
+			name:          "providers unavailable, cache miss",
+			cacheErr:      redis.ErrCacheMiss,
+			providerErr:   unavailable,
+			providerCalls: 1,
+			expectErr:     require.Error,
+		}, {
+			name:          "provider stale rate",
+			cacheErr:      redis.ErrCacheMiss,
+			providerQuote: fiatQuoteAt(time.Hour, 1.25),
+			providerCalls: 1,
+			expectErr:     require.Error,
+		}, {
+			name:          "provider stale rate, cache within max age",
+			cached:        func() *models.FiatQuote { q := fiatQuoteAt(5*time.Minute, 1.5); return &q }(),
+			providerQuote: fiatQuoteAt(time.Hour, 1.25),
+			providerCalls: 1,
+			expectErr:     require.NoError,
+			expectRate:    decimal.NewFromFloat(1.5),
+		}, {
+			name:          "invalid currency",
+			cached:        func() *models.FiatQuote { q := fiatQuoteAt(5*time.Minute, 1.5); return &q }(),
+			providerErr:   NewError("invalid Fiat currency code").SetStatus(http.StatusBadRequest),
+			providerCalls: 1,
+			expectErr:     require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCache := mocks.NewMockRedis(gomock.NewController(t))
+			provider := &stubFiatProvider{quote: test.providerQuote, err: test.providerErr}
+
+			mockCache.EXPECT().Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ string, value any) error {
+					if test.cached != nil {
+						*value.(*models.FiatQuote) = *test.cached //nolint:forcetypeassert
+					}
+
+					return test.cacheErr
+				}).Times(1)
+
+			mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(test.setTimes)
+
+			mockCache.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			impl := &quotesImpl{
+				fiatProviders: []fiatProvider{provider},
+				cache:         mockCache,
+				conf:          &config{Cache: testCacheConfig},
+				logger:        zapLogger,
+			}
+
+			result, err := impl.fiatQuote("USD", "CAD", amount)
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.providerCalls, provider.calls, "provider call count mismatch.")
+
+			if err != nil {
+				return
+			}
+
+			require.True(t, test.expectRate.Equal(result.Info.Rate), "rate mismatch.")
+			require.True(t, test.expectRate.Mul(amount).Equal(result.Result), "converted amount mismatch.")
+		})
+	}
+}
+
+func TestQuotesImpl_CryptoQuote_Cache(t *testing.T) {
+	t.Parallel()
+
+	unavailable := NewError("unavailable").SetStatus(http.StatusServiceUnavailable)
+	cryptoQuoteAt := func(age time.Duration, rate float64) models.CryptoQuote {
+		return models.CryptoQuote{
+			BaseCurrency:  "BTC",
+			QuoteCurrency: "USD",
+			Time:          time.Now().Add(-age).UTC().Format(time.RFC3339Nano),
+			Rate:          decimal.NewFromFloat(rate),
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		cached        *models.CryptoQuote
+		cacheErr      error
+		providerQuote models.CryptoQuote
+		providerErr   error
+		providerCalls int
+		setTimes      int
+		expectErr     require.ErrorAssertionFunc
+		expectRate    decimal.Decimal
+	}{
+		{
+			name:          "fresh cache hit",
+			cached:        func() *models.CryptoQuote { q := cryptoQuoteAt(0, 20000); return &q }(),
+			providerCalls: 0,
+			expectErr:     require.NoError,
+			expectRate:    decimal.NewFromFloat(20000),
+		}, {
+			name:          "cache miss",
+			cacheErr:      redis.ErrCacheMiss,
+			providerQuote: cryptoQuoteAt(0, 21000),
+			providerCalls: 1,
+			setTimes:      1,
+			expectErr:     require.NoError,
+			expectRate:    decimal.NewFromFloat(21000),
+		}, {
+			name:          "providers unavailable, cache within max age",
+			cached:        func() *models.CryptoQuote { q := cryptoQuoteAt(5*time.Minute, 20000); return &q }(),
+			providerErr:   unavailable,
+			providerCalls: 1,
+			expectErr:     require.NoError,
+			expectRate:    decimal.NewFromFloat(20000),
+		}, {
+			name:          "providers unavailable, cache outside max age",
+			cached:        func() *models.CryptoQuote { q := cryptoQuoteAt(time.Hour, 20000); return &q }(),
+			providerErr:   unavailable,
+			providerCalls: 1,
+			expectErr:     require.Error,
+		}, {
+			name:          "provider stale rate",
+			cacheErr:      redis.ErrCacheMiss,
+			providerQuote: cryptoQuoteAt(time.Hour, 21000),
+			providerCalls: 1,
+			expectErr:     require.Error,
+		}, {
+			name:          "provider malformed time",
+			cacheErr:      redis.ErrCacheMiss,
+			providerQuote: models.CryptoQuote{Time: "invalid", Rate: decimal.NewFromFloat(21000)},
+			providerCalls: 1,
+			expectErr:     require.Error,
+		}, {
+			name:          "invalid currency",
+			cacheErr:      redis.ErrCacheMiss,
+			providerErr:   NewError("invalid Crypto currency code").SetStatus(http.StatusBadRequest),
+			providerCalls: 1,
+			expectErr:     require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCache := mocks.NewMockRedis(gomock.NewController(t))
+			provider := &stubCryptoProvider{quote: test.providerQuote, err: test.providerErr}
+
+			mockCache.EXPECT().Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ string, value any) error {
+					if test.cached != nil {
+						*value.(*models.CryptoQuote) = *test.cached //nolint:forcetypeassert
+					}
+
+					return test.cacheErr
+				}).Times(1)
+
+			mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(test.setTimes)
+
+			mockCache.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			impl := &quotesImpl{
+				cryptoProviders: []cryptoProvider{provider},
+				cache:           mockCache,
+				conf:            &config{Cache: testCacheConfig},
+				logger:          zapLogger,
+			}
+
+			result, err := impl.cryptoQuote("BTC", "USD")
+			test.expectErr(t, err, "error expectation failed.")
+			require.Equal(t, test.providerCalls, provider.calls, "provider call count mismatch.")
+
+			if err != nil {
+				return
+			}
+
+			require.True(t, test.expectRate.Equal(result.Rate), "rate mismatch.")
+		})
+	}
+}
@@ -2,27 +2,48 @@ package quotes
 
 import (
 	"fmt"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/afero"
 	"github.com/surahman/FTeX/pkg/configloader"
 	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/validator"
 )
 
 // config is the configuration container for connecting to external Quotes services.
 //
 //nolint:lll
 type config struct {
-	FiatCurrency   apiConfig        `json:"fiatCurrency,omitempty"   mapstructure:"fiatCurrency"   validate:"required"         yaml:"fiatCurrency,omitempty"`
-	CryptoCurrency apiConfig        `json:"cryptoCurrency,omitempty" mapstructure:"cryptoCurrency" validate:"required"         yaml:"cryptoCurrency,omitempty"`
-	Connection     connectionConfig `json:"connection,omitempty"     mapstructure:"connection"     yaml:"connection,omitempty"`
+	FiatProviders   []providerConfig `json:"fiatProviders,omitempty"   mapstructure:"fiatProviders"   validate:"required_unless=Offline.Enabled true,omitempty,min=1,dive" yaml:"fiatProviders,omitempty"`
+	CryptoProviders []providerConfig `json:"cryptoProviders,omitempty" mapstructure:"cryptoProviders" validate:"required_unless=Offline.Enabled true,omitempty,min=1,dive" yaml:"cryptoProviders,omitempty"`
+	Offline         offlineConfig    `json:"offline,omitempty"         mapstructure:"offline"         yaml:"offline,omitempty"`
+	Connection      connectionConfig `json:"connection,omitempty"      mapstructure:"connection"      yaml:"connection,omitempty"`
+	Cache           cacheConfig      `json:"cache,omitempty"           mapstructure:"cache"           yaml:"cache,omitempty"`
+	Fees            feesConfig       `json:"fees,omitempty"            mapstructure:"fees"            yaml:"fees,omitempty"`
 }
 
-// apiConfig contains the API Key and URL information for a currency exchange endpoint.
-type apiConfig struct {
-	APIKey    string `json:"apiKey,omitempty"    mapstructure:"apiKey"    validate:"required" yaml:"apiKey,omitempty"`
-	HeaderKey string `json:"headerKey,omitempty" mapstructure:"headerKey" validate:"required" yaml:"headerKey,omitempty"`
-	Endpoint  string `json:"endpoint,omitempty"  mapstructure:"endpoint"  validate:"required" yaml:"endpoint,omitempty"`
+// providerConfig contains the adapter name, API Key, and URL information for a currency price quote provider. Providers
+// are tried in the order they are configured.
+//
+//nolint:lll
+type providerConfig struct {
+	Provider  string `json:"provider,omitempty"  mapstructure:"provider"  validate:"required"              yaml:"provider,omitempty"`
+	APIKey    string `json:"apiKey,omitempty"    mapstructure:"apiKey"    yaml:"apiKey,omitempty"`
+	HeaderKey string `json:"headerKey,omitempty" mapstructure:"headerKey" validate:"required_with=APIKey" yaml:"headerKey,omitempty"`
+	Endpoint  string `json:"endpoint,omitempty"  mapstructure:"endpoint"  validate:"required"              yaml:"endpoint,omitempty"`
+}
+
+// offlineConfig contains the location of the rate table used to serve price quotes without accessing the price quote
+// providers. The rates will drift on each request by up to the drift fraction, if it is set.
+//
+//nolint:lll
+type offlineConfig struct {
+	Enabled bool    `json:"enabled,omitempty" mapstructure:"enabled" yaml:"enabled,omitempty"`
+	Rates   string  `json:"rates,omitempty"   mapstructure:"rates"   validate:"required_if=Enabled true" yaml:"rates,omitempty"`
+	Drift   float64 `json:"drift,omitempty"   mapstructure:"drift"   validate:"min=0,lt=1"                yaml:"drift,omitempty"`
 }
 
 // connectionConfig contains HTTP connection attempt information.
@@ -33,6 +54,72 @@ type connectionConfig struct {
 	Timeout   time.Duration `json:"timeout,omitempty"   mapstructure:"timeout"   validate:"required" yaml:"timeout,omitempty"`
 }
 
+// cacheConfig contains the freshness windows within which a cached price quote will be used in place of a provider
+// request, as well as the maximum age of a price quote that will be accepted for an offer.
+//
+//nolint:lll
+type cacheConfig struct {
+	FiatFreshness   time.Duration `json:"fiatFreshness,omitempty"   mapstructure:"fiatFreshness"   validate:"required"                          yaml:"fiatFreshness,omitempty"`
+	FiatMaxAge      time.Duration `json:"fiatMaxAge,omitempty"      mapstructure:"fiatMaxAge"      validate:"required,gtefield=FiatFreshness"   yaml:"fiatMaxAge,omitempty"`
+	CryptoFreshness time.Duration `json:"cryptoFreshness,omitempty" mapstructure:"cryptoFreshness" validate:"required"                          yaml:"cryptoFreshness,omitempty"`
+	CryptoMaxAge    time.Duration `json:"cryptoMaxAge,omitempty"    mapstructure:"cryptoMaxAge"    validate:"required,gtefield=CryptoFreshness" yaml:"cryptoMaxAge,omitempty"`
+}
+
+// feesConfig contains the default fee schedule for exchange offers as well as any currency pair specific overrides. Fees
+// are charged in the source currency, and the default minimum fee is only applied to Fiat currency sources.
+//
+//nolint:lll
+type feesConfig struct {
+	BasisPoints int64               `json:"basisPoints,omitempty" mapstructure:"basisPoints" validate:"min=0,max=10000" yaml:"basisPoints,omitempty"`
+	Minimum     float64             `json:"minimum,omitempty"     mapstructure:"minimum"     validate:"min=0"           yaml:"minimum,omitempty"`
+	Overrides   []feeOverrideConfig `json:"overrides,omitempty"   mapstructure:"overrides"   validate:"dive"            yaml:"overrides,omitempty"`
+}
+
+// feeOverrideConfig contains the fee schedule for a specific source and destination currency pair.
+//
+//nolint:lll
+type feeOverrideConfig struct {
+	Source      string  `json:"source,omitempty"      mapstructure:"source"      validate:"required"        yaml:"source,omitempty"`
+	Destination string  `json:"destination,omitempty" mapstructure:"destination" validate:"required"        yaml:"destination,omitempty"`
+	BasisPoints int64   `json:"basisPoints,omitempty" mapstructure:"basisPoints" validate:"min=0,max=10000" yaml:"basisPoints,omitempty"`
+	Minimum     float64 `json:"minimum,omitempty"     mapstructure:"minimum"     validate:"min=0"           yaml:"minimum,omitempty"`
+}
+
+// schedule will return the basis points and minimum fee for a currency pair. Currency pair overrides take precedence over
+// the default fee schedule. The default minimum fee is denominated in Fiat currency units, so Cryptocurrency sources
+// without an override are not subject to a minimum fee.
+func (cfg *feesConfig) schedule(source, destination string) (int64, float64) {
+	for _, override := range cfg.Overrides {
+		if strings.EqualFold(override.Source, source) && strings.EqualFold(override.Destination, destination) {
+			return override.BasisPoints, override.Minimum
+		}
+	}
+
+	var fiatCurrency postgres.Currency
+	if err := fiatCurrency.Scan(strings.ToUpper(source)); err != nil || !fiatCurrency.Valid() {
+		return cfg.BasisPoints, 0
+	}
+
+	return cfg.BasisPoints, cfg.Minimum
+}
+
+// providerAPIKeyEnv returns the name of the environment variable that supplies a price quote provider's API Key.
+func providerAPIKeyEnv(provider string) string {
+	return fmt.Sprintf("%s_%s.APIKEY", constants.QuotesPrefix(), strings.ToUpper(provider))
+}
+
+// loadAPIKeys will set the API Keys of the price quote providers from their environment variables. Viper cannot
+// override the items in a list from environment variables, so the keys are looked up by provider name.
+func (cfg *config) loadAPIKeys() {
+	for _, providers := range [][]providerConfig{cfg.FiatProviders, cfg.CryptoProviders} {
+		for idx := range providers {
+			if apiKey, ok := os.LookupEnv(providerAPIKeyEnv(providers[idx].Provider)); ok {
+				providers[idx].APIKey = apiKey
+			}
+		}
+	}
+}
+
 // newConfig creates a blank configuration struct for Redis.
 func newConfig() *config {
 	return &config{}
@@ -49,5 +136,11 @@ func (cfg *config) Load(fs afero.Fs) (err error) {
 		return fmt.Errorf("quotes config loading failed: %w", err)
 	}
 
+	cfg.loadAPIKeys()
+
+	if err := validator.ValidateStruct(cfg); err != nil {
+		return fmt.Errorf("quotes config loading failed: %w", err)
+	}
+
 	return nil
 }
@@ -13,8 +13,6 @@ import (
 )
 
 func TestQuotesConfigs_Load(t *testing.T) {
-	envFiatKey := constants.QuotesPrefix() + "_FIATCURRENCY."
-	envCryptoKey := constants.QuotesPrefix() + "_CRYPTOCURRENCY."
 	envConnKey := constants.QuotesPrefix() + "_CONNECTION."
 
 	testCases := []struct {
@@ -35,8 +33,8 @@ func TestQuotesConfigs_Load(t *testing.T) {
 			expectErrCnt: 0,
 			expectErr:    require.NoError,
 		}, {
-			name:         "no api key fiat",
-			input:        quotesConfigTestData["no fiat api key"],
+			name:         "no provider fiat",
+			input:        quotesConfigTestData["no fiat provider name"],
 			expectErrCnt: 1,
 			expectErr:    require.Error,
 		}, {
@@ -47,16 +45,16 @@ func TestQuotesConfigs_Load(t *testing.T) {
 		}, {
 			name:         "no api endpoint fiat",
 			input:        quotesConfigTestData["no fiat api endpoint"],
-			expectErrCnt: 1,
+			expectErrCnt: 2,
 			expectErr:    require.Error,
 		}, {
 			name:         "no fiat",
 			input:        quotesConfigTestData["no fiat"],
-			expectErrCnt: 3,
+			expectErrCnt: 1,
 			expectErr:    require.Error,
 		}, {
-			name:         "no api key crypto",
-			input:        quotesConfigTestData["no crypto api key"],
+			name:         "no provider crypto",
+			input:        quotesConfigTestData["no crypto provider name"],
 			expectErrCnt: 1,
 			expectErr:    require.Error,
 		}, {
@@ -72,7 +70,12 @@ func TestQuotesConfigs_Load(t *testing.T) {
 		}, {
 			name:         "no crypto",
 			input:        quotesConfigTestData["no crypto"],
-			expectErrCnt: 3,
+			expectErrCnt: 1,
+			expectErr:    require.Error,
+		}, {
+			name:         "empty crypto",
+			input:        quotesConfigTestData["empty crypto"],
+			expectErrCnt: 1,
 			expectErr:    require.Error,
 		}, {
 			name:         "no connection user-agent",
@@ -89,6 +92,31 @@ func TestQuotesConfigs_Load(t *testing.T) {
 			input:        quotesConfigTestData["no connection"],
 			expectErrCnt: 2,
 			expectErr:    require.Error,
+		}, {
+			name:         "no cache",
+			input:        quotesConfigTestData["no cache"],
+			expectErrCnt: 4,
+			expectErr:    require.Error,
+		}, {
+			name:         "invalid cache",
+			input:        quotesConfigTestData["invalid cache"],
+			expectErrCnt: 2,
+			expectErr:    require.Error,
+		}, {
+			name:         "valid offline",
+			input:        quotesConfigTestData["valid offline"],
+			expectErrCnt: 0,
+			expectErr:    require.NoError,
+		}, {
+			name:         "invalid offline",
+			input:        quotesConfigTestData["invalid offline"],
+			expectErrCnt: 2,
+			expectErr:    require.Error,
+		}, {
+			name:         "invalid fees",
+			input:        quotesConfigTestData["invalid fees"],
+			expectErrCnt: 4,
+			expectErr:    require.Error,
 		},
 	}
 	for _, testCase := range testCases {
@@ -114,37 +142,43 @@ func TestQuotesConfigs_Load(t *testing.T) {
 			}
 
 			// Test configuring of environment variable.
-			apiKeyFiat := xid.New().String()
-			headerKeyFiat := xid.New().String()
-			apiEndpointFiat := xid.New().String()
-
-			t.Setenv(envFiatKey+"APIKEY", apiKeyFiat)
-			t.Setenv(envFiatKey+"HEADERKEY", headerKeyFiat)
-			t.Setenv(envFiatKey+"ENDPOINT", apiEndpointFiat)
-
-			apiKeyCrypto := xid.New().String()
-			headerKeyCrypto := xid.New().String()
-			apiEndpointCrypto := xid.New().String()
-
-			t.Setenv(envCryptoKey+"APIKEY", apiKeyCrypto)
-			t.Setenv(envCryptoKey+"HEADERKEY", headerKeyCrypto)
-			t.Setenv(envCryptoKey+"ENDPOINT", apiEndpointCrypto)
-
 			timeout := 999 * time.Second
 			userAgent := xid.New().String()
 
 			t.Setenv(envConnKey+"TIMEOUT", timeout.String())
 			t.Setenv(envConnKey+"USERAGENT", userAgent)
 
+			fiatKey := xid.New().String()
+			cryptoKey := xid.New().String()
+
+			t.Setenv(providerAPIKeyEnv(providerRapidAPI), fiatKey)
+			t.Setenv(providerAPIKeyEnv(providerCoinAPI), cryptoKey)
+
 			require.NoErrorf(t, actual.Load(fs), "failed to load configurations file: %v", err)
 
-			require.Equal(t, apiKeyFiat, actual.FiatCurrency.APIKey, "failed to load fiat API Key.")
-			require.Equal(t, headerKeyFiat, actual.FiatCurrency.HeaderKey, "failed to load fiat Header Key.")
-			require.Equal(t, apiEndpointFiat, actual.FiatCurrency.Endpoint, "failed to load fiat API endpoint.")
+			if actual.Offline.Enabled {
+				require.Empty(t, actual.FiatProviders, "offline configuration loaded fiat providers.")
+				require.Empty(t, actual.CryptoProviders, "offline configuration loaded crypto providers.")
+				require.Equal(t, "QuoteRates.yaml", actual.Offline.Rates, "failed to load offline rate table.")
+				require.InDelta(t, 0.001, actual.Offline.Drift, 1e-9, "failed to load offline drift.")
+			} else {
+				require.Len(t, actual.FiatProviders, 2, "failed to load fiat providers.")
+				require.Equal(t, providerRapidAPI, actual.FiatProviders[0].Provider, "fiat provider order mismatch.")
+				require.Equal(t, providerFrankfurter, actual.FiatProviders[1].Provider, "fiat provider order mismatch.")
+				require.Equal(t, fiatKey, actual.FiatProviders[0].APIKey, "failed to load fiat API Key.")
+				require.Empty(t, actual.FiatProviders[1].APIKey, "keyless fiat provider loaded an API Key.")
+
+				require.Len(t, actual.CryptoProviders, 2, "failed to load crypto providers.")
+				require.Equal(t, providerCoinAPI, actual.CryptoProviders[0].Provider, "crypto provider order mismatch.")
+				require.Equal(t, providerCoinbase, actual.CryptoProviders[1].Provider, "crypto provider order mismatch.")
+				require.Equal(t, cryptoKey, actual.CryptoProviders[0].APIKey, "failed to load crypto API Key.")
+				require.Empty(t, actual.CryptoProviders[1].APIKey, "keyless crypto provider loaded an API Key.")
+			}
 
-			require.Equal(t, apiKeyCrypto, actual.CryptoCurrency.APIKey, "failed to load crypto API Key.")
-			require.Equal(t, headerKeyCrypto, actual.CryptoCurrency.HeaderKey, "failed to load crypto Header Key.")
-			require.Equal(t, apiEndpointCrypto, actual.CryptoCurrency.Endpoint, "failed to load crypto API endpoint.")
+			require.Equal(t, time.Minute, actual.Cache.FiatFreshness, "failed to load Fiat freshness window.")
+			require.Equal(t, 96*time.Hour, actual.Cache.FiatMaxAge, "failed to load Fiat maximum age.")
+			require.Equal(t, 15*time.Second, actual.Cache.CryptoFreshness, "failed to load Crypto freshness window.")
+			require.Equal(t, 2*time.Minute, actual.Cache.CryptoMaxAge, "failed to load Crypto maximum age.")
 
 			require.Equal(t, timeout, actual.Connection.Timeout, "failed to load timeout.")
 			require.Equal(t, userAgent, actual.Connection.UserAgent, "failed to load user-agent.")
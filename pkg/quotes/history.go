@@ -0,0 +1,66 @@
+package quotes
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/events"
+	"go.uber.org/zap"
+)
+
+// recordRate will record a price quote fetched from a provider in the rate history and publish it to the currency pair's
+// rate ticker. Price quotes without a valid rate or issue time cannot be charted and are not recorded. Failures are
+// logged and will not fail the price quote. Currency codes are recorded in upper case.
+func (q *quotesImpl) recordRate(source, destination, provider string, rate decimal.Decimal, quotedAt time.Time) {
+	if q.db == nil && q.cache == nil {
+		return
+	}
+
+	source, destination = strings.ToUpper(source), strings.ToUpper(destination)
+
+	if !rate.IsPositive() || quotedAt.IsZero() {
+		q.logger.Warn("price quote not recorded in rate history",
+			zap.String("source", source), zap.String("destination", destination), zap.String("provider", provider))
+
+		return
+	}
+
+	q.publishRate(source, destination, rate, quotedAt)
+
+	if q.db == nil {
+		return
+	}
+
+	if err := q.db.RateCreate(source, destination, provider, rate, quotedAt); err != nil {
+		q.logger.Warn("failed to record price quote in rate history",
+			zap.String("source", source), zap.String("destination", destination), zap.String("provider", provider),
+			zap.Error(err))
+	}
+}
+
+// publishRate will publish a fresh price quote to the Redis channel for the currency pair's rate ticker, from which it
+// is fanned out to the subscribers on every instance of the service. Failures are logged.
+func (q *quotesImpl) publishRate(source, destination string, rate decimal.Decimal, quotedAt time.Time) {
+	if q.cache == nil {
+		return
+	}
+
+	message, err := json.Marshal(&events.RateTick{
+		Source:      strings.ToUpper(source),
+		Destination: strings.ToUpper(destination),
+		Rate:        rate,
+		QuotedAt:    quotedAt.UTC(),
+	})
+	if err != nil {
+		q.logger.Warn("failed to serialize rate tick", zap.Error(err))
+
+		return
+	}
+
+	if err = q.cache.Publish(events.RateTickerChannel(source, destination), message); err != nil {
+		q.logger.Warn("failed to publish rate tick",
+			zap.String("source", source), zap.String("destination", destination), zap.Error(err))
+	}
+}
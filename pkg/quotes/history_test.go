@@ -0,0 +1,120 @@
+package quotes
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/events"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestQuotesImpl_RecordRate(t *testing.T) {
+	t.Parallel()
+
+	issued := time.Now().UTC().Truncate(time.Second)
+	rate := decimal.NewFromFloat(1.35)
+
+	testCases := []struct {
+		name        string
+		source      string
+		fiat        *stubFiatProvider
+		crypto      *stubCryptoProvider
+		expectErr   require.ErrorAssertionFunc
+		createErr   error
+		publishErr  error
+		createTimes int
+	}{
+		{
+			name:        "recorded",
+			source:      "USD",
+			fiat:        &stubFiatProvider{quote: models.FiatQuote{Info: models.FiatInfo{Rate: rate, Timestamp: issued.Unix()}}},
+			crypto:      &stubCryptoProvider{quote: models.CryptoQuote{Rate: rate, Time: issued.Format(time.RFC3339Nano)}},
+			expectErr:   require.NoError,
+			createTimes: 2,
+		}, {
+			name:        "lower case currency codes",
+			source:      "usd",
+			fiat:        &stubFiatProvider{quote: models.FiatQuote{Info: models.FiatInfo{Rate: rate, Timestamp: issued.Unix()}}},
+			crypto:      &stubCryptoProvider{quote: models.CryptoQuote{Rate: rate, Time: issued.Format(time.RFC3339Nano)}},
+			expectErr:   require.NoError,
+			createTimes: 2,
+		}, {
+			name:        "database failure",
+			source:      "USD",
+			fiat:        &stubFiatProvider{quote: models.FiatQuote{Info: models.FiatInfo{Rate: rate, Timestamp: issued.Unix()}}},
+			crypto:      &stubCryptoProvider{quote: models.CryptoQuote{Rate: rate, Time: issued.Format(time.RFC3339Nano)}},
+			expectErr:   require.NoError,
+			createErr:   postgres.ErrCreateRate,
+			publishErr:  redis.ErrPubSub,
+			createTimes: 2,
+		}, {
+			name:        "invalid rate and time",
+			source:      "USD",
+			fiat:        &stubFiatProvider{quote: models.FiatQuote{Info: models.FiatInfo{Timestamp: issued.Unix()}}},
+			crypto:      &stubCryptoProvider{quote: models.CryptoQuote{Rate: rate, Time: "invalid"}},
+			expectErr:   require.NoError,
+			createTimes: 0,
+		}, {
+			name:        "unavailable",
+			source:      "USD",
+			fiat:        &stubFiatProvider{err: errProviderUnavailable},
+			crypto:      &stubCryptoProvider{err: errProviderUnavailable},
+			expectErr:   require.Error,
+			createTimes: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockDB.EXPECT().RateCreate("USD", gomock.Any(), "stub", rate, issued).
+				Do(func(_, destination, _ string, _ decimal.Decimal, _ time.Time) {
+					require.Equal(t, strings.ToUpper(destination), destination, "destination not upper case.")
+				}).
+				Return(test.createErr).
+				Times(test.createTimes)
+
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockCache.EXPECT().Publish(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(channel string, message []byte) error {
+					var tick events.RateTick
+					require.NoError(t, json.Unmarshal(message, &tick), "failed to unmarshal rate tick.")
+					require.Equal(t, events.RateTickerChannel(tick.Source, tick.Destination), channel,
+						"rate ticker channel mismatch.")
+					require.True(t, rate.Equal(tick.Rate), "rate tick rate mismatch.")
+					require.True(t, issued.Equal(tick.QuotedAt), "rate tick time mismatch.")
+
+					return test.publishErr
+				}).
+				Times(test.createTimes)
+
+			impl := &quotesImpl{
+				fiatProviders:   []fiatProvider{test.fiat},
+				cryptoProviders: []cryptoProvider{test.crypto},
+				db:              mockDB,
+				cache:           mockCache,
+				conf:            &config{Cache: testCacheConfig},
+				logger:          zapLogger,
+			}
+
+			_, err := impl.fiatProviderQuote(test.source, "cad", decimal.NewFromFloat(10))
+			test.expectErr(t, err, "Fiat price quote error expectation failed.")
+
+			_, err = impl.cryptoProviderQuote(test.source, "btc")
+			test.expectErr(t, err, "Crypto price quote error expectation failed.")
+		})
+	}
+}
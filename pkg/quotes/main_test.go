@@ -27,6 +27,13 @@ var zapLogger *logger.Logger
 // quotes is used test wide to access third-party currency pricing services.
 var quotes Quotes
 
+// liveQuotes indicates whether the test suite is using the live price quote providers. The sample offline rate table is
+// used when running short tests or when the provider API keys are unavailable.
+var liveQuotes bool
+
+// offlineTestRates is the sample offline rate table used when the live price quote providers are unavailable.
+const offlineTestRates = "../../configs/QuoteRates.yaml"
+
 func TestMain(m *testing.M) {
 	// Parse commandline flags to check for short tests.
 	flag.Parse()
@@ -45,23 +52,17 @@ func TestMain(m *testing.M) {
 	}
 
 	// Configure Quotes.
-	clientFiat, err := configFiatClient(testConfigs)
+	fiatProviders, cryptoProviders, err := testProviders()
 	if err != nil {
-		zapLogger.Error("Failed to configure Fiat client", zap.Error(err))
-		os.Exit(1)
-	}
-
-	clientCrypto, err := configCryptoClient(testConfigs)
-	if err != nil {
-		zapLogger.Error("Failed to configure Fiat client", zap.Error(err))
+		zapLogger.Error("Failed to configure price quote providers", zap.Error(err))
 		os.Exit(1)
 	}
 
 	quotes = &quotesImpl{
-		clientFiat:   clientFiat,
-		clientCrypto: clientCrypto,
-		conf:         testConfigs,
-		logger:       zapLogger,
+		fiatProviders:   fiatProviders,
+		cryptoProviders: cryptoProviders,
+		conf:            testConfigs,
+		logger:          zapLogger,
 	}
 
 	// Run test suite.
@@ -76,14 +77,9 @@ func TestMain(m *testing.M) {
 	os.Exit(exitCode)
 }
 
-// setup will configure the connection to the test database.
+// setup will load the test configurations and the API keys for the live price quote providers. The offline rate table
+// will be used if the tests are short or the API keys are unavailable on a development environment.
 func setup() error {
-	if testing.Short() {
-		zapLogger.Warn("Short test: Skipping Quotes integration tests")
-
-		return nil
-	}
-
 	// Configure in memory file system to load configs.
 	fs := afero.NewMemMapFs()
 	if err := fs.MkdirAll(constants.EtcDir(), 0644); err != nil {
@@ -104,45 +100,82 @@ func setup() error {
 		return fmt.Errorf("failed to load test configs %w", err)
 	}
 
+	if testing.Short() {
+		zapLogger.Warn("Short test: Skipping Quotes integration tests, using the offline rate table")
+
+		return nil
+	}
+
 	// If running on a GitHub Actions runner, use the secret stored in the GitHub Actions Secrets.
 	if _, ok := os.LookupEnv(constants.GithubCIKey()); ok {
 		zapLogger.Info("Integration Test running on Github CI runner.")
 		zapLogger.Warn("*** Please ensure that the Quotes configurations are upto date in GHA Secrets ***")
 
-		// Remove Fiat currency API Key from environment variables.
-		if err := os.Unsetenv(constants.QuotesPrefix() + "_FIATCURRENCY.APIKEY"); err != nil {
+		// Load the primary Fiat currency provider's API Key from environment variables.
+		fiatKey, ok := os.LookupEnv(providerAPIKeyEnv(testConfigs.FiatProviders[0].Provider))
+		if !ok {
 			msg := "failed to load  Fiat currency API Key from GitHub Actions Secrets"
 			zapLogger.Error(msg)
 
 			return errors.New(msg)
 		}
 
-		// Remove Cryptocurrency API Key from environment variables.
-		if err := os.Unsetenv(constants.QuotesPrefix() + "_CRYPTOCURRENCY.APIKEY"); err != nil {
+		// Load the primary Cryptocurrency provider's API Key from environment variables.
+		cryptoKey, ok := os.LookupEnv(providerAPIKeyEnv(testConfigs.CryptoProviders[0].Provider))
+		if !ok {
 			msg := "failed to load Cryptocurrency API Key from GitHub Actions Secrets"
 			zapLogger.Error(msg)
 
 			return errors.New(msg)
 		}
+
+		testConfigs.FiatProviders[0].APIKey = fiatKey
+		testConfigs.CryptoProviders[0].APIKey = cryptoKey
 	} else {
 		zapLogger.Info("Tests are running on local development environment.")
 		zapLogger.Warn("*** Please ensure that the Quotes configurations are upto date ***")
 
 		creds, err := readDevAPICredentials()
 		if err != nil {
-			msg := "failed to read credentials for development environment"
-			zapLogger.Error(msg)
+			zapLogger.Warn("Failed to read credentials for development environment, using the offline rate table")
 
-			return errors.New(msg)
+			return nil
 		}
 
-		testConfigs.FiatCurrency.APIKey = creds.Fiat
-		testConfigs.CryptoCurrency.APIKey = creds.Crypto
+		testConfigs.FiatProviders[0].APIKey = creds.Fiat
+		testConfigs.CryptoProviders[0].APIKey = creds.Crypto
 	}
 
+	liveQuotes = true
+
 	return nil
 }
 
+// testProviders will configure the live price quote providers, or the offline rate table if the live providers are not
+// available to the test suite.
+func testProviders() ([]fiatProvider, []cryptoProvider, error) {
+	if !liveQuotes {
+		offline, err := newOfflineProvider(afero.NewOsFs(), &offlineConfig{Enabled: true, Rates: offlineTestRates})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return []fiatProvider{offline}, []cryptoProvider{offline}, nil
+	}
+
+	fiatProviders, err := configFiatProviders(testConfigs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to configure Fiat providers %w", err)
+	}
+
+	cryptoProviders, err := configCryptoProviders(testConfigs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to configure Crypto providers %w", err)
+	}
+
+	return fiatProviders, cryptoProviders, nil
+}
+
 // tearDown will delete the test clusters keyspace.
 func tearDown() error {
 	return nil
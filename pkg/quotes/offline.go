@@ -0,0 +1,242 @@
+package quotes
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/spf13/afero"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/models"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// offlineRatePrecision is the number of decimal places retained for drifting offline rates.
+	offlineRatePrecision = int32(12)
+
+	// offlineRateFiat and offlineRateCrypto are the rate types in a CSV rate table.
+	offlineRateFiat   = "fiat"
+	offlineRateCrypto = "crypto"
+)
+
+// offlineRate is a currency pair's exchange rate in the offline rate table.
+type offlineRate struct {
+	Source      string `json:"source"      yaml:"source"`
+	Destination string `json:"destination" yaml:"destination"`
+	Rate        string `json:"rate"        yaml:"rate"`
+}
+
+// offlineRateTable is the offline rate table as stored in a YAML file.
+type offlineRateTable struct {
+	Fiat   []offlineRate `json:"fiat"   yaml:"fiat"`
+	Crypto []offlineRate `json:"crypto" yaml:"crypto"`
+}
+
+// offlineProvider serves Fiat and Cryptocurrency price quotes from a rate table stored on disk. The rates will drift
+// using a random walk on each request if a drift is configured.
+type offlineProvider struct {
+	mutex  sync.Mutex
+	drift  float64
+	fiat   map[string]decimal.Decimal
+	crypto map[string]decimal.Decimal
+}
+
+// newOfflineProvider will load the offline rate table from disk.
+func newOfflineProvider(fs afero.Fs, conf *offlineConfig) (*offlineProvider, error) {
+	var (
+		err      error
+		rawTable []byte
+		table    offlineRateTable
+		path     = offlineRatesPath(fs, conf.Rates)
+	)
+
+	if rawTable, err = afero.ReadFile(fs, path); err != nil {
+		return nil, fmt.Errorf("failed to read offline rate table %s: %w", path, err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(rawTable, &table)
+	case ".csv":
+		table, err = parseOfflineRatesCSV(rawTable)
+	default:
+		err = errors.New("unsupported file format, expected YAML or CSV")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse offline rate table %s: %w", path, err)
+	}
+
+	provider := &offlineProvider{drift: conf.Drift}
+
+	if provider.fiat, err = offlineRateMap(table.Fiat); err != nil {
+		return nil, fmt.Errorf("invalid Fiat currency rate in offline rate table: %w", err)
+	}
+
+	if provider.crypto, err = offlineRateMap(table.Crypto); err != nil {
+		return nil, fmt.Errorf("invalid Cryptocurrency rate in offline rate table: %w", err)
+	}
+
+	return provider, nil
+}
+
+// offlineRatesPath will locate the offline rate table. Relative paths are searched for in the configuration directories
+// in the same order as the configuration files.
+func offlineRatesPath(fs afero.Fs, rates string) string {
+	if filepath.IsAbs(rates) {
+		return rates
+	}
+
+	for _, dir := range []string{constants.EtcDir(), os.ExpandEnv(constants.HomeDir()), constants.BaseDir()} {
+		if exists, _ := afero.Exists(fs, filepath.Join(dir, rates)); exists {
+			return filepath.Join(dir, rates)
+		}
+	}
+
+	return rates
+}
+
+// parseOfflineRatesCSV will parse a CSV offline rate table. The table must have a header row with the type, source,
+// destination, and rate columns. The type is either fiat or crypto.
+func parseOfflineRatesCSV(rawTable []byte) (offlineRateTable, error) {
+	var (
+		table  offlineRateTable
+		reader = csv.NewReader(bytes.NewReader(rawTable))
+	)
+
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+
+	// Skip the header row.
+	if _, err := reader.Read(); err != nil {
+		return table, fmt.Errorf("failed to read header row: %w", err)
+	}
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return table, fmt.Errorf("%w", err)
+		}
+
+		rate := offlineRate{Source: record[1], Destination: record[2], Rate: record[3]}
+
+		switch strings.ToLower(record[0]) {
+		case offlineRateFiat:
+			table.Fiat = append(table.Fiat, rate)
+		case offlineRateCrypto:
+			table.Crypto = append(table.Crypto, rate)
+		default:
+			return table, fmt.Errorf("invalid rate type %s", record[0])
+		}
+	}
+
+	return table, nil
+}
+
+// offlineRateMap will convert a list of offline rates into a map of currency pairs to rates.
+func offlineRateMap(rates []offlineRate) (map[string]decimal.Decimal, error) {
+	rateMap := make(map[string]decimal.Decimal, len(rates))
+
+	for _, entry := range rates {
+		rate, err := decimal.NewFromString(entry.Rate)
+		if err != nil || !rate.IsPositive() || len(entry.Source) == 0 || len(entry.Destination) == 0 {
+			return nil, fmt.Errorf("%s to %s at %s", entry.Source, entry.Destination, entry.Rate)
+		}
+
+		rateMap[offlinePair(entry.Source, entry.Destination)] = rate
+	}
+
+	return rateMap, nil
+}
+
+// offlinePair will generate the key for a currency pair in the offline rate table.
+func offlinePair(source, destination string) string {
+	return strings.ToUpper(source) + "-" + strings.ToUpper(destination)
+}
+
+// rate will retrieve the rate for a currency pair, drifting it if configured. A pair that is only listed in the reverse
+// direction is served using the inverse of its rate.
+func (p *offlineProvider) rate(rates map[string]decimal.Decimal, source, destination string) (decimal.Decimal, bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if pair := offlinePair(source, destination); p.walk(rates, pair) {
+		return rates[pair], true
+	}
+
+	if pair := offlinePair(destination, source); p.walk(rates, pair) {
+		return decimal.NewFromInt(1).Div(rates[pair]), true
+	}
+
+	return decimal.Decimal{}, false
+}
+
+// walk will take a random step, bounded by the drift, from a currency pair's current rate. It reports whether the pair
+// is in the rate table.
+func (p *offlineProvider) walk(rates map[string]decimal.Decimal, pair string) bool {
+	rate, ok := rates[pair]
+	if !ok {
+		return false
+	}
+
+	if p.drift > 0 {
+		step := decimal.NewFromFloat(1 + (2*rand.Float64()-1)*p.drift) //nolint:gosec // simulated drift only.
+		rates[pair] = rate.Mul(step).Round(offlineRatePrecision)
+	}
+
+	return true
+}
+
+// name is the name of the provider's adapter.
+func (p *offlineProvider) name() string {
+	return providerOffline
+}
+
+// fiatQuote will retrieve a quote for a Fiat currency price from the offline rate table.
+func (p *offlineProvider) fiatQuote(source, destination string, sourceAmount decimal.Decimal) (
+	models.FiatQuote, error) {
+	rate, ok := p.rate(p.fiat, source, destination)
+	if !ok {
+		return models.FiatQuote{}, NewError("invalid Fiat currency code").SetStatus(http.StatusBadRequest)
+	}
+
+	now := time.Now().UTC()
+
+	return models.FiatQuote{
+		Info:    models.FiatInfo{Rate: rate, Timestamp: now.Unix()},
+		Query:   models.FiatQuery{From: source, To: destination, Amount: sourceAmount},
+		Date:    now.Format(time.DateOnly),
+		Result:  rate.Mul(sourceAmount).RoundBank(constants.DecimalPlacesFiat()),
+		Success: true,
+	}, nil
+}
+
+// cryptoQuote will retrieve a quote for a Cryptocurrency price from the offline rate table.
+func (p *offlineProvider) cryptoQuote(source, destination string) (models.CryptoQuote, error) {
+	rate, ok := p.rate(p.crypto, source, destination)
+	if !ok {
+		return models.CryptoQuote{}, NewError("invalid Crypto currency code").SetStatus(http.StatusBadRequest)
+	}
+
+	return models.CryptoQuote{
+		BaseCurrency:  source,
+		QuoteCurrency: destination,
+		Time:          time.Now().UTC().Format(time.RFC3339Nano),
+		Rate:          rate,
+	}, nil
+}
@@ -0,0 +1,241 @@
+package quotes
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+)
+
+// offlineRatesData is a map of offline rate table test data.
+var offlineRatesData = offlineRatesTestData()
+
+func TestOffline_NewOfflineProvider(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		fileName  string
+		input     string
+		expectErr require.ErrorAssertionFunc
+	}{
+		{
+			name:      "valid yaml",
+			fileName:  "rates.yaml",
+			input:     offlineRatesData["valid yaml"],
+			expectErr: require.NoError,
+		}, {
+			name:      "valid csv",
+			fileName:  "rates.csv",
+			input:     offlineRatesData["valid csv"],
+			expectErr: require.NoError,
+		}, {
+			name:      "invalid yaml rate",
+			fileName:  "rates.yml",
+			input:     offlineRatesData["invalid yaml rate"],
+			expectErr: require.Error,
+		}, {
+			name:      "invalid yaml",
+			fileName:  "rates.yaml",
+			input:     offlineRatesData["invalid yaml"],
+			expectErr: require.Error,
+		}, {
+			name:      "invalid csv type",
+			fileName:  "rates.csv",
+			input:     offlineRatesData["invalid csv type"],
+			expectErr: require.Error,
+		}, {
+			name:      "invalid csv columns",
+			fileName:  "rates.csv",
+			input:     offlineRatesData["invalid csv columns"],
+			expectErr: require.Error,
+		}, {
+			name:      "invalid csv rate",
+			fileName:  "rates.csv",
+			input:     offlineRatesData["invalid csv rate"],
+			expectErr: require.Error,
+		}, {
+			name:      "empty csv",
+			fileName:  "rates.csv",
+			input:     "",
+			expectErr: require.Error,
+		}, {
+			name:      "unsupported format",
+			fileName:  "rates.json",
+			input:     `{"fiat":[]}`,
+			expectErr: require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			fs := afero.NewMemMapFs()
+			require.NoError(t, fs.MkdirAll(constants.EtcDir(), 0644), "failed to create in memory directory.")
+			require.NoError(t, afero.WriteFile(fs, constants.EtcDir()+test.fileName, []byte(test.input), 0644),
+				"failed to write in memory file.")
+
+			provider, err := newOfflineProvider(fs, &offlineConfig{Enabled: true, Rates: test.fileName})
+			test.expectErr(t, err, "error expectation failed.")
+
+			if err != nil {
+				require.Nil(t, provider, "failure should return nil provider.")
+
+				return
+			}
+
+			require.Len(t, provider.fiat, 1, "failed to load Fiat rates.")
+			require.Len(t, provider.crypto, 1, "failed to load Crypto rates.")
+		})
+	}
+}
+
+func TestOffline_NewOfflineProvider_NotFound(t *testing.T) {
+	t.Parallel()
+
+	provider, err := newOfflineProvider(afero.NewMemMapFs(), &offlineConfig{Enabled: true, Rates: "rates.yaml"})
+	require.Error(t, err, "missing rate table should fail.")
+	require.Nil(t, provider, "failure should return nil provider.")
+}
+
+func TestOffline_RatesPath(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, filepath.Join(constants.BaseDir(), "base.yaml"), []byte{}, 0644),
+		"failed to write in memory file.")
+	require.NoError(t, afero.WriteFile(fs, filepath.Join(constants.EtcDir(), "etc.yaml"), []byte{}, 0644),
+		"failed to write in memory file.")
+
+	require.Equal(t, filepath.Join(constants.EtcDir(), "etc.yaml"), offlineRatesPath(fs, "etc.yaml"),
+		"etc directory not searched.")
+	require.Equal(t, filepath.Join(constants.BaseDir(), "base.yaml"), offlineRatesPath(fs, "base.yaml"),
+		"base directory not searched.")
+	require.Equal(t, "/absolute/rates.yaml", offlineRatesPath(fs, "/absolute/rates.yaml"),
+		"absolute path modified.")
+	require.Equal(t, "missing.yaml", offlineRatesPath(fs, "missing.yaml"), "missing path modified.")
+}
+
+func TestOffline_FiatQuote(t *testing.T) {
+	t.Parallel()
+
+	provider := &offlineProvider{
+		fiat: map[string]decimal.Decimal{offlinePair("USD", "CAD"): decimal.NewFromFloat(1.25)},
+	}
+	amount := decimal.NewFromFloat(100)
+
+	result, err := provider.fiatQuote("usd", "cad", amount)
+	require.NoError(t, err, "failed to retrieve quote.")
+	require.True(t, result.Success, "quote not marked as successful.")
+	require.True(t, decimal.NewFromFloat(1.25).Equal(result.Info.Rate), "rate mismatch.")
+	require.True(t, decimal.NewFromFloat(125).Equal(result.Result), "converted amount mismatch.")
+	require.Positive(t, result.Info.Timestamp, "timestamp not set.")
+
+	result, err = provider.fiatQuote("CAD", "USD", amount)
+	require.NoError(t, err, "failed to retrieve inverse quote.")
+	require.True(t, decimal.NewFromFloat(0.8).Equal(result.Info.Rate), "inverse rate mismatch.")
+	require.True(t, decimal.NewFromFloat(80).Equal(result.Result), "inverse converted amount mismatch.")
+
+	_, err = provider.fiatQuote("USD", "EUR", amount)
+	require.ErrorIs(t, err, NewError("").SetStatus(http.StatusBadRequest), "unknown pair should be a bad request.")
+}
+
+func TestOffline_CryptoQuote(t *testing.T) {
+	t.Parallel()
+
+	provider := &offlineProvider{
+		crypto: map[string]decimal.Decimal{offlinePair("BTC", "USD"): decimal.NewFromFloat(20000)},
+	}
+
+	result, err := provider.cryptoQuote("BTC", "USD")
+	require.NoError(t, err, "failed to retrieve quote.")
+	require.Equal(t, "BTC", result.BaseCurrency, "base currency mismatch.")
+	require.Equal(t, "USD", result.QuoteCurrency, "quote currency mismatch.")
+	require.True(t, decimal.NewFromFloat(20000).Equal(result.Rate), "rate mismatch.")
+	require.False(t, cryptoQuoteTime(&result).IsZero(), "time not set.")
+
+	result, err = provider.cryptoQuote("USD", "BTC")
+	require.NoError(t, err, "failed to retrieve inverse quote.")
+	require.True(t, decimal.NewFromFloat(0.00005).Equal(result.Rate), "inverse rate mismatch.")
+
+	_, err = provider.cryptoQuote("ETH", "USD")
+	require.ErrorIs(t, err, NewError("").SetStatus(http.StatusBadRequest), "unknown pair should be a bad request.")
+}
+
+func TestOffline_Drift(t *testing.T) {
+	t.Parallel()
+
+	var (
+		drift    = 0.01
+		initial  = decimal.NewFromFloat(100)
+		provider = &offlineProvider{
+			drift:  drift,
+			crypto: map[string]decimal.Decimal{offlinePair("BTC", "USD"): initial},
+		}
+		previous = initial
+		moved    bool
+	)
+
+	for idx := 0; idx < 100; idx++ {
+		result, err := provider.cryptoQuote("BTC", "USD")
+		require.NoError(t, err, "failed to retrieve quote.")
+
+		step := result.Rate.Div(previous).Sub(decimal.NewFromInt(1)).Abs()
+		require.True(t, step.LessThanOrEqual(decimal.NewFromFloat(drift)), "drift step exceeds bound: %s", step)
+
+		moved = moved || !result.Rate.Equal(previous)
+		previous = result.Rate
+	}
+
+	require.True(t, moved, "rate did not drift.")
+}
+
+func TestQuotesImpl_New_Offline(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, fs.MkdirAll(constants.EtcDir(), 0644), "failed to create in memory directory.")
+	require.NoError(t, afero.WriteFile(fs, constants.EtcDir()+constants.QuotesFileName(),
+		[]byte(quotesConfigTestData["valid offline"]), 0644), "failed to write in memory file.")
+
+	// Rate table is missing.
+	impl, err := newQuotesImpl(&fs, nil, nil, zapLogger)
+	require.Error(t, err, "missing rate table should fail.")
+	require.Nil(t, impl, "failure should return nil implementation.")
+
+	require.NoError(t, afero.WriteFile(fs, constants.EtcDir()+"QuoteRates.yaml",
+		[]byte(offlineRatesData["valid yaml"]), 0644), "failed to write in memory file.")
+
+	impl, err = newQuotesImpl(&fs, nil, nil, zapLogger)
+	require.NoError(t, err, "failed to configure offline quotes.")
+	require.Len(t, impl.fiatProviders, 1, "offline Fiat provider not configured.")
+	require.Len(t, impl.cryptoProviders, 1, "offline Crypto provider not configured.")
+	require.Equal(t, providerOffline, impl.fiatProviders[0].name(), "offline Fiat provider not configured.")
+
+	rate, amount, err := impl.FiatConversion("USD", "CAD", decimal.NewFromFloat(100), nil)
+	require.NoError(t, err, "failed to convert Fiat currency offline.")
+	require.True(t, rate.IsPositive(), "invalid Fiat rate.")
+	require.True(t, amount.IsPositive(), "invalid Fiat amount.")
+
+	rate, amount, err = impl.CryptoConversion("USD", "BTC", decimal.NewFromFloat(100), true, nil)
+	require.NoError(t, err, "failed to convert Cryptocurrency offline.")
+	require.True(t, rate.IsPositive(), "invalid Crypto rate.")
+	require.True(t, amount.IsPositive(), "invalid Crypto amount.")
+}
+
+func TestOffline_SampleRateTable(t *testing.T) {
+	t.Parallel()
+
+	provider, err := newOfflineProvider(afero.NewOsFs(),
+		&offlineConfig{Enabled: true, Rates: "../../configs/QuoteRates.yaml"})
+	require.NoError(t, err, "failed to load sample rate table.")
+	require.NotEmpty(t, provider.fiat, "no Fiat rates in sample rate table.")
+	require.NotEmpty(t, provider.crypto, "no Crypto rates in sample rate table.")
+}
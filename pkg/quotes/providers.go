@@ -0,0 +1,298 @@
+package quotes
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/imroc/req/v3"
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/models"
+)
+
+// Names of the supported price quote providers. Each provider has an adapter that converts its responses into the quote
+// models.
+const (
+	providerRapidAPI    = "rapidapi"
+	providerFrankfurter = "frankfurter"
+	providerCoinAPI     = "coinapi"
+	providerCoinbase    = "coinbase"
+	providerOffline     = "offline"
+)
+
+// errProviderUnavailable is returned by a provider that has timed out or responded with a server error. The request will
+// fail over to the next configured provider.
+var errProviderUnavailable = NewError("price quote provider unavailable").SetStatus(http.StatusServiceUnavailable)
+
+// fiatProvider is a Fiat currency price quote service.
+type fiatProvider interface {
+	// name is the name of the provider's adapter.
+	name() string
+
+	// fiatQuote will retrieve a quote for a Fiat currency price.
+	fiatQuote(source, destination string, sourceAmount decimal.Decimal) (models.FiatQuote, error)
+}
+
+// cryptoProvider is a Cryptocurrency price quote service.
+type cryptoProvider interface {
+	// name is the name of the provider's adapter.
+	name() string
+
+	// cryptoQuote will retrieve a quote for a Cryptocurrency price.
+	cryptoQuote(source, destination string) (models.CryptoQuote, error)
+}
+
+// configClient will set up an HTTP client for a price quote provider.
+func configClient(conn connectionConfig, provider providerConfig) *req.Client {
+	client := req.C().
+		SetUserAgent(conn.UserAgent).
+		SetTimeout(conn.Timeout)
+
+	if len(provider.APIKey) > 0 {
+		client.SetCommonHeader(provider.HeaderKey, provider.APIKey)
+	}
+
+	return client
+}
+
+// configFiatProviders will set up the Fiat currency price quote providers in their failover order.
+func configFiatProviders(conf *config) ([]fiatProvider, error) {
+	if conf == nil {
+		return nil, errors.New("configurations not loaded")
+	}
+
+	providers := make([]fiatProvider, len(conf.FiatProviders))
+
+	for idx, provider := range conf.FiatProviders {
+		client := configClient(conf.Connection, provider)
+
+		switch strings.ToLower(provider.Provider) {
+		case providerRapidAPI:
+			providers[idx] = &rapidAPIProvider{client: client, endpoint: provider.Endpoint}
+		case providerFrankfurter:
+			providers[idx] = &frankfurterProvider{client: client, endpoint: provider.Endpoint}
+		default:
+			return nil, fmt.Errorf("unsupported Fiat currency price quote provider %s", provider.Provider)
+		}
+	}
+
+	return providers, nil
+}
+
+// configCryptoProviders will set up the Cryptocurrency price quote providers in their failover order.
+func configCryptoProviders(conf *config) ([]cryptoProvider, error) {
+	if conf == nil {
+		return nil, errors.New("configurations not loaded")
+	}
+
+	providers := make([]cryptoProvider, len(conf.CryptoProviders))
+
+	for idx, provider := range conf.CryptoProviders {
+		client := configClient(conf.Connection, provider)
+
+		switch strings.ToLower(provider.Provider) {
+		case providerCoinAPI:
+			providers[idx] = &coinAPIProvider{client: client, endpoint: provider.Endpoint}
+		case providerCoinbase:
+			providers[idx] = &coinbaseProvider{client: client, endpoint: provider.Endpoint}
+		default:
+			return nil, fmt.Errorf("unsupported Cryptocurrency price quote provider %s", provider.Provider)
+		}
+	}
+
+	return providers, nil
+}
+
+// isServerError will check whether a response is a server error that warrants failing over to the next provider.
+func isServerError(resp *req.Response) bool {
+	return resp.StatusCode >= http.StatusInternalServerError
+}
+
+// rapidAPIProvider is the Currency Conversion and Exchange Rates API available through RapidAPI.
+type rapidAPIProvider struct {
+	client   *req.Client
+	endpoint string
+}
+
+// name is the name of the provider's adapter.
+func (p *rapidAPIProvider) name() string {
+	return providerRapidAPI
+}
+
+// fiatQuote will retrieve a quote for a Fiat currency price. The response is already in the Fiat quote format.
+func (p *rapidAPIProvider) fiatQuote(source, destination string, sourceAmount decimal.Decimal) (
+	models.FiatQuote, error) {
+	result := models.FiatQuote{}
+
+	resp, err := p.client.R().
+		SetQueryParam("from", source).
+		SetQueryParam("to", destination).
+		SetQueryParam("amount", sourceAmount.String()).
+		SetSuccessResult(&result).
+		Get(p.endpoint)
+
+	// Failed to query endpoint for price.
+	if err != nil || isServerError(resp) {
+		return result, errProviderUnavailable
+	}
+
+	// Check for a successful rate retrieval.
+	if !result.Success {
+		return result, NewError("invalid Fiat currency code").SetStatus(http.StatusBadRequest)
+	}
+
+	return result, nil
+}
+
+// frankfurterProvider is the Frankfurter API which publishes the European Central Bank's reference rates.
+type frankfurterProvider struct {
+	client   *req.Client
+	endpoint string
+}
+
+// frankfurterQuote is the quote returned from the Frankfurter API.
+type frankfurterQuote struct {
+	Base  string                     `json:"base"`
+	Date  string                     `json:"date"`
+	Rates map[string]decimal.Decimal `json:"rates"`
+}
+
+// name is the name of the provider's adapter.
+func (p *frankfurterProvider) name() string {
+	return providerFrankfurter
+}
+
+// fiatQuote will retrieve a quote for a Fiat currency price. The rate for a single unit of the source currency is
+// requested and the converted amount is calculated from it.
+func (p *frankfurterProvider) fiatQuote(source, destination string, sourceAmount decimal.Decimal) (
+	models.FiatQuote, error) {
+	var (
+		quote  = frankfurterQuote{}
+		result = models.FiatQuote{}
+	)
+
+	resp, err := p.client.R().
+		SetQueryParam("from", source).
+		SetQueryParam("to", destination).
+		SetSuccessResult(&quote).
+		Get(p.endpoint)
+
+	// Failed to query endpoint for price.
+	if err != nil || isServerError(resp) {
+		return result, errProviderUnavailable
+	}
+
+	rate, ok := quote.Rates[strings.ToUpper(destination)]
+	if !resp.IsSuccessState() || !ok {
+		return result, NewError("invalid Fiat currency code").SetStatus(http.StatusBadRequest)
+	}
+
+	timestamp := time.Now().UTC()
+	if date, err := time.Parse(time.DateOnly, quote.Date); err == nil {
+		timestamp = date
+	}
+
+	result.Info = models.FiatInfo{Rate: rate, Timestamp: timestamp.Unix()}
+	result.Query = models.FiatQuery{From: source, To: destination, Amount: sourceAmount}
+	result.Date = quote.Date
+	result.Result = rate.Mul(sourceAmount).RoundBank(constants.DecimalPlacesFiat())
+	result.Success = true
+
+	return result, nil
+}
+
+// coinAPIProvider is the CoinAPI exchange rate API.
+type coinAPIProvider struct {
+	client   *req.Client
+	endpoint string
+}
+
+// name is the name of the provider's adapter.
+func (p *coinAPIProvider) name() string {
+	return providerCoinAPI
+}
+
+// cryptoQuote will retrieve a quote for a Cryptocurrency price. The response is already in the Crypto quote format.
+func (p *coinAPIProvider) cryptoQuote(source, destination string) (models.CryptoQuote, error) {
+	result := models.CryptoQuote{}
+
+	resp, err := p.client.R().
+		SetPathParam("base_symbol", source).
+		SetPathParam("quote_symbol", destination).
+		SetSuccessResult(&result).
+		Get(p.endpoint)
+
+	// Failed to query endpoint for price.
+	if err != nil {
+		return result, errProviderUnavailable
+	}
+
+	if !resp.IsSuccessState() {
+		// Invalid cryptocurrency codes.
+		if resp.StatusCode == 550 { //nolint:mnd,gomnd
+			return result, NewError("invalid Crypto currency code").SetStatus(http.StatusBadRequest)
+		}
+
+		if isServerError(resp) {
+			return result, errProviderUnavailable
+		}
+
+		// Other API related errors are returned as an internal server error to user.
+		return result, NewError(constants.RetryMessageString()).SetStatus(http.StatusInternalServerError)
+	}
+
+	return result, nil
+}
+
+// coinbaseProvider is the Coinbase spot price API.
+type coinbaseProvider struct {
+	client   *req.Client
+	endpoint string
+}
+
+// coinbaseQuote is the quote returned from the Coinbase spot price API.
+type coinbaseQuote struct {
+	Data struct {
+		Amount   decimal.Decimal `json:"amount"`
+		Base     string          `json:"base"`
+		Currency string          `json:"currency"`
+	} `json:"data"`
+}
+
+// name is the name of the provider's adapter.
+func (p *coinbaseProvider) name() string {
+	return providerCoinbase
+}
+
+// cryptoQuote will retrieve a quote for a Cryptocurrency price.
+func (p *coinbaseProvider) cryptoQuote(source, destination string) (models.CryptoQuote, error) {
+	var (
+		quote  = coinbaseQuote{}
+		result = models.CryptoQuote{}
+	)
+
+	resp, err := p.client.R().
+		SetPathParam("base_symbol", source).
+		SetPathParam("quote_symbol", destination).
+		SetSuccessResult(&quote).
+		Get(p.endpoint)
+
+	// Failed to query endpoint for price.
+	if err != nil || isServerError(resp) {
+		return result, errProviderUnavailable
+	}
+
+	if !resp.IsSuccessState() || !quote.Data.Amount.IsPositive() {
+		return result, NewError("invalid Crypto currency code").SetStatus(http.StatusBadRequest)
+	}
+
+	result.BaseCurrency = quote.Data.Base
+	result.QuoteCurrency = quote.Data.Currency
+	result.Time = time.Now().UTC().Format(time.RFC3339Nano)
+	result.Rate = quote.Data.Amount
+
+	return result, nil
+}
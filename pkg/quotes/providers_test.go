@@ -0,0 +1,324 @@
+package quotes
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// testConnection is the HTTP connection configuration used for the provider test servers.
+var testConnection = connectionConfig{UserAgent: "ftex_test", Timeout: 250 * time.Millisecond}
+
+// newTestProviderServer will start a test server that responds with the status code and body provided. A delay can be
+// provided to trigger client timeouts.
+func newTestProviderServer(t *testing.T, status int, body string, delay time.Duration) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		time.Sleep(delay)
+		writer.Header().Set("Content-Type", "application/json")
+		writer.WriteHeader(status)
+		_, _ = fmt.Fprint(writer, body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestProviders_ConfigClient(t *testing.T) {
+	t.Parallel()
+
+	client := configClient(testConnection, providerConfig{Provider: providerFrankfurter, Endpoint: "endpoint"})
+	require.NotNil(t, client, "failed to configure keyless client.")
+	require.Empty(t, client.Headers.Get("X-Test-Key"), "keyless client has API Key header.")
+
+	client = configClient(testConnection,
+		providerConfig{Provider: providerRapidAPI, APIKey: "api-key", HeaderKey: "X-Test-Key", Endpoint: "endpoint"})
+	require.NotNil(t, client, "failed to configure client.")
+	require.Equal(t, "api-key", client.Headers.Get("X-Test-Key"), "API Key header not set.")
+}
+
+func TestProviders_FiatQuote(t *testing.T) {
+	t.Parallel()
+
+	amount := decimal.NewFromFloat(100)
+
+	testCases := []struct {
+		name         string
+		provider     string
+		status       int
+		body         string
+		delay        time.Duration
+		expectErr    error
+		expectResult decimal.Decimal
+	}{
+		{
+			name:         "rapidapi - valid",
+			provider:     providerRapidAPI,
+			status:       http.StatusOK,
+			body:         `{"success":true,"info":{"rate":1.25,"timestamp":1},"result":125}`,
+			expectResult: decimal.NewFromFloat(125),
+		}, {
+			name:      "rapidapi - invalid code",
+			provider:  providerRapidAPI,
+			status:    http.StatusOK,
+			body:      `{"success":false}`,
+			expectErr: NewError("").SetStatus(http.StatusBadRequest),
+		}, {
+			name:      "rapidapi - server error",
+			provider:  providerRapidAPI,
+			status:    http.StatusBadGateway,
+			expectErr: errProviderUnavailable,
+		}, {
+			name:      "rapidapi - timeout",
+			provider:  providerRapidAPI,
+			status:    http.StatusOK,
+			body:      `{"success":true}`,
+			delay:     time.Second,
+			expectErr: errProviderUnavailable,
+		}, {
+			name:         "frankfurter - valid",
+			provider:     providerFrankfurter,
+			status:       http.StatusOK,
+			body:         `{"amount":1.0,"base":"USD","date":"2023-06-01","rates":{"CAD":1.3571}}`,
+			expectResult: decimal.NewFromFloat(135.71),
+		}, {
+			name:      "frankfurter - invalid code",
+			provider:  providerFrankfurter,
+			status:    http.StatusNotFound,
+			body:      `{"message":"not found"}`,
+			expectErr: NewError("").SetStatus(http.StatusBadRequest),
+		}, {
+			name:      "frankfurter - missing rate",
+			provider:  providerFrankfurter,
+			status:    http.StatusOK,
+			body:      `{"amount":1.0,"base":"USD","date":"2023-06-01","rates":{"EUR":0.93}}`,
+			expectErr: NewError("").SetStatus(http.StatusBadRequest),
+		}, {
+			name:      "frankfurter - server error",
+			provider:  providerFrankfurter,
+			status:    http.StatusInternalServerError,
+			expectErr: errProviderUnavailable,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newTestProviderServer(t, test.status, test.body, test.delay)
+			providers, err := configFiatProviders(&config{
+				FiatProviders: []providerConfig{{Provider: test.provider, Endpoint: server.URL}},
+				Connection:    testConnection,
+			})
+			require.NoError(t, err, "failed to configure provider.")
+
+			result, err := providers[0].fiatQuote("USD", "CAD", amount)
+			if test.expectErr != nil {
+				require.ErrorIs(t, err, test.expectErr, "error mismatch.")
+
+				return
+			}
+
+			require.NoError(t, err, "failed to retrieve quote.")
+			require.True(t, result.Success, "quote not marked as successful.")
+			require.True(t, test.expectResult.Equal(result.Result), "converted amount mismatch.")
+			require.True(t, result.Info.Rate.IsPositive(), "rate not set.")
+		})
+	}
+}
+
+func TestProviders_CryptoQuote(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		provider   string
+		status     int
+		body       string
+		delay      time.Duration
+		expectErr  error
+		expectRate decimal.Decimal
+	}{
+		{
+			name:       "coinapi - valid",
+			provider:   providerCoinAPI,
+			status:     http.StatusOK,
+			body:       `{"asset_id_base":"BTC","asset_id_quote":"USD","time":"2023-06-01T00:00:00Z","rate":27000.5}`,
+			expectRate: decimal.NewFromFloat(27000.5),
+		}, {
+			name:      "coinapi - invalid code",
+			provider:  providerCoinAPI,
+			status:    550,
+			expectErr: NewError("").SetStatus(http.StatusBadRequest),
+		}, {
+			name:      "coinapi - server error",
+			provider:  providerCoinAPI,
+			status:    http.StatusServiceUnavailable,
+			expectErr: errProviderUnavailable,
+		}, {
+			name:      "coinapi - client error",
+			provider:  providerCoinAPI,
+			status:    http.StatusUnauthorized,
+			expectErr: NewError("").SetStatus(http.StatusInternalServerError),
+		}, {
+			name:      "coinapi - timeout",
+			provider:  providerCoinAPI,
+			status:    http.StatusOK,
+			delay:     time.Second,
+			expectErr: errProviderUnavailable,
+		}, {
+			name:       "coinbase - valid",
+			provider:   providerCoinbase,
+			status:     http.StatusOK,
+			body:       `{"data":{"amount":"27000.5","base":"BTC","currency":"USD"}}`,
+			expectRate: decimal.NewFromFloat(27000.5),
+		}, {
+			name:      "coinbase - invalid code",
+			provider:  providerCoinbase,
+			status:    http.StatusNotFound,
+			body:      `{"errors":[{"id":"not_found"}]}`,
+			expectErr: NewError("").SetStatus(http.StatusBadRequest),
+		}, {
+			name:      "coinbase - server error",
+			provider:  providerCoinbase,
+			status:    http.StatusInternalServerError,
+			expectErr: errProviderUnavailable,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newTestProviderServer(t, test.status, test.body, test.delay)
+			providers, err := configCryptoProviders(&config{
+				CryptoProviders: []providerConfig{{Provider: test.provider, Endpoint: server.URL}},
+				Connection:      testConnection,
+			})
+			require.NoError(t, err, "failed to configure provider.")
+
+			result, err := providers[0].cryptoQuote("BTC", "USD")
+			if test.expectErr != nil {
+				require.ErrorIs(t, err, test.expectErr, "error mismatch.")
+
+				return
+			}
+
+			require.NoError(t, err, "failed to retrieve quote.")
+			require.Equal(t, "BTC", result.BaseCurrency, "base currency mismatch.")
+			require.Equal(t, "USD", result.QuoteCurrency, "quote currency mismatch.")
+			require.True(t, test.expectRate.Equal(result.Rate), "rate mismatch.")
+		})
+	}
+}
+
+func TestProviders_Failover(t *testing.T) {
+	t.Parallel()
+
+	var (
+		fiatBody   = `{"amount":1.0,"base":"USD","date":"2023-06-01","rates":{"CAD":1.5}}`
+		cryptoBody = `{"data":{"amount":"20000","base":"BTC","currency":"USD"}}`
+	)
+
+	testCases := []struct {
+		name          string
+		primaryStatus int
+		primaryDelay  time.Duration
+		backupStatus  int
+		expectErr     require.ErrorAssertionFunc
+		expectStatus  int
+	}{
+		{
+			name:          "primary available",
+			primaryStatus: http.StatusOK,
+			backupStatus:  http.StatusInternalServerError,
+			expectErr:     require.NoError,
+		}, {
+			name:          "primary server error",
+			primaryStatus: http.StatusServiceUnavailable,
+			backupStatus:  http.StatusOK,
+			expectErr:     require.NoError,
+		}, {
+			name:          "primary timeout",
+			primaryStatus: http.StatusOK,
+			primaryDelay:  time.Second,
+			backupStatus:  http.StatusOK,
+			expectErr:     require.NoError,
+		}, {
+			name:          "primary client error",
+			primaryStatus: http.StatusNotFound,
+			backupStatus:  http.StatusOK,
+			expectErr:     require.Error,
+			expectStatus:  http.StatusBadRequest,
+		}, {
+			name:          "all unavailable",
+			primaryStatus: http.StatusBadGateway,
+			backupStatus:  http.StatusGatewayTimeout,
+			expectErr:     require.Error,
+			expectStatus:  http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			fiatPrimary := newTestProviderServer(t, test.primaryStatus, fiatBody, test.primaryDelay)
+			fiatBackup := newTestProviderServer(t, test.backupStatus, fiatBody, 0)
+			cryptoPrimary := newTestProviderServer(t, test.primaryStatus, cryptoBody, test.primaryDelay)
+			cryptoBackup := newTestProviderServer(t, test.backupStatus, cryptoBody, 0)
+
+			conf := &config{
+				FiatProviders: []providerConfig{
+					{Provider: providerFrankfurter, Endpoint: fiatPrimary.URL},
+					{Provider: providerFrankfurter, Endpoint: fiatBackup.URL},
+				},
+				CryptoProviders: []providerConfig{
+					{Provider: providerCoinbase, Endpoint: cryptoPrimary.URL},
+					{Provider: providerCoinbase, Endpoint: cryptoBackup.URL},
+				},
+				Connection: testConnection,
+			}
+
+			fiatProviders, err := configFiatProviders(conf)
+			require.NoError(t, err, "failed to configure Fiat providers.")
+
+			cryptoProviders, err := configCryptoProviders(conf)
+			require.NoError(t, err, "failed to configure Crypto providers.")
+
+			impl := &quotesImpl{
+				fiatProviders:   fiatProviders,
+				cryptoProviders: cryptoProviders,
+				conf:            conf,
+				logger:          zapLogger,
+			}
+
+			fiat, err := impl.fiatProviderQuote("USD", "CAD", decimal.NewFromFloat(10))
+			test.expectErr(t, err, "Fiat quote error expectation failed.")
+
+			crypto, err := impl.cryptoProviderQuote("BTC", "USD")
+			test.expectErr(t, err, "Crypto quote error expectation failed.")
+
+			if err != nil {
+				require.ErrorIs(t, err, NewError("").SetStatus(test.expectStatus), "error status mismatch.")
+
+				return
+			}
+
+			require.True(t, decimal.NewFromFloat(15).Equal(fiat.Result), "Fiat converted amount mismatch.")
+			require.True(t, decimal.NewFromFloat(20000).Equal(crypto.Rate), "Crypto rate mismatch.")
+		})
+	}
+}
@@ -4,13 +4,15 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
-	"github.com/imroc/req/v3"
 	"github.com/shopspring/decimal"
 	"github.com/spf13/afero"
 	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/logger"
 	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
 	"go.uber.org/zap"
 )
 
@@ -34,6 +36,9 @@ type Quotes interface {
 	CryptoConversion(fiatSymbol, cryptoSymbol string, amount decimal.Decimal, isPurchasingCrypto bool,
 		cryptoQuote func(source, destination string) (models.CryptoQuote, error)) (
 		decimal.Decimal, decimal.Decimal, error)
+
+	// Fee will calculate the fee, in the source currency, to be charged on an amount exchanged between two currencies.
+	Fee(source, destination string, amount decimal.Decimal, precision int32) decimal.Decimal
 }
 
 // Check to ensure the Redis interface has been implemented.
@@ -41,42 +46,64 @@ var _ Quotes = &quotesImpl{}
 
 // quoteImpl implements the Quote interface and contains the logic to interface with currency price services.
 type quotesImpl struct {
-	clientCrypto *req.Client
-	clientFiat   *req.Client
-	conf         *config
-	logger       *logger.Logger
+	cryptoProviders []cryptoProvider
+	fiatProviders   []fiatProvider
+	cache           redis.Redis
+	db              postgres.Postgres
+	conf            *config
+	logger          *logger.Logger
 }
 
-// NewQuote will create a new Quote configuration by loading it.
-func NewQuote(fs *afero.Fs, logger *logger.Logger) (Quotes, error) {
-	if fs == nil || logger == nil {
-		return nil, errors.New("nil file system or logger supplied")
+// NewQuote will create a new Quote configuration by loading it. Every price quote fetched from a provider will be recorded
+// in the database for the rate history.
+func NewQuote(fs *afero.Fs, cache redis.Redis, db postgres.Postgres, logger *logger.Logger) (Quotes, error) {
+	if fs == nil || cache == nil || db == nil || logger == nil {
+		return nil, errors.New("nil file system, cache, database, or logger supplied")
 	}
 
-	return newQuotesImpl(fs, logger)
+	return newQuotesImpl(fs, cache, db, logger)
 }
 
 // newQuoteImpl will create a new quoteImpl configuration and load it from disk.
-func newQuotesImpl(fs *afero.Fs, logger *logger.Logger) (q *quotesImpl, err error) {
-	q = &quotesImpl{conf: newConfig(), logger: logger}
+func newQuotesImpl(fs *afero.Fs, cache redis.Redis, db postgres.Postgres, logger *logger.Logger) (
+	q *quotesImpl, err error) {
+	q = &quotesImpl{cache: cache, db: db, conf: newConfig(), logger: logger}
 	if err = q.conf.Load(*fs); err != nil {
 		q.logger.Error("failed to load Quote configurations from disk", zap.Error(err))
 
 		return nil, err
 	}
 
-	// Fiat Client configuration.
-	q.clientFiat, err = configFiatClient(q.conf)
+	// Offline rate table configuration.
+	if q.conf.Offline.Enabled {
+		var offline *offlineProvider
+		if offline, err = newOfflineProvider(*fs, &q.conf.Offline); err != nil {
+			q.logger.Error("failed to configure offline rate table", zap.Error(err))
+
+			return nil, err
+		}
+
+		q.logger.Warn("price quotes are being served from an offline rate table",
+			zap.String("rates", q.conf.Offline.Rates), zap.Float64("drift", q.conf.Offline.Drift))
+
+		q.fiatProviders = []fiatProvider{offline}
+		q.cryptoProviders = []cryptoProvider{offline}
+
+		return
+	}
+
+	// Fiat providers configuration.
+	q.fiatProviders, err = configFiatProviders(q.conf)
 	if err != nil {
-		q.logger.Error("failed to configure Fiat client", zap.Error(err))
+		q.logger.Error("failed to configure Fiat providers", zap.Error(err))
 
 		return nil, err
 	}
 
-	// Crypto Client configuration.
-	q.clientCrypto, err = configCryptoClient(q.conf)
+	// Crypto providers configuration.
+	q.cryptoProviders, err = configCryptoProviders(q.conf)
 	if err != nil {
-		q.logger.Error("failed to configure Crypto client", zap.Error(err))
+		q.logger.Error("failed to configure Crypto providers", zap.Error(err))
 
 		return nil, err
 	}
@@ -84,56 +111,72 @@ func newQuotesImpl(fs *afero.Fs, logger *logger.Logger) (q *quotesImpl, err erro
 	return
 }
 
-// configFiatClient will setup the global configurations for the Fiat client.
-func configFiatClient(conf *config) (*req.Client, error) {
-	if conf == nil {
-		return nil, errors.New("configurations not loaded")
-	}
+// fiatQuote will retrieve a Fiat currency price quote. Cached price quotes within the freshness window are used in place
+// of a provider request. If the providers are unavailable, a cached price quote within the maximum age will be used.
+func (q *quotesImpl) fiatQuote(source, destination string, sourceAmount decimal.Decimal) (models.FiatQuote, error) {
+	var (
+		cached    models.FiatQuote
+		cacheKey  = quoteCacheKey(constants.QuoteFiatKeyPrefix(), source, destination)
+		isCached  = q.cacheGet(cacheKey, &cached)
+		cachedAge = time.Since(fiatQuoteTime(&cached))
+	)
 
-	return req.C().
-			SetUserAgent(conf.Connection.UserAgent).
-			SetTimeout(conf.Connection.Timeout).
-			SetCommonHeader(conf.FiatCurrency.HeaderKey, conf.FiatCurrency.APIKey),
-		nil
-}
+	if isCached && cachedAge <= q.conf.Cache.FiatFreshness {
+		return fiatQuoteAmount(cached, sourceAmount), nil
+	}
 
-// configCryptoClient will setup the global configurations for the Crypto client.
-func configCryptoClient(conf *config) (*req.Client, error) {
-	if conf == nil {
-		return nil, errors.New("configurations not loaded")
+	quote, err := q.fiatProviderQuote(source, destination, sourceAmount)
+	if err != nil && !errors.Is(err, errProviderUnavailable) {
+		return quote, err
 	}
 
-	return req.C().
-			SetUserAgent(conf.Connection.UserAgent).
-			SetTimeout(conf.Connection.Timeout).
-			SetCommonHeader(conf.CryptoCurrency.HeaderKey, conf.CryptoCurrency.APIKey),
-		nil
-}
+	if err == nil {
+		age := time.Since(fiatQuoteTime(&quote))
+		if age <= q.conf.Cache.FiatMaxAge {
+			q.cacheSet(cacheKey, &quote, q.conf.Cache.FiatMaxAge-age)
 
-// FiatQuote will access the Fiat currency price quote service and get the latest exchange rate.
-func (q *quotesImpl) fiatQuote(source, destination string, sourceAmount decimal.Decimal) (models.FiatQuote, error) {
-	result := models.FiatQuote{}
+			return quote, nil
+		}
 
-	_, err := q.clientFiat.R().
-		SetQueryParam("from", source).
-		SetQueryParam("to", destination).
-		SetQueryParam("amount", sourceAmount.String()).
-		SetSuccessResult(&result).
-		Get(q.conf.FiatCurrency.Endpoint)
+		q.logger.Warn("Fiat currency price quote provider returned a stale rate",
+			zap.String("source", source), zap.String("destination", destination), zap.Duration("age", age))
+	}
 
-	// Failed to query endpoint for price.
-	if err != nil {
-		q.logger.Warn("failed to get Fiat currency price quote", zap.Error(err))
+	if isCached && cachedAge <= q.conf.Cache.FiatMaxAge {
+		q.logger.Warn("using cached Fiat currency price quote",
+			zap.String("source", source), zap.String("destination", destination), zap.Duration("age", cachedAge))
 
-		return result, NewError(constants.RetryMessageString()).SetStatus(http.StatusServiceUnavailable)
+		return fiatQuoteAmount(cached, sourceAmount), nil
 	}
 
-	// Check for a successful rate retrieval.
-	if !result.Success {
-		return result, NewError("invalid Fiat currency code").SetStatus(http.StatusBadRequest)
+	if err != nil {
+		return models.FiatQuote{}, err
+	}
+
+	return models.FiatQuote{}, errStaleQuote
+}
+
+// fiatProviderQuote will access the Fiat currency price quote providers, in order, and get the latest exchange rate. The
+// request will fail over to the next provider if a provider times out or responds with a server error.
+func (q *quotesImpl) fiatProviderQuote(source, destination string, sourceAmount decimal.Decimal) (
+	models.FiatQuote, error) {
+	for _, provider := range q.fiatProviders {
+		result, err := provider.fiatQuote(source, destination, sourceAmount)
+		if err == nil {
+			q.recordRate(source, destination, provider.name(), result.Info.Rate, fiatQuoteTime(&result))
+		}
+
+		if !errors.Is(err, errProviderUnavailable) {
+			return result, err
+		}
+
+		q.logger.Warn("failed to get Fiat currency price quote, failing over to next provider",
+			zap.String("provider", provider.name()), zap.Error(err))
 	}
 
-	return result, nil
+	q.logger.Error("all Fiat currency price quote providers are unavailable")
+
+	return models.FiatQuote{}, NewError(constants.RetryMessageString()).SetStatus(http.StatusServiceUnavailable)
 }
 
 // FiatConversion will convert a source currency, of a given amount, to the destination currency.
@@ -169,36 +212,72 @@ func (q *quotesImpl) FiatConversion(
 	return rawQuote.Info.Rate, convertedAmount, nil
 }
 
-// CryptoQuote will access the Fiat currency price quote service and get the latest exchange rate.
+// cryptoQuote will retrieve a Cryptocurrency price quote. Cached price quotes within the freshness window are used in
+// place of a provider request. If the providers are unavailable, a cached price quote within the maximum age will be
+// used.
 func (q *quotesImpl) cryptoQuote(source, destination string) (models.CryptoQuote, error) {
-	result := models.CryptoQuote{}
+	var (
+		cached    models.CryptoQuote
+		cacheKey  = quoteCacheKey(constants.QuoteCryptoKeyPrefix(), source, destination)
+		isCached  = q.cacheGet(cacheKey, &cached)
+		cachedAge = time.Since(cryptoQuoteTime(&cached))
+	)
 
-	resp, err := q.clientCrypto.R().
-		SetPathParam("base_symbol", source).
-		SetPathParam("quote_symbol", destination).
-		SetSuccessResult(&result).
-		Get(q.conf.CryptoCurrency.Endpoint)
+	if isCached && cachedAge <= q.conf.Cache.CryptoFreshness {
+		return cached, nil
+	}
 
-	// Failed to query endpoint for price.
-	if err != nil {
-		q.logger.Warn("failed to get Fiat currency price quote", zap.Error(err))
+	quote, err := q.cryptoProviderQuote(source, destination)
+	if err != nil && !errors.Is(err, errProviderUnavailable) {
+		return quote, err
+	}
 
-		return result, NewError("crypto price service unreachable").SetStatus(http.StatusInternalServerError)
+	if err == nil {
+		age := time.Since(cryptoQuoteTime(&quote))
+		if age <= q.conf.Cache.CryptoMaxAge {
+			q.cacheSet(cacheKey, &quote, q.conf.Cache.CryptoMaxAge-age)
+
+			return quote, nil
+		}
+
+		q.logger.Warn("Cryptocurrency price quote provider returned a stale rate",
+			zap.String("source", source), zap.String("destination", destination), zap.Duration("age", age))
 	}
 
-	if !resp.IsSuccessState() {
-		// Invalid cryptocurrency codes.
-		if resp.StatusCode == 550 { //nolint:mnd,gomnd
-			return result, NewError("invalid Crypto currency code").SetStatus(http.StatusBadRequest)
+	if isCached && cachedAge <= q.conf.Cache.CryptoMaxAge {
+		q.logger.Warn("using cached Cryptocurrency price quote",
+			zap.String("source", source), zap.String("destination", destination), zap.Duration("age", cachedAge))
+
+		return cached, nil
+	}
+
+	if err != nil {
+		return models.CryptoQuote{}, err
+	}
+
+	return models.CryptoQuote{}, errStaleQuote
+}
+
+// cryptoProviderQuote will access the Cryptocurrency price quote providers, in order, and get the latest exchange rate.
+// The request will fail over to the next provider if a provider times out or responds with a server error.
+func (q *quotesImpl) cryptoProviderQuote(source, destination string) (models.CryptoQuote, error) {
+	for _, provider := range q.cryptoProviders {
+		result, err := provider.cryptoQuote(source, destination)
+		if err == nil {
+			q.recordRate(source, destination, provider.name(), result.Rate, cryptoQuoteTime(&result))
 		}
 
-		// Log and other API related errors and return an internal server error to user.
-		q.logger.Error("API error", zap.String("Response", resp.String()))
+		if !errors.Is(err, errProviderUnavailable) {
+			return result, err
+		}
 
-		return result, NewError(constants.RetryMessageString()).SetStatus(http.StatusInternalServerError)
+		q.logger.Warn("failed to get Cryptocurrency price quote, failing over to next provider",
+			zap.String("provider", provider.name()), zap.Error(err))
 	}
 
-	return result, nil
+	q.logger.Error("all Cryptocurrency price quote providers are unavailable")
+
+	return models.CryptoQuote{}, NewError(constants.RetryMessageString()).SetStatus(http.StatusServiceUnavailable)
 }
 
 // CryptoConversion will convert Fiat to Crypto and Crypto to Fiat currencies, for a given amount.
@@ -239,3 +318,21 @@ func (q *quotesImpl) CryptoConversion(
 
 	return rawQuote.Rate, convertedAmount, nil
 }
+
+// Fee will calculate the fee, in the source currency, to be charged on an amount exchanged between two currencies. The
+// fee is calculated using the basis points configured for the currency pair, rounded to the precision of the source
+// currency, and will not be less than the configured minimum fee.
+func (q *quotesImpl) Fee(source, destination string, amount decimal.Decimal, precision int32) decimal.Decimal {
+	basisPoints, minimum := q.conf.Fees.schedule(source, destination)
+
+	fee := amount.
+		Mul(decimal.NewFromInt(basisPoints)).
+		Div(decimal.NewFromInt(constants.BasisPointsPerUnit())).
+		RoundBank(precision)
+
+	if minimumFee := decimal.NewFromFloat(minimum).RoundBank(precision); fee.LessThan(minimumFee) {
+		return minimumFee
+	}
+
+	return fee
+}
@@ -51,6 +51,20 @@ func (mr *MockQuotesMockRecorder) CryptoConversion(arg0, arg1, arg2, arg3, arg4
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CryptoConversion", reflect.TypeOf((*MockQuotes)(nil).CryptoConversion), arg0, arg1, arg2, arg3, arg4)
 }
 
+// Fee mocks base method.
+func (m *MockQuotes) Fee(arg0, arg1 string, arg2 decimal.Decimal, arg3 int32) decimal.Decimal {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fee", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(decimal.Decimal)
+	return ret0
+}
+
+// Fee indicates an expected call of Fee.
+func (mr *MockQuotesMockRecorder) Fee(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fee", reflect.TypeOf((*MockQuotes)(nil).Fee), arg0, arg1, arg2, arg3)
+}
+
 // FiatConversion mocks base method.
 func (m *MockQuotes) FiatConversion(arg0, arg1 string, arg2 decimal.Decimal, arg3 func(string, string, decimal.Decimal) (models.FiatQuote, error)) (decimal.Decimal, decimal.Decimal, error) {
 	m.ctrl.T.Helper()
@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
 	"github.com/surahman/FTeX/pkg/models"
 )
 
@@ -43,35 +44,47 @@ func TestQuotesImpl_New(t *testing.T) {
 			require.NoError(t, afero.WriteFile(fs, constants.EtcDir()+test.fileName, []byte(test.input), 0644),
 				"failed to write in memory file.")
 
-			c, err := newQuotesImpl(&fs, zapLogger)
+			c, err := newQuotesImpl(&fs, mocks.NewMockRedis(gomock.NewController(t)), nil, zapLogger)
 			test.expectErr(t, err)
 			test.expectNil(t, c)
 		})
 	}
 }
 
-func TestQuotesImpl_ConfigFiatClient(t *testing.T) {
+func TestQuotesImpl_ConfigFiatProviders(t *testing.T) {
 	t.Parallel()
 
-	testClient, err := configFiatClient(nil)
+	providers, err := configFiatProviders(nil)
 	require.Error(t, err, "no config should fail")
-	require.Nil(t, testClient, "failure should return nil client.")
+	require.Nil(t, providers, "failure should return nil providers.")
 
-	testClient, err = configFiatClient(testConfigs)
+	providers, err = configFiatProviders(testConfigs)
 	require.NoError(t, err, "failed to configure Quotes.")
-	require.NotNil(t, testClient, "failed to configure Quotes.")
+	require.Len(t, providers, len(testConfigs.FiatProviders), "failed to configure all providers.")
+	require.Equal(t, providerRapidAPI, providers[0].name(), "provider order mismatch.")
+	require.Equal(t, providerFrankfurter, providers[1].name(), "provider order mismatch.")
+
+	providers, err = configFiatProviders(&config{FiatProviders: []providerConfig{{Provider: "unknown"}}})
+	require.Error(t, err, "unsupported provider should fail.")
+	require.Nil(t, providers, "failure should return nil providers.")
 }
 
-func TestQuotesImpl_ConfigCryptoClient(t *testing.T) {
+func TestQuotesImpl_ConfigCryptoProviders(t *testing.T) {
 	t.Parallel()
 
-	testClient, err := configCryptoClient(nil)
+	providers, err := configCryptoProviders(nil)
 	require.Error(t, err, "no config should fail.")
-	require.Nil(t, testClient, "failure should return nil client.")
+	require.Nil(t, providers, "failure should return nil providers.")
 
-	testClient, err = configCryptoClient(testConfigs)
+	providers, err = configCryptoProviders(testConfigs)
 	require.NoError(t, err, "failed to configure Quotes.")
-	require.NotNil(t, testClient, "failed to configure Quotes.")
+	require.Len(t, providers, len(testConfigs.CryptoProviders), "failed to configure all providers.")
+	require.Equal(t, providerCoinAPI, providers[0].name(), "provider order mismatch.")
+	require.Equal(t, providerCoinbase, providers[1].name(), "provider order mismatch.")
+
+	providers, err = configCryptoProviders(&config{CryptoProviders: []providerConfig{{Provider: "unknown"}}})
+	require.Error(t, err, "unsupported provider should fail.")
+	require.Nil(t, providers, "failure should return nil providers.")
 }
 
 func TestQuotesImpl_FiatQuote(t *testing.T) {
@@ -112,10 +125,13 @@ func TestQuotesImpl_FiatQuote(t *testing.T) {
 			test.errExpectation(t, err, "error expectation failed.")
 			test.successExpectation(t, result.Success, "success code incorrectly set.")
 
+			// The error details are only returned by the live price quote providers.
 			if err != nil {
-				require.NotEqual(t, 200, result.Error.Code, "received valid response code on error.")
-				require.NotEmpty(t, result.Error.Type, "received no type on error.")
-				require.NotEmpty(t, result.Error.Info, "received no info on error.")
+				if liveQuotes {
+					require.NotEqual(t, 200, result.Error.Code, "received valid response code on error.")
+					require.NotEmpty(t, result.Error.Type, "received no type on error.")
+					require.NotEmpty(t, result.Error.Info, "received no info on error.")
+				}
 
 				return
 			}
@@ -445,3 +461,113 @@ func TestQuotesImpl_CryptoConversion_Mock(t *testing.T) {
 		})
 	}
 }
+
+func TestQuotesImpl_Fee(t *testing.T) {
+	t.Parallel()
+
+	feeQuotes := &quotesImpl{
+		conf: &config{
+			Fees: feesConfig{
+				BasisPoints: 25,
+				Minimum:     0.5,
+				Overrides: []feeOverrideConfig{
+					{Source: "BTC", Destination: "USD", BasisPoints: 50, Minimum: 0.00001},
+					{Source: "USD", Destination: "CAD", BasisPoints: 0, Minimum: 0},
+				},
+			},
+		},
+		logger: zapLogger,
+	}
+
+	testCases := []struct {
+		name        string
+		source      string
+		destination string
+		amount      decimal.Decimal
+		precision   int32
+		expectFee   decimal.Decimal
+	}{
+		{
+			name:        "default schedule",
+			source:      "USD",
+			destination: "EUR",
+			amount:      decimal.NewFromFloat(1000),
+			precision:   constants.DecimalPlacesFiat(),
+			expectFee:   decimal.NewFromFloat(2.5),
+		}, {
+			name:        "default schedule rounded",
+			source:      "USD",
+			destination: "EUR",
+			amount:      decimal.NewFromFloat(1234.56),
+			precision:   constants.DecimalPlacesFiat(),
+			expectFee:   decimal.NewFromFloat(3.09),
+		}, {
+			name:        "default schedule minimum",
+			source:      "USD",
+			destination: "EUR",
+			amount:      decimal.NewFromFloat(10),
+			precision:   constants.DecimalPlacesFiat(),
+			expectFee:   decimal.NewFromFloat(0.5),
+		}, {
+			name:        "override",
+			source:      "BTC",
+			destination: "USD",
+			amount:      decimal.NewFromFloat(1.5),
+			precision:   constants.DecimalPlacesCrypto(),
+			expectFee:   decimal.NewFromFloat(0.0075),
+		}, {
+			name:        "override case insensitive",
+			source:      "btc",
+			destination: "usd",
+			amount:      decimal.NewFromFloat(1.5),
+			precision:   constants.DecimalPlacesCrypto(),
+			expectFee:   decimal.NewFromFloat(0.0075),
+		}, {
+			name:        "override minimum",
+			source:      "BTC",
+			destination: "USD",
+			amount:      decimal.NewFromFloat(0.001),
+			precision:   constants.DecimalPlacesCrypto(),
+			expectFee:   decimal.NewFromFloat(0.00001),
+		}, {
+			name:        "override reversed pair uses default",
+			source:      "USD",
+			destination: "BTC",
+			amount:      decimal.NewFromFloat(1000),
+			precision:   constants.DecimalPlacesFiat(),
+			expectFee:   decimal.NewFromFloat(2.5),
+		}, {
+			name:        "crypto source without override has no minimum",
+			source:      "BTC",
+			destination: "ETH",
+			amount:      decimal.NewFromFloat(0.001),
+			precision:   constants.DecimalPlacesCrypto(),
+			expectFee:   decimal.NewFromFloat(0.0000025),
+		}, {
+			name:        "crypto sale into other fiat has no minimum",
+			source:      "ETH",
+			destination: "EUR",
+			amount:      decimal.NewFromFloat(0.01),
+			precision:   constants.DecimalPlacesCrypto(),
+			expectFee:   decimal.NewFromFloat(0.000025),
+		}, {
+			name:        "override no fee",
+			source:      "USD",
+			destination: "CAD",
+			amount:      decimal.NewFromFloat(1000),
+			precision:   constants.DecimalPlacesFiat(),
+			expectFee:   decimal.Zero,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			fee := feeQuotes.Fee(test.source, test.destination, test.amount, test.precision)
+			require.Truef(t, test.expectFee.Equal(fee), "fee mismatch: expected %s, actual %s", test.expectFee, fee)
+		})
+	}
+}
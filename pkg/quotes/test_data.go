@@ -6,149 +6,380 @@ func configTestData() map[string]string {
 		"empty": ``,
 
 		"valid": `
-fiatCurrency:
-  apiKey: some-api-key-for-fiat-currencies
-  headerKey: X-RapidAPI-Key
-  endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
-cryptoCurrency:
-  apiKey: some-api-key-for-crypto-currencies
-  headerKey: X-CoinAPI-Key
-  endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
+fiatProviders:
+  - provider: rapidapi
+    apiKey: some-api-key-for-fiat-currencies
+    headerKey: X-RapidAPI-Key
+    endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
+  - provider: frankfurter
+    endpoint: https://api.frankfurter.app/latest
+cryptoProviders:
+  - provider: coinapi
+    apiKey: some-api-key-for-crypto-currencies
+    headerKey: X-CoinAPI-Key
+    endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
+  - provider: coinbase
+    endpoint: https://api.coinbase.com/v2/prices/{base_symbol}-{quote_symbol}/spot
 connection:
   userAgent: ftex_inc
-  timeout: 5s`,
+  timeout: 5s
+fees:
+  basisPoints: 25
+  minimum: 0.01
+  overrides:
+    - source: BTC
+      destination: USD
+      basisPoints: 50
+      minimum: 0.00001
+cache:
+  fiatFreshness: 1m
+  fiatMaxAge: 96h
+  cryptoFreshness: 15s
+  cryptoMaxAge: 2m`,
 
-		"no fiat api key": `
-fiatCurrency:
-  apiKey:
-  headerKey: X-RapidAPI-Key
-  endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
-cryptoCurrency:
-  apiKey: some-api-key-for-crypto-currencies
-  headerKey: X-CoinAPI-Key
-  endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
+		"invalid fees": `
+fiatProviders:
+  - provider: rapidapi
+    apiKey: some-api-key-for-fiat-currencies
+    headerKey: X-RapidAPI-Key
+    endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
+cryptoProviders:
+  - provider: coinapi
+    apiKey: some-api-key-for-crypto-currencies
+    headerKey: X-CoinAPI-Key
+    endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
 connection:
   userAgent: ftex_inc
-  timeout: 1s`,
+  timeout: 5s
+fees:
+  basisPoints: 10001
+  minimum: -0.01
+  overrides:
+    - destination: USD
+      basisPoints: -1
+      minimum: 0.00001
+cache:
+  fiatFreshness: 1m
+  fiatMaxAge: 96h
+  cryptoFreshness: 15s
+  cryptoMaxAge: 2m`,
+
+		"no fiat provider name": `
+fiatProviders:
+  - apiKey: some-api-key-for-fiat-currencies
+    headerKey: X-RapidAPI-Key
+    endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
+cryptoProviders:
+  - provider: coinapi
+    apiKey: some-api-key-for-crypto-currencies
+    headerKey: X-CoinAPI-Key
+    endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
+connection:
+  userAgent: ftex_inc
+  timeout: 1s
+cache:
+  fiatFreshness: 1m
+  fiatMaxAge: 96h
+  cryptoFreshness: 15s
+  cryptoMaxAge: 2m`,
 
 		"no fiat header key": `
-fiatCurrency:
-  apiKey: some-api-key-for-fiat-currencies
-  headerKey:
-  endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
-cryptoCurrency:
-  apiKey: some-api-key-for-crypto-currencies
-  headerKey: X-CoinAPI-Key
-  endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
+fiatProviders:
+  - provider: rapidapi
+    apiKey: some-api-key-for-fiat-currencies
+    endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
+cryptoProviders:
+  - provider: coinapi
+    apiKey: some-api-key-for-crypto-currencies
+    headerKey: X-CoinAPI-Key
+    endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
 connection:
   userAgent: ftex_inc
   timeout: 1s
-`,
+cache:
+  fiatFreshness: 1m
+  fiatMaxAge: 96h
+  cryptoFreshness: 15s
+  cryptoMaxAge: 2m`,
 
 		"no fiat api endpoint": `
-fiatCurrency:
-  apiKey: some-api-key-for-fiat-currencies
-  headerKey: X-RapidAPI-Key
-  endpoint:
-cryptoCurrency:
-  apiKey: some-api-key-for-crypto-currencies
-  headerKey: X-CoinAPI-Key
-  endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
+fiatProviders:
+  - provider: rapidapi
+    apiKey: some-api-key-for-fiat-currencies
+    headerKey: X-RapidAPI-Key
+  - provider: frankfurter
+cryptoProviders:
+  - provider: coinapi
+    apiKey: some-api-key-for-crypto-currencies
+    headerKey: X-CoinAPI-Key
+    endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
 connection:
   userAgent: ftex_inc
-  timeout: 1s`,
+  timeout: 1s
+cache:
+  fiatFreshness: 1m
+  fiatMaxAge: 96h
+  cryptoFreshness: 15s
+  cryptoMaxAge: 2m`,
 
 		"no fiat": `
-cryptoCurrency:
-  apiKey: some-api-key-for-crypto-currencies
-  headerKey: X-CoinAPI-Key
-  endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
+cryptoProviders:
+  - provider: coinapi
+    apiKey: some-api-key-for-crypto-currencies
+    headerKey: X-CoinAPI-Key
+    endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
 connection:
   userAgent: ftex_inc
-  timeout: 1s`,
+  timeout: 1s
+cache:
+  fiatFreshness: 1m
+  fiatMaxAge: 96h
+  cryptoFreshness: 15s
+  cryptoMaxAge: 2m`,
 
-		"no crypto api key": `
-fiatCurrency:
-  apiKey: some-api-key-for-fiat-currencies
-  headerKey: X-RapidAPI-Key
-  endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
-cryptoCurrency:
-  apiKey:
-  headerKey: X-CoinAPI-Key
-  endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
+		"no crypto provider name": `
+fiatProviders:
+  - provider: rapidapi
+    apiKey: some-api-key-for-fiat-currencies
+    headerKey: X-RapidAPI-Key
+    endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
+cryptoProviders:
+  - apiKey: some-api-key-for-crypto-currencies
+    headerKey: X-CoinAPI-Key
+    endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
 connection:
   userAgent: ftex_inc
-  timeout: 1s`,
+  timeout: 1s
+cache:
+  fiatFreshness: 1m
+  fiatMaxAge: 96h
+  cryptoFreshness: 15s
+  cryptoMaxAge: 2m`,
 
 		"no crypto header key": `
-fiatCurrency:
-  apiKey: some-api-key-for-fiat-currencies
-  headerKey: X-RapidAPI-Key
-  endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
-cryptoCurrency:
-  apiKey: some-api-key-for-crypto-currencies
-  headerKey:
-  endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
+fiatProviders:
+  - provider: rapidapi
+    apiKey: some-api-key-for-fiat-currencies
+    headerKey: X-RapidAPI-Key
+    endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
+cryptoProviders:
+  - provider: coinapi
+    apiKey: some-api-key-for-crypto-currencies
+    endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
 connection:
   userAgent: ftex_inc
-  timeout: 1s`,
+  timeout: 1s
+cache:
+  fiatFreshness: 1m
+  fiatMaxAge: 96h
+  cryptoFreshness: 15s
+  cryptoMaxAge: 2m`,
 
 		"no crypto api endpoint": `
-fiatCurrency:
-  apiKey: some-api-key-for-fiat-currencies
-  headerKey: X-RapidAPI-Key
-  endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
-cryptoCurrency:
-  apiKey: some-api-key-for-crypto-currencies
-  headerKey: X-CoinAPI-Key
-  endpoint:
+fiatProviders:
+  - provider: rapidapi
+    apiKey: some-api-key-for-fiat-currencies
+    headerKey: X-RapidAPI-Key
+    endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
+cryptoProviders:
+  - provider: coinapi
+    apiKey: some-api-key-for-crypto-currencies
+    headerKey: X-CoinAPI-Key
 connection:
   userAgent: ftex_inc
-  timeout: 1s`,
+  timeout: 1s
+cache:
+  fiatFreshness: 1m
+  fiatMaxAge: 96h
+  cryptoFreshness: 15s
+  cryptoMaxAge: 2m`,
 
 		"no crypto": `
-fiatCurrency:
-  apiKey: some-api-key-for-fiat-currencies
-  headerKey: X-RapidAPI-Key
-  endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
+fiatProviders:
+  - provider: rapidapi
+    apiKey: some-api-key-for-fiat-currencies
+    headerKey: X-RapidAPI-Key
+    endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
 connection:
   userAgent: ftex_inc
-  timeout: 1s`,
+  timeout: 1s
+cache:
+  fiatFreshness: 1m
+  fiatMaxAge: 96h
+  cryptoFreshness: 15s
+  cryptoMaxAge: 2m`,
+
+		"empty crypto": `
+fiatProviders:
+  - provider: rapidapi
+    apiKey: some-api-key-for-fiat-currencies
+    headerKey: X-RapidAPI-Key
+    endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
+cryptoProviders: []
+connection:
+  userAgent: ftex_inc
+  timeout: 1s
+cache:
+  fiatFreshness: 1m
+  fiatMaxAge: 96h
+  cryptoFreshness: 15s
+  cryptoMaxAge: 2m`,
 
 		"no connection user-agent": `
-fiatCurrency:
-  apiKey: some-api-key-for-fiat-currencies
-  headerKey: X-RapidAPI-Key
-  endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
-cryptoCurrency:
-  apiKey: some-api-key-for-crypto-currencies
-  headerKey: X-CoinAPI-Key
-  endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
+fiatProviders:
+  - provider: rapidapi
+    apiKey: some-api-key-for-fiat-currencies
+    headerKey: X-RapidAPI-Key
+    endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
+cryptoProviders:
+  - provider: coinapi
+    apiKey: some-api-key-for-crypto-currencies
+    headerKey: X-CoinAPI-Key
+    endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
 connection:
   userAgent:
-  timeout: 1s`,
+  timeout: 1s
+cache:
+  fiatFreshness: 1m
+  fiatMaxAge: 96h
+  cryptoFreshness: 15s
+  cryptoMaxAge: 2m`,
 
 		"no connection timeout": `
-fiatCurrency:
-  apiKey: some-api-key-for-fiat-currencies
-  headerKey: X-RapidAPI-Key
-  endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
-cryptoCurrency:
-  apiKey: some-api-key-for-crypto-currencies
-  headerKey: X-CoinAPI-Key
-  endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
+fiatProviders:
+  - provider: rapidapi
+    apiKey: some-api-key-for-fiat-currencies
+    headerKey: X-RapidAPI-Key
+    endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
+cryptoProviders:
+  - provider: coinapi
+    apiKey: some-api-key-for-crypto-currencies
+    headerKey: X-CoinAPI-Key
+    endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
 connection:
   userAgent: ftex_inc
-  timeout:`,
+  timeout:
+cache:
+  fiatFreshness: 1m
+  fiatMaxAge: 96h
+  cryptoFreshness: 15s
+  cryptoMaxAge: 2m`,
 
 		"no connection": `
-fiatCurrency:
-  apiKey: some-api-key-for-fiat-currencies
-  headerKey: X-RapidAPI-Key
-  endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
-cryptoCurrency:
-  apiKey: some-api-key-for-crypto-currencies
-  headerKey: X-CoinAPI-Key
-  endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}`,
+fiatProviders:
+  - provider: rapidapi
+    apiKey: some-api-key-for-fiat-currencies
+    headerKey: X-RapidAPI-Key
+    endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
+cryptoProviders:
+  - provider: coinapi
+    apiKey: some-api-key-for-crypto-currencies
+    headerKey: X-CoinAPI-Key
+    endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
+cache:
+  fiatFreshness: 1m
+  fiatMaxAge: 96h
+  cryptoFreshness: 15s
+  cryptoMaxAge: 2m`,
+
+		"no cache": `
+fiatProviders:
+  - provider: rapidapi
+    apiKey: some-api-key-for-fiat-currencies
+    headerKey: X-RapidAPI-Key
+    endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
+cryptoProviders:
+  - provider: coinapi
+    apiKey: some-api-key-for-crypto-currencies
+    headerKey: X-CoinAPI-Key
+    endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
+connection:
+  userAgent: ftex_inc
+  timeout: 1s`,
+
+		"invalid cache": `
+fiatProviders:
+  - provider: rapidapi
+    apiKey: some-api-key-for-fiat-currencies
+    headerKey: X-RapidAPI-Key
+    endpoint: https://currency-conversion-and-exchange-rates.p.rapidapi.com/convert?
+cryptoProviders:
+  - provider: coinapi
+    apiKey: some-api-key-for-crypto-currencies
+    headerKey: X-CoinAPI-Key
+    endpoint: https://rest.coinapi.io/v1/exchangerate/{base_symbol}/{quote_symbol}
+connection:
+  userAgent: ftex_inc
+  timeout: 1s
+cache:
+  fiatFreshness: 1m
+  fiatMaxAge: 30s
+  cryptoFreshness: 15s
+  cryptoMaxAge: 10s`,
+
+		"valid offline": `
+offline:
+  enabled: true
+  rates: QuoteRates.yaml
+  drift: 0.001
+connection:
+  userAgent: ftex_inc
+  timeout: 1s
+cache:
+  fiatFreshness: 1m
+  fiatMaxAge: 96h
+  cryptoFreshness: 15s
+  cryptoMaxAge: 2m`,
+
+		"invalid offline": `
+offline:
+  enabled: true
+  drift: 1
+connection:
+  userAgent: ftex_inc
+  timeout: 1s
+cache:
+  fiatFreshness: 1m
+  fiatMaxAge: 96h
+  cryptoFreshness: 15s
+  cryptoMaxAge: 2m`,
+	}
+}
+
+// offlineRatesTestData will return a map of test data containing valid and invalid offline rate tables.
+func offlineRatesTestData() map[string]string {
+	return map[string]string{
+		"valid yaml": `
+fiat:
+  - source: USD
+    destination: CAD
+    rate: "1.25"
+crypto:
+  - source: BTC
+    destination: USD
+    rate: "20000"`,
+
+		"valid csv": `type,source,destination,rate
+fiat,USD,CAD,1.25
+crypto, BTC, USD, 20000`,
+
+		"invalid yaml rate": `
+fiat:
+  - source: USD
+    destination: CAD
+    rate: "-1.25"`,
+
+		"invalid yaml": `
+fiat:
+  source: USD`,
+
+		"invalid csv type": `type,source,destination,rate
+stock,USD,CAD,1.25`,
+
+		"invalid csv columns": `type,source,destination,rate
+fiat,USD,1.25`,
+
+		"invalid csv rate": `type,source,destination,rate
+crypto,BTC,USD,twenty`,
 	}
 }
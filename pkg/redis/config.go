@@ -32,6 +32,7 @@ type connectionConfig struct {
 	PoolSize        int    `json:"poolSize,omitempty"        mapstructure:"poolSize"        validate:"required,min=1"     yaml:"poolSize,omitempty"`
 	MinIdleConns    int    `json:"minIdleConns,omitempty"    mapstructure:"minIdleConns"    validate:"required,min=1"     yaml:"minIdleConns,omitempty"`
 	MaxIdleConns    int    `json:"maxIdleConns,omitempty"    mapstructure:"maxIdleConns"    yaml:"maxIdleConns,omitempty"`
+	StreamPoolSize  int    `json:"streamPoolSize,omitempty"  mapstructure:"streamPoolSize"  validate:"required,min=1"     yaml:"streamPoolSize,omitempty"`
 }
 
 // newConfig creates a blank configuration struct for Redis.
@@ -25,7 +25,7 @@ func TestRedisConfigs_Load(t *testing.T) {
 		{
 			name:         "empty - etc dir",
 			input:        redisConfigTestData["empty"],
-			expectErrCnt: 6,
+			expectErrCnt: 7,
 			expectErr:    require.Error,
 		}, {
 			name:         "valid - etc dir",
@@ -62,6 +62,11 @@ func TestRedisConfigs_Load(t *testing.T) {
 			input:        redisConfigTestData["invalid_pool_size"],
 			expectErrCnt: 1,
 			expectErr:    require.Error,
+		}, {
+			name:         "invalid stream pool size - etc dir",
+			input:        redisConfigTestData["invalid_stream_pool_size"],
+			expectErrCnt: 1,
+			expectErr:    require.Error,
 		}, {
 			name:         "invalid min idle conns - etc dir",
 			input:        redisConfigTestData["invalid_min_idle_conns"],
@@ -111,6 +116,7 @@ func TestRedisConfigs_Load(t *testing.T) {
 			poolSize := 164
 			minIdleConns := 9
 			maxIdleConns := 101
+			streamPoolSize := 64
 
 			t.Setenv(envConnKey+"ADDR", addr)
 			t.Setenv(envConnKey+"MAXCONNATTEMPTS", strconv.Itoa(maxConnAttempts))
@@ -118,6 +124,7 @@ func TestRedisConfigs_Load(t *testing.T) {
 			t.Setenv(envConnKey+"POOLSIZE", strconv.Itoa(poolSize))
 			t.Setenv(envConnKey+"MINIDLECONNS", strconv.Itoa(minIdleConns))
 			t.Setenv(envConnKey+"MAXIDLECONNS", strconv.Itoa(maxIdleConns))
+			t.Setenv(envConnKey+"STREAMPOOLSIZE", strconv.Itoa(streamPoolSize))
 
 			err = actual.Load(fs)
 			require.NoErrorf(t, actual.Load(fs), "failed to load configurations file: %v", err)
@@ -132,6 +139,7 @@ func TestRedisConfigs_Load(t *testing.T) {
 			require.Equal(t, poolSize, actual.Connection.PoolSize, "failed to load pool size.")
 			require.Equal(t, minIdleConns, actual.Connection.MinIdleConns, "failed to load min idle conns.")
 			require.Equal(t, maxIdleConns, actual.Connection.MaxIdleConns, "failed to load max idle conns.")
+			require.Equal(t, streamPoolSize, actual.Connection.StreamPoolSize, "failed to load stream pool size.")
 		})
 	}
 }
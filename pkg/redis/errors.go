@@ -11,6 +11,7 @@ const (
 	ErrorCacheSet
 	ErrorCacheDel
 	ErrorUnhealthy
+	ErrorPubSub
 )
 
 // Error is the base error type. The builder pattern is used to add specialization codes to the errors.
@@ -63,6 +64,13 @@ func (e *Error) errorCacheDel() *Error {
 	return e
 }
 
+// errorPubSub will specialize the error as a publish or subscribe failure.
+func (e *Error) errorPubSub() *Error {
+	e.Code = ErrorPubSub
+
+	return e
+}
+
 // Generic error variables.
 // Errors to be returned by the Postgres Queries exposed through the interface for various failure conditions.
 var (
@@ -71,6 +79,7 @@ var (
 	ErrCacheSet     = errorCacheSet()     // ErrCacheSet is returned if a key-value pair cannot be placed in the cache.
 	ErrCacheDel     = errorCacheDel()     // ErrCacheDel is returned if a key-value pair cannot be deleted from the cache.
 	ErrUnhealthy    = errorUnhealthy()    // ErrUnhealthy is returned if the cache is unreachable.
+	ErrPubSub       = errorPubSub()       // ErrPubSub is returned if a publish or subscribe fails.
 )
 
 func errorCacheUnknown() error {
@@ -107,3 +116,10 @@ func errorUnhealthy() error {
 		Code:    ErrorUnhealthy,
 	}
 }
+
+func errorPubSub() error {
+	return &Error{
+		Message: "Redis publish or subscribe failure",
+		Code:    ErrorPubSub,
+	}
+}
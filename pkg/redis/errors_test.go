@@ -31,6 +31,10 @@ func TestError_New(t *testing.T) {
 			name:         "cache del",
 			err:          NewError("cache del").errorCacheDel(),
 			expectedCode: ErrorCacheDel,
+		}, {
+			name:         "pub sub",
+			err:          NewError("pub sub").errorPubSub(),
+			expectedCode: ErrorPubSub,
 		},
 	}
 
@@ -88,6 +92,11 @@ func TestError_Is(t *testing.T) {
 			inputErr:        NewError("").errorCacheDel(),
 			baseErr:         baseError,
 			boolExpectation: require.False,
+		}, {
+			name:            "base vs pub sub",
+			inputErr:        NewError("").errorPubSub(),
+			baseErr:         baseError,
+			boolExpectation: require.False,
 		}, {
 			name:            "cache miss",
 			inputErr:        NewError("").errorCacheMiss(),
@@ -103,6 +112,11 @@ func TestError_Is(t *testing.T) {
 			inputErr:        NewError("").errorCacheDel(),
 			baseErr:         NewError("").errorCacheDel(),
 			boolExpectation: require.True,
+		}, {
+			name:            "pub sub",
+			inputErr:        NewError("").errorPubSub(),
+			baseErr:         NewError("").errorPubSub(),
+			boolExpectation: require.True,
 		},
 	}
 
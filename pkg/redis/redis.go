@@ -35,21 +35,100 @@ type Redis interface {
 	// Set will place a key with a given value in the cache with a TTL, if specified in the configurations.
 	Set(key string, value any, ttl time.Duration) error
 
+	// SetNX will atomically place a key with a given value in the cache with a TTL, only if the key does not exist. It
+	// reports whether the key was placed.
+	SetNX(key string, value any, ttl time.Duration) (bool, error)
+
 	// Get will retrieve a value associated with a provided key.
 	Get(key string, value any) error
 
 	// Del will remove all keys provided as a set of keys.
 	Del(key ...string) error
+
+	// TakeTokens will atomically remove tokens from a token bucket that holds up to capacity tokens and refills one
+	// token every interval. If there are insufficient tokens the bucket is left untouched and the time to wait until
+	// they become available is returned.
+	TakeTokens(key string, capacity, tokens int64, interval time.Duration) (bool, time.Duration, error)
+
+	// Incr will atomically increment a counter and reset its TTL, creating it with a value of one if it does not exist.
+	Incr(key string, expiration time.Duration) (int64, error)
+
+	// Publish will send a message to all the subscribers of a channel across all instances of the service.
+	Publish(channel string, message []byte) error
+
+	// Subscribe will listen for the messages published to a channel until the context is cancelled, at which point the
+	// returned message channel is closed.
+	Subscribe(ctx context.Context, channel string) (<-chan []byte, error)
+
+	// AppendLog will append a message to a log that retains approximately the most recent maxLen messages, and reset the
+	// log's TTL. The ID assigned to the message is returned.
+	AppendLog(key string, maxLen int64, expiration time.Duration, message []byte) (string, error)
+
+	// ReadLog will retrieve the messages appended to a log after an ID. If there are none, it will wait up to the block
+	// duration for a message to be appended before returning an empty set of entries.
+	ReadLog(ctx context.Context, key, afterID string, block time.Duration) ([]LogEntry, error)
+
+	// LogTail will retrieve the ID of the most recent message in a log, or the ID preceding all messages if it is empty.
+	LogTail(key string) (string, error)
+}
+
+// LogEntry is a message in a log and the ID it was assigned when it was appended. IDs increase monotonically.
+type LogEntry struct {
+	ID      string
+	Message []byte
 }
 
+// logMessageField is the name of the field that holds the message in log entries.
+const logMessageField = "message"
+
+// tokenBucketScript will refill a token bucket, stored as a hash of the available tokens and the time of the last
+// refill in microseconds, using the Redis server clock and then attempt to remove the requested tokens from it. It
+// returns whether the tokens were removed and, if not, the number of microseconds until they will be available.
+var tokenBucketScript = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local requested = tonumber(ARGV[2])
+local interval = tonumber(ARGV[3])
+local clock = redis.call('TIME')
+local now = tonumber(clock[1]) * 1000000 + tonumber(clock[2])
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'refilled')
+local tokens = tonumber(bucket[1])
+local refilled = tonumber(bucket[2])
+
+if tokens == nil or refilled == nil then
+	tokens = capacity
+	refilled = now
+end
+
+local earned = math.floor((now - refilled) / interval)
+tokens = tokens + earned
+refilled = refilled + earned * interval
+
+if tokens >= capacity then
+	tokens = capacity
+	refilled = now
+end
+
+if tokens < requested then
+	return {0, (requested - tokens) * interval - (now - refilled)}
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tokens - requested, 'refilled', refilled)
+redis.call('PEXPIRE', KEYS[1], math.ceil(capacity * interval / 1000))
+
+return {1, 0}
+`)
+
 // Check to ensure the Redis interface has been implemented.
 var _ Redis = &redisImpl{}
 
-// redisImpl implements the Redis interface and contains the logic to interface with the cache.
+// redisImpl implements the Redis interface and contains the logic to interface with the cache. Blocking reads from logs
+// hold a connection for as long as they wait, so they are served from a separate connection pool to stop event streams
+// from starving all other operations of connections.
 type redisImpl struct {
-	conf    *config
-	logger  *logger.Logger
-	redisDB *redis.Client
+	conf     *config
+	logger   *logger.Logger
+	redisDB  *redis.Client
+	streamDB *redis.Client
 }
 
 // NewRedis will create a new Redis configuration by loading it.
@@ -134,6 +213,13 @@ func (r *redisImpl) Open() error {
 
 	r.redisDB = redis.NewClient(redisConfig)
 
+	// Blocking log reads use a dedicated connection pool. Connections are only opened when event streams need them.
+	streamConfig := *redisConfig
+	streamConfig.PoolSize = r.conf.Connection.StreamPoolSize
+	streamConfig.MinIdleConns = 0
+	streamConfig.MaxIdleConns = 0
+	r.streamDB = redis.NewClient(&streamConfig)
+
 	return r.createSessionRetry()
 }
 
@@ -149,7 +235,7 @@ func (r *redisImpl) Close() error {
 		return fmt.Errorf(constants.ErrorFormatMessage(), msg, err)
 	}
 
-	if err = r.redisDB.Close(); err != nil {
+	if err = errors.Join(r.redisDB.Close(), r.streamDB.Close()); err != nil {
 		msg := "failed to close Redis server connection"
 		r.logger.Warn(msg)
 
@@ -194,6 +280,27 @@ func (r *redisImpl) Set(key string, value any, expiration time.Duration) error {
 	return nil
 }
 
+// SetNX will atomically place a key with a given value in the cache with a TTL, only if the key does not already exist.
+// It reports whether the key was placed.
+func (r *redisImpl) SetNX(key string, value any, expiration time.Duration) (bool, error) {
+	// Write value to a byte array.
+	buffer := bytes.Buffer{}
+	encoder := gob.NewEncoder(&buffer)
+
+	if err := encoder.Encode(value); err != nil {
+		return false, NewError(err.Error())
+	}
+
+	placed, err := r.redisDB.SetNX(context.Background(), key, buffer.Bytes(), expiration).Result()
+	if err != nil {
+		r.logger.Error("failed to conditionally place item in Redis cache", zap.String("key", key), zap.Error(err))
+
+		return false, NewError(err.Error()).errorCacheSet()
+	}
+
+	return placed, nil
+}
+
 // Get will retrieve a value associated with a provided key and write the result into the value parameter.
 func (r *redisImpl) Get(key string, value any) error {
 	var (
@@ -234,3 +341,163 @@ func (r *redisImpl) Del(keys ...string) error {
 
 	return nil
 }
+
+// TakeTokens will atomically remove tokens from a token bucket that holds up to capacity tokens and refills one token
+// every interval. Buckets that do not exist are created full and expire once they would have refilled.
+func (r *redisImpl) TakeTokens(key string, capacity, tokens int64, interval time.Duration) (
+	bool, time.Duration, error) {
+	result, err := tokenBucketScript.Run(context.Background(), r.redisDB, []string{key},
+		capacity, tokens, max(interval.Microseconds(), 1)).Int64Slice()
+	if err != nil {
+		r.logger.Error("failed to take tokens from Redis token bucket", zap.String("key", key), zap.Error(err))
+
+		return false, 0, NewError(err.Error()).errorCacheSet()
+	}
+
+	return result[0] == 1, time.Duration(result[1]) * time.Microsecond, nil
+}
+
+// Incr will atomically increment a counter and reset its TTL in a single transaction. Counters that do not exist are
+// created with a value of one.
+func (r *redisImpl) Incr(key string, expiration time.Duration) (int64, error) {
+	var counter *redis.IntCmd
+
+	if _, err := r.redisDB.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		counter = pipe.Incr(context.Background(), key)
+		pipe.PExpire(context.Background(), key, expiration)
+
+		return nil
+	}); err != nil {
+		r.logger.Error("failed to increment counter in Redis cache", zap.String("key", key), zap.Error(err))
+
+		return 0, NewError(err.Error()).errorCacheSet()
+	}
+
+	return counter.Val(), nil
+}
+
+// Publish will send a message to all the subscribers of a channel. Messages are not retained and will not be received by
+// subscribers that join later.
+func (r *redisImpl) Publish(channel string, message []byte) error {
+	if err := r.redisDB.Publish(context.Background(), channel, message).Err(); err != nil {
+		r.logger.Error("failed to publish message to Redis channel", zap.String("channel", channel), zap.Error(err))
+
+		return NewError(err.Error()).errorPubSub()
+	}
+
+	return nil
+}
+
+// Subscribe will listen for the messages published to a channel. The subscription is confirmed before returning so that
+// no messages published afterward are missed. Messages are forwarded until the context is cancelled or the connection to
+// the Redis cache server is closed, after which the returned message channel is closed.
+func (r *redisImpl) Subscribe(ctx context.Context, channel string) (<-chan []byte, error) {
+	pubsub := r.redisDB.Subscribe(ctx, channel)
+
+	if _, err := pubsub.Receive(ctx); err != nil {
+		r.logger.Error("failed to subscribe to Redis channel", zap.String("channel", channel), zap.Error(err))
+
+		_ = pubsub.Close()
+
+		return nil, NewError(err.Error()).errorPubSub()
+	}
+
+	messages := make(chan []byte)
+
+	go func() {
+		defer close(messages)
+		defer pubsub.Close()
+
+		received := pubsub.Channel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message, ok := <-received:
+				if !ok {
+					return
+				}
+
+				select {
+				case messages <- []byte(message.Payload):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return messages, nil
+}
+
+// AppendLog will append a message to a log, stored as a Redis stream, and reset its TTL in a single transaction. The
+// log is trimmed to approximately maxLen messages so that trimming remains efficient.
+func (r *redisImpl) AppendLog(key string, maxLen int64, expiration time.Duration, message []byte) (string, error) {
+	var entryID *redis.StringCmd
+
+	if _, err := r.redisDB.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		entryID = pipe.XAdd(context.Background(), &redis.XAddArgs{
+			Stream: key,
+			MaxLen: maxLen,
+			Approx: true,
+			Values: map[string]any{logMessageField: message},
+		})
+		pipe.PExpire(context.Background(), key, expiration)
+
+		return nil
+	}); err != nil {
+		r.logger.Error("failed to append message to Redis log", zap.String("key", key), zap.Error(err))
+
+		return "", NewError(err.Error()).errorCacheSet()
+	}
+
+	return entryID.Val(), nil
+}
+
+// ReadLog will retrieve the messages appended to a log after an ID, blocking for up to the block duration if there are
+// none. Messages that have been trimmed from the log are not returned. Reads are made from the dedicated event stream
+// connection pool.
+func (r *redisImpl) ReadLog(ctx context.Context, key, afterID string, block time.Duration) ([]LogEntry, error) {
+	streams, err := r.streamDB.XRead(ctx, &redis.XReadArgs{
+		Streams: []string{key, afterID},
+		Block:   block,
+	}).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+
+	if err != nil {
+		r.logger.Warn("failed to read messages from Redis log", zap.String("key", key), zap.Error(err))
+
+		return nil, NewError(err.Error())
+	}
+
+	var entries []LogEntry
+
+	for _, stream := range streams {
+		for _, message := range stream.Messages {
+			payload, _ := message.Values[logMessageField].(string)
+			entries = append(entries, LogEntry{ID: message.ID, Message: []byte(payload)})
+		}
+	}
+
+	return entries, nil
+}
+
+// LogTail will retrieve the ID of the most recent message in a log. Empty and missing logs report the ID 0, which
+// precedes the IDs of all messages.
+func (r *redisImpl) LogTail(key string) (string, error) {
+	messages, err := r.redisDB.XRevRangeN(context.Background(), key, "+", "-", 1).Result()
+	if err != nil {
+		r.logger.Warn("failed to retrieve tail of Redis log", zap.String("key", key), zap.Error(err))
+
+		return "", NewError(err.Error())
+	}
+
+	if len(messages) == 0 {
+		return "0", nil
+	}
+
+	return messages[0].ID, nil
+}
@@ -1,6 +1,8 @@
 package redis
 
 import (
+	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -259,3 +261,185 @@ func TestRedisImpl_Set_Get_Del(t *testing.T) {
 		})
 	}
 }
+
+func TestRedisImpl_SetNX(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	key := xid.New().String()
+	first := xid.New().String()
+
+	// Place a new key.
+	placed, err := connection.SetNX(key, first, time.Minute)
+	require.NoError(t, err, "failed to place new key")
+	require.True(t, placed, "new key should be placed")
+
+	// Existing keys are not overwritten.
+	placed, err = connection.SetNX(key, xid.New().String(), time.Minute)
+	require.NoError(t, err, "failed to attempt placing existing key")
+	require.False(t, placed, "existing key should not be placed")
+
+	retrieved := ""
+	require.NoError(t, connection.Get(key, &retrieved), "failed to retrieve data from Redis")
+	require.Equal(t, first, retrieved, "existing key was overwritten")
+
+	require.NoError(t, connection.Del(key), "failed to remove key from Redis server")
+}
+
+func TestRedisImpl_TakeTokens(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	key := xid.New().String()
+	interval := 500 * time.Millisecond
+
+	// Drain the bucket.
+	for idx := 0; idx < 3; idx++ {
+		allowed, wait, err := connection.TakeTokens(key, 3, 1, interval)
+		require.NoError(t, err, "failed to take token from bucket")
+		require.True(t, allowed, "token should be available")
+		require.Zero(t, wait, "no wait expected when tokens are available")
+	}
+
+	// Empty bucket.
+	allowed, wait, err := connection.TakeTokens(key, 3, 1, interval)
+	require.NoError(t, err, "failed to take token from empty bucket")
+	require.False(t, allowed, "token should not be available from an empty bucket")
+	require.Positive(t, wait, "wait expected for an empty bucket")
+	require.LessOrEqual(t, wait, interval, "wait should not exceed the refill interval")
+
+	// Request more tokens than are available.
+	time.Sleep(wait)
+
+	allowed, wait, err = connection.TakeTokens(key, 3, 2, interval)
+	require.NoError(t, err, "failed to take tokens from partially filled bucket")
+	require.False(t, allowed, "insufficient tokens should not be taken")
+	require.Positive(t, wait, "wait expected for insufficient tokens")
+
+	// Refilled token.
+	allowed, _, err = connection.TakeTokens(key, 3, 1, interval)
+	require.NoError(t, err, "failed to take refilled token from bucket")
+	require.True(t, allowed, "refilled token should be available")
+
+	require.NoError(t, connection.Del(key), "failed to remove token bucket")
+}
+
+func TestRedisImpl_Incr(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	key := xid.New().String()
+
+	for expected := int64(1); expected <= 3; expected++ {
+		actual, err := connection.Incr(key, time.Minute)
+		require.NoError(t, err, "failed to increment counter")
+		require.Equal(t, expected, actual, "counter value mismatch")
+	}
+
+	// Counter expiry.
+	_, err := connection.Incr(key, 100*time.Millisecond)
+	require.NoError(t, err, "failed to increment counter with short expiration")
+
+	time.Sleep(200 * time.Millisecond)
+
+	actual, err := connection.Incr(key, time.Minute)
+	require.NoError(t, err, "failed to increment expired counter")
+	require.Equal(t, int64(1), actual, "expired counter was not reset")
+
+	require.NoError(t, connection.Del(key), "failed to remove counter")
+}
+
+func TestRedisImpl_Publish_Subscribe(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	channel := xid.New().String()
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+
+	defer cancel()
+
+	messages, err := connection.Subscribe(ctx, channel)
+	require.NoError(t, err, "failed to subscribe to channel")
+
+	for _, expected := range []string{"first message", "second message"} {
+		require.NoError(t, connection.Publish(channel, []byte(expected)), "failed to publish message")
+
+		select {
+		case actual := <-messages:
+			require.Equal(t, expected, string(actual), "message mismatch")
+		case <-ctx.Done():
+			require.FailNow(t, "timed out waiting for message")
+		}
+	}
+
+	// Cancelling the subscription closes the message channel.
+	cancel()
+
+	select {
+	case _, ok := <-messages:
+		require.False(t, ok, "message channel should be closed")
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for message channel to close")
+	}
+}
+
+func TestRedisImpl_Log(t *testing.T) {
+	// Skip integration tests for short test runs.
+	if testing.Short() {
+		t.Skip()
+	}
+
+	key := xid.New().String()
+
+	// Empty logs are read from the start.
+	tail, err := connection.LogTail(key)
+	require.NoError(t, err, "failed to retrieve tail of empty log")
+	require.Equal(t, "0", tail, "empty log tail mismatch")
+
+	entries, err := connection.ReadLog(context.Background(), key, tail, 10*time.Millisecond)
+	require.NoError(t, err, "failed to read empty log")
+	require.Empty(t, entries, "empty log returned entries")
+
+	// Append more messages than the log retains.
+	var ids []string
+
+	for idx := 0; idx < 5; idx++ {
+		id, err := connection.AppendLog(key, 3, time.Minute, []byte(fmt.Sprintf("message %d", idx)))
+		require.NoError(t, err, "failed to append message")
+
+		ids = append(ids, id)
+	}
+
+	tail, err = connection.LogTail(key)
+	require.NoError(t, err, "failed to retrieve tail of log")
+	require.Equal(t, ids[4], tail, "log tail mismatch")
+
+	// Messages after an ID are returned in order.
+	entries, err = connection.ReadLog(context.Background(), key, ids[2], 10*time.Millisecond)
+	require.NoError(t, err, "failed to read log")
+	require.Len(t, entries, 2, "log entries mismatch")
+	require.Equal(t, ids[3], entries[0].ID, "first entry ID mismatch")
+	require.Equal(t, "message 3", string(entries[0].Message), "first entry message mismatch")
+	require.Equal(t, ids[4], entries[1].ID, "second entry ID mismatch")
+	require.Equal(t, "message 4", string(entries[1].Message), "second entry message mismatch")
+
+	// Reading from the tail blocks until a message is appended.
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+
+		_, _ = connection.AppendLog(key, 3, time.Minute, []byte("message 5"))
+	}()
+
+	entries, err = connection.ReadLog(context.Background(), key, tail, 3*time.Second)
+	require.NoError(t, err, "failed to read appended message")
+	require.Len(t, entries, 1, "appended entries mismatch")
+	require.Equal(t, "message 5", string(entries[0].Message), "appended message mismatch")
+}
@@ -15,7 +15,8 @@ connection:
   maxRetries: 3
   poolSize: 4
   minIdleConns: 1
-  maxIdleConns: 20`,
+  maxIdleConns: 20
+  streamPoolSize: 16`,
 
 		"username_empty": `
 authentication:
@@ -27,7 +28,8 @@ connection:
   maxRetries: 3
   poolSize: 4
   minIdleConns: 1
-  maxIdleConns: 20`,
+  maxIdleConns: 20
+  streamPoolSize: 16`,
 
 		"password_empty": `
 authentication:
@@ -39,7 +41,8 @@ connection:
   maxRetries: 3
   poolSize: 4
   minIdleConns: 1
-  maxIdleConns: 20`,
+  maxIdleConns: 20
+  streamPoolSize: 16`,
 
 		"no_addr": `
 authentication:
@@ -51,7 +54,8 @@ connection:
   maxRetries: 3
   poolSize: 4
   minIdleConns: 1
-  maxIdleConns: 20`,
+  maxIdleConns: 20
+  streamPoolSize: 16`,
 
 		"invalid_max_retries": `
 authentication:
@@ -63,7 +67,8 @@ connection:
   maxRetries: 0
   poolSize: 4
   minIdleConns: 1
-  maxIdleConns: 20`,
+  maxIdleConns: 20
+  streamPoolSize: 16`,
 
 		"invalid_pool_size": `
 authentication:
@@ -75,7 +80,21 @@ connection:
   maxRetries: 3
   poolSize: 0
   minIdleConns: 1
-  maxIdleConns: 20`,
+  maxIdleConns: 20
+  streamPoolSize: 16`,
+
+		"invalid_stream_pool_size": `
+authentication:
+  username: root
+  password: root
+connection:
+  addr: 127.0.0.1:7379
+  maxConnAttempts: 5
+  maxRetries: 3
+  poolSize: 4
+  minIdleConns: 1
+  maxIdleConns: 20
+  streamPoolSize: 0`,
 
 		"invalid_min_idle_conns": `
 authentication:
@@ -87,7 +106,8 @@ connection:
   maxRetries: 3
   poolSize: 4
   minIdleConns: 0
-  maxIdleConns: 20`,
+  maxIdleConns: 20
+  streamPoolSize: 16`,
 
 		"no_max_idle_conns": `
 authentication:
@@ -99,7 +119,8 @@ connection:
   maxRetries: 3
   poolSize: 4
   minIdleConns: 10
-  maxIdleConns:`,
+  maxIdleConns:
+  streamPoolSize: 16`,
 
 		"test_suite": `
 authentication:
@@ -111,7 +132,8 @@ connection:
   maxRetries: 3
   poolSize: 4
   minIdleConns: 1
-  maxIdleConns: 20`,
+  maxIdleConns: 20
+  streamPoolSize: 16`,
 
 		"github-ci-runner": `
 authentication:
@@ -123,6 +145,7 @@ connection:
   maxRetries: 3
   poolSize: 4
   minIdleConns: 1
-  maxIdleConns: 20`,
+  maxIdleConns: 20
+  streamPoolSize: 16`,
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/afero"
 	"github.com/surahman/FTeX/pkg/configloader"
 	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/models"
 )
 
 // config is the configuration for the HTTP REST endpoint.
@@ -15,19 +16,21 @@ import (
 type config struct {
 	Server        serverConfig        `json:"server,omitempty"        mapstructure:"server"        validate:"required" yaml:"server,omitempty"`
 	Authorization authorizationConfig `json:"authorization,omitempty" mapstructure:"authorization" validate:"required" yaml:"authorization,omitempty"`
+	RateLimits    rateLimitsConfig    `json:"rateLimits,omitempty"    mapstructure:"rateLimits"    validate:"required" yaml:"rateLimits,omitempty"`
 }
 
 // serverConfig contains the configurations for the HTTP REST server.
 //
 //nolint:lll
 type serverConfig struct {
-	BasePath          string        `json:"basePath,omitempty"          mapstructure:"basePath"          validate:"required"          yaml:"basePath,omitempty"`
-	SwaggerPath       string        `json:"swaggerPath,omitempty"       mapstructure:"swaggerPath"       validate:"required"          yaml:"swaggerPath,omitempty"`
-	PortNumber        int           `json:"portNumber,omitempty"        mapstructure:"portNumber"        validate:"required,min=1000" yaml:"portNumber,omitempty"`
-	ShutdownDelay     time.Duration `json:"shutdownDelay,omitempty"     mapstructure:"shutdownDelay"     validate:"required,min=0"    yaml:"shutdownDelay,omitempty"`
-	ReadTimeout       time.Duration `json:"readTimeout,omitempty"       mapstructure:"readTimeout"       validate:"required,min=1"    yaml:"readTimeout,omitempty"`
-	WriteTimeout      time.Duration `json:"writeTimeout,omitempty"      mapstructure:"writeTimeout"      validate:"required,min=1"    yaml:"writeTimeout,omitempty"`
-	ReadHeaderTimeout time.Duration `json:"readHeaderTimeout,omitempty" mapstructure:"readHeaderTimeout" validate:"required,min=1"    yaml:"readHeaderTimeout,omitempty"`
+	BasePath          string        `json:"basePath,omitempty"          mapstructure:"basePath"          validate:"required"               yaml:"basePath,omitempty"`
+	SwaggerPath       string        `json:"swaggerPath,omitempty"       mapstructure:"swaggerPath"       validate:"required"               yaml:"swaggerPath,omitempty"`
+	PortNumber        int           `json:"portNumber,omitempty"        mapstructure:"portNumber"        validate:"required,min=1000"      yaml:"portNumber,omitempty"`
+	ShutdownDelay     time.Duration `json:"shutdownDelay,omitempty"     mapstructure:"shutdownDelay"     validate:"required,min=0"         yaml:"shutdownDelay,omitempty"`
+	ReadTimeout       time.Duration `json:"readTimeout,omitempty"       mapstructure:"readTimeout"       validate:"required,min=1"         yaml:"readTimeout,omitempty"`
+	WriteTimeout      time.Duration `json:"writeTimeout,omitempty"      mapstructure:"writeTimeout"      validate:"required,min=1"         yaml:"writeTimeout,omitempty"`
+	ReadHeaderTimeout time.Duration `json:"readHeaderTimeout,omitempty" mapstructure:"readHeaderTimeout" validate:"required,min=1"         yaml:"readHeaderTimeout,omitempty"`
+	TrustedProxies    []string      `json:"trustedProxies,omitempty"    mapstructure:"trustedProxies"    validate:"omitempty,dive,ip|cidr" yaml:"trustedProxies,omitempty"`
 }
 
 // authorizationConfig contains the configurations for request authorization.
@@ -35,6 +38,21 @@ type authorizationConfig struct {
 	HeaderKey string `json:"headerKey,omitempty" mapstructure:"headerKey" validate:"required" yaml:"headerKey,omitempty"`
 }
 
+// rateLimitsConfig contains the token bucket rate limits for each group of endpoints. Requests are throttled by IP
+// address and, for authenticated endpoints, by client ID. Price quote endpoints share a separate group.
+//
+//nolint:lll
+type rateLimitsConfig struct {
+	User      models.RateLimit `json:"user,omitempty"      mapstructure:"user"      validate:"required" yaml:"user,omitempty"`
+	Fiat      models.RateLimit `json:"fiat,omitempty"      mapstructure:"fiat"      validate:"required" yaml:"fiat,omitempty"`
+	Crypto    models.RateLimit `json:"crypto,omitempty"    mapstructure:"crypto"    validate:"required" yaml:"crypto,omitempty"`
+	Quotes    models.RateLimit `json:"quotes,omitempty"    mapstructure:"quotes"    validate:"required" yaml:"quotes,omitempty"`
+	Orders    models.RateLimit `json:"orders,omitempty"    mapstructure:"orders"    validate:"required" yaml:"orders,omitempty"`
+	Schedules models.RateLimit `json:"schedules,omitempty" mapstructure:"schedules" validate:"required" yaml:"schedules,omitempty"`
+	Rates     models.RateLimit `json:"rates,omitempty"     mapstructure:"rates"     validate:"required" yaml:"rates,omitempty"`
+	Admin     models.RateLimit `json:"admin,omitempty"     mapstructure:"admin"     validate:"required" yaml:"admin,omitempty"`
+}
+
 // newConfig creates a blank configuration struct for the authorization.
 func newConfig() *config {
 	return &config{}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 func TestRestConfigs_Load(t *testing.T) {
 	keyspaceServer := constants.HTTPRESTPrefix() + "_SERVER."
 	keyspaceAuth := constants.HTTPRESTPrefix() + "_AUTHORIZATION."
+	keyspaceRateLimits := constants.HTTPRESTPrefix() + "_RATELIMITS."
 
 	testCases := []struct {
 		name         string
@@ -29,7 +31,7 @@ func TestRestConfigs_Load(t *testing.T) {
 			name:         "empty - etc dir",
 			input:        restConfigTestData["empty"],
 			expectErr:    require.Error,
-			expectErrCnt: 8,
+			expectErrCnt: 32,
 		}, {
 			name:         "valid - etc dir",
 			input:        restConfigTestData["valid"],
@@ -75,6 +77,21 @@ func TestRestConfigs_Load(t *testing.T) {
 			input:        restConfigTestData["no auth header"],
 			expectErr:    require.Error,
 			expectErrCnt: 1,
+		}, {
+			name:         "out of range rate limit - etc dir",
+			input:        restConfigTestData["out of range rate limit"],
+			expectErr:    require.Error,
+			expectErrCnt: 3,
+		}, {
+			name:         "no rate limits - etc dir",
+			input:        restConfigTestData["no rate limits"],
+			expectErr:    require.Error,
+			expectErrCnt: 24,
+		}, {
+			name:         "invalid trusted proxy - etc dir",
+			input:        restConfigTestData["invalid trusted proxy"],
+			expectErr:    require.Error,
+			expectErrCnt: 1,
 		},
 	}
 
@@ -114,6 +131,10 @@ func TestRestConfigs_Load(t *testing.T) {
 			readTimeout := time.Duration(4)
 			writeTimeout := time.Duration(5)
 			readHeaderTimeout := time.Duration(7)
+			trustedProxies := []string{"192.168.0.0/16", "172.16.0.1"}
+			quotesRequests := int64(42)
+			quotesPeriod := 3 * time.Minute
+			quotesBurst := int64(7)
 
 			t.Setenv(keyspaceServer+"BASEPATH", basePath)
 			t.Setenv(keyspaceServer+"SWAGGERPATH", swaggerPath)
@@ -122,7 +143,11 @@ func TestRestConfigs_Load(t *testing.T) {
 			t.Setenv(keyspaceServer+"READTIMEOUT", readTimeout.String())
 			t.Setenv(keyspaceServer+"WRITETIMEOUT", writeTimeout.String())
 			t.Setenv(keyspaceServer+"READHEADERTIMEOUT", readHeaderTimeout.String())
+			t.Setenv(keyspaceServer+"TRUSTEDPROXIES", strings.Join(trustedProxies, ","))
 			t.Setenv(keyspaceAuth+"HEADERKEY", headerKey)
+			t.Setenv(keyspaceRateLimits+"QUOTES.REQUESTS", strconv.FormatInt(quotesRequests, 10))
+			t.Setenv(keyspaceRateLimits+"QUOTES.PERIOD", quotesPeriod.String())
+			t.Setenv(keyspaceRateLimits+"QUOTES.BURST", strconv.FormatInt(quotesBurst, 10))
 
 			err = actual.Load(fs)
 			require.NoErrorf(t, err, "Failed to load constants file: %v", err)
@@ -139,8 +164,16 @@ func TestRestConfigs_Load(t *testing.T) {
 				"failed to load write timeout environment variable into configs")
 			require.Equal(t, readHeaderTimeout, actual.Server.ReadHeaderTimeout,
 				"failed to load read header timeout environment variable into configs")
+			require.Equal(t, trustedProxies, actual.Server.TrustedProxies,
+				"failed to load trusted proxies environment variable into configs")
 			require.Equal(t, headerKey, actual.Authorization.HeaderKey,
 				"Failed to load authorization header key environment variable into configs")
+			require.Equal(t, quotesRequests, actual.RateLimits.Quotes.Requests,
+				"Failed to load quotes rate limit requests environment variable into configs")
+			require.Equal(t, quotesPeriod, actual.RateLimits.Quotes.Period,
+				"Failed to load quotes rate limit period environment variable into configs")
+			require.Equal(t, quotesBurst, actual.RateLimits.Quotes.Burst,
+				"Failed to load quotes rate limit burst environment variable into configs")
 		})
 	}
 }
@@ -0,0 +1,634 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// AdminUser will handle an HTTP request by an administrator to retrieve the account information of a user.
+//
+//	@Summary		Retrieve a user's account information.
+//	@Description	Retrieves the Client ID, name, email address, role, and frozen and deleted statuses of a user's account. Requires the administrator role.
+//	@Tags			admin user users
+//	@Id				adminUser
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			username	path		string				true	"the username of the user to retrieve"
+//	@Success		200			{object}	models.HTTPSuccess	"the user's account information in the payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/users/{username} [get]
+func AdminUser(logger *logger.Logger, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			user       *models.HTTPAdminUserResponse
+			httpMsg    string
+			httpStatus int
+			username   = ginCtx.Param("username")
+		)
+
+		if user, httpMsg, httpStatus, err = common.HTTPAdminUser(db, logger, username); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: username})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "user account", Payload: user})
+	}
+}
+
+// AdminBalanceFiatPaginated will handle an HTTP request by an administrator to retrieve a balance for all currency
+// accounts held by a client.
+//
+//	@Summary		Retrieve all the currency balances for a client.
+//	@Description	Retrieves all the currency balances for a client. Pagination follows the client's own balance endpoint. Requires the administrator role.
+//	@Tags			admin fiat currency balance
+//	@Id				adminBalanceFiatPaginated
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID	path		string				true	"the Client ID of the account holder"
+//	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
+//	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	models.HTTPSuccess	"a message to with a page of account balances for the client's accounts"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/accounts/{clientID}/fiat/balance/ [get]
+func AdminBalanceFiatPaginated(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			accDetails  *models.HTTPFiatDetailsPaginated
+			clientID    uuid.UUID
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, httpMessage, httpStatus, err = common.HTTPAdminParseClientID(ginCtx.Param("clientID")); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		if accDetails, httpStatus, httpMessage, err = common.HTTPFiatBalancePaginated(auth, db, logger,
+			clientID, ginCtx.Query("pageCursor"), ginCtx.Query("pageSize"), true); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account balances", Payload: accDetails})
+	}
+}
+
+// AdminTxDetailsFiatPaginated will handle an HTTP request by an administrator to retrieve all transaction details for
+// a currency account held by a client for a given month.
+//
+//	@Summary		Retrieve all the transactions for a client's currency account during a specified month.
+//	@Description	Retrieves the Journal entries for a client's currency account during the specified month. Pagination follows the client's own transactions endpoint. Requires the administrator role.
+//	@Tags			admin fiat currency transaction
+//	@Id				adminTxDetailsFiatPaginated
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID		path		string				true	"the Client ID of the account holder"
+//	@Param			currencyCode	path		string				true	"the currency code to retrieve the transaction details for."
+//	@Param			pageCursor		query		string				false	"The page cursor into the query results records."
+//	@Param			timezone		query		string				false	"The timezone for the month in question."
+//	@Param			month			query		int					false	"The month for which transaction records are being requested."
+//	@Param			year			query		int					false	"The year for the month for which transaction records are being requested."
+//	@Param			pageSize		query		int					false	"The number of records to retrieve on this page."
+//	@Success		200				{object}	models.HTTPSuccess	"a page of the account's transactions"
+//	@Failure		400				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		416				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/accounts/{clientID}/fiat/transactions/{currencyCode} [get]
+func AdminTxDetailsFiatPaginated(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			journalEntries *models.HTTPFiatTransactionsPaginated
+			clientID       uuid.UUID
+			err            error
+			httpStatus     int
+			httpMessage    string
+			payload        any
+
+			params = common.HTTPPaginatedTxParams{
+				PageSizeStr:   ginCtx.Query("pageSize"),
+				PageCursorStr: ginCtx.Query("pageCursor"),
+				TimezoneStr:   ginCtx.Query("timezone"),
+				MonthStr:      ginCtx.Query("month"),
+				YearStr:       ginCtx.Query("year"),
+			}
+		)
+
+		if clientID, httpMessage, httpStatus, err = common.HTTPAdminParseClientID(ginCtx.Param("clientID")); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		if journalEntries, httpStatus, httpMessage, payload, err = common.HTTPFiatTransactionsPaginated(auth, db,
+			logger, clientID, ginCtx.Param("currencyCode"), &params, true); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account transactions", Payload: journalEntries})
+	}
+}
+
+// AdminBalanceCryptoPaginated will handle an HTTP request by an administrator to retrieve a balance for all
+// Cryptocurrency accounts held by a client.
+//
+//	@Summary		Retrieve all the Cryptocurrency balances for a client.
+//	@Description	Retrieves all the Cryptocurrency balances for a client. Pagination follows the client's own balance endpoint. Requires the administrator role.
+//	@Tags			admin crypto cryptocurrency balance
+//	@Id				adminBalanceCryptoPaginated
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID	path		string				true	"the Client ID of the account holder"
+//	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
+//	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	models.HTTPSuccess	"a message to with a page of account balances for the client's accounts"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/accounts/{clientID}/crypto/balance/ [get]
+func AdminBalanceCryptoPaginated(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			accDetails  models.HTTPCryptoDetailsPaginated
+			clientID    uuid.UUID
+			err         error
+			httpStatus  int
+			httpMessage string
+		)
+
+		if clientID, httpMessage, httpStatus, err = common.HTTPAdminParseClientID(ginCtx.Param("clientID")); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		if accDetails, httpStatus, httpMessage, err = common.HTTPCryptoBalancePaginated(auth, db, logger,
+			clientID, ginCtx.Query("pageCursor"), ginCtx.Query("pageSize"), true); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account balances", Payload: accDetails})
+	}
+}
+
+// AdminTxDetailsCryptoPaginated will handle an HTTP request by an administrator to retrieve all transaction details for
+// a Cryptocurrency account held by a client for a given month.
+//
+//	@Summary		Retrieve all the transactions for a client's Cryptocurrency account during a specified month.
+//	@Description	Retrieves the Journal entries for a client's Cryptocurrency account during the specified month. Pagination follows the client's own transactions endpoint. Requires the administrator role.
+//	@Tags			admin crypto cryptocurrency transaction
+//	@Id				adminTxDetailsCryptoPaginated
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID	path		string				true	"the Client ID of the account holder"
+//	@Param			ticker		path		string				true	"the Cryptocurrency ticker to retrieve the transaction details for."
+//	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
+//	@Param			timezone	query		string				false	"The timezone for the month in question."
+//	@Param			month		query		int					false	"The month for which transaction records are being requested."
+//	@Param			year		query		int					false	"The year for the month for which transaction records are being requested."
+//	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	models.HTTPSuccess	"a page of the account's transactions"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		416			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/accounts/{clientID}/crypto/transactions/{ticker} [get]
+func AdminTxDetailsCryptoPaginated(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			journalEntries models.HTTPCryptoTransactionsPaginated
+			clientID       uuid.UUID
+			err            error
+			httpStatus     int
+			httpMessage    string
+
+			params = common.HTTPPaginatedTxParams{
+				PageSizeStr:   ginCtx.Query("pageSize"),
+				PageCursorStr: ginCtx.Query("pageCursor"),
+				TimezoneStr:   ginCtx.Query("timezone"),
+				MonthStr:      ginCtx.Query("month"),
+				YearStr:       ginCtx.Query("year"),
+			}
+		)
+
+		if clientID, httpMessage, httpStatus, err = common.HTTPAdminParseClientID(ginCtx.Param("clientID")); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		if journalEntries, httpStatus, httpMessage, err = common.HTTPCryptoTransactionsPaginated(auth, db, logger,
+			&params, clientID, ginCtx.Param("ticker"), true); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account transactions", Payload: journalEntries})
+	}
+}
+
+// AdminTxDetails will handle an HTTP request by an administrator to retrieve the Journal entries for a client's
+// transaction.
+//
+//	@Summary		Retrieve the Journal entries for a client's transaction.
+//	@Description	Retrieves the Fiat and Cryptocurrency Journal entries for a client's transaction. Requires the administrator role.
+//	@Tags			admin transaction
+//	@Id				adminTxDetails
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID		path		string				true	"the Client ID of the account holder"
+//	@Param			transactionID	path		string				true	"the transaction ID to retrieve the Journal entries for"
+//	@Success		200				{object}	models.HTTPSuccess	"the transaction's Journal entries in the payload"
+//	@Failure		400				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/accounts/{clientID}/transaction/{transactionID} [get]
+func AdminTxDetails(logger *logger.Logger, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err            error
+			clientID       uuid.UUID
+			journalEntries []any
+			httpMsg        string
+			httpStatus     int
+			transactionID  = ginCtx.Param("transactionID")
+		)
+
+		if clientID, httpMsg, httpStatus, err = common.HTTPAdminParseClientID(ginCtx.Param("clientID")); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg})
+
+			return
+		}
+
+		if journalEntries, httpStatus, httpMsg, err = common.HTTPTxDetails(db, logger, clientID, transactionID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMsg})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "transaction details", Payload: journalEntries})
+	}
+}
+
+// AdminFreezeAccount will handle an HTTP request by an administrator to freeze a user's account.
+//
+//	@Summary		Freeze a user's account.
+//	@Description	Freezes a user's account. Frozen accounts cannot trade, deposit, or withdraw funds, and their open limit orders and recurring purchase schedules are not executed. Balances and transactions can still be viewed. Requires the administrator role.
+//	@Tags			admin user users freeze
+//	@Id				adminFreezeAccount
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID	path		string				true	"the Client ID of the account holder"
+//	@Success		200			{object}	models.HTTPSuccess	"a message to confirm the account has been frozen"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/accounts/{clientID}/freeze [post]
+func AdminFreezeAccount(logger *logger.Logger, db postgres.Postgres) gin.HandlerFunc {
+	return adminSetFrozen(logger, db, true, "account frozen")
+}
+
+// AdminUnfreezeAccount will handle an HTTP request by an administrator to unfreeze a user's account.
+//
+//	@Summary		Unfreeze a user's account.
+//	@Description	Unfreezes a user's account so that it can trade, deposit, and withdraw funds again. Requires the administrator role.
+//	@Tags			admin user users freeze
+//	@Id				adminUnfreezeAccount
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID	path		string				true	"the Client ID of the account holder"
+//	@Success		200			{object}	models.HTTPSuccess	"a message to confirm the account has been unfrozen"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/accounts/{clientID}/unfreeze [post]
+func AdminUnfreezeAccount(logger *logger.Logger, db postgres.Postgres) gin.HandlerFunc {
+	return adminSetFrozen(logger, db, false, "account unfrozen")
+}
+
+// adminSetFrozen will create the handler to freeze or unfreeze a user's account.
+func adminSetFrozen(logger *logger.Logger, db postgres.Postgres, isFrozen bool, successMsg string) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		clientID := ginCtx.Param("clientID")
+
+		if httpMsg, httpStatus, err := common.HTTPAdminSetFrozen(db, logger, clientID, isFrozen); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: clientID})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: successMsg, Payload: clientID})
+	}
+}
+
+// AdminReverseDeposit will handle an HTTP request by an administrator to reverse an erroneous Fiat deposit.
+//
+//	@Summary		Reverse a Fiat deposit.
+//	@Description	Reverses an erroneous Fiat deposit by posting compensating Journal entries that withdraw the deposited amount. The deposit itself is never edited or removed, and can only be reversed once. The account must have a sufficient balance to cover the reversal. Requires the administrator role.
+//	@Tags			admin fiat currency deposit reverse
+//	@Id				adminReverseDeposit
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID		path		string				true	"the Client ID of the account holder"
+//	@Param			transactionID	path		string				true	"the transaction ID of the deposit to reverse"
+//	@Success		200				{object}	models.HTTPSuccess	"a message to confirm the reversal with the reversal transaction receipt in the payload"
+//	@Failure		400				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		409				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/admin/accounts/{clientID}/fiat/reverse/{transactionID} [post]
+func AdminReverseDeposit(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			adminID    uuid.UUID
+			receipt    *postgres.FiatAccountTransferResult
+			httpMsg    string
+			httpStatus int
+		)
+
+		if adminID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if receipt, httpMsg, httpStatus, err = common.HTTPAdminReverseDeposit(db, logger, adminID,
+			ginCtx.Param("clientID"), ginCtx.Param("transactionID")); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "deposit reversed", Payload: receipt})
+	}
+}
+
+// AdminSetStatusFiat will handle an HTTP request by an administrator to set the status of a user's Fiat currency
+// account.
+//
+//	@Summary		Set the status of a Fiat currency account.
+//	@Description	Sets the status of a user's Fiat currency account to active, frozen, or closed. Accounts that are not active cannot send or receive funds. Requires the administrator role.
+//	@Tags			admin fiat status
+//	@Id				adminSetStatusFiat
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID	path		string							true	"the Client ID of the account holder"
+//	@Param			request		body		models.HTTPAccountStatusRequest	true	"the Fiat currency code and the new account status"
+//	@Success		200			{object}	models.HTTPSuccess				"a message to confirm the status update with the account balance in the payload"
+//	@Failure		400			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Router			/admin/accounts/{clientID}/fiat/status [post]
+func AdminSetStatusFiat(logger *logger.Logger, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			account    *postgres.FiatAccount
+			request    models.HTTPAccountStatusRequest
+			httpMsg    string
+			httpStatus int
+			payload    any
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, &models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if account, httpMsg, httpStatus, payload, err =
+			common.HTTPAdminFiatSetStatus(db, logger, ginCtx.Param("clientID"), &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account status updated", Payload: account})
+	}
+}
+
+// AdminHoldFiat will handle an HTTP request by an administrator to place a hold on funds in a user's Fiat currency
+// account.
+//
+//	@Summary		Place a hold on funds in a Fiat currency account.
+//	@Description	Places a hold on funds in a user's Fiat currency account. Funds on hold remain in the balance but cannot be withdrawn, transferred, or exchanged, and the total on hold cannot exceed the balance. Requires the administrator role.
+//	@Tags			admin fiat hold
+//	@Id				adminHoldFiat
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID	path		string							true	"the Client ID of the account holder"
+//	@Param			request		body		models.HTTPAccountHoldRequest	true	"the Fiat currency code and the amount to place on hold"
+//	@Success		200			{object}	models.HTTPSuccess				"a message to confirm the hold update with the account balance in the payload"
+//	@Failure		400			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		409			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Router			/admin/accounts/{clientID}/fiat/hold [post]
+func AdminHoldFiat(logger *logger.Logger, db postgres.Postgres) gin.HandlerFunc {
+	return adminUpdateHoldFiat(logger, db, false, "funds placed on hold")
+}
+
+// AdminReleaseFiat will handle an HTTP request by an administrator to release a hold on funds in a user's Fiat currency
+// account.
+//
+//	@Summary		Release a hold on funds in a Fiat currency account.
+//	@Description	Releases funds on hold in a user's Fiat currency account so they are available again. The amount released cannot exceed the funds on hold. Requires the administrator role.
+//	@Tags			admin fiat hold
+//	@Id				adminReleaseFiat
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID	path		string							true	"the Client ID of the account holder"
+//	@Param			request		body		models.HTTPAccountHoldRequest	true	"the Fiat currency code and the amount to release"
+//	@Success		200			{object}	models.HTTPSuccess				"a message to confirm the hold update with the account balance in the payload"
+//	@Failure		400			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		409			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Router			/admin/accounts/{clientID}/fiat/release [post]
+func AdminReleaseFiat(logger *logger.Logger, db postgres.Postgres) gin.HandlerFunc {
+	return adminUpdateHoldFiat(logger, db, true, "funds released from hold")
+}
+
+// adminUpdateHoldFiat will create the handler to place or release a hold on funds in a user's Fiat currency account.
+func adminUpdateHoldFiat(logger *logger.Logger, db postgres.Postgres, release bool, successMsg string) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			account    *postgres.FiatAccount
+			request    models.HTTPAccountHoldRequest
+			httpMsg    string
+			httpStatus int
+			payload    any
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, &models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if account, httpMsg, httpStatus, payload, err =
+			common.HTTPAdminFiatUpdateHold(db, logger, ginCtx.Param("clientID"), &request, release); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: successMsg, Payload: account})
+	}
+}
+
+// AdminSetStatusCrypto will handle an HTTP request by an administrator to set the status of a user's Cryptocurrency
+// account.
+//
+//	@Summary		Set the status of a Cryptocurrency account.
+//	@Description	Sets the status of a user's Cryptocurrency account to active, frozen, or closed. Accounts that are not active cannot send or receive funds. Requires the administrator role.
+//	@Tags			admin crypto status
+//	@Id				adminSetStatusCrypto
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID	path		string							true	"the Client ID of the account holder"
+//	@Param			request		body		models.HTTPAccountStatusRequest	true	"the Cryptocurrency ticker and the new account status"
+//	@Success		200			{object}	models.HTTPSuccess				"a message to confirm the status update with the account balance in the payload"
+//	@Failure		400			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Router			/admin/accounts/{clientID}/crypto/status [post]
+func AdminSetStatusCrypto(logger *logger.Logger, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			account    *postgres.CryptoAccount
+			request    models.HTTPAccountStatusRequest
+			httpMsg    string
+			httpStatus int
+			payload    any
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, &models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if account, httpMsg, httpStatus, payload, err =
+			common.HTTPAdminCryptoSetStatus(db, logger, ginCtx.Param("clientID"), &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "account status updated", Payload: account})
+	}
+}
+
+// AdminHoldCrypto will handle an HTTP request by an administrator to place a hold on funds in a user's Cryptocurrency
+// account.
+//
+//	@Summary		Place a hold on funds in a Cryptocurrency account.
+//	@Description	Places a hold on funds in a user's Cryptocurrency account. Funds on hold remain in the balance but cannot be sold or swapped, and the total on hold cannot exceed the balance. Requires the administrator role.
+//	@Tags			admin crypto hold
+//	@Id				adminHoldCrypto
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID	path		string							true	"the Client ID of the account holder"
+//	@Param			request		body		models.HTTPAccountHoldRequest	true	"the Cryptocurrency ticker and the amount to place on hold"
+//	@Success		200			{object}	models.HTTPSuccess				"a message to confirm the hold update with the account balance in the payload"
+//	@Failure		400			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		409			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Router			/admin/accounts/{clientID}/crypto/hold [post]
+func AdminHoldCrypto(logger *logger.Logger, db postgres.Postgres) gin.HandlerFunc {
+	return adminUpdateHoldCrypto(logger, db, false, "funds placed on hold")
+}
+
+// AdminReleaseCrypto will handle an HTTP request by an administrator to release a hold on funds in a user's
+// Cryptocurrency account.
+//
+//	@Summary		Release a hold on funds in a Cryptocurrency account.
+//	@Description	Releases funds on hold in a user's Cryptocurrency account so they are available again. The amount released cannot exceed the funds on hold. Requires the administrator role.
+//	@Tags			admin crypto hold
+//	@Id				adminReleaseCrypto
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clientID	path		string							true	"the Client ID of the account holder"
+//	@Param			request		body		models.HTTPAccountHoldRequest	true	"the Cryptocurrency ticker and the amount to release"
+//	@Success		200			{object}	models.HTTPSuccess				"a message to confirm the hold update with the account balance in the payload"
+//	@Failure		400			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		409			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Router			/admin/accounts/{clientID}/crypto/release [post]
+func AdminReleaseCrypto(logger *logger.Logger, db postgres.Postgres) gin.HandlerFunc {
+	return adminUpdateHoldCrypto(logger, db, true, "funds released from hold")
+}
+
+// adminUpdateHoldCrypto will create the handler to place or release a hold on funds in a user's Cryptocurrency account.
+func adminUpdateHoldCrypto(logger *logger.Logger, db postgres.Postgres, release bool,
+	successMsg string) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			account    *postgres.CryptoAccount
+			request    models.HTTPAccountHoldRequest
+			httpMsg    string
+			httpStatus int
+			payload    any
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, &models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if account, httpMsg, httpStatus, payload, err =
+			common.HTTPAdminCryptoUpdateHold(db, logger, ginCtx.Param("clientID"), &request, release); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: successMsg, Payload: account})
+	}
+}
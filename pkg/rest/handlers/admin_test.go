@@ -0,0 +1,916 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// adminTestResponseMessage will extract the message from an administrative endpoint's response.
+func adminTestResponseMessage(t *testing.T, recorder *httptest.ResponseRecorder) string {
+	t.Helper()
+
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+	actualMessage, ok := resp["message"].(string)
+	require.True(t, ok, "failed to extract response message.")
+
+	return actualMessage
+}
+
+func TestHandlers_AdminUser(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/users/"
+
+	testCases := []struct {
+		name             string
+		expectedMsg      string
+		expectedStatus   int
+		getClientIDErr   error
+		getClientIDTimes int
+		getInfoErr       error
+		getInfoTimes     int
+	}{
+		{
+			name:             "unknown user",
+			expectedMsg:      "user not found",
+			expectedStatus:   http.StatusNotFound,
+			getClientIDErr:   postgres.ErrNotFoundUser,
+			getClientIDTimes: 1,
+			getInfoErr:       nil,
+			getInfoTimes:     0,
+		}, {
+			name:             "db failure",
+			expectedMsg:      "retry",
+			expectedStatus:   http.StatusInternalServerError,
+			getClientIDErr:   nil,
+			getClientIDTimes: 1,
+			getInfoErr:       errors.New("db failure"),
+			getInfoTimes:     1,
+		}, {
+			name:             "valid",
+			expectedMsg:      "user account",
+			expectedStatus:   http.StatusOK,
+			getClientIDErr:   nil,
+			getClientIDTimes: 1,
+			getInfoErr:       nil,
+			getInfoTimes:     1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockDB.EXPECT().UserGetClientID("username1").
+					Return(uuid.UUID{}, test.getClientIDErr).
+					Times(test.getClientIDTimes),
+
+				mockDB.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{}}, test.getInfoErr).
+					Times(test.getInfoTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+":username", AdminUser(zapLogger, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, basePath+"username1", nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+			require.Contains(t, adminTestResponseMessage(t, recorder), test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_AdminBalanceFiatPaginated(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/accounts/"
+
+	clientID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name             string
+		clientID         string
+		expectedMsg      string
+		expectedStatus   int
+		fiatBalanceErr   error
+		fiatBalanceTimes int
+	}{
+		{
+			name:             "invalid client id",
+			clientID:         "invalid-client-id",
+			expectedMsg:      "invalid client ID",
+			expectedStatus:   http.StatusBadRequest,
+			fiatBalanceErr:   nil,
+			fiatBalanceTimes: 0,
+		}, {
+			name:             "db failure",
+			clientID:         clientID.String(),
+			expectedMsg:      "records not found",
+			expectedStatus:   http.StatusNotFound,
+			fiatBalanceErr:   postgres.ErrNotFound,
+			fiatBalanceTimes: 1,
+		}, {
+			name:             "valid",
+			clientID:         clientID.String(),
+			expectedMsg:      "account balances",
+			expectedStatus:   http.StatusOK,
+			fiatBalanceErr:   nil,
+			fiatBalanceTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().FiatBalancePaginated(clientID, gomock.Any(), gomock.Any()).
+				Return([]postgres.FiatAccount{{}}, test.fiatBalanceErr).
+				Times(test.fiatBalanceTimes)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+":clientID/fiat/balance/", AdminBalanceFiatPaginated(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet,
+				basePath+test.clientID+"/fiat/balance/", nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+			require.Contains(t, adminTestResponseMessage(t, recorder), test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_AdminTxDetailsFiatPaginated(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/accounts/"
+
+	clientID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name           string
+		clientID       string
+		querySegment   string
+		expectedMsg    string
+		expectedStatus int
+		fiatTxTimes    int
+	}{
+		{
+			name:           "invalid client id",
+			clientID:       "invalid-client-id",
+			querySegment:   "?month=6&year=2023",
+			expectedMsg:    "invalid client ID",
+			expectedStatus: http.StatusBadRequest,
+			fiatTxTimes:    0,
+		}, {
+			name:           "missing parameters",
+			clientID:       clientID.String(),
+			querySegment:   "",
+			expectedMsg:    "missing required parameters",
+			expectedStatus: http.StatusBadRequest,
+			fiatTxTimes:    0,
+		}, {
+			name:           "valid",
+			clientID:       clientID.String(),
+			querySegment:   "?month=6&year=2023",
+			expectedMsg:    "account transactions",
+			expectedStatus: http.StatusOK,
+			fiatTxTimes:    1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockAuth.EXPECT().EncryptToString(gomock.Any()).
+				Return("encrypted-page-cursor", nil).
+				AnyTimes()
+
+			mockDB.EXPECT().FiatTransactionsPaginated(
+				clientID, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]postgres.FiatJournal{{}}, nil).
+				Times(test.fiatTxTimes)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+":clientID/fiat/transactions/:currencyCode",
+				AdminTxDetailsFiatPaginated(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet,
+				basePath+test.clientID+"/fiat/transactions/USD"+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+			require.Contains(t, adminTestResponseMessage(t, recorder), test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_AdminBalanceCryptoPaginated(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/accounts/"
+
+	clientID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name               string
+		clientID           string
+		expectedMsg        string
+		expectedStatus     int
+		cryptoBalanceErr   error
+		cryptoBalanceTimes int
+	}{
+		{
+			name:               "invalid client id",
+			clientID:           "invalid-client-id",
+			expectedMsg:        "invalid client ID",
+			expectedStatus:     http.StatusBadRequest,
+			cryptoBalanceErr:   nil,
+			cryptoBalanceTimes: 0,
+		}, {
+			name:               "db failure",
+			clientID:           clientID.String(),
+			expectedMsg:        "records not found",
+			expectedStatus:     http.StatusNotFound,
+			cryptoBalanceErr:   postgres.ErrNotFound,
+			cryptoBalanceTimes: 1,
+		}, {
+			name:               "valid",
+			clientID:           clientID.String(),
+			expectedMsg:        "account balances",
+			expectedStatus:     http.StatusOK,
+			cryptoBalanceErr:   nil,
+			cryptoBalanceTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().CryptoBalancesPaginated(clientID, gomock.Any(), gomock.Any()).
+				Return([]postgres.CryptoAccount{{}}, test.cryptoBalanceErr).
+				Times(test.cryptoBalanceTimes)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+":clientID/crypto/balance/", AdminBalanceCryptoPaginated(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet,
+				basePath+test.clientID+"/crypto/balance/", nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+			require.Contains(t, adminTestResponseMessage(t, recorder), test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_AdminTxDetailsCryptoPaginated(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/accounts/"
+
+	clientID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name           string
+		clientID       string
+		querySegment   string
+		expectedMsg    string
+		expectedStatus int
+		cryptoTxTimes  int
+	}{
+		{
+			name:           "invalid client id",
+			clientID:       "invalid-client-id",
+			querySegment:   "?month=6&year=2023",
+			expectedMsg:    "invalid client ID",
+			expectedStatus: http.StatusBadRequest,
+			cryptoTxTimes:  0,
+		}, {
+			name:           "missing parameters",
+			clientID:       clientID.String(),
+			querySegment:   "",
+			expectedMsg:    "missing required parameters",
+			expectedStatus: http.StatusBadRequest,
+			cryptoTxTimes:  0,
+		}, {
+			name:           "valid",
+			clientID:       clientID.String(),
+			querySegment:   "?month=6&year=2023",
+			expectedMsg:    "account transactions",
+			expectedStatus: http.StatusOK,
+			cryptoTxTimes:  1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockAuth.EXPECT().EncryptToString(gomock.Any()).
+				Return("encrypted-page-cursor", nil).
+				AnyTimes()
+
+			mockDB.EXPECT().CryptoTransactionsPaginated(
+				clientID, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]postgres.CryptoJournal{{}}, nil).
+				Times(test.cryptoTxTimes)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+":clientID/crypto/transactions/:ticker",
+				AdminTxDetailsCryptoPaginated(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet,
+				basePath+test.clientID+"/crypto/transactions/BTC"+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+			require.Contains(t, adminTestResponseMessage(t, recorder), test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_AdminTxDetails(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/accounts/"
+
+	clientID := uuid.Must(uuid.NewV4())
+	txID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name           string
+		clientID       string
+		txID           string
+		expectedMsg    string
+		expectedStatus int
+		fiatTxErr      error
+		fiatTxTimes    int
+		cryptoTxTimes  int
+	}{
+		{
+			name:           "invalid client id",
+			clientID:       "invalid-client-id",
+			txID:           txID.String(),
+			expectedMsg:    "invalid client ID",
+			expectedStatus: http.StatusBadRequest,
+			fiatTxErr:      nil,
+			fiatTxTimes:    0,
+			cryptoTxTimes:  0,
+		}, {
+			name:           "invalid transaction id",
+			clientID:       clientID.String(),
+			txID:           "invalid-tx-id",
+			expectedMsg:    "invalid transaction ID",
+			expectedStatus: http.StatusBadRequest,
+			fiatTxErr:      nil,
+			fiatTxTimes:    0,
+			cryptoTxTimes:  0,
+		}, {
+			name:           "db failure",
+			clientID:       clientID.String(),
+			txID:           txID.String(),
+			expectedMsg:    "records not found",
+			expectedStatus: http.StatusNotFound,
+			fiatTxErr:      postgres.ErrNotFound,
+			fiatTxTimes:    1,
+			cryptoTxTimes:  0,
+		}, {
+			name:           "valid",
+			clientID:       clientID.String(),
+			txID:           txID.String(),
+			expectedMsg:    "transaction details",
+			expectedStatus: http.StatusOK,
+			fiatTxErr:      nil,
+			fiatTxTimes:    1,
+			cryptoTxTimes:  1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockDB.EXPECT().FiatTxDetails(clientID, txID).
+					Return([]postgres.FiatJournal{{}}, test.fiatTxErr).
+					Times(test.fiatTxTimes),
+
+				mockDB.EXPECT().CryptoTxDetails(clientID, txID).
+					Return([]postgres.CryptoJournal{}, nil).
+					Times(test.cryptoTxTimes),
+			)
+
+			mockDB.EXPECT().TradeTxDetails(clientID, txID).
+				Return([]postgres.Trade{}, nil).
+				AnyTimes()
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+":clientID/transaction/:transactionID", AdminTxDetails(zapLogger, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet,
+				basePath+test.clientID+"/transaction/"+test.txID, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+			require.Contains(t, adminTestResponseMessage(t, recorder), test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_AdminFreezeAccount(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/accounts/"
+
+	clientID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name           string
+		clientID       string
+		isFrozen       bool
+		expectedMsg    string
+		expectedStatus int
+		setFrozenErr   error
+		setFrozenTimes int
+	}{
+		{
+			name:           "invalid client id",
+			clientID:       "invalid-client-id",
+			isFrozen:       true,
+			expectedMsg:    "invalid client ID",
+			expectedStatus: http.StatusBadRequest,
+			setFrozenErr:   nil,
+			setFrozenTimes: 0,
+		}, {
+			name:           "unknown user",
+			clientID:       clientID.String(),
+			isFrozen:       true,
+			expectedMsg:    "user not found",
+			expectedStatus: http.StatusNotFound,
+			setFrozenErr:   postgres.ErrNotFoundUser,
+			setFrozenTimes: 1,
+		}, {
+			name:           "freeze",
+			clientID:       clientID.String(),
+			isFrozen:       true,
+			expectedMsg:    "account frozen",
+			expectedStatus: http.StatusOK,
+			setFrozenErr:   nil,
+			setFrozenTimes: 1,
+		}, {
+			name:           "unfreeze",
+			clientID:       clientID.String(),
+			isFrozen:       false,
+			expectedMsg:    "account unfrozen",
+			expectedStatus: http.StatusOK,
+			setFrozenErr:   nil,
+			setFrozenTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().UserSetFrozen(clientID, test.isFrozen).
+				Return(test.setFrozenErr).
+				Times(test.setFrozenTimes)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(basePath+":clientID/freeze", AdminFreezeAccount(zapLogger, mockDB))
+			router.POST(basePath+":clientID/unfreeze", AdminUnfreezeAccount(zapLogger, mockDB))
+
+			action := "/unfreeze"
+			if test.isFrozen {
+				action = "/freeze"
+			}
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, basePath+test.clientID+action, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+			require.Contains(t, adminTestResponseMessage(t, recorder), test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_AdminReverseDeposit(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/accounts/"
+
+	clientID := uuid.Must(uuid.NewV4())
+	txID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name               string
+		clientID           string
+		txID               string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		reverseErr         error
+		reverseTimes       int
+	}{
+		{
+			name:               "invalid jwt",
+			clientID:           clientID.String(),
+			txID:               txID.String(),
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid jwt"),
+			authTokenInfoTimes: 1,
+			reverseErr:         nil,
+			reverseTimes:       0,
+		}, {
+			name:               "invalid transaction id",
+			clientID:           clientID.String(),
+			txID:               "invalid-tx-id",
+			expectedMsg:        "invalid transaction ID",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			reverseErr:         nil,
+			reverseTimes:       0,
+		}, {
+			name:               "already reversed",
+			clientID:           clientID.String(),
+			txID:               txID.String(),
+			expectedMsg:        "could not reverse Fiat deposit",
+			expectedStatus:     http.StatusConflict,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			reverseErr:         postgres.ErrReverseFiat,
+			reverseTimes:       1,
+		}, {
+			name:               "valid",
+			clientID:           clientID.String(),
+			txID:               txID.String(),
+			expectedMsg:        "deposit reversed",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			reverseErr:         nil,
+			reverseTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().FiatReverseDeposit(gomock.Any(), gomock.Any(), clientID, txID).
+					Return(&postgres.FiatAccountTransferResult{}, test.reverseErr).
+					Times(test.reverseTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(basePath+":clientID/fiat/reverse/:transactionID", AdminReverseDeposit(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost,
+				basePath+test.clientID+"/fiat/reverse/"+test.txID, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+			require.Contains(t, adminTestResponseMessage(t, recorder), test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_AdminSetStatus(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/accounts/"
+
+	clientID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name           string
+		path           string
+		request        *models.HTTPAccountStatusRequest
+		expectedMsg    string
+		expectedStatus int
+		fiatErr        error
+		fiatTimes      int
+		cryptoErr      error
+		cryptoTimes    int
+	}{
+		{
+			name:           "empty request",
+			path:           "/fiat/status",
+			request:        &models.HTTPAccountStatusRequest{},
+			expectedMsg:    "validation",
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "invalid status",
+			path:           "/fiat/status",
+			request:        &models.HTTPAccountStatusRequest{Currency: "USD", Status: "suspended"},
+			expectedMsg:    "invalid account status",
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "fiat account not found",
+			path:           "/fiat/status",
+			request:        &models.HTTPAccountStatusRequest{Currency: "USD", Status: "frozen"},
+			expectedMsg:    "not found",
+			expectedStatus: http.StatusNotFound,
+			fiatErr:        postgres.ErrNotFound,
+			fiatTimes:      1,
+		}, {
+			name:           "fiat valid",
+			path:           "/fiat/status",
+			request:        &models.HTTPAccountStatusRequest{Currency: "USD", Status: "frozen"},
+			expectedMsg:    "account status updated",
+			expectedStatus: http.StatusOK,
+			fiatTimes:      1,
+		}, {
+			name:           "crypto account not found",
+			path:           "/crypto/status",
+			request:        &models.HTTPAccountStatusRequest{Currency: "BTC", Status: "frozen"},
+			expectedMsg:    "not found",
+			expectedStatus: http.StatusNotFound,
+			cryptoErr:      postgres.ErrNotFound,
+			cryptoTimes:    1,
+		}, {
+			name:           "crypto valid",
+			path:           "/crypto/status",
+			request:        &models.HTTPAccountStatusRequest{Currency: "BTC", Status: "frozen"},
+			expectedMsg:    "account status updated",
+			expectedStatus: http.StatusOK,
+			cryptoTimes:    1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockDB.EXPECT().FiatSetStatus(clientID, postgres.CurrencyUSD, postgres.AccountStatusFrozen).
+					Return(postgres.FiatAccount{}, test.fiatErr).
+					Times(test.fiatTimes),
+
+				mockDB.EXPECT().CryptoSetStatus(clientID, "BTC", postgres.AccountStatusFrozen).
+					Return(postgres.CryptoAccount{}, test.cryptoErr).
+					Times(test.cryptoTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(basePath+":clientID/fiat/status", AdminSetStatusFiat(zapLogger, mockDB))
+			router.POST(basePath+":clientID/crypto/status", AdminSetStatusCrypto(zapLogger, mockDB))
+
+			body, err := json.Marshal(test.request)
+			require.NoError(t, err, "failed to marshall request.")
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost,
+				basePath+clientID.String()+test.path, bytes.NewBuffer(body))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+			require.Contains(t, adminTestResponseMessage(t, recorder), test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_AdminUpdateHold(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/admin/accounts/"
+
+	clientID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name           string
+		path           string
+		request        *models.HTTPAccountHoldRequest
+		expectedMsg    string
+		expectedStatus int
+		expectedAmount decimal.Decimal
+		fiatErr        error
+		fiatTimes      int
+		cryptoErr      error
+		cryptoTimes    int
+	}{
+		{
+			name:           "empty request",
+			path:           "/fiat/hold",
+			request:        &models.HTTPAccountHoldRequest{},
+			expectedMsg:    "validation",
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "invalid amount",
+			path:           "/fiat/hold",
+			request:        &models.HTTPAccountHoldRequest{Currency: "USD", Amount: decimal.NewFromFloat(1.001)},
+			expectedMsg:    "invalid amount",
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "fiat hold exceeds balance",
+			path:           "/fiat/hold",
+			request:        &models.HTTPAccountHoldRequest{Currency: "USD", Amount: decimal.NewFromFloat(10)},
+			expectedMsg:    "could not update funds on hold",
+			expectedStatus: http.StatusConflict,
+			expectedAmount: decimal.RequireFromString("10"),
+			fiatErr:        postgres.ErrUpdateHold,
+			fiatTimes:      1,
+		}, {
+			name:           "fiat hold",
+			path:           "/fiat/hold",
+			request:        &models.HTTPAccountHoldRequest{Currency: "USD", Amount: decimal.NewFromFloat(10)},
+			expectedMsg:    "funds placed on hold",
+			expectedStatus: http.StatusOK,
+			expectedAmount: decimal.RequireFromString("10"),
+			fiatTimes:      1,
+		}, {
+			name:           "fiat release",
+			path:           "/fiat/release",
+			request:        &models.HTTPAccountHoldRequest{Currency: "USD", Amount: decimal.NewFromFloat(10)},
+			expectedMsg:    "funds released from hold",
+			expectedStatus: http.StatusOK,
+			expectedAmount: decimal.RequireFromString("-10"),
+			fiatTimes:      1,
+		}, {
+			name:           "crypto hold",
+			path:           "/crypto/hold",
+			request:        &models.HTTPAccountHoldRequest{Currency: "BTC", Amount: decimal.NewFromFloat(0.5)},
+			expectedMsg:    "funds placed on hold",
+			expectedStatus: http.StatusOK,
+			expectedAmount: decimal.RequireFromString("0.5"),
+			cryptoTimes:    1,
+		}, {
+			name:           "crypto release exceeds hold",
+			path:           "/crypto/release",
+			request:        &models.HTTPAccountHoldRequest{Currency: "BTC", Amount: decimal.NewFromFloat(0.5)},
+			expectedMsg:    "could not update funds on hold",
+			expectedStatus: http.StatusConflict,
+			expectedAmount: decimal.RequireFromString("-0.5"),
+			cryptoErr:      postgres.ErrUpdateHold,
+			cryptoTimes:    1,
+		}, {
+			name:           "crypto release",
+			path:           "/crypto/release",
+			request:        &models.HTTPAccountHoldRequest{Currency: "BTC", Amount: decimal.NewFromFloat(0.5)},
+			expectedMsg:    "funds released from hold",
+			expectedStatus: http.StatusOK,
+			expectedAmount: decimal.RequireFromString("-0.5"),
+			cryptoTimes:    1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockDB.EXPECT().FiatUpdateHold(clientID, postgres.CurrencyUSD, test.expectedAmount).
+					Return(postgres.FiatAccount{}, test.fiatErr).
+					Times(test.fiatTimes),
+
+				mockDB.EXPECT().CryptoUpdateHold(clientID, "BTC", test.expectedAmount).
+					Return(postgres.CryptoAccount{}, test.cryptoErr).
+					Times(test.cryptoTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(basePath+":clientID/fiat/hold", AdminHoldFiat(zapLogger, mockDB))
+			router.POST(basePath+":clientID/fiat/release", AdminReleaseFiat(zapLogger, mockDB))
+			router.POST(basePath+":clientID/crypto/hold", AdminHoldCrypto(zapLogger, mockDB))
+			router.POST(basePath+":clientID/crypto/release", AdminReleaseCrypto(zapLogger, mockDB))
+
+			body, err := json.Marshal(test.request)
+			require.NoError(t, err, "failed to marshall request.")
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost,
+				basePath+clientID.String()+test.path, bytes.NewBuffer(body))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+			require.Contains(t, adminTestResponseMessage(t, recorder), test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// CreateAPIKey will generate an API key with scopes for a programmatic client.
+//
+//	@Summary		Create an API key.
+//	@Description	Generates an API key that programmatic clients can supply in the X-API-Key header in place of a JWT. The key can only access endpoints covered by its scopes: read-balances, trade, deposit, and withdraw. Only a hash of the key is stored and the key is only ever returned once.
+//	@Tags			user users api-keys create security
+//	@Id				createAPIKey
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		models.HTTPAPIKeyRequest	true	"the name of the API key and the scopes it grants"
+//	@Success		201		{object}	models.HTTPSuccess			"a message to confirm the creation of the API key with the key in the payload"
+//	@Failure		400		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Router			/user/api-keys/create [post]
+func CreateAPIKey(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			clientID   uuid.UUID
+			request    models.HTTPAPIKeyRequest
+			apiKey     *models.HTTPAPIKeyResponse
+			httpMsg    string
+			httpStatus int
+			payload    any
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, &models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if apiKey, httpMsg, httpStatus, payload, err =
+			common.HTTPAPIKeyCreate(auth, db, logger, clientID, &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusCreated, models.HTTPSuccess{
+			Message: "store the api key securely, it will not be shown again",
+			Payload: apiKey,
+		})
+	}
+}
+
+// APIKeys will retrieve the API keys of a client that have not been revoked.
+//
+//	@Summary		Retrieve all the API keys for a specific client.
+//	@Description	Retrieves the name, scopes, and creation time of all the API keys for a specific client that have not been revoked, oldest first. The keys themselves are never returned.
+//	@Tags			user users api-keys info security
+//	@Id				apiKeys
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	models.HTTPSuccess	"the API keys in the payload"
+//	@Failure		403	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/api-keys/info/ [get]
+func APIKeys(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			clientID   uuid.UUID
+			apiKeys    *models.HTTPAPIKeysResponse
+			httpMsg    string
+			httpStatus int
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if apiKeys, httpMsg, httpStatus, err = common.HTTPAPIKeyList(db, logger, clientID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "api keys", Payload: apiKeys})
+	}
+}
+
+// RevokeAPIKey will revoke one of a client's API keys.
+//
+//	@Summary		Revoke an API key.
+//	@Description	Revokes an API key. Requests made with the key are rejected immediately.
+//	@Tags			user users api-keys revoke security
+//	@Id				revokeAPIKey
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			keyID	path		string				true	"the key ID of the API key to revoke"
+//	@Success		200		{object}	models.HTTPSuccess	"a message to confirm the revocation of the API key"
+//	@Failure		400		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/api-keys/revoke/{keyID} [delete]
+func RevokeAPIKey(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			clientID   uuid.UUID
+			httpMsg    string
+			httpStatus int
+			keyID      = ginCtx.Param("keyID")
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if httpMsg, httpStatus, err = common.HTTPAPIKeyRevoke(db, logger, clientID, keyID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: keyID})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "api key revoked", Payload: keyID})
+	}
+}
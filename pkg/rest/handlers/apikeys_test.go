@@ -0,0 +1,321 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestHandlers_CreateAPIKey(t *testing.T) {
+	t.Parallel()
+
+	const path = "/api-keys/create"
+
+	validRequest := &models.HTTPAPIKeyRequest{Name: "trading bot", Scopes: []string{"read-balances", "trade"}}
+
+	testCases := []struct {
+		name               string
+		expectedMsg        string
+		expectedStatus     int
+		request            any
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		generateKeyTimes   int
+		apiKeyCreateErr    error
+		apiKeyCreateTimes  int
+	}{
+		{
+			name:               "invalid jwt",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			request:            validRequest,
+			authTokenInfoErr:   errors.New("invalid jwt"),
+			authTokenInfoTimes: 1,
+			generateKeyTimes:   0,
+			apiKeyCreateErr:    nil,
+			apiKeyCreateTimes:  0,
+		}, {
+			name:               "invalid request",
+			expectedMsg:        "cannot unmarshal",
+			expectedStatus:     http.StatusBadRequest,
+			request:            "invalid request",
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			generateKeyTimes:   0,
+			apiKeyCreateErr:    nil,
+			apiKeyCreateTimes:  0,
+		}, {
+			name:               "unknown scope",
+			expectedMsg:        "validation",
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPAPIKeyRequest{Name: "trading bot", Scopes: []string{"admin"}},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			generateKeyTimes:   0,
+			apiKeyCreateErr:    nil,
+			apiKeyCreateTimes:  0,
+		}, {
+			name:               "db failure",
+			expectedMsg:        "could not complete api key request",
+			expectedStatus:     http.StatusInternalServerError,
+			request:            validRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			generateKeyTimes:   1,
+			apiKeyCreateErr:    postgres.ErrTransactAPIKey,
+			apiKeyCreateTimes:  1,
+		}, {
+			name:               "valid",
+			expectedMsg:        "will not be shown again",
+			expectedStatus:     http.StatusCreated,
+			request:            validRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			generateKeyTimes:   1,
+			apiKeyCreateErr:    nil,
+			apiKeyCreateTimes:  1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			requestJSON, err := json.Marshal(test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockAuth.EXPECT().GenerateAPIKey().
+					Return("ftex_api-key", "hashed-api-key", nil).
+					Times(test.generateKeyTimes),
+
+				mockDB.EXPECT().APIKeyCreate(gomock.Any(), gomock.Any(), "hashed-api-key", gomock.Any()).
+					Return(postgres.ApiKey{}, test.apiKeyCreateErr).
+					Times(test.apiKeyCreateTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(path, CreateAPIKey(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, path, bytes.NewBuffer(requestJSON))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_APIKeys(t *testing.T) {
+	t.Parallel()
+
+	const path = "/api-keys/info/"
+
+	testCases := []struct {
+		name               string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		apiKeyListErr      error
+		apiKeyListTimes    int
+	}{
+		{
+			name:               "invalid jwt",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid jwt"),
+			authTokenInfoTimes: 1,
+			apiKeyListErr:      nil,
+			apiKeyListTimes:    0,
+		}, {
+			name:               "db failure",
+			expectedMsg:        "could not complete api key request",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			apiKeyListErr:      postgres.ErrTransactAPIKey,
+			apiKeyListTimes:    1,
+		}, {
+			name:               "valid",
+			expectedMsg:        "api keys",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			apiKeyListErr:      nil,
+			apiKeyListTimes:    1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().APIKeyList(gomock.Any()).
+					Return([]postgres.ApiKey{{}, {}}, test.apiKeyListErr).
+					Times(test.apiKeyListTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(path, APIKeys(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, path, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_RevokeAPIKey(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/api-keys/revoke/"
+
+	keyID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate key id.")
+
+	testCases := []struct {
+		name               string
+		keyID              string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		apiKeyRevokeErr    error
+		apiKeyRevokeTimes  int
+	}{
+		{
+			name:               "invalid jwt",
+			keyID:              keyID.String(),
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid jwt"),
+			authTokenInfoTimes: 1,
+			apiKeyRevokeErr:    nil,
+			apiKeyRevokeTimes:  0,
+		}, {
+			name:               "invalid key id",
+			keyID:              "invalid-key-id",
+			expectedMsg:        "invalid api key ID",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			apiKeyRevokeErr:    nil,
+			apiKeyRevokeTimes:  0,
+		}, {
+			name:               "not found",
+			keyID:              keyID.String(),
+			expectedMsg:        "api key not found",
+			expectedStatus:     http.StatusNotFound,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			apiKeyRevokeErr:    postgres.ErrNotFoundAPIKey,
+			apiKeyRevokeTimes:  1,
+		}, {
+			name:               "valid",
+			keyID:              keyID.String(),
+			expectedMsg:        "api key revoked",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			apiKeyRevokeErr:    nil,
+			apiKeyRevokeTimes:  1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().APIKeyRevoke(gomock.Any(), gomock.Any()).
+					Return(test.apiKeyRevokeErr).
+					Times(test.apiKeyRevokeTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.DELETE(basePath+":keyID", RevokeAPIKey(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodDelete, basePath+test.keyID, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
@@ -6,32 +6,59 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
 	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/logger"
 	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
 	"go.uber.org/zap"
 )
 
-// AuthMiddleware is the middleware that checks whether a JWT is valid and can access an endpoint.
-func AuthMiddleware(auth auth.Auth, db postgres.Postgres, logger *logger.Logger, authHeaderKey string) gin.HandlerFunc {
+// AuthMiddleware is the middleware that checks whether a JWT, or an API key that grants the endpoint's scope, is valid,
+// has not been revoked, and can access an endpoint. Endpoints for sensitive operations will also require a step-up
+// multifactor authentication code if configured. Endpoints without a scope cannot be accessed with API keys, and
+// endpoints that move funds cannot be accessed by frozen accounts.
+func AuthMiddleware(auth auth.Auth, cache redis.Redis, db postgres.Postgres, logger *logger.Logger,
+	authHeaderKey string, stepUp auth.StepUp, scope auth.Scope) gin.HandlerFunc {
 	handler := func(context *gin.Context) {
 		var (
 			err         error
 			clientID    uuid.UUID
 			expiresAt   int64
 			isDeleted   bool
+			httpMsg     string
+			httpStatus  int
 			tokenString = context.GetHeader(authHeaderKey)
+			apiKey      = context.GetHeader(constants.APIKeyHeader())
 		)
 
-		if tokenString == "" {
-			context.JSON(http.StatusUnauthorized, "request does not contain an access token")
-			context.Abort()
+		switch {
+		case tokenString != "":
+			if clientID, expiresAt, err = auth.ValidateJWT(tokenString); err != nil {
+				context.JSON(http.StatusForbidden, "request contains invalid or expired authorization token")
+				context.Abort()
 
-			return
-		}
+				return
+			}
 
-		if clientID, expiresAt, err = auth.ValidateJWT(tokenString); err != nil {
-			context.JSON(http.StatusForbidden, "request contains invalid or expired authorization token")
+			// Check for a logout from the session or all the user's sessions.
+			if httpMsg, httpStatus, err =
+				common.HTTPSessionRevoked(auth, cache, logger, clientID, tokenString); err != nil {
+				context.JSON(httpStatus, httpMsg)
+				context.Abort()
+
+				return
+			}
+		case apiKey != "":
+			// API keys do not expire and are checked for revocation when they are retrieved.
+			if clientID, httpMsg, httpStatus, err = common.HTTPAPIKeyAuth(auth, db, logger, apiKey, scope); err != nil {
+				context.JSON(httpStatus, httpMsg)
+				context.Abort()
+
+				return
+			}
+		default:
+			context.JSON(http.StatusUnauthorized, "request does not contain an access token")
 			context.Abort()
 
 			return
@@ -54,6 +81,23 @@ func AuthMiddleware(auth auth.Auth, db postgres.Postgres, logger *logger.Logger,
 			return
 		}
 
+		// Check for frozen accounts on endpoints that move funds.
+		if httpMsg, httpStatus, err = common.HTTPAccountFrozen(db, logger, clientID, scope); err != nil {
+			context.JSON(httpStatus, httpMsg)
+			context.Abort()
+
+			return
+		}
+
+		// Check for a step-up multifactor authentication code on sensitive operations.
+		if httpMsg, httpStatus, err = common.HTTPMFAStepUp(
+			auth, cache, db, logger, clientID, stepUp, context.GetHeader(constants.MFACodeHeader())); err != nil {
+			context.JSON(httpStatus, httpMsg)
+			context.Abort()
+
+			return
+		}
+
 		// Store the extracted ClientID and expiration deadline values in the Gin context for handlers.
 		context.Set(constants.ClientIDCtxKey(), clientID)
 		context.Set(constants.ExpiresAtCtxKey(), expiresAt)
@@ -63,3 +107,34 @@ func AuthMiddleware(auth auth.Auth, db postgres.Postgres, logger *logger.Logger,
 
 	return handler
 }
+
+// AdminMiddleware is the middleware that checks whether an authenticated client has been granted the administrator
+// role. It must be installed after the AuthMiddleware.
+func AdminMiddleware(auth auth.Auth, db postgres.Postgres, logger *logger.Logger) gin.HandlerFunc {
+	handler := func(context *gin.Context) {
+		var (
+			err        error
+			clientID   uuid.UUID
+			httpMsg    string
+			httpStatus int
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(context); err != nil {
+			context.JSON(http.StatusForbidden, "malformed authentication token")
+			context.Abort()
+
+			return
+		}
+
+		if httpMsg, httpStatus, err = common.HTTPAdminCheck(db, logger, clientID); err != nil {
+			context.JSON(httpStatus, httpMsg)
+			context.Abort()
+
+			return
+		}
+
+		context.Next()
+	}
+
+	return handler
+}
@@ -6,12 +6,18 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/mocks"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
 )
 
 func TestAuthMiddleware(t *testing.T) {
@@ -20,9 +26,10 @@ func TestAuthMiddleware(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 	mockAuth := mocks.NewMockAuth(mockCtrl)
+	mockCache := mocks.NewMockRedis(mockCtrl)
 	mockDB := mocks.NewMockPostgres(mockCtrl)
 
-	handler := AuthMiddleware(mockAuth, mockDB, zapLogger, "Authorization")
+	handler := AuthMiddleware(mockAuth, mockCache, mockDB, zapLogger, "Authorization", auth.StepUpNone, auth.ScopeNone)
 	require.NotNil(t, handler)
 }
 
@@ -37,9 +44,27 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 		authJWTError      error
 		authJWTExpiration int64
 		authJWTTimes      int
+		sessionErr        error
+		sessionTimes      int
+		cacheGetErr       error
+		cacheGetTimes     int
 		isDeletedError    error
 		isDeletedTimes    int
 		isDeletedValue    bool
+		isFrozenError     error
+		isFrozenTimes     int
+		isFrozenValue     bool
+		mfaCode           string
+		stepUpRequired    bool
+		stepUpTimes       int
+		mfaGetEnrollment  postgres.MfaEnrollment
+		mfaGetErr         error
+		mfaGetTimes       int
+		stepUpFailTimes   int
+		apiKey            string
+		apiKeyGetScopes   []string
+		apiKeyGetErr      error
+		apiKeyGetTimes    int
 		expectedStatus    int
 	}{
 		{
@@ -51,9 +76,27 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 			authJWTExpiration: -1,
 			authJWTError:      nil,
 			authJWTTimes:      0,
+			sessionErr:        nil,
+			sessionTimes:      0,
+			cacheGetErr:       nil,
+			cacheGetTimes:     0,
 			isDeletedError:    nil,
 			isDeletedTimes:    0,
 			isDeletedValue:    false,
+			isFrozenError:     nil,
+			isFrozenTimes:     0,
+			isFrozenValue:     false,
+			mfaCode:           "",
+			stepUpRequired:    false,
+			stepUpTimes:       0,
+			mfaGetEnrollment:  postgres.MfaEnrollment{},
+			mfaGetErr:         nil,
+			mfaGetTimes:       0,
+			stepUpFailTimes:   0,
+			apiKey:            "",
+			apiKeyGetScopes:   nil,
+			apiKeyGetErr:      nil,
+			apiKeyGetTimes:    0,
 		}, {
 			name:              "invalid token",
 			path:              "/auth-middleware/invalid-token",
@@ -63,9 +106,87 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 			authJWTExpiration: -1,
 			authJWTError:      errors.New("JWT validation failure"),
 			authJWTTimes:      1,
+			sessionErr:        nil,
+			sessionTimes:      0,
+			cacheGetErr:       nil,
+			cacheGetTimes:     0,
 			isDeletedError:    nil,
 			isDeletedTimes:    0,
 			isDeletedValue:    false,
+			isFrozenError:     nil,
+			isFrozenTimes:     0,
+			isFrozenValue:     false,
+			mfaCode:           "",
+			stepUpRequired:    false,
+			stepUpTimes:       0,
+			mfaGetEnrollment:  postgres.MfaEnrollment{},
+			mfaGetErr:         nil,
+			mfaGetTimes:       0,
+			stepUpFailTimes:   0,
+			apiKey:            "",
+			apiKeyGetScopes:   nil,
+			apiKeyGetErr:      nil,
+			apiKeyGetTimes:    0,
+		}, {
+			name:              "invalid session",
+			path:              "/auth-middleware/invalid-session",
+			token:             "valid-token",
+			expectedStatus:    http.StatusForbidden,
+			authJWTUUID:       uuid.UUID{},
+			authJWTExpiration: -1,
+			authJWTError:      nil,
+			authJWTTimes:      1,
+			sessionErr:        errors.New("session failure"),
+			sessionTimes:      1,
+			cacheGetErr:       nil,
+			cacheGetTimes:     0,
+			isDeletedError:    nil,
+			isDeletedTimes:    0,
+			isDeletedValue:    false,
+			isFrozenError:     nil,
+			isFrozenTimes:     0,
+			isFrozenValue:     false,
+			mfaCode:           "",
+			stepUpRequired:    false,
+			stepUpTimes:       0,
+			mfaGetEnrollment:  postgres.MfaEnrollment{},
+			mfaGetErr:         nil,
+			mfaGetTimes:       0,
+			stepUpFailTimes:   0,
+			apiKey:            "",
+			apiKeyGetScopes:   nil,
+			apiKeyGetErr:      nil,
+			apiKeyGetTimes:    0,
+		}, {
+			name:              "revocation cache failure",
+			path:              "/auth-middleware/revocation-cache-failure",
+			token:             "valid-token",
+			expectedStatus:    http.StatusInternalServerError,
+			authJWTUUID:       uuid.UUID{},
+			authJWTExpiration: -1,
+			authJWTError:      nil,
+			authJWTTimes:      1,
+			sessionErr:        nil,
+			sessionTimes:      1,
+			cacheGetErr:       redis.ErrCacheUnknown,
+			cacheGetTimes:     1,
+			isDeletedError:    nil,
+			isDeletedTimes:    0,
+			isDeletedValue:    false,
+			isFrozenError:     nil,
+			isFrozenTimes:     0,
+			isFrozenValue:     false,
+			mfaCode:           "",
+			stepUpRequired:    false,
+			stepUpTimes:       0,
+			mfaGetEnrollment:  postgres.MfaEnrollment{},
+			mfaGetErr:         nil,
+			mfaGetTimes:       0,
+			stepUpFailTimes:   0,
+			apiKey:            "",
+			apiKeyGetScopes:   nil,
+			apiKeyGetErr:      nil,
+			apiKeyGetTimes:    0,
 		}, {
 			name:              "db failure",
 			path:              "/auth-middleware/db-failure",
@@ -75,9 +196,27 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 			authJWTExpiration: -1,
 			authJWTError:      nil,
 			authJWTTimes:      1,
+			sessionErr:        nil,
+			sessionTimes:      1,
+			cacheGetErr:       redis.ErrCacheMiss,
+			cacheGetTimes:     2,
 			isDeletedError:    errors.New("db failure"),
 			isDeletedTimes:    1,
 			isDeletedValue:    false,
+			isFrozenError:     nil,
+			isFrozenTimes:     0,
+			isFrozenValue:     false,
+			mfaCode:           "",
+			stepUpRequired:    false,
+			stepUpTimes:       0,
+			mfaGetEnrollment:  postgres.MfaEnrollment{},
+			mfaGetErr:         nil,
+			mfaGetTimes:       0,
+			stepUpFailTimes:   0,
+			apiKey:            "",
+			apiKeyGetScopes:   nil,
+			apiKeyGetErr:      nil,
+			apiKeyGetTimes:    0,
 		}, {
 			name:              "deleted account",
 			path:              "/auth-middleware/deleted-account",
@@ -87,9 +226,27 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 			authJWTExpiration: -1,
 			authJWTError:      nil,
 			authJWTTimes:      1,
+			sessionErr:        nil,
+			sessionTimes:      1,
+			cacheGetErr:       redis.ErrCacheMiss,
+			cacheGetTimes:     2,
 			isDeletedError:    nil,
 			isDeletedTimes:    1,
 			isDeletedValue:    true,
+			isFrozenError:     nil,
+			isFrozenTimes:     0,
+			isFrozenValue:     false,
+			mfaCode:           "",
+			stepUpRequired:    false,
+			stepUpTimes:       0,
+			mfaGetEnrollment:  postgres.MfaEnrollment{},
+			mfaGetErr:         nil,
+			mfaGetTimes:       0,
+			stepUpFailTimes:   0,
+			apiKey:            "",
+			apiKeyGetScopes:   nil,
+			apiKeyGetErr:      nil,
+			apiKeyGetTimes:    0,
 		}, {
 			name:              "valid token",
 			path:              "/auth-middleware/valid-token",
@@ -99,9 +256,297 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 			authJWTExpiration: -1,
 			authJWTError:      nil,
 			authJWTTimes:      1,
+			sessionErr:        nil,
+			sessionTimes:      1,
+			cacheGetErr:       redis.ErrCacheMiss,
+			cacheGetTimes:     2,
+			isDeletedError:    nil,
+			isDeletedTimes:    1,
+			isDeletedValue:    false,
+			isFrozenError:     nil,
+			isFrozenTimes:     1,
+			isFrozenValue:     false,
+			mfaCode:           "",
+			stepUpRequired:    false,
+			stepUpTimes:       1,
+			mfaGetEnrollment:  postgres.MfaEnrollment{},
+			mfaGetErr:         nil,
+			mfaGetTimes:       0,
+			stepUpFailTimes:   0,
+			apiKey:            "",
+			apiKeyGetScopes:   nil,
+			apiKeyGetErr:      nil,
+			apiKeyGetTimes:    0,
+		}, {
+			name:              "frozen status failure",
+			path:              "/auth-middleware/frozen-status-failure",
+			token:             "valid-token",
+			expectedStatus:    http.StatusInternalServerError,
+			authJWTUUID:       uuid.UUID{},
+			authJWTExpiration: -1,
+			authJWTError:      nil,
+			authJWTTimes:      1,
+			sessionErr:        nil,
+			sessionTimes:      1,
+			cacheGetErr:       redis.ErrCacheMiss,
+			cacheGetTimes:     2,
+			isDeletedError:    nil,
+			isDeletedTimes:    1,
+			isDeletedValue:    false,
+			isFrozenError:     errors.New("db failure"),
+			isFrozenTimes:     1,
+			isFrozenValue:     false,
+			mfaCode:           "",
+			stepUpRequired:    false,
+			stepUpTimes:       0,
+			mfaGetEnrollment:  postgres.MfaEnrollment{},
+			mfaGetErr:         nil,
+			mfaGetTimes:       0,
+			stepUpFailTimes:   0,
+			apiKey:            "",
+			apiKeyGetScopes:   nil,
+			apiKeyGetErr:      nil,
+			apiKeyGetTimes:    0,
+		}, {
+			name:              "frozen user",
+			path:              "/auth-middleware/frozen-user",
+			token:             "valid-token",
+			expectedStatus:    http.StatusForbidden,
+			authJWTUUID:       uuid.UUID{},
+			authJWTExpiration: -1,
+			authJWTError:      nil,
+			authJWTTimes:      1,
+			sessionErr:        nil,
+			sessionTimes:      1,
+			cacheGetErr:       redis.ErrCacheMiss,
+			cacheGetTimes:     2,
+			isDeletedError:    nil,
+			isDeletedTimes:    1,
+			isDeletedValue:    false,
+			isFrozenError:     nil,
+			isFrozenTimes:     1,
+			isFrozenValue:     true,
+			mfaCode:           "",
+			stepUpRequired:    false,
+			stepUpTimes:       0,
+			mfaGetEnrollment:  postgres.MfaEnrollment{},
+			mfaGetErr:         nil,
+			mfaGetTimes:       0,
+			stepUpFailTimes:   0,
+			apiKey:            "",
+			apiKeyGetScopes:   nil,
+			apiKeyGetErr:      nil,
+			apiKeyGetTimes:    0,
+		}, {
+			name:              "step-up not enrolled",
+			path:              "/auth-middleware/step-up-not-enrolled",
+			expectedStatus:    http.StatusOK,
+			token:             "valid-token",
+			authJWTUUID:       uuid.UUID{},
+			authJWTExpiration: -1,
+			authJWTError:      nil,
+			authJWTTimes:      1,
+			sessionErr:        nil,
+			sessionTimes:      1,
+			cacheGetErr:       redis.ErrCacheMiss,
+			cacheGetTimes:     2,
+			isDeletedError:    nil,
+			isDeletedTimes:    1,
+			isDeletedValue:    false,
+			isFrozenError:     nil,
+			isFrozenTimes:     1,
+			isFrozenValue:     false,
+			mfaCode:           "",
+			stepUpRequired:    true,
+			stepUpTimes:       1,
+			mfaGetEnrollment:  postgres.MfaEnrollment{IsEnabled: false},
+			mfaGetErr:         postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:       1,
+			stepUpFailTimes:   0,
+			apiKey:            "",
+			apiKeyGetScopes:   nil,
+			apiKeyGetErr:      nil,
+			apiKeyGetTimes:    0,
+		}, {
+			name:              "step-up db failure",
+			path:              "/auth-middleware/step-up-db-failure",
+			expectedStatus:    http.StatusInternalServerError,
+			token:             "valid-token",
+			authJWTUUID:       uuid.UUID{},
+			authJWTExpiration: -1,
+			authJWTError:      nil,
+			authJWTTimes:      1,
+			sessionErr:        nil,
+			sessionTimes:      1,
+			cacheGetErr:       redis.ErrCacheMiss,
+			cacheGetTimes:     2,
+			isDeletedError:    nil,
+			isDeletedTimes:    1,
+			isDeletedValue:    false,
+			isFrozenError:     nil,
+			isFrozenTimes:     1,
+			isFrozenValue:     false,
+			mfaCode:           "",
+			stepUpRequired:    true,
+			stepUpTimes:       1,
+			mfaGetEnrollment:  postgres.MfaEnrollment{IsEnabled: false},
+			mfaGetErr:         postgres.ErrTransactMFA,
+			mfaGetTimes:       1,
+			stepUpFailTimes:   0,
+			apiKey:            "",
+			apiKeyGetScopes:   nil,
+			apiKeyGetErr:      nil,
+			apiKeyGetTimes:    0,
+		}, {
+			name:              "step-up code missing",
+			path:              "/auth-middleware/step-up-code-missing",
+			expectedStatus:    http.StatusUnauthorized,
+			token:             "valid-token",
+			authJWTUUID:       uuid.UUID{},
+			authJWTExpiration: -1,
+			authJWTError:      nil,
+			authJWTTimes:      1,
+			sessionErr:        nil,
+			sessionTimes:      1,
+			cacheGetErr:       redis.ErrCacheMiss,
+			cacheGetTimes:     2,
+			isDeletedError:    nil,
+			isDeletedTimes:    1,
+			isDeletedValue:    false,
+			isFrozenError:     nil,
+			isFrozenTimes:     1,
+			isFrozenValue:     false,
+			mfaCode:           "",
+			stepUpRequired:    true,
+			stepUpTimes:       1,
+			mfaGetEnrollment:  postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetErr:         nil,
+			mfaGetTimes:       1,
+			stepUpFailTimes:   0,
+			apiKey:            "",
+			apiKeyGetScopes:   nil,
+			apiKeyGetErr:      nil,
+			apiKeyGetTimes:    0,
+		}, {
+			name:              "step-up recovery code",
+			path:              "/auth-middleware/step-up-recovery-code",
+			expectedStatus:    http.StatusForbidden,
+			token:             "valid-token",
+			authJWTUUID:       uuid.UUID{},
+			authJWTExpiration: -1,
+			authJWTError:      nil,
+			authJWTTimes:      1,
+			sessionErr:        nil,
+			sessionTimes:      1,
+			cacheGetErr:       redis.ErrCacheMiss,
+			cacheGetTimes:     2,
+			isDeletedError:    nil,
+			isDeletedTimes:    1,
+			isDeletedValue:    false,
+			isFrozenError:     nil,
+			isFrozenTimes:     1,
+			isFrozenValue:     false,
+			mfaCode:           "ABCD-EFGH-IJKL-MNOP",
+			stepUpRequired:    true,
+			stepUpTimes:       1,
+			mfaGetEnrollment:  postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetErr:         nil,
+			mfaGetTimes:       1,
+			stepUpFailTimes:   1,
+			apiKey:            "",
+			apiKeyGetScopes:   nil,
+			apiKeyGetErr:      nil,
+			apiKeyGetTimes:    0,
+		}, {
+			name:              "invalid api key",
+			path:              "/auth-middleware/invalid-api-key",
+			token:             "",
+			expectedStatus:    http.StatusForbidden,
+			authJWTUUID:       uuid.UUID{},
+			authJWTExpiration: -1,
+			authJWTError:      nil,
+			authJWTTimes:      0,
+			sessionErr:        nil,
+			sessionTimes:      0,
+			cacheGetErr:       nil,
+			cacheGetTimes:     0,
+			isDeletedError:    nil,
+			isDeletedTimes:    0,
+			isDeletedValue:    false,
+			isFrozenError:     nil,
+			isFrozenTimes:     0,
+			isFrozenValue:     false,
+			mfaCode:           "",
+			stepUpRequired:    false,
+			stepUpTimes:       0,
+			mfaGetEnrollment:  postgres.MfaEnrollment{},
+			mfaGetErr:         nil,
+			mfaGetTimes:       0,
+			stepUpFailTimes:   0,
+			apiKey:            "ftex_api-key",
+			apiKeyGetScopes:   nil,
+			apiKeyGetErr:      postgres.ErrNotFoundAPIKey,
+			apiKeyGetTimes:    1,
+		}, {
+			name:              "api key missing scope",
+			path:              "/auth-middleware/api-key-missing-scope",
+			token:             "",
+			expectedStatus:    http.StatusForbidden,
+			authJWTUUID:       uuid.UUID{},
+			authJWTExpiration: -1,
+			authJWTError:      nil,
+			authJWTTimes:      0,
+			sessionErr:        nil,
+			sessionTimes:      0,
+			cacheGetErr:       nil,
+			cacheGetTimes:     0,
+			isDeletedError:    nil,
+			isDeletedTimes:    0,
+			isDeletedValue:    false,
+			isFrozenError:     nil,
+			isFrozenTimes:     0,
+			isFrozenValue:     false,
+			mfaCode:           "",
+			stepUpRequired:    false,
+			stepUpTimes:       0,
+			mfaGetEnrollment:  postgres.MfaEnrollment{},
+			mfaGetErr:         nil,
+			mfaGetTimes:       0,
+			stepUpFailTimes:   0,
+			apiKey:            "ftex_api-key",
+			apiKeyGetScopes:   []string{"read-balances"},
+			apiKeyGetErr:      nil,
+			apiKeyGetTimes:    1,
+		}, {
+			name:              "valid api key",
+			path:              "/auth-middleware/valid-api-key",
+			token:             "",
+			expectedStatus:    http.StatusOK,
+			authJWTUUID:       uuid.UUID{},
+			authJWTExpiration: -1,
+			authJWTError:      nil,
+			authJWTTimes:      0,
+			sessionErr:        nil,
+			sessionTimes:      0,
+			cacheGetErr:       nil,
+			cacheGetTimes:     0,
 			isDeletedError:    nil,
 			isDeletedTimes:    1,
 			isDeletedValue:    false,
+			isFrozenError:     nil,
+			isFrozenTimes:     1,
+			isFrozenValue:     false,
+			mfaCode:           "",
+			stepUpRequired:    false,
+			stepUpTimes:       1,
+			mfaGetEnrollment:  postgres.MfaEnrollment{},
+			mfaGetErr:         nil,
+			mfaGetTimes:       0,
+			stepUpFailTimes:   0,
+			apiKey:            "ftex_api-key",
+			apiKeyGetScopes:   []string{"read-balances", "withdraw"},
+			apiKeyGetErr:      nil,
+			apiKeyGetTimes:    1,
 		},
 	}
 
@@ -115,6 +560,7 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 			mockCtrl := gomock.NewController(t)
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
 			mockDB := mocks.NewMockPostgres(mockCtrl)
 
 			gomock.InOrder(
@@ -125,16 +571,155 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 						test.authJWTError,
 					).Times(test.authJWTTimes),
 
+				mockAuth.EXPECT().SessionFromJWT(gomock.Any()).
+					Return("session-id", int64(100), test.sessionErr).
+					Times(test.sessionTimes),
+
+				mockCache.EXPECT().Get(gomock.Any(), gomock.Any()).
+					Return(test.cacheGetErr).
+					Times(test.cacheGetTimes),
+
+				mockAuth.EXPECT().HashAPIKey(test.apiKey).
+					Return("hashed-api-key").
+					Times(test.apiKeyGetTimes),
+
+				mockDB.EXPECT().APIKeyGet("hashed-api-key").
+					Return(postgres.ApiKey{Scopes: test.apiKeyGetScopes}, test.apiKeyGetErr).
+					Times(test.apiKeyGetTimes),
+
 				mockDB.EXPECT().UserIsDeleted(gomock.Any()).
 					Return(test.isDeletedValue, test.isDeletedError).
 					Times(test.isDeletedTimes),
+
+				mockDB.EXPECT().UserIsFrozen(gomock.Any()).
+					Return(test.isFrozenValue, test.isFrozenError).
+					Times(test.isFrozenTimes),
+
+				mockAuth.EXPECT().StepUpRequired(auth.StepUpTransfers).
+					Return(test.stepUpRequired).
+					Times(test.stepUpTimes),
+
+				mockDB.EXPECT().MFAGet(gomock.Any()).
+					Return(test.mfaGetEnrollment, test.mfaGetErr).
+					Times(test.mfaGetTimes),
+
+				mockDB.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: &modelsPostgres.UserAccount{
+						UserLoginCredentials: modelsPostgres.UserLoginCredentials{Username: "username1"},
+					}}, nil).
+					Times(test.stepUpFailTimes),
+
+				mockCache.EXPECT().Get(constants.LoginLockoutKeyPrefix()+"username1", gomock.Any()).
+					Return(redis.ErrCacheMiss).
+					Times(test.stepUpFailTimes),
+
+				mockAuth.EXPECT().LoginFailureWindow().
+					Return(time.Hour).
+					Times(test.stepUpFailTimes),
+
+				mockCache.EXPECT().Incr(constants.LoginFailuresKeyPrefix()+"username1", time.Hour).
+					Return(int64(1), nil).
+					Times(test.stepUpFailTimes),
+
+				mockAuth.EXPECT().LoginLockout(int64(1)).
+					Return(time.Duration(0)).
+					Times(test.stepUpFailTimes),
 			)
 
 			// Endpoint setup for test.
 			router := gin.Default()
-			router.POST(test.path, AuthMiddleware(mockAuth, mockDB, zapLogger, "Authorization"))
+			router.POST(test.path, AuthMiddleware(mockAuth, mockCache, mockDB, zapLogger, "Authorization",
+				auth.StepUpTransfers, auth.ScopeWithdraw))
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, nil)
 			req.Header.Set("Authorization", test.token)
+			req.Header.Set(constants.APIKeyHeader(), test.apiKey)
+			req.Header.Set(constants.MFACodeHeader(), test.mfaCode)
+
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestAdminMiddleware_Handler(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		getRoleValue       postgres.UserRole
+		getRoleErr         error
+		getRoleTimes       int
+	}{
+		{
+			name:               "malformed token",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("malformed token"),
+			authTokenInfoTimes: 1,
+			getRoleValue:       postgres.UserRoleAdmin,
+			getRoleErr:         nil,
+			getRoleTimes:       0,
+		}, {
+			name:               "role lookup failure",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			getRoleValue:       postgres.UserRoleAdmin,
+			getRoleErr:         errors.New("db failure"),
+			getRoleTimes:       1,
+		}, {
+			name:               "not an admin",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			getRoleValue:       postgres.UserRoleUser,
+			getRoleErr:         nil,
+			getRoleTimes:       1,
+		}, {
+			name:               "admin",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			getRoleValue:       postgres.UserRoleAdmin,
+			getRoleErr:         nil,
+			getRoleTimes:       1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().UserGetRole(gomock.Any()).
+					Return(test.getRoleValue, test.getRoleErr).
+					Times(test.getRoleTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET("/admin", AdminMiddleware(mockAuth, mockDB, zapLogger), func(ctx *gin.Context) {
+				ctx.Status(http.StatusOK)
+			})
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, "/admin", nil)
 
 			w := httptest.NewRecorder()
 
@@ -25,6 +25,7 @@ import (
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
 //	@Param			request	body		models.HTTPOpenCurrencyAccountRequest	true	"cryptocurrency ticker for new account"
 //	@Success		201		{object}	models.HTTPSuccess						"a message to confirm the creation of an account"
 //	@Failure		400		{object}	models.HTTPError						"error message with any available details in payload"
@@ -82,6 +83,7 @@ func OpenCrypto(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
 //	@Param			request	body		models.HTTPCryptoOfferRequest	true	"the Cryptocurrency ticker, Fiat currency code, and amount to be converted in the source currency"
 //	@Success		200		{object}	models.HTTPSuccess				"a message to confirm the purchase rate for a Fiat or Cryptocurrency"
 //	@Failure		400		{object}	models.HTTPError				"error message with any available details in payload"
@@ -147,12 +149,17 @@ func OfferCrypto(logger *logger.Logger, auth auth.Auth, cache redis.Redis, quote
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
-//	@Param			offerID	body		models.HTTPTransferRequest	true	"the two currency codes and amount to be converted"
-//	@Success		200		{object}	models.HTTPSuccess			"a message to confirm the conversion of funds"
-//	@Failure		400		{object}	models.HTTPError			"error message with any available details in payload"
-//	@Failure		403		{object}	models.HTTPError			"error message with any available details in payload"
-//	@Failure		408		{object}	models.HTTPError			"error message with any available details in payload"
-//	@Failure		500		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Security		ClientAPIKey
+//	@Param			offerID			body		models.HTTPTransferRequest	true	"the two currency codes and amount to be converted"
+//	@Param			Idempotency-Key	header		string						false	"unique key used to safely retry the request"
+//	@Param			X-MFA-Code		header		string						false	"one-time password when step-up multifactor authentication is required"
+//	@Success		200				{object}	models.HTTPSuccess			"a message to confirm the conversion of funds"
+//	@Failure		400				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		403				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		408				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		409				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		422				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		500				{object}	models.HTTPError			"error message with any available details in payload"
 //	@Router			/crypto/exchange/ [post]
 func ExchangeCrypto(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db postgres.Postgres) gin.HandlerFunc {
 	return func(ginCtx *gin.Context) {
@@ -181,7 +188,14 @@ func ExchangeCrypto(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db
 			return
 		}
 
-		receipt, status, httpErrMsg, err := common.HTTPExchangeCrypto(auth, cache, db, logger, clientID, request.OfferID)
+		receipt, status, httpErrMsg, _, err := common.HTTPIdempotentRequest(cache, logger, clientID,
+			ginCtx.GetHeader(constants.IdempotencyKeyHeader()), &request,
+			func() (*models.HTTPCryptoTransferResponse, int, string, any, error) {
+				exchangeReceipt, httpStatus, httpMessage, err := common.HTTPExchangeCrypto(
+					auth, cache, db, logger, clientID, request.OfferID)
+
+				return &exchangeReceipt, httpStatus, httpMessage, nil, err
+			})
 		if err != nil {
 			ginCtx.AbortWithStatusJSON(status, &models.HTTPError{Message: httpErrMsg})
 
@@ -192,6 +206,141 @@ func ExchangeCrypto(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db
 	}
 }
 
+// OfferSwapCrypto will handle an HTTP request to get an offer to swap one Cryptocurrency for another.
+//
+//	@Summary		Swap a Cryptocurrency for another Cryptocurrency.
+//	@Description	Swap a source Cryptocurrency for a destination Cryptocurrency. The amount must be a positive number with at most eight decimal places. Both currency accounts must be opened beforehand.
+//	@Tags			crypto cryptocurrency currency swap offer
+//	@Id				swapOfferCrypto
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
+//	@Param			request	body		models.HTTPExchangeOfferRequest	true	"the source and destination Cryptocurrency tickers, and amount to be converted in the source currency"
+//	@Success		200		{object}	models.HTTPSuccess				"a message to confirm the swap rate for a Cryptocurrency"
+//	@Failure		400		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Router			/crypto/swap/offer [post]
+func OfferSwapCrypto(logger *logger.Logger, auth auth.Auth, cache redis.Redis, quotes quotes.Quotes) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID      uuid.UUID
+			err           error
+			request       models.HTTPExchangeOfferRequest
+			offer         models.HTTPExchangeOfferResponse
+			status        int
+			statusMessage string
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if err = validator.ValidateStruct(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest,
+				models.HTTPError{Message: constants.ValidationString(), Payload: err})
+
+			return
+		}
+
+		offer, status, statusMessage, err = common.HTTPCryptoSwapOffer(auth, cache, logger, quotes,
+			clientID, request.SourceCurrency, request.DestinationCurrency, request.SourceAmount)
+		if err != nil {
+			httpErr := &models.HTTPError{Message: statusMessage}
+			if statusMessage == constants.InvalidRequestString() {
+				httpErr.Payload = err.Error()
+			}
+
+			ginCtx.AbortWithStatusJSON(status, httpErr)
+
+			return
+		}
+
+		offer.ClientID = clientID
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "crypto swap rate offer", Payload: offer})
+	}
+}
+
+// ExchangeSwapCrypto will handle an HTTP request to execute and complete a Cryptocurrency swap offer.
+//
+//	@Summary		Transfer funds between two Crypto accounts using a valid swap Offer ID.
+//	@Description	Swap a source Cryptocurrency for a destination Cryptocurrency. The Offer ID must be valid and have not expired.
+//	@Tags			crypto cryptocurrency swap exchange convert offer transfer execute
+//	@Id				exchangeSwapCrypto
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
+//	@Param			offerID			body		models.HTTPTransferRequest	true	"the swap offer ID"
+//	@Param			Idempotency-Key	header		string						false	"unique key used to safely retry the request"
+//	@Param			X-MFA-Code		header		string						false	"one-time password when step-up multifactor authentication is required"
+//	@Success		200				{object}	models.HTTPSuccess			"a message to confirm the swap of funds"
+//	@Failure		400				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		403				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		408				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		409				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		422				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		500				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Router			/crypto/swap/exchange [post]
+func ExchangeSwapCrypto(
+	logger *logger.Logger,
+	auth auth.Auth,
+	cache redis.Redis,
+	db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err      error
+			clientID uuid.UUID
+			request  models.HTTPTransferRequest
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if err = validator.ValidateStruct(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest,
+				models.HTTPError{Message: constants.ValidationString(), Payload: err})
+
+			return
+		}
+
+		receipt, status, httpErrMsg, _, err := common.HTTPIdempotentRequest(cache, logger, clientID,
+			ginCtx.GetHeader(constants.IdempotencyKeyHeader()), &request,
+			func() (*models.HTTPCryptoSwapResponse, int, string, any, error) {
+				swapReceipt, httpStatus, httpMessage, err := common.HTTPSwapCrypto(
+					auth, cache, db, logger, clientID, request.OfferID)
+
+				return &swapReceipt, httpStatus, httpMessage, nil, err
+			})
+		if err != nil {
+			ginCtx.AbortWithStatusJSON(status, &models.HTTPError{Message: httpErrMsg})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "funds swap transfer successful", Payload: receipt})
+	}
+}
+
 // BalanceCrypto will handle an HTTP request to retrieve a balance for a specific Cryptocurrency.
 //
 //	@Summary		Retrieve balance for a specific Cryptocurrency.
@@ -201,6 +350,7 @@ func ExchangeCrypto(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
 //	@Param			ticker	path		string				true	"the Cryptocurrency ticker to retrieve the balance for"
 //	@Success		200		{object}	models.HTTPSuccess	"the details for a specific currency account"
 //	@Failure		400		{object}	models.HTTPError	"error message with any available details in payload"
@@ -247,6 +397,7 @@ func BalanceCrypto(logger *logger.Logger, auth auth.Auth, db postgres.Postgres)
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
 //	@Param			transactionID	path		string				true	"the transaction ID to retrieve the details for"
 //	@Success		200				{object}	models.HTTPSuccess	"the transaction details for a specific transaction ID"
 //	@Failure		400				{object}	models.HTTPError	"error message with any available details in payload"
@@ -293,6 +444,7 @@ func TxDetailsCrypto(logger *logger.Logger, auth auth.Auth, db postgres.Postgres
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
 //	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
 //	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
 //	@Success		200			{object}	models.HTTPSuccess	"a message to with a page of account balances for the client's accounts"
@@ -342,6 +494,7 @@ func BalanceCryptoPaginated(logger *logger.Logger, auth auth.Auth, db postgres.P
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
 //	@Param			ticker		path		string				true	"the currency ticker to retrieve the transaction details for."
 //	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
 //	@Param			timezone	query		string				false	"The timezone for the month in question."
@@ -441,6 +441,10 @@ func TestHandlers_OfferCrypto(t *testing.T) { //nolint:maintidx
 					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
 					Times(test.authTokenInfoTimes),
 
+				mockQuotes.EXPECT().Fee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(decimal.Zero).
+					Times(test.quotesTimes),
+
 				mockQuotes.EXPECT().CryptoConversion(gomock.Any(), gomock.Any(), gomock.Any(), test.isPurchase, nil).
 					Return(amountValid, test.quotesAmount, test.quotesErr).
 					Times(test.quotesTimes),
@@ -491,6 +495,7 @@ func TestHandlers_ExchangeCrypto(t *testing.T) {
 
 	cryptoAmount := decimal.NewFromFloat(1234.56)
 	fiatAmount := decimal.NewFromFloat(78910.11)
+	feeAmount := decimal.NewFromFloat(0.25)
 
 	validSale := models.HTTPExchangeOfferResponse{
 		PriceQuote: models.PriceQuote{
@@ -501,6 +506,7 @@ func TestHandlers_ExchangeCrypto(t *testing.T) {
 			Amount:         fiatAmount,
 		},
 		DebitAmount:      cryptoAmount,
+		Fee:              feeAmount,
 		OfferID:          "OFFER-ID",
 		Expires:          0,
 		IsCryptoPurchase: false,
@@ -516,6 +522,7 @@ func TestHandlers_ExchangeCrypto(t *testing.T) {
 			Amount:         cryptoAmount,
 		},
 		DebitAmount:      fiatAmount,
+		Fee:              feeAmount,
 		OfferID:          "OFFER-ID",
 		Expires:          0,
 		IsCryptoPurchase: true,
@@ -657,12 +664,12 @@ func TestHandlers_ExchangeCrypto(t *testing.T) {
 					Times(test.redisDelTimes),
 
 				mockDB.EXPECT().CryptoPurchase(
-					gomock.Any(), postgres.CurrencyUSD, fiatAmount, "BTC", cryptoAmount).
+					gomock.Any(), postgres.CurrencyUSD, fiatAmount, "BTC", cryptoAmount, feeAmount, gomock.Any()).
 					Return(&postgres.FiatJournal{}, &postgres.CryptoJournal{}, nil).
 					Times(test.purchaseTimes),
 
 				mockDB.EXPECT().CryptoSell(
-					gomock.Any(), postgres.CurrencyUSD, fiatAmount, "BTC", cryptoAmount).
+					gomock.Any(), postgres.CurrencyUSD, fiatAmount, "BTC", cryptoAmount, feeAmount, gomock.Any()).
 					Return(&postgres.FiatJournal{}, &postgres.CryptoJournal{}, nil).
 					Times(test.sellTimes),
 			)
@@ -696,6 +703,381 @@ func TestHandlers_ExchangeCrypto(t *testing.T) {
 	}
 }
 
+func TestHandlers_OfferSwapCrypto(t *testing.T) {
+	t.Parallel()
+
+	var (
+		amountValid           = decimal.NewFromFloat(1.12345678)
+		amountInvalidDecimal  = decimal.NewFromFloat(1.123456789)
+		amountInvalidNegative = decimal.NewFromFloat(-1)
+	)
+
+	testCases := []struct {
+		name               string
+		expectedMsg        string
+		path               string
+		expectedStatus     int
+		request            *models.HTTPExchangeOfferRequest
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		quotesErr          error
+		quotesAmount       decimal.Decimal
+		quotesTimes        int
+		authEncryptErr     error
+		authEncryptTimes   int
+		redisErr           error
+		redisTimes         int
+	}{
+		{
+			name:           "invalid jwt",
+			expectedMsg:    "malformed authentication",
+			path:           "/swap-offer-crypto/invalid-jwt",
+			expectedStatus: http.StatusForbidden,
+			request: &models.HTTPExchangeOfferRequest{
+				SourceCurrency:      "BTC",
+				DestinationCurrency: "ETH",
+				SourceAmount:        amountValid,
+			},
+			authTokenInfoErr:   errors.New("invalid jwt"),
+			authTokenInfoTimes: 1,
+			quotesAmount:       amountValid,
+			quotesTimes:        0,
+			authEncryptTimes:   0,
+			redisTimes:         0,
+		}, {
+			name:               "empty request",
+			expectedMsg:        constants.ValidationString(),
+			path:               "/swap-offer-crypto/empty-request",
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPExchangeOfferRequest{},
+			authTokenInfoTimes: 1,
+			quotesAmount:       amountValid,
+			quotesTimes:        0,
+			authEncryptTimes:   0,
+			redisTimes:         0,
+		}, {
+			name:           "same source and destination",
+			expectedMsg:    "must differ",
+			path:           "/swap-offer-crypto/same-currency",
+			expectedStatus: http.StatusBadRequest,
+			request: &models.HTTPExchangeOfferRequest{
+				SourceCurrency:      "BTC",
+				DestinationCurrency: "BTC",
+				SourceAmount:        amountValid,
+			},
+			authTokenInfoTimes: 1,
+			quotesAmount:       amountValid,
+			quotesTimes:        0,
+			authEncryptTimes:   0,
+			redisTimes:         0,
+		}, {
+			name:           "invalid amount decimal places",
+			expectedMsg:    "source amount",
+			path:           "/swap-offer-crypto/invalid-decimal",
+			expectedStatus: http.StatusBadRequest,
+			request: &models.HTTPExchangeOfferRequest{
+				SourceCurrency:      "BTC",
+				DestinationCurrency: "ETH",
+				SourceAmount:        amountInvalidDecimal,
+			},
+			authTokenInfoTimes: 1,
+			quotesAmount:       amountValid,
+			quotesTimes:        0,
+			authEncryptTimes:   0,
+			redisTimes:         0,
+		}, {
+			name:           "invalid amount negative",
+			expectedMsg:    "source amount",
+			path:           "/swap-offer-crypto/invalid-negative",
+			expectedStatus: http.StatusBadRequest,
+			request: &models.HTTPExchangeOfferRequest{
+				SourceCurrency:      "BTC",
+				DestinationCurrency: "ETH",
+				SourceAmount:        amountInvalidNegative,
+			},
+			authTokenInfoTimes: 1,
+			quotesAmount:       amountValid,
+			quotesTimes:        0,
+			authEncryptTimes:   0,
+			redisTimes:         0,
+		}, {
+			name:           "crypto conversion error",
+			expectedMsg:    "retry",
+			path:           "/swap-offer-crypto/crypto-conversion-error",
+			expectedStatus: http.StatusInternalServerError,
+			request: &models.HTTPExchangeOfferRequest{
+				SourceCurrency:      "BTC",
+				DestinationCurrency: "ETH",
+				SourceAmount:        amountValid,
+			},
+			authTokenInfoTimes: 1,
+			quotesErr:          errors.New(constants.RetryMessageString()),
+			quotesAmount:       amountValid,
+			quotesTimes:        1,
+			authEncryptTimes:   0,
+			redisTimes:         0,
+		}, {
+			name:           "cache error",
+			expectedMsg:    "retry",
+			path:           "/swap-offer-crypto/cache-error",
+			expectedStatus: http.StatusInternalServerError,
+			request: &models.HTTPExchangeOfferRequest{
+				SourceCurrency:      "BTC",
+				DestinationCurrency: "ETH",
+				SourceAmount:        amountValid,
+			},
+			authTokenInfoTimes: 1,
+			quotesAmount:       amountValid,
+			quotesTimes:        1,
+			authEncryptTimes:   1,
+			redisErr:           errors.New("cache error"),
+			redisTimes:         1,
+		}, {
+			name:           "valid",
+			expectedMsg:    "",
+			path:           "/swap-offer-crypto/valid",
+			expectedStatus: http.StatusOK,
+			request: &models.HTTPExchangeOfferRequest{
+				SourceCurrency:      "BTC",
+				DestinationCurrency: "ETH",
+				SourceAmount:        amountValid,
+			},
+			authTokenInfoTimes: 1,
+			quotesAmount:       amountValid,
+			quotesTimes:        1,
+			authEncryptTimes:   1,
+			redisTimes:         1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+
+			offerReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockQuotes.EXPECT().Fee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(decimal.Zero).
+					Times(test.quotesTimes),
+
+				mockQuotes.EXPECT().CryptoConversion(gomock.Any(), gomock.Any(), gomock.Any(), true, nil).
+					Return(amountValid, test.quotesAmount, test.quotesErr).
+					Times(test.quotesTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("OFFER-ID", test.authEncryptErr).
+					Times(test.authEncryptTimes),
+
+				mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.redisErr).
+					Times(test.redisTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, OfferSwapCrypto(zapLogger, mockAuth, mockCache, mockQuotes))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(offerReqJSON))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			errorMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+
+			// Check for invalid currency codes and amount.
+			if errorMessage == constants.InvalidRequestString() {
+				payload, ok := resp["payload"].(string)
+				require.True(t, ok, "failed to extract payload from response.")
+				require.Contains(t, payload, test.expectedMsg)
+			} else {
+				require.Contains(t, errorMessage, test.expectedMsg, "incorrect response message.")
+			}
+		})
+	}
+}
+
+func TestHandlers_ExchangeSwapCrypto(t *testing.T) {
+	t.Parallel()
+
+	validClientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate a valid uuid.")
+
+	sourceAmount := decimal.NewFromFloat(1.12345678)
+	feeAmount := decimal.NewFromFloat(0.00012345)
+	destinationAmount := decimal.NewFromFloat(18.53703687)
+
+	validSwap := models.HTTPExchangeOfferResponse{
+		PriceQuote: models.PriceQuote{
+			ClientID:       validClientID,
+			SourceAcc:      "BTC",
+			DestinationAcc: "ETH",
+			Rate:           decimal.Decimal{},
+			Amount:         destinationAmount,
+		},
+		DebitAmount:  sourceAmount,
+		Fee:          feeAmount,
+		OfferID:      "OFFER-ID",
+		Expires:      0,
+		IsCryptoSwap: true,
+	}
+
+	testCases := []struct {
+		name               string
+		expectedMsg        string
+		path               string
+		expectedStatus     int
+		request            *models.HTTPTransferRequest
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		authDecryptTimes   int
+		authDecryptErr     error
+		redisGetTimes      int
+		redisDelTimes      int
+		swapTimes          int
+		swapErr            error
+	}{
+		{
+			name:               "invalid jwt",
+			expectedMsg:        "malformed authentication",
+			path:               "/swap-crypto/invalid-jwt",
+			expectedStatus:     http.StatusForbidden,
+			request:            &models.HTTPTransferRequest{OfferID: "OFFER-ID"},
+			authTokenInfoTimes: 1,
+			authTokenInfoErr:   errors.New("invalid jwt"),
+			authDecryptTimes:   0,
+			redisGetTimes:      0,
+			redisDelTimes:      0,
+			swapTimes:          0,
+		}, {
+			name:               "empty request",
+			expectedMsg:        constants.ValidationString(),
+			path:               "/swap-crypto/empty-request",
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPTransferRequest{},
+			authTokenInfoTimes: 1,
+			authDecryptTimes:   0,
+			redisGetTimes:      0,
+			redisDelTimes:      0,
+			swapTimes:          0,
+		}, {
+			name:               "decrypt failure",
+			expectedMsg:        "retry",
+			path:               "/swap-crypto/decrypt-failure",
+			expectedStatus:     http.StatusInternalServerError,
+			request:            &models.HTTPTransferRequest{OfferID: "OFFER-ID"},
+			authTokenInfoTimes: 1,
+			authDecryptTimes:   1,
+			authDecryptErr:     errors.New("decrypt failure"),
+			redisGetTimes:      0,
+			redisDelTimes:      0,
+			swapTimes:          0,
+		}, {
+			name:               "transaction failure",
+			expectedMsg:        "swap failure",
+			path:               "/swap-crypto/transaction-failure",
+			expectedStatus:     http.StatusInternalServerError,
+			request:            &models.HTTPTransferRequest{OfferID: "OFFER-ID"},
+			authTokenInfoTimes: 1,
+			authDecryptTimes:   1,
+			redisGetTimes:      1,
+			redisDelTimes:      1,
+			swapTimes:          1,
+			swapErr:            errors.New("swap failure"),
+		}, {
+			name:               "valid",
+			expectedMsg:        "successful",
+			path:               "/swap-crypto/valid",
+			expectedStatus:     http.StatusOK,
+			request:            &models.HTTPTransferRequest{OfferID: "OFFER-ID"},
+			authTokenInfoTimes: 1,
+			authDecryptTimes:   1,
+			redisGetTimes:      1,
+			redisDelTimes:      1,
+			swapTimes:          1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			offerReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(validClientID, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("OFFER-ID"), test.authDecryptErr).
+					Times(test.authDecryptTimes),
+
+				mockCache.EXPECT().Get(gomock.Any(), gomock.Any()).
+					Return(nil).
+					SetArg(1, validSwap).
+					Times(test.redisGetTimes),
+
+				mockCache.EXPECT().Del(gomock.Any()).
+					Return(nil).
+					Times(test.redisDelTimes),
+
+				mockDB.EXPECT().CryptoSwap(gomock.Any(), "BTC", sourceAmount, "ETH", destinationAmount, feeAmount).
+					Return(&postgres.CryptoJournal{}, &postgres.CryptoJournal{}, test.swapErr).
+					Times(test.swapTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, ExchangeSwapCrypto(zapLogger, mockAuth, mockCache, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(offerReqJSON))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			errorMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, errorMessage, test.expectedMsg, "incorrect response message.")
+		})
+	}
+}
+
 func TestHandler_BalanceCrypto(t *testing.T) { //nolint:dupl
 	t.Parallel()
 
@@ -820,6 +1202,8 @@ func TestHandler_TxDetailsCrypto(t *testing.T) {
 		fiatTxTimes        int
 		cryptoTxErr        error
 		cryptoTxTimes      int
+		tradeTxErr         error
+		tradeTxTimes       int
 	}{
 		{
 			name:               "invalid jwt",
@@ -831,6 +1215,8 @@ func TestHandler_TxDetailsCrypto(t *testing.T) {
 			fiatTxTimes:        0,
 			cryptoTxErr:        nil,
 			cryptoTxTimes:      0,
+			tradeTxErr:         nil,
+			tradeTxTimes:       0,
 		}, {
 			name:               "fiat db error",
 			expectedMsg:        "could not retrieve transaction details",
@@ -841,6 +1227,8 @@ func TestHandler_TxDetailsCrypto(t *testing.T) {
 			fiatTxTimes:        1,
 			cryptoTxErr:        nil,
 			cryptoTxTimes:      0,
+			tradeTxErr:         nil,
+			tradeTxTimes:       0,
 		}, {
 			name:               "crypto db error",
 			expectedMsg:        "could not retrieve transaction details",
@@ -851,6 +1239,8 @@ func TestHandler_TxDetailsCrypto(t *testing.T) {
 			fiatTxTimes:        1,
 			cryptoTxErr:        postgres.ErrTransactCryptoDetails,
 			cryptoTxTimes:      1,
+			tradeTxErr:         nil,
+			tradeTxTimes:       0,
 		}, {
 			name:               "valid",
 			expectedMsg:        "transaction details",
@@ -861,6 +1251,8 @@ func TestHandler_TxDetailsCrypto(t *testing.T) {
 			fiatTxTimes:        1,
 			cryptoTxErr:        nil,
 			cryptoTxTimes:      1,
+			tradeTxErr:         nil,
+			tradeTxTimes:       1,
 		},
 	}
 
@@ -888,6 +1280,10 @@ func TestHandler_TxDetailsCrypto(t *testing.T) {
 				mockDB.EXPECT().CryptoTxDetails(gomock.Any(), gomock.Any()).
 					Return([]postgres.CryptoJournal{{}}, test.cryptoTxErr).
 					Times(test.cryptoTxTimes),
+
+				mockDB.EXPECT().TradeTxDetails(gomock.Any(), gomock.Any()).
+					Return([]postgres.Trade{{}}, test.tradeTxErr).
+					Times(test.tradeTxTimes),
 			)
 
 			// Endpoint setup for test.
@@ -0,0 +1,137 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/events"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/redis"
+	"go.uber.org/zap"
+)
+
+// writeEvent will write an account event from a client's event log to an event stream. The event type is extracted
+// from the account event, and events that cannot be parsed are sent without one.
+func writeEvent(writer io.Writer, entry *redis.LogEntry) error {
+	var event events.Event
+
+	if err := json.Unmarshal(entry.Message, &event); err == nil && event.EventType != "" {
+		if _, err = fmt.Fprintf(writer, "event: %s\n", event.EventType); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+	}
+
+	if _, err := fmt.Fprintf(writer, "id: %s\ndata: %s\n\n", entry.ID, entry.Message); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	return nil
+}
+
+// Events will stream a client's account events as Server-Sent Events.
+//
+//	@Summary		Stream account events.
+//	@Description	Streams the deposits, currency exchanges, transfers, and Cryptocurrency purchases and sales on a client's accounts as Server-Sent Events as they are committed. Each event's id is its position in the client's event log, its event is the event type, and its data is the JSON event. A stream that is reconnected with the Last-Event-ID header will resume after that event, provided it is still retained in the event log. Only the most recent events are retained, for up to a day. Heartbeat comments are sent on idle streams, and streams opened with a JWT are closed when it expires. Events are delivered at least once and should be deduplicated with the eventId in the data.
+//	@Tags			events stream
+//	@Id				events
+//	@Produce		text/event-stream
+//	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
+//	@Param			Last-Event-ID	header		string				false	"the id of the last event received, to resume the stream after"
+//	@Success		200				{string}	string				"a stream of account events"
+//	@Failure		400				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500				{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/events [get]
+func Events(logger *logger.Logger, auth auth.Auth, cache redis.Redis) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err       error
+			clientID  uuid.UUID
+			expiresAt int64
+			entries   []redis.LogEntry
+		)
+
+		if clientID, expiresAt, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		logKey := events.AccountEventsLog(clientID)
+		lastEventID := ginCtx.GetHeader(constants.LastEventIDHeader())
+
+		// Streams that are not being resumed start after the most recent event.
+		if lastEventID == "" {
+			if lastEventID, err = cache.LogTail(logKey); err != nil {
+				ginCtx.AbortWithStatusJSON(http.StatusInternalServerError,
+					&models.HTTPError{Message: constants.RetryMessageString()})
+
+				return
+			}
+		} else if !events.ValidLogID(lastEventID) {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest,
+				&models.HTTPError{Message: "invalid Last-Event-ID", Payload: lastEventID})
+
+			return
+		}
+
+		// Streams opened with a JWT are closed when it expires, whilst API keys do not expire.
+		ctx, cancel := context.WithCancel(ginCtx.Request.Context())
+		if expiresAt > 0 {
+			ctx, cancel = context.WithDeadline(ginCtx.Request.Context(), time.Unix(expiresAt, 0))
+		}
+
+		defer cancel()
+
+		// The stream must outlive the server's write timeout.
+		if err = http.NewResponseController(ginCtx.Writer).SetWriteDeadline(time.Time{}); err != nil {
+			logger.Debug("unable to clear write deadline for event stream", zap.Error(err))
+		}
+
+		ginCtx.Header("Content-Type", "text/event-stream")
+		ginCtx.Header("Cache-Control", "no-cache")
+		ginCtx.Header("Connection", "keep-alive")
+		ginCtx.Header("X-Accel-Buffering", "no")
+		ginCtx.Status(http.StatusOK)
+		ginCtx.Writer.Flush()
+
+		for {
+			entries, err = cache.ReadLog(ctx, logKey, lastEventID, constants.EventStreamHeartbeat())
+			if ctx.Err() != nil {
+				return
+			}
+
+			if err != nil {
+				logger.Warn("failed to read account events for event stream", zap.Error(err))
+
+				return
+			}
+
+			if len(entries) == 0 {
+				if _, err = io.WriteString(ginCtx.Writer, ": heartbeat\n\n"); err != nil {
+					return
+				}
+			}
+
+			for idx := range entries {
+				if err = writeEvent(ginCtx.Writer, &entries[idx]); err != nil {
+					return
+				}
+
+				lastEventID = entries[idx].ID
+			}
+
+			ginCtx.Writer.Flush()
+		}
+	}
+}
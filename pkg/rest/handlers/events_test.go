@@ -0,0 +1,225 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/events"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestHandlers_Events(t *testing.T) {
+	t.Parallel()
+
+	const path = "/events"
+
+	clientID := uuid.Must(uuid.NewV4())
+	logKey := events.AccountEventsLog(clientID)
+
+	deposit, err := json.Marshal(&events.Event{
+		EventID:   uuid.Must(uuid.NewV4()),
+		EventType: postgres.OutboxEventTypeFiatDeposit,
+		ClientID:  clientID,
+		CreatedAt: time.Now().UTC(),
+		Payload:   json.RawMessage(`{"currency":"USD"}`),
+	})
+	require.NoError(t, err, "failed to marshal deposit event.")
+
+	purchase, err := json.Marshal(&events.Event{
+		EventID:   uuid.Must(uuid.NewV4()),
+		EventType: postgres.OutboxEventTypeCryptoPurchase,
+		ClientID:  clientID,
+		CreatedAt: time.Now().UTC(),
+		Payload:   json.RawMessage(`{"fiatCurrency":"USD","ticker":"BTC"}`),
+	})
+	require.NoError(t, err, "failed to marshal purchase event.")
+
+	entries := []redis.LogEntry{{ID: "7-0", Message: deposit}, {ID: "8-0", Message: purchase}}
+
+	testCases := []struct {
+		name               string
+		lastEventID        string
+		expectedStatus     int
+		expectedMsg        string
+		expectedBody       []string
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		logTailErr         error
+		logTailTimes       int
+		readFromID         string
+		readErr            error
+		readTimes          int
+	}{
+		{
+			name:               "invalid jwt",
+			expectedStatus:     http.StatusForbidden,
+			expectedMsg:        "malformed authentication",
+			authTokenInfoErr:   errors.New("invalid jwt"),
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "invalid last event id",
+			lastEventID:        "invalid",
+			expectedStatus:     http.StatusBadRequest,
+			expectedMsg:        "invalid Last-Event-ID",
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "log tail failure",
+			expectedStatus:     http.StatusInternalServerError,
+			expectedMsg:        constants.RetryMessageString(),
+			authTokenInfoTimes: 1,
+			logTailErr:         redis.ErrCacheUnknown,
+			logTailTimes:       1,
+		}, {
+			name:               "read failure",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoTimes: 1,
+			logTailTimes:       1,
+			readFromID:         "6-0",
+			readErr:            redis.ErrCacheUnknown,
+			readTimes:          1,
+		}, {
+			name:               "new stream",
+			expectedStatus:     http.StatusOK,
+			expectedBody:       []string{"event: fiat_deposit\nid: 7-0\ndata: ", "id: 8-0\n", ": heartbeat\n\n"},
+			authTokenInfoTimes: 1,
+			logTailTimes:       1,
+			readFromID:         "6-0",
+			readTimes:          3,
+		}, {
+			name:               "resumed stream",
+			lastEventID:        "2-0",
+			expectedStatus:     http.StatusOK,
+			expectedBody:       []string{"event: crypto_purchase\nid: 8-0\ndata: ", ": heartbeat\n\n"},
+			authTokenInfoTimes: 1,
+			readFromID:         "2-0",
+			readTimes:          3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl)
+
+			mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+				Return(clientID, time.Now().Add(time.Minute).Unix(), test.authTokenInfoErr).
+				Times(test.authTokenInfoTimes)
+
+			mockCache.EXPECT().LogTail(logKey).
+				Return("6-0", test.logTailErr).
+				Times(test.logTailTimes)
+
+			// Events are streamed, followed by a heartbeat, and then the client disconnects.
+			readCall := 0
+			afterIDs := []string{test.readFromID, "8-0", "8-0"}
+			mockCache.EXPECT().ReadLog(gomock.Any(), logKey, gomock.Any(), constants.EventStreamHeartbeat()).
+				DoAndReturn(func(_ context.Context, _, afterID string, _ time.Duration) ([]redis.LogEntry, error) {
+					require.Equal(t, afterIDs[readCall], afterID, "read log from wrong ID.")
+					readCall++
+
+					switch {
+					case test.readErr != nil:
+						return nil, test.readErr
+					case readCall == 1 && afterID == "2-0":
+						return entries[1:], nil
+					case readCall == 1:
+						return entries, nil
+					case readCall == 2:
+						return nil, nil
+					default:
+						cancel()
+
+						return nil, context.Canceled
+					}
+				}).
+				Times(test.readTimes)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(path, Events(zapLogger, mockAuth, mockCache))
+			req, _ := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
+
+			if test.lastEventID != "" {
+				req.Header.Set(constants.LastEventIDHeader(), test.lastEventID)
+			}
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			if test.expectedStatus != http.StatusOK {
+				var resp map[string]interface{}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+				actualMessage, ok := resp["message"].(string)
+				require.True(t, ok, "failed to extract response message.")
+				require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+
+				return
+			}
+
+			require.Equal(t, "text/event-stream", recorder.Header().Get("Content-Type"), "content type mismatch.")
+
+			for _, expected := range test.expectedBody {
+				require.Contains(t, recorder.Body.String(), expected, "event stream mismatch.")
+			}
+		})
+	}
+}
+
+func TestHandlers_WriteEvent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		message  string
+		expected string
+	}{
+		{
+			name:     "account event",
+			message:  `{"eventType":"fiat_exchange","payload":{}}`,
+			expected: "event: fiat_exchange\nid: 1-0\ndata: {\"eventType\":\"fiat_exchange\",\"payload\":{}}\n\n",
+		}, {
+			name:     "malformed",
+			message:  `malformed`,
+			expected: "id: 1-0\ndata: malformed\n\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := httptest.NewRecorder()
+			require.NoError(t, writeEvent(recorder, &redis.LogEntry{ID: "1-0", Message: []byte(test.message)}),
+				"failed to write event.")
+			require.Equal(t, test.expected, recorder.Body.String(), "event mismatch.")
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
 //	@Param			request	body		models.HTTPOpenCurrencyAccountRequest	true	"currency code for new account"
 //	@Success		201		{object}	models.HTTPSuccess						"a message to confirm the creation of an account"
 //	@Failure		400		{object}	models.HTTPError						"error message with any available details in payload"
@@ -82,13 +83,17 @@ func OpenFiat(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.H
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
-//	@Param			request	body		models.HTTPDepositCurrencyRequest	true	"currency code and amount to be deposited"
-//	@Success		200		{object}	models.HTTPSuccess					"a message to confirm the deposit of funds"
-//	@Failure		400		{object}	models.HTTPError					"error message with any available details in payload"
-//	@Failure		403		{object}	models.HTTPError					"error message with any available details in payload"
-//	@Failure		500		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Security		ClientAPIKey
+//	@Param			request			body		models.HTTPDepositCurrencyRequest	true	"currency code and amount to be deposited"
+//	@Param			Idempotency-Key	header		string								false	"unique key used to safely retry the request"
+//	@Success		200				{object}	models.HTTPSuccess					"a message to confirm the deposit of funds"
+//	@Failure		400				{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		403				{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		409				{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		422				{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		500				{object}	models.HTTPError					"error message with any available details in payload"
 //	@Router			/fiat/deposit [post]
-func DepositFiat(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+func DepositFiat(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db postgres.Postgres) gin.HandlerFunc {
 	return func(ginCtx *gin.Context) {
 		var (
 			clientID        uuid.UUID
@@ -112,8 +117,11 @@ func DepositFiat(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gi
 			return
 		}
 
-		if transferReceipt, httpStatus, httpMessage, payload, err =
-			common.HTTPFiatDeposit(db, logger, clientID, &request); err != nil {
+		if transferReceipt, httpStatus, httpMessage, payload, err = common.HTTPIdempotentRequest(cache, logger, clientID,
+			ginCtx.GetHeader(constants.IdempotencyKeyHeader()), &request,
+			func() (*postgres.FiatAccountTransferResult, int, string, any, error) {
+				return common.HTTPFiatDeposit(db, logger, clientID, &request)
+			}); err != nil {
 			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
 
 			return
@@ -123,6 +131,64 @@ func DepositFiat(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gi
 	}
 }
 
+// WithdrawFiat will handle an HTTP request to withdraw funds from a Fiat account.
+//
+//	@Summary		Withdraw funds from a Fiat account.
+//	@Description	Withdraw funds from a Fiat account in a specific currency for a user. The amount must be a positive number with at most two decimal places and cannot exceed the account balance.
+//	@Tags			fiat currency withdraw
+//	@Id				withdrawFiat
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
+//	@Param			request			body		models.HTTPWithdrawCurrencyRequest	true	"currency code and amount to be withdrawn"
+//	@Param			Idempotency-Key	header		string								false	"unique key used to safely retry the request"
+//	@Param			X-MFA-Code		header		string								false	"one-time password when step-up multifactor authentication is required"
+//	@Success		200				{object}	models.HTTPSuccess					"a message to confirm the withdrawal of funds"
+//	@Failure		400				{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		403				{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		409				{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		422				{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		500				{object}	models.HTTPError					"error message with any available details in payload"
+//	@Router			/fiat/withdraw [post]
+func WithdrawFiat(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID        uuid.UUID
+			err             error
+			httpMessage     string
+			httpStatus      int
+			payload         any
+			request         models.HTTPWithdrawCurrencyRequest
+			transferReceipt *postgres.FiatAccountTransferResult
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if transferReceipt, httpStatus, httpMessage, payload, err = common.HTTPIdempotentRequest(cache, logger, clientID,
+			ginCtx.GetHeader(constants.IdempotencyKeyHeader()), &request,
+			func() (*postgres.FiatAccountTransferResult, int, string, any, error) {
+				return common.HTTPFiatWithdraw(db, logger, clientID, &request)
+			}); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "funds successfully withdrawn", Payload: *transferReceipt})
+	}
+}
+
 // ExchangeOfferFiat will handle an HTTP request to get an exchange offer of funds between two Fiat currencies.
 //
 //	@Summary		Exchange quote for Fiat funds between two Fiat currencies.
@@ -132,6 +198,7 @@ func DepositFiat(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gi
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
 //	@Param			request	body		models.HTTPExchangeOfferRequest	true	"the two currency code and amount to be converted"
 //	@Success		200		{object}	models.HTTPSuccess				"a message to confirm the conversion rate for a currency"
 //	@Failure		400		{object}	models.HTTPError				"error message with any available details in payload"
@@ -182,12 +249,17 @@ func ExchangeOfferFiat(logger *logger.Logger, auth auth.Auth, cache redis.Redis,
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
-//	@Param			offerID	body		models.HTTPTransferRequest	true	"the two currency codes and amount to be converted"
-//	@Success		200		{object}	models.HTTPSuccess			"a message to confirm the conversion of funds"
-//	@Failure		400		{object}	models.HTTPError			"error message with any available details in payload"
-//	@Failure		403		{object}	models.HTTPError			"error message with any available details in payload"
-//	@Failure		408		{object}	models.HTTPError			"error message with any available details in payload"
-//	@Failure		500		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Security		ClientAPIKey
+//	@Param			offerID			body		models.HTTPTransferRequest	true	"the two currency codes and amount to be converted"
+//	@Param			Idempotency-Key	header		string						false	"unique key used to safely retry the request"
+//	@Param			X-MFA-Code		header		string						false	"one-time password when step-up multifactor authentication is required"
+//	@Success		200				{object}	models.HTTPSuccess			"a message to confirm the conversion of funds"
+//	@Failure		400				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		403				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		408				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		409				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		422				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		500				{object}	models.HTTPError			"error message with any available details in payload"
 //	@Router			/fiat/exchange/transfer [post]
 func ExchangeTransferFiat(
 	logger *logger.Logger,
@@ -217,8 +289,11 @@ func ExchangeTransferFiat(
 			return
 		}
 
-		if receipt, httpStatus, httpMessage, payload, err =
-			common.HTTPFiatTransfer(auth, cache, db, logger, clientID, &request); err != nil {
+		if receipt, httpStatus, httpMessage, payload, err = common.HTTPIdempotentRequest(cache, logger, clientID,
+			ginCtx.GetHeader(constants.IdempotencyKeyHeader()), &request,
+			func() (*models.HTTPFiatTransferResponse, int, string, any, error) {
+				return common.HTTPFiatTransfer(auth, cache, db, logger, clientID, &request)
+			}); err != nil {
 			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
 
 			return
@@ -228,6 +303,65 @@ func ExchangeTransferFiat(
 	}
 }
 
+// TransferP2PFiat will handle an HTTP request to transfer Fiat funds to another client.
+//
+//	@Summary		Transfer Fiat funds to another client.
+//	@Description	Transfer Fiat funds to another client's account in the same currency. The recipient is identified by their username and must have an open account in the currency. The amount must be a positive number with at most two decimal places.
+//	@Tags			fiat currency transfer p2p peer
+//	@Id				transferP2PFiat
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
+//	@Param			request			body		models.HTTPFiatP2PTransferRequest	true	"recipient username, currency code, and amount to be transferred"
+//	@Param			Idempotency-Key	header		string								false	"unique key used to safely retry the request"
+//	@Param			X-MFA-Code		header		string								false	"one-time password when step-up multifactor authentication is required"
+//	@Success		200				{object}	models.HTTPSuccess					"a message to confirm the transfer of funds"
+//	@Failure		400				{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		403				{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		404				{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		409				{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		422				{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		500				{object}	models.HTTPError					"error message with any available details in payload"
+//	@Router			/fiat/transfer/p2p [post]
+func TransferP2PFiat(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			httpMessage string
+			httpStatus  int
+			payload     any
+			request     models.HTTPFiatP2PTransferRequest
+			receipt     *postgres.FiatAccountTransferResult
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if receipt, httpStatus, httpMessage, payload, err = common.HTTPIdempotentRequest(cache, logger, clientID,
+			ginCtx.GetHeader(constants.IdempotencyKeyHeader()), &request,
+			func() (*postgres.FiatAccountTransferResult, int, string, any, error) {
+				return common.HTTPFiatTransferP2P(db, logger, clientID, &request)
+			}); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "funds successfully transferred", Payload: *receipt})
+	}
+}
+
 // BalanceFiat will handle an HTTP request to retrieve a balance for a specific Fiat currency.
 //
 //	@Summary		Retrieve balance for a specific Fiat currency.
@@ -237,6 +371,7 @@ func ExchangeTransferFiat(
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
 //	@Param			ticker	path		string				true	"the currency ticker to retrieve the balance for"
 //	@Success		200		{object}	models.HTTPSuccess	"the details for a specific currency account"
 //	@Failure		400		{object}	models.HTTPError	"error message with any available details in payload"
@@ -283,6 +418,7 @@ func BalanceFiat(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gi
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
 //	@Param			transactionID	path		string				true	"the transaction ID to retrieve the details for"
 //	@Success		200				{object}	models.HTTPSuccess	"the transaction details for a specific transaction ID"
 //	@Failure		400				{object}	models.HTTPError	"error message with any available details in payload"
@@ -329,6 +465,7 @@ func TxDetailsFiat(logger *logger.Logger, auth auth.Auth, db postgres.Postgres)
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
 //	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
 //	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
 //	@Success		200			{object}	models.HTTPSuccess	"a message to with a page of account balances for the client's accounts"
@@ -377,6 +514,7 @@ func BalanceFiatPaginated(logger *logger.Logger, auth auth.Auth, db postgres.Pos
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
 //	@Param			currencyCode	path		string				true	"the currency code to retrieve the transaction details for."
 //	@Param			pageCursor		query		string				false	"The page cursor into the query results records."
 //	@Param			timezone		query		string				false	"The timezone for the month in question."
@@ -239,6 +239,7 @@ func TestHandlers_DepositFiat(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl) // Not called.
 
 			depositReqJSON, err := json.Marshal(&test.request)
 			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
@@ -255,7 +256,7 @@ func TestHandlers_DepositFiat(t *testing.T) {
 
 			// Endpoint setup for test.
 			router := gin.Default()
-			router.POST(test.path, DepositFiat(zapLogger, mockAuth, mockPostgres))
+			router.POST(test.path, DepositFiat(zapLogger, mockAuth, mockCache, mockPostgres))
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(depositReqJSON))
 			recorder := httptest.NewRecorder()
 			router.ServeHTTP(recorder, req)
@@ -274,6 +275,150 @@ func TestHandlers_DepositFiat(t *testing.T) {
 	}
 }
 
+func TestHandlers_WithdrawFiat(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		expectedMsg        string
+		path               string
+		expectedStatus     int
+		request            *models.HTTPWithdrawCurrencyRequest
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		extWithdrawErr     error
+		extWithdrawTimes   int
+	}{
+		{
+			name:               "invalid jwt",
+			expectedMsg:        "malformed authentication",
+			path:               "/fiat-withdraw/invalid-jwt",
+			expectedStatus:     http.StatusForbidden,
+			request:            &models.HTTPWithdrawCurrencyRequest{Currency: "USD", Amount: decimal.NewFromFloat(1337.89)},
+			authTokenInfoErr:   errors.New("invalid jwt"),
+			authTokenInfoTimes: 1,
+			extWithdrawErr:     nil,
+			extWithdrawTimes:   0,
+		}, {
+			name:               "empty request",
+			expectedMsg:        constants.ValidationString(),
+			path:               "/fiat-withdraw/empty-request",
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPWithdrawCurrencyRequest{},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			extWithdrawErr:     nil,
+			extWithdrawTimes:   0,
+		}, {
+			name:               "invalid currency",
+			expectedMsg:        "currency",
+			path:               "/fiat-withdraw/invalid-currency",
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPWithdrawCurrencyRequest{Currency: "INVALID", Amount: decimal.NewFromFloat(1)},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			extWithdrawErr:     nil,
+			extWithdrawTimes:   0,
+		}, {
+			name:               "too many decimal places",
+			expectedMsg:        "amount",
+			path:               "/fiat-withdraw/too-many-decimal-places",
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPWithdrawCurrencyRequest{Currency: "USD", Amount: decimal.NewFromFloat(1.234)},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			extWithdrawErr:     nil,
+			extWithdrawTimes:   0,
+		}, {
+			name:               "negative",
+			expectedMsg:        "amount",
+			path:               "/fiat-withdraw/negative",
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPWithdrawCurrencyRequest{Currency: "USD", Amount: decimal.NewFromFloat(-1)},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			extWithdrawErr:     nil,
+			extWithdrawTimes:   0,
+		}, {
+			name:               "unknown xfer error",
+			expectedMsg:        "retry",
+			path:               "/fiat-withdraw/unknown-xfer-error",
+			expectedStatus:     http.StatusInternalServerError,
+			request:            &models.HTTPWithdrawCurrencyRequest{Currency: "USD", Amount: decimal.NewFromFloat(1337.89)},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			extWithdrawErr:     errors.New("unknown error"),
+			extWithdrawTimes:   1,
+		}, {
+			name:               "xfer error",
+			expectedMsg:        "could not complete",
+			path:               "/fiat-withdraw/xfer-error",
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPWithdrawCurrencyRequest{Currency: "USD", Amount: decimal.NewFromFloat(1337.89)},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			extWithdrawErr:     postgres.ErrWithdrawFiat,
+			extWithdrawTimes:   1,
+		}, {
+			name:               "valid",
+			expectedMsg:        "successfully withdrawn",
+			path:               "/fiat-withdraw/valid",
+			expectedStatus:     http.StatusOK,
+			request:            &models.HTTPWithdrawCurrencyRequest{Currency: "USD", Amount: decimal.NewFromFloat(1337.89)},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			extWithdrawErr:     nil,
+			extWithdrawTimes:   1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl) // Not called.
+
+			withdrawReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockPostgres.EXPECT().FiatExternalWithdrawal(gomock.Any(), gomock.Any()).
+					Return(&postgres.FiatAccountTransferResult{}, test.extWithdrawErr).
+					Times(test.extWithdrawTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, WithdrawFiat(zapLogger, mockAuth, mockCache, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(withdrawReqJSON))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack success response.")
+
+			errorMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, errorMessage, test.expectedMsg, "incorrect response message.")
+		})
+	}
+}
+
 func TestHandlers_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 	t.Parallel()
 
@@ -501,6 +646,10 @@ func TestHandlers_ExchangeOfferFiat(t *testing.T) { //nolint:maintidx
 					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
 					Times(test.authTokenInfoTimes),
 
+				mockQuotes.EXPECT().Fee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(decimal.Zero).
+					Times(test.quotesTimes),
+
 				mockQuotes.EXPECT().FiatConversion(gomock.Any(), gomock.Any(), gomock.Any(), nil).
 					Return(amountValid, amountValid, test.quotesErr).
 					Times(test.quotesTimes),
@@ -870,7 +1019,7 @@ func TestHandler_ExchangeTransferFiat(t *testing.T) { //nolint:maintidx
 					Return(test.redisDelErr).
 					Times(test.redisDelTimes),
 
-				mockDB.EXPECT().FiatInternalTransfer(gomock.Any(), gomock.Any(), gomock.Any()).
+				mockDB.EXPECT().FiatInternalTransfer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil, nil, test.internalXferErr).
 					Times(test.internalXferTimes),
 			)
@@ -904,6 +1053,155 @@ func TestHandler_ExchangeTransferFiat(t *testing.T) { //nolint:maintidx
 	}
 }
 
+func TestHandlers_TransferP2PFiat(t *testing.T) {
+	t.Parallel()
+
+	recipientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate recipient id.")
+
+	validRequest := &models.HTTPFiatP2PTransferRequest{
+		Username: "recipient",
+		Currency: "USD",
+		Amount:   decimal.NewFromFloat(1337.89),
+	}
+
+	testCases := []struct {
+		name               string
+		expectedMsg        string
+		path               string
+		expectedStatus     int
+		request            *models.HTTPFiatP2PTransferRequest
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		getClientIDErr     error
+		getClientIDTimes   int
+		isDeletedTimes     int
+		transferErr        error
+		transferTimes      int
+	}{
+		{
+			name:               "invalid jwt",
+			expectedMsg:        "malformed authentication",
+			path:               "/fiat-p2p/invalid-jwt",
+			expectedStatus:     http.StatusForbidden,
+			request:            validRequest,
+			authTokenInfoErr:   errors.New("invalid jwt"),
+			authTokenInfoTimes: 1,
+			getClientIDTimes:   0,
+			isDeletedTimes:     0,
+			transferTimes:      0,
+		}, {
+			name:               "empty request",
+			expectedMsg:        constants.ValidationString(),
+			path:               "/fiat-p2p/empty-request",
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPFiatP2PTransferRequest{},
+			authTokenInfoTimes: 1,
+			getClientIDTimes:   0,
+			isDeletedTimes:     0,
+			transferTimes:      0,
+		}, {
+			name:           "invalid currency",
+			expectedMsg:    "currency",
+			path:           "/fiat-p2p/invalid-currency",
+			expectedStatus: http.StatusBadRequest,
+			request: &models.HTTPFiatP2PTransferRequest{
+				Username: "recipient", Currency: "INVALID", Amount: decimal.NewFromFloat(1),
+			},
+			authTokenInfoTimes: 1,
+			getClientIDTimes:   0,
+			isDeletedTimes:     0,
+			transferTimes:      0,
+		}, {
+			name:               "recipient not found",
+			expectedMsg:        "recipient not found",
+			path:               "/fiat-p2p/recipient-not-found",
+			expectedStatus:     http.StatusNotFound,
+			request:            validRequest,
+			authTokenInfoTimes: 1,
+			getClientIDErr:     postgres.ErrNotFoundUser,
+			getClientIDTimes:   1,
+			isDeletedTimes:     0,
+			transferTimes:      0,
+		}, {
+			name:               "transfer error",
+			expectedMsg:        "enough funds",
+			path:               "/fiat-p2p/transfer-error",
+			expectedStatus:     http.StatusBadRequest,
+			request:            validRequest,
+			authTokenInfoTimes: 1,
+			getClientIDTimes:   1,
+			isDeletedTimes:     1,
+			transferErr:        postgres.ErrTransactFiat,
+			transferTimes:      1,
+		}, {
+			name:               "valid",
+			expectedMsg:        "successfully",
+			path:               "/fiat-p2p/valid",
+			expectedStatus:     http.StatusOK,
+			request:            validRequest,
+			authTokenInfoTimes: 1,
+			getClientIDTimes:   1,
+			isDeletedTimes:     1,
+			transferTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl) // Not called.
+
+			transferReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockPostgres.EXPECT().UserGetClientID(gomock.Any()).
+					Return(recipientID, test.getClientIDErr).
+					Times(test.getClientIDTimes),
+
+				mockPostgres.EXPECT().UserIsDeleted(gomock.Any()).
+					Return(false, nil).
+					Times(test.isDeletedTimes),
+
+				mockPostgres.EXPECT().FiatInternalTransfer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&postgres.FiatAccountTransferResult{}, &postgres.FiatAccountTransferResult{}, test.transferErr).
+					Times(test.transferTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, TransferP2PFiat(zapLogger, mockAuth, mockCache, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(transferReqJSON))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack success response.")
+
+			errorMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, errorMessage, test.expectedMsg, "incorrect response message.")
+		})
+	}
+}
+
 func TestHandler_BalanceFiat(t *testing.T) { //nolint:dupl
 	t.Parallel()
 
@@ -1034,6 +1332,8 @@ func TestHandler_TxDetailsFiat(t *testing.T) {
 		fiatTxTimes        int
 		cryptoTxErr        error
 		cryptoTxTimes      int
+		tradeTxErr         error
+		tradeTxTimes       int
 	}{
 		{
 			name:               "invalid transaction ID",
@@ -1048,6 +1348,8 @@ func TestHandler_TxDetailsFiat(t *testing.T) {
 			fiatTxTimes:        0,
 			cryptoTxErr:        nil,
 			cryptoTxTimes:      0,
+			tradeTxErr:         nil,
+			tradeTxTimes:       0,
 		}, {
 			name:               "invalid JWT",
 			transactionID:      txID.String(),
@@ -1061,6 +1363,8 @@ func TestHandler_TxDetailsFiat(t *testing.T) {
 			fiatTxTimes:        0,
 			cryptoTxErr:        nil,
 			cryptoTxTimes:      0,
+			tradeTxErr:         nil,
+			tradeTxTimes:       0,
 		}, {
 			name:               "unknown db error",
 			transactionID:      txID.String(),
@@ -1074,6 +1378,8 @@ func TestHandler_TxDetailsFiat(t *testing.T) {
 			fiatTxTimes:        1,
 			cryptoTxErr:        nil,
 			cryptoTxTimes:      0,
+			tradeTxErr:         nil,
+			tradeTxTimes:       0,
 		}, {
 			name:               "known db error",
 			transactionID:      txID.String(),
@@ -1087,6 +1393,8 @@ func TestHandler_TxDetailsFiat(t *testing.T) {
 			fiatTxTimes:        1,
 			cryptoTxErr:        nil,
 			cryptoTxTimes:      0,
+			tradeTxErr:         nil,
+			tradeTxTimes:       0,
 		}, {
 			name:               "transaction id not found",
 			transactionID:      txID.String(),
@@ -1100,6 +1408,8 @@ func TestHandler_TxDetailsFiat(t *testing.T) {
 			fiatTxTimes:        1,
 			cryptoTxErr:        nil,
 			cryptoTxTimes:      1,
+			tradeTxErr:         nil,
+			tradeTxTimes:       0,
 		}, {
 			name:               "valid",
 			transactionID:      txID.String(),
@@ -1113,6 +1423,8 @@ func TestHandler_TxDetailsFiat(t *testing.T) {
 			fiatTxTimes:        1,
 			cryptoTxErr:        nil,
 			cryptoTxTimes:      1,
+			tradeTxErr:         nil,
+			tradeTxTimes:       1,
 		},
 	}
 
@@ -1140,6 +1452,10 @@ func TestHandler_TxDetailsFiat(t *testing.T) {
 				mockDB.EXPECT().CryptoTxDetails(gomock.Any(), gomock.Any()).
 					Return(test.cryptoJournal, test.cryptoTxErr).
 					Times(test.cryptoTxTimes),
+
+				mockDB.EXPECT().TradeTxDetails(gomock.Any(), gomock.Any()).
+					Return([]postgres.Trade{{}}, test.tradeTxErr).
+					Times(test.tradeTxTimes),
 			)
 
 			// Endpoint setup for test.
@@ -0,0 +1,18 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+)
+
+// JWKS publishes the public keys that JWTs issued by the service can be verified with as a JSON Web Key Set. The key
+// set is served from the root of the server at the well-known location and is not part of the versioned API.
+func JWKS(auth auth.Auth) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		ginCtx.Header("Cache-Control", constants.JWKSCacheControl())
+		ginCtx.JSON(http.StatusOK, auth.JWKS())
+	}
+}
@@ -0,0 +1,72 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+)
+
+func TestJWKS(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		path string
+		jwks *models.JWKSet
+	}{
+		{
+			name: "no keys",
+			path: "/jwks/no-keys",
+			jwks: &models.JWKSet{Keys: []models.JWK{}},
+		}, {
+			name: "keys",
+			path: "/jwks/keys",
+			jwks: &models.JWKSet{Keys: []models.JWK{
+				{Kty: "OKP", Use: "sig", Alg: "EdDSA", Kid: "key-2", Crv: "Ed25519", X: "public-key", N: "", E: ""},
+				{Kty: "RSA", Use: "sig", Alg: "RS256", Kid: "key-1", Crv: "", X: "", N: "modulus", E: "AQAB"},
+			}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+
+			mockAuth.EXPECT().JWKS().
+				Return(test.jwks).
+				Times(1)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(test.path, JWKS(mockAuth))
+
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, test.path, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, http.StatusOK, recorder.Code, "expected status codes do not match")
+			require.Equal(t, constants.JWKSCacheControl(), recorder.Header().Get("Cache-Control"), "cache control mismatched")
+
+			actual := &models.JWKSet{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), actual), "failed to unmarshal response body")
+			require.Equal(t, test.jwks, actual, "key sets mismatched")
+		})
+	}
+}
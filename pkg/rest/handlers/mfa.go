@@ -0,0 +1,251 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+// LoginMFA completes a login challenge with a multifactor authentication code and generates a JWT.
+//
+//	@Summary		Complete a multifactor authentication login.
+//	@Description	Completes the login challenge issued to a user with multifactor authentication enabled. A one-time password or an unused recovery code must be supplied. A challenge can only be attempted once.
+//	@Tags			user users login mfa security
+//	@Id				loginMFA
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		models.HTTPMFALoginRequest	true	"the challenge ID and a one-time password or recovery code"
+//	@Success		200		{object}	models.JWTAuthResponse		"a valid JWT token for the account"
+//	@Failure		400		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		408		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		429		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Router			/user/login/mfa [post]
+func LoginMFA(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			authToken  *models.JWTAuthResponse
+			request    models.HTTPMFALoginRequest
+			httpMsg    string
+			httpStatus int
+			payload    any
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, &models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if authToken, httpMsg, httpStatus, payload, err =
+			common.HTTPLoginMFA(auth, cache, db, logger, &request, ginCtx.ClientIP(), ginCtx.Request.UserAgent()); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, authToken)
+	}
+}
+
+// EnrollMFA will generate a multifactor authentication secret and recovery codes for a user.
+//
+//	@Summary		Enroll in multifactor authentication.
+//	@Description	Generates a Time-based One-Time Password secret, a provisioning URI for authenticator applications, and single-use recovery codes. The enrollment must be verified before it is enabled. Pending enrollments are replaced.
+//	@Tags			user users mfa enroll security
+//	@Id				enrollMFA
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		201	{object}	models.HTTPSuccess	"a message to confirm enrollment with the secret and recovery codes in the payload"
+//	@Failure		403	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		409	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/mfa/enroll [post]
+func EnrollMFA(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			clientID   uuid.UUID
+			enrollment *models.HTTPMFAEnrollResponse
+			httpMsg    string
+			httpStatus int
+			payload    any
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if enrollment, httpMsg, httpStatus, payload, err = common.HTTPMFAEnroll(auth, db, logger, clientID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusCreated, models.HTTPSuccess{
+			Message: "verify multifactor authentication enrollment with a one-time password",
+			Payload: enrollment,
+		})
+	}
+}
+
+// VerifyMFA will enable a pending multifactor authentication enrollment.
+//
+//	@Summary		Verify a multifactor authentication enrollment.
+//	@Description	Enables a pending multifactor authentication enrollment once it has been confirmed with a one-time password from an authenticator application.
+//	@Tags			user users mfa verify security
+//	@Id				verifyMFA
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		models.HTTPMFACodeRequest	true	"a one-time password"
+//	@Success		200		{object}	models.HTTPSuccess			"a message to confirm multifactor authentication is enabled"
+//	@Failure		400		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		409		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Router			/user/mfa/verify [post]
+func VerifyMFA(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			clientID   uuid.UUID
+			request    models.HTTPMFACodeRequest
+			httpMsg    string
+			httpStatus int
+			payload    any
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, &models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if httpMsg, httpStatus, payload, err = common.HTTPMFAVerify(auth, db, logger, clientID, &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "multifactor authentication enabled"})
+	}
+}
+
+// DisableMFA will remove a user's multifactor authentication enrollment.
+//
+//	@Summary		Disable multifactor authentication.
+//	@Description	Removes a user's multifactor authentication enrollment. Enabled enrollments must be confirmed with a one-time password or an unused recovery code.
+//	@Tags			user users mfa disable security
+//	@Id				disableMFA
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		models.HTTPMFACodeRequest	true	"a one-time password or recovery code"
+//	@Success		200		{object}	models.HTTPSuccess			"a message to confirm multifactor authentication is disabled"
+//	@Failure		400		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Router			/user/mfa/disable [post]
+func DisableMFA(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			clientID   uuid.UUID
+			request    models.HTTPMFACodeRequest
+			httpMsg    string
+			httpStatus int
+			payload    any
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, &models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if httpMsg, httpStatus, payload, err = common.HTTPMFADisable(auth, db, logger, clientID, &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "multifactor authentication disabled"})
+	}
+}
+
+// RecoveryCodesMFA will replace a user's multifactor authentication recovery codes.
+//
+//	@Summary		Regenerate multifactor authentication recovery codes.
+//	@Description	Replaces a user's recovery codes once the request has been confirmed with a one-time password. Any unused recovery codes are invalidated.
+//	@Tags			user users mfa recovery security
+//	@Id				recoveryCodesMFA
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		models.HTTPMFACodeRequest	true	"a one-time password"
+//	@Success		200		{object}	models.HTTPSuccess			"a message to confirm the replacement with the recovery codes in the payload"
+//	@Failure		400		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Router			/user/mfa/recovery-codes [post]
+func RecoveryCodesMFA(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			clientID   uuid.UUID
+			codes      *models.HTTPMFARecoveryCodesResponse
+			request    models.HTTPMFACodeRequest
+			httpMsg    string
+			httpStatus int
+			payload    any
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, &models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if codes, httpMsg, httpStatus, payload, err =
+			common.HTTPMFARecoveryCodes(auth, db, logger, clientID, &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "recovery codes replaced", Payload: codes})
+	}
+}
@@ -0,0 +1,636 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestHandlers_LoginMFA(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		path             string
+		expectedStatus   int
+		request          *models.HTTPMFALoginRequest
+		authDecryptErr   error
+		authDecryptTimes int
+		redisGetErr      error
+		redisGetTimes    int
+		redisDelTimes    int
+		mfaGetTimes      int
+		recoveryCodeErr  error
+		recoveryTimes    int
+		authGenJWTTimes  int
+	}{
+		{
+			name:             "empty request",
+			path:             "/login-mfa/empty-request",
+			expectedStatus:   http.StatusBadRequest,
+			request:          &models.HTTPMFALoginRequest{},
+			authDecryptErr:   nil,
+			authDecryptTimes: 0,
+			redisGetErr:      nil,
+			redisGetTimes:    0,
+			redisDelTimes:    0,
+			mfaGetTimes:      0,
+			recoveryCodeErr:  nil,
+			recoveryTimes:    0,
+			authGenJWTTimes:  0,
+		}, {
+			name:             "invalid challenge",
+			path:             "/login-mfa/invalid-challenge",
+			expectedStatus:   http.StatusForbidden,
+			request:          &models.HTTPMFALoginRequest{ChallengeID: "challenge", Code: "ABCD-EFGH-IJKL-MNOP"},
+			authDecryptErr:   errors.New("decryption failure"),
+			authDecryptTimes: 1,
+			redisGetErr:      nil,
+			redisGetTimes:    0,
+			redisDelTimes:    0,
+			mfaGetTimes:      0,
+			recoveryCodeErr:  nil,
+			recoveryTimes:    0,
+			authGenJWTTimes:  0,
+		}, {
+			name:             "expired challenge",
+			path:             "/login-mfa/expired-challenge",
+			expectedStatus:   http.StatusRequestTimeout,
+			request:          &models.HTTPMFALoginRequest{ChallengeID: "challenge", Code: "ABCD-EFGH-IJKL-MNOP"},
+			authDecryptErr:   nil,
+			authDecryptTimes: 1,
+			redisGetErr:      redis.ErrCacheMiss,
+			redisGetTimes:    1,
+			redisDelTimes:    0,
+			mfaGetTimes:      0,
+			recoveryCodeErr:  nil,
+			recoveryTimes:    0,
+			authGenJWTTimes:  0,
+		}, {
+			name:             "used recovery code",
+			path:             "/login-mfa/used-recovery-code",
+			expectedStatus:   http.StatusForbidden,
+			request:          &models.HTTPMFALoginRequest{ChallengeID: "challenge", Code: "ABCD-EFGH-IJKL-MNOP"},
+			authDecryptErr:   nil,
+			authDecryptTimes: 1,
+			redisGetErr:      nil,
+			redisGetTimes:    1,
+			redisDelTimes:    1,
+			mfaGetTimes:      1,
+			recoveryCodeErr:  postgres.ErrUsedMFA,
+			recoveryTimes:    1,
+			authGenJWTTimes:  0,
+		}, {
+			name:             "valid",
+			path:             "/login-mfa/valid",
+			expectedStatus:   http.StatusOK,
+			request:          &models.HTTPMFALoginRequest{ChallengeID: "challenge", Code: "ABCD-EFGH-IJKL-MNOP"},
+			authDecryptErr:   nil,
+			authDecryptTimes: 1,
+			redisGetErr:      nil,
+			redisGetTimes:    1,
+			redisDelTimes:    1,
+			mfaGetTimes:      1,
+			recoveryCodeErr:  nil,
+			recoveryTimes:    1,
+			authGenJWTTimes:  1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			requestJSON, err := json.Marshal(test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			// Login lockout bookkeeping is exercised in the common package.
+			mockRedis.EXPECT().Get(constants.LoginLockoutKeyPrefix(), gomock.Any()).Return(redis.ErrCacheMiss).AnyTimes()
+			mockRedis.EXPECT().Incr(constants.LoginFailuresKeyPrefix(), gomock.Any()).Return(int64(1), nil).AnyTimes()
+			mockRedis.EXPECT().Del(constants.LoginFailuresKeyPrefix()).Return(nil).AnyTimes()
+			mockAuth.EXPECT().LoginFailureWindow().Return(time.Hour).AnyTimes()
+			mockAuth.EXPECT().LoginLockout(gomock.Any()).Return(time.Duration(0)).AnyTimes()
+			mockPostgres.EXPECT().LoginEventCreate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil).AnyTimes()
+
+			gomock.InOrder(
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("challenge-id"), test.authDecryptErr).
+					Times(test.authDecryptTimes),
+
+				mockRedis.EXPECT().Get(constants.MFAChallengeKeyPrefix()+"challenge-id", gomock.Any()).
+					Return(test.redisGetErr).
+					Times(test.redisGetTimes),
+
+				mockRedis.EXPECT().Del(constants.MFAChallengeKeyPrefix()+"challenge-id").
+					Return(nil).
+					Times(test.redisDelTimes),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(postgres.MfaEnrollment{IsEnabled: true}, nil).
+					Times(test.mfaGetTimes),
+
+				mockAuth.EXPECT().HashRecoveryCode(gomock.Any()).
+					Return("hashed code").
+					Times(test.recoveryTimes),
+
+				mockPostgres.EXPECT().MFAUseRecoveryCode(gomock.Any(), "hashed code").
+					Return(test.recoveryCodeErr).
+					Times(test.recoveryTimes),
+
+				mockAuth.EXPECT().GenerateJWT(gomock.Any()).
+					Return(&models.JWTAuthResponse{}, nil).
+					Times(test.authGenJWTTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, LoginMFA(zapLogger, mockAuth, mockRedis, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(requestJSON))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_EnrollMFA(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		userGetInfoErr     error
+		userGetInfoTimes   int
+		mfaEnrollErr       error
+		mfaEnrollTimes     int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/enroll-mfa/invalid-jwt",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid jwt"),
+			authTokenInfoTimes: 1,
+			userGetInfoErr:     nil,
+			userGetInfoTimes:   0,
+			mfaEnrollErr:       nil,
+			mfaEnrollTimes:     0,
+		}, {
+			name:               "user info failure",
+			path:               "/enroll-mfa/user-info-failure",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			userGetInfoErr:     postgres.ErrNotFound,
+			userGetInfoTimes:   1,
+			mfaEnrollErr:       nil,
+			mfaEnrollTimes:     0,
+		}, {
+			name:               "already enabled",
+			path:               "/enroll-mfa/already-enabled",
+			expectedStatus:     http.StatusConflict,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			userGetInfoErr:     nil,
+			userGetInfoTimes:   1,
+			mfaEnrollErr:       postgres.ErrEnabledMFA,
+			mfaEnrollTimes:     1,
+		}, {
+			name:               "valid",
+			path:               "/enroll-mfa/valid",
+			expectedStatus:     http.StatusCreated,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			userGetInfoErr:     nil,
+			userGetInfoTimes:   1,
+			mfaEnrollErr:       nil,
+			mfaEnrollTimes:     1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			generateTimes := test.mfaEnrollTimes
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: testUserData["username1"]}, test.userGetInfoErr).
+					Times(test.userGetInfoTimes),
+
+				mockAuth.EXPECT().GenerateTOTP(gomock.Any()).
+					Return("SECRET", "otpauth://totp/issuer:username1", nil).
+					Times(generateTimes),
+
+				mockAuth.EXPECT().GenerateRecoveryCodes().
+					Return([]string{"code"}, []string{"hashed code"}, nil).
+					Times(generateTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted secret", nil).
+					Times(generateTimes),
+
+				mockPostgres.EXPECT().MFAEnroll(gomock.Any(), "encrypted secret", []string{"hashed code"}).
+					Return(test.mfaEnrollErr).
+					Times(test.mfaEnrollTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, EnrollMFA(zapLogger, mockAuth, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_VerifyMFA(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		expectedStatus     int
+		request            *models.HTTPMFACodeRequest
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		mfaGetEnrollment   postgres.MfaEnrollment
+		mfaGetErr          error
+		mfaGetTimes        int
+		validateTOTPErr    error
+		validateTOTPTimes  int
+		mfaEnableTimes     int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/verify-mfa/invalid-jwt",
+			expectedStatus:     http.StatusForbidden,
+			request:            &models.HTTPMFACodeRequest{Code: "123456"},
+			authTokenInfoErr:   errors.New("invalid jwt"),
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "empty request",
+			path:               "/verify-mfa/empty-request",
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPMFACodeRequest{},
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "not enrolled",
+			path:               "/verify-mfa/not-enrolled",
+			expectedStatus:     http.StatusNotFound,
+			request:            &models.HTTPMFACodeRequest{Code: "123456"},
+			authTokenInfoTimes: 1,
+			mfaGetErr:          postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:        1,
+		}, {
+			name:               "already enabled",
+			path:               "/verify-mfa/already-enabled",
+			expectedStatus:     http.StatusConflict,
+			request:            &models.HTTPMFACodeRequest{Code: "123456"},
+			authTokenInfoTimes: 1,
+			mfaGetEnrollment:   postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:        1,
+		}, {
+			name:               "invalid code",
+			path:               "/verify-mfa/invalid-code",
+			expectedStatus:     http.StatusForbidden,
+			request:            &models.HTTPMFACodeRequest{Code: "123456"},
+			authTokenInfoTimes: 1,
+			mfaGetTimes:        1,
+			validateTOTPErr:    errors.New("invalid code"),
+			validateTOTPTimes:  1,
+		}, {
+			name:               "valid",
+			path:               "/verify-mfa/valid",
+			expectedStatus:     http.StatusOK,
+			request:            &models.HTTPMFACodeRequest{Code: "123456"},
+			authTokenInfoTimes: 1,
+			mfaGetTimes:        1,
+			validateTOTPTimes:  1,
+			mfaEnableTimes:     1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			requestJSON, err := json.Marshal(test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(test.mfaGetEnrollment, test.mfaGetErr).
+					Times(test.mfaGetTimes),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("SECRET"), nil).
+					Times(test.validateTOTPTimes),
+
+				mockAuth.EXPECT().ValidateTOTP("SECRET", test.request.Code).
+					Return(int64(10), test.validateTOTPErr).
+					Times(test.validateTOTPTimes),
+
+				mockPostgres.EXPECT().MFAEnable(gomock.Any(), int64(10)).
+					Return(nil).
+					Times(test.mfaEnableTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, VerifyMFA(zapLogger, mockAuth, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(requestJSON))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_DisableMFA(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		expectedStatus     int
+		request            *models.HTTPMFACodeRequest
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		mfaGetEnrollment   postgres.MfaEnrollment
+		mfaGetErr          error
+		mfaGetTimes        int
+		recoveryCodeErr    error
+		recoveryTimes      int
+		mfaDeleteTimes     int
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/disable-mfa/invalid-jwt",
+			expectedStatus:     http.StatusForbidden,
+			request:            &models.HTTPMFACodeRequest{Code: "ABCD-EFGH-IJKL-MNOP"},
+			authTokenInfoErr:   errors.New("invalid jwt"),
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "empty request",
+			path:               "/disable-mfa/empty-request",
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPMFACodeRequest{},
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "not enrolled",
+			path:               "/disable-mfa/not-enrolled",
+			expectedStatus:     http.StatusNotFound,
+			request:            &models.HTTPMFACodeRequest{Code: "ABCD-EFGH-IJKL-MNOP"},
+			authTokenInfoTimes: 1,
+			mfaGetErr:          postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:        1,
+		}, {
+			name:               "used recovery code",
+			path:               "/disable-mfa/used-recovery-code",
+			expectedStatus:     http.StatusForbidden,
+			request:            &models.HTTPMFACodeRequest{Code: "ABCD-EFGH-IJKL-MNOP"},
+			authTokenInfoTimes: 1,
+			mfaGetEnrollment:   postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:        1,
+			recoveryCodeErr:    postgres.ErrUsedMFA,
+			recoveryTimes:      1,
+		}, {
+			name:               "valid",
+			path:               "/disable-mfa/valid",
+			expectedStatus:     http.StatusOK,
+			request:            &models.HTTPMFACodeRequest{Code: "ABCD-EFGH-IJKL-MNOP"},
+			authTokenInfoTimes: 1,
+			mfaGetEnrollment:   postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:        1,
+			recoveryTimes:      1,
+			mfaDeleteTimes:     1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			requestJSON, err := json.Marshal(test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(test.mfaGetEnrollment, test.mfaGetErr).
+					Times(test.mfaGetTimes),
+
+				mockAuth.EXPECT().HashRecoveryCode(test.request.Code).
+					Return("hashed code").
+					Times(test.recoveryTimes),
+
+				mockPostgres.EXPECT().MFAUseRecoveryCode(gomock.Any(), "hashed code").
+					Return(test.recoveryCodeErr).
+					Times(test.recoveryTimes),
+
+				mockPostgres.EXPECT().MFADelete(gomock.Any()).
+					Return(nil).
+					Times(test.mfaDeleteTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, DisableMFA(zapLogger, mockAuth, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(requestJSON))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_RecoveryCodesMFA(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		expectedStatus     int
+		request            *models.HTTPMFACodeRequest
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		mfaGetEnrollment   postgres.MfaEnrollment
+		mfaGetTimes        int
+		verifyTimes        int
+		mfaSetCodesErr     error
+	}{
+		{
+			name:               "invalid jwt",
+			path:               "/recovery-codes-mfa/invalid-jwt",
+			expectedStatus:     http.StatusForbidden,
+			request:            &models.HTTPMFACodeRequest{Code: "123456"},
+			authTokenInfoErr:   errors.New("invalid jwt"),
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "empty request",
+			path:               "/recovery-codes-mfa/empty-request",
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPMFACodeRequest{},
+			authTokenInfoTimes: 1,
+		}, {
+			name:               "pending enrollment",
+			path:               "/recovery-codes-mfa/pending-enrollment",
+			expectedStatus:     http.StatusNotFound,
+			request:            &models.HTTPMFACodeRequest{Code: "123456"},
+			authTokenInfoTimes: 1,
+			mfaGetEnrollment:   postgres.MfaEnrollment{IsEnabled: false},
+			mfaGetTimes:        1,
+		}, {
+			name:               "store failure",
+			path:               "/recovery-codes-mfa/store-failure",
+			expectedStatus:     http.StatusInternalServerError,
+			request:            &models.HTTPMFACodeRequest{Code: "123456"},
+			authTokenInfoTimes: 1,
+			mfaGetEnrollment:   postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:        1,
+			verifyTimes:        1,
+			mfaSetCodesErr:     postgres.ErrTransactMFA,
+		}, {
+			name:               "valid",
+			path:               "/recovery-codes-mfa/valid",
+			expectedStatus:     http.StatusOK,
+			request:            &models.HTTPMFACodeRequest{Code: "123456"},
+			authTokenInfoTimes: 1,
+			mfaGetEnrollment:   postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetTimes:        1,
+			verifyTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			requestJSON, err := json.Marshal(test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(test.mfaGetEnrollment, nil).
+					Times(test.mfaGetTimes),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("SECRET"), nil).
+					Times(test.verifyTimes),
+
+				mockAuth.EXPECT().ValidateTOTP("SECRET", test.request.Code).
+					Return(int64(10), nil).
+					Times(test.verifyTimes),
+
+				mockPostgres.EXPECT().MFAUseStep(gomock.Any(), int64(10)).
+					Return(nil).
+					Times(test.verifyTimes),
+
+				mockAuth.EXPECT().GenerateRecoveryCodes().
+					Return([]string{"code"}, []string{"hashed code"}, nil).
+					Times(test.verifyTimes),
+
+				mockPostgres.EXPECT().MFASetRecoveryCodes(gomock.Any(), []string{"hashed code"}).
+					Return(test.mfaSetCodesErr).
+					Times(test.verifyTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, RecoveryCodesMFA(zapLogger, mockAuth, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(requestJSON))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
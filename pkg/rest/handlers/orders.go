@@ -0,0 +1,165 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+// PlaceOrder will handle an HTTP request to place a limit order.
+//
+//	@Summary		Place a limit order to convert between two currencies.
+//	@Description	Place a limit order to convert funds from a source to a destination currency once the conversion rate is at or above the limit rate. Fiat to Fiat, Fiat to Cryptocurrency, and Cryptocurrency to Fiat orders are supported. The source amount must be a positive number with the source currency's precision. Account balances are verified when the order is settled.
+//	@Tags			orders limit fiat crypto cryptocurrency currency place
+//	@Id				placeOrder
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
+//	@Param			request			body		models.HTTPOrderRequest	true	"the two currency codes, the source amount, and the limit rate"
+//	@Param			Idempotency-Key	header		string					false	"unique key used to safely retry the request"
+//	@Success		201				{object}	models.HTTPSuccess		"a message to confirm the placement of the order"
+//	@Failure		400				{object}	models.HTTPError		"error message with any available details in payload"
+//	@Failure		403				{object}	models.HTTPError		"error message with any available details in payload"
+//	@Failure		409				{object}	models.HTTPError		"error message with any available details in payload"
+//	@Failure		422				{object}	models.HTTPError		"error message with any available details in payload"
+//	@Failure		500				{object}	models.HTTPError		"error message with any available details in payload"
+//	@Router			/orders/place [post]
+func PlaceOrder(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			httpMessage string
+			httpStatus  int
+			payload     any
+			request     models.HTTPOrderRequest
+			order       *postgres.Order
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if order, httpStatus, httpMessage, payload, err = common.HTTPIdempotentRequest(cache, logger, clientID,
+			ginCtx.GetHeader(constants.IdempotencyKeyHeader()), &request,
+			func() (*postgres.Order, int, string, any, error) {
+				return common.HTTPOrderPlace(db, logger, clientID, &request)
+			}); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusCreated, models.HTTPSuccess{Message: "order placed", Payload: *order})
+	}
+}
+
+// CancelOrder will handle an HTTP request to cancel an open limit order.
+//
+//	@Summary		Cancel an open limit order.
+//	@Description	Cancel an open limit order. Orders that are being executed, filled, cancelled, or have failed cannot be cancelled.
+//	@Tags			orders limit cancel
+//	@Id				cancelOrder
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
+//	@Param			orderID	path		string				true	"the order ID of the open limit order to cancel"
+//	@Success		200		{object}	models.HTTPSuccess	"a message to confirm the cancellation of the order"
+//	@Failure		400		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/orders/cancel/{orderID} [delete]
+func CancelOrder(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			httpMessage string
+			httpStatus  int
+			order       *postgres.Order
+			orderID     = ginCtx.Param("orderID")
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if order, httpStatus, httpMessage, err = common.HTTPOrderCancel(db, logger, clientID, orderID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: orderID})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "order cancelled", Payload: *order})
+	}
+}
+
+// OrdersPaginated will handle an HTTP request to retrieve the limit orders placed by a single client, newest first.
+//
+// If a user requests N records, N+1 records will be requested. This is used to calculate if any further records are
+// available for retrieval. The page cursor will be the encrypted offset of the next page of records.
+//
+//	@Summary		Retrieve all the limit orders for a specific client.
+//	@Description	Retrieves all the limit orders for a specific client, newest first. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. The user may choose to change the page size in any sequence of calls.
+//	@Tags			orders limit info
+//	@Id				ordersPaginated
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
+//	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
+//	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	models.HTTPSuccess	"a message to with a page of limit orders for the client"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/orders/info [get]
+func OrdersPaginated(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			orderDetails models.HTTPOrdersPaginated
+			httpStatus   int
+			httpMessage  string
+			clientID     uuid.UUID
+			err          error
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		orderDetails, httpStatus, httpMessage, err = common.HTTPOrdersPaginated(auth, db, logger,
+			clientID, ginCtx.Query("pageCursor"), ginCtx.Query("pageSize"), true)
+		if err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "limit orders", Payload: orderDetails})
+	}
+}
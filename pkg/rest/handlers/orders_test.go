@@ -0,0 +1,351 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestHandlers_PlaceOrder(t *testing.T) {
+	t.Parallel()
+
+	validRequest := &models.HTTPOrderRequest{
+		SourceCurrency:      "USD",
+		DestinationCurrency: "BTC",
+		SourceAmount:        decimal.NewFromFloat(1337.89),
+		LimitRate:           decimal.NewFromFloat(0.00003),
+	}
+
+	testCases := []struct {
+		name               string
+		expectedMsg        string
+		path               string
+		expectedStatus     int
+		request            *models.HTTPOrderRequest
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		orderCreateErr     error
+		orderCreateTimes   int
+	}{
+		{
+			name:               "invalid jwt",
+			expectedMsg:        "malformed authentication",
+			path:               "/place-order/invalid-jwt",
+			expectedStatus:     http.StatusForbidden,
+			request:            validRequest,
+			authTokenInfoErr:   errors.New("invalid jwt"),
+			authTokenInfoTimes: 1,
+			orderCreateTimes:   0,
+		}, {
+			name:               "empty request",
+			expectedMsg:        constants.ValidationString(),
+			path:               "/place-order/empty-request",
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPOrderRequest{},
+			authTokenInfoTimes: 1,
+			orderCreateTimes:   0,
+		}, {
+			name:        "crypto to crypto",
+			expectedMsg: constants.InvalidRequestString(),
+			path:        "/place-order/crypto-to-crypto",
+			request: &models.HTTPOrderRequest{
+				SourceCurrency:      "ETH",
+				DestinationCurrency: "BTC",
+				SourceAmount:        decimal.NewFromFloat(1.5),
+				LimitRate:           decimal.NewFromFloat(0.06),
+			},
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoTimes: 1,
+			orderCreateTimes:   0,
+		}, {
+			name:               "db failure",
+			expectedMsg:        "retry",
+			path:               "/place-order/db-failure",
+			expectedStatus:     http.StatusInternalServerError,
+			request:            validRequest,
+			authTokenInfoTimes: 1,
+			orderCreateErr:     postgres.ErrCreateOrder,
+			orderCreateTimes:   1,
+		}, {
+			name:               "valid",
+			expectedMsg:        "order placed",
+			path:               "/place-order/valid",
+			expectedStatus:     http.StatusCreated,
+			request:            validRequest,
+			authTokenInfoTimes: 1,
+			orderCreateTimes:   1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl) // Not called.
+
+			orderReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockPostgres.EXPECT().OrderCreate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(postgres.Order{}, test.orderCreateErr).
+					Times(test.orderCreateTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, PlaceOrder(zapLogger, mockAuth, mockCache, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(orderReqJSON))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_CancelOrder(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/orders/cancel/"
+
+	orderID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate order id.")
+
+	testCases := []struct {
+		name               string
+		orderID            string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		orderCancelErr     error
+		orderCancelTimes   int
+	}{
+		{
+			name:               "invalid jwt",
+			orderID:            orderID.String(),
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid jwt"),
+			authTokenInfoTimes: 1,
+			orderCancelTimes:   0,
+		}, {
+			name:               "invalid order id",
+			orderID:            "invalid-order-id",
+			expectedMsg:        "invalid order ID",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoTimes: 1,
+			orderCancelTimes:   0,
+		}, {
+			name:               "not found",
+			orderID:            orderID.String(),
+			expectedMsg:        "open order not found",
+			expectedStatus:     http.StatusNotFound,
+			authTokenInfoTimes: 1,
+			orderCancelErr:     postgres.ErrNotFound,
+			orderCancelTimes:   1,
+		}, {
+			name:               "valid",
+			orderID:            orderID.String(),
+			expectedMsg:        "order cancelled",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoTimes: 1,
+			orderCancelTimes:   1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().OrderCancel(gomock.Any(), gomock.Any()).
+					Return(postgres.Order{}, test.orderCancelErr).
+					Times(test.orderCancelTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.DELETE(basePath+":orderID", CancelOrder(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodDelete, basePath+test.orderID, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_OrdersPaginated(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/orders/info/"
+
+	orders := []postgres.Order{{}, {}, {}, {}}
+
+	testCases := []struct {
+		name                string
+		path                string
+		querySegment        string
+		expectedMsg         string
+		expectedStatus      int
+		authTokenInfoErr    error
+		authTokenInfoTimes  int
+		authDecryptStrErr   error
+		authDecryptStrTimes int
+		ordersErr           error
+		ordersTimes         int
+		authEncryptStrTimes int
+	}{
+		{
+			name:                "invalid JWT",
+			path:                "invalid-jwt",
+			querySegment:        "?pageCursor=PaGeCuRs0R==&pageSize=3",
+			expectedMsg:         "malformed authentication",
+			expectedStatus:      http.StatusForbidden,
+			authTokenInfoErr:    errors.New("invalid JWT"),
+			authTokenInfoTimes:  1,
+			authDecryptStrTimes: 0,
+			ordersTimes:         0,
+			authEncryptStrTimes: 0,
+		}, {
+			name:                "decrypt cursor failure",
+			path:                "decrypt-cursor-failure",
+			querySegment:        "?pageCursor=PaGeCuRs0R==&pageSize=3",
+			expectedMsg:         "invalid page cursor or page size",
+			expectedStatus:      http.StatusBadRequest,
+			authTokenInfoTimes:  1,
+			authDecryptStrErr:   errors.New("decrypt failure"),
+			authDecryptStrTimes: 1,
+			ordersTimes:         0,
+			authEncryptStrTimes: 0,
+		}, {
+			name:                "orders unknown failure",
+			path:                "orders-unknown-failure",
+			querySegment:        "?pageSize=3",
+			expectedMsg:         "retry",
+			expectedStatus:      http.StatusInternalServerError,
+			authTokenInfoTimes:  1,
+			authDecryptStrTimes: 0,
+			ordersErr:           errors.New("unknown error"),
+			ordersTimes:         1,
+			authEncryptStrTimes: 0,
+		}, {
+			name:                "valid",
+			path:                "valid",
+			querySegment:        "?pageSize=3",
+			expectedMsg:         "limit orders",
+			expectedStatus:      http.StatusOK,
+			authTokenInfoTimes:  1,
+			authDecryptStrTimes: 0,
+			ordersTimes:         1,
+			authEncryptStrTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("3"), test.authDecryptStrErr).
+					Times(test.authDecryptStrTimes),
+
+				mockDB.EXPECT().OrdersPaginated(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(orders, test.ordersErr).
+					Times(test.ordersTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-page-cursor", nil).
+					Times(test.authEncryptStrTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+test.path, OrdersPaginated(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, basePath+test.path+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package rest
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+// RateLimitMiddleware is the middleware that throttles requests to a group of endpoints using token buckets for the
+// client's IP address and, when installed after the AuthMiddleware, the client ID. Requests over the limit are rejected
+// with the number of seconds to wait in the Retry-After header.
+func RateLimitMiddleware(cache redis.Redis, logger *logger.Logger, group string,
+	limit *models.RateLimit) gin.HandlerFunc {
+	handler := func(context *gin.Context) {
+		identities := []string{"ip:" + context.ClientIP()}
+
+		if clientID, ok := context.Get(constants.ClientIDCtxKey()); ok {
+			identities = append(identities, fmt.Sprintf("client:%s", clientID))
+		}
+
+		retryAfter, httpMsg, httpStatus, err := common.HTTPRateLimit(cache, logger, limit, group, 1, identities...)
+		if err != nil {
+			if retryAfter > 0 {
+				context.Header(constants.RetryAfterHeader(), strconv.FormatInt(retryAfter, 10))
+			}
+
+			context.JSON(httpStatus, httpMsg)
+			context.Abort()
+
+			return
+		}
+
+		context.Next()
+	}
+
+	return handler
+}
@@ -0,0 +1,134 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+func TestRateLimitMiddleware(t *testing.T) {
+	t.Parallel()
+
+	limit := &models.RateLimit{Requests: 60, Period: time.Minute, Burst: 10}
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id")
+
+	testCases := []struct {
+		name               string
+		expectedStatus     int
+		expectedRetryAfter string
+		authenticated      bool
+		ipAllowed          bool
+		ipWait             time.Duration
+		ipErr              error
+		clientAllowed      bool
+		clientWait         time.Duration
+		clientTimes        int
+	}{
+		{
+			name:               "ip limited",
+			expectedStatus:     http.StatusTooManyRequests,
+			expectedRetryAfter: "2",
+			authenticated:      true,
+			ipAllowed:          false,
+			ipWait:             1500 * time.Millisecond,
+			ipErr:              nil,
+			clientTimes:        0,
+		}, {
+			name:               "client limited",
+			expectedStatus:     http.StatusTooManyRequests,
+			expectedRetryAfter: "1",
+			authenticated:      true,
+			ipAllowed:          true,
+			ipErr:              nil,
+			clientAllowed:      false,
+			clientWait:         time.Second,
+			clientTimes:        1,
+		}, {
+			name:               "cache failure",
+			expectedStatus:     http.StatusOK,
+			expectedRetryAfter: "",
+			authenticated:      false,
+			ipAllowed:          false,
+			ipErr:              redis.ErrCacheSet,
+			clientTimes:        0,
+		}, {
+			name:               "unauthenticated",
+			expectedStatus:     http.StatusOK,
+			expectedRetryAfter: "",
+			authenticated:      false,
+			ipAllowed:          true,
+			ipErr:              nil,
+			clientTimes:        0,
+		}, {
+			name:               "authenticated",
+			expectedStatus:     http.StatusOK,
+			expectedRetryAfter: "",
+			authenticated:      true,
+			ipAllowed:          true,
+			ipErr:              nil,
+			clientAllowed:      true,
+			clientTimes:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockCache := mocks.NewMockRedis(mockCtrl)
+
+			gomock.InOrder(
+				mockCache.EXPECT().TakeTokens(constants.RateLimitKeyPrefix()+"fiat-ip:192.0.2.1", limit.Burst,
+					int64(1), time.Second).
+					Return(test.ipAllowed, test.ipWait, test.ipErr).
+					Times(1),
+
+				mockCache.EXPECT().TakeTokens(constants.RateLimitKeyPrefix()+"fiat-client:"+clientID.String(),
+					limit.Burst, int64(1), time.Second).
+					Return(test.clientAllowed, test.clientWait, nil).
+					Times(test.clientTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET("/rate-limit",
+				func(ctx *gin.Context) {
+					if test.authenticated {
+						ctx.Set(constants.ClientIDCtxKey(), clientID)
+					}
+				},
+				RateLimitMiddleware(mockCache, zapLogger, "fiat", limit),
+				func(ctx *gin.Context) {
+					ctx.Status(http.StatusOK)
+				})
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, "/rate-limit", nil)
+			req.RemoteAddr = "192.0.2.1:43210"
+
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+			require.Equal(t, test.expectedRetryAfter, w.Header().Get(constants.RetryAfterHeader()),
+				"expected retry after header does not match")
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// RateHistory will handle an HTTP request to retrieve the open, high, low, and close rates for a currency pair over a
+// time range, bucketed into fixed intervals.
+//
+//	@Summary		Retrieve the rate history for a currency pair.
+//	@Description	Retrieves the open, high, low, and close rates, and the number of price quotes, for a currency pair over an RFC3339 time range. The time range is split into intervals of the requested duration, such as 15m or 1h, starting from the beginning of the range. Intervals without any recorded price quotes are omitted. Rates are recorded for the direction in which they were quoted.
+//	@Tags			rates history quotes
+//	@Id				rateHistory
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
+//	@Param			source		query		string				true	"the source currency code or ticker"
+//	@Param			destination	query		string				true	"the destination currency code or ticker"
+//	@Param			from		query		string				true	"the RFC3339 start of the time range, inclusive"
+//	@Param			to			query		string				true	"the RFC3339 end of the time range, exclusive"
+//	@Param			interval	query		string				true	"the duration of each interval, such as 15m or 1h"
+//	@Success		200			{object}	models.HTTPSuccess	"a message with the rate history for the currency pair"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/rates/history [get]
+func RateHistory(logger *logger.Logger, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			history     *models.HTTPRateHistoryResponse
+			httpStatus  int
+			httpMessage string
+			payload     any
+			err         error
+		)
+
+		request := models.HTTPRateHistoryRequest{
+			Source:      ginCtx.Query("source"),
+			Destination: ginCtx.Query("destination"),
+			From:        ginCtx.Query("from"),
+			To:          ginCtx.Query("to"),
+			Interval:    ginCtx.Query("interval"),
+		}
+
+		if history, httpStatus, httpMessage, payload, err = common.HTTPRateHistory(db, logger, &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "rate history", Payload: history})
+	}
+}
@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestHandlers_RateHistory(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/rates/history"
+
+	candles := []postgres.RateCandle{{}, {}}
+
+	testCases := []struct {
+		name           string
+		querySegment   string
+		expectedMsg    string
+		expectedStatus int
+		historyErr     error
+		historyTimes   int
+	}{
+		{
+			name:           "empty request",
+			querySegment:   "",
+			expectedMsg:    constants.ValidationString(),
+			expectedStatus: http.StatusBadRequest,
+			historyTimes:   0,
+		}, {
+			name:           "invalid time range",
+			querySegment:   "?source=USD&destination=CAD&from=2023-06-02T00:00:00Z&to=2023-06-01T00:00:00Z&interval=1h",
+			expectedMsg:    "invalid time range",
+			expectedStatus: http.StatusBadRequest,
+			historyTimes:   0,
+		}, {
+			name:           "invalid interval",
+			querySegment:   "?source=USD&destination=CAD&from=2023-06-01T00:00:00Z&to=2023-06-02T00:00:00Z&interval=1s",
+			expectedMsg:    "invalid interval",
+			expectedStatus: http.StatusBadRequest,
+			historyTimes:   0,
+		}, {
+			name:           "db failure",
+			querySegment:   "?source=USD&destination=CAD&from=2023-06-01T00:00:00Z&to=2023-06-02T00:00:00Z&interval=1h",
+			expectedMsg:    "retry",
+			expectedStatus: http.StatusInternalServerError,
+			historyErr:     postgres.ErrNotFound,
+			historyTimes:   1,
+		}, {
+			name:           "valid",
+			querySegment:   "?source=BTC&destination=USD&from=2023-06-01T00:00:00Z&to=2023-06-02T00:00:00Z&interval=15m",
+			expectedMsg:    "rate history",
+			expectedStatus: http.StatusOK,
+			historyTimes:   1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			mockDB.EXPECT().RateHistory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(candles, test.historyErr).
+				Times(test.historyTimes)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath, RateHistory(zapLogger, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, basePath+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
+)
+
+// CreateSchedule will handle an HTTP request to create a recurring Cryptocurrency purchase schedule.
+//
+//	@Summary		Create a recurring Cryptocurrency purchase schedule.
+//	@Description	Create a schedule to purchase a Fiat amount of a Cryptocurrency daily, weekly, or monthly. The first purchase will be made at the optional RFC3339 start time, or immediately if it is omitted or in the past. Account balances are verified each time the schedule runs and the outcome of every run is recorded in the schedule's run history.
+//	@Tags			schedules recurring crypto cryptocurrency purchase create
+//	@Id				createSchedule
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
+//	@Param			request			body		models.HTTPScheduleRequest	true	"the Fiat currency, Cryptocurrency ticker, Fiat amount, frequency, and optional start time"
+//	@Param			Idempotency-Key	header		string						false	"unique key used to safely retry the request"
+//	@Success		201				{object}	models.HTTPSuccess			"a message to confirm the creation of the schedule"
+//	@Failure		400				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		403				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		409				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		422				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		500				{object}	models.HTTPError			"error message with any available details in payload"
+//	@Router			/schedules/create [post]
+func CreateSchedule(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			httpMessage string
+			httpStatus  int
+			payload     any
+			request     models.HTTPScheduleRequest
+			schedule    *postgres.Schedule
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if schedule, httpStatus, httpMessage, payload, err = common.HTTPIdempotentRequest(cache, logger, clientID,
+			ginCtx.GetHeader(constants.IdempotencyKeyHeader()), &request,
+			func() (*postgres.Schedule, int, string, any, error) {
+				return common.HTTPScheduleCreate(db, logger, clientID, &request)
+			}); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusCreated, models.HTTPSuccess{Message: "schedule created", Payload: *schedule})
+	}
+}
+
+// UpdateSchedule will handle an HTTP request to update a recurring Cryptocurrency purchase schedule.
+//
+//	@Summary		Update a recurring Cryptocurrency purchase schedule.
+//	@Description	Update the Fiat amount and frequency of a recurring Cryptocurrency purchase schedule, and pause or resume it. Changes take effect from the next scheduled run.
+//	@Tags			schedules recurring crypto cryptocurrency purchase update
+//	@Id				updateSchedule
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
+//	@Param			scheduleID	path		string								true	"the schedule ID of the recurring purchase to update"
+//	@Param			request		body		models.HTTPScheduleUpdateRequest	true	"the Fiat amount, frequency, and active state"
+//	@Success		200			{object}	models.HTTPSuccess					"a message to confirm the update of the schedule"
+//	@Failure		400			{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError					"error message with any available details in payload"
+//	@Router			/schedules/update/{scheduleID} [put]
+func UpdateSchedule(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			httpMessage string
+			httpStatus  int
+			payload     any
+			request     models.HTTPScheduleUpdateRequest
+			schedule    *postgres.Schedule
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if schedule, httpStatus, httpMessage, payload, err = common.HTTPScheduleUpdate(db, logger, clientID,
+			ginCtx.Param("scheduleID"), &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "schedule updated", Payload: *schedule})
+	}
+}
+
+// DeleteSchedule will handle an HTTP request to delete a recurring Cryptocurrency purchase schedule.
+//
+//	@Summary		Delete a recurring Cryptocurrency purchase schedule.
+//	@Description	Delete a recurring Cryptocurrency purchase schedule along with its run history. Purchases that have already been made are not affected.
+//	@Tags			schedules recurring delete
+//	@Id				deleteSchedule
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
+//	@Param			scheduleID	path		string				true	"the schedule ID of the recurring purchase to delete"
+//	@Success		200			{object}	models.HTTPSuccess	"a message to confirm the deletion of the schedule"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/schedules/delete/{scheduleID} [delete]
+func DeleteSchedule(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			clientID    uuid.UUID
+			err         error
+			httpMessage string
+			httpStatus  int
+			scheduleID  = ginCtx.Param("scheduleID")
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if httpStatus, httpMessage, err = common.HTTPScheduleDelete(db, logger, clientID, scheduleID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage, Payload: scheduleID})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "schedule deleted", Payload: scheduleID})
+	}
+}
+
+// SchedulesPaginated will handle an HTTP request to retrieve the recurring purchase schedules of a single client,
+// newest first.
+//
+// If a user requests N records, N+1 records will be requested. This is used to calculate if any further records are
+// available for retrieval. The page cursor will be the encrypted offset of the next page of records.
+//
+//	@Summary		Retrieve all the recurring purchase schedules for a specific client.
+//	@Description	Retrieves all the recurring Cryptocurrency purchase schedules for a specific client, newest first. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. The user may choose to change the page size in any sequence of calls.
+//	@Tags			schedules recurring info
+//	@Id				schedulesPaginated
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
+//	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
+//	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	models.HTTPSuccess	"a message to with a page of recurring purchase schedules for the client"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/schedules/info [get]
+func SchedulesPaginated(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			schedules   models.HTTPSchedulesPaginated
+			httpStatus  int
+			httpMessage string
+			clientID    uuid.UUID
+			err         error
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		schedules, httpStatus, httpMessage, err = common.HTTPSchedulesPaginated(auth, db, logger,
+			clientID, ginCtx.Query("pageCursor"), ginCtx.Query("pageSize"), true)
+		if err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "recurring purchase schedules", Payload: schedules})
+	}
+}
+
+// ScheduleRunsPaginated will handle an HTTP request to retrieve the run history of a recurring purchase schedule,
+// newest first.
+//
+// If a user requests N records, N+1 records will be requested. This is used to calculate if any further records are
+// available for retrieval. The page cursor will be the encrypted offset of the next page of records.
+//
+//	@Summary		Retrieve the run history for a recurring purchase schedule.
+//	@Description	Retrieves the results and failures of every run of a recurring Cryptocurrency purchase schedule, newest first. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. The user may choose to change the page size in any sequence of calls.
+//	@Tags			schedules recurring runs history info
+//	@Id				scheduleRunsPaginated
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Security		ClientAPIKey
+//	@Param			scheduleID	path		string				true	"the schedule ID of the recurring purchase"
+//	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
+//	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	models.HTTPSuccess	"a message to with a page of runs for the recurring purchase schedule"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/schedules/runs/{scheduleID} [get]
+func ScheduleRunsPaginated(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			runs        models.HTTPScheduleRunsPaginated
+			httpStatus  int
+			httpMessage string
+			clientID    uuid.UUID
+			err         error
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		runs, httpStatus, httpMessage, err = common.HTTPScheduleRunsPaginated(auth, db, logger, clientID,
+			ginCtx.Param("scheduleID"), ginCtx.Query("pageCursor"), ginCtx.Query("pageSize"), true)
+		if err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "recurring purchase schedule runs", Payload: runs})
+	}
+}
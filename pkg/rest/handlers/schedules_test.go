@@ -0,0 +1,582 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestHandlers_CreateSchedule(t *testing.T) {
+	t.Parallel()
+
+	validRequest := &models.HTTPScheduleRequest{
+		FiatCurrency: "USD",
+		Ticker:       "BTC",
+		Amount:       decimal.NewFromFloat(50),
+		Frequency:    "weekly",
+	}
+
+	testCases := []struct {
+		name                string
+		expectedMsg         string
+		path                string
+		expectedStatus      int
+		request             *models.HTTPScheduleRequest
+		authTokenInfoErr    error
+		authTokenInfoTimes  int
+		scheduleCreateErr   error
+		scheduleCreateTimes int
+	}{
+		{
+			name:                "invalid jwt",
+			expectedMsg:         "malformed authentication",
+			path:                "/create-schedule/invalid-jwt",
+			expectedStatus:      http.StatusForbidden,
+			request:             validRequest,
+			authTokenInfoErr:    errors.New("invalid jwt"),
+			authTokenInfoTimes:  1,
+			scheduleCreateTimes: 0,
+		}, {
+			name:                "empty request",
+			expectedMsg:         constants.ValidationString(),
+			path:                "/create-schedule/empty-request",
+			expectedStatus:      http.StatusBadRequest,
+			request:             &models.HTTPScheduleRequest{},
+			authTokenInfoTimes:  1,
+			scheduleCreateTimes: 0,
+		}, {
+			name:        "invalid frequency",
+			expectedMsg: "invalid frequency",
+			path:        "/create-schedule/invalid-frequency",
+			request: &models.HTTPScheduleRequest{
+				FiatCurrency: "USD",
+				Ticker:       "BTC",
+				Amount:       decimal.NewFromFloat(50),
+				Frequency:    "hourly",
+			},
+			expectedStatus:      http.StatusBadRequest,
+			authTokenInfoTimes:  1,
+			scheduleCreateTimes: 0,
+		}, {
+			name:                "db failure",
+			expectedMsg:         "retry",
+			path:                "/create-schedule/db-failure",
+			expectedStatus:      http.StatusInternalServerError,
+			request:             validRequest,
+			authTokenInfoTimes:  1,
+			scheduleCreateErr:   postgres.ErrCreateSchedule,
+			scheduleCreateTimes: 1,
+		}, {
+			name:                "valid",
+			expectedMsg:         "schedule created",
+			path:                "/create-schedule/valid",
+			expectedStatus:      http.StatusCreated,
+			request:             validRequest,
+			authTokenInfoTimes:  1,
+			scheduleCreateTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockCache := mocks.NewMockRedis(mockCtrl) // Not called.
+
+			scheduleReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockPostgres.EXPECT().ScheduleCreate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any()).
+					Return(postgres.Schedule{}, test.scheduleCreateErr).
+					Times(test.scheduleCreateTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, CreateSchedule(zapLogger, mockAuth, mockCache, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path,
+				bytes.NewBuffer(scheduleReqJSON))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_UpdateSchedule(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/schedules/update/"
+
+	scheduleID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate schedule id.")
+
+	isActive := true
+	validRequest := &models.HTTPScheduleUpdateRequest{
+		Amount:    decimal.NewFromFloat(75),
+		Frequency: "monthly",
+		IsActive:  &isActive,
+	}
+
+	testCases := []struct {
+		name                string
+		scheduleID          string
+		expectedMsg         string
+		expectedStatus      int
+		request             *models.HTTPScheduleUpdateRequest
+		authTokenInfoErr    error
+		authTokenInfoTimes  int
+		scheduleUpdateErr   error
+		scheduleUpdateTimes int
+	}{
+		{
+			name:                "invalid jwt",
+			scheduleID:          scheduleID.String(),
+			expectedMsg:         "malformed authentication",
+			expectedStatus:      http.StatusForbidden,
+			request:             validRequest,
+			authTokenInfoErr:    errors.New("invalid jwt"),
+			authTokenInfoTimes:  1,
+			scheduleUpdateTimes: 0,
+		}, {
+			name:                "invalid schedule id",
+			scheduleID:          "invalid-schedule-id",
+			expectedMsg:         "invalid schedule ID",
+			expectedStatus:      http.StatusBadRequest,
+			request:             validRequest,
+			authTokenInfoTimes:  1,
+			scheduleUpdateTimes: 0,
+		}, {
+			name:                "empty request",
+			scheduleID:          scheduleID.String(),
+			expectedMsg:         constants.ValidationString(),
+			expectedStatus:      http.StatusBadRequest,
+			request:             &models.HTTPScheduleUpdateRequest{},
+			authTokenInfoTimes:  1,
+			scheduleUpdateTimes: 0,
+		}, {
+			name:                "not found",
+			scheduleID:          scheduleID.String(),
+			expectedMsg:         "schedule not found",
+			expectedStatus:      http.StatusNotFound,
+			request:             validRequest,
+			authTokenInfoTimes:  1,
+			scheduleUpdateErr:   postgres.ErrNotFound,
+			scheduleUpdateTimes: 1,
+		}, {
+			name:                "valid",
+			scheduleID:          scheduleID.String(),
+			expectedMsg:         "schedule updated",
+			expectedStatus:      http.StatusOK,
+			request:             validRequest,
+			authTokenInfoTimes:  1,
+			scheduleUpdateTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			scheduleReqJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().ScheduleUpdate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(postgres.Schedule{}, test.scheduleUpdateErr).
+					Times(test.scheduleUpdateTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.PUT(basePath+":scheduleID", UpdateSchedule(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPut, basePath+test.scheduleID,
+				bytes.NewBuffer(scheduleReqJSON))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_DeleteSchedule(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/schedules/delete/"
+
+	scheduleID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate schedule id.")
+
+	testCases := []struct {
+		name                string
+		scheduleID          string
+		expectedMsg         string
+		expectedStatus      int
+		authTokenInfoErr    error
+		authTokenInfoTimes  int
+		scheduleDeleteErr   error
+		scheduleDeleteTimes int
+	}{
+		{
+			name:                "invalid jwt",
+			scheduleID:          scheduleID.String(),
+			expectedMsg:         "malformed authentication",
+			expectedStatus:      http.StatusForbidden,
+			authTokenInfoErr:    errors.New("invalid jwt"),
+			authTokenInfoTimes:  1,
+			scheduleDeleteTimes: 0,
+		}, {
+			name:                "invalid schedule id",
+			scheduleID:          "invalid-schedule-id",
+			expectedMsg:         "invalid schedule ID",
+			expectedStatus:      http.StatusBadRequest,
+			authTokenInfoTimes:  1,
+			scheduleDeleteTimes: 0,
+		}, {
+			name:                "not found",
+			scheduleID:          scheduleID.String(),
+			expectedMsg:         "schedule not found",
+			expectedStatus:      http.StatusNotFound,
+			authTokenInfoTimes:  1,
+			scheduleDeleteErr:   postgres.ErrNotFound,
+			scheduleDeleteTimes: 1,
+		}, {
+			name:                "valid",
+			scheduleID:          scheduleID.String(),
+			expectedMsg:         "schedule deleted",
+			expectedStatus:      http.StatusOK,
+			authTokenInfoTimes:  1,
+			scheduleDeleteTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().ScheduleDelete(gomock.Any(), gomock.Any()).
+					Return(test.scheduleDeleteErr).
+					Times(test.scheduleDeleteTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.DELETE(basePath+":scheduleID", DeleteSchedule(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodDelete, basePath+test.scheduleID, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_SchedulesPaginated(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/schedules/info/"
+
+	schedules := []postgres.Schedule{{}, {}, {}, {}}
+
+	testCases := []struct {
+		name                string
+		path                string
+		querySegment        string
+		expectedMsg         string
+		expectedStatus      int
+		authTokenInfoErr    error
+		authTokenInfoTimes  int
+		authDecryptStrErr   error
+		authDecryptStrTimes int
+		schedulesErr        error
+		schedulesTimes      int
+		authEncryptStrTimes int
+	}{
+		{
+			name:                "invalid JWT",
+			path:                "invalid-jwt",
+			querySegment:        "?pageCursor=PaGeCuRs0R==&pageSize=3",
+			expectedMsg:         "malformed authentication",
+			expectedStatus:      http.StatusForbidden,
+			authTokenInfoErr:    errors.New("invalid JWT"),
+			authTokenInfoTimes:  1,
+			authDecryptStrTimes: 0,
+			schedulesTimes:      0,
+			authEncryptStrTimes: 0,
+		}, {
+			name:                "decrypt cursor failure",
+			path:                "decrypt-cursor-failure",
+			querySegment:        "?pageCursor=PaGeCuRs0R==&pageSize=3",
+			expectedMsg:         "invalid page cursor or page size",
+			expectedStatus:      http.StatusBadRequest,
+			authTokenInfoTimes:  1,
+			authDecryptStrErr:   errors.New("decrypt failure"),
+			authDecryptStrTimes: 1,
+			schedulesTimes:      0,
+			authEncryptStrTimes: 0,
+		}, {
+			name:                "schedules unknown failure",
+			path:                "schedules-unknown-failure",
+			querySegment:        "?pageSize=3",
+			expectedMsg:         "retry",
+			expectedStatus:      http.StatusInternalServerError,
+			authTokenInfoTimes:  1,
+			authDecryptStrTimes: 0,
+			schedulesErr:        errors.New("unknown error"),
+			schedulesTimes:      1,
+			authEncryptStrTimes: 0,
+		}, {
+			name:                "valid",
+			path:                "valid",
+			querySegment:        "?pageSize=3",
+			expectedMsg:         "recurring purchase schedules",
+			expectedStatus:      http.StatusOK,
+			authTokenInfoTimes:  1,
+			authDecryptStrTimes: 0,
+			schedulesTimes:      1,
+			authEncryptStrTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("3"), test.authDecryptStrErr).
+					Times(test.authDecryptStrTimes),
+
+				mockDB.EXPECT().SchedulesPaginated(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(schedules, test.schedulesErr).
+					Times(test.schedulesTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-page-cursor", nil).
+					Times(test.authEncryptStrTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+test.path, SchedulesPaginated(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, basePath+test.path+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_ScheduleRunsPaginated(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/schedules/runs/"
+
+	scheduleID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate schedule id.")
+
+	runs := []postgres.ScheduleRun{{}, {}, {}, {}}
+
+	testCases := []struct {
+		name                string
+		scheduleID          string
+		querySegment        string
+		expectedMsg         string
+		expectedStatus      int
+		authTokenInfoErr    error
+		authTokenInfoTimes  int
+		runsErr             error
+		runsTimes           int
+		authEncryptStrTimes int
+	}{
+		{
+			name:                "invalid JWT",
+			scheduleID:          scheduleID.String(),
+			querySegment:        "?pageSize=3",
+			expectedMsg:         "malformed authentication",
+			expectedStatus:      http.StatusForbidden,
+			authTokenInfoErr:    errors.New("invalid JWT"),
+			authTokenInfoTimes:  1,
+			runsTimes:           0,
+			authEncryptStrTimes: 0,
+		}, {
+			name:                "invalid schedule id",
+			scheduleID:          "invalid-schedule-id",
+			querySegment:        "?pageSize=3",
+			expectedMsg:         "invalid schedule ID",
+			expectedStatus:      http.StatusBadRequest,
+			authTokenInfoTimes:  1,
+			runsTimes:           0,
+			authEncryptStrTimes: 0,
+		}, {
+			name:                "runs not found",
+			scheduleID:          scheduleID.String(),
+			querySegment:        "?pageSize=3",
+			expectedMsg:         "not found",
+			expectedStatus:      http.StatusNotFound,
+			authTokenInfoTimes:  1,
+			runsErr:             postgres.ErrNotFound,
+			runsTimes:           1,
+			authEncryptStrTimes: 0,
+		}, {
+			name:                "valid",
+			scheduleID:          scheduleID.String(),
+			querySegment:        "?pageSize=3",
+			expectedMsg:         "recurring purchase schedule runs",
+			expectedStatus:      http.StatusOK,
+			authTokenInfoTimes:  1,
+			runsTimes:           1,
+			authEncryptStrTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().ScheduleRunsPaginated(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(runs, test.runsErr).
+					Times(test.runsTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-page-cursor", nil).
+					Times(test.authEncryptStrTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+":scheduleID", ScheduleRunsPaginated(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet,
+				basePath+test.scheduleID+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
@@ -10,7 +10,9 @@ import (
 	"github.com/surahman/FTeX/pkg/logger"
 	"github.com/surahman/FTeX/pkg/models"
 	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
+	"github.com/surahman/FTeX/pkg/notifier"
 	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
 )
 
 // RegisterUser will handle an HTTP request to create a user.
@@ -54,25 +56,29 @@ func RegisterUser(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) g
 	}
 }
 
-// LoginUser validates login credentials and generates a JWT.
+// LoginUser validates login credentials and generates a JWT. Users enrolled in multifactor authentication are issued a
+// challenge that must be completed to receive a JWT. Repeated failed login attempts will temporarily lock the account.
 //
 //	@Summary		Login a user.
-//	@Description	Logs in a user by validating credentials and returning a JWT.
+//	@Description	Logs in a user by validating credentials and returning a JWT. Users with multifactor authentication enabled will receive a challenge that must be completed at the multifactor authentication login endpoint. Repeated failed login attempts will temporarily lock the account with an increasing lockout duration.
 //	@Tags			user users login security
 //	@Id				loginUser
 //	@Accept			json
 //	@Produce		json
-//	@Param			credentials	body		models.UserLoginCredentials	true	"Username and password to login with"
-//	@Success		200			{object}	models.JWTAuthResponse		"a valid JWT token for the new account"
-//	@Failure		400			{object}	models.HTTPError			"error message with any available details in payload"
-//	@Failure		409			{object}	models.HTTPError			"error message with any available details in payload"
-//	@Failure		500			{object}	models.HTTPError			"error message with any available details in payload"
+//	@Param			credentials	body		models.UserLoginCredentials		true	"Username and password to login with"
+//	@Success		200			{object}	models.JWTAuthResponse			"a valid JWT token for the new account"
+//	@Success		202			{object}	models.HTTPMFAChallengeResponse	"a multifactor authentication challenge to be completed"
+//	@Failure		400			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		429			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError				"error message with any available details in payload"
 //	@Router			/user/login [post]
-func LoginUser(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+func LoginUser(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db postgres.Postgres) gin.HandlerFunc {
 	return func(ginCtx *gin.Context) {
 		var (
 			err          error
 			authToken    *models.JWTAuthResponse
+			challenge    *models.HTTPMFAChallengeResponse
 			loginRequest modelsPostgres.UserLoginCredentials
 			httpMsg      string
 			httpStatus   int
@@ -85,12 +91,20 @@ func LoginUser(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.
 			return
 		}
 
-		if authToken, httpMsg, httpStatus, payload, err = common.HTTPLoginUser(auth, db, logger, &loginRequest); err != nil {
+		if authToken, challenge, httpMsg, httpStatus, payload, err =
+			common.HTTPLoginUser(auth, cache, db, logger, &loginRequest, ginCtx.ClientIP(),
+				ginCtx.Request.UserAgent()); err != nil {
 			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: payload})
 
 			return
 		}
 
+		if challenge != nil {
+			ginCtx.JSON(http.StatusAccepted, challenge)
+
+			return
+		}
+
 		ginCtx.JSON(http.StatusOK, authToken)
 	}
 }
@@ -135,6 +149,129 @@ func LoginRefresh(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) g
 	}
 }
 
+// Logout will revoke the JWT used to make the request.
+//
+//	@Summary		Logout of the current session.
+//	@Description	Revokes the JSON Web Token supplied in the request header. The token will be rejected for the remainder of its validity interval.
+//	@Tags			user users logout security
+//	@Id				logout
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	models.HTTPSuccess	"a message to confirm the session has been logged out"
+//	@Failure		403	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/logout [post]
+func Logout(logger *logger.Logger, auth auth.Auth, cache redis.Redis, authHeaderKey string) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			expiresAt  int64
+			httpMsg    string
+			httpStatus int
+		)
+
+		if _, expiresAt, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if httpMsg, httpStatus, err =
+			common.HTTPLogout(auth, cache, logger, ginCtx.GetHeader(authHeaderKey), expiresAt); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "logged out"})
+	}
+}
+
+// LogoutEverywhere will revoke all the JWTs issued to a user.
+//
+//	@Summary		Logout of all sessions.
+//	@Description	Revokes all JSON Web Tokens issued to the user up to and including the time of the request, including the token supplied in the request header.
+//	@Tags			user users logout security
+//	@Id				logoutEverywhere
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	models.HTTPSuccess	"a message to confirm all sessions have been logged out"
+//	@Failure		403	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/logout/all [post]
+func LogoutEverywhere(logger *logger.Logger, auth auth.Auth, cache redis.Redis) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			clientID   uuid.UUID
+			httpMsg    string
+			httpStatus int
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if httpMsg, httpStatus, err = common.HTTPLogoutEverywhere(auth, cache, logger, clientID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "logged out of all sessions"})
+	}
+}
+
+// LoginEvents will handle an HTTP request to retrieve the login attempts against a client's account, newest first.
+//
+// If a user requests N records, N+1 records will be requested. This is used to calculate if any further records are
+// available for retrieval. The page cursor will be the encrypted offset of the next page of records.
+//
+//	@Summary		Retrieve the login attempts against a user's account.
+//	@Description	Retrieves the successful, failed, and locked out login attempts against a user's account with the originating IP address and user agent, newest first. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. The user may choose to change the page size in any sequence of calls.
+//	@Tags			user users login security
+//	@Id				loginEvents
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
+//	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	models.HTTPSuccess	"a message to with a page of login events for the client"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/security/events [get]
+func LoginEvents(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			events      models.HTTPLoginEventsPaginated
+			httpStatus  int
+			httpMessage string
+			clientID    uuid.UUID
+			err         error
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		events, httpStatus, httpMessage, err = common.HTTPLoginEventsPaginated(auth, db, logger,
+			clientID, ginCtx.Query("pageCursor"), ginCtx.Query("pageSize"), true)
+		if err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "login events", Payload: events})
+	}
+}
+
 // DeleteUser will mark a user as deleted in the database.
 //
 //	@Summary		Deletes a user. The user must supply their credentials as well as a confirmation message.
@@ -145,11 +282,12 @@ func LoginRefresh(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) g
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
-//	@Param			request	body		models.HTTPDeleteUserRequest	true	"The request payload for deleting an account"
-//	@Success		204		{object}	models.HTTPSuccess				"message with a confirmation of a deleted user account"
-//	@Failure		400		{object}	models.HTTPError				"error message with any available details in payload"
-//	@Failure		403		{object}	models.HTTPError				"error message with any available details in payload"
-//	@Failure		500		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Param			request		body		models.HTTPDeleteUserRequest	true	"The request payload for deleting an account"
+//	@Param			X-MFA-Code	header		string							false	"one-time password when step-up multifactor authentication is required"
+//	@Success		204			{object}	models.HTTPSuccess				"message with a confirmation of a deleted user account"
+//	@Failure		400			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError				"error message with any available details in payload"
 //	@Router			/user/delete [delete]
 func DeleteUser(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
 	return func(ginCtx *gin.Context) {
@@ -184,3 +322,137 @@ func DeleteUser(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin
 		ginCtx.JSON(http.StatusNoContent, models.HTTPSuccess{Message: "account successfully deleted"})
 	}
 }
+
+// ChangePassword will replace the password of an authenticated user.
+//
+//	@Summary		Change a user's password.
+//	@Description	Replaces the password of the user once their current password has been verified. All the user's sessions, including the one used to make the request, are logged out and the user must log in again.
+//	@Tags			user users password security
+//	@Id				changePassword
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		models.HTTPChangePasswordRequest	true	"the current and new passwords"
+//	@Success		200		{object}	models.HTTPSuccess					"a message to confirm the password has been changed"
+//	@Failure		400		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError					"error message with any available details in payload"
+//	@Router			/user/password/change [post]
+func ChangePassword(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			clientID   uuid.UUID
+			request    models.HTTPChangePasswordRequest
+			httpMsg    string
+			httpStatus int
+			payload    any
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, &models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if httpMsg, httpStatus, payload, err =
+			common.HTTPChangePassword(auth, cache, db, logger, clientID, &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "password changed, please log in again"})
+	}
+}
+
+// RequestPasswordReset will issue a password reset token to the owner of an account.
+//
+//	@Summary		Request a password reset.
+//	@Description	Issues a single-use, time-limited password reset token and delivers it to the owner of the account. The same response is returned whether the account exists so as not to disclose registered usernames.
+//	@Tags			user users password reset security
+//	@Id				requestPasswordReset
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		models.HTTPPasswordResetRequest	true	"the username of the account"
+//	@Success		202		{object}	models.HTTPSuccess				"a message to confirm the request with the token expiration in the payload"
+//	@Failure		400		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Router			/user/password/reset/request [post]
+func RequestPasswordReset(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db postgres.Postgres,
+	notify notifier.Notifier) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			request    models.HTTPPasswordResetRequest
+			response   *models.HTTPPasswordResetResponse
+			httpMsg    string
+			httpStatus int
+			payload    any
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, &models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if response, httpMsg, httpStatus, payload, err =
+			common.HTTPRequestPasswordReset(auth, cache, db, notify, logger, &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusAccepted, models.HTTPSuccess{
+			Message: "a password reset token will be delivered to the account owner if the account exists",
+			Payload: response,
+		})
+	}
+}
+
+// ResetPassword will replace a user's password using a password reset token.
+//
+//	@Summary		Reset a user's password.
+//	@Description	Replaces the password of the account a password reset token was issued for. A token can only be used once. All the user's sessions are logged out.
+//	@Tags			user users password reset security
+//	@Id				resetPassword
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		models.HTTPResetPasswordRequest	true	"the password reset token and new password"
+//	@Success		200		{object}	models.HTTPSuccess				"a message to confirm the password has been reset"
+//	@Failure		400		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError				"error message with any available details in payload"
+//	@Router			/user/password/reset [post]
+func ResetPassword(logger *logger.Logger, auth auth.Auth, cache redis.Redis, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			request    models.HTTPResetPasswordRequest
+			httpMsg    string
+			httpStatus int
+			payload    any
+		)
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, &models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if httpMsg, httpStatus, payload, err = common.HTTPResetPassword(auth, cache, db, logger, &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "password reset, please log in"})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/surahman/FTeX/pkg/models"
 	modelsPostgres "github.com/surahman/FTeX/pkg/models/postgres"
 	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/redis"
 )
 
 func TestHandlers_UserRegister(t *testing.T) {
@@ -158,10 +159,15 @@ func TestHandlers_UserLogin(t *testing.T) {
 		path               string
 		expectedStatus     int
 		user               *modelsPostgres.UserLoginCredentials
+		isLocked           bool
 		userCredsErr       error
 		userCredsTimes     int
 		authCheckPassErr   error
 		authCheckPassTimes int
+		mfaGetEnrollment   postgres.MfaEnrollment
+		mfaGetErr          error
+		mfaGetTimes        int
+		redisSetTimes      int
 		authGenJWTErr      error
 		authGenJWTTimes    int
 	}{
@@ -174,6 +180,10 @@ func TestHandlers_UserLogin(t *testing.T) {
 			userCredsTimes:     0,
 			authCheckPassErr:   nil,
 			authCheckPassTimes: 0,
+			mfaGetEnrollment:   postgres.MfaEnrollment{},
+			mfaGetErr:          postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:        0,
+			redisSetTimes:      0,
 			authGenJWTErr:      nil,
 			authGenJWTTimes:    0,
 		}, {
@@ -185,6 +195,10 @@ func TestHandlers_UserLogin(t *testing.T) {
 			userCredsTimes:     1,
 			authCheckPassErr:   nil,
 			authCheckPassTimes: 1,
+			mfaGetEnrollment:   postgres.MfaEnrollment{},
+			mfaGetErr:          postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:        1,
+			redisSetTimes:      0,
 			authGenJWTErr:      nil,
 			authGenJWTTimes:    1,
 		}, {
@@ -196,6 +210,10 @@ func TestHandlers_UserLogin(t *testing.T) {
 			userCredsTimes:     1,
 			authCheckPassErr:   nil,
 			authCheckPassTimes: 0,
+			mfaGetEnrollment:   postgres.MfaEnrollment{},
+			mfaGetErr:          postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:        0,
+			redisSetTimes:      0,
 			authGenJWTErr:      nil,
 			authGenJWTTimes:    0,
 		}, {
@@ -207,6 +225,10 @@ func TestHandlers_UserLogin(t *testing.T) {
 			userCredsTimes:     1,
 			authCheckPassErr:   errors.New("password hash failure"),
 			authCheckPassTimes: 1,
+			mfaGetEnrollment:   postgres.MfaEnrollment{},
+			mfaGetErr:          postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:        0,
+			redisSetTimes:      0,
 			authGenJWTErr:      nil,
 			authGenJWTTimes:    0,
 		}, {
@@ -218,8 +240,56 @@ func TestHandlers_UserLogin(t *testing.T) {
 			authCheckPassTimes: 1,
 			userCredsErr:       nil,
 			userCredsTimes:     1,
+			mfaGetEnrollment:   postgres.MfaEnrollment{},
+			mfaGetErr:          postgres.ErrNotEnrolledMFA,
+			mfaGetTimes:        1,
+			redisSetTimes:      0,
 			authGenJWTErr:      errors.New("auth token failure"),
 			authGenJWTTimes:    1,
+		}, {
+			name:               "mfa challenge",
+			path:               "/user-login/mfa-challenge",
+			expectedStatus:     http.StatusAccepted,
+			user:               &testUserData["username1"].UserLoginCredentials,
+			userCredsErr:       nil,
+			userCredsTimes:     1,
+			authCheckPassErr:   nil,
+			authCheckPassTimes: 1,
+			mfaGetEnrollment:   postgres.MfaEnrollment{IsEnabled: true},
+			mfaGetErr:          nil,
+			mfaGetTimes:        1,
+			redisSetTimes:      1,
+			authGenJWTErr:      nil,
+			authGenJWTTimes:    0,
+		}, {
+			name:               "mfa enrollment failure",
+			path:               "/user-login/mfa-enrollment-failure",
+			expectedStatus:     http.StatusInternalServerError,
+			user:               &testUserData["username1"].UserLoginCredentials,
+			userCredsErr:       nil,
+			userCredsTimes:     1,
+			authCheckPassErr:   nil,
+			authCheckPassTimes: 1,
+			mfaGetEnrollment:   postgres.MfaEnrollment{},
+			mfaGetErr:          postgres.ErrTransactMFA,
+			mfaGetTimes:        1,
+			redisSetTimes:      0,
+			authGenJWTErr:      nil,
+			authGenJWTTimes:    0,
+		}, {
+			name:               "account locked",
+			path:               "/user-login/account-locked",
+			expectedStatus:     http.StatusTooManyRequests,
+			user:               &testUserData["username1"].UserLoginCredentials,
+			isLocked:           true,
+			userCredsErr:       nil,
+			userCredsTimes:     1,
+			authCheckPassErr:   nil,
+			authCheckPassTimes: 0,
+			mfaGetTimes:        0,
+			redisSetTimes:      0,
+			authGenJWTErr:      nil,
+			authGenJWTTimes:    0,
 		},
 	}
 
@@ -234,11 +304,30 @@ func TestHandlers_UserLogin(t *testing.T) {
 			defer mockCtrl.Finish()
 			mockAuth := mocks.NewMockAuth(mockCtrl)
 			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
 
 			user := test.user
 			userJSON, err := json.Marshal(&user)
 			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
 
+			// Failed login attempt counting is covered by the common login security tests.
+			mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ string, value any) error {
+					if !test.isLocked {
+						return redis.ErrCacheMiss
+					}
+
+					*value.(*int64) = time.Now().Add(time.Minute).Unix() //nolint:forcetypeassert
+
+					return nil
+				}).AnyTimes()
+			mockRedis.EXPECT().Incr(gomock.Any(), gomock.Any()).Return(int64(1), nil).AnyTimes()
+			mockRedis.EXPECT().Del(gomock.Any()).Return(nil).AnyTimes()
+			mockAuth.EXPECT().LoginFailureWindow().Return(time.Hour).AnyTimes()
+			mockAuth.EXPECT().LoginLockout(gomock.Any()).Return(time.Duration(0)).AnyTimes()
+			mockPostgres.EXPECT().LoginEventCreate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil).AnyTimes()
+
 			gomock.InOrder(
 				mockPostgres.EXPECT().UserCredentials(gomock.Any()).
 					Return(uuid.UUID{}, "hashed password", test.userCredsErr).
@@ -248,6 +337,22 @@ func TestHandlers_UserLogin(t *testing.T) {
 					Return(test.authCheckPassErr).
 					Times(test.authCheckPassTimes),
 
+				mockPostgres.EXPECT().MFAGet(gomock.Any()).
+					Return(test.mfaGetEnrollment, test.mfaGetErr).
+					Times(test.mfaGetTimes),
+
+				mockAuth.EXPECT().MFAChallengeExpiration().
+					Return(int64(300)).
+					Times(test.redisSetTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-challenge-id", nil).
+					Times(test.redisSetTimes),
+
+				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(test.redisSetTimes),
+
 				mockAuth.EXPECT().GenerateJWT(gomock.Any()).
 					Return(&models.JWTAuthResponse{}, test.authGenJWTErr).
 					Times(test.authGenJWTTimes),
@@ -255,7 +360,7 @@ func TestHandlers_UserLogin(t *testing.T) {
 
 			// Endpoint setup for test.
 			router := gin.Default()
-			router.POST(test.path, LoginUser(zapLogger, mockAuth, mockPostgres))
+			router.POST(test.path, LoginUser(zapLogger, mockAuth, mockRedis, mockPostgres))
 			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(userJSON))
 			w := httptest.NewRecorder()
 			router.ServeHTTP(w, req)
@@ -437,6 +542,299 @@ func TestHandlers_LoginRefresh(t *testing.T) {
 	}
 }
 
+func TestHandlers_Logout(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		sessionErr         error
+		sessionTimes       int
+		redisSetErr        error
+		redisSetTimes      int
+	}{
+		{
+			name:               "invalid token info",
+			path:               "/user-logout/invalid-token-info",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid token"),
+			authTokenInfoTimes: 1,
+			sessionErr:         nil,
+			sessionTimes:       0,
+			redisSetErr:        nil,
+			redisSetTimes:      0,
+		}, {
+			name:               "invalid session",
+			path:               "/user-logout/invalid-session",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			sessionErr:         errors.New("invalid session"),
+			sessionTimes:       1,
+			redisSetErr:        nil,
+			redisSetTimes:      0,
+		}, {
+			name:               "cache failure",
+			path:               "/user-logout/cache-failure",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			sessionErr:         nil,
+			sessionTimes:       1,
+			redisSetErr:        redis.ErrCacheSet,
+			redisSetTimes:      1,
+		}, {
+			name:               "valid",
+			path:               "/user-logout/valid",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			sessionErr:         nil,
+			sessionTimes:       1,
+			redisSetErr:        nil,
+			redisSetTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, time.Now().Add(time.Minute).Unix(), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockAuth.EXPECT().SessionFromJWT("valid-token").
+					Return("session-id", time.Now().Unix(), test.sessionErr).
+					Times(test.sessionTimes),
+
+				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.redisSetErr).
+					Times(test.redisSetTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, Logout(zapLogger, mockAuth, mockRedis, "Authorization"))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, nil)
+			req.Header.Set("Authorization", "valid-token")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_LogoutEverywhere(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		path               string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		redisSetErr        error
+		redisSetTimes      int
+	}{
+		{
+			name:               "invalid token info",
+			path:               "/user-logout-all/invalid-token-info",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid token"),
+			authTokenInfoTimes: 1,
+			redisSetErr:        nil,
+			redisSetTimes:      0,
+		}, {
+			name:               "cache failure",
+			path:               "/user-logout-all/cache-failure",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			redisSetErr:        redis.ErrCacheSet,
+			redisSetTimes:      1,
+		}, {
+			name:               "valid",
+			path:               "/user-logout-all/valid",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			redisSetErr:        nil,
+			redisSetTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, time.Now().Add(time.Minute).Unix(), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockAuth.EXPECT().ExpirationDuration().
+					Return(int64(600)).
+					Times(test.redisSetTimes),
+
+				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.redisSetErr).
+					Times(test.redisSetTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, LogoutEverywhere(zapLogger, mockAuth, mockRedis))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_LoginEvents(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/user/security/events/"
+
+	events := []postgres.LoginEvent{{}, {}, {}, {}}
+
+	testCases := []struct {
+		name                string
+		path                string
+		querySegment        string
+		expectedMsg         string
+		expectedStatus      int
+		authTokenInfoErr    error
+		authTokenInfoTimes  int
+		authDecryptStrErr   error
+		authDecryptStrTimes int
+		eventsErr           error
+		eventsTimes         int
+		authEncryptStrTimes int
+	}{
+		{
+			name:                "invalid JWT",
+			path:                "invalid-jwt",
+			querySegment:        "?pageCursor=PaGeCuRs0R==&pageSize=3",
+			expectedMsg:         "malformed authentication",
+			expectedStatus:      http.StatusForbidden,
+			authTokenInfoErr:    errors.New("invalid JWT"),
+			authTokenInfoTimes:  1,
+			authDecryptStrTimes: 0,
+			eventsTimes:         0,
+			authEncryptStrTimes: 0,
+		}, {
+			name:                "decrypt cursor failure",
+			path:                "decrypt-cursor-failure",
+			querySegment:        "?pageCursor=PaGeCuRs0R==&pageSize=3",
+			expectedMsg:         "invalid page cursor or page size",
+			expectedStatus:      http.StatusBadRequest,
+			authTokenInfoTimes:  1,
+			authDecryptStrErr:   errors.New("decrypt failure"),
+			authDecryptStrTimes: 1,
+			eventsTimes:         0,
+			authEncryptStrTimes: 0,
+		}, {
+			name:                "login events unknown failure",
+			path:                "login-events-unknown-failure",
+			querySegment:        "?pageSize=3",
+			expectedMsg:         "retry",
+			expectedStatus:      http.StatusInternalServerError,
+			authTokenInfoTimes:  1,
+			authDecryptStrTimes: 0,
+			eventsErr:           errors.New("unknown error"),
+			eventsTimes:         1,
+			authEncryptStrTimes: 0,
+		}, {
+			name:                "valid",
+			path:                "valid",
+			querySegment:        "?pageSize=3",
+			expectedMsg:         "login events",
+			expectedStatus:      http.StatusOK,
+			authTokenInfoTimes:  1,
+			authDecryptStrTimes: 0,
+			eventsTimes:         1,
+			authEncryptStrTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("3"), test.authDecryptStrErr).
+					Times(test.authDecryptStrTimes),
+
+				mockDB.EXPECT().LoginEventsPaginated(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(events, test.eventsErr).
+					Times(test.eventsTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-page-cursor", nil).
+					Times(test.authEncryptStrTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+test.path, LoginEvents(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, basePath+test.path+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
 func TestHandlers_DeleteUser(t *testing.T) {
 	t.Parallel()
 
@@ -696,3 +1094,411 @@ func TestHandlers_DeleteUser(t *testing.T) {
 		})
 	}
 }
+
+func TestHandlers_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	validRequest := &models.HTTPChangePasswordRequest{
+		CurrentPassword: "current-password",
+		NewPassword:     "new-password",
+	}
+
+	testCases := []struct {
+		name               string
+		path               string
+		expectedStatus     int
+		request            *models.HTTPChangePasswordRequest
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		userGetInfoErr     error
+		userGetInfoTimes   int
+		authCheckPwdErr    error
+		authCheckPwdTimes  int
+		updatePwdTimes     int
+		redisSetErr        error
+		redisSetTimes      int
+	}{
+		{
+			name:               "invalid token info",
+			path:               "/user-password-change/invalid-token-info",
+			expectedStatus:     http.StatusForbidden,
+			request:            validRequest,
+			authTokenInfoErr:   errors.New("invalid token"),
+			authTokenInfoTimes: 1,
+			userGetInfoErr:     nil,
+			userGetInfoTimes:   0,
+			authCheckPwdErr:    nil,
+			authCheckPwdTimes:  0,
+			updatePwdTimes:     0,
+			redisSetErr:        nil,
+			redisSetTimes:      0,
+		}, {
+			name:               "empty request",
+			path:               "/user-password-change/empty-request",
+			expectedStatus:     http.StatusBadRequest,
+			request:            &models.HTTPChangePasswordRequest{},
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			userGetInfoErr:     nil,
+			userGetInfoTimes:   0,
+			authCheckPwdErr:    nil,
+			authCheckPwdTimes:  0,
+			updatePwdTimes:     0,
+			redisSetErr:        nil,
+			redisSetTimes:      0,
+		}, {
+			name:               "user info failure",
+			path:               "/user-password-change/user-info-failure",
+			expectedStatus:     http.StatusInternalServerError,
+			request:            validRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			userGetInfoErr:     postgres.ErrNotFoundUser,
+			userGetInfoTimes:   1,
+			authCheckPwdErr:    nil,
+			authCheckPwdTimes:  0,
+			updatePwdTimes:     0,
+			redisSetErr:        nil,
+			redisSetTimes:      0,
+		}, {
+			name:               "invalid current password",
+			path:               "/user-password-change/invalid-current-password",
+			expectedStatus:     http.StatusForbidden,
+			request:            validRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			userGetInfoErr:     nil,
+			userGetInfoTimes:   1,
+			authCheckPwdErr:    errors.New("invalid password"),
+			authCheckPwdTimes:  1,
+			updatePwdTimes:     0,
+			redisSetErr:        nil,
+			redisSetTimes:      0,
+		}, {
+			name:               "session revocation failure",
+			path:               "/user-password-change/session-revocation-failure",
+			expectedStatus:     http.StatusInternalServerError,
+			request:            validRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			userGetInfoErr:     nil,
+			userGetInfoTimes:   1,
+			authCheckPwdErr:    nil,
+			authCheckPwdTimes:  1,
+			updatePwdTimes:     1,
+			redisSetErr:        redis.ErrCacheSet,
+			redisSetTimes:      1,
+		}, {
+			name:               "valid",
+			path:               "/user-password-change/valid",
+			expectedStatus:     http.StatusOK,
+			request:            validRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			userGetInfoErr:     nil,
+			userGetInfoTimes:   1,
+			authCheckPwdErr:    nil,
+			authCheckPwdTimes:  1,
+			updatePwdTimes:     1,
+			redisSetErr:        nil,
+			redisSetTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			requestJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: testUserData["username1"]}, test.userGetInfoErr).
+					Times(test.userGetInfoTimes),
+
+				mockAuth.EXPECT().CheckPassword(gomock.Any(), gomock.Any()).
+					Return(test.authCheckPwdErr).
+					Times(test.authCheckPwdTimes),
+
+				mockAuth.EXPECT().HashPassword(gomock.Any()).
+					Return("new-hashed-password", nil).
+					Times(test.updatePwdTimes),
+
+				mockPostgres.EXPECT().UserUpdatePassword(gomock.Any(), "new-hashed-password").
+					Return(nil).
+					Times(test.updatePwdTimes),
+
+				mockAuth.EXPECT().ExpirationDuration().
+					Return(int64(600)).
+					Times(test.redisSetTimes),
+
+				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.redisSetErr).
+					Times(test.redisSetTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, ChangePassword(zapLogger, mockAuth, mockRedis, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(requestJSON))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_RequestPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	validRequest := &models.HTTPPasswordResetRequest{Username: "username1"}
+
+	testCases := []struct {
+		name             string
+		path             string
+		expectedStatus   int
+		request          *models.HTTPPasswordResetRequest
+		clientIDErr      error
+		clientIDTimes    int
+		userGetInfoTimes int
+		redisSetErr      error
+		redisSetTimes    int
+		notifyTimes      int
+	}{
+		{
+			name:             "empty request",
+			path:             "/user-password-reset-request/empty-request",
+			expectedStatus:   http.StatusBadRequest,
+			request:          &models.HTTPPasswordResetRequest{},
+			clientIDErr:      nil,
+			clientIDTimes:    0,
+			userGetInfoTimes: 0,
+			redisSetErr:      nil,
+			redisSetTimes:    0,
+			notifyTimes:      0,
+		}, {
+			name:             "unknown user",
+			path:             "/user-password-reset-request/unknown-user",
+			expectedStatus:   http.StatusAccepted,
+			request:          validRequest,
+			clientIDErr:      postgres.ErrNotFoundUser,
+			clientIDTimes:    1,
+			userGetInfoTimes: 0,
+			redisSetErr:      nil,
+			redisSetTimes:    0,
+			notifyTimes:      0,
+		}, {
+			name:             "cache failure",
+			path:             "/user-password-reset-request/cache-failure",
+			expectedStatus:   http.StatusInternalServerError,
+			request:          validRequest,
+			clientIDErr:      nil,
+			clientIDTimes:    1,
+			userGetInfoTimes: 1,
+			redisSetErr:      redis.ErrCacheSet,
+			redisSetTimes:    1,
+			notifyTimes:      0,
+		}, {
+			name:             "valid",
+			path:             "/user-password-reset-request/valid",
+			expectedStatus:   http.StatusAccepted,
+			request:          validRequest,
+			clientIDErr:      nil,
+			clientIDTimes:    1,
+			userGetInfoTimes: 1,
+			redisSetErr:      nil,
+			redisSetTimes:    1,
+			notifyTimes:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockNotifier := mocks.NewMockNotifier(mockCtrl)
+
+			requestJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockPostgres.EXPECT().UserGetClientID(gomock.Any()).
+					Return(uuid.UUID{}, test.clientIDErr).
+					Times(test.clientIDTimes),
+
+				mockPostgres.EXPECT().UserGetInfo(gomock.Any()).
+					Return(modelsPostgres.User{UserAccount: testUserData["username1"]}, nil).
+					Times(test.userGetInfoTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-token", nil).
+					Times(test.userGetInfoTimes),
+
+				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.redisSetErr).
+					Times(test.redisSetTimes),
+
+				mockNotifier.EXPECT().PasswordReset(gomock.Any(), "encrypted-token", gomock.Any()).
+					Return(nil).
+					Times(test.notifyTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, RequestPasswordReset(zapLogger, mockAuth, mockRedis, mockPostgres, mockNotifier))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(requestJSON))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
+
+func TestHandlers_ResetPassword(t *testing.T) {
+	t.Parallel()
+
+	validRequest := &models.HTTPResetPasswordRequest{Token: "encrypted-token", Password: "new-password"}
+
+	testCases := []struct {
+		name           string
+		path           string
+		expectedStatus int
+		request        *models.HTTPResetPasswordRequest
+		decryptTimes   int
+		redisGetErr    error
+		redisGetTimes  int
+		updatePwdTimes int
+		redisSetErr    error
+		redisSetTimes  int
+	}{
+		{
+			name:           "empty request",
+			path:           "/user-password-reset/empty-request",
+			expectedStatus: http.StatusBadRequest,
+			request:        &models.HTTPResetPasswordRequest{},
+			decryptTimes:   0,
+			redisGetErr:    nil,
+			redisGetTimes:  0,
+			updatePwdTimes: 0,
+			redisSetErr:    nil,
+			redisSetTimes:  0,
+		}, {
+			name:           "expired token",
+			path:           "/user-password-reset/expired-token",
+			expectedStatus: http.StatusForbidden,
+			request:        validRequest,
+			decryptTimes:   1,
+			redisGetErr:    redis.ErrCacheMiss,
+			redisGetTimes:  1,
+			updatePwdTimes: 0,
+			redisSetErr:    nil,
+			redisSetTimes:  0,
+		}, {
+			name:           "session revocation failure",
+			path:           "/user-password-reset/session-revocation-failure",
+			expectedStatus: http.StatusInternalServerError,
+			request:        validRequest,
+			decryptTimes:   1,
+			redisGetErr:    nil,
+			redisGetTimes:  1,
+			updatePwdTimes: 1,
+			redisSetErr:    redis.ErrCacheSet,
+			redisSetTimes:  1,
+		}, {
+			name:           "valid",
+			path:           "/user-password-reset/valid",
+			expectedStatus: http.StatusOK,
+			request:        validRequest,
+			decryptTimes:   1,
+			redisGetErr:    nil,
+			redisGetTimes:  1,
+			updatePwdTimes: 1,
+			redisSetErr:    nil,
+			redisSetTimes:  1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+
+			requestJSON, err := json.Marshal(&test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("token-id"), nil).
+					Times(test.decryptTimes),
+
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).
+					Return(test.redisGetErr).
+					Times(test.redisGetTimes),
+
+				mockAuth.EXPECT().HashPassword(gomock.Any()).
+					Return("new-hashed-password", nil).
+					Times(test.updatePwdTimes),
+
+				mockRedis.EXPECT().Del(gomock.Any()).
+					Return(nil).
+					Times(test.updatePwdTimes),
+
+				mockPostgres.EXPECT().UserUpdatePassword(gomock.Any(), "new-hashed-password").
+					Return(nil).
+					Times(test.updatePwdTimes),
+
+				mockAuth.EXPECT().ExpirationDuration().
+					Return(int64(600)).
+					Times(test.redisSetTimes),
+
+				mockRedis.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(test.redisSetErr).
+					Times(test.redisSetTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(test.path, ResetPassword(zapLogger, mockAuth, mockRedis, mockPostgres))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, test.path, bytes.NewBuffer(requestJSON))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, w.Code, "expected status codes do not match")
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/common"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// CreateWebhook will register a webhook URL that a client's account events will be delivered to.
+//
+//	@Summary		Register a webhook.
+//	@Description	Registers an HTTPS URL that deposits, currency exchanges, transfers, and Cryptocurrency purchases and sales will be delivered to as signed JSON events. Each request is signed with the returned secret: the X-FTeX-Signature header contains sha256= followed by the hex encoded HMAC-SHA256 of the X-FTeX-Timestamp header, a period, and the request body. Events that are not acknowledged with a 2xx response are retried with an exponential backoff before being dead-lettered. Only the encrypted secret is stored and it is only ever returned once.
+//	@Tags			user users webhooks create
+//	@Id				createWebhook
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		models.HTTPWebhookRequest	true	"the HTTPS URL to deliver events to"
+//	@Success		201		{object}	models.HTTPSuccess			"a message to confirm the registration of the webhook with the signing secret in the payload"
+//	@Failure		400		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		403		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		409		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Failure		500		{object}	models.HTTPError			"error message with any available details in payload"
+//	@Router			/user/webhooks/create [post]
+func CreateWebhook(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			clientID   uuid.UUID
+			request    models.HTTPWebhookRequest
+			webhook    *models.HTTPWebhookResponse
+			httpMsg    string
+			httpStatus int
+			payload    any
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if err = ginCtx.ShouldBindJSON(&request); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusBadRequest, &models.HTTPError{Message: err.Error()})
+
+			return
+		}
+
+		if webhook, httpMsg, httpStatus, payload, err =
+			common.HTTPWebhookCreate(auth, db, logger, clientID, &request); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: payload})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusCreated, models.HTTPSuccess{
+			Message: "store the signing secret securely, it will not be shown again",
+			Payload: webhook,
+		})
+	}
+}
+
+// Webhooks will retrieve the webhooks registered by a client.
+//
+//	@Summary		Retrieve all the webhooks for a specific client.
+//	@Description	Retrieves the URL and registration time of all the webhooks for a specific client, oldest first. The signing secrets are never returned.
+//	@Tags			user users webhooks info
+//	@Id				webhooks
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	models.HTTPSuccess	"the webhooks in the payload"
+//	@Failure		403	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500	{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/webhooks/info/ [get]
+func Webhooks(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			clientID   uuid.UUID
+			webhooks   *models.HTTPWebhooksResponse
+			httpMsg    string
+			httpStatus int
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if webhooks, httpMsg, httpStatus, err = common.HTTPWebhookList(db, logger, clientID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "webhooks", Payload: webhooks})
+	}
+}
+
+// DeleteWebhook will delete one of a client's webhooks.
+//
+//	@Summary		Delete a webhook.
+//	@Description	Deletes a webhook. Events that have not been delivered to it, and its dead-lettered events, are discarded.
+//	@Tags			user users webhooks delete
+//	@Id				deleteWebhook
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			webhookID	path		string				true	"the webhook ID of the webhook to delete"
+//	@Success		200			{object}	models.HTTPSuccess	"a message to confirm the deletion of the webhook"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/webhooks/delete/{webhookID} [delete]
+func DeleteWebhook(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			err        error
+			clientID   uuid.UUID
+			httpMsg    string
+			httpStatus int
+			webhookID  = ginCtx.Param("webhookID")
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		if httpMsg, httpStatus, err = common.HTTPWebhookDelete(db, logger, clientID, webhookID); err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, &models.HTTPError{Message: httpMsg, Payload: webhookID})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "webhook deleted", Payload: webhookID})
+	}
+}
+
+// WebhookDeadLetters will handle an HTTP request to retrieve the events that could not be delivered to a client's
+// webhooks, most recent first.
+//
+// If a user requests N records, N+1 records will be requested. This is used to calculate if any further records are
+// available for retrieval. The page cursor will be the encrypted offset of the next page of records.
+//
+//	@Summary		Retrieve the events that could not be delivered to a user's webhooks.
+//	@Description	Retrieves the events that were abandoned after exhausting their delivery attempts with the webhook URL, number of attempts, and the error from the final attempt, most recent first. The initial request will only contain (optionally) the page size. Subsequent requests will require a cursors to the next page that will be returned in a previous call to the endpoint. The user may choose to change the page size in any sequence of calls.
+//	@Tags			user users webhooks dead-letters info
+//	@Id				webhookDeadLetters
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			pageCursor	query		string				false	"The page cursor into the query results records."
+//	@Param			pageSize	query		int					false	"The number of records to retrieve on this page."
+//	@Success		200			{object}	models.HTTPSuccess	"a message to with a page of dead-lettered events for the client"
+//	@Failure		400			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		403			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		404			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Failure		500			{object}	models.HTTPError	"error message with any available details in payload"
+//	@Router			/user/webhooks/dead-letters/ [get]
+func WebhookDeadLetters(logger *logger.Logger, auth auth.Auth, db postgres.Postgres) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		var (
+			deadLetters models.HTTPWebhookDeadLettersPaginated
+			httpStatus  int
+			httpMessage string
+			clientID    uuid.UUID
+			err         error
+		)
+
+		if clientID, _, err = auth.TokenInfoFromGinCtx(ginCtx); err != nil {
+			ginCtx.AbortWithStatusJSON(http.StatusForbidden, &models.HTTPError{Message: "malformed authentication token"})
+
+			return
+		}
+
+		deadLetters, httpStatus, httpMessage, err = common.HTTPWebhookDeadLettersPaginated(auth, db, logger,
+			clientID, ginCtx.Query("pageCursor"), ginCtx.Query("pageSize"), true)
+		if err != nil {
+			ginCtx.AbortWithStatusJSON(httpStatus, models.HTTPError{Message: httpMessage})
+
+			return
+		}
+
+		ginCtx.JSON(http.StatusOK, models.HTTPSuccess{Message: "webhook dead letters", Payload: deadLetters})
+	}
+}
@@ -0,0 +1,439 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestHandlers_CreateWebhook(t *testing.T) {
+	t.Parallel()
+
+	const path = "/webhooks/create"
+
+	validRequest := &models.HTTPWebhookRequest{URL: "https://example.com/ftex/events"}
+
+	testCases := []struct {
+		name                string
+		expectedMsg         string
+		expectedStatus      int
+		request             any
+		authTokenInfoErr    error
+		authTokenInfoTimes  int
+		generateSecretTimes int
+		webhookCreateErr    error
+		webhookCreateTimes  int
+	}{
+		{
+			name:                "invalid jwt",
+			expectedMsg:         "malformed authentication",
+			expectedStatus:      http.StatusForbidden,
+			request:             validRequest,
+			authTokenInfoErr:    errors.New("invalid jwt"),
+			authTokenInfoTimes:  1,
+			generateSecretTimes: 0,
+			webhookCreateErr:    nil,
+			webhookCreateTimes:  0,
+		}, {
+			name:                "invalid request",
+			expectedMsg:         "cannot unmarshal",
+			expectedStatus:      http.StatusBadRequest,
+			request:             "invalid request",
+			authTokenInfoErr:    nil,
+			authTokenInfoTimes:  1,
+			generateSecretTimes: 0,
+			webhookCreateErr:    nil,
+			webhookCreateTimes:  0,
+		}, {
+			name:                "insecure url",
+			expectedMsg:         "validation",
+			expectedStatus:      http.StatusBadRequest,
+			request:             &models.HTTPWebhookRequest{URL: "http://example.com/ftex/events"},
+			authTokenInfoErr:    nil,
+			authTokenInfoTimes:  1,
+			generateSecretTimes: 0,
+			webhookCreateErr:    nil,
+			webhookCreateTimes:  0,
+		}, {
+			name:                "already registered or limit reached",
+			expectedMsg:         "could not register webhook",
+			expectedStatus:      http.StatusConflict,
+			request:             validRequest,
+			authTokenInfoErr:    nil,
+			authTokenInfoTimes:  1,
+			generateSecretTimes: 1,
+			webhookCreateErr:    postgres.ErrCreateWebhook,
+			webhookCreateTimes:  1,
+		}, {
+			name:                "valid",
+			expectedMsg:         "will not be shown again",
+			expectedStatus:      http.StatusCreated,
+			request:             validRequest,
+			authTokenInfoErr:    nil,
+			authTokenInfoTimes:  1,
+			generateSecretTimes: 1,
+			webhookCreateErr:    nil,
+			webhookCreateTimes:  1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			requestJSON, err := json.Marshal(test.request)
+			require.NoErrorf(t, err, "failed to marshall JSON: %v", err)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockAuth.EXPECT().GenerateWebhookSecret().
+					Return("whsec_secret", "encrypted-secret", nil).
+					Times(test.generateSecretTimes),
+
+				mockDB.EXPECT().WebhookCreate(gomock.Any(), gomock.Any(), "encrypted-secret", gomock.Any()).
+					Return(postgres.Webhook{}, test.webhookCreateErr).
+					Times(test.webhookCreateTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.POST(path, CreateWebhook(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, path, bytes.NewBuffer(requestJSON))
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_Webhooks(t *testing.T) {
+	t.Parallel()
+
+	const path = "/webhooks/info/"
+
+	testCases := []struct {
+		name               string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		webhookListErr     error
+		webhookListTimes   int
+	}{
+		{
+			name:               "invalid jwt",
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid jwt"),
+			authTokenInfoTimes: 1,
+			webhookListErr:     nil,
+			webhookListTimes:   0,
+		}, {
+			name:               "db failure",
+			expectedMsg:        "could not complete webhook request",
+			expectedStatus:     http.StatusInternalServerError,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			webhookListErr:     postgres.ErrTransactWebhook,
+			webhookListTimes:   1,
+		}, {
+			name:               "valid",
+			expectedMsg:        "webhooks",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			webhookListErr:     nil,
+			webhookListTimes:   1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().WebhookList(gomock.Any()).
+					Return([]postgres.Webhook{{}, {}}, test.webhookListErr).
+					Times(test.webhookListTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(path, Webhooks(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, path, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_DeleteWebhook(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/webhooks/delete/"
+
+	webhookID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate webhook id.")
+
+	testCases := []struct {
+		name               string
+		webhookID          string
+		expectedMsg        string
+		expectedStatus     int
+		authTokenInfoErr   error
+		authTokenInfoTimes int
+		webhookDeleteErr   error
+		webhookDeleteTimes int
+	}{
+		{
+			name:               "invalid jwt",
+			webhookID:          webhookID.String(),
+			expectedMsg:        "malformed authentication",
+			expectedStatus:     http.StatusForbidden,
+			authTokenInfoErr:   errors.New("invalid jwt"),
+			authTokenInfoTimes: 1,
+			webhookDeleteErr:   nil,
+			webhookDeleteTimes: 0,
+		}, {
+			name:               "invalid webhook id",
+			webhookID:          "invalid-webhook-id",
+			expectedMsg:        "invalid webhook ID",
+			expectedStatus:     http.StatusBadRequest,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			webhookDeleteErr:   nil,
+			webhookDeleteTimes: 0,
+		}, {
+			name:               "not found",
+			webhookID:          webhookID.String(),
+			expectedMsg:        "webhook not found",
+			expectedStatus:     http.StatusNotFound,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			webhookDeleteErr:   postgres.ErrNotFoundWebhook,
+			webhookDeleteTimes: 1,
+		}, {
+			name:               "valid",
+			webhookID:          webhookID.String(),
+			expectedMsg:        "webhook deleted",
+			expectedStatus:     http.StatusOK,
+			authTokenInfoErr:   nil,
+			authTokenInfoTimes: 1,
+			webhookDeleteErr:   nil,
+			webhookDeleteTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockDB.EXPECT().WebhookDelete(gomock.Any(), gomock.Any()).
+					Return(test.webhookDeleteErr).
+					Times(test.webhookDeleteTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.DELETE(basePath+":webhookID", DeleteWebhook(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodDelete, basePath+test.webhookID, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
+
+func TestHandlers_WebhookDeadLetters(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "/user/webhooks/dead-letters/"
+
+	deadLetters := []postgres.WebhookDeadLetter{{}, {}, {}, {}}
+
+	testCases := []struct {
+		name                string
+		path                string
+		querySegment        string
+		expectedMsg         string
+		expectedStatus      int
+		authTokenInfoErr    error
+		authTokenInfoTimes  int
+		authDecryptStrErr   error
+		authDecryptStrTimes int
+		deadLettersErr      error
+		deadLettersTimes    int
+		authEncryptStrTimes int
+	}{
+		{
+			name:                "invalid JWT",
+			path:                "invalid-jwt",
+			querySegment:        "?pageCursor=PaGeCuRs0R==&pageSize=3",
+			expectedMsg:         "malformed authentication",
+			expectedStatus:      http.StatusForbidden,
+			authTokenInfoErr:    errors.New("invalid JWT"),
+			authTokenInfoTimes:  1,
+			authDecryptStrTimes: 0,
+			deadLettersTimes:    0,
+			authEncryptStrTimes: 0,
+		}, {
+			name:                "decrypt cursor failure",
+			path:                "decrypt-cursor-failure",
+			querySegment:        "?pageCursor=PaGeCuRs0R==&pageSize=3",
+			expectedMsg:         "invalid page cursor or page size",
+			expectedStatus:      http.StatusBadRequest,
+			authTokenInfoTimes:  1,
+			authDecryptStrErr:   errors.New("decrypt failure"),
+			authDecryptStrTimes: 1,
+			deadLettersTimes:    0,
+			authEncryptStrTimes: 0,
+		}, {
+			name:                "dead letters unknown failure",
+			path:                "dead-letters-unknown-failure",
+			querySegment:        "?pageSize=3",
+			expectedMsg:         "retry",
+			expectedStatus:      http.StatusInternalServerError,
+			authTokenInfoTimes:  1,
+			authDecryptStrTimes: 0,
+			deadLettersErr:      errors.New("unknown error"),
+			deadLettersTimes:    1,
+			authEncryptStrTimes: 0,
+		}, {
+			name:                "valid",
+			path:                "valid",
+			querySegment:        "?pageSize=3",
+			expectedMsg:         "webhook dead letters",
+			expectedStatus:      http.StatusOK,
+			authTokenInfoTimes:  1,
+			authDecryptStrTimes: 0,
+			deadLettersTimes:    1,
+			authEncryptStrTimes: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+
+			gomock.InOrder(
+				mockAuth.EXPECT().TokenInfoFromGinCtx(gomock.Any()).
+					Return(uuid.UUID{}, int64(0), test.authTokenInfoErr).
+					Times(test.authTokenInfoTimes),
+
+				mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+					Return([]byte("3"), test.authDecryptStrErr).
+					Times(test.authDecryptStrTimes),
+
+				mockDB.EXPECT().WebhookDeadLettersPaginated(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(deadLetters, test.deadLettersErr).
+					Times(test.deadLettersTimes),
+
+				mockAuth.EXPECT().EncryptToString(gomock.Any()).
+					Return("encrypted-page-cursor", nil).
+					Times(test.authEncryptStrTimes),
+			)
+
+			// Endpoint setup for test.
+			router := gin.Default()
+			router.GET(basePath+test.path, WebhookDeadLetters(zapLogger, mockAuth, mockDB))
+			req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, basePath+test.path+test.querySegment, nil)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Verify responses
+			require.Equal(t, test.expectedStatus, recorder.Code, "expected status codes do not match")
+
+			var resp map[string]interface{}
+
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp), "failed to unpack response.")
+
+			actualMessage, ok := resp["message"].(string)
+			require.True(t, ok, "failed to extract response message.")
+			require.Contains(t, actualMessage, test.expectedMsg, "response message mismatch.")
+		})
+	}
+}
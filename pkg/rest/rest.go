@@ -14,6 +14,8 @@ import (
 	_ "github.com/surahman/FTeX/docs" // Swaggo generated Swagger documentation
 	"github.com/surahman/FTeX/pkg/auth"
 	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/models"
+	"github.com/surahman/FTeX/pkg/notifier"
 	"github.com/surahman/FTeX/pkg/postgres"
 	"github.com/surahman/FTeX/pkg/quotes"
 	"github.com/surahman/FTeX/pkg/redis"
@@ -32,6 +34,7 @@ type Server struct {
 	cache  redis.Redis
 	db     postgres.Postgres
 	quotes quotes.Quotes
+	notify notifier.Notifier
 	conf   *config
 	logger *logger.Logger
 	router *gin.Engine
@@ -40,7 +43,7 @@ type Server struct {
 
 // NewServer will create a new REST server instance in a non-running state.
 func NewServer(fs *afero.Fs, auth auth.Auth, postgres postgres.Postgres, redis redis.Redis, quotes quotes.Quotes,
-	logger *logger.Logger, wg *sync.WaitGroup) (server *Server, err error) {
+	notify notifier.Notifier, logger *logger.Logger, wg *sync.WaitGroup) (server *Server, err error) {
 	// Load configurations.
 	conf := newConfig()
 	if err = conf.Load(*fs); err != nil {
@@ -53,16 +56,22 @@ func NewServer(fs *afero.Fs, auth auth.Auth, postgres postgres.Postgres, redis r
 			cache:  redis,
 			db:     postgres,
 			quotes: quotes,
+			notify: notify,
 			logger: logger,
 			wg:     wg,
 		},
 		err
 }
 
-// initialize will configure the HTTP server routes.
-func (s *Server) initialize() {
+// initialize will configure the HTTP server routes. Client IP addresses are only taken from forwarding headers set by
+// the trusted proxies.
+func (s *Server) initialize() error {
 	s.router = gin.Default()
 
+	if err := s.router.SetTrustedProxies(s.conf.Server.TrustedProxies); err != nil {
+		return fmt.Errorf("failed to configure trusted proxies: %w", err)
+	}
+
 	//	@title						FTeX, Inc. (Formerly Crypto-Bro's Bank, Inc.)
 	//	@version					1.2.6
 	//	@description				FTeX Fiat and Cryptocurrency Banking API.
@@ -85,43 +94,170 @@ func (s *Server) initialize() {
 	//	@securityDefinitions.apikey	ApiKeyAuth
 	//	@in							header
 	//	@name						Authorization
+	//
+	//	@securityDefinitions.apikey	ClientAPIKey
+	//	@in							header
+	//	@name						X-API-Key
 
 	s.router.GET(s.conf.Server.SwaggerPath, ginSwagger.WrapHandler(swaggerfiles.Handler))
+	s.router.GET("/.well-known/jwks.json", restHandlers.JWKS(s.auth))
 
 	// Endpoint configurations
-	authMiddleware := restHandlers.AuthMiddleware(s.auth, s.db, s.logger, s.conf.Authorization.HeaderKey)
+	authMiddleware := s.authMiddleware(auth.StepUpNone, auth.ScopeNone)
+	deleteMiddleware := s.authMiddleware(auth.StepUpDeletes, auth.ScopeNone)
+	readMiddleware := s.authMiddleware(auth.StepUpNone, auth.ScopeReadBalances)
+	tradeMiddleware := s.authMiddleware(auth.StepUpNone, auth.ScopeTrade)
+	tradeTransferMiddleware := s.authMiddleware(auth.StepUpTransfers, auth.ScopeTrade)
+	depositMiddleware := s.authMiddleware(auth.StepUpNone, auth.ScopeDeposit)
+	withdrawMiddleware := s.authMiddleware(auth.StepUpTransfers, auth.ScopeWithdraw)
+	transferMiddleware := s.authMiddleware(auth.StepUpTransfers, auth.ScopeNone)
+	adminMiddleware := restHandlers.AdminMiddleware(s.auth, s.db, s.logger)
+	userLimit := s.rateLimitMiddleware("user", &s.conf.RateLimits.User)
+	fiatLimit := s.rateLimitMiddleware("fiat", &s.conf.RateLimits.Fiat)
+	cryptoLimit := s.rateLimitMiddleware("crypto", &s.conf.RateLimits.Crypto)
+	quotesLimit := s.rateLimitMiddleware("quotes", &s.conf.RateLimits.Quotes)
+	ordersLimit := s.rateLimitMiddleware("orders", &s.conf.RateLimits.Orders)
+	schedulesLimit := s.rateLimitMiddleware("schedules", &s.conf.RateLimits.Schedules)
+	ratesLimit := s.rateLimitMiddleware("rates", &s.conf.RateLimits.Rates)
+	adminLimit := s.rateLimitMiddleware("admin", &s.conf.RateLimits.Admin)
 	api := s.router.Group(s.conf.Server.BasePath)
 
 	api.GET("/health", restHandlers.Healthcheck(s.logger, s.db, s.cache))
 
-	userGroup := api.Group("/user")
+	userGroup := api.Group("/user", userLimit)
 	userGroup.POST("/register", restHandlers.RegisterUser(s.logger, s.auth, s.db))
-	userGroup.POST("/login", restHandlers.LoginUser(s.logger, s.auth, s.db))
-	userGroup.
-		Use(authMiddleware).
-		POST("/refresh", restHandlers.LoginRefresh(s.logger, s.auth, s.db))
-	userGroup.
-		Use(authMiddleware).
+	userGroup.POST("/login", restHandlers.LoginUser(s.logger, s.auth, s.cache, s.db))
+	userGroup.POST("/login/mfa", restHandlers.LoginMFA(s.logger, s.auth, s.cache, s.db))
+	userGroup.POST("/password/reset/request",
+		restHandlers.RequestPasswordReset(s.logger, s.auth, s.cache, s.db, s.notify))
+	userGroup.POST("/password/reset", restHandlers.ResetPassword(s.logger, s.auth, s.cache, s.db))
+
+	userAuthGroup := api.Group("/user").Use(authMiddleware, userLimit)
+	userAuthGroup.POST("/refresh", restHandlers.LoginRefresh(s.logger, s.auth, s.db))
+	userAuthGroup.POST("/logout", restHandlers.Logout(s.logger, s.auth, s.cache, s.conf.Authorization.HeaderKey))
+	userAuthGroup.POST("/logout/all", restHandlers.LogoutEverywhere(s.logger, s.auth, s.cache))
+	userAuthGroup.POST("/password/change", restHandlers.ChangePassword(s.logger, s.auth, s.cache, s.db))
+	userAuthGroup.GET("/security/events", restHandlers.LoginEvents(s.logger, s.auth, s.db))
+	api.Group("/user").
+		Use(deleteMiddleware, userLimit).
 		DELETE("/delete", restHandlers.DeleteUser(s.logger, s.auth, s.db))
 
-	fiatGroup := api.Group("/fiat").Use(authMiddleware)
-	fiatGroup.POST("/open", restHandlers.OpenFiat(s.logger, s.auth, s.db))
-	fiatGroup.POST("/deposit", restHandlers.DepositFiat(s.logger, s.auth, s.db))
-	fiatGroup.POST("/exchange/offer", restHandlers.ExchangeOfferFiat(s.logger, s.auth, s.cache, s.quotes))
-	fiatGroup.POST("/exchange/transfer", restHandlers.ExchangeTransferFiat(s.logger, s.auth, s.cache, s.db))
-	fiatGroup.GET("/info/balance/:ticker", restHandlers.BalanceFiat(s.logger, s.auth, s.db))
-	fiatGroup.GET("/info/balance/", restHandlers.BalanceFiatPaginated(s.logger, s.auth, s.db))
-	fiatGroup.GET("/info/transaction/:transactionID", restHandlers.TxDetailsFiat(s.logger, s.auth, s.db))
-	fiatGroup.GET("/info/transaction/all/:currencyCode", restHandlers.TxDetailsFiatPaginated(s.logger, s.auth, s.db))
-
-	cryptoGroup := api.Group("/crypto").Use(authMiddleware)
-	cryptoGroup.POST("/open", restHandlers.OpenCrypto(s.logger, s.auth, s.db))
-	cryptoGroup.POST("/offer", restHandlers.OfferCrypto(s.logger, s.auth, s.cache, s.quotes))
-	cryptoGroup.POST("/exchange", restHandlers.ExchangeCrypto(s.logger, s.auth, s.cache, s.db))
-	cryptoGroup.GET("/info/balance/:ticker", restHandlers.BalanceCrypto(s.logger, s.auth, s.db))
-	cryptoGroup.GET("/info/transaction/:transactionID", restHandlers.TxDetailsCrypto(s.logger, s.auth, s.db))
-	cryptoGroup.GET("/info/balance/", restHandlers.BalanceCryptoPaginated(s.logger, s.auth, s.db))
-	cryptoGroup.GET("/info/transaction/all/:ticker", restHandlers.TxDetailsCryptoPaginated(s.logger, s.auth, s.db))
+	mfaGroup := api.Group("/user/mfa").Use(authMiddleware, userLimit)
+	mfaGroup.POST("/enroll", restHandlers.EnrollMFA(s.logger, s.auth, s.db))
+	mfaGroup.POST("/verify", restHandlers.VerifyMFA(s.logger, s.auth, s.db))
+	mfaGroup.POST("/disable", restHandlers.DisableMFA(s.logger, s.auth, s.db))
+	mfaGroup.POST("/recovery-codes", restHandlers.RecoveryCodesMFA(s.logger, s.auth, s.db))
+
+	apiKeysGroup := api.Group("/user/api-keys").Use(authMiddleware, userLimit)
+	apiKeysGroup.POST("/create", restHandlers.CreateAPIKey(s.logger, s.auth, s.db))
+	apiKeysGroup.GET("/info/", restHandlers.APIKeys(s.logger, s.auth, s.db))
+	apiKeysGroup.DELETE("/revoke/:keyID", restHandlers.RevokeAPIKey(s.logger, s.auth, s.db))
+
+	webhooksGroup := api.Group("/user/webhooks").Use(authMiddleware, userLimit)
+	webhooksGroup.POST("/create", restHandlers.CreateWebhook(s.logger, s.auth, s.db))
+	webhooksGroup.GET("/info/", restHandlers.Webhooks(s.logger, s.auth, s.db))
+	webhooksGroup.DELETE("/delete/:webhookID", restHandlers.DeleteWebhook(s.logger, s.auth, s.db))
+	webhooksGroup.GET("/dead-letters/", restHandlers.WebhookDeadLetters(s.logger, s.auth, s.db))
+
+	fiatDepositGroup := api.Group("/fiat").Use(depositMiddleware, fiatLimit)
+	fiatDepositGroup.POST("/open", restHandlers.OpenFiat(s.logger, s.auth, s.db))
+	fiatDepositGroup.POST("/deposit", restHandlers.DepositFiat(s.logger, s.auth, s.cache, s.db))
+
+	fiatQuotesGroup := api.Group("/fiat").Use(tradeMiddleware, quotesLimit)
+	fiatQuotesGroup.POST("/exchange/offer", restHandlers.ExchangeOfferFiat(s.logger, s.auth, s.cache, s.quotes))
+
+	fiatInfoGroup := api.Group("/fiat").Use(readMiddleware, fiatLimit)
+	fiatInfoGroup.GET("/info/balance/:ticker", restHandlers.BalanceFiat(s.logger, s.auth, s.db))
+	fiatInfoGroup.GET("/info/balance/", restHandlers.BalanceFiatPaginated(s.logger, s.auth, s.db))
+	fiatInfoGroup.GET("/info/transaction/:transactionID", restHandlers.TxDetailsFiat(s.logger, s.auth, s.db))
+	fiatInfoGroup.GET("/info/transaction/all/:currencyCode",
+		restHandlers.TxDetailsFiatPaginated(s.logger, s.auth, s.db))
+
+	fiatTransferGroup := api.Group("/fiat").Use(tradeTransferMiddleware, fiatLimit)
+	fiatTransferGroup.POST("/exchange/transfer", restHandlers.ExchangeTransferFiat(s.logger, s.auth, s.cache, s.db))
+
+	fiatWithdrawGroup := api.Group("/fiat").Use(withdrawMiddleware, fiatLimit)
+	fiatWithdrawGroup.POST("/withdraw", restHandlers.WithdrawFiat(s.logger, s.auth, s.cache, s.db))
+	fiatWithdrawGroup.POST("/transfer/p2p", restHandlers.TransferP2PFiat(s.logger, s.auth, s.cache, s.db))
+
+	api.Group("/crypto").
+		Use(tradeMiddleware, cryptoLimit).
+		POST("/open", restHandlers.OpenCrypto(s.logger, s.auth, s.db))
+
+	cryptoQuotesGroup := api.Group("/crypto").Use(tradeMiddleware, quotesLimit)
+	cryptoQuotesGroup.POST("/offer", restHandlers.OfferCrypto(s.logger, s.auth, s.cache, s.quotes))
+	cryptoQuotesGroup.POST("/swap/offer", restHandlers.OfferSwapCrypto(s.logger, s.auth, s.cache, s.quotes))
+
+	cryptoInfoGroup := api.Group("/crypto").Use(readMiddleware, cryptoLimit)
+	cryptoInfoGroup.GET("/info/balance/:ticker", restHandlers.BalanceCrypto(s.logger, s.auth, s.db))
+	cryptoInfoGroup.GET("/info/transaction/:transactionID", restHandlers.TxDetailsCrypto(s.logger, s.auth, s.db))
+	cryptoInfoGroup.GET("/info/balance/", restHandlers.BalanceCryptoPaginated(s.logger, s.auth, s.db))
+	cryptoInfoGroup.GET("/info/transaction/all/:ticker",
+		restHandlers.TxDetailsCryptoPaginated(s.logger, s.auth, s.db))
+
+	cryptoTransferGroup := api.Group("/crypto").Use(tradeTransferMiddleware, cryptoLimit)
+	cryptoTransferGroup.POST("/exchange", restHandlers.ExchangeCrypto(s.logger, s.auth, s.cache, s.db))
+	cryptoTransferGroup.POST("/swap/exchange", restHandlers.ExchangeSwapCrypto(s.logger, s.auth, s.cache, s.db))
+
+	ordersGroup := api.Group("/orders").Use(tradeMiddleware, ordersLimit)
+	ordersGroup.POST("/place", restHandlers.PlaceOrder(s.logger, s.auth, s.cache, s.db))
+	ordersGroup.DELETE("/cancel/:orderID", restHandlers.CancelOrder(s.logger, s.auth, s.db))
+	api.Group("/orders").
+		Use(readMiddleware, ordersLimit).
+		GET("/info/", restHandlers.OrdersPaginated(s.logger, s.auth, s.db))
+
+	schedulesGroup := api.Group("/schedules").Use(tradeMiddleware, schedulesLimit)
+	schedulesGroup.POST("/create", restHandlers.CreateSchedule(s.logger, s.auth, s.cache, s.db))
+	schedulesGroup.PUT("/update/:scheduleID", restHandlers.UpdateSchedule(s.logger, s.auth, s.db))
+	schedulesGroup.DELETE("/delete/:scheduleID", restHandlers.DeleteSchedule(s.logger, s.auth, s.db))
+
+	schedulesInfoGroup := api.Group("/schedules").Use(readMiddleware, schedulesLimit)
+	schedulesInfoGroup.GET("/info/", restHandlers.SchedulesPaginated(s.logger, s.auth, s.db))
+	schedulesInfoGroup.GET("/runs/:scheduleID", restHandlers.ScheduleRunsPaginated(s.logger, s.auth, s.db))
+
+	ratesGroup := api.Group("/rates").Use(readMiddleware, ratesLimit)
+	ratesGroup.GET("/history", restHandlers.RateHistory(s.logger, s.db))
+
+	api.Group("/events").
+		Use(readMiddleware, userLimit).
+		GET("", restHandlers.Events(s.logger, s.auth, s.cache))
+
+	adminGroup := api.Group("/admin").Use(authMiddleware, adminMiddleware, adminLimit)
+	adminGroup.GET("/users/:username", restHandlers.AdminUser(s.logger, s.db))
+	adminGroup.GET("/accounts/:clientID/fiat/balance/", restHandlers.AdminBalanceFiatPaginated(s.logger, s.auth, s.db))
+	adminGroup.GET("/accounts/:clientID/fiat/transactions/:currencyCode",
+		restHandlers.AdminTxDetailsFiatPaginated(s.logger, s.auth, s.db))
+	adminGroup.GET("/accounts/:clientID/crypto/balance/",
+		restHandlers.AdminBalanceCryptoPaginated(s.logger, s.auth, s.db))
+	adminGroup.GET("/accounts/:clientID/crypto/transactions/:ticker",
+		restHandlers.AdminTxDetailsCryptoPaginated(s.logger, s.auth, s.db))
+	adminGroup.GET("/accounts/:clientID/transaction/:transactionID", restHandlers.AdminTxDetails(s.logger, s.db))
+	adminGroup.POST("/accounts/:clientID/freeze", restHandlers.AdminFreezeAccount(s.logger, s.db))
+	adminGroup.POST("/accounts/:clientID/unfreeze", restHandlers.AdminUnfreezeAccount(s.logger, s.db))
+	adminGroup.POST("/accounts/:clientID/fiat/status", restHandlers.AdminSetStatusFiat(s.logger, s.db))
+	adminGroup.POST("/accounts/:clientID/fiat/hold", restHandlers.AdminHoldFiat(s.logger, s.db))
+	adminGroup.POST("/accounts/:clientID/fiat/release", restHandlers.AdminReleaseFiat(s.logger, s.db))
+	adminGroup.POST("/accounts/:clientID/crypto/status", restHandlers.AdminSetStatusCrypto(s.logger, s.db))
+	adminGroup.POST("/accounts/:clientID/crypto/hold", restHandlers.AdminHoldCrypto(s.logger, s.db))
+	adminGroup.POST("/accounts/:clientID/crypto/release", restHandlers.AdminReleaseCrypto(s.logger, s.db))
+
+	api.Group("/admin").
+		Use(transferMiddleware, adminMiddleware, adminLimit).
+		POST("/accounts/:clientID/fiat/reverse/:transactionID", restHandlers.AdminReverseDeposit(s.logger, s.auth, s.db))
+
+	return nil
+}
+
+// authMiddleware will create the authorization middleware for a group of endpoints with the step-up multifactor
+// authentication requirement and the scope that API keys must grant to access them.
+func (s *Server) authMiddleware(stepUp auth.StepUp, scope auth.Scope) gin.HandlerFunc {
+	return restHandlers.AuthMiddleware(s.auth, s.cache, s.db, s.logger, s.conf.Authorization.HeaderKey, stepUp, scope)
+}
+
+// rateLimitMiddleware will create the rate limiting middleware for a group of endpoints. It must be installed after the
+// authorization middleware for authenticated endpoints so that requests are also throttled by client ID.
+func (s *Server) rateLimitMiddleware(group string, limit *models.RateLimit) gin.HandlerFunc {
+	return restHandlers.RateLimitMiddleware(s.cache, s.logger, group, limit)
 }
 
 // Run brings the HTTP service up.
@@ -130,7 +266,11 @@ func (s *Server) Run() {
 	defer s.wg.Done()
 
 	// Configure routes.
-	s.initialize()
+	if err := s.initialize(); err != nil {
+		s.logger.Error("REST server failed to initialize", zap.Error(err))
+
+		return
+	}
 
 	// Create server.
 	srv := &http.Server{
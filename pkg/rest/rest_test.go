@@ -1,15 +1,21 @@
 package rest
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/golang/mock/gomock"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/auth"
 	"github.com/surahman/FTeX/pkg/constants"
 	"github.com/surahman/FTeX/pkg/mocks"
 	"github.com/surahman/FTeX/pkg/quotes"
+	"github.com/surahman/FTeX/pkg/redis"
 )
 
 func TestNewRESTServer(t *testing.T) {
@@ -20,13 +26,96 @@ func TestNewRESTServer(t *testing.T) {
 	mockPostgres := mocks.NewMockPostgres(mockCtrl)
 	mockRedis := mocks.NewMockRedis(mockCtrl)
 	mockQuotes := quotes.NewMockQuotes(mockCtrl)
+	mockNotifier := mocks.NewMockNotifier(mockCtrl)
 
 	fs := afero.NewMemMapFs()
 	require.NoError(t, fs.MkdirAll(constants.EtcDir(), 0644), "Failed to create in memory directory")
 	require.NoError(t, afero.WriteFile(fs, constants.EtcDir()+constants.HTTPRESTFileName(),
 		[]byte(restConfigTestData["valid"]), 0644), "Failed to write in memory file")
 
-	server, err := NewServer(&fs, mockAuth, mockPostgres, mockRedis, mockQuotes, zapLogger, &sync.WaitGroup{})
+	server, err := NewServer(&fs, mockAuth, mockPostgres, mockRedis, mockQuotes, mockNotifier,
+		zapLogger, &sync.WaitGroup{})
 	require.NoError(t, err, "error whilst creating mock server")
 	require.NotNil(t, server, "failed to create mock server")
 }
+
+func TestServer_UserRateLimits(t *testing.T) {
+	clientID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name         string
+		path         string
+		token        string
+		expectedKeys []string
+	}{
+		{
+			name:         "unauthenticated",
+			path:         "/user/login",
+			expectedKeys: []string{"ip:192.0.2.1"},
+		}, {
+			name:         "authenticated",
+			path:         "/user/logout/all",
+			token:        "valid-token",
+			expectedKeys: []string{"ip:192.0.2.1", "client:" + clientID.String()},
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+			mockPostgres := mocks.NewMockPostgres(mockCtrl)
+			mockRedis := mocks.NewMockRedis(mockCtrl)
+
+			fs := afero.NewMemMapFs()
+			require.NoError(t, fs.MkdirAll(constants.EtcDir(), 0644), "Failed to create in memory directory")
+			require.NoError(t, afero.WriteFile(fs, constants.EtcDir()+constants.HTTPRESTFileName(),
+				[]byte(restConfigTestData["valid"]), 0644), "Failed to write in memory file")
+
+			server, err := NewServer(&fs, mockAuth, mockPostgres, mockRedis, quotes.NewMockQuotes(mockCtrl),
+				mocks.NewMockNotifier(mockCtrl), zapLogger, &sync.WaitGroup{})
+			require.NoError(t, err, "error whilst creating mock server")
+			require.NoError(t, server.initialize(), "failed to initialize server routes")
+
+			if test.token != "" {
+				mockAuth.EXPECT().ValidateJWT(test.token).Return(clientID, int64(0), nil).Times(1)
+				mockAuth.EXPECT().SessionFromJWT(test.token).Return("session-id", int64(0), nil).Times(1)
+				mockRedis.EXPECT().Get(gomock.Any(), gomock.Any()).Return(redis.ErrCacheMiss).Times(2)
+				mockPostgres.EXPECT().UserIsDeleted(clientID).Return(false, nil).Times(1)
+				mockAuth.EXPECT().StepUpRequired(auth.StepUpNone).Return(false).Times(1)
+			}
+
+			// The last bucket is exhausted so that the request is rejected before reaching the handler.
+			var spentKeys []string
+
+			mockRedis.EXPECT().TakeTokens(gomock.Any(), int64(5), int64(1), gomock.Any()).
+				DoAndReturn(func(key string, _, _ int64, _ time.Duration) (bool, time.Duration, error) {
+					spentKeys = append(spentKeys, key)
+
+					return len(spentKeys) < len(test.expectedKeys), time.Second, nil
+				}).
+				Times(len(test.expectedKeys))
+
+			request := httptest.NewRequest(http.MethodPost, "/api/rest/v1"+test.path, nil)
+			request.RemoteAddr = "192.0.2.1:443"
+
+			if test.token != "" {
+				request.Header.Set("Authorization", test.token)
+			}
+
+			recorder := httptest.NewRecorder()
+			server.router.ServeHTTP(recorder, request)
+
+			require.Equal(t, http.StatusTooManyRequests, recorder.Code, "expected request to be rate limited.")
+
+			for idx, identity := range test.expectedKeys {
+				require.Equal(t, constants.RateLimitKeyPrefix()+"user-"+identity, spentKeys[idx],
+					"rate limit bucket mismatch.")
+			}
+		})
+	}
+}
@@ -14,8 +14,44 @@ server:
   readTimeout: 3s
   writeTimeout: 3s
   readHeaderTimeout: 3s
+  trustedProxies:
+    - 10.0.0.0/8
+    - 127.0.0.1
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimits:
+  user:
+    requests: 10
+    period: 1m
+    burst: 5
+  fiat:
+    requests: 120
+    period: 1m
+    burst: 30
+  crypto:
+    requests: 120
+    period: 1m
+    burst: 30
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5
+  orders:
+    requests: 60
+    period: 1m
+    burst: 20
+  schedules:
+    requests: 30
+    period: 1m
+    burst: 10
+  rates:
+    requests: 60
+    period: 1m
+    burst: 20
+  admin:
+    requests: 120
+    period: 1m
+    burst: 30`,
 
 		"out of range port": `
 server:
@@ -27,7 +63,40 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimits:
+  user:
+    requests: 10
+    period: 1m
+    burst: 5
+  fiat:
+    requests: 120
+    period: 1m
+    burst: 30
+  crypto:
+    requests: 120
+    period: 1m
+    burst: 30
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5
+  orders:
+    requests: 60
+    period: 1m
+    burst: 20
+  schedules:
+    requests: 30
+    period: 1m
+    burst: 10
+  rates:
+    requests: 60
+    period: 1m
+    burst: 20
+  admin:
+    requests: 120
+    period: 1m
+    burst: 30`,
 
 		"out of range time delay": `
 server:
@@ -39,7 +108,40 @@ server:
   writeTimeout: 0s
   readHeaderTimeout: 0s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimits:
+  user:
+    requests: 10
+    period: 1m
+    burst: 5
+  fiat:
+    requests: 120
+    period: 1m
+    burst: 30
+  crypto:
+    requests: 120
+    period: 1m
+    burst: 30
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5
+  orders:
+    requests: 60
+    period: 1m
+    burst: 20
+  schedules:
+    requests: 30
+    period: 1m
+    burst: 10
+  rates:
+    requests: 60
+    period: 1m
+    burst: 20
+  admin:
+    requests: 120
+    period: 1m
+    burst: 30`,
 
 		"no base path": `
 server:
@@ -50,7 +152,40 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimits:
+  user:
+    requests: 10
+    period: 1m
+    burst: 5
+  fiat:
+    requests: 120
+    period: 1m
+    burst: 30
+  crypto:
+    requests: 120
+    period: 1m
+    burst: 30
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5
+  orders:
+    requests: 60
+    period: 1m
+    burst: 20
+  schedules:
+    requests: 30
+    period: 1m
+    burst: 10
+  rates:
+    requests: 60
+    period: 1m
+    burst: 20
+  admin:
+    requests: 120
+    period: 1m
+    burst: 30`,
 
 		"no swagger path": `
 server:
@@ -61,7 +196,40 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimits:
+  user:
+    requests: 10
+    period: 1m
+    burst: 5
+  fiat:
+    requests: 120
+    period: 1m
+    burst: 30
+  crypto:
+    requests: 120
+    period: 1m
+    burst: 30
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5
+  orders:
+    requests: 60
+    period: 1m
+    burst: 20
+  schedules:
+    requests: 30
+    period: 1m
+    burst: 10
+  rates:
+    requests: 60
+    period: 1m
+    burst: 20
+  admin:
+    requests: 120
+    period: 1m
+    burst: 30`,
 
 		"no read timeout": `
 server:
@@ -72,7 +240,40 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimits:
+  user:
+    requests: 10
+    period: 1m
+    burst: 5
+  fiat:
+    requests: 120
+    period: 1m
+    burst: 30
+  crypto:
+    requests: 120
+    period: 1m
+    burst: 30
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5
+  orders:
+    requests: 60
+    period: 1m
+    burst: 20
+  schedules:
+    requests: 30
+    period: 1m
+    burst: 10
+  rates:
+    requests: 60
+    period: 1m
+    burst: 20
+  admin:
+    requests: 120
+    period: 1m
+    burst: 30`,
 
 		"no write timeout": `
 server:
@@ -83,7 +284,40 @@ server:
   readTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimits:
+  user:
+    requests: 10
+    period: 1m
+    burst: 5
+  fiat:
+    requests: 120
+    period: 1m
+    burst: 30
+  crypto:
+    requests: 120
+    period: 1m
+    burst: 30
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5
+  orders:
+    requests: 60
+    period: 1m
+    burst: 20
+  schedules:
+    requests: 30
+    period: 1m
+    burst: 10
+  rates:
+    requests: 60
+    period: 1m
+    burst: 20
+  admin:
+    requests: 120
+    period: 1m
+    burst: 30`,
 
 		"no read header timeout": `
 server:
@@ -94,7 +328,40 @@ server:
   readTimeout: 1s
   writeTimeout: 1s
 authorization:
-  headerKey: Authorization`,
+  headerKey: Authorization
+rateLimits:
+  user:
+    requests: 10
+    period: 1m
+    burst: 5
+  fiat:
+    requests: 120
+    period: 1m
+    burst: 30
+  crypto:
+    requests: 120
+    period: 1m
+    burst: 30
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5
+  orders:
+    requests: 60
+    period: 1m
+    burst: 20
+  schedules:
+    requests: 30
+    period: 1m
+    burst: 10
+  rates:
+    requests: 60
+    period: 1m
+    burst: 20
+  admin:
+    requests: 120
+    period: 1m
+    burst: 30`,
 
 		"no auth header": `
 server:
@@ -106,6 +373,144 @@ server:
   writeTimeout: 1s
   readHeaderTimeout: 1s
 authorization:
-  headerKey:`,
+  headerKey:
+rateLimits:
+  user:
+    requests: 10
+    period: 1m
+    burst: 5
+  fiat:
+    requests: 120
+    period: 1m
+    burst: 30
+  crypto:
+    requests: 120
+    period: 1m
+    burst: 30
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5
+  orders:
+    requests: 60
+    period: 1m
+    burst: 20
+  schedules:
+    requests: 30
+    period: 1m
+    burst: 10
+  rates:
+    requests: 60
+    period: 1m
+    burst: 20
+  admin:
+    requests: 120
+    period: 1m
+    burst: 30`,
+
+		"out of range rate limit": `
+server:
+  portNumber: 33723
+  shutdownDelay: 5s
+  basePath: api/rest/v1
+  swaggerPath: /swagger/*any
+  readTimeout: 3s
+  writeTimeout: 3s
+  readHeaderTimeout: 3s
+authorization:
+  headerKey: Authorization
+rateLimits:
+  user:
+    requests: 0
+    period: 0s
+    burst: 0
+  fiat:
+    requests: 120
+    period: 1m
+    burst: 30
+  crypto:
+    requests: 120
+    period: 1m
+    burst: 30
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5
+  orders:
+    requests: 60
+    period: 1m
+    burst: 20
+  schedules:
+    requests: 30
+    period: 1m
+    burst: 10
+  rates:
+    requests: 60
+    period: 1m
+    burst: 20
+  admin:
+    requests: 120
+    period: 1m
+    burst: 30`,
+
+		"no rate limits": `
+server:
+  portNumber: 33723
+  shutdownDelay: 5s
+  basePath: api/rest/v1
+  swaggerPath: /swagger/*any
+  readTimeout: 3s
+  writeTimeout: 3s
+  readHeaderTimeout: 3s
+authorization:
+  headerKey: Authorization`,
+
+		"invalid trusted proxy": `
+server:
+  portNumber: 33723
+  shutdownDelay: 5s
+  basePath: api/rest/v1
+  swaggerPath: /swagger/*any
+  readTimeout: 3s
+  writeTimeout: 3s
+  readHeaderTimeout: 3s
+  trustedProxies:
+    - 10.0.0.0/8
+    - not-an-address
+authorization:
+  headerKey: Authorization
+rateLimits:
+  user:
+    requests: 10
+    period: 1m
+    burst: 5
+  fiat:
+    requests: 120
+    period: 1m
+    burst: 30
+  crypto:
+    requests: 120
+    period: 1m
+    burst: 30
+  quotes:
+    requests: 20
+    period: 1m
+    burst: 5
+  orders:
+    requests: 60
+    period: 1m
+    burst: 20
+  schedules:
+    requests: 30
+    period: 1m
+    burst: 10
+  rates:
+    requests: 60
+    period: 1m
+    burst: 20
+  admin:
+    requests: 120
+    period: 1m
+    burst: 30`,
 	}
 }
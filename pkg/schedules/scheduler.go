@@ -0,0 +1,137 @@
+package schedules
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/poller"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/quotes"
+	"go.uber.org/zap"
+)
+
+// Scheduler will periodically poll the recurring purchase schedules and execute those that are due.
+type Scheduler struct {
+	db     postgres.Postgres
+	quotes quotes.Quotes
+	logger *logger.Logger
+	wg     *sync.WaitGroup
+}
+
+// NewScheduler will create a new recurring purchase scheduler instance in a non-running state.
+func NewScheduler(db postgres.Postgres, quotes quotes.Quotes, logger *logger.Logger, wg *sync.WaitGroup) (
+	*Scheduler, error) {
+	if db == nil || quotes == nil || logger == nil || wg == nil {
+		return nil, errors.New("nil database, quotes, logger, or wait group supplied")
+	}
+
+	return &Scheduler{db: db, quotes: quotes, logger: logger, wg: wg}, nil
+}
+
+// Run will poll and execute the due recurring purchase schedules until a shutdown signal is received.
+func (s *Scheduler) Run() {
+	poller.Run("Recurring purchase scheduler", constants.SchedulerInterval(), s.logger, s.wg, s.poll)
+}
+
+// poll will retrieve a batch of the most overdue schedules and execute each of them.
+func (s *Scheduler) poll() {
+	schedules, err := s.db.SchedulesDue(constants.SchedulerBatchSize())
+	if err != nil {
+		s.logger.Warn("failed to retrieve due recurring purchase schedules", zap.Error(err))
+
+		return
+	}
+
+	for idx := range schedules {
+		s.execute(&schedules[idx])
+	}
+}
+
+// execute will claim a due schedule by advancing its next run time and then purchase the Cryptocurrency. The outcome
+// of every claimed run is recorded in the schedule's run history. A run that fails is not retried; the schedule will
+// run again at its next run time.
+func (s *Scheduler) execute(schedule *postgres.Schedule) {
+	next, err := NextRun(schedule.Frequency, int(schedule.AnchorDay), schedule.NextRunAt.Time, time.Now())
+	if err != nil {
+		s.logger.Warn("failed to compute next run for recurring purchase schedule",
+			zap.String("scheduleID", schedule.ScheduleID.String()), zap.Error(err))
+
+		return
+	}
+
+	// Claim the run. This will fail if the schedule has been run, updated, or paused since it was retrieved.
+	if err = s.db.ScheduleAdvance(schedule.ScheduleID, schedule.NextRunAt.Time, next); err != nil {
+		return
+	}
+
+	run := &postgres.ScheduleRun{
+		ScheduleID: schedule.ScheduleID,
+		ClientID:   schedule.ClientID,
+		FiatAmount: schedule.Amount,
+	}
+
+	// Compute the fee, which is collected in the Fiat currency, and ensure there are funds left to convert.
+	run.Fee = s.quotes.Fee(string(schedule.FiatCurrency), schedule.Ticker, schedule.Amount,
+		constants.DecimalPlacesFiat())
+	if !schedule.Amount.GreaterThan(run.Fee) {
+		s.record(run, postgres.ScheduleRunStatusFailed, "purchase amount does not cover the fee")
+
+		return
+	}
+
+	if run.Rate, run.CryptoAmount, err = s.quotes.CryptoConversion(string(schedule.FiatCurrency), schedule.Ticker,
+		schedule.Amount.Sub(run.Fee), true, nil); err != nil {
+		s.logger.Warn("failed to retrieve quote for recurring purchase schedule",
+			zap.String("scheduleID", schedule.ScheduleID.String()), zap.Error(err))
+		s.record(run, postgres.ScheduleRunStatusFailed, "failed to retrieve a quote")
+
+		return
+	}
+
+	if !run.CryptoAmount.GreaterThan(decimal.Zero) {
+		s.record(run, postgres.ScheduleRunStatusFailed, "purchase amount is too small")
+
+		return
+	}
+
+	fiatJournal, _, err := s.db.CryptoPurchase(schedule.ClientID, schedule.FiatCurrency, schedule.Amount,
+		schedule.Ticker, run.CryptoAmount, run.Fee, &postgres.TradeDetails{Rate: run.Rate})
+	if err != nil {
+		// A purchase that succeeded but whose details could not be retrieved is recorded without a transaction ID.
+		var dbErr *postgres.Error
+		if errors.As(err, &dbErr) && error(dbErr) == postgres.ErrTransactCryptoDetails {
+			s.logger.Error("recurring purchase completed but transaction details could not be retrieved",
+				zap.String("scheduleID", schedule.ScheduleID.String()), zap.Error(err))
+			s.record(run, postgres.ScheduleRunStatusSucceeded, dbErr.Message)
+
+			return
+		}
+
+		s.record(run, postgres.ScheduleRunStatusFailed, err.Error())
+
+		return
+	}
+
+	run.TxID.UUID, run.TxID.Valid = fiatJournal.TxID, true
+	s.record(run, postgres.ScheduleRunStatusSucceeded, "")
+}
+
+// record will store the outcome of a schedule's run in its run history.
+func (s *Scheduler) record(run *postgres.ScheduleRun, status postgres.ScheduleRunStatus, message string) {
+	run.Status = status
+	run.Message = message
+
+	if status == postgres.ScheduleRunStatusFailed {
+		s.logger.Info("recurring purchase failed",
+			zap.String("scheduleID", run.ScheduleID.String()), zap.String("reason", message))
+	}
+
+	if err := s.db.ScheduleRunCreate(run); err != nil {
+		s.logger.Error("failed to record recurring purchase run",
+			zap.String("scheduleID", run.ScheduleID.String()), zap.String("status", string(status)), zap.Error(err))
+	}
+}
@@ -0,0 +1,261 @@
+package schedules
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"github.com/surahman/FTeX/pkg/quotes"
+)
+
+func TestScheduler_NewScheduler(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var waitGroup sync.WaitGroup
+
+	scheduler, err := NewScheduler(nil, quotes.NewMockQuotes(mockCtrl), zapLogger, &waitGroup)
+	require.Error(t, err, "created scheduler with nil database.")
+	require.Nil(t, scheduler, "scheduler returned with nil database.")
+
+	scheduler, err = NewScheduler(mocks.NewMockPostgres(mockCtrl), quotes.NewMockQuotes(mockCtrl), zapLogger,
+		&waitGroup)
+	require.NoError(t, err, "failed to create scheduler.")
+	require.NotNil(t, scheduler, "nil scheduler returned.")
+}
+
+func TestScheduler_Poll(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockDB := mocks.NewMockPostgres(mockCtrl)
+	mockQuotes := quotes.NewMockQuotes(mockCtrl)
+
+	due := []postgres.Schedule{
+		{Frequency: postgres.ScheduleFrequencyDaily, NextRunAt: pgtype.Timestamptz{Time: time.Now(), Valid: true}},
+		{Frequency: postgres.ScheduleFrequencyWeekly, NextRunAt: pgtype.Timestamptz{Time: time.Now(), Valid: true}},
+	}
+
+	gomock.InOrder(
+		mockDB.EXPECT().SchedulesDue(gomock.Any()).
+			Return(nil, postgres.ErrNotFound).
+			Times(1),
+
+		mockDB.EXPECT().SchedulesDue(gomock.Any()).
+			Return(due, nil).
+			Times(1),
+	)
+
+	// Both schedules have been claimed by another instance.
+	mockDB.EXPECT().ScheduleAdvance(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(postgres.ErrUpdateSchedule).
+		Times(2)
+
+	scheduler := &Scheduler{db: mockDB, quotes: mockQuotes, logger: zapLogger}
+
+	scheduler.poll()
+	scheduler.poll()
+}
+
+func TestScheduler_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate client id.")
+
+	txID, err := uuid.NewV4()
+	require.NoError(t, err, "failed to generate transaction id.")
+
+	var (
+		fee          = decimal.NewFromFloat(0.5)
+		amount       = decimal.NewFromFloat(50)
+		rate         = decimal.NewFromFloat(0.00003)
+		purchased    = decimal.NewFromFloat(0.001485)
+		fiatJrnl     = &postgres.FiatJournal{TxID: txID}
+		nextRunAt    = time.Now().Add(-time.Minute)
+		quoteFailure = errors.New("quote failure")
+	)
+
+	testCases := []struct {
+		name           string
+		frequency      postgres.ScheduleFrequency
+		claimErr       error
+		claimTimes     int
+		feeAmount      decimal.Decimal
+		feeTimes       int
+		quoteAmount    decimal.Decimal
+		quoteErr       error
+		quoteTimes     int
+		purchaseErr    error
+		purchaseTimes  int
+		expectedStatus postgres.ScheduleRunStatus
+		expectTxID     bool
+		recordErr      error
+		recordTimes    int
+	}{
+		{
+			name:           "purchase succeeded",
+			frequency:      postgres.ScheduleFrequencyWeekly,
+			claimTimes:     1,
+			feeAmount:      fee,
+			feeTimes:       1,
+			quoteAmount:    purchased,
+			quoteTimes:     1,
+			purchaseTimes:  1,
+			expectedStatus: postgres.ScheduleRunStatusSucceeded,
+			expectTxID:     true,
+			recordTimes:    1,
+		}, {
+			name:       "invalid frequency",
+			frequency:  postgres.ScheduleFrequency("hourly"),
+			claimTimes: 0,
+		}, {
+			name:       "claimed elsewhere",
+			frequency:  postgres.ScheduleFrequencyWeekly,
+			claimErr:   postgres.ErrUpdateSchedule,
+			claimTimes: 1,
+		}, {
+			name:           "fee exceeds amount",
+			frequency:      postgres.ScheduleFrequencyDaily,
+			claimTimes:     1,
+			feeAmount:      amount,
+			feeTimes:       1,
+			expectedStatus: postgres.ScheduleRunStatusFailed,
+			recordTimes:    1,
+		}, {
+			name:           "quote failure",
+			frequency:      postgres.ScheduleFrequencyMonthly,
+			claimTimes:     1,
+			feeAmount:      fee,
+			feeTimes:       1,
+			quoteErr:       quoteFailure,
+			quoteTimes:     1,
+			expectedStatus: postgres.ScheduleRunStatusFailed,
+			recordTimes:    1,
+		}, {
+			name:           "purchase amount too small",
+			frequency:      postgres.ScheduleFrequencyWeekly,
+			claimTimes:     1,
+			feeAmount:      fee,
+			feeTimes:       1,
+			quoteAmount:    decimal.Zero,
+			quoteTimes:     1,
+			expectedStatus: postgres.ScheduleRunStatusFailed,
+			recordTimes:    1,
+		}, {
+			name:           "purchase failure",
+			frequency:      postgres.ScheduleFrequencyWeekly,
+			claimTimes:     1,
+			feeAmount:      fee,
+			feeTimes:       1,
+			quoteAmount:    purchased,
+			quoteTimes:     1,
+			purchaseErr:    postgres.ErrTransactCrypto,
+			purchaseTimes:  1,
+			expectedStatus: postgres.ScheduleRunStatusFailed,
+			recordTimes:    1,
+		}, {
+			name:           "transaction details failure",
+			frequency:      postgres.ScheduleFrequencyWeekly,
+			claimTimes:     1,
+			feeAmount:      fee,
+			feeTimes:       1,
+			quoteAmount:    purchased,
+			quoteTimes:     1,
+			purchaseErr:    postgres.ErrTransactCryptoDetails,
+			purchaseTimes:  1,
+			expectedStatus: postgres.ScheduleRunStatusSucceeded,
+			recordTimes:    1,
+		}, {
+			name:           "record failure",
+			frequency:      postgres.ScheduleFrequencyWeekly,
+			claimTimes:     1,
+			feeAmount:      fee,
+			feeTimes:       1,
+			quoteAmount:    purchased,
+			quoteTimes:     1,
+			purchaseTimes:  1,
+			expectedStatus: postgres.ScheduleRunStatusSucceeded,
+			expectTxID:     true,
+			recordErr:      postgres.ErrCreateScheduleRun,
+			recordTimes:    1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock configurations.
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockQuotes := quotes.NewMockQuotes(mockCtrl)
+
+			scheduleID, err := uuid.NewV4()
+			require.NoError(t, err, "failed to generate schedule id.")
+
+			schedule := &postgres.Schedule{
+				ScheduleID:   scheduleID,
+				ClientID:     clientID,
+				FiatCurrency: postgres.CurrencyUSD,
+				Ticker:       "BTC",
+				Amount:       amount,
+				Frequency:    test.frequency,
+				NextRunAt:    pgtype.Timestamptz{Time: nextRunAt, Valid: true},
+				AnchorDay:    int16(nextRunAt.UTC().Day()),
+				IsActive:     true,
+			}
+
+			mockDB.EXPECT().ScheduleAdvance(scheduleID, nextRunAt, gomock.Any()).
+				Return(test.claimErr).
+				Times(test.claimTimes)
+
+			mockQuotes.EXPECT().Fee("USD", "BTC", amount, gomock.Any()).
+				Return(test.feeAmount).
+				Times(test.feeTimes)
+
+			mockQuotes.EXPECT().CryptoConversion("USD", "BTC", amount.Sub(test.feeAmount), true, nil).
+				Return(rate, test.quoteAmount, test.quoteErr).
+				Times(test.quoteTimes)
+
+			mockDB.EXPECT().CryptoPurchase(clientID, postgres.CurrencyUSD, amount, "BTC", test.quoteAmount,
+				test.feeAmount, &postgres.TradeDetails{Rate: rate}).
+				Return(fiatJrnl, nil, test.purchaseErr).
+				Times(test.purchaseTimes)
+
+			mockDB.EXPECT().ScheduleRunCreate(gomock.Any()).
+				DoAndReturn(func(run *postgres.ScheduleRun) error {
+					require.Equal(t, scheduleID, run.ScheduleID, "run schedule id mismatch.")
+					require.Equal(t, clientID, run.ClientID, "run client id mismatch.")
+					require.Equal(t, test.expectedStatus, run.Status, "run status mismatch.")
+					require.Equal(t, test.expectTxID, run.TxID.Valid, "run transaction id validity mismatch.")
+
+					if test.expectTxID {
+						require.Equal(t, txID, run.TxID.UUID, "run transaction id mismatch.")
+					}
+
+					return test.recordErr
+				}).
+				Times(test.recordTimes)
+
+			scheduler := &Scheduler{db: mockDB, quotes: mockQuotes, logger: zapLogger}
+			scheduler.execute(schedule)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package schedules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// NextRun will compute the first run time of a schedule, stepping forward from its current run time by the frequency,
+// that is after the specified time. Runs that were missed whilst a schedule was paused or the service was down are
+// skipped rather than executed in a burst. Monthly runs are made on the anchor day of the month, or on the last day of
+// months that are shorter.
+func NextRun(frequency postgres.ScheduleFrequency, anchorDay int, from, after time.Time) (time.Time, error) {
+	var step func(time.Time) time.Time
+
+	switch frequency {
+	case postgres.ScheduleFrequencyDaily:
+		step = func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	case postgres.ScheduleFrequencyWeekly:
+		step = func(t time.Time) time.Time { return t.AddDate(0, 0, 7) }
+	case postgres.ScheduleFrequencyMonthly:
+		if anchorDay < 1 || anchorDay > 31 {
+			return time.Time{}, fmt.Errorf("invalid schedule anchor day %d", anchorDay)
+		}
+
+		step = func(t time.Time) time.Time { return nextMonthlyRun(t, anchorDay) }
+	default:
+		return time.Time{}, fmt.Errorf("invalid schedule frequency %s", frequency)
+	}
+
+	next := step(from)
+	for !next.After(after) {
+		next = step(next)
+	}
+
+	return next, nil
+}
+
+// nextMonthlyRun will compute the run time in the month following the specified time on the anchor day, clamped to the
+// last day of that month. The anchor day is a day of the month in UTC.
+func nextMonthlyRun(current time.Time, anchorDay int) time.Time {
+	current = current.UTC()
+	year, month := current.Year(), current.Month()+1
+
+	// The zeroth day of the following month normalizes to the last day of this month.
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	return time.Date(year, month, min(anchorDay, lastDay),
+		current.Hour(), current.Minute(), current.Second(), current.Nanosecond(), time.UTC)
+}
@@ -0,0 +1,121 @@
+package schedules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestSchedules_NextRun(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2023, time.June, 5, 9, 0, 0, 0, time.UTC) // Monday.
+
+	testCases := []struct {
+		name      string
+		frequency postgres.ScheduleFrequency
+		anchorDay int
+		from      time.Time
+		after     time.Time
+		expected  time.Time
+		expectErr require.ErrorAssertionFunc
+	}{
+		{
+			name:      "daily",
+			frequency: postgres.ScheduleFrequencyDaily,
+			after:     from,
+			expected:  time.Date(2023, time.June, 6, 9, 0, 0, 0, time.UTC),
+			expectErr: require.NoError,
+		}, {
+			name:      "weekly",
+			frequency: postgres.ScheduleFrequencyWeekly,
+			after:     from.Add(time.Minute),
+			expected:  time.Date(2023, time.June, 12, 9, 0, 0, 0, time.UTC),
+			expectErr: require.NoError,
+		}, {
+			name:      "monthly",
+			frequency: postgres.ScheduleFrequencyMonthly,
+			anchorDay: 5,
+			after:     from,
+			expected:  time.Date(2023, time.July, 5, 9, 0, 0, 0, time.UTC),
+			expectErr: require.NoError,
+		}, {
+			name:      "weekly missed runs skipped",
+			frequency: postgres.ScheduleFrequencyWeekly,
+			after:     time.Date(2023, time.June, 20, 12, 0, 0, 0, time.UTC),
+			expected:  time.Date(2023, time.June, 26, 9, 0, 0, 0, time.UTC),
+			expectErr: require.NoError,
+		}, {
+			name:      "daily on boundary",
+			frequency: postgres.ScheduleFrequencyDaily,
+			after:     time.Date(2023, time.June, 6, 9, 0, 0, 0, time.UTC),
+			expected:  time.Date(2023, time.June, 7, 9, 0, 0, 0, time.UTC),
+			expectErr: require.NoError,
+		}, {
+			name:      "monthly clamped to end of short month",
+			frequency: postgres.ScheduleFrequencyMonthly,
+			anchorDay: 31,
+			from:      time.Date(2023, time.January, 31, 9, 0, 0, 0, time.UTC),
+			after:     time.Date(2023, time.January, 31, 9, 0, 0, 0, time.UTC),
+			expected:  time.Date(2023, time.February, 28, 9, 0, 0, 0, time.UTC),
+			expectErr: require.NoError,
+		}, {
+			name:      "monthly returns to anchor day after short month",
+			frequency: postgres.ScheduleFrequencyMonthly,
+			anchorDay: 31,
+			from:      time.Date(2023, time.February, 28, 9, 0, 0, 0, time.UTC),
+			after:     time.Date(2023, time.February, 28, 9, 0, 0, 0, time.UTC),
+			expected:  time.Date(2023, time.March, 31, 9, 0, 0, 0, time.UTC),
+			expectErr: require.NoError,
+		}, {
+			name:      "monthly clamped to end of leap february",
+			frequency: postgres.ScheduleFrequencyMonthly,
+			anchorDay: 30,
+			from:      time.Date(2024, time.January, 30, 9, 0, 0, 0, time.UTC),
+			after:     time.Date(2024, time.January, 30, 9, 0, 0, 0, time.UTC),
+			expected:  time.Date(2024, time.February, 29, 9, 0, 0, 0, time.UTC),
+			expectErr: require.NoError,
+		}, {
+			name:      "monthly missed runs skipped across year end",
+			frequency: postgres.ScheduleFrequencyMonthly,
+			anchorDay: 31,
+			from:      time.Date(2023, time.October, 31, 9, 0, 0, 0, time.UTC),
+			after:     time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC),
+			expected:  time.Date(2024, time.January, 31, 9, 0, 0, 0, time.UTC),
+			expectErr: require.NoError,
+		}, {
+			name:      "monthly invalid anchor day",
+			frequency: postgres.ScheduleFrequencyMonthly,
+			anchorDay: 0,
+			after:     from,
+			expectErr: require.Error,
+		}, {
+			name:      "invalid frequency",
+			frequency: postgres.ScheduleFrequency("hourly"),
+			after:     from,
+			expectErr: require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			start := test.from
+			if start.IsZero() {
+				start = from
+			}
+
+			next, err := NextRun(test.frequency, test.anchorDay, start, test.after)
+			test.expectErr(t, err, "error expectation failed.")
+
+			if err == nil {
+				require.True(t, test.expected.Equal(next), "next run mismatch: expected %s, got %s", test.expected, next)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/surahman/FTeX/pkg/auth"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/logger"
+	"github.com/surahman/FTeX/pkg/poller"
+	"github.com/surahman/FTeX/pkg/postgres"
+	"go.uber.org/zap"
+)
+
+// maxErrorLength is the maximum length of a failed delivery attempt's error that will be recorded.
+const maxErrorLength = 512
+
+// Dispatcher will periodically poll the webhook deliveries and deliver the account events that are due.
+type Dispatcher struct {
+	db     postgres.Postgres
+	auth   auth.Auth
+	client *http.Client
+	logger *logger.Logger
+	wg     *sync.WaitGroup
+}
+
+// NewDispatcher will create a new webhook dispatcher instance in a non-running state.
+func NewDispatcher(db postgres.Postgres, auth auth.Auth, logger *logger.Logger, wg *sync.WaitGroup) (
+	*Dispatcher, error) {
+	if db == nil || auth == nil || logger == nil || wg == nil {
+		return nil, errors.New("nil database, auth, logger, or wait group supplied")
+	}
+
+	return &Dispatcher{db: db, auth: auth, client: newClient(PublicAddress), logger: logger, wg: wg}, nil
+}
+
+// newClient will create the HTTP client used to deliver webhook requests. Redirects are not followed so that events
+// are only ever delivered to the registered URL. Every address that is dialed, after the host name has been resolved,
+// must be permitted. This prevents deliveries to internal services, including through DNS rebinding. Proxies are not
+// used because only the address of the proxy would be checked.
+func newClient(permitted func(netip.Addr) bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: constants.WebhookTimeout(),
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !permitted(addrPort.Addr()) {
+				return ErrForbiddenTarget
+			}
+
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   constants.WebhookTimeout(),
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// Run will poll and deliver the due webhook deliveries until a shutdown signal is received.
+func (d *Dispatcher) Run() {
+	poller.Run("Webhook dispatcher", constants.WebhookInterval(), d.logger, d.wg, d.poll)
+}
+
+// poll will claim a batch of the most overdue deliveries and attempt each of them concurrently. Claimed deliveries are
+// leased so that they will not be claimed again by this or another instance whilst they are being attempted.
+func (d *Dispatcher) poll() {
+	deliveries, err := d.db.WebhookDeliveriesDue(constants.WebhookBatchSize(),
+		time.Now().Add(constants.WebhookLease()))
+	if err != nil {
+		d.logger.Warn("failed to retrieve due webhook deliveries", zap.Error(err))
+
+		return
+	}
+
+	var waitGroup sync.WaitGroup
+
+	for idx := range deliveries {
+		waitGroup.Add(1)
+
+		go func(delivery *postgres.WebhookDeliveryDue) {
+			defer waitGroup.Done()
+
+			d.deliver(delivery)
+		}(&deliveries[idx])
+	}
+
+	waitGroup.Wait()
+}
+
+// deliver will attempt to deliver an event to a webhook and record the outcome. Deliveries that fail are retried with
+// an exponential backoff until the maximum number of attempts is reached, after which they are dead-lettered.
+func (d *Dispatcher) deliver(delivery *postgres.WebhookDeliveryDue) {
+	var (
+		status        = postgres.WebhookDeliveryStatusDelivered
+		nextAttemptAt = time.Now()
+		lastError     string
+	)
+
+	if err := d.send(delivery); err != nil {
+		attempts := delivery.Attempts + 1
+		lastError = err.Error()
+
+		if len(lastError) > maxErrorLength {
+			lastError = lastError[:maxErrorLength]
+		}
+
+		if attempts >= constants.WebhookMaxAttempts() {
+			status = postgres.WebhookDeliveryStatusDead
+		} else {
+			status = postgres.WebhookDeliveryStatusPending
+			nextAttemptAt = nextAttemptAt.Add(Backoff(attempts))
+		}
+
+		d.logger.Info("webhook delivery failed",
+			zap.String("deliveryID", delivery.DeliveryID.String()), zap.Int32("attempts", attempts),
+			zap.String("status", string(status)), zap.Error(err))
+	}
+
+	if err := d.db.WebhookDeliveryUpdate(delivery.DeliveryID, status, nextAttemptAt, lastError); err != nil {
+		d.logger.Error("failed to record webhook delivery attempt",
+			zap.String("deliveryID", delivery.DeliveryID.String()), zap.String("status", string(status)),
+			zap.Error(err))
+	}
+}
+
+// send will sign and POST an event to a webhook. Only 2xx responses are considered successful.
+func (d *Dispatcher) send(delivery *postgres.WebhookDeliveryDue) error {
+	var (
+		err      error
+		secret   []byte
+		body     []byte
+		request  *http.Request
+		response *http.Response
+	)
+
+	if secret, err = d.auth.DecryptFromString(delivery.Secret); err != nil {
+		d.logger.Error("failed to decrypt webhook signing secret",
+			zap.String("deliveryID", delivery.DeliveryID.String()), zap.Error(err))
+
+		return errors.New("failed to load webhook signing secret")
+	}
+
+	if body, err = json.Marshal(&Event{
+		EventID:   delivery.EventID,
+		EventType: delivery.EventType,
+		ClientID:  delivery.ClientID,
+		CreatedAt: delivery.CreatedAt,
+		Payload:   delivery.Payload,
+	}); err != nil {
+		return fmt.Errorf(constants.ErrorFormatMessage(), "failed to serialize event", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.WebhookTimeout())
+
+	defer cancel()
+
+	if request, err = http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body)); err != nil {
+		return fmt.Errorf(constants.ErrorFormatMessage(), "failed to create request", err)
+	}
+
+	timestamp := time.Now().Unix()
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(constants.WebhookTimestampHeader(), strconv.FormatInt(timestamp, 10))
+	request.Header.Set(constants.WebhookSignatureHeader(), Sign(secret, timestamp, body))
+	request.Header.Set(constants.WebhookEventIDHeader(), delivery.EventID.String())
+	request.Header.Set(constants.WebhookEventTypeHeader(), string(delivery.EventType))
+
+	if response, err = d.client.Do(request); err != nil {
+		return fmt.Errorf(constants.ErrorFormatMessage(), "request failed", err)
+	}
+
+	// Drain the response body to allow the connection to be reused.
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, maxErrorLength))
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected response status %d", response.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,238 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/mocks"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+func TestDispatcher_NewDispatcher(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var waitGroup sync.WaitGroup
+
+	dispatcher, err := NewDispatcher(nil, mocks.NewMockAuth(mockCtrl), zapLogger, &waitGroup)
+	require.Error(t, err, "created dispatcher with nil database.")
+	require.Nil(t, dispatcher, "dispatcher returned with nil database.")
+
+	dispatcher, err = NewDispatcher(mocks.NewMockPostgres(mockCtrl), mocks.NewMockAuth(mockCtrl), zapLogger,
+		&waitGroup)
+	require.NoError(t, err, "failed to create dispatcher.")
+	require.NotNil(t, dispatcher, "nil dispatcher returned.")
+}
+
+func TestDispatcher_NewClient(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	// Host names are resolved before the address is checked.
+	loopbackURL := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+
+	response, err := newClient(PublicAddress).Post(loopbackURL, "application/json", nil) //nolint:noctx
+	require.ErrorIs(t, err, ErrForbiddenTarget, "delivered to a loopback address.")
+	require.Nil(t, response, "response returned from a loopback address.")
+
+	response, err = newClient(func(netip.Addr) bool { return true }).
+		Post(loopbackURL, "application/json", nil) //nolint:noctx
+	require.NoError(t, err, "failed to deliver to a permitted address.")
+	require.NoError(t, response.Body.Close(), "failed to close response body.")
+	require.Equal(t, http.StatusNoContent, response.StatusCode, "response status mismatch.")
+}
+
+func TestDispatcher_Poll(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockDB := mocks.NewMockPostgres(mockCtrl)
+	mockAuth := mocks.NewMockAuth(mockCtrl)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	due := []postgres.WebhookDeliveryDue{
+		{DeliveryID: uuid.Must(uuid.NewV4()), URL: server.URL},
+		{DeliveryID: uuid.Must(uuid.NewV4()), URL: server.URL},
+	}
+
+	gomock.InOrder(
+		mockDB.EXPECT().WebhookDeliveriesDue(constants.WebhookBatchSize(), gomock.Any()).
+			Return(nil, postgres.ErrTransactWebhook).
+			Times(1),
+
+		mockDB.EXPECT().WebhookDeliveriesDue(constants.WebhookBatchSize(), gomock.Any()).
+			Return(due, nil).
+			Times(1),
+	)
+
+	mockAuth.EXPECT().DecryptFromString(gomock.Any()).
+		Return([]byte("secret"), nil).
+		Times(2)
+
+	mockDB.EXPECT().WebhookDeliveryUpdate(gomock.Any(), postgres.WebhookDeliveryStatusDelivered, gomock.Any(), "").
+		Return(nil).
+		Times(2)
+
+	dispatcher := &Dispatcher{db: mockDB, auth: mockAuth, client: newClient(func(netip.Addr) bool { return true }), logger: zapLogger}
+
+	dispatcher.poll()
+	dispatcher.poll()
+}
+
+func TestDispatcher_Deliver(t *testing.T) {
+	t.Parallel()
+
+	eventID := uuid.Must(uuid.NewV4())
+	payload := json.RawMessage(`{"txId":"test"}`)
+
+	testCases := []struct {
+		name           string
+		handler        http.HandlerFunc
+		attempts       int32
+		decryptErr     error
+		expectedStatus postgres.WebhookDeliveryStatus
+		expectedErr    string
+		expectedDelay  time.Duration
+	}{
+		{
+			name: "delivered",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			},
+			expectedStatus: postgres.WebhookDeliveryStatusDelivered,
+		}, {
+			name: "server error",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			attempts:       2,
+			expectedStatus: postgres.WebhookDeliveryStatusPending,
+			expectedErr:    "unexpected response status 500",
+			expectedDelay:  Backoff(3),
+		}, {
+			name: "redirect not followed",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, "/elsewhere", http.StatusFound)
+			},
+			expectedStatus: postgres.WebhookDeliveryStatusPending,
+			expectedErr:    "unexpected response status 302",
+			expectedDelay:  Backoff(1),
+		}, {
+			name: "attempts exhausted",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+			},
+			attempts:       constants.WebhookMaxAttempts() - 1,
+			expectedStatus: postgres.WebhookDeliveryStatusDead,
+			expectedErr:    "unexpected response status 502",
+		}, {
+			name:           "secret decryption failure",
+			decryptErr:     errors.New("decryption failure"),
+			expectedStatus: postgres.WebhookDeliveryStatusPending,
+			expectedErr:    "failed to load webhook signing secret",
+			expectedDelay:  Backoff(1),
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockDB := mocks.NewMockPostgres(mockCtrl)
+			mockAuth := mocks.NewMockAuth(mockCtrl)
+
+			var received *http.Request
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err, "failed to read request body.")
+
+				// Check the signature and the event envelope.
+				timestamp, err := strconv.ParseInt(r.Header.Get(constants.WebhookTimestampHeader()), 10, 64)
+				require.NoError(t, err, "failed to parse timestamp header.")
+				require.Equal(t, Sign([]byte("secret"), timestamp, body),
+					r.Header.Get(constants.WebhookSignatureHeader()), "signature mismatch.")
+
+				var event Event
+				require.NoError(t, json.Unmarshal(body, &event), "failed to unmarshal event.")
+				require.Equal(t, eventID, event.EventID, "event ID mismatch.")
+				require.JSONEq(t, string(payload), string(event.Payload), "payload mismatch.")
+
+				received = r
+				test.handler(w, r)
+			}))
+			defer server.Close()
+
+			delivery := &postgres.WebhookDeliveryDue{
+				DeliveryID: uuid.Must(uuid.NewV4()),
+				EventID:    eventID,
+				Attempts:   test.attempts,
+				EventType:  postgres.OutboxEventTypeFiatDeposit,
+				Payload:    payload,
+				URL:        server.URL,
+				Secret:     "encrypted-secret",
+			}
+
+			mockAuth.EXPECT().DecryptFromString("encrypted-secret").
+				Return([]byte("secret"), test.decryptErr).
+				Times(1)
+
+			start := time.Now()
+
+			mockDB.EXPECT().WebhookDeliveryUpdate(delivery.DeliveryID, test.expectedStatus, gomock.Any(),
+				test.expectedErr).
+				DoAndReturn(func(_ uuid.UUID, _ postgres.WebhookDeliveryStatus, nextAttemptAt time.Time, _ string) error {
+					require.WithinDuration(t, start.Add(test.expectedDelay), nextAttemptAt, time.Second,
+						"next attempt time mismatch.")
+
+					return nil
+				}).
+				Times(1)
+
+			dispatcher := &Dispatcher{db: mockDB, auth: mockAuth, client: newClient(func(netip.Addr) bool { return true }), logger: zapLogger}
+			dispatcher.deliver(delivery)
+
+			if test.decryptErr != nil {
+				require.Nil(t, received, "request sent without a signing secret.")
+
+				return
+			}
+
+			require.NotNil(t, received, "request not received.")
+			require.Equal(t, eventID.String(), received.Header.Get(constants.WebhookEventIDHeader()),
+				"event ID header mismatch.")
+			require.Equal(t, string(postgres.OutboxEventTypeFiatDeposit),
+				received.Header.Get(constants.WebhookEventTypeHeader()), "event type header mismatch.")
+		})
+	}
+}
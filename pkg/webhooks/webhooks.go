@@ -0,0 +1,121 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/surahman/FTeX/pkg/constants"
+	"github.com/surahman/FTeX/pkg/postgres"
+)
+
+// signaturePrefix identifies the algorithm used to generate a webhook request signature.
+const signaturePrefix = "sha256="
+
+// ErrForbiddenTarget is returned when a webhook URL refers to an address that is not publicly routable.
+var ErrForbiddenTarget = errors.New("webhook URL must refer to a publicly routable address")
+
+// reservedPrefixes are the special-purpose address ranges that are not covered by the netip.Addr classifiers. This
+// includes the carrier-grade NAT range, which some cloud providers serve instance metadata from.
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+}
+
+// reservedHosts are the host names that always refer to the local machine or to cloud instance metadata services.
+var reservedHosts = []string{"localhost", "metadata", "metadata.google.internal"}
+
+// Event is the JSON body of a webhook request.
+type Event struct {
+	EventID   uuid.UUID                `json:"eventId"`
+	EventType postgres.OutboxEventType `json:"eventType"`
+	ClientID  uuid.UUID                `json:"clientId"`
+	CreatedAt time.Time                `json:"createdAt"`
+	Payload   json.RawMessage          `json:"payload"`
+}
+
+// Sign will generate the signature of a webhook request body. The signature is the hex encoded HMAC-SHA256 digest of
+// the Unix timestamp and the body, separated by a period, using the webhook's signing secret as the key. Including the
+// timestamp allows endpoints to reject replayed requests.
+func Sign(secret []byte, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Backoff will compute the time duration to wait before retrying a webhook delivery after a number of failed attempts.
+// The wait doubles with each failed attempt, starting at the base backoff, up to the maximum backoff.
+func Backoff(attempts int32) time.Duration {
+	backoff := constants.WebhookBaseBackoff()
+
+	for idx := int32(1); idx < attempts && backoff < constants.WebhookMaxBackoff(); idx++ {
+		backoff *= 2
+	}
+
+	return min(backoff, constants.WebhookMaxBackoff())
+}
+
+// PublicAddress will check whether an IP address is publicly routable. Loopback, private, link-local (including the
+// instance metadata address), multicast, unspecified, and other reserved addresses are not.
+func PublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+
+	for _, prefix := range reservedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValidateURL will check that a webhook URL does not refer to a loopback, private, link-local, or reserved address or
+// to a well-known local host name. Host names are only resolved when a delivery is made, at which point the resolved
+// addresses are checked again to guard against DNS rebinding.
+func ValidateURL(rawURL string) error {
+	target, err := url.Parse(rawURL)
+	if err != nil {
+		return ErrForbiddenTarget
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(target.Hostname()), ".")
+	if host == "" {
+		return ErrForbiddenTarget
+	}
+
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if !PublicAddress(addr) {
+			return ErrForbiddenTarget
+		}
+
+		return nil
+	}
+
+	for _, reserved := range reservedHosts {
+		if host == reserved || strings.HasSuffix(host, "."+reserved) {
+			return ErrForbiddenTarget
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package webhooks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/surahman/FTeX/pkg/constants"
+)
+
+func TestWebhooks_Sign(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("whsec_test-secret")
+	body := []byte(`{"eventId":"test"}`)
+
+	// echo -n '1700000000.{"eventId":"test"}' | openssl dgst -sha256 -hmac 'whsec_test-secret'
+	expected := "sha256=749d8c51f4a9b922978cf684f9452dce35321d7d20e9e37b31dc2670bc922225"
+
+	signature := Sign(secret, 1700000000, body)
+	require.Equal(t, expected, signature, "signature mismatch.")
+	require.NotEqual(t, signature, Sign(secret, 1700000001, body), "timestamp not signed.")
+	require.NotEqual(t, signature, Sign([]byte("whsec_other"), 1700000000, body), "secret not used.")
+	require.NotEqual(t, signature, Sign(secret, 1700000000, []byte(`{"eventId":"other"}`)), "body not signed.")
+}
+
+func TestWebhooks_Backoff(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		attempts int32
+		expected time.Duration
+	}{
+		{
+			name:     "first attempt",
+			attempts: 1,
+			expected: constants.WebhookBaseBackoff(),
+		}, {
+			name:     "second attempt",
+			attempts: 2,
+			expected: 2 * constants.WebhookBaseBackoff(),
+		}, {
+			name:     "fourth attempt",
+			attempts: 4,
+			expected: 8 * constants.WebhookBaseBackoff(),
+		}, {
+			name:     "capped",
+			attempts: 20,
+			expected: constants.WebhookMaxBackoff(),
+		}, {
+			name:     "overflow",
+			attempts: 1000,
+			expected: constants.WebhookMaxBackoff(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.expected, Backoff(test.attempts), "backoff mismatch.")
+		})
+	}
+}
+
+func TestWebhooks_ValidateURL(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		url       string
+		expectErr require.ErrorAssertionFunc
+	}{
+		{
+			name:      "public host name",
+			url:       "https://example.com/ftex/events",
+			expectErr: require.NoError,
+		}, {
+			name:      "public IPv4",
+			url:       "https://93.184.216.34/ftex/events",
+			expectErr: require.NoError,
+		}, {
+			name:      "public IPv6",
+			url:       "https://[2606:2800:220:1:248:1893:25c8:1946]/ftex/events",
+			expectErr: require.NoError,
+		}, {
+			name:      "loopback",
+			url:       "https://127.0.0.1:8443/ftex/events",
+			expectErr: require.Error,
+		}, {
+			name:      "loopback IPv6",
+			url:       "https://[::1]/ftex/events",
+			expectErr: require.Error,
+		}, {
+			name:      "IPv4 mapped loopback",
+			url:       "https://[::ffff:127.0.0.1]/ftex/events",
+			expectErr: require.Error,
+		}, {
+			name:      "private",
+			url:       "https://10.0.0.5/ftex/events",
+			expectErr: require.Error,
+		}, {
+			name:      "private IPv6",
+			url:       "https://[fd00:ec2::254]/latest/meta-data",
+			expectErr: require.Error,
+		}, {
+			name:      "link-local metadata",
+			url:       "https://169.254.169.254/latest/meta-data",
+			expectErr: require.Error,
+		}, {
+			name:      "shared address space metadata",
+			url:       "https://100.100.100.200/latest/meta-data",
+			expectErr: require.Error,
+		}, {
+			name:      "unspecified",
+			url:       "https://0.0.0.0/ftex/events",
+			expectErr: require.Error,
+		}, {
+			name:      "localhost",
+			url:       "https://LocalHost./ftex/events",
+			expectErr: require.Error,
+		}, {
+			name:      "localhost subdomain",
+			url:       "https://api.localhost/ftex/events",
+			expectErr: require.Error,
+		}, {
+			name:      "metadata host name",
+			url:       "https://metadata.google.internal/computeMetadata/v1",
+			expectErr: require.Error,
+		}, {
+			name:      "no host",
+			url:       "https:///ftex/events",
+			expectErr: require.Error,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			test.expectErr(t, ValidateURL(test.url), "validation expectation failed.")
+		})
+	}
+}